@@ -0,0 +1,17 @@
+BEGIN;
+
+ALTER TABLE public.workflow_nodes
+  ADD COLUMN IF NOT EXISTS retry_policy jsonb;
+
+ALTER TABLE public.workflow_node_executions
+  ADD COLUMN IF NOT EXISTS attempt integer NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS retry_of_execution_id uuid,
+  ADD COLUMN IF NOT EXISTS run_at timestamp without time zone;
+
+ALTER TABLE public.workflow_node_executions
+  ADD CONSTRAINT workflow_node_executions_retry_of_execution_id_fkey FOREIGN KEY (retry_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_retry_of_execution_id
+  ON public.workflow_node_executions USING btree (retry_of_execution_id);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE public.workflow_nodes
+  ADD COLUMN IF NOT EXISTS timeout_seconds integer NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE public.workflow_nodes
+  ADD COLUMN IF NOT EXISTS max_concurrency integer NOT NULL DEFAULT 1,
+  ADD COLUMN IF NOT EXISTS queue_policy character varying(32) NOT NULL DEFAULT 'fifo';
+
+COMMIT;
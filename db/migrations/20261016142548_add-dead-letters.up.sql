@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE public.workflow_events
+  ADD COLUMN IF NOT EXISTS attempts integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS failure_reason text;
+
+ALTER TABLE public.workflow_node_queue_items
+  ADD COLUMN IF NOT EXISTS state character varying(32) NOT NULL DEFAULT 'pending',
+  ADD COLUMN IF NOT EXISTS attempts integer NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS failure_reason text;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_events_dead_letter ON public.workflow_events USING btree (workflow_id, created_at DESC) WHERE ((state)::text = 'dead_letter'::text);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE public.workflow_events
+  ADD COLUMN IF NOT EXISTS replay_of_id uuid;
+
+ALTER TABLE public.workflow_events
+  ADD CONSTRAINT workflow_events_replay_of_id_fkey FOREIGN KEY (replay_of_id) REFERENCES public.workflow_events(id) ON DELETE SET NULL;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE public.workflow_node_executions
+  ADD COLUMN IF NOT EXISTS rerun_of_execution_id uuid;
+
+ALTER TABLE public.workflow_node_queue_items
+  ADD COLUMN IF NOT EXISTS rerun_of_execution_id uuid;
+
+ALTER TABLE public.workflow_node_executions
+  ADD CONSTRAINT workflow_node_executions_rerun_of_execution_id_fkey FOREIGN KEY (rerun_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+ALTER TABLE public.workflow_node_queue_items
+  ADD CONSTRAINT workflow_node_queue_items_rerun_of_execution_id_fkey FOREIGN KEY (rerun_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_rerun_of_execution_id
+  ON public.workflow_node_executions USING btree (rerun_of_execution_id);
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+--
+-- No foreign key to workflow_node_executions on purpose:
+-- log lines are written outside of the transaction that holds the
+-- execution row locked, and they must not wait on that lock.
+--
+CREATE TABLE IF NOT EXISTS public.workflow_node_execution_logs (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  workflow_id uuid NOT NULL,
+  node_id character varying(128) NOT NULL,
+  execution_id uuid NOT NULL,
+  level character varying(16) NOT NULL,
+  message text NOT NULL,
+  fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+  created_at timestamp with time zone DEFAULT now() NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_execution_logs_execution_id_created_at
+  ON public.workflow_node_execution_logs USING btree (execution_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_node_execution_logs_workflow_node
+  ON public.workflow_node_execution_logs USING btree (workflow_id, node_id);
+
+COMMIT;
@@ -0,0 +1,46 @@
+BEGIN;
+
+--
+-- A row without workflow_id is the organization default.
+-- A row with workflow_id overrides it for that canvas.
+-- A zero value disables the corresponding rule.
+--
+CREATE TABLE IF NOT EXISTS public.retention_policies (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  organization_id uuid NOT NULL,
+  workflow_id uuid,
+  max_age_days integer DEFAULT 0 NOT NULL,
+  max_executions_per_node integer DEFAULT 0 NOT NULL,
+  failed_max_age_days integer DEFAULT 0 NOT NULL,
+  updated_by uuid,
+  created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_retention_policies_organization_default
+  ON public.retention_policies USING btree (organization_id) WHERE (workflow_id IS NULL);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_retention_policies_workflow_id
+  ON public.retention_policies USING btree (workflow_id) WHERE (workflow_id IS NOT NULL);
+
+ALTER TABLE public.retention_policies
+  ADD CONSTRAINT retention_policies_organization_id_fkey
+  FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE public.retention_policies
+  ADD CONSTRAINT retention_policies_workflow_id_fkey
+  FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+ALTER TABLE public.retention_policies
+  ADD CONSTRAINT retention_policies_updated_by_fkey
+  FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+--
+-- Used by the retention worker to find old finished executions per node.
+--
+CREATE INDEX IF NOT EXISTS idx_workflow_node_executions_retention
+  ON public.workflow_node_executions USING btree (workflow_id, node_id, created_at)
+  WHERE (parent_execution_id IS NULL);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+ALTER TABLE public.canvas_memories ADD COLUMN expires_at timestamp with time zone;
+
+CREATE INDEX idx_canvas_memories_expires_at ON public.canvas_memories USING btree (expires_at) WHERE expires_at IS NOT NULL;
+
+CREATE TABLE public.canvas_memory_namespaces (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    canvas_id uuid NOT NULL,
+    namespace text NOT NULL,
+    default_ttl_seconds integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT canvas_memory_namespaces_pkey PRIMARY KEY (id),
+    CONSTRAINT canvas_memory_namespaces_canvas_id_namespace_key UNIQUE (canvas_id, namespace)
+);
+
+ALTER TABLE public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+COMMIT;
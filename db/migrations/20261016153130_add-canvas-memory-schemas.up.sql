@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE public.canvas_memory_namespaces ADD COLUMN schema jsonb;
+
+--
+-- Values of the unique key fields declared in the namespace schema,
+-- as a JSON array, so unique key upserts are enforced by the database.
+--
+ALTER TABLE public.canvas_memories ADD COLUMN unique_key jsonb;
+
+CREATE UNIQUE INDEX uix_canvas_memories_unique_key ON public.canvas_memories USING btree (canvas_id, namespace, unique_key) WHERE (unique_key IS NOT NULL);
+
+COMMIT;
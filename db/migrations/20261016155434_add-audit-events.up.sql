@@ -0,0 +1,30 @@
+BEGIN;
+
+--
+-- Audit events are only ever inserted.
+-- Nothing in the application updates or deletes them,
+-- they are only removed with their organization.
+--
+CREATE TABLE public.audit_events (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  organization_id uuid NOT NULL,
+  actor_id uuid,
+  resource_type character varying(64) NOT NULL,
+  resource_id character varying(255) DEFAULT ''::character varying NOT NULL,
+  action character varying(64) NOT NULL,
+  method character varying(255) DEFAULT ''::character varying NOT NULL,
+  status character varying(64) NOT NULL,
+  error text DEFAULT ''::text NOT NULL,
+  before jsonb DEFAULT '{}'::jsonb NOT NULL,
+  after jsonb DEFAULT '{}'::jsonb NOT NULL,
+  metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+  created_at timestamp without time zone DEFAULT now() NOT NULL,
+  PRIMARY KEY (id),
+  FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_audit_events_organization_id_created_at ON public.audit_events USING btree (organization_id, created_at DESC);
+CREATE INDEX idx_audit_events_resource ON public.audit_events USING btree (organization_id, resource_type, resource_id);
+CREATE INDEX idx_audit_events_actor_id ON public.audit_events USING btree (organization_id, actor_id);
+
+COMMIT;
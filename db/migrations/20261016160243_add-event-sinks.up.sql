@@ -0,0 +1,65 @@
+BEGIN;
+
+--
+-- Each organization can stream its activity to one external sink,
+-- either an HTTP endpoint or a syslog receiver.
+--
+CREATE TABLE IF NOT EXISTS public.event_sinks (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  organization_id uuid NOT NULL,
+  type character varying(32) NOT NULL,
+  url text NOT NULL,
+  secret_ciphertext bytea,
+  categories jsonb DEFAULT '[]'::jsonb NOT NULL,
+  enabled boolean DEFAULT true NOT NULL,
+  last_delivered_at timestamp without time zone,
+  last_error text,
+  last_error_at timestamp without time zone,
+  updated_by uuid,
+  created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_event_sinks_organization_id
+  ON public.event_sinks USING btree (organization_id);
+
+ALTER TABLE public.event_sinks
+  ADD CONSTRAINT event_sinks_organization_id_fkey
+  FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE public.event_sinks
+  ADD CONSTRAINT event_sinks_updated_by_fkey
+  FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+--
+-- Deliveries are the backlog of a sink.
+-- The dedup key prevents the same event from being delivered twice,
+-- when the message that produced it is received more than once.
+--
+CREATE TABLE IF NOT EXISTS public.event_sink_deliveries (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  sink_id uuid NOT NULL,
+  event_type character varying(64) NOT NULL,
+  dedup_key character varying(255) NOT NULL,
+  payload jsonb DEFAULT '{}'::jsonb NOT NULL,
+  state character varying(32) NOT NULL,
+  attempts integer DEFAULT 0 NOT NULL,
+  next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  last_error text,
+  delivered_at timestamp without time zone,
+  created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_event_sink_deliveries_sink_id_dedup_key
+  ON public.event_sink_deliveries USING btree (sink_id, dedup_key);
+
+CREATE INDEX IF NOT EXISTS idx_event_sink_deliveries_state_next_attempt_at
+  ON public.event_sink_deliveries USING btree (state, next_attempt_at);
+
+ALTER TABLE public.event_sink_deliveries
+  ADD CONSTRAINT event_sink_deliveries_sink_id_fkey
+  FOREIGN KEY (sink_id) REFERENCES public.event_sinks(id) ON DELETE CASCADE;
+
+COMMIT;
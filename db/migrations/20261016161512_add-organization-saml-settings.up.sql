@@ -0,0 +1,38 @@
+BEGIN;
+
+--
+-- Each organization can sign people in with one SAML identity provider.
+-- The metadata document is kept as uploaded, and the values read from it
+-- are stored next to it, so sign-ins do not parse it every time.
+--
+CREATE TABLE IF NOT EXISTS public.organization_saml_settings (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  organization_id uuid NOT NULL,
+  enabled boolean DEFAULT false NOT NULL,
+  enforced boolean DEFAULT false NOT NULL,
+  idp_metadata text NOT NULL,
+  idp_entity_id text NOT NULL,
+  idp_sso_url text NOT NULL,
+  idp_certificates jsonb DEFAULT '[]'::jsonb NOT NULL,
+  email_attribute character varying(255),
+  name_attribute character varying(255),
+  groups_attribute character varying(255),
+  group_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
+  updated_by uuid,
+  created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_organization_saml_settings_organization_id
+  ON public.organization_saml_settings USING btree (organization_id);
+
+ALTER TABLE public.organization_saml_settings
+  ADD CONSTRAINT organization_saml_settings_organization_id_fkey
+  FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE public.organization_saml_settings
+  ADD CONSTRAINT organization_saml_settings_updated_by_fkey
+  FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+--
+-- Variables and the environments overriding their values are part of the
+-- canvas spec, so they are versioned with the nodes and edges.
+-- Each canvas picks the environment whose values it uses.
+--
+ALTER TABLE public.workflow_versions ADD COLUMN variables jsonb DEFAULT '[]'::jsonb NOT NULL;
+ALTER TABLE public.workflow_versions ADD COLUMN environments jsonb DEFAULT '[]'::jsonb NOT NULL;
+
+ALTER TABLE public.workflows ADD COLUMN environment character varying(128) DEFAULT ''::character varying NOT NULL;
+
+COMMIT;
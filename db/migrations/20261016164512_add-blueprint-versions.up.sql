@@ -0,0 +1,53 @@
+BEGIN;
+
+--
+-- The version of the blueprint content stored in the blueprints table,
+-- which is always the latest published one.
+--
+ALTER TABLE public.blueprints
+  ADD COLUMN IF NOT EXISTS version integer DEFAULT 0 NOT NULL;
+
+--
+-- Published versions are immutable snapshots of the blueprint content,
+-- numbered per blueprint. Each blueprint also has at most one draft,
+-- with version 0, where updates are saved until they are published.
+--
+CREATE TABLE IF NOT EXISTS public.blueprint_versions (
+  id uuid DEFAULT gen_random_uuid() NOT NULL,
+  blueprint_id uuid NOT NULL,
+  version integer DEFAULT 0 NOT NULL,
+  owner_id uuid,
+  is_published boolean DEFAULT false NOT NULL,
+  published_at timestamp without time zone,
+  nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+  edges jsonb DEFAULT '[]'::jsonb NOT NULL,
+  configuration jsonb DEFAULT '[]'::jsonb NOT NULL,
+  output_channels jsonb DEFAULT '[]'::jsonb NOT NULL,
+  created_at timestamp without time zone NOT NULL,
+  updated_at timestamp without time zone NOT NULL,
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_blueprint_versions_blueprint_id_version
+  ON public.blueprint_versions USING btree (blueprint_id, version) WHERE is_published;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uix_blueprint_versions_blueprint_id_draft
+  ON public.blueprint_versions USING btree (blueprint_id) WHERE NOT is_published;
+
+ALTER TABLE public.blueprint_versions
+  ADD CONSTRAINT blueprint_versions_blueprint_id_fkey
+  FOREIGN KEY (blueprint_id) REFERENCES public.blueprints(id) ON DELETE CASCADE;
+
+--
+-- Existing blueprints become version 1 of themselves.
+--
+INSERT INTO public.blueprint_versions (
+  blueprint_id, version, owner_id, is_published, published_at,
+  nodes, edges, configuration, output_channels, created_at, updated_at
+)
+SELECT id, 1, created_by, true, updated_at, nodes, edges, configuration, output_channels, created_at, updated_at
+FROM public.blueprints;
+
+UPDATE public.blueprints SET version = 1;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+--
+-- SAML assertions already used to sign in, kept until they expire,
+-- so a captured response cannot be posted again to any of the API instances.
+--
+CREATE TABLE IF NOT EXISTS public.saml_used_assertions (
+  organization_id uuid NOT NULL,
+  assertion_id text NOT NULL,
+  expires_at timestamp without time zone NOT NULL,
+  created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+  PRIMARY KEY (organization_id, assertion_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_saml_used_assertions_expires_at
+  ON public.saml_used_assertions USING btree (expires_at);
+
+ALTER TABLE public.saml_used_assertions
+  ADD CONSTRAINT saml_used_assertions_organization_id_fkey
+  FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+COMMIT;
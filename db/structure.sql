@@ -150,6 +150,47 @@ CREATE TABLE public.app_installations (
 );
 
 
+--
+-- Name: audit_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_events (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    actor_id uuid,
+    resource_type character varying(64) NOT NULL,
+    resource_id character varying(255) DEFAULT ''::character varying NOT NULL,
+    action character varying(64) NOT NULL,
+    method character varying(255) DEFAULT ''::character varying NOT NULL,
+    status character varying(64) NOT NULL,
+    error text DEFAULT ''::text NOT NULL,
+    before jsonb DEFAULT '{}'::jsonb NOT NULL,
+    after jsonb DEFAULT '{}'::jsonb NOT NULL,
+    metadata jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: blueprint_versions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.blueprint_versions (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    blueprint_id uuid NOT NULL,
+    version integer DEFAULT 0 NOT NULL,
+    owner_id uuid,
+    is_published boolean DEFAULT false NOT NULL,
+    published_at timestamp without time zone,
+    nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
+    edges jsonb DEFAULT '[]'::jsonb NOT NULL,
+    configuration jsonb DEFAULT '[]'::jsonb NOT NULL,
+    output_channels jsonb DEFAULT '[]'::jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: blueprints; Type: TABLE; Schema: public; Owner: -
 --
@@ -167,7 +208,8 @@ CREATE TABLE public.blueprints (
     output_channels jsonb DEFAULT '[]'::jsonb NOT NULL,
     icon character varying(32),
     color character varying(32),
-    created_by uuid
+    created_by uuid,
+    version integer DEFAULT 0 NOT NULL
 );
 
 
@@ -181,7 +223,24 @@ CREATE TABLE public.canvas_memories (
     "values" jsonb NOT NULL,
     id uuid DEFAULT gen_random_uuid() NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    expires_at timestamp with time zone,
+    unique_key jsonb
+);
+
+
+--
+-- Name: canvas_memory_namespaces; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.canvas_memory_namespaces (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    canvas_id uuid NOT NULL,
+    namespace text NOT NULL,
+    default_ttl_seconds integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    schema jsonb
 );
 
 
@@ -250,6 +309,46 @@ CREATE TABLE public.email_settings (
 );
 
 
+--
+-- Name: event_sink_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.event_sink_deliveries (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    sink_id uuid NOT NULL,
+    event_type character varying(64) NOT NULL,
+    dedup_key character varying(255) NOT NULL,
+    payload jsonb DEFAULT '{}'::jsonb NOT NULL,
+    state character varying(32) NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_error text,
+    delivered_at timestamp without time zone,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
+--
+-- Name: event_sinks; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.event_sinks (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    type character varying(32) NOT NULL,
+    url text NOT NULL,
+    secret_ciphertext bytea,
+    categories jsonb DEFAULT '[]'::jsonb NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    last_delivered_at timestamp without time zone,
+    last_error text,
+    last_error_at timestamp without time zone,
+    updated_by uuid,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
 --
 -- Name: group_metadata; Type: TABLE; Schema: public; Owner: -
 --
@@ -330,6 +429,29 @@ CREATE TABLE public.organization_invite_links (
 );
 
 
+--
+-- Name: organization_saml_settings; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.organization_saml_settings (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    enabled boolean DEFAULT false NOT NULL,
+    enforced boolean DEFAULT false NOT NULL,
+    idp_metadata text NOT NULL,
+    idp_entity_id text NOT NULL,
+    idp_sso_url text NOT NULL,
+    idp_certificates jsonb DEFAULT '[]'::jsonb NOT NULL,
+    email_attribute character varying(255),
+    name_attribute character varying(255),
+    groups_attribute character varying(255),
+    group_mappings jsonb DEFAULT '[]'::jsonb NOT NULL,
+    updated_by uuid,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
 --
 -- Name: organizations; Type: TABLE; Schema: public; Owner: -
 --
@@ -346,6 +468,23 @@ CREATE TABLE public.organizations (
 );
 
 
+--
+-- Name: retention_policies; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.retention_policies (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    organization_id uuid NOT NULL,
+    workflow_id uuid,
+    max_age_days integer DEFAULT 0 NOT NULL,
+    max_executions_per_node integer DEFAULT 0 NOT NULL,
+    failed_max_age_days integer DEFAULT 0 NOT NULL,
+    updated_by uuid,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
 --
 -- Name: role_metadata; Type: TABLE; Schema: public; Owner: -
 --
@@ -362,6 +501,18 @@ CREATE TABLE public.role_metadata (
 );
 
 
+--
+-- Name: saml_used_assertions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.saml_used_assertions (
+    organization_id uuid NOT NULL,
+    assertion_id text NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -482,7 +633,10 @@ CREATE TABLE public.workflow_events (
     state character varying(32) NOT NULL,
     execution_id uuid,
     created_at timestamp without time zone NOT NULL,
-    custom_name text
+    custom_name text,
+    attempts integer DEFAULT 0 NOT NULL,
+    failure_reason text,
+    replay_of_id uuid
 );
 
 
@@ -501,6 +655,22 @@ CREATE TABLE public.workflow_node_execution_kvs (
 );
 
 
+--
+-- Name: workflow_node_execution_logs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workflow_node_execution_logs (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workflow_id uuid NOT NULL,
+    node_id character varying(128) NOT NULL,
+    execution_id uuid NOT NULL,
+    level character varying(16) NOT NULL,
+    message text NOT NULL,
+    fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: workflow_node_executions; Type: TABLE; Schema: public; Owner: -
 --
@@ -521,7 +691,11 @@ CREATE TABLE public.workflow_node_executions (
     configuration jsonb DEFAULT '{}'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
     updated_at timestamp without time zone NOT NULL,
-    cancelled_by uuid
+    cancelled_by uuid,
+    attempt integer DEFAULT 1 NOT NULL,
+    retry_of_execution_id uuid,
+    run_at timestamp without time zone,
+    rerun_of_execution_id uuid
 );
 
 
@@ -535,7 +709,11 @@ CREATE TABLE public.workflow_node_queue_items (
     node_id character varying(128) NOT NULL,
     root_event_id uuid,
     event_id uuid,
-    created_at timestamp without time zone NOT NULL
+    created_at timestamp without time zone NOT NULL,
+    state character varying(32) DEFAULT 'pending'::character varying NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    failure_reason text,
+    rerun_of_execution_id uuid
 );
 
 
@@ -578,7 +756,11 @@ CREATE TABLE public.workflow_nodes (
     parent_node_id character varying(128),
     deleted_at timestamp with time zone,
     app_installation_id uuid,
-    state_reason character varying(255) DEFAULT NULL::character varying
+    state_reason character varying(255) DEFAULT NULL::character varying,
+    retry_policy jsonb,
+    timeout_seconds integer DEFAULT 0 NOT NULL,
+    max_concurrency integer DEFAULT 1 NOT NULL,
+    queue_policy character varying(32) DEFAULT 'fifo'::character varying NOT NULL
 );
 
 
@@ -608,7 +790,9 @@ CREATE TABLE public.workflow_versions (
     nodes jsonb DEFAULT '[]'::jsonb NOT NULL,
     edges jsonb DEFAULT '[]'::jsonb NOT NULL,
     created_at timestamp without time zone NOT NULL,
-    updated_at timestamp without time zone NOT NULL
+    updated_at timestamp without time zone NOT NULL,
+    variables jsonb DEFAULT '[]'::jsonb NOT NULL,
+    environments jsonb DEFAULT '[]'::jsonb NOT NULL
 );
 
 
@@ -628,7 +812,8 @@ CREATE TABLE public.workflows (
     is_template boolean DEFAULT false NOT NULL,
     live_version_id uuid NOT NULL,
     canvas_versioning_enabled boolean DEFAULT false NOT NULL,
-    change_request_approvers jsonb DEFAULT '[{"type": "anyone"}]'::jsonb NOT NULL
+    change_request_approvers jsonb DEFAULT '[{"type": "anyone"}]'::jsonb NOT NULL,
+    environment character varying(128) DEFAULT ''::character varying NOT NULL
 );
 
 
@@ -735,6 +920,22 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: audit_events audit_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: blueprint_versions blueprint_versions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.blueprint_versions
+    ADD CONSTRAINT blueprint_versions_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: blueprints blueprints_organization_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -759,6 +960,22 @@ ALTER TABLE ONLY public.canvas_memories
     ADD CONSTRAINT canvas_memories_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_canvas_id_namespace_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_canvas_id_namespace_key UNIQUE (canvas_id, namespace);
+
+
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: casbin_rule casbin_rule_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -791,6 +1008,22 @@ ALTER TABLE ONLY public.email_settings
     ADD CONSTRAINT email_settings_provider_key UNIQUE (provider);
 
 
+--
+-- Name: event_sink_deliveries event_sink_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_sink_deliveries
+    ADD CONSTRAINT event_sink_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: event_sinks event_sinks_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_sinks
+    ADD CONSTRAINT event_sinks_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: group_metadata group_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -855,6 +1088,14 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_token_key UNIQUE (token);
 
 
+--
+-- Name: organization_saml_settings organization_saml_settings_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_saml_settings
+    ADD CONSTRAINT organization_saml_settings_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: organizations organizations_name_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -871,6 +1112,14 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: retention_policies retention_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: role_metadata role_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -879,6 +1128,14 @@ ALTER TABLE ONLY public.role_metadata
     ADD CONSTRAINT role_metadata_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: saml_used_assertions saml_used_assertions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.saml_used_assertions
+    ADD CONSTRAINT saml_used_assertions_pkey PRIMARY KEY (organization_id, assertion_id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -975,6 +1232,14 @@ ALTER TABLE ONLY public.workflow_node_execution_kvs
     ADD CONSTRAINT workflow_node_execution_kvs_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workflow_node_execution_logs workflow_node_execution_logs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_execution_logs
+    ADD CONSTRAINT workflow_node_execution_logs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: workflow_node_requests workflow_node_execution_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1138,6 +1403,27 @@ CREATE UNIQUE INDEX idx_app_installations_org_name_unique ON public.app_installa
 CREATE INDEX idx_app_installations_organization_id ON public.app_installations USING btree (organization_id);
 
 
+--
+-- Name: idx_audit_events_actor_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_actor_id ON public.audit_events USING btree (organization_id, actor_id);
+
+
+--
+-- Name: idx_audit_events_organization_id_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_organization_id_created_at ON public.audit_events USING btree (organization_id, created_at DESC);
+
+
+--
+-- Name: idx_audit_events_resource; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_events_resource ON public.audit_events USING btree (organization_id, resource_type, resource_id);
+
+
 --
 -- Name: idx_blueprints_organization_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1152,6 +1438,13 @@ CREATE INDEX idx_blueprints_organization_id ON public.blueprints USING btree (or
 CREATE INDEX idx_canvas_memories_canvas_namespace ON public.canvas_memories USING btree (canvas_id, namespace);
 
 
+--
+-- Name: idx_canvas_memories_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_canvas_memories_expires_at ON public.canvas_memories USING btree (expires_at) WHERE (expires_at IS NOT NULL);
+
+
 --
 -- Name: idx_casbin_rule_ptype; Type: INDEX; Schema: public; Owner: -
 --
@@ -1180,6 +1473,13 @@ CREATE INDEX idx_casbin_rule_v1 ON public.casbin_rule USING btree (v1);
 CREATE INDEX idx_casbin_rule_v2 ON public.casbin_rule USING btree (v2);
 
 
+--
+-- Name: idx_event_sink_deliveries_state_next_attempt_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_event_sink_deliveries_state_next_attempt_at ON public.event_sink_deliveries USING btree (state, next_attempt_at);
+
+
 --
 -- Name: idx_group_metadata_lookup; Type: INDEX; Schema: public; Owner: -
 --
@@ -1215,6 +1515,13 @@ CREATE INDEX idx_organizations_deleted_at ON public.organizations USING btree (d
 CREATE INDEX idx_role_metadata_lookup ON public.role_metadata USING btree (role_name, domain_type, domain_id);
 
 
+--
+-- Name: idx_saml_used_assertions_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_saml_used_assertions_expires_at ON public.saml_used_assertions USING btree (expires_at);
+
+
 --
 -- Name: idx_webhooks_app_installation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1271,6 +1578,13 @@ CREATE INDEX idx_workflow_change_requests_status ON public.workflow_change_reque
 CREATE INDEX idx_workflow_change_requests_workflow_id ON public.workflow_change_requests USING btree (workflow_id);
 
 
+--
+-- Name: idx_workflow_events_dead_letter; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_events_dead_letter ON public.workflow_events USING btree (workflow_id, created_at DESC) WHERE ((state)::text = 'dead_letter'::text);
+
+
 --
 -- Name: idx_workflow_events_execution_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1306,6 +1620,20 @@ CREATE INDEX idx_workflow_node_execution_kvs_ekv ON public.workflow_node_executi
 CREATE INDEX idx_workflow_node_execution_kvs_workflow_node_key_value ON public.workflow_node_execution_kvs USING btree (workflow_id, node_id, key, value);
 
 
+--
+-- Name: idx_workflow_node_execution_logs_execution_id_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_execution_id_created_at ON public.workflow_node_execution_logs USING btree (execution_id, created_at);
+
+
+--
+-- Name: idx_workflow_node_execution_logs_workflow_node; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_execution_logs_workflow_node ON public.workflow_node_execution_logs USING btree (workflow_id, node_id);
+
+
 --
 -- Name: idx_workflow_node_executions_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1334,6 +1662,27 @@ CREATE INDEX idx_workflow_node_executions_parent_state ON public.workflow_node_e
 CREATE INDEX idx_workflow_node_executions_previous_execution_id ON public.workflow_node_executions USING btree (previous_execution_id);
 
 
+--
+-- Name: idx_workflow_node_executions_rerun_of_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_rerun_of_execution_id ON public.workflow_node_executions USING btree (rerun_of_execution_id);
+
+
+--
+-- Name: idx_workflow_node_executions_retention; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_retention ON public.workflow_node_executions USING btree (workflow_id, node_id, created_at) WHERE (parent_execution_id IS NULL);
+
+
+--
+-- Name: idx_workflow_node_executions_retry_of_execution_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workflow_node_executions_retry_of_execution_id ON public.workflow_node_executions USING btree (retry_of_execution_id);
+
+
 --
 -- Name: idx_workflow_node_executions_root_event_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -1453,6 +1802,62 @@ CREATE INDEX idx_workflows_live_version_id ON public.workflows USING btree (live
 CREATE INDEX idx_workflows_organization_id ON public.workflows USING btree (organization_id);
 
 
+--
+-- Name: uix_blueprint_versions_blueprint_id_draft; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_blueprint_versions_blueprint_id_draft ON public.blueprint_versions USING btree (blueprint_id) WHERE (NOT is_published);
+
+
+--
+-- Name: uix_blueprint_versions_blueprint_id_version; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_blueprint_versions_blueprint_id_version ON public.blueprint_versions USING btree (blueprint_id, version) WHERE is_published;
+
+
+--
+-- Name: uix_canvas_memories_unique_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_canvas_memories_unique_key ON public.canvas_memories USING btree (canvas_id, namespace, unique_key) WHERE (unique_key IS NOT NULL);
+
+
+--
+-- Name: uix_event_sink_deliveries_sink_id_dedup_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_event_sink_deliveries_sink_id_dedup_key ON public.event_sink_deliveries USING btree (sink_id, dedup_key);
+
+
+--
+-- Name: uix_event_sinks_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_event_sinks_organization_id ON public.event_sinks USING btree (organization_id);
+
+
+--
+-- Name: uix_organization_saml_settings_organization_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_organization_saml_settings_organization_id ON public.organization_saml_settings USING btree (organization_id);
+
+
+--
+-- Name: uix_retention_policies_organization_default; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_retention_policies_organization_default ON public.retention_policies USING btree (organization_id) WHERE (workflow_id IS NULL);
+
+
+--
+-- Name: uix_retention_policies_workflow_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_retention_policies_workflow_id ON public.retention_policies USING btree (workflow_id) WHERE (workflow_id IS NOT NULL);
+
+
 --
 -- Name: unique_human_user_in_organization; Type: INDEX; Schema: public; Owner: -
 --
@@ -1539,6 +1944,22 @@ ALTER TABLE ONLY public.app_installations
     ADD CONSTRAINT app_installations_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: audit_events audit_events_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_events
+    ADD CONSTRAINT audit_events_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: blueprint_versions blueprint_versions_blueprint_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.blueprint_versions
+    ADD CONSTRAINT blueprint_versions_blueprint_id_fkey FOREIGN KEY (blueprint_id) REFERENCES public.blueprints(id) ON DELETE CASCADE;
+
+
 --
 -- Name: canvas_memories canvas_memories_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1547,6 +1968,38 @@ ALTER TABLE ONLY public.canvas_memories
     ADD CONSTRAINT canvas_memories_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
 
 
+--
+-- Name: canvas_memory_namespaces canvas_memory_namespaces_canvas_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.canvas_memory_namespaces
+    ADD CONSTRAINT canvas_memory_namespaces_canvas_id_fkey FOREIGN KEY (canvas_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: event_sink_deliveries event_sink_deliveries_sink_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_sink_deliveries
+    ADD CONSTRAINT event_sink_deliveries_sink_id_fkey FOREIGN KEY (sink_id) REFERENCES public.event_sinks(id) ON DELETE CASCADE;
+
+
+--
+-- Name: event_sinks event_sinks_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_sinks
+    ADD CONSTRAINT event_sinks_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: event_sinks event_sinks_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.event_sinks
+    ADD CONSTRAINT event_sinks_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_node_execution_kvs fk_wnek_workflow; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1635,6 +2088,54 @@ ALTER TABLE ONLY public.organization_invite_links
     ADD CONSTRAINT organization_invite_links_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
 
 
+--
+-- Name: organization_saml_settings organization_saml_settings_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_saml_settings
+    ADD CONSTRAINT organization_saml_settings_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: organization_saml_settings organization_saml_settings_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.organization_saml_settings
+    ADD CONSTRAINT organization_saml_settings_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: retention_policies retention_policies_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
+--
+-- Name: retention_policies retention_policies_updated_by_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_updated_by_fkey FOREIGN KEY (updated_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: retention_policies retention_policies_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_policies
+    ADD CONSTRAINT retention_policies_workflow_id_fkey FOREIGN KEY (workflow_id) REFERENCES public.workflows(id) ON DELETE CASCADE;
+
+
+--
+-- Name: saml_used_assertions saml_used_assertions_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.saml_used_assertions
+    ADD CONSTRAINT saml_used_assertions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES public.organizations(id) ON DELETE CASCADE;
+
+
 --
 -- Name: users users_account_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1739,6 +2240,14 @@ ALTER TABLE ONLY public.workflow_events
     ADD CONSTRAINT workflow_events_execution_id_fkey FOREIGN KEY (execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE CASCADE;
 
 
+--
+-- Name: workflow_events workflow_events_replay_of_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_events
+    ADD CONSTRAINT workflow_events_replay_of_id_fkey FOREIGN KEY (replay_of_id) REFERENCES public.workflow_events(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_events workflow_events_workflow_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1779,6 +2288,22 @@ ALTER TABLE ONLY public.workflow_node_executions
     ADD CONSTRAINT workflow_node_executions_previous_execution_id_fkey FOREIGN KEY (previous_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
 
 
+--
+-- Name: workflow_node_executions workflow_node_executions_rerun_of_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_executions
+    ADD CONSTRAINT workflow_node_executions_rerun_of_execution_id_fkey FOREIGN KEY (rerun_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+
+--
+-- Name: workflow_node_executions workflow_node_executions_retry_of_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_executions
+    ADD CONSTRAINT workflow_node_executions_retry_of_execution_id_fkey FOREIGN KEY (retry_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_node_executions workflow_node_executions_root_event_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1803,6 +2328,14 @@ ALTER TABLE ONLY public.workflow_node_queue_items
     ADD CONSTRAINT workflow_node_queue_items_event_id_fkey FOREIGN KEY (event_id) REFERENCES public.workflow_events(id) ON DELETE SET NULL;
 
 
+--
+-- Name: workflow_node_queue_items workflow_node_queue_items_rerun_of_execution_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workflow_node_queue_items
+    ADD CONSTRAINT workflow_node_queue_items_rerun_of_execution_id_fkey FOREIGN KEY (rerun_of_execution_id) REFERENCES public.workflow_node_executions(id) ON DELETE SET NULL;
+
+
 --
 -- Name: workflow_node_queue_items workflow_node_queue_items_root_event_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1939,7 +2472,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20260310154909	f
+20261016170512	f
 \.
 
 
@@ -0,0 +1,187 @@
+package audit
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"gorm.io/datatypes"
+)
+
+const (
+	StatusOK = "OK"
+
+	ResourceExecutions = "executions"
+)
+
+type Event struct {
+	OrganizationID uuid.UUID
+	ActorID        string
+	ResourceType   string
+	ResourceID     string
+	Action         string
+	Method         string
+	Status         string
+	Error          string
+	Before         map[string]any
+	After          map[string]any
+}
+
+// Record writes an audit event, with the metadata of the request in ctx.
+// The change was already made when it is called, so errors are only logged.
+func Record(ctx context.Context, event Event) {
+	auditEvent := &models.AuditEvent{
+		OrganizationID: event.OrganizationID,
+		ResourceType:   event.ResourceType,
+		ResourceID:     event.ResourceID,
+		Action:         event.Action,
+		Method:         event.Method,
+		Status:         event.Status,
+		Error:          event.Error,
+		Before:         datatypes.NewJSONType(nonNil(event.Before)),
+		After:          datatypes.NewJSONType(nonNil(event.After)),
+		Metadata:       datatypes.NewJSONType(RequestMetadata(ctx)),
+	}
+
+	if actorID, err := uuid.Parse(event.ActorID); err == nil {
+		auditEvent.ActorID = &actorID
+	}
+
+	if auditEvent.Status == "" {
+		auditEvent.Status = StatusOK
+	}
+
+	if err := models.CreateAuditEvent(auditEvent); err != nil {
+		log.Errorf("failed to record audit event for %s %s in organization %s: %v", event.Action, event.ResourceType, event.OrganizationID, err)
+		return
+	}
+
+	if err := messages.NewAuditEventCreatedMessage(auditEvent).Publish(); err != nil {
+		log.Errorf("failed to publish audit event %s: %v", auditEvent.ID, err)
+	}
+}
+
+// RequestMetadata returns the client address, user agent
+// and request ID of the request, when they are known.
+func RequestMetadata(ctx context.Context) map[string]string {
+	result := map[string]string{}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if forwardedFor := first(md, "x-forwarded-for"); forwardedFor != "" {
+		result["ip"] = strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		result["ip"] = p.Addr.String()
+	}
+
+	if userAgent := first(md, "grpcgateway-user-agent", "user-agent"); userAgent != "" {
+		result["user_agent"] = userAgent
+	}
+
+	if requestID := first(md, "x-request-id"); requestID != "" {
+		result["request_id"] = requestID
+	}
+
+	return result
+}
+
+// CanvasSnapshot captures the fields of a canvas that changes are recorded for.
+// Node positions are left out, since moving nodes around does not change the canvas.
+func CanvasSnapshot(orgID uuid.UUID, canvasID uuid.UUID) map[string]any {
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	snapshot := map[string]any{
+		"name":                    canvas.Name,
+		"description":             canvas.Description,
+		"canvasVersioningEnabled": canvas.CanvasVersioningEnabled,
+	}
+
+	version, err := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+	if err == nil {
+		nodes := map[string]any{}
+		for _, node := range version.Nodes {
+			value, err := ToMap(node)
+			if err != nil {
+				continue
+			}
+
+			delete(value, "position")
+			delete(value, "isCollapsed")
+			delete(value, "metadata")
+			nodes[node.ID] = value
+		}
+
+		edges := []string{}
+		for _, edge := range version.Edges {
+			edges = append(edges, edge.SourceID+" -> "+edge.TargetID+" ("+edge.Channel+")")
+		}
+
+		sort.Strings(edges)
+		snapshot["nodes"] = nodes
+		snapshot["edges"] = edges
+	}
+
+	canvasNodes, err := models.FindCanvasNodes(canvas.ID)
+	if err == nil {
+		states := map[string]any{}
+		for _, node := range canvasNodes {
+			states[node.NodeID] = node.State
+		}
+
+		snapshot["nodeStates"] = states
+	}
+
+	return snapshot
+}
+
+// ExecutionSnapshot captures the state of an execution,
+// and the metadata its component keeps about it, like approvals.
+func ExecutionSnapshot(execution *models.CanvasNodeExecution) map[string]any {
+	snapshot := map[string]any{
+		"state":         execution.State,
+		"result":        execution.Result,
+		"resultReason":  execution.ResultReason,
+		"resultMessage": execution.ResultMessage,
+	}
+
+	//
+	// Components update the metadata map in place,
+	// so the snapshot needs its own copy of it.
+	//
+	if values, err := ToMap(execution.Metadata.Data()); err == nil {
+		snapshot["metadata"] = values
+	}
+
+	if execution.CancelledBy != nil {
+		snapshot["cancelledBy"] = execution.CancelledBy.String()
+	}
+
+	return snapshot
+}
+
+func first(md metadata.MD, keys ...string) string {
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+
+	return ""
+}
+
+func nonNil(value map[string]any) map[string]any {
+	if value == nil {
+		return map[string]any{}
+	}
+
+	return value
+}
@@ -0,0 +1,142 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const RedactedValue = "[REDACTED]"
+
+// Fields which can hold credentials or secret values,
+// compared without case, dashes and underscores.
+var sensitiveFields = map[string]bool{
+	"value":        true,
+	"values":       true,
+	"data":         true,
+	"token":        true,
+	"password":     true,
+	"secret":       true,
+	"secretid":     true,
+	"privatekey":   true,
+	"apikey":       true,
+	"clientsecret": true,
+}
+
+// Diff compares two snapshots of a resource, and returns only
+// the fields which changed, with their values before and after the change.
+// Nested objects are compared field by field, using dotted paths,
+// and lists are compared as a whole.
+func Diff(before, after map[string]any) (map[string]any, map[string]any) {
+	flatBefore := map[string]any{}
+	flatAfter := map[string]any{}
+	flatten("", before, flatBefore)
+	flatten("", after, flatAfter)
+
+	changedBefore := map[string]any{}
+	changedAfter := map[string]any{}
+	for path, value := range flatBefore {
+		other, ok := flatAfter[path]
+		if !ok || !reflect.DeepEqual(value, other) {
+			changedBefore[path] = value
+		}
+	}
+
+	for path, value := range flatAfter {
+		other, ok := flatBefore[path]
+		if !ok || !reflect.DeepEqual(value, other) {
+			changedAfter[path] = value
+		}
+	}
+
+	return changedBefore, changedAfter
+}
+
+// Redact replaces the values of sensitive fields, at any depth.
+func Redact(value map[string]any) map[string]any {
+	if value == nil {
+		return nil
+	}
+
+	result := make(map[string]any, len(value))
+	for key, v := range value {
+		if isSensitive(key) {
+			result[key] = RedactedValue
+			continue
+		}
+
+		result[key] = redactValue(v)
+	}
+
+	return result
+}
+
+// ToMap converts a value to its JSON representation as a map.
+func ToMap(value any) (map[string]any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ProtoToMap converts a request or response to its JSON representation as a map.
+func ProtoToMap(message proto.Message) map[string]any {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return map[string]any{}
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return map[string]any{}
+	}
+
+	return result
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return Redact(v)
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = redactValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	return sensitiveFields[normalized]
+}
+
+func flatten(prefix string, value map[string]any, result map[string]any) {
+	for key, v := range value {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		nested, ok := v.(map[string]any)
+		if ok && len(nested) > 0 {
+			flatten(path, nested, result)
+			continue
+		}
+
+		result[path] = v
+	}
+}
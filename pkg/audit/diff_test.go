@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__Diff(t *testing.T) {
+	t.Run("only changed fields are returned", func(t *testing.T) {
+		before, after := Diff(
+			map[string]any{"name": "a", "description": "same"},
+			map[string]any{"name": "b", "description": "same"},
+		)
+
+		assert.Equal(t, map[string]any{"name": "a"}, before)
+		assert.Equal(t, map[string]any{"name": "b"}, after)
+	})
+
+	t.Run("nested fields are compared using dotted paths", func(t *testing.T) {
+		before, after := Diff(
+			map[string]any{"nodes": map[string]any{"n1": map[string]any{"name": "a", "type": "noop"}}},
+			map[string]any{"nodes": map[string]any{"n1": map[string]any{"name": "b", "type": "noop"}, "n2": map[string]any{"name": "c"}}},
+		)
+
+		assert.Equal(t, map[string]any{"nodes.n1.name": "a"}, before)
+		assert.Equal(t, map[string]any{"nodes.n1.name": "b", "nodes.n2.name": "c"}, after)
+	})
+
+	t.Run("removed fields only appear before", func(t *testing.T) {
+		before, after := Diff(map[string]any{"name": "a", "edges": []any{"x"}}, map[string]any{"name": "a"})
+		assert.Equal(t, map[string]any{"edges": []any{"x"}}, before)
+		assert.Empty(t, after)
+	})
+
+	t.Run("same snapshots have no changes", func(t *testing.T) {
+		before, after := Diff(map[string]any{"name": "a"}, map[string]any{"name": "a"})
+		assert.Empty(t, before)
+		assert.Empty(t, after)
+	})
+}
+
+func Test__Redact(t *testing.T) {
+	redacted := Redact(map[string]any{
+		"name": "my-secret",
+		"spec": map[string]any{
+			"local": map[string]any{
+				"data": map[string]any{"KEY": "value"},
+			},
+			"items": []any{map[string]any{"api_key": "123", "label": "a"}},
+		},
+	})
+
+	assert.Equal(t, "my-secret", redacted["name"])
+	spec := redacted["spec"].(map[string]any)
+	assert.Equal(t, RedactedValue, spec["local"].(map[string]any)["data"])
+	item := spec["items"].([]any)[0].(map[string]any)
+	assert.Equal(t, RedactedValue, item["api_key"])
+	assert.Equal(t, "a", item["label"])
+}
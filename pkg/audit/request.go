@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbOrganization "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestSnapshot returns a request as recorded in audit events.
+// Integration configurations are redacted using the schema of the integration,
+// and dropped when the schema cannot be found. Other fields are redacted by name.
+func RequestSnapshot(registry *registry.Registry, orgID uuid.UUID, message proto.Message) map[string]any {
+	snapshot := ProtoToMap(message)
+
+	if _, ok := snapshot["configuration"]; ok {
+		switch r := message.(type) {
+		case *pbOrganization.CreateIntegrationRequest:
+			snapshot["configuration"] = redactIntegrationConfiguration(registry, r.IntegrationName, snapshot["configuration"])
+
+		case *pbOrganization.UpdateIntegrationRequest:
+			snapshot["configuration"] = RedactedValue
+			if integrationID, err := uuid.Parse(r.IntegrationId); err == nil {
+				if integration, err := models.FindIntegration(orgID, integrationID); err == nil {
+					snapshot["configuration"] = redactIntegrationConfiguration(registry, integration.AppName, snapshot["configuration"])
+				}
+			}
+		}
+	}
+
+	return Redact(snapshot)
+}
+
+func redactIntegrationConfiguration(registry *registry.Registry, integrationName string, value any) any {
+	config, ok := value.(map[string]any)
+	if !ok || registry == nil {
+		return RedactedValue
+	}
+
+	integration, err := registry.GetIntegration(integrationName)
+	if err != nil {
+		return RedactedValue
+	}
+
+	return RedactConfiguration(config, integration.Configuration())
+}
+
+// RedactConfiguration replaces the values of the fields
+// marked as sensitive in the schema of a configuration.
+func RedactConfiguration(config map[string]any, fields []configuration.Field) map[string]any {
+	result := make(map[string]any, len(config))
+	for key, value := range config {
+		result[key] = value
+	}
+
+	for _, field := range fields {
+		value, ok := config[field.Name]
+		if !ok {
+			continue
+		}
+
+		if field.Sensitive {
+			result[field.Name] = RedactedValue
+			continue
+		}
+
+		result[field.Name] = redactFieldValue(field.Type, field.TypeOptions, value)
+	}
+
+	return result
+}
+
+func redactFieldValue(fieldType string, options *configuration.TypeOptions, value any) any {
+	if options == nil {
+		return value
+	}
+
+	switch fieldType {
+	case configuration.FieldTypeObject:
+		object, ok := value.(map[string]any)
+		if !ok || options.Object == nil {
+			return value
+		}
+
+		return RedactConfiguration(object, options.Object.Schema)
+
+	case configuration.FieldTypeList:
+		items, ok := value.([]any)
+		if !ok || options.List == nil || options.List.ItemDefinition == nil {
+			return value
+		}
+
+		definition := options.List.ItemDefinition
+		itemOptions := &configuration.TypeOptions{Object: &configuration.ObjectTypeOptions{Schema: definition.Schema}}
+		result := make([]any, len(items))
+		for i, item := range items {
+			result[i] = redactFieldValue(definition.Type, itemOptions, item)
+		}
+
+		return result
+	}
+
+	return value
+}
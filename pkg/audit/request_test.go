@@ -0,0 +1,73 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/audit"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test__RequestSnapshot(t *testing.T) {
+	r := support.Setup(t)
+
+	configuration, err := structpb.NewStruct(map[string]any{
+		"organizationUrl": "https://example.semaphoreci.com",
+		"apiToken":        "smph-token",
+	})
+	require.NoError(t, err)
+
+	t.Run("sensitive fields of integration configuration are redacted", func(t *testing.T) {
+		snapshot := audit.RequestSnapshot(r.Registry, r.Organization.ID, &pb.CreateIntegrationRequest{
+			Id:              r.Organization.ID.String(),
+			Name:            "Semaphore",
+			IntegrationName: "semaphore",
+			Configuration:   configuration,
+		})
+
+		assert.Equal(t, "semaphore", snapshot["integrationName"])
+		assert.Equal(t, map[string]any{
+			"organizationUrl": "https://example.semaphoreci.com",
+			"apiToken":        audit.RedactedValue,
+		}, snapshot["configuration"])
+	})
+
+	t.Run("configuration of integration updates is redacted", func(t *testing.T) {
+		integration, err := models.CreateIntegration(uuid.New(), r.Organization.ID, "semaphore", "Semaphore", map[string]any{})
+		require.NoError(t, err)
+
+		snapshot := audit.RequestSnapshot(r.Registry, r.Organization.ID, &pb.UpdateIntegrationRequest{
+			Id:            r.Organization.ID.String(),
+			IntegrationId: integration.ID.String(),
+			Configuration: configuration,
+		})
+
+		assert.Equal(t, map[string]any{
+			"organizationUrl": "https://example.semaphoreci.com",
+			"apiToken":        audit.RedactedValue,
+		}, snapshot["configuration"])
+	})
+
+	t.Run("configuration of unknown integration is dropped", func(t *testing.T) {
+		snapshot := audit.RequestSnapshot(r.Registry, r.Organization.ID, &pb.CreateIntegrationRequest{
+			Id:              r.Organization.ID.String(),
+			IntegrationName: "unknown",
+			Configuration:   configuration,
+		})
+
+		assert.Equal(t, audit.RedactedValue, snapshot["configuration"])
+
+		snapshot = audit.RequestSnapshot(r.Registry, r.Organization.ID, &pb.UpdateIntegrationRequest{
+			Id:            r.Organization.ID.String(),
+			IntegrationId: uuid.NewString(),
+			Configuration: configuration,
+		})
+
+		assert.Equal(t, audit.RedactedValue, snapshot["configuration"])
+	})
+}
@@ -30,6 +30,8 @@ import (
 const SignupDisabledError = "signup is currently disabled"
 
 type Handler struct {
+	baseURL              string
+	samlSigner           *jwt.Signer
 	jwtSigner            *jwt.Signer
 	authService          authorization.Authorization
 	encryptor            crypto.Encryptor
@@ -93,6 +95,12 @@ func (a *Handler) RegisterRoutes(router *mux.Router) {
 		router.HandleFunc("/signup", a.handlePasswordSignup).Methods("POST")
 	}
 
+	//
+	// SAML routes are registered before the OAuth ones,
+	// since /auth/{provider} would match them too.
+	//
+	a.registerSAMLRoutes(router)
+
 	//
 	// If we are running the application locally,
 	// we provide handlers that auto-autenticate to
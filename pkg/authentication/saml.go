@@ -0,0 +1,479 @@
+package authentication
+
+import (
+	"errors"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authentication/saml"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const (
+	SSOLoginURLHeader = "X-SSO-Login-URL"
+
+	samlRequestPurpose  = "saml_request"
+	samlRequestDuration = 10 * time.Minute
+	samlLoginPurpose    = "saml_login"
+	samlLoginDuration   = time.Minute
+	samlLoginCookie     = "saml_login"
+)
+
+func SAMLLoginPath(organizationID string) string {
+	return "/auth/saml/" + organizationID
+}
+
+// SAMLEntityID is the entity ID of an organization, as a service provider.
+// It is also the URL of its service provider metadata.
+func SAMLEntityID(baseURL, organizationID string) string {
+	return strings.TrimRight(baseURL, "/") + SAMLLoginPath(organizationID) + "/metadata"
+}
+
+func SAMLACSURL(baseURL, organizationID string) string {
+	return strings.TrimRight(baseURL, "/") + SAMLLoginPath(organizationID) + "/acs"
+}
+
+// InitializeSAML enables the SAML single sign-on routes.
+// The base URL is where identity providers send people back to.
+// The tokens used during the sign-in are signed with their own key,
+// so they can never be mistaken for a session token, or the other way around.
+func (a *Handler) InitializeSAML(baseURL string) {
+	a.baseURL = baseURL
+	a.samlSigner = a.jwtSigner.Derive("saml")
+}
+
+func (a *Handler) registerSAMLRoutes(router *mux.Router) {
+	if a.samlSigner == nil {
+		return
+	}
+
+	router.HandleFunc("/auth/saml/{organizationId}/metadata", a.handleSAMLMetadata).Methods("GET")
+	router.HandleFunc("/auth/saml/{organizationId}/acs", a.handleSAMLACS).Methods("POST")
+	router.HandleFunc("/auth/saml/{organizationId}/complete", a.handleSAMLComplete).Methods("GET")
+	router.HandleFunc("/auth/saml/{organizationId}", a.handleSAMLLogin).Methods("GET")
+}
+
+func (a *Handler) handleSAMLMetadata(w http.ResponseWriter, r *http.Request) {
+	organizationID := mux.Vars(r)["organizationId"]
+	if _, err := uuid.Parse(organizationID); err != nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	metadata := saml.ServiceProviderMetadata(SAMLEntityID(a.baseURL, organizationID), SAMLACSURL(a.baseURL, organizationID))
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, _ = w.Write(metadata)
+}
+
+// handleSAMLLogin sends the person to the identity provider of the organization.
+// The request ID and the page to go back to travel in the RelayState,
+// signed, since the response is posted back by the IdP from another site,
+// where the browser does not send our cookies.
+func (a *Handler) handleSAMLLogin(w http.ResponseWriter, r *http.Request) {
+	organizationID := mux.Vars(r)["organizationId"]
+	settings, sp, err := a.findSAMLServiceProvider(organizationID)
+	if err != nil {
+		http.Error(w, "SAML single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	requestID, err := saml.NewRequestID()
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	relayState, err := a.samlSigner.GenerateWithClaims(settings.OrganizationID.String(), samlRequestDuration, map[string]any{
+		"purpose":    samlRequestPurpose,
+		"request_id": requestID,
+		"redirect":   getRedirectURL(r),
+	})
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	requestURL, err := sp.AuthnRequestURL(requestID, relayState)
+	if err != nil {
+		log.Errorf("Error building SAML request for organization %s: %v", organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, requestURL, http.StatusFound)
+}
+
+func (a *Handler) handleSAMLACS(w http.ResponseWriter, r *http.Request) {
+	organizationID := mux.Vars(r)["organizationId"]
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := a.samlSigner.ValidateAndGetClaims(r.PostForm.Get("RelayState"))
+	if err != nil || claims["purpose"] != samlRequestPurpose || claims["sub"] != organizationID {
+		http.Error(w, "SAML sign-in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	requestID, _ := claims["request_id"].(string)
+	redirectURL, _ := claims["redirect"].(string)
+	if !isValidRedirectURL(redirectURL) {
+		redirectURL = "/"
+	}
+
+	settings, sp, err := a.findSAMLServiceProvider(organizationID)
+	if err != nil {
+		http.Error(w, "SAML single sign-on is not configured", http.StatusNotFound)
+		return
+	}
+
+	assertion, err := sp.ParseResponse(r.PostForm.Get("SAMLResponse"), requestID)
+	if err != nil {
+		log.Warnf("Invalid SAML response for organization %s: %v", organizationID, err)
+		http.Error(w, "SAML authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	fresh, err := models.UseSAMLAssertion(settings.OrganizationID, assertion.ID, assertion.NotOnOrAfter.Add(saml.DefaultClockSkew), time.Now())
+	if err != nil {
+		log.Errorf("Error recording SAML assertion %s for organization %s: %v", assertion.ID, organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !fresh {
+		log.Warnf("SAML assertion %s for organization %s was already used", assertion.ID, organizationID)
+		http.Error(w, "SAML authentication failed", http.StatusUnauthorized)
+		return
+	}
+
+	email, name, err := samlIdentity(settings, assertion)
+	if err != nil {
+		log.Warnf("Invalid SAML identity for organization %s: %v", organizationID, err)
+		http.Error(w, "SAML authentication failed: "+err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	account, user, err := a.provisionSAMLUser(settings.OrganizationID, email, name)
+	if errors.Is(err, errSAMLAccountNotLinked) {
+		log.Warnf("SAML user %s is not a member of organization %s", email, organizationID)
+		http.Error(w, "SAML authentication failed: "+err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if err != nil {
+		log.Errorf("Error provisioning SAML user %s in organization %s: %v", email, organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if settings.GroupsAttribute != nil && *settings.GroupsAttribute != "" {
+		err = a.syncSAMLGroups(settings, user, assertion.Attributes[*settings.GroupsAttribute])
+		if err != nil {
+			log.Errorf("Error syncing SAML groups of user %s in organization %s: %v", user.ID, organizationID, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	//
+	// The browser does not send the account cookie with the response posted by the IdP,
+	// so the session is completed after a redirect, where the cookie is sent,
+	// in order to add the organization to the session instead of replacing it.
+	// The login token goes along in a short-lived cookie, kept out of URLs and logs.
+	//
+	loginToken, err := a.samlSigner.GenerateWithClaims(account.ID.String(), samlLoginDuration, map[string]any{
+		"purpose":         samlLoginPurpose,
+		"organization_id": organizationID,
+		"assertion_id":    assertion.ID,
+		"redirect":        redirectURL,
+	})
+
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlLoginCookie,
+		Value:    loginToken,
+		Path:     SAMLLoginPath(organizationID) + "/complete",
+		MaxAge:   int(samlLoginDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, SAMLLoginPath(organizationID)+"/complete", http.StatusSeeOther)
+}
+
+func (a *Handler) handleSAMLComplete(w http.ResponseWriter, r *http.Request) {
+	organizationID := mux.Vars(r)["organizationId"]
+	cookie, err := r.Cookie(samlLoginCookie)
+	if err != nil {
+		http.Error(w, "SAML sign-in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     samlLoginCookie,
+		Path:     SAMLLoginPath(organizationID) + "/complete",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	claims, err := a.samlSigner.ValidateAndGetClaims(cookie.Value)
+	if err != nil || claims["purpose"] != samlLoginPurpose || claims["organization_id"] != organizationID {
+		http.Error(w, "SAML sign-in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	accountID, _ := claims["sub"].(string)
+	assertionID, _ := claims["assertion_id"].(string)
+	redirectURL, _ := claims["redirect"].(string)
+	if !isValidRedirectURL(redirectURL) {
+		redirectURL = "/"
+	}
+
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		http.Error(w, "SAML sign-in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	fresh, err := models.UseSAMLAssertion(orgID, "complete:"+assertionID, time.Now().Add(samlLoginDuration), time.Now())
+	if err != nil {
+		log.Errorf("Error recording SAML sign-in %s for organization %s: %v", assertionID, organizationID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !fresh {
+		http.Error(w, "SAML sign-in expired, please try again", http.StatusBadRequest)
+		return
+	}
+
+	session := a.samlSession(r, accountID, organizationID)
+	token, err := a.jwtSigner.GenerateWithClaims(accountID, 24*time.Hour, session.claims())
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "account_token",
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(24 * time.Hour.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+}
+
+func (a *Handler) findSAMLServiceProvider(organizationID string) (*models.OrganizationSAMLSettings, *saml.ServiceProvider, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := models.FindOrganizationSAMLSettings(orgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !settings.Enabled {
+		return nil, nil, errors.New("SAML single sign-on is disabled")
+	}
+
+	idp := saml.IdentityProvider{
+		EntityID:     settings.IdpEntityID,
+		SSOURL:       settings.IdpSSOURL,
+		Certificates: settings.IdpCertificates,
+	}
+
+	certificates, err := idp.X509Certificates()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return settings, &saml.ServiceProvider{
+		EntityID:        SAMLEntityID(a.baseURL, organizationID),
+		ACSURL:          SAMLACSURL(a.baseURL, organizationID),
+		IDPEntityID:     idp.EntityID,
+		IDPSSOURL:       idp.SSOURL,
+		IDPCertificates: certificates,
+	}, nil
+}
+
+// samlIdentity reads the email and name of the person from the mapped attributes.
+// Without an email attribute, the NameID is used as the email.
+func samlIdentity(settings *models.OrganizationSAMLSettings, assertion *saml.Assertion) (string, string, error) {
+	email := assertion.NameID
+	if settings.EmailAttribute != nil && *settings.EmailAttribute != "" {
+		email = assertion.Attribute(*settings.EmailAttribute)
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return "", "", errors.New("the identity provider did not send an email address")
+	}
+
+	email = utils.NormalizeEmail(email)
+	name := ""
+	if settings.NameAttribute != nil && *settings.NameAttribute != "" {
+		name = strings.TrimSpace(assertion.Attribute(*settings.NameAttribute))
+	}
+
+	if name == "" {
+		name = email
+	}
+
+	return email, name, nil
+}
+
+// errSAMLAccountNotLinked is returned when the identity provider asserts the email
+// of an account which has nothing to do with the organization.
+var errSAMLAccountNotLinked = errors.New("the account is not a member of the organization")
+
+// provisionSAMLUser finds or creates the account and the organization user
+// for a person authenticated by the identity provider of the organization.
+// New people join with the viewer role, the same way accepting an invitation does,
+// and people removed from the organization are restored.
+//
+// The identity provider is managed by the organization, so it can assert any email.
+// An existing account is only used when it is, or was, a member of the organization,
+// or when it was invited to it. Otherwise, the organization could sign in as anyone.
+func (a *Handler) provisionSAMLUser(organizationID uuid.UUID, email, name string) (*models.Account, *models.User, error) {
+	var account *models.Account
+	var user *models.User
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		user, err = models.FindMaybeDeletedUserByEmailInTransaction(tx, organizationID.String(), email)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		isMember := err == nil
+		invitation, err := models.FindPendingInvitationInTransaction(tx, email, organizationID.String())
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		isInvited := err == nil
+		account, err = models.FindAccountByEmailInTransaction(tx, email)
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			account, err = models.CreateAccountInTransaction(tx, name, email)
+		case err == nil && !isMember && !isInvited:
+			return errSAMLAccountNotLinked
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if isInvited {
+			invitation.State = models.InvitationStateAccepted
+			invitation.UpdatedAt = time.Now()
+			if err := tx.Save(invitation).Error; err != nil {
+				return err
+			}
+		}
+
+		if !isMember {
+			user, err = models.CreateUserInTransaction(tx, organizationID, account.ID, email, name)
+			if err != nil {
+				return err
+			}
+
+			return a.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, organizationID.String(), models.DomainTypeOrganization)
+		}
+
+		if user.DeletedAt.Valid {
+			if err := user.RestoreInTransaction(tx); err != nil {
+				return err
+			}
+
+			user.DeletedAt = gorm.DeletedAt{}
+			return a.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, organizationID.String(), models.DomainTypeOrganization)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, user, nil
+}
+
+// syncSAMLGroups adds the user to the groups mapped from their IdP groups,
+// and removes them from the other mapped groups.
+// Groups without a mapping are managed in the application, so they are left alone.
+func (a *Handler) syncSAMLGroups(settings *models.OrganizationSAMLSettings, user *models.User, idpGroups []string) error {
+	organizationID := settings.OrganizationID.String()
+	userID := user.ID.String()
+	groups := settings.MappedGroups(idpGroups)
+
+	for _, group := range settings.ManagedGroups() {
+		members, err := a.authService.GetGroupUsers(organizationID, models.DomainTypeOrganization, group)
+		if err != nil {
+			return err
+		}
+
+		isMember := slices.Contains(members, userID)
+		shouldBeMember := slices.Contains(groups, group)
+
+		switch {
+		case shouldBeMember && !isMember:
+			err = a.authService.AddUserToGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		case !shouldBeMember && isMember:
+			err = a.authService.RemoveUserFromGroup(organizationID, models.DomainTypeOrganization, userID, group)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// samlSession adds the organization to the session of the account, when there is one.
+// Otherwise, a new session is started, limited to the organization,
+// since the identity provider of one organization cannot vouch for the person elsewhere.
+func (a *Handler) samlSession(r *http.Request, accountID, organizationID string) *Session {
+	if cookie, err := r.Cookie("account_token"); err == nil {
+		session, err := ParseSession(a.jwtSigner, cookie.Value)
+		if err == nil && session.AccountID == accountID {
+			if !session.SignedInWithSSO(organizationID) {
+				session.SSOOrganizations = append(session.SSOOrganizations, organizationID)
+			}
+
+			return session
+		}
+	}
+
+	return &Session{
+		AccountID:        accountID,
+		SSOOrganizations: []string{organizationID},
+		SSOOnly:          true,
+	}
+}
@@ -0,0 +1,144 @@
+package saml
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Canonicalize returns the exclusive XML canonicalization (without comments)
+// of an element, as defined in https://www.w3.org/TR/xml-exc-c14n/.
+// The excluded element is left out of the output, which is how
+// the enveloped signature transform removes the signature from the signed element.
+// The inclusive prefixes are the ones listed in the InclusiveNamespaces PrefixList,
+// where "#default" stands for the default namespace.
+func Canonicalize(element *Element, excluded *Element, inclusivePrefixes []string) []byte {
+	var buf bytes.Buffer
+	writeCanonical(&buf, element, excluded, inclusivePrefixes, map[string]string{})
+	return buf.Bytes()
+}
+
+func writeCanonical(buf *bytes.Buffer, element *Element, excluded *Element, inclusivePrefixes []string, rendered map[string]string) {
+	buf.WriteByte('<')
+	buf.WriteString(qualifiedName(element.Prefix, element.Local))
+
+	//
+	// Only the namespaces used by the element and its attributes are rendered,
+	// unless they were already rendered with the same value by an ancestor.
+	//
+	utilized := []string{element.Prefix}
+	for _, attr := range element.Attrs {
+		if attr.Prefix != "" && !slices.Contains(utilized, attr.Prefix) {
+			utilized = append(utilized, attr.Prefix)
+		}
+	}
+
+	for _, prefix := range inclusivePrefixes {
+		if prefix == "#default" {
+			prefix = ""
+		}
+
+		if !slices.Contains(utilized, prefix) && (prefix == "" || element.LookupNamespace(prefix) != "") {
+			utilized = append(utilized, prefix)
+		}
+	}
+
+	sort.Strings(utilized)
+
+	scope := make(map[string]string, len(rendered)+len(utilized))
+	for prefix, uri := range rendered {
+		scope[prefix] = uri
+	}
+
+	for _, prefix := range utilized {
+		if prefix == "xml" {
+			continue
+		}
+
+		uri := element.LookupNamespace(prefix)
+		previous, wasRendered := rendered[prefix]
+		if prefix == "" && uri == "" && (!wasRendered || previous == "") {
+			continue
+		}
+
+		if wasRendered && previous == uri {
+			continue
+		}
+
+		if prefix == "" {
+			buf.WriteString(` xmlns="`)
+		} else {
+			buf.WriteString(" xmlns:" + prefix + `="`)
+		}
+
+		buf.WriteString(escapeAttr(uri))
+		buf.WriteByte('"')
+		scope[prefix] = uri
+	}
+
+	attrs := slices.Clone(element.Attrs)
+	sort.SliceStable(attrs, func(i, j int) bool {
+		si, sj := element.LookupNamespace(attrs[i].Prefix), element.LookupNamespace(attrs[j].Prefix)
+		if attrs[i].Prefix == "" {
+			si = ""
+		}
+
+		if attrs[j].Prefix == "" {
+			sj = ""
+		}
+
+		if si != sj {
+			return si < sj
+		}
+
+		return attrs[i].Local < attrs[j].Local
+	})
+
+	for _, attr := range attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(qualifiedName(attr.Prefix, attr.Local))
+		buf.WriteString(`="`)
+		buf.WriteString(escapeAttr(attr.Value))
+		buf.WriteByte('"')
+	}
+
+	buf.WriteByte('>')
+
+	for _, child := range element.Children {
+		switch c := child.(type) {
+		case string:
+			buf.WriteString(escapeText(c))
+		case *Element:
+			if c == excluded {
+				continue
+			}
+
+			writeCanonical(buf, c, excluded, inclusivePrefixes, scope)
+		}
+	}
+
+	buf.WriteString("</")
+	buf.WriteString(qualifiedName(element.Prefix, element.Local))
+	buf.WriteByte('>')
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+
+	return prefix + ":" + local
+}
+
+var textReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+var attrReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;")
+
+func escapeText(s string) string {
+	return textReplacer.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrReplacer.Replace(s)
+}
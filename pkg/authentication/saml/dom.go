@@ -0,0 +1,187 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// Element is a node of a parsed XML document.
+// Prefixes and namespace declarations are kept as they appear in the document,
+// since verifying signatures needs the exact canonical form of the signed elements.
+// Comments and processing instructions are dropped.
+type Element struct {
+	Prefix     string
+	Local      string
+	Attrs      []Attr
+	Namespaces map[string]string
+	Children   []any
+	Parent     *Element
+}
+
+type Attr struct {
+	Prefix string
+	Local  string
+	Value  string
+}
+
+// Parse parses an XML document into a tree of elements.
+// Documents with a DTD are rejected.
+func Parse(data []byte) (*Element, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var root *Element
+	var current *Element
+
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := newElement(t, current)
+			if current == nil {
+				if root != nil {
+					return nil, errors.New("document has more than one root element")
+				}
+
+				root = element
+			} else {
+				current.Children = append(current.Children, element)
+			}
+
+			current = element
+
+		case xml.EndElement:
+			if current == nil || current.Prefix != t.Name.Space || current.Local != t.Name.Local {
+				return nil, fmt.Errorf("unexpected end element %s", t.Name.Local)
+			}
+
+			current = current.Parent
+
+		case xml.CharData:
+			if current == nil {
+				continue
+			}
+
+			text := string(t)
+			if n := len(current.Children); n > 0 {
+				if previous, ok := current.Children[n-1].(string); ok {
+					current.Children[n-1] = previous + text
+					continue
+				}
+			}
+
+			current.Children = append(current.Children, text)
+
+		case xml.Directive:
+			return nil, errors.New("documents with a DTD are not supported")
+		}
+	}
+
+	if root == nil || current != nil {
+		return nil, errors.New("incomplete document")
+	}
+
+	return root, nil
+}
+
+func newElement(start xml.StartElement, parent *Element) *Element {
+	element := &Element{
+		Prefix:     start.Name.Space,
+		Local:      start.Name.Local,
+		Namespaces: map[string]string{},
+		Parent:     parent,
+	}
+
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+			element.Namespaces[""] = attr.Value
+		case attr.Name.Space == "xmlns":
+			element.Namespaces[attr.Name.Local] = attr.Value
+		default:
+			element.Attrs = append(element.Attrs, Attr{Prefix: attr.Name.Space, Local: attr.Name.Local, Value: attr.Value})
+		}
+	}
+
+	return element
+}
+
+// LookupNamespace returns the namespace bound to a prefix where the element is.
+func (e *Element) LookupNamespace(prefix string) string {
+	if prefix == "xml" {
+		return xmlNamespace
+	}
+
+	for element := e; element != nil; element = element.Parent {
+		if uri, ok := element.Namespaces[prefix]; ok {
+			return uri
+		}
+	}
+
+	return ""
+}
+
+func (e *Element) Space() string {
+	return e.LookupNamespace(e.Prefix)
+}
+
+func (e *Element) Is(space, local string) bool {
+	return e.Local == local && e.Space() == space
+}
+
+// Attr returns the value of an attribute without namespace.
+func (e *Element) Attr(local string) string {
+	for _, attr := range e.Attrs {
+		if attr.Prefix == "" && attr.Local == local {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func (e *Element) ChildElements(space, local string) []*Element {
+	var result []*Element
+	for _, child := range e.Children {
+		if element, ok := child.(*Element); ok && element.Is(space, local) {
+			result = append(result, element)
+		}
+	}
+
+	return result
+}
+
+// Child returns the first child element with the given name, or nil.
+func (e *Element) Child(space, local string) *Element {
+	children := e.ChildElements(space, local)
+	if len(children) == 0 {
+		return nil
+	}
+
+	return children[0]
+}
+
+// Text returns the text directly inside the element, without surrounding spaces.
+func (e *Element) Text() string {
+	var text strings.Builder
+	for _, child := range e.Children {
+		if s, ok := child.(string); ok {
+			text.WriteString(s)
+		}
+	}
+
+	return strings.TrimSpace(text.String())
+}
@@ -0,0 +1,138 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	NamespaceMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	NamespaceAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	NamespaceProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+
+	NameIDFormatUnspecified = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	NameIDFormatEmail       = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+)
+
+// IdentityProvider is what the service provider needs to know about the IdP,
+// read from the metadata document the IdP publishes.
+type IdentityProvider struct {
+	EntityID string
+
+	// SSOURL receives the authentication requests, with the HTTP-Redirect binding.
+	SSOURL string
+
+	// Certificates are the base64 encoded DER certificates the IdP signs with.
+	// Several certificates are listed while the IdP rotates its signing key.
+	Certificates []string
+}
+
+type entityDescriptor struct {
+	XMLName          xml.Name          `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID         string            `xml:"entityID,attr"`
+	IDPSSODescriptor *idpSSODescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+}
+
+type idpSSODescriptor struct {
+	KeyDescriptors      []keyDescriptor `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+	SingleSignOnService []endpoint      `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleSignOnService"`
+}
+
+type keyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo>X509Data>X509Certificate"`
+}
+
+type endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// ParseMetadata reads the entity ID, the SSO URL and the signing certificates
+// from the metadata of an identity provider.
+func ParseMetadata(data []byte) (*IdentityProvider, error) {
+	if _, err := Parse(data); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %v", err)
+	}
+
+	var descriptor entityDescriptor
+	if err := xml.Unmarshal(data, &descriptor); err != nil {
+		return nil, fmt.Errorf("invalid metadata: %v", err)
+	}
+
+	if descriptor.EntityID == "" {
+		return nil, errors.New("metadata has no entityID")
+	}
+
+	if descriptor.IDPSSODescriptor == nil {
+		return nil, errors.New("metadata has no IDPSSODescriptor")
+	}
+
+	idp := &IdentityProvider{EntityID: descriptor.EntityID}
+	for _, service := range descriptor.IDPSSODescriptor.SingleSignOnService {
+		if service.Binding == BindingHTTPRedirect {
+			idp.SSOURL = service.Location
+			break
+		}
+	}
+
+	if idp.SSOURL == "" {
+		return nil, errors.New("metadata has no SingleSignOnService with the HTTP-Redirect binding")
+	}
+
+	for _, key := range descriptor.IDPSSODescriptor.KeyDescriptors {
+		if key.Use != "" && key.Use != "signing" {
+			continue
+		}
+
+		for _, certificate := range key.Certificates {
+			certificate = strings.Join(strings.Fields(certificate), "")
+			if _, err := ParseCertificate(certificate); err != nil {
+				return nil, fmt.Errorf("invalid signing certificate: %v", err)
+			}
+
+			idp.Certificates = append(idp.Certificates, certificate)
+		}
+	}
+
+	if len(idp.Certificates) == 0 {
+		return nil, errors.New("metadata has no signing certificate")
+	}
+
+	return idp, nil
+}
+
+// X509Certificates parses the signing certificates of the identity provider.
+func (idp *IdentityProvider) X509Certificates() ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0, len(idp.Certificates))
+	for _, value := range idp.Certificates {
+		certificate, err := ParseCertificate(value)
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	return certificates, nil
+}
+
+// ServiceProviderMetadata returns the metadata document administrators
+// upload to their identity provider to register the organization.
+func ServiceProviderMetadata(entityID, acsURL string) []byte {
+	var buf strings.Builder
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<md:EntityDescriptor xmlns:md="` + NamespaceMetadata + `" entityID="` + escapeAttr(entityID) + `">`)
+	buf.WriteString(`<md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="` + NamespaceProtocol + `">`)
+	buf.WriteString(`<md:NameIDFormat>` + NameIDFormatEmail + `</md:NameIDFormat>`)
+	buf.WriteString(`<md:AssertionConsumerService Binding="` + BindingHTTPPost + `" Location="` + escapeAttr(acsURL) + `" index="0" isDefault="true"/>`)
+	buf.WriteString(`</md:SPSSODescriptor>`)
+	buf.WriteString(`</md:EntityDescriptor>`)
+	return []byte(buf.String())
+}
@@ -0,0 +1,358 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIDPEntityID = "https://idp.example.com/metadata"
+	testSPEntityID  = "https://superplane.example.com/auth/saml/org/metadata"
+	testACSURL      = "https://superplane.example.com/auth/saml/org/acs"
+	testRequestID   = "_request"
+)
+
+var testNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func Test__Canonicalize(t *testing.T) {
+	t.Run("renders only utilized namespaces and sorts attributes", func(t *testing.T) {
+		root, err := Parse([]byte(`<a:root xmlns:a="urn:a" xmlns:b="urn:b" z="1" a:y="2"><a:child b:x="3">t &amp; &lt;</a:child><c xmlns="urn:c"/></a:root>`))
+		require.NoError(t, err)
+		assert.Equal(t,
+			`<a:root xmlns:a="urn:a" z="1" a:y="2"><a:child xmlns:b="urn:b" b:x="3">t &amp; &lt;</a:child><c xmlns="urn:c"></c></a:root>`,
+			string(Canonicalize(root, nil, nil)),
+		)
+	})
+
+	t.Run("undeclares the default namespace", func(t *testing.T) {
+		root, err := Parse([]byte(`<r xmlns="urn:r"><s xmlns=""/></r>`))
+		require.NoError(t, err)
+		assert.Equal(t, `<r xmlns="urn:r"><s xmlns=""></s></r>`, string(Canonicalize(root, nil, nil)))
+	})
+
+	t.Run("renders namespaces of ancestors for a subtree", func(t *testing.T) {
+		root, err := Parse([]byte(`<a:root xmlns:a="urn:a"><a:child/></a:root>`))
+		require.NoError(t, err)
+		child := root.Child("urn:a", "child")
+		require.NotNil(t, child)
+		assert.Equal(t, `<a:child xmlns:a="urn:a"></a:child>`, string(Canonicalize(child, nil, nil)))
+	})
+
+	t.Run("renders inclusive prefixes", func(t *testing.T) {
+		root, err := Parse([]byte(`<a:root xmlns:a="urn:a" xmlns:b="urn:b"></a:root>`))
+		require.NoError(t, err)
+		assert.Equal(t, `<a:root xmlns:a="urn:a" xmlns:b="urn:b"></a:root>`, string(Canonicalize(root, nil, []string{"b"})))
+	})
+}
+
+func Test__Parse(t *testing.T) {
+	_, err := Parse([]byte(`<!DOCTYPE r [<!ENTITY x "y">]><r>&x;</r>`))
+	require.Error(t, err)
+
+	_, err = Parse([]byte(`<r><s></r>`))
+	require.Error(t, err)
+}
+
+func Test__ParseMetadata(t *testing.T) {
+	_, certificate := newTestKey(t)
+
+	t.Run("valid metadata", func(t *testing.T) {
+		idp, err := ParseMetadata([]byte(testMetadata(certificate)))
+		require.NoError(t, err)
+		assert.Equal(t, testIDPEntityID, idp.EntityID)
+		assert.Equal(t, "https://idp.example.com/sso", idp.SSOURL)
+		assert.Equal(t, []string{base64.StdEncoding.EncodeToString(certificate.Raw)}, idp.Certificates)
+
+		certificates, err := idp.X509Certificates()
+		require.NoError(t, err)
+		require.Len(t, certificates, 1)
+		assert.True(t, certificates[0].Equal(certificate))
+	})
+
+	t.Run("metadata without redirect binding -> error", func(t *testing.T) {
+		metadata := strings.ReplaceAll(testMetadata(certificate), BindingHTTPRedirect, BindingHTTPPost)
+		_, err := ParseMetadata([]byte(metadata))
+		require.ErrorContains(t, err, "HTTP-Redirect")
+	})
+
+	t.Run("not metadata -> error", func(t *testing.T) {
+		_, err := ParseMetadata([]byte(`<foo/>`))
+		require.Error(t, err)
+	})
+}
+
+func Test__AuthnRequestURL(t *testing.T) {
+	sp := newTestServiceProvider(t, nil)
+	sp.IDPSSOURL = "https://idp.example.com/sso?tenant=1"
+
+	id, err := NewRequestID()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(id, "_"))
+
+	requestURL, err := sp.AuthnRequestURL(id, "state")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(requestURL)
+	require.NoError(t, err)
+	assert.Equal(t, "idp.example.com", parsed.Host)
+	assert.Equal(t, "1", parsed.Query().Get("tenant"))
+	assert.Equal(t, "state", parsed.Query().Get("RelayState"))
+	assert.NotEmpty(t, parsed.Query().Get("SAMLRequest"))
+}
+
+func Test__ParseResponse(t *testing.T) {
+	key, certificate := newTestKey(t)
+	sp := newTestServiceProvider(t, certificate)
+
+	t.Run("signed assertion", func(t *testing.T) {
+		response := signAssertion(t, key, testResponse(testAssertion()))
+		assertion, err := sp.ParseResponse(encode(response), testRequestID)
+		require.NoError(t, err)
+		assert.Equal(t, "_assertion", assertion.ID)
+		assert.Equal(t, "jane@example.com", assertion.NameID)
+		assert.Equal(t, "jane@example.com", assertion.Attribute("email"))
+		assert.Equal(t, "jane@example.com", assertion.Attribute("mail"))
+		assert.Equal(t, []string{"engineering", "admins"}, assertion.Attributes["groups"])
+		assert.Equal(t, testNow.Add(5*time.Minute), assertion.NotOnOrAfter)
+	})
+
+	t.Run("signed response", func(t *testing.T) {
+		response := signResponse(t, key, testResponse(testAssertion()))
+		assertion, err := sp.ParseResponse(encode(response), testRequestID)
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", assertion.NameID)
+	})
+
+	t.Run("unsigned -> error", func(t *testing.T) {
+		_, err := sp.ParseResponse(encode(testResponse(testAssertion())), testRequestID)
+		require.ErrorContains(t, err, "neither the response nor the assertion is signed")
+	})
+
+	t.Run("tampered assertion -> error", func(t *testing.T) {
+		response := signAssertion(t, key, testResponse(testAssertion()))
+		response = strings.Replace(response, "jane@example.com</saml:NameID>", "admin@example.com</saml:NameID>", 1)
+		_, err := sp.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "digest")
+	})
+
+	t.Run("signed by another key -> error", func(t *testing.T) {
+		otherKey, _ := newTestKey(t)
+		response := signAssertion(t, otherKey, testResponse(testAssertion()))
+		_, err := sp.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "does not match")
+	})
+
+	t.Run("signature wrapping -> error", func(t *testing.T) {
+		//
+		// The signed assertion is moved inside an extension of an unsigned one,
+		// so the signature is valid, but does not cover the assertion that is read.
+		//
+		signed := signAssertion(t, key, testResponse(testAssertion()))
+		start := strings.Index(signed, "<saml:Assertion")
+		end := strings.Index(signed, "</saml:Assertion>") + len("</saml:Assertion>")
+		evil := strings.Replace(testAssertion(), "jane@example.com</saml:NameID>", "admin@example.com</saml:NameID>", 1)
+		evil = strings.Replace(evil, `ID="_assertion"`, `ID="_evil"`, 1)
+		evil = strings.Replace(evil, "</saml:Assertion>", "<saml:Advice>"+signed[start:end]+"</saml:Advice></saml:Assertion>", 1)
+
+		_, err := sp.ParseResponse(encode(testResponse(evil)), testRequestID)
+		require.Error(t, err)
+	})
+
+	t.Run("two assertions -> error", func(t *testing.T) {
+		signed := signAssertion(t, key, testResponse(testAssertion()))
+		start := strings.Index(signed, "<saml:Assertion")
+		end := strings.Index(signed, "</saml:Assertion>") + len("</saml:Assertion>")
+		response := strings.Replace(signed, "</samlp:Response>", signed[start:end]+"</samlp:Response>", 1)
+
+		_, err := sp.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "exactly one assertion")
+	})
+
+	t.Run("other request -> error", func(t *testing.T) {
+		response := signAssertion(t, key, testResponse(testAssertion()))
+		_, err := sp.ParseResponse(encode(response), "_other")
+		require.ErrorContains(t, err, "not for the authentication request")
+	})
+
+	t.Run("expired -> error", func(t *testing.T) {
+		response := signAssertion(t, key, testResponse(testAssertion()))
+		expired := newTestServiceProvider(t, certificate)
+		expired.Now = func() time.Time { return testNow.Add(time.Hour) }
+
+		_, err := expired.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "subject confirmation")
+	})
+
+	t.Run("other audience -> error", func(t *testing.T) {
+		assertion := strings.Replace(testAssertion(), testSPEntityID+"</saml:Audience>", "https://other.example.com</saml:Audience>", 1)
+		response := signAssertion(t, key, testResponse(assertion))
+		_, err := sp.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "audience")
+	})
+
+	t.Run("failed status -> error", func(t *testing.T) {
+		response := strings.Replace(testResponse(testAssertion()), StatusSuccess, "urn:oasis:names:tc:SAML:2.0:status:Requester", 1)
+		_, err := sp.ParseResponse(encode(response), testRequestID)
+		require.ErrorContains(t, err, "authentication failed")
+	})
+}
+
+func newTestKey(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    testNow.Add(-time.Hour),
+		NotAfter:     testNow.Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, certificate
+}
+
+func newTestServiceProvider(t *testing.T, certificate *x509.Certificate) *ServiceProvider {
+	sp := &ServiceProvider{
+		EntityID:    testSPEntityID,
+		ACSURL:      testACSURL,
+		IDPEntityID: testIDPEntityID,
+		IDPSSOURL:   "https://idp.example.com/sso",
+		Now:         func() time.Time { return testNow },
+	}
+
+	if certificate != nil {
+		sp.IDPCertificates = []*x509.Certificate{certificate}
+	}
+
+	return sp
+}
+
+func testMetadata(certificate *x509.Certificate) string {
+	return `<md:EntityDescriptor xmlns:md="` + NamespaceMetadata + `" entityID="` + testIDPEntityID + `">
+  <md:IDPSSODescriptor protocolSupportEnumeration="` + NamespaceProtocol + `">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo xmlns:ds="` + NamespaceDSig + `">
+        <ds:X509Data>
+          <ds:X509Certificate>` + base64.StdEncoding.EncodeToString(certificate.Raw) + `</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="` + BindingHTTPRedirect + `" Location="https://idp.example.com/sso"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+}
+
+func testResponse(assertion string) string {
+	return `<samlp:Response xmlns:samlp="` + NamespaceProtocol + `" xmlns:saml="` + NamespaceAssertion + `"` +
+		` ID="_response" Version="2.0" IssueInstant="2026-01-01T12:00:00Z"` +
+		` Destination="` + testACSURL + `" InResponseTo="` + testRequestID + `">` +
+		`<saml:Issuer>` + testIDPEntityID + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="` + StatusSuccess + `"/></samlp:Status>` +
+		assertion +
+		`</samlp:Response>`
+}
+
+func testAssertion() string {
+	return `<saml:Assertion ID="_assertion" Version="2.0" IssueInstant="2026-01-01T12:00:00Z">
+  <saml:Issuer>` + testIDPEntityID + `</saml:Issuer>
+  <saml:Subject>
+    <saml:NameID Format="` + NameIDFormatEmail + `">jane@example.com</saml:NameID>
+    <saml:SubjectConfirmation Method="` + SubjectConfirmationBearer + `">
+      <saml:SubjectConfirmationData InResponseTo="` + testRequestID + `" NotOnOrAfter="2026-01-01T12:05:00Z" Recipient="` + testACSURL + `"/>
+    </saml:SubjectConfirmation>
+  </saml:Subject>
+  <saml:Conditions NotBefore="2026-01-01T11:59:00Z" NotOnOrAfter="2026-01-01T12:10:00Z">
+    <saml:AudienceRestriction><saml:Audience>` + testSPEntityID + `</saml:Audience></saml:AudienceRestriction>
+  </saml:Conditions>
+  <saml:AttributeStatement>
+    <saml:Attribute Name="email" FriendlyName="mail"><saml:AttributeValue>jane@example.com</saml:AttributeValue></saml:Attribute>
+    <saml:Attribute Name="groups"><saml:AttributeValue>engineering</saml:AttributeValue><saml:AttributeValue>admins</saml:AttributeValue></saml:Attribute>
+  </saml:AttributeStatement>
+</saml:Assertion>`
+}
+
+func encode(response string) string {
+	return base64.StdEncoding.EncodeToString([]byte(response))
+}
+
+// signAssertion signs the assertion of a response, the way identity providers do it:
+// the signature goes right after the issuer of the signed element.
+func signAssertion(t *testing.T, key *rsa.PrivateKey, response string) string {
+	return sign(t, key, response, "_assertion")
+}
+
+func signResponse(t *testing.T, key *rsa.PrivateKey, response string) string {
+	return sign(t, key, response, "_response")
+}
+
+func sign(t *testing.T, key *rsa.PrivateKey, document, id string) string {
+	root, err := Parse([]byte(document))
+	require.NoError(t, err)
+
+	element := findByID(root, id)
+	require.NotNil(t, element)
+
+	digest := sha256.Sum256(Canonicalize(element, nil, nil))
+	signature := `<ds:Signature xmlns:ds="` + NamespaceDSig + `"><ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="` + AlgorithmExcC14N + `"/>` +
+		`<ds:SignatureMethod Algorithm="` + AlgorithmRSASHA256 + `"/>` +
+		`<ds:Reference URI="#` + id + `"><ds:Transforms>` +
+		`<ds:Transform Algorithm="` + AlgorithmEnvelopedSignature + `"/>` +
+		`<ds:Transform Algorithm="` + AlgorithmExcC14N + `"/>` +
+		`</ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="` + AlgorithmSHA256 + `"/>` +
+		`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest[:]) + `</ds:DigestValue>` +
+		`</ds:Reference></ds:SignedInfo><ds:SignatureValue>SIGNATURE</ds:SignatureValue></ds:Signature>`
+
+	//
+	// The signature is inserted without surrounding whitespace,
+	// so removing it gives back exactly the digested element.
+	//
+	start := strings.Index(document, `ID="`+id+`"`)
+	index := start + strings.Index(document[start:], "</saml:Issuer>") + len("</saml:Issuer>")
+	document = document[:index] + signature + document[index:]
+
+	root, err = Parse([]byte(document))
+	require.NoError(t, err)
+
+	signedInfo := findByID(root, id).Child(NamespaceDSig, "Signature").Child(NamespaceDSig, "SignedInfo")
+	hashed := sha256.Sum256(Canonicalize(signedInfo, nil, nil))
+	value, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	require.NoError(t, err)
+
+	return strings.Replace(document, "SIGNATURE", base64.StdEncoding.EncodeToString(value), 1)
+}
+
+func findByID(element *Element, id string) *Element {
+	if element.Attr("ID") == id {
+		return element
+	}
+
+	for _, child := range element.Children {
+		if c, ok := child.(*Element); ok {
+			if found := findByID(c, id); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,358 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+const (
+	StatusSuccess              = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	SubjectConfirmationBearer  = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	DefaultClockSkew           = 3 * time.Minute
+	maxSAMLResponseLength      = 1 << 20
+	authnRequestIDRandomLength = 20
+)
+
+// ServiceProvider authenticates people with one identity provider.
+// Authentication requests are sent with the HTTP-Redirect binding,
+// and responses are received with the HTTP-POST binding.
+type ServiceProvider struct {
+	EntityID string
+	ACSURL   string
+
+	IDPEntityID     string
+	IDPSSOURL       string
+	IDPCertificates []*x509.Certificate
+
+	ClockSkew time.Duration
+	Now       func() time.Time
+}
+
+// Assertion is what the identity provider says about the person who signed in.
+type Assertion struct {
+	ID           string
+	NameID       string
+	Attributes   map[string][]string
+	NotOnOrAfter time.Time
+}
+
+// Attribute returns the first value of an attribute, or an empty string.
+func (a *Assertion) Attribute(name string) string {
+	values := a.Attributes[name]
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// NewRequestID generates the ID of an authentication request.
+func NewRequestID() (string, error) {
+	random := make([]byte, authnRequestIDRandomLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	//
+	// IDs must not start with a digit.
+	//
+	return "_" + hex.EncodeToString(random), nil
+}
+
+// AuthnRequestURL returns the URL the browser is redirected to in order to sign in.
+// The response of the identity provider refers to the request by its ID.
+func (sp *ServiceProvider) AuthnRequestURL(id, relayState string) (string, error) {
+	request := `<samlp:AuthnRequest xmlns:samlp="` + NamespaceProtocol + `" xmlns:saml="` + NamespaceAssertion + `"` +
+		` ID="` + escapeAttr(id) + `" Version="2.0" IssueInstant="` + sp.now().UTC().Format(time.RFC3339) + `"` +
+		` Destination="` + escapeAttr(sp.IDPSSOURL) + `"` +
+		` AssertionConsumerServiceURL="` + escapeAttr(sp.ACSURL) + `"` +
+		` ProtocolBinding="` + BindingHTTPPost + `">` +
+		`<saml:Issuer>` + escapeText(sp.EntityID) + `</saml:Issuer>` +
+		`<samlp:NameIDPolicy Format="` + NameIDFormatUnspecified + `" AllowCreate="true"/>` +
+		`</samlp:AuthnRequest>`
+
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := writer.Write([]byte(request)); err != nil {
+		return "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	ssoURL, err := url.Parse(sp.IDPSSOURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid SSO URL: %v", err)
+	}
+
+	query := ssoURL.Query()
+	query.Set("SAMLRequest", base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	if relayState != "" {
+		query.Set("RelayState", relayState)
+	}
+
+	ssoURL.RawQuery = query.Encode()
+	return ssoURL.String(), nil
+}
+
+// ParseResponse validates the SAMLResponse posted to the assertion consumer service,
+// for the authentication request with the given ID, and returns its assertion.
+// Either the response or the assertion must be signed by the identity provider,
+// and encrypted assertions are not supported.
+func (sp *ServiceProvider) ParseResponse(samlResponse string, requestID string) (*Assertion, error) {
+	if len(samlResponse) > maxSAMLResponseLength {
+		return nil, errors.New("response is too large")
+	}
+
+	data, err := decodeBase64(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response encoding: %v", err)
+	}
+
+	response, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %v", err)
+	}
+
+	if !response.Is(NamespaceProtocol, "Response") {
+		return nil, errors.New("document is not a SAML response")
+	}
+
+	if requestID == "" || response.Attr("InResponseTo") != requestID {
+		return nil, errors.New("response is not for the authentication request")
+	}
+
+	if destination := response.Attr("Destination"); destination != "" && destination != sp.ACSURL {
+		return nil, fmt.Errorf("response is for another destination: %s", destination)
+	}
+
+	if issuer := response.Child(NamespaceAssertion, "Issuer"); issuer != nil && issuer.Text() != sp.IDPEntityID {
+		return nil, fmt.Errorf("response is from an unknown issuer: %s", issuer.Text())
+	}
+
+	if err := checkStatus(response); err != nil {
+		return nil, err
+	}
+
+	if len(response.ChildElements(NamespaceAssertion, "EncryptedAssertion")) > 0 {
+		return nil, errors.New("encrypted assertions are not supported")
+	}
+
+	assertions := response.ChildElements(NamespaceAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, errors.New("response must have exactly one assertion")
+	}
+
+	assertion := assertions[0]
+	responseSigned, err := sp.verify(response)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response signature: %v", err)
+	}
+
+	assertionSigned, err := sp.verify(assertion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid assertion signature: %v", err)
+	}
+
+	if !responseSigned && !assertionSigned {
+		return nil, errors.New("neither the response nor the assertion is signed")
+	}
+
+	return sp.readAssertion(assertion, requestID)
+}
+
+// verify checks the signature of an element, when it has one.
+func (sp *ServiceProvider) verify(element *Element) (bool, error) {
+	err := VerifySignature(element, sp.IDPCertificates)
+	if errors.Is(err, ErrMissingSignature) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func checkStatus(response *Element) error {
+	status := response.Child(NamespaceProtocol, "Status")
+	if status == nil {
+		return errors.New("response has no status")
+	}
+
+	code := status.Child(NamespaceProtocol, "StatusCode")
+	if code == nil || code.Attr("Value") != StatusSuccess {
+		message := ""
+		if m := status.Child(NamespaceProtocol, "StatusMessage"); m != nil {
+			message = m.Text()
+		}
+
+		value := ""
+		if code != nil {
+			value = code.Attr("Value")
+		}
+
+		return fmt.Errorf("authentication failed with status %s %s", value, message)
+	}
+
+	return nil
+}
+
+func (sp *ServiceProvider) readAssertion(element *Element, requestID string) (*Assertion, error) {
+	now := sp.now()
+	skew := sp.clockSkew()
+
+	issuer := element.Child(NamespaceAssertion, "Issuer")
+	if issuer == nil || issuer.Text() != sp.IDPEntityID {
+		return nil, errors.New("assertion is from an unknown issuer")
+	}
+
+	assertion := &Assertion{
+		ID:         element.Attr("ID"),
+		Attributes: map[string][]string{},
+	}
+
+	if assertion.ID == "" {
+		return nil, errors.New("assertion has no ID")
+	}
+
+	subject := element.Child(NamespaceAssertion, "Subject")
+	if subject == nil {
+		return nil, errors.New("assertion has no subject")
+	}
+
+	if nameID := subject.Child(NamespaceAssertion, "NameID"); nameID != nil {
+		assertion.NameID = nameID.Text()
+	}
+
+	confirmed := false
+	for _, confirmation := range subject.ChildElements(NamespaceAssertion, "SubjectConfirmation") {
+		if confirmation.Attr("Method") != SubjectConfirmationBearer {
+			continue
+		}
+
+		data := confirmation.Child(NamespaceAssertion, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+
+		if data.Attr("Recipient") != sp.ACSURL {
+			continue
+		}
+
+		if inResponseTo := data.Attr("InResponseTo"); inResponseTo != "" && inResponseTo != requestID {
+			continue
+		}
+
+		notOnOrAfter, err := parseTime(data.Attr("NotOnOrAfter"))
+		if err != nil || !now.Before(notOnOrAfter.Add(skew)) {
+			continue
+		}
+
+		confirmed = true
+		assertion.NotOnOrAfter = notOnOrAfter
+		break
+	}
+
+	if !confirmed {
+		return nil, errors.New("assertion has no valid bearer subject confirmation")
+	}
+
+	conditions := element.Child(NamespaceAssertion, "Conditions")
+	if conditions == nil {
+		return nil, errors.New("assertion has no conditions")
+	}
+
+	if err := sp.checkConditions(conditions, now, skew); err != nil {
+		return nil, err
+	}
+
+	if notOnOrAfter, err := parseTime(conditions.Attr("NotOnOrAfter")); err == nil && notOnOrAfter.Before(assertion.NotOnOrAfter) {
+		assertion.NotOnOrAfter = notOnOrAfter
+	}
+
+	for _, statement := range element.ChildElements(NamespaceAssertion, "AttributeStatement") {
+		for _, attribute := range statement.ChildElements(NamespaceAssertion, "Attribute") {
+			values := []string{}
+			for _, value := range attribute.ChildElements(NamespaceAssertion, "AttributeValue") {
+				values = append(values, value.Text())
+			}
+
+			//
+			// Attributes can be mapped by their name or their friendly name,
+			// since identity providers show one or the other to administrators.
+			//
+			for _, name := range []string{attribute.Attr("Name"), attribute.Attr("FriendlyName")} {
+				if name != "" {
+					assertion.Attributes[name] = append(assertion.Attributes[name], values...)
+				}
+			}
+		}
+	}
+
+	return assertion, nil
+}
+
+func (sp *ServiceProvider) checkConditions(conditions *Element, now time.Time, skew time.Duration) error {
+	if value := conditions.Attr("NotBefore"); value != "" {
+		notBefore, err := parseTime(value)
+		if err != nil || now.Add(skew).Before(notBefore) {
+			return errors.New("assertion is not valid yet")
+		}
+	}
+
+	if value := conditions.Attr("NotOnOrAfter"); value != "" {
+		notOnOrAfter, err := parseTime(value)
+		if err != nil || !now.Before(notOnOrAfter.Add(skew)) {
+			return errors.New("assertion has expired")
+		}
+	}
+
+	for _, restriction := range conditions.ChildElements(NamespaceAssertion, "AudienceRestriction") {
+		audiences := []string{}
+		for _, audience := range restriction.ChildElements(NamespaceAssertion, "Audience") {
+			audiences = append(audiences, audience.Text())
+		}
+
+		if !slices.Contains(audiences, sp.EntityID) {
+			return errors.New("assertion is for another audience")
+		}
+	}
+
+	return nil
+}
+
+func (sp *ServiceProvider) now() time.Time {
+	if sp.Now != nil {
+		return sp.Now()
+	}
+
+	return time.Now()
+}
+
+func (sp *ServiceProvider) clockSkew() time.Duration {
+	if sp.ClockSkew > 0 {
+		return sp.ClockSkew
+	}
+
+	return DefaultClockSkew
+}
+
+func parseTime(value string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,207 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	// Registers the hash functions used by the supported algorithms.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+const (
+	NamespaceDSig   = "http://www.w3.org/2000/09/xmldsig#"
+	NamespaceExcC14 = "http://www.w3.org/2001/10/xml-exc-c14n#"
+
+	AlgorithmEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	AlgorithmExcC14N            = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	AlgorithmRSASHA256          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	AlgorithmRSASHA512          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	AlgorithmSHA256             = "http://www.w3.org/2001/04/xmlenc#sha256"
+	AlgorithmSHA512             = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+var ErrMissingSignature = errors.New("element is not signed")
+
+var signatureAlgorithms = map[string]crypto.Hash{
+	AlgorithmRSASHA256: crypto.SHA256,
+	AlgorithmRSASHA512: crypto.SHA512,
+}
+
+var digestAlgorithms = map[string]crypto.Hash{
+	AlgorithmSHA256: crypto.SHA256,
+	AlgorithmSHA512: crypto.SHA512,
+}
+
+// VerifySignature verifies the enveloped signature of an element
+// with one of the certificates of the identity provider.
+// The signature must be a direct child of the element, and reference it by its ID,
+// so the signed content is exactly the element the caller reads from.
+// SHA-1 and inclusive canonicalization are not supported.
+func VerifySignature(element *Element, certificates []*x509.Certificate) error {
+	signatures := element.ChildElements(NamespaceDSig, "Signature")
+	if len(signatures) == 0 {
+		return ErrMissingSignature
+	}
+
+	if len(signatures) > 1 {
+		return errors.New("element has more than one signature")
+	}
+
+	signature := signatures[0]
+	signedInfo := signature.Child(NamespaceDSig, "SignedInfo")
+	if signedInfo == nil {
+		return errors.New("signature has no SignedInfo")
+	}
+
+	canonicalization := signedInfo.Child(NamespaceDSig, "CanonicalizationMethod")
+	if canonicalization == nil || canonicalization.Attr("Algorithm") != AlgorithmExcC14N {
+		return errors.New("unsupported canonicalization method")
+	}
+
+	signatureMethod := signedInfo.Child(NamespaceDSig, "SignatureMethod")
+	if signatureMethod == nil {
+		return errors.New("signature has no SignatureMethod")
+	}
+
+	signatureHash, ok := signatureAlgorithms[signatureMethod.Attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("unsupported signature method %s", signatureMethod.Attr("Algorithm"))
+	}
+
+	if err := verifyReference(element, signature, signedInfo); err != nil {
+		return err
+	}
+
+	signatureValue := signature.Child(NamespaceDSig, "SignatureValue")
+	if signatureValue == nil {
+		return errors.New("signature has no SignatureValue")
+	}
+
+	value, err := decodeBase64(signatureValue.Text())
+	if err != nil {
+		return fmt.Errorf("invalid signature value: %v", err)
+	}
+
+	h := signatureHash.New()
+	h.Write(Canonicalize(signedInfo, nil, inclusivePrefixes(canonicalization)))
+	hashed := h.Sum(nil)
+
+	for _, certificate := range certificates {
+		publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+
+		if rsa.VerifyPKCS1v15(publicKey, signatureHash, hashed, value) == nil {
+			return nil
+		}
+	}
+
+	return errors.New("signature does not match the identity provider certificates")
+}
+
+func verifyReference(element, signature, signedInfo *Element) error {
+	references := signedInfo.ChildElements(NamespaceDSig, "Reference")
+	if len(references) != 1 {
+		return errors.New("signature must have exactly one reference")
+	}
+
+	reference := references[0]
+	id := element.Attr("ID")
+	if id == "" || reference.Attr("URI") != "#"+id {
+		return errors.New("signature does not reference the signed element")
+	}
+
+	var prefixes []string
+	enveloped := false
+	exclusive := false
+
+	if transforms := reference.Child(NamespaceDSig, "Transforms"); transforms != nil {
+		for _, transform := range transforms.ChildElements(NamespaceDSig, "Transform") {
+			switch transform.Attr("Algorithm") {
+			case AlgorithmEnvelopedSignature:
+				enveloped = true
+			case AlgorithmExcC14N:
+				exclusive = true
+				prefixes = inclusivePrefixes(transform)
+			default:
+				return fmt.Errorf("unsupported transform %s", transform.Attr("Algorithm"))
+			}
+		}
+	}
+
+	if !enveloped || !exclusive {
+		return errors.New("signature must use the enveloped signature and exclusive canonicalization transforms")
+	}
+
+	digestMethod := reference.Child(NamespaceDSig, "DigestMethod")
+	if digestMethod == nil {
+		return errors.New("reference has no DigestMethod")
+	}
+
+	digestHash, ok := digestAlgorithms[digestMethod.Attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("unsupported digest method %s", digestMethod.Attr("Algorithm"))
+	}
+
+	digestValue := reference.Child(NamespaceDSig, "DigestValue")
+	if digestValue == nil {
+		return errors.New("reference has no DigestValue")
+	}
+
+	expected, err := decodeBase64(digestValue.Text())
+	if err != nil {
+		return fmt.Errorf("invalid digest value: %v", err)
+	}
+
+	h := digestHash.New()
+	h.Write(Canonicalize(element, signature, prefixes))
+	if subtle.ConstantTimeCompare(h.Sum(nil), expected) != 1 {
+		return errors.New("digest of the signed element does not match")
+	}
+
+	return nil
+}
+
+func inclusivePrefixes(element *Element) []string {
+	namespaces := element.Child(NamespaceExcC14, "InclusiveNamespaces")
+	if namespaces == nil {
+		return nil
+	}
+
+	return strings.Fields(namespaces.Attr("PrefixList"))
+}
+
+func decodeBase64(value string) ([]byte, error) {
+	value = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\n' || r == '\r' || r == '\t' {
+			return -1
+		}
+
+		return r
+	}, value)
+
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// ParseCertificate parses a base64 encoded DER certificate,
+// as found in X509Certificate elements, or a PEM certificate.
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "-----BEGIN CERTIFICATE-----")
+	value = strings.TrimSuffix(value, "-----END CERTIFICATE-----")
+
+	der, err := decodeBase64(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
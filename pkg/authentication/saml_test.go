@@ -0,0 +1,322 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func setupSAMLHandler(t *testing.T) (*Handler, *support.ResourceRegistry, *mux.Router) {
+	handler, r := setupAuthHandler(t, true)
+	handler.InitializeSAML("https://superplane.example.com")
+
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+	return handler, r, router
+}
+
+func createSAMLSettings(t *testing.T, organizationID uuid.UUID, mappings ...models.SAMLGroupMapping) *models.OrganizationSAMLSettings {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	groupsAttribute := "groups"
+	settings := &models.OrganizationSAMLSettings{
+		OrganizationID:  organizationID,
+		Enabled:         true,
+		IdpMetadata:     "<md:EntityDescriptor/>",
+		IdpEntityID:     "https://idp.example.com",
+		IdpSSOURL:       "https://idp.example.com/sso",
+		IdpCertificates: datatypes.JSONSlice[string]{base64.StdEncoding.EncodeToString(der)},
+		GroupsAttribute: &groupsAttribute,
+		GroupMappings:   datatypes.JSONSlice[models.SAMLGroupMapping](mappings),
+	}
+
+	require.NoError(t, database.Conn().Create(settings).Error)
+	return settings
+}
+
+func Test__SAMLRoutes(t *testing.T) {
+	handler, r, router := setupSAMLHandler(t)
+	orgID := r.Organization.ID.String()
+
+	t.Run("metadata describes the organization as a service provider", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID+"/metadata", nil))
+
+		require.Equal(t, http.StatusOK, res.Code)
+		assert.Contains(t, res.Body.String(), `entityID="https://superplane.example.com/auth/saml/`+orgID+`/metadata"`)
+		assert.Contains(t, res.Body.String(), `Location="https://superplane.example.com/auth/saml/`+orgID+`/acs"`)
+	})
+
+	t.Run("login without SAML settings returns not found", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID, nil))
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	createSAMLSettings(t, r.Organization.ID)
+
+	t.Run("login redirects to the identity provider", func(t *testing.T) {
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID+"?redirect=/canvases", nil))
+
+		require.Equal(t, http.StatusFound, res.Code)
+		location, err := url.Parse(res.Header().Get("Location"))
+		require.NoError(t, err)
+		assert.Equal(t, "idp.example.com", location.Host)
+		assert.NotEmpty(t, location.Query().Get("SAMLRequest"))
+		assert.NotEmpty(t, location.Query().Get("RelayState"))
+	})
+
+	t.Run("response without a valid relay state is rejected", func(t *testing.T) {
+		form := url.Values{"SAMLResponse": {"x"}, "RelayState": {"invalid"}}
+		req := httptest.NewRequest(http.MethodPost, "/auth/saml/"+orgID+"/acs", nil)
+		req.PostForm = form
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("relay state signed with the session key is rejected", func(t *testing.T) {
+		relayState, err := handler.jwtSigner.GenerateWithClaims(orgID, time.Minute, map[string]any{"purpose": samlRequestPurpose})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/saml/"+orgID+"/acs", nil)
+		req.PostForm = url.Values{"SAMLResponse": {"x"}, "RelayState": {relayState}}
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	loginToken := func(t *testing.T, signer *jwt.Signer, assertionID string) string {
+		token, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Minute, map[string]any{
+			"purpose":         samlLoginPurpose,
+			"organization_id": orgID,
+			"assertion_id":    assertionID,
+			"redirect":        "/canvases",
+		})
+
+		require.NoError(t, err)
+		return token
+	}
+
+	t.Run("login token in the query string is not accepted", func(t *testing.T) {
+		token := loginToken(t, handler.samlSigner, "query")
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID+"/complete?token="+token, nil))
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("login token signed with the session key is rejected", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID+"/complete", nil)
+		req.AddCookie(&http.Cookie{Name: samlLoginCookie, Value: loginToken(t, handler.jwtSigner, "session-key")})
+
+		res := httptest.NewRecorder()
+		router.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("login cookie completes the session once", func(t *testing.T) {
+		token := loginToken(t, handler.samlSigner, "cookie")
+		complete := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/auth/saml/"+orgID+"/complete", nil)
+			req.AddCookie(&http.Cookie{Name: samlLoginCookie, Value: token})
+
+			res := httptest.NewRecorder()
+			router.ServeHTTP(res, req)
+			return res
+		}
+
+		res := complete()
+		require.Equal(t, http.StatusTemporaryRedirect, res.Code)
+		assert.Equal(t, "/canvases", res.Header().Get("Location"))
+
+		cookies := map[string]*http.Cookie{}
+		for _, cookie := range res.Result().Cookies() {
+			cookies[cookie.Name] = cookie
+		}
+
+		require.Contains(t, cookies, "account_token")
+		require.Contains(t, cookies, samlLoginCookie)
+		assert.Negative(t, cookies[samlLoginCookie].MaxAge)
+
+		assert.Equal(t, http.StatusBadRequest, complete().Code)
+	})
+}
+
+func Test__ProvisionSAMLUser(t *testing.T) {
+	handler, r := setupAuthHandler(t, true)
+
+	t.Run("new person joins the organization as viewer", func(t *testing.T) {
+		account, user, err := handler.provisionSAMLUser(r.Organization.ID, "new@example.com", "New Person")
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", account.Email)
+		assert.Equal(t, "New Person", user.Name)
+
+		roles, err := r.AuthService.GetUserRolesForOrg(user.ID.String(), r.Organization.ID.String())
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, models.RoleOrgViewer, roles[0].Name)
+	})
+
+	t.Run("existing member keeps their user", func(t *testing.T) {
+		account, user, err := handler.provisionSAMLUser(r.Organization.ID, r.Account.Email, "Someone")
+		require.NoError(t, err)
+		assert.Equal(t, r.Account.ID, account.ID)
+		assert.Equal(t, r.User.String(), user.ID.String())
+	})
+
+	t.Run("removed member is restored", func(t *testing.T) {
+		_, user, err := handler.provisionSAMLUser(r.Organization.ID, "removed@example.com", "Removed")
+		require.NoError(t, err)
+		require.NoError(t, user.Delete())
+
+		_, restored, err := handler.provisionSAMLUser(r.Organization.ID, "removed@example.com", "Removed")
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, restored.ID)
+		assert.False(t, restored.DeletedAt.Valid)
+	})
+
+	t.Run("invited account joins the organization", func(t *testing.T) {
+		account, err := models.CreateAccount("Invited", "invited@example.com")
+		require.NoError(t, err)
+		invitation, err := models.CreateInvitation(r.Organization.ID, r.User, account.Email, models.InvitationStatePending)
+		require.NoError(t, err)
+
+		linked, user, err := handler.provisionSAMLUser(r.Organization.ID, account.Email, "Invited")
+		require.NoError(t, err)
+		assert.Equal(t, account.ID, linked.ID)
+		assert.Equal(t, &account.ID, user.AccountID)
+
+		invitation, err = models.FindInvitationByID(invitation.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.InvitationStateAccepted, invitation.State)
+	})
+
+	t.Run("account outside of the organization is not linked", func(t *testing.T) {
+		account, err := models.CreateAccount("Foreign", "foreign@example.com")
+		require.NoError(t, err)
+
+		_, _, err = handler.provisionSAMLUser(r.Organization.ID, account.Email, "Foreign")
+		require.ErrorIs(t, err, errSAMLAccountNotLinked)
+
+		_, err = models.FindMaybeDeletedUserByEmail(r.Organization.ID.String(), account.Email)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+}
+
+func Test__SyncSAMLGroups(t *testing.T) {
+	handler, r := setupAuthHandler(t, true)
+	orgID := r.Organization.ID.String()
+	userID := r.User.String()
+
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "engineers", models.RoleOrgViewer, "Engineers", ""))
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "admins", models.RoleOrgAdmin, "Admins", ""))
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "local", models.RoleOrgViewer, "Local", ""))
+	require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, "local"))
+
+	settings := createSAMLSettings(t, r.Organization.ID,
+		models.SAMLGroupMapping{IdpGroup: "eng", Group: "engineers"},
+		models.SAMLGroupMapping{IdpGroup: "it-admins", Group: "admins"},
+	)
+
+	user, err := models.FindActiveUserByID(orgID, userID)
+	require.NoError(t, err)
+
+	membersOf := func(group string) []string {
+		members, err := r.AuthService.GetGroupUsers(orgID, models.DomainTypeOrganization, group)
+		require.NoError(t, err)
+		return members
+	}
+
+	require.NoError(t, handler.syncSAMLGroups(settings, user, []string{"eng", "it-admins"}))
+	assert.Contains(t, membersOf("engineers"), userID)
+	assert.Contains(t, membersOf("admins"), userID)
+
+	//
+	// Only mapped groups follow the identity provider.
+	//
+	require.NoError(t, handler.syncSAMLGroups(settings, user, []string{"eng"}))
+	assert.Contains(t, membersOf("engineers"), userID)
+	assert.NotContains(t, membersOf("admins"), userID)
+	assert.Contains(t, membersOf("local"), userID)
+}
+
+func Test__SAMLSession(t *testing.T) {
+	handler, r := setupAuthHandler(t, true)
+	accountID := r.Account.ID.String()
+	orgID := r.Organization.ID.String()
+
+	t.Run("without a session, the new session is limited to the organization", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		session := handler.samlSession(req, accountID, orgID)
+
+		assert.True(t, session.SSOOnly)
+		assert.True(t, session.CanAccess(orgID))
+		assert.False(t, session.CanAccess("other"))
+	})
+
+	t.Run("the organization is added to the existing session", func(t *testing.T) {
+		token, err := handler.jwtSigner.Generate(accountID, time.Hour)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		session := handler.samlSession(req, accountID, orgID)
+
+		assert.False(t, session.SSOOnly)
+		assert.True(t, session.SignedInWithSSO(orgID))
+		assert.True(t, session.CanAccess("other"))
+
+		token, err = handler.jwtSigner.GenerateWithClaims(accountID, time.Hour, session.claims())
+		require.NoError(t, err)
+
+		parsed, err := ParseSession(handler.jwtSigner, token)
+		require.NoError(t, err)
+		assert.Equal(t, session, parsed)
+	})
+
+	t.Run("sessions of another account are not reused", func(t *testing.T) {
+		token, err := handler.jwtSigner.Generate("another-account", time.Hour)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		session := handler.samlSession(req, accountID, orgID)
+
+		assert.True(t, session.SSOOnly)
+		assert.Equal(t, []string{orgID}, session.SSOOrganizations)
+	})
+}
@@ -0,0 +1,79 @@
+package authentication
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/superplanehq/superplane/pkg/jwt"
+)
+
+const (
+	SSOOrganizationsClaim = "sso"
+	SSOOnlyClaim          = "sso_only"
+)
+
+// Session is what the account token cookie says about the person signed in.
+type Session struct {
+	AccountID string
+
+	// SSOOrganizations are the organizations signed in with SAML single sign-on.
+	SSOOrganizations []string
+
+	// SSOOnly sessions were started by a SAML sign-in, so they can only be used
+	// for the organizations whose identity provider authenticated the person.
+	SSOOnly bool
+}
+
+func ParseSession(jwtSigner *jwt.Signer, token string) (*Session, error) {
+	claims, err := jwtSigner.ValidateAndGetClaims(token)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Tokens issued for a step of a sign-in flow are signed with the same secret,
+	// but they are not sessions.
+	//
+	if _, ok := claims["purpose"]; ok {
+		return nil, errors.New("token is not an account session")
+	}
+
+	accountID, ok := claims["sub"].(string)
+	if !ok || accountID == "" {
+		return nil, errors.New("account ID missing from token")
+	}
+
+	session := &Session{AccountID: accountID}
+	if organizations, ok := claims[SSOOrganizationsClaim].([]any); ok {
+		for _, organization := range organizations {
+			if id, ok := organization.(string); ok {
+				session.SSOOrganizations = append(session.SSOOrganizations, id)
+			}
+		}
+	}
+
+	if ssoOnly, ok := claims[SSOOnlyClaim].(bool); ok {
+		session.SSOOnly = ssoOnly
+	}
+
+	return session, nil
+}
+
+func (s *Session) SignedInWithSSO(organizationID string) bool {
+	return slices.Contains(s.SSOOrganizations, organizationID)
+}
+
+func (s *Session) CanAccess(organizationID string) bool {
+	return !s.SSOOnly || s.SignedInWithSSO(organizationID)
+}
+
+func (s *Session) claims() map[string]any {
+	if len(s.SSOOrganizations) == 0 {
+		return nil
+	}
+
+	return map[string]any{
+		SSOOrganizationsClaim: s.SSOOrganizations,
+		SSOOnlyClaim:          s.SSOOnly,
+	}
+}
@@ -0,0 +1,123 @@
+package authorization_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__AuthService_CanvasPermissions(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	canvasID := uuid.NewString()
+	otherCanvasID := uuid.NewString()
+
+	check := func(t *testing.T, userID, canvasID, action string) bool {
+		allowed, err := r.AuthService.CheckCanvasPermission(userID, orgID, canvasID, action)
+		require.NoError(t, err)
+		return allowed
+	}
+
+	t.Run("org roles apply to every canvas", func(t *testing.T) {
+		adminID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(adminID, models.RoleOrgAdmin, orgID, models.DomainTypeOrganization))
+		for _, action := range []string{"read", authorization.ActionOperate, "update", "delete"} {
+			assert.True(t, check(t, adminID, canvasID, action), "org admin should have %s permission", action)
+		}
+
+		viewerID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(viewerID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+		assert.True(t, check(t, viewerID, canvasID, "read"))
+		assert.False(t, check(t, viewerID, canvasID, authorization.ActionOperate))
+		assert.False(t, check(t, viewerID, canvasID, "update"))
+	})
+
+	t.Run("canvas operator can operate only its canvas", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignRole(userID, models.RoleOrgViewer, orgID, models.DomainTypeOrganization))
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasOperator))
+
+		assert.True(t, check(t, userID, canvasID, "read"))
+		assert.True(t, check(t, userID, canvasID, authorization.ActionOperate))
+		assert.False(t, check(t, userID, canvasID, "update"))
+		assert.False(t, check(t, userID, canvasID, "delete"))
+		assert.False(t, check(t, userID, otherCanvasID, authorization.ActionOperate))
+	})
+
+	t.Run("canvas editor can operate and update its canvas", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasEditor))
+
+		assert.True(t, check(t, userID, canvasID, "read"))
+		assert.True(t, check(t, userID, canvasID, authorization.ActionOperate))
+		assert.True(t, check(t, userID, canvasID, "update"))
+		assert.False(t, check(t, userID, canvasID, "delete"))
+		assert.False(t, check(t, userID, otherCanvasID, "read"))
+	})
+
+	t.Run("canvas roles bound to a group apply to its members", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "operators", models.RoleOrgViewer, "Operators", ""))
+		require.NoError(t, r.AuthService.AddUserToGroup(orgID, models.DomainTypeOrganization, userID, "operators"))
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeGroup, "operators", models.RoleCanvasOperator))
+
+		assert.True(t, check(t, userID, canvasID, authorization.ActionOperate))
+		assert.False(t, check(t, userID, canvasID, "update"))
+	})
+
+	t.Run("assigning a role replaces the previous one", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasEditor))
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasViewer))
+
+		assert.True(t, check(t, userID, canvasID, "read"))
+		assert.False(t, check(t, userID, canvasID, "update"))
+
+		bindings, err := r.AuthService.ListCanvasRoleBindings(orgID, canvasID)
+		require.NoError(t, err)
+
+		roles := []string{}
+		for _, binding := range bindings {
+			if binding.SubjectID == userID {
+				roles = append(roles, binding.Role)
+			}
+		}
+
+		assert.Equal(t, []string{models.RoleCanvasViewer}, roles)
+	})
+
+	t.Run("removing a role revokes the permissions", func(t *testing.T) {
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasViewer))
+		require.NoError(t, r.AuthService.RemoveCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID))
+		assert.False(t, check(t, userID, canvasID, "read"))
+
+		err := r.AuthService.RemoveCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, userID)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid roles and subjects are rejected", func(t *testing.T) {
+		err := r.AuthService.AssignCanvasRole(orgID, canvasID, authorization.SubjectTypeUser, uuid.NewString(), models.RoleOrgAdmin)
+		require.ErrorContains(t, err, "invalid canvas role")
+
+		err = r.AuthService.AssignCanvasRole(orgID, canvasID, "robot", uuid.NewString(), models.RoleCanvasViewer)
+		require.ErrorContains(t, err, "invalid subject type")
+	})
+
+	t.Run("destroying a canvas removes its role bindings", func(t *testing.T) {
+		destroyedCanvasID := uuid.NewString()
+		userID := uuid.NewString()
+		require.NoError(t, r.AuthService.AssignCanvasRole(orgID, destroyedCanvasID, authorization.SubjectTypeUser, userID, models.RoleCanvasEditor))
+		require.NoError(t, r.AuthService.DestroyCanvas(destroyedCanvasID))
+
+		bindings, err := r.AuthService.ListCanvasRoleBindings(orgID, destroyedCanvasID)
+		require.NoError(t, err)
+		assert.Empty(t, bindings)
+		assert.False(t, check(t, userID, destroyedCanvasID, "read"))
+	})
+}
@@ -7,6 +7,15 @@ type PermissionChecker interface {
 	IsValidPermission(domainType string, permission *Permission) bool
 }
 
+// Canvas role bindings interface
+type CanvasAccessManager interface {
+	CheckCanvasPermission(userID, orgID, canvasID, action string) (bool, error)
+	AssignCanvasRole(orgID, canvasID, subjectType, subjectID, role string) error
+	RemoveCanvasRole(orgID, canvasID, subjectType, subjectID string) error
+	ListCanvasRoleBindings(orgID, canvasID string) ([]*CanvasRoleBinding, error)
+	DestroyCanvas(canvasID string) error
+}
+
 // Group management interface
 type GroupManager interface {
 	CreateGroup(domainID string, domainType string, groupName string, role string, displayName string, description string) error
@@ -62,6 +71,7 @@ type Authorization interface {
 	UserAccessQuery
 	RoleDefinitionQuery
 	CustomRoleManager
+	CanvasAccessManager
 }
 
 type RoleDefinition struct {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/casbin/casbin/v2"
@@ -25,13 +26,18 @@ const (
 var _ Authorization = (*AuthService)(nil)
 
 type AuthService struct {
-	enforcer           *casbin.SyncedEnforcer
-	orgPolicyTemplates [][5]string
+	enforcer              *casbin.SyncedEnforcer
+	orgPolicyTemplates    [][5]string
+	canvasPolicyTemplates [][5]string
 }
 
 func NewAuthService() (*AuthService, error) {
 	modelPath := os.Getenv("RBAC_MODEL_PATH")
 	orgPolicyPath := os.Getenv("RBAC_ORG_POLICY_PATH")
+	canvasPolicyPath := os.Getenv("RBAC_CANVAS_POLICY_PATH")
+	if canvasPolicyPath == "" {
+		canvasPolicyPath = filepath.Join(filepath.Dir(orgPolicyPath), "rbac_canvas_policy.csv")
+	}
 
 	adapter, err := gormadapter.NewTransactionalAdapterByDB(database.Conn())
 	if err != nil {
@@ -60,9 +66,20 @@ func NewAuthService() (*AuthService, error) {
 		return nil, fmt.Errorf("failed to parse org policies: %w", err)
 	}
 
+	canvasPoliciesCsv, err := os.ReadFile(canvasPolicyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read canvas policies: %w", err)
+	}
+
+	canvasPolicyTemplates, err := parsePoliciesFromCsv(canvasPoliciesCsv)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse canvas policies: %w", err)
+	}
+
 	service := &AuthService{
-		enforcer:           enforcer,
-		orgPolicyTemplates: orgPolicyTemplates,
+		enforcer:              enforcer,
+		orgPolicyTemplates:    orgPolicyTemplates,
+		canvasPolicyTemplates: canvasPolicyTemplates,
 	}
 
 	if err := service.loadDefaultPolicies(); err != nil {
@@ -900,7 +917,8 @@ func parsePoliciesFromCsv(content []byte) ([][5]string, error) {
 }
 
 func (a *AuthService) loadDefaultPolicies() error {
-	for _, policy := range a.orgPolicyTemplates {
+	templates := append(append([][5]string{}, a.orgPolicyTemplates...), a.canvasPolicyTemplates...)
+	for _, policy := range templates {
 		switch policy[0] {
 		case "g":
 			_, err := a.enforcer.AddGroupingPolicy(policy[1], policy[2], policy[3])
@@ -1150,6 +1168,10 @@ func (a *AuthService) getRoleDescription(roleName string) string {
 		models.RoleOrgViewer: models.DescOrgViewer,
 		models.RoleOrgAdmin:  models.DescOrgAdmin,
 		models.RoleOrgOwner:  models.DescOrgOwner,
+
+		models.RoleCanvasViewer:   models.DescCanvasViewer,
+		models.RoleCanvasOperator: models.DescCanvasOperator,
+		models.RoleCanvasEditor:   models.DescCanvasEditor,
 	}
 
 	if description, exists := descriptions[roleName]; exists {
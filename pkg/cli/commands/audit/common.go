@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type Filters struct {
+	ActorID      string
+	ResourceType string
+	ResourceID   string
+	Action       string
+	Since        string
+}
+
+func newListRequest(ctx core.CommandContext, filters *Filters, before string) (openapi_client.ApiOrganizationsListAuditEventsRequest, error) {
+	me, _, err := ctx.API.MeAPI.MeMe(ctx.Context).Execute()
+	if err != nil {
+		return openapi_client.ApiOrganizationsListAuditEventsRequest{}, err
+	}
+	if !me.HasOrganizationId() {
+		return openapi_client.ApiOrganizationsListAuditEventsRequest{}, fmt.Errorf("organization id not found for authenticated user")
+	}
+
+	request := ctx.API.OrganizationAPI.OrganizationsListAuditEvents(ctx.Context, me.GetOrganizationId())
+	if filters.ActorID != "" {
+		request = request.ActorId(filters.ActorID)
+	}
+
+	if filters.ResourceType != "" {
+		request = request.ResourceType(filters.ResourceType)
+	}
+
+	if filters.ResourceID != "" {
+		request = request.ResourceId(filters.ResourceID)
+	}
+
+	if filters.Action != "" {
+		request = request.Action(filters.Action)
+	}
+
+	if filters.Since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, filters.Since)
+		if err != nil {
+			return request, fmt.Errorf("invalid --since value %q: expected RFC3339 timestamp", filters.Since)
+		}
+		request = request.Since(sinceTime)
+	}
+
+	if before != "" {
+		beforeTime, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return request, fmt.Errorf("invalid --before value %q: expected RFC3339 timestamp", before)
+		}
+		request = request.Before(beforeTime)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+const exportPageSize = 500
+
+type ExportAuditEventsCommand struct {
+	Filters *Filters
+	Before  *string
+	File    *string
+}
+
+func (c *ExportAuditEventsCommand) Execute(ctx core.CommandContext) error {
+	request, err := newListRequest(ctx, c.Filters, *c.Before)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = ctx.Cmd.OutOrStdout()
+	if c.File != nil && *c.File != "" {
+		file, err := os.Create(*c.File)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", *c.File, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	//
+	// Events are written one JSON object per line,
+	// paging through the audit log from the most recent event.
+	//
+	encoder := json.NewEncoder(out)
+	count := 0
+	request = request.Limit(exportPageSize)
+	for {
+		response, _, err := request.Execute()
+		if err != nil {
+			return err
+		}
+
+		for _, event := range response.GetEvents() {
+			if err := encoder.Encode(event); err != nil {
+				return err
+			}
+			count++
+		}
+
+		if !response.GetHasNextPage() || !response.HasLastTimestamp() {
+			break
+		}
+
+		request = request.Before(response.GetLastTimestamp())
+	}
+
+	if c.File == nil || *c.File == "" || !ctx.Renderer.IsText() {
+		return nil
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Exported %d audit events to %s\n", count, *c.File)
+		return err
+	})
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListAuditEventsCommand struct {
+	Filters *Filters
+	Limit   *int64
+	Before  *string
+}
+
+func (c *ListAuditEventsCommand) Execute(ctx core.CommandContext) error {
+	request, err := newListRequest(ctx, c.Filters, *c.Before)
+	if err != nil {
+		return err
+	}
+
+	if c.Limit != nil && *c.Limit > 0 {
+		request = request.Limit(*c.Limit)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "CREATED_AT\tACTOR\tACTION\tRESOURCE_TYPE\tRESOURCE_ID\tSTATUS")
+		for _, event := range response.GetEvents() {
+			actor := event.GetActorEmail()
+			if actor == "" {
+				actor = event.GetActorId()
+			}
+
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%s\t%s\t%s\n",
+				event.GetCreatedAt().Format(time.RFC3339),
+				actor,
+				event.GetAction(),
+				event.GetResourceType(),
+				event.GetResourceId(),
+				event.GetStatus(),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+func NewCommand(options core.BindOptions) *cobra.Command {
+	var filters Filters
+	var limit int64
+	var before string
+	var output string
+
+	root := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the audit log of the organization",
+	}
+
+	//
+	// List command
+	//
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the most recent audit events",
+		Args:  cobra.NoArgs,
+	}
+	bindFilterFlags(listCmd, &filters)
+	listCmd.Flags().Int64Var(&limit, "limit", 20, "maximum number of items to return")
+	listCmd.Flags().StringVar(&before, "before", "", "return items before this timestamp (RFC3339)")
+	core.Bind(listCmd, &ListAuditEventsCommand{
+		Filters: &filters,
+		Limit:   &limit,
+		Before:  &before,
+	}, options)
+
+	//
+	// Export command
+	//
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export audit events as JSON lines",
+		Args:  cobra.NoArgs,
+	}
+	bindFilterFlags(exportCmd, &filters)
+	exportCmd.Flags().StringVar(&before, "before", "", "export items before this timestamp (RFC3339)")
+	exportCmd.Flags().StringVarP(&output, "file", "f", "", "file to write the events to, defaults to stdout")
+	core.Bind(exportCmd, &ExportAuditEventsCommand{
+		Filters: &filters,
+		Before:  &before,
+		File:    &output,
+	}, options)
+
+	root.AddCommand(listCmd)
+	root.AddCommand(exportCmd)
+
+	return root
+}
+
+func bindFilterFlags(cmd *cobra.Command, filters *Filters) {
+	cmd.Flags().StringVar(&filters.ActorID, "actor-id", "", "only return changes made by this user")
+	cmd.Flags().StringVar(&filters.ResourceType, "resource-type", "", "only return changes to this type of resource, e.g. canvases")
+	cmd.Flags().StringVar(&filters.ResourceID, "resource-id", "", "only return changes to this resource")
+	cmd.Flags().StringVar(&filters.Action, "action", "", "only return changes made with this action, e.g. update")
+	cmd.Flags().StringVar(&filters.Since, "since", "", "only return changes made after this timestamp (RFC3339)")
+}
@@ -0,0 +1,194 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/commands/canvases/models"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type exportCommand struct {
+	file *string
+}
+
+func (c *exportCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = ctx.Args[0]
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesExportCanvasBundle(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	resource := models.CanvasBundleResourceFromBundle(response.GetBundle())
+	file := strings.TrimSpace(*c.file)
+	if file == "" {
+		if !ctx.Renderer.IsText() {
+			return ctx.Renderer.Render(resource)
+		}
+
+		return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			data, err := yaml.Marshal(resource)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprint(stdout, string(data))
+			return err
+		})
+	}
+
+	data, err := yaml.Marshal(resource)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(file, data, 0600); err != nil {
+		return fmt.Errorf("failed to write canvas bundle: %w", err)
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(map[string]string{"file": file})
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Canvas bundle written to %s\n", file)
+		return err
+	})
+}
+
+type importCommand struct {
+	file         *string
+	name         *string
+	integrations *[]string
+	dryRun       *bool
+}
+
+func (c *importCommand) Execute(ctx core.CommandContext) error {
+	file := strings.TrimSpace(*c.file)
+	if file == "" {
+		return fmt.Errorf("--file is required")
+	}
+
+	// #nosec
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read resource file: %w", err)
+	}
+
+	resource, err := models.ParseCanvasBundle(data)
+	if err != nil {
+		return err
+	}
+
+	integrationIDs, err := parseIntegrationMappings(*c.integrations)
+	if err != nil {
+		return err
+	}
+
+	request := openapi_client.CanvasesImportCanvasBundleRequest{}
+	request.SetBundle(*resource.Spec)
+	request.SetIntegrationIds(integrationIDs)
+	request.SetDryRun(*c.dryRun)
+	if name := strings.TrimSpace(*c.name); name != "" {
+		request.SetName(name)
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesImportCanvasBundle(ctx.Context).Body(request).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		if err := ctx.Renderer.Render(response); err != nil {
+			return err
+		}
+	} else {
+		err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+			return renderImportResponse(stdout, response)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(response.GetUnresolved()) > 0 {
+		return fmt.Errorf("canvas bundle has %d unresolved dependencies", len(response.GetUnresolved()))
+	}
+
+	return nil
+}
+
+// parseIntegrationMappings parses --integration values,
+// given as <bundle-integration-id>=<integration-id>.
+func parseIntegrationMappings(values []string) (map[string]string, error) {
+	mappings := map[string]string{}
+	for _, value := range values {
+		from, to, ok := strings.Cut(value, "=")
+		from = strings.TrimSpace(from)
+		to = strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid integration mapping %q, expected <bundle-integration-id>=<integration-id>", value)
+		}
+
+		mappings[from] = to
+	}
+
+	return mappings, nil
+}
+
+func renderImportResponse(stdout io.Writer, response *openapi_client.CanvasesImportCanvasBundleResponse) error {
+	unresolved := response.GetUnresolved()
+	if len(unresolved) > 0 {
+		_, _ = fmt.Fprintln(stdout, "Unresolved dependencies:")
+		for _, dependency := range unresolved {
+			kind := strings.ToLower(strings.TrimPrefix(string(dependency.GetType()), "TYPE_"))
+			_, _ = fmt.Fprintf(stdout, "  %s %s (%s): %s\n", kind, dependency.GetName(), dependency.GetId(), dependency.GetMessage())
+		}
+
+		_, err := fmt.Fprintln(stdout, "Map integrations with --integration <bundle-integration-id>=<integration-id>, and create missing secrets before importing again.")
+		return err
+	}
+
+	renderMappings(stdout, "Integrations", response.GetIntegrationIds())
+	renderMappings(stdout, "Blueprints", response.GetBlueprintIds())
+
+	if !response.HasCanvas() {
+		_, err := fmt.Fprintln(stdout, "All dependencies resolved, nothing was created.")
+		return err
+	}
+
+	canvas := response.GetCanvas()
+	_, err := fmt.Fprintf(stdout, "Canvas %s imported (ID: %s)\n", canvas.Metadata.GetName(), canvas.Metadata.GetId())
+	return err
+}
+
+func renderMappings(stdout io.Writer, title string, mappings map[string]string) {
+	if len(mappings) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(mappings))
+	for key := range mappings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	_, _ = fmt.Fprintf(stdout, "%s:\n", title)
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(stdout, "  %s -> %s\n", key, mappings[key])
+	}
+}
@@ -0,0 +1,23 @@
+package canvases
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIntegrationMappings(t *testing.T) {
+	mappings, err := parseIntegrationMappings([]string{"a=b", " c = d "})
+	if err != nil {
+		t.Fatalf("parseIntegrationMappings returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(mappings, map[string]string{"a": "b", "c": "d"}) {
+		t.Fatalf("unexpected mappings %v", mappings)
+	}
+
+	for _, value := range []string{"a", "a=", "=b"} {
+		if _, err := parseIntegrationMappings([]string{value}); err == nil {
+			t.Fatalf("expected an error for %q", value)
+		}
+	}
+}
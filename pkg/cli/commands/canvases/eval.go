@@ -0,0 +1,134 @@
+package canvases
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type evalCommand struct {
+	expression  *string
+	file        *string
+	eventID     *string
+	executionID *string
+}
+
+func (c *evalCommand) Execute(ctx core.CommandContext) error {
+	nodeID := strings.TrimSpace(ctx.Args[0])
+	target := ""
+	if len(ctx.Args) == 2 {
+		target = strings.TrimSpace(ctx.Args[1])
+	}
+
+	expression := strings.TrimSpace(*c.expression)
+	file := strings.TrimSpace(*c.file)
+	if (expression == "") == (file == "") {
+		return fmt.Errorf("either --expression or --file is required")
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	body := openapi_client.CanvasesEvaluateExpressionBody{}
+	if expression != "" {
+		body.SetExpression(expression)
+	} else {
+		configuration, err := loadEvalConfiguration(file)
+		if err != nil {
+			return err
+		}
+		body.SetConfiguration(configuration)
+	}
+
+	if eventID := strings.TrimSpace(*c.eventID); eventID != "" {
+		body.SetEventId(eventID)
+	}
+
+	if executionID := strings.TrimSpace(*c.executionID); executionID != "" {
+		body.SetExecutionId(executionID)
+	}
+
+	response, _, err := ctx.API.CanvasAPI.
+		CanvasesEvaluateExpression(ctx.Context, canvasID, nodeID).
+		Body(body).
+		Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	err = ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		if response.HasConfiguration() {
+			data, err := yaml.Marshal(response.GetConfiguration())
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprint(stdout, string(data))
+		} else if len(response.GetErrors()) == 0 {
+			_, _ = fmt.Fprintln(stdout, formatEvalValue(response.GetValue()))
+		}
+
+		if len(response.GetReferencedNodes()) > 0 {
+			_, _ = fmt.Fprintf(stdout, "\nReferenced nodes: %s\n", strings.Join(response.GetReferencedNodes(), ", "))
+		}
+
+		if len(response.GetErrors()) > 0 {
+			_, _ = fmt.Fprintln(stdout, "\nErrors:")
+			for _, e := range response.GetErrors() {
+				_, _ = fmt.Fprintf(stdout, "  - %s\n", e)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if len(response.GetErrors()) > 0 {
+		return fmt.Errorf("expression has %d error(s)", len(response.GetErrors()))
+	}
+
+	return nil
+}
+
+// loadEvalConfiguration reads a node configuration from a YAML or JSON file.
+func loadEvalConfiguration(path string) (map[string]interface{}, error) {
+	// #nosec
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	configuration := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file: %w", err)
+	}
+
+	return configuration, nil
+}
+
+func formatEvalValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
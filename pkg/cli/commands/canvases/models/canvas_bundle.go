@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CanvasBundleKind = "CanvasBundle"
+)
+
+type CanvasBundle struct {
+	APIVersion string                               `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string                               `json:"kind" yaml:"kind"`
+	Spec       *openapi_client.CanvasesCanvasBundle `json:"spec" yaml:"spec"`
+}
+
+func ParseCanvasBundle(raw []byte) (*CanvasBundle, error) {
+	var yamlObject any
+	if err := yaml.Unmarshal(raw, &yamlObject); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas bundle resource: %w", err)
+	}
+
+	jsonData, err := json.Marshal(yamlObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert canvas bundle resource to json: %w", err)
+	}
+
+	var resource CanvasBundle
+	if err := json.Unmarshal(jsonData, &resource); err != nil {
+		return nil, fmt.Errorf("failed to parse canvas bundle json payload: %w", err)
+	}
+
+	if resource.Kind != CanvasBundleKind {
+		return nil, fmt.Errorf("unsupported resource kind %q", resource.Kind)
+	}
+
+	if resource.APIVersion == "" {
+		return nil, fmt.Errorf("canvas bundle apiVersion is required")
+	}
+
+	if resource.Spec == nil || resource.Spec.Canvas == nil {
+		return nil, fmt.Errorf("canvas bundle spec.canvas is required")
+	}
+
+	return &resource, nil
+}
+
+func CanvasBundleResourceFromBundle(bundle openapi_client.CanvasesCanvasBundle) CanvasBundle {
+	return CanvasBundle{
+		APIVersion: "v1",
+		Kind:       CanvasBundleKind,
+		Spec:       &bundle,
+	}
+}
@@ -0,0 +1,69 @@
+package models
+
+import "testing"
+
+func TestParseCanvasBundle(t *testing.T) {
+	raw := []byte(`
+apiVersion: v1
+kind: CanvasBundle
+spec:
+  formatVersion: 1
+  canvas:
+    metadata:
+      name: release
+    spec:
+      nodes:
+        - id: deploy
+          name: deploy
+          type: TYPE_COMPONENT
+          component:
+            name: github.runWorkflow
+          integration:
+            id: 0b0d7b6c-6a51-4b7a-9d8e-0f3c2b1a9e11
+  integrations:
+    - id: 0b0d7b6c-6a51-4b7a-9d8e-0f3c2b1a9e11
+      name: GitHub
+      integrationName: github
+  secrets:
+    - name: deploy
+      keys:
+        - token
+`)
+
+	resource, err := ParseCanvasBundle(raw)
+	if err != nil {
+		t.Fatalf("ParseCanvasBundle returned error: %v", err)
+	}
+
+	bundle := resource.Spec
+	if bundle.GetFormatVersion() != 1 {
+		t.Fatalf("expected formatVersion=1, got %d", bundle.GetFormatVersion())
+	}
+
+	canvas := bundle.GetCanvas()
+	if canvas.Metadata.GetName() != "release" {
+		t.Fatalf("expected canvas name release, got %q", canvas.Metadata.GetName())
+	}
+
+	integrations := bundle.GetIntegrations()
+	if len(integrations) != 1 || integrations[0].GetIntegrationName() != "github" {
+		t.Fatalf("expected one github integration, got %v", integrations)
+	}
+
+	secrets := bundle.GetSecrets()
+	if len(secrets) != 1 || secrets[0].GetName() != "deploy" {
+		t.Fatalf("expected the deploy secret, got %v", secrets)
+	}
+}
+
+func TestParseCanvasBundleRequiresCanvas(t *testing.T) {
+	_, err := ParseCanvasBundle([]byte("apiVersion: v1\nkind: CanvasBundle\nspec:\n  formatVersion: 1\n"))
+	if err == nil {
+		t.Fatal("expected an error for a bundle without a canvas")
+	}
+
+	_, err = ParseCanvasBundle([]byte("apiVersion: v1\nkind: Canvas\nmetadata:\n  name: release\n"))
+	if err == nil {
+		t.Fatal("expected an error for a canvas resource")
+	}
+}
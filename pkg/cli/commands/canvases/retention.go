@@ -0,0 +1,89 @@
+package canvases
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+type retentionCommand struct{}
+
+func (c *retentionCommand) Execute(ctx core.CommandContext) error {
+	target := ""
+	if len(ctx.Args) == 1 {
+		target = strings.TrimSpace(ctx.Args[0])
+	}
+
+	canvasID, err := resolveCanvasTargetFromOptionalArg(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasAPI.CanvasesGetCanvasRetentionReport(ctx.Context, canvasID).Execute()
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		policy := response.GetRetentionPolicy()
+		_, _ = fmt.Fprintf(stdout, "Policy: %s\n", retentionPolicySourceLabel(response.GetSource()))
+		_, _ = fmt.Fprintf(stdout, "Max age: %s\n", retentionDaysLabel(policy.GetMaxAgeDays()))
+		_, _ = fmt.Fprintf(stdout, "Max executions per node: %s\n", retentionCountLabel(policy.GetMaxExecutionsPerNode()))
+		_, _ = fmt.Fprintf(stdout, "Failed executions max age: %s\n", retentionDaysLabel(policy.GetFailedMaxAgeDays()))
+		_, _ = fmt.Fprintf(
+			stdout,
+			"Would delete: %d executions, %d events, %d queue items\n",
+			response.GetExecutions(),
+			response.GetEvents(),
+			response.GetQueueItems(),
+		)
+
+		if len(response.GetNodes()) == 0 {
+			return nil
+		}
+
+		_, _ = fmt.Fprintln(stdout)
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "NODE\tEXECUTIONS\tEVENTS\tQUEUE_ITEMS")
+		for _, node := range response.GetNodes() {
+			_, _ = fmt.Fprintf(writer, "%s\t%d\t%d\t%d\n", node.GetNodeId(), node.GetExecutions(), node.GetEvents(), node.GetQueueItems())
+		}
+
+		return writer.Flush()
+	})
+}
+
+func retentionPolicySourceLabel(source openapi_client.RetentionPolicySource) string {
+	switch source {
+	case openapi_client.RETENTIONPOLICYSOURCE_SOURCE_CANVAS:
+		return "canvas"
+	case openapi_client.RETENTIONPOLICYSOURCE_SOURCE_ORGANIZATION:
+		return "organization"
+	default:
+		return "none (everything is kept)"
+	}
+}
+
+func retentionDaysLabel(days int32) string {
+	if days == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%d days", days)
+}
+
+func retentionCountLabel(count int32) string {
+	if count == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%d", count)
+}
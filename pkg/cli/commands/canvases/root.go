@@ -67,6 +67,68 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		autoLayoutNodes: &updateAutoLayoutNodes,
 	}, options)
 
+	retentionCmd := &cobra.Command{
+		Use:   "retention [name-or-id]",
+		Short: "Show the retention policy of a canvas, and what it would delete right now",
+		Args:  cobra.MaximumNArgs(1),
+	}
+	core.Bind(retentionCmd, &retentionCommand{}, options)
+
+	var evalExpression string
+	var evalFile string
+	var evalEventID string
+	var evalExecutionID string
+	evalCmd := &cobra.Command{
+		Use:   "eval <node-id> [name-or-id]",
+		Short: "Evaluate an expression or a configuration for a node",
+		Long: "Resolves an expression, or a whole node configuration, the way the node would when running, " +
+			"against an existing event or execution. Nothing is emitted or stored.",
+		Args: cobra.RangeArgs(1, 2),
+	}
+	evalCmd.Flags().StringVarP(&evalExpression, "expression", "e", "", "expression or template to evaluate")
+	evalCmd.Flags().StringVarP(&evalFile, "file", "f", "", "YAML or JSON file with a node configuration to resolve")
+	evalCmd.Flags().StringVar(&evalEventID, "event-id", "", "event to evaluate against")
+	evalCmd.Flags().StringVar(&evalExecutionID, "execution-id", "", "execution of the node to evaluate against")
+	core.Bind(evalCmd, &evalCommand{
+		expression:  &evalExpression,
+		file:        &evalFile,
+		eventID:     &evalEventID,
+		executionID: &evalExecutionID,
+	}, options)
+
+	var exportFile string
+	exportCmd := &cobra.Command{
+		Use:   "export [name-or-id]",
+		Short: "Export a canvas as a bundle",
+		Long: "Writes a bundle with the canvas, the blueprints it uses, and the integrations and secrets it needs, " +
+			"which can be imported into another organization or instance. Secret values are not exported.",
+		Args: cobra.MaximumNArgs(1),
+	}
+	exportCmd.Flags().StringVarP(&exportFile, "file", "f", "", "file to write the bundle to (defaults to stdout)")
+	core.Bind(exportCmd, &exportCommand{file: &exportFile}, options)
+
+	var importFile string
+	var importName string
+	var importIntegrations []string
+	var importDryRun bool
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import a canvas from a bundle",
+		Long: "Creates a canvas from a bundle written by export. Integrations are matched by name and type, " +
+			"unless mapped with --integration. Nothing is created if a dependency cannot be resolved.",
+		Args: cobra.NoArgs,
+	}
+	importCmd.Flags().StringVarP(&importFile, "file", "f", "", "bundle file to import")
+	importCmd.Flags().StringVar(&importName, "name", "", "name of the imported canvas (defaults to the bundle one)")
+	importCmd.Flags().StringArrayVar(&importIntegrations, "integration", nil, "map a bundle integration to an integration: <bundle-integration-id>=<integration-id> (repeatable)")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "only resolve the dependencies, without creating anything")
+	core.Bind(importCmd, &importCommand{
+		file:         &importFile,
+		name:         &importName,
+		integrations: &importIntegrations,
+		dryRun:       &importDryRun,
+	}, options)
+
 	var changeRequestsListStatusFilter string
 	var changeRequestsListOnlyMine bool
 	var changeRequestsListQuery string
@@ -201,6 +263,10 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	root.AddCommand(activeCmd)
 	root.AddCommand(createCmd)
 	root.AddCommand(updateCmd)
+	root.AddCommand(retentionCmd)
+	root.AddCommand(evalCmd)
+	root.AddCommand(exportCmd)
+	root.AddCommand(importCmd)
 	root.AddCommand(changeRequestsCmd)
 
 	return root
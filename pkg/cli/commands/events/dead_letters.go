@@ -0,0 +1,97 @@
+package events
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+)
+
+type ListDeadLettersCommand struct {
+	CanvasID *string
+	Limit    *int64
+	Before   *string
+}
+
+func (c *ListDeadLettersCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	request := ctx.API.CanvasEventAPI.
+		CanvasesListDeadLetters(ctx.Context, canvasID)
+
+	if c.Limit != nil && *c.Limit > 0 {
+		request = request.Limit(*c.Limit)
+	}
+
+	if c.Before != nil && *c.Before != "" {
+		beforeTime, err := time.Parse(time.RFC3339, *c.Before)
+		if err != nil {
+			return fmt.Errorf("invalid --before value %q: expected RFC3339 timestamp", *c.Before)
+		}
+		request = request.Before(beforeTime)
+	}
+
+	response, _, err := request.Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		writer := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		_, _ = fmt.Fprintln(writer, "ID\tTYPE\tNODE_ID\tATTEMPTS\tFAILURE_REASON\tCREATED_AT")
+		for _, deadLetter := range response.GetDeadLetters() {
+			_, _ = fmt.Fprintf(
+				writer,
+				"%s\t%s\t%s\t%d\t%s\t%s\n",
+				deadLetter.GetId(),
+				deadLetter.GetType(),
+				deadLetter.GetNodeId(),
+				deadLetter.GetAttempts(),
+				deadLetter.GetFailureReason(),
+				deadLetter.GetCreatedAt().Format(time.RFC3339),
+			)
+		}
+
+		return writer.Flush()
+	})
+}
+
+type ReplayDeadLetterCommand struct {
+	CanvasID *string
+	ID       *string
+}
+
+func (c *ReplayDeadLetterCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ctx.API.CanvasEventAPI.
+		CanvasesReplayDeadLetter(ctx.Context, canvasID, *c.ID).
+		Body(map[string]any{}).
+		Execute()
+
+	if err != nil {
+		return err
+	}
+
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		_, err := fmt.Fprintf(stdout, "Dead letter replayed: %s\n", *c.ID)
+		return err
+	})
+}
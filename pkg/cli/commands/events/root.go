@@ -11,6 +11,7 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var eventID string
 	var limit int64
 	var before string
+	var deadLetterID string
 
 	root := &cobra.Command{
 		Use:     "events",
@@ -53,8 +54,41 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		EventID:  &eventID,
 	}, options)
 
+	//
+	// Dead letters command
+	//
+	deadLettersCmd := &cobra.Command{
+		Use:   "dead-letters",
+		Short: "List events and queue items that could not be processed",
+		Args:  cobra.NoArgs,
+	}
+	deadLettersCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	deadLettersCmd.Flags().Int64Var(&limit, "limit", 20, "maximum number of items to return")
+	deadLettersCmd.Flags().StringVar(&before, "before", "", "return items before this timestamp (RFC3339)")
+	core.Bind(deadLettersCmd, &ListDeadLettersCommand{
+		CanvasID: &canvasID,
+		Limit:    &limit,
+		Before:   &before,
+	}, options)
+
+	replayDeadLetterCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay a dead-lettered event or queue item",
+		Args:  cobra.NoArgs,
+	}
+	replayDeadLetterCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	replayDeadLetterCmd.Flags().StringVar(&deadLetterID, "id", "", "dead letter ID")
+	_ = replayDeadLetterCmd.MarkFlagRequired("id")
+	core.Bind(replayDeadLetterCmd, &ReplayDeadLetterCommand{
+		CanvasID: &canvasID,
+		ID:       &deadLetterID,
+	}, options)
+
+	deadLettersCmd.AddCommand(replayDeadLetterCmd)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(listExecutionsCmd)
+	root.AddCommand(deadLettersCmd)
 
 	return root
 }
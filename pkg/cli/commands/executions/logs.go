@@ -0,0 +1,140 @@
+package executions
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/cli/core"
+	"github.com/superplanehq/superplane/pkg/openapi_client"
+)
+
+const logsFollowInterval = 2 * time.Second
+
+type ExecutionLogsCommand struct {
+	CanvasID    *string
+	ExecutionID *string
+	Limit       *int64
+	After       *string
+	Follow      *bool
+}
+
+func (c *ExecutionLogsCommand) Execute(ctx core.CommandContext) error {
+	canvasID, err := core.ResolveCanvasID(ctx, *c.CanvasID)
+	if err != nil {
+		return err
+	}
+
+	var after *time.Time
+	if c.After != nil && *c.After != "" {
+		afterTime, err := time.Parse(time.RFC3339, *c.After)
+		if err != nil {
+			return fmt.Errorf("invalid --after value %q: expected RFC3339 timestamp", *c.After)
+		}
+		after = &afterTime
+	}
+
+	for {
+		response, err := c.fetch(ctx, canvasID, after)
+		if err != nil {
+			return err
+		}
+
+		err = c.render(ctx, response)
+		if err != nil {
+			return err
+		}
+
+		if response.LastTimestamp != nil {
+			after = response.LastTimestamp
+		}
+
+		if response.GetHasNextPage() {
+			continue
+		}
+
+		//
+		// Without --follow, only one page is returned.
+		// With it, we keep polling for new log lines until the execution finishes.
+		//
+		if c.Follow == nil || !*c.Follow {
+			return nil
+		}
+
+		if response.GetExecutionState() == openapi_client.CANVASESCANVASNODEEXECUTIONSTATE_STATE_FINISHED {
+			return nil
+		}
+
+		select {
+		case <-ctx.Context.Done():
+			return ctx.Context.Err()
+		case <-time.After(logsFollowInterval):
+		}
+	}
+}
+
+func (c *ExecutionLogsCommand) fetch(ctx core.CommandContext, canvasID string, after *time.Time) (*openapi_client.CanvasesGetExecutionLogsResponse, error) {
+	request := ctx.API.CanvasNodeExecutionAPI.
+		CanvasesGetExecutionLogs(ctx.Context, canvasID, *c.ExecutionID)
+
+	if c.Limit != nil && *c.Limit > 0 {
+		request = request.Limit(*c.Limit)
+	}
+
+	if after != nil {
+		request = request.After(*after)
+	}
+
+	response, _, err := request.Execute()
+	return response, err
+}
+
+func (c *ExecutionLogsCommand) render(ctx core.CommandContext, response *openapi_client.CanvasesGetExecutionLogsResponse) error {
+	if !ctx.Renderer.IsText() {
+		return ctx.Renderer.Render(response)
+	}
+
+	return ctx.Renderer.RenderText(func(stdout io.Writer) error {
+		for _, line := range response.GetLogs() {
+			_, err := fmt.Fprintf(
+				stdout,
+				"%s %-5s %s%s\n",
+				line.GetCreatedAt().Format(time.RFC3339),
+				formatLogLevel(line.GetLevel()),
+				line.GetMessage(),
+				formatLogFields(line.GetFields()),
+			)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func formatLogLevel(level openapi_client.CanvasNodeExecutionLogLevel) string {
+	return strings.ToUpper(strings.TrimPrefix(string(level), "LEVEL_"))
+}
+
+func formatLogFields(fields map[string]any) string {
+	if len(fields) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", key, fields[key]))
+	}
+
+	return " " + strings.Join(parts, " ")
+}
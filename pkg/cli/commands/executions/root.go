@@ -11,6 +11,8 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 	var executionID string
 	var limit int64
 	var before string
+	var after string
+	var follow bool
 
 	root := &cobra.Command{
 		Use:     "executions",
@@ -48,8 +50,42 @@ func NewCommand(options core.BindOptions) *cobra.Command {
 		ExecutionID: &executionID,
 	}, options)
 
+	rerunCmd := &cobra.Command{
+		Use:   "rerun",
+		Short: "Rerun an execution and everything downstream of it",
+		Args:  cobra.NoArgs,
+	}
+	rerunCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	rerunCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	_ = rerunCmd.MarkFlagRequired("execution-id")
+	core.Bind(rerunCmd, &RerunExecutionCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+	}, options)
+
+	logsCmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Show the logs of an execution",
+		Args:  cobra.NoArgs,
+	}
+	logsCmd.Flags().StringVar(&canvasID, "canvas-id", "", "canvas ID")
+	logsCmd.Flags().StringVar(&executionID, "execution-id", "", "execution ID")
+	logsCmd.Flags().Int64Var(&limit, "limit", 100, "maximum number of log lines to return per page")
+	logsCmd.Flags().StringVar(&after, "after", "", "return log lines after this timestamp (RFC3339)")
+	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep printing new log lines until the execution finishes")
+	_ = logsCmd.MarkFlagRequired("execution-id")
+	core.Bind(logsCmd, &ExecutionLogsCommand{
+		CanvasID:    &canvasID,
+		ExecutionID: &executionID,
+		Limit:       &limit,
+		After:       &after,
+		Follow:      &follow,
+	}, options)
+
 	root.AddCommand(listCmd)
 	root.AddCommand(cancelCmd)
+	root.AddCommand(rerunCmd)
+	root.AddCommand(logsCmd)
 
 	return root
 }
@@ -89,10 +89,7 @@ func renderSecretListText(stdout io.Writer, items []openapi_client.SecretsSecret
 		metadata := item.GetMetadata()
 		spec := item.GetSpec()
 
-		keyCount := 0
-		if local, ok := spec.GetLocalOk(); ok && local.HasData() {
-			keyCount = len(local.GetData())
-		}
+		keyCount := len(secretKeys(spec))
 
 		createdAt := ""
 		if metadata.HasCreatedAt() {
@@ -126,17 +123,32 @@ func renderSecretText(stdout io.Writer, item openapi_client.SecretsSecret) error
 		_, _ = fmt.Fprintf(stdout, "CreatedAt: %s\n", metadata.GetCreatedAt().Format(time.RFC3339))
 	}
 
+	if vault, ok := spec.GetVaultOk(); ok {
+		_, _ = fmt.Fprintf(stdout, "VaultAddress: %s\n", vault.GetAddress())
+		_, _ = fmt.Fprintf(stdout, "VaultPath: %s/%s\n", vault.GetMount(), vault.GetPath())
+		_, _ = fmt.Fprintf(stdout, "VaultAuthMethod: %s\n", vault.GetAuthMethod())
+	}
+
 	_, _ = fmt.Fprintln(stdout, "Keys:")
+	for _, key := range secretKeys(spec) {
+		_, _ = fmt.Fprintf(stdout, "- %s\n", key)
+	}
+
+	return nil
+}
+
+func secretKeys(spec openapi_client.SecretsSecretSpec) []string {
 	keys := make([]string, 0)
 	if local, ok := spec.GetLocalOk(); ok && local.HasData() {
 		for key := range local.GetData() {
 			keys = append(keys, key)
 		}
 	}
-	sort.Strings(keys)
-	for _, key := range keys {
-		_, _ = fmt.Fprintf(stdout, "- %s\n", key)
+
+	if vault, ok := spec.GetVaultOk(); ok {
+		keys = append(keys, vault.GetKeys()...)
 	}
 
-	return nil
+	sort.Strings(keys)
+	return keys
 }
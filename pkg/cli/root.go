@@ -10,6 +10,7 @@ import (
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	audit "github.com/superplanehq/superplane/pkg/cli/commands/audit"
 	canvases "github.com/superplanehq/superplane/pkg/cli/commands/canvases"
 	events "github.com/superplanehq/superplane/pkg/cli/commands/events"
 	executions "github.com/superplanehq/superplane/pkg/cli/commands/executions"
@@ -51,6 +52,7 @@ func init() {
 	RootCmd.PersistentFlags().StringVarP(&OutputFormat, "output", "o", "", "output format: text|json|yaml (overrides config output)")
 
 	options := defaultBindOptions()
+	RootCmd.AddCommand(audit.NewCommand(options))
 	RootCmd.AddCommand(canvases.NewCommand(options))
 	RootCmd.AddCommand(executions.NewCommand(options))
 	RootCmd.AddCommand(events.NewCommand(options))
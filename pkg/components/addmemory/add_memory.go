@@ -3,7 +3,9 @@ package addmemory
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
@@ -15,6 +17,12 @@ import (
 const ComponentName = "addMemory"
 const PayloadType = "memory.added"
 
+const (
+	TTLUnitMinutes = "minutes"
+	TTLUnitHours   = "hours"
+	TTLUnitDays    = "days"
+)
+
 func init() {
 	registry.RegisterComponent(ComponentName, &AddMemory{})
 }
@@ -25,6 +33,8 @@ type Spec struct {
 	Namespace string      `json:"namespace"`
 	Values    any         `json:"values,omitempty"`
 	ValueList []ValuePair `json:"valueList,omitempty"`
+	TTL       any         `json:"ttl,omitempty"`
+	TTLUnit   string      `json:"ttlUnit,omitempty"`
 }
 
 type ValuePair struct {
@@ -32,6 +42,10 @@ type ValuePair struct {
 	Value any    `json:"value"`
 }
 
+type canvasMemoryTTLContext interface {
+	AddWithTTL(namespace string, values any, ttl time.Duration) error
+}
+
 func (c *AddMemory) Name() string {
 	return ComponentName
 }
@@ -57,7 +71,13 @@ func (c *AddMemory) Documentation() string {
 
 1. Reads ` + "`namespace`" + ` and value fields from configuration
 2. Appends a new memory row for the current canvas
-3. Emits ` + "`memory.added`" + ` with the saved payload`
+3. Emits ` + "`memory.added`" + ` with the saved payload
+
+## Expiry
+
+When ` + "`Expire After`" + ` is set, the row is deleted once that time has passed.
+Otherwise, the default TTL of the namespace is used, if it has one.
+Expired rows are never returned by memory lookups, even before they are deleted.`
 }
 
 func (c *AddMemory) Icon() string {
@@ -112,6 +132,34 @@ func (c *AddMemory) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "ttl",
+			Label:       "Expire After",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Delete the record after this amount of time. The namespace default is used when not set.",
+			Togglable:   true,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "ttlUnit",
+			Label:       "Expiry Unit",
+			Type:        configuration.FieldTypeSelect,
+			Description: "Time unit for the expiry",
+			Default:     TTLUnitHours,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Minutes", Value: TTLUnitMinutes},
+						{Label: "Hours", Value: TTLUnitHours},
+						{Label: "Days", Value: TTLUnitDays},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -126,6 +174,11 @@ func (c *AddMemory) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("namespace is required")
 	}
 
+	ttl, err := parseTTL(spec.TTL, spec.TTLUnit)
+	if err != nil {
+		return err
+	}
+
 	values := buildValues(spec)
 	metadata := map[string]any{
 		"namespace": spec.Namespace,
@@ -140,7 +193,7 @@ func (c *AddMemory) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to set node metadata: %w", err)
 	}
 
-	if err := ctx.CanvasMemory.Add(spec.Namespace, values); err != nil {
+	if err := addMemory(ctx.CanvasMemory, spec.Namespace, values, ttl); err != nil {
 		return fmt.Errorf("failed to add canvas memory: %w", err)
 	}
 
@@ -158,6 +211,63 @@ func (c *AddMemory) Execute(ctx core.ExecutionContext) error {
 	)
 }
 
+func addMemory(memory core.CanvasMemoryContext, namespace string, values any, ttl time.Duration) error {
+	if ttl == 0 {
+		return memory.Add(namespace, values)
+	}
+
+	ttlCtx, ok := memory.(canvasMemoryTTLContext)
+	if !ok {
+		return fmt.Errorf("canvas memory expiry is not supported")
+	}
+
+	return ttlCtx.AddWithTTL(namespace, values, ttl)
+}
+
+// parseTTL returns zero when no TTL is configured,
+// so the default TTL of the namespace is used.
+func parseTTL(value any, unit string) (time.Duration, error) {
+	var amount int
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		amount = v
+	case int64:
+		amount = int(v)
+	case float64:
+		amount = int(v)
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return 0, nil
+		}
+
+		parsed, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("ttl is not a valid integer: %s", v)
+		}
+
+		amount = parsed
+	default:
+		return 0, fmt.Errorf("ttl must be an integer, got %T", value)
+	}
+
+	if amount <= 0 {
+		return 0, fmt.Errorf("ttl must be positive, got: %d", amount)
+	}
+
+	switch unit {
+	case TTLUnitMinutes:
+		return time.Duration(amount) * time.Minute, nil
+	case "", TTLUnitHours:
+		return time.Duration(amount) * time.Hour, nil
+	case TTLUnitDays:
+		return time.Duration(amount) * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid ttl unit: %s", unit)
+	}
+}
+
 func buildValues(spec Spec) any {
 	if len(spec.ValueList) == 0 {
 		return spec.Values
@@ -219,7 +329,21 @@ func (c *AddMemory) HandleAction(ctx core.ActionContext) error {
 }
 
 func (c *AddMemory) Setup(ctx core.SetupContext) error {
-	return nil
+	var spec Spec
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	//
+	// The TTL can be an expression,
+	// so it is only validated on execution.
+	//
+	if ttl, ok := spec.TTL.(string); ok && strings.Contains(ttl, "{{") {
+		return nil
+	}
+
+	_, err := parseTTL(spec.TTL, spec.TTLUnit)
+	return err
 }
 
 func (c *AddMemory) Cancel(ctx core.ExecutionContext) error {
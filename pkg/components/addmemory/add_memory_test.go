@@ -2,6 +2,7 @@ package addmemory
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -30,6 +31,16 @@ func (c *canvasMemoryContext) FindFirst(namespace string, matches map[string]any
 	return nil, c.err
 }
 
+type expiringCanvasMemoryContext struct {
+	canvasMemoryContext
+	ttl time.Duration
+}
+
+func (c *expiringCanvasMemoryContext) AddWithTTL(namespace string, values any, ttl time.Duration) error {
+	c.ttl = ttl
+	return c.Add(namespace, values)
+}
+
 func TestAddMemoryExecute(t *testing.T) {
 	t.Run("adds memory and emits payload", func(t *testing.T) {
 		component := &AddMemory{}
@@ -87,4 +98,66 @@ func TestAddMemoryExecute(t *testing.T) {
 		)
 	})
 
+	t.Run("adds memory with ttl", func(t *testing.T) {
+		component := &AddMemory{}
+		memoryCtx := &expiringCanvasMemoryContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "deploys",
+				"valueList": []map[string]any{{"name": "id", "value": "1"}},
+				"ttl":       2,
+				"ttlUnit":   TTLUnitDays,
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, memoryCtx.addCalls)
+		assert.Equal(t, 48*time.Hour, memoryCtx.ttl)
+	})
+
+	t.Run("ttl requires expiry support", func(t *testing.T) {
+		component := &AddMemory{}
+		memoryCtx := &canvasMemoryContext{}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "deploys",
+				"valueList": []map[string]any{{"name": "id", "value": "1"}},
+				"ttl":       "30",
+				"ttlUnit":   TTLUnitMinutes,
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.ErrorContains(t, err, "canvas memory expiry is not supported")
+		assert.Equal(t, 0, memoryCtx.addCalls)
+	})
+}
+
+func TestAddMemorySetup(t *testing.T) {
+	component := &AddMemory{}
+
+	assert.NoError(t, component.Setup(core.SetupContext{
+		Configuration: map[string]any{"namespace": "deploys"},
+	}))
+
+	assert.NoError(t, component.Setup(core.SetupContext{
+		Configuration: map[string]any{"namespace": "deploys", "ttl": "{{ $.ttl }}", "ttlUnit": TTLUnitHours},
+	}))
+
+	assert.ErrorContains(t, component.Setup(core.SetupContext{
+		Configuration: map[string]any{"namespace": "deploys", "ttl": 0, "ttlUnit": TTLUnitHours},
+	}), "ttl must be positive")
+
+	assert.ErrorContains(t, component.Setup(core.SetupContext{
+		Configuration: map[string]any{"namespace": "deploys", "ttl": 1, "ttlUnit": "weeks"},
+	}), "invalid ttl unit")
 }
@@ -0,0 +1,253 @@
+package canvasrun
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	ComponentName = "canvas.run"
+	PayloadType   = "canvas.run.finished"
+	PollAction    = "poll"
+	PollInterval  = 15 * time.Second
+
+	ChannelPassed = "passed"
+	ChannelFailed = "failed"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &CanvasRun{})
+}
+
+type CanvasRun struct{}
+
+type Spec struct {
+	Canvas  string         `json:"canvas" mapstructure:"canvas"`
+	Payload map[string]any `json:"payload" mapstructure:"payload"`
+}
+
+type Metadata struct {
+	CanvasID   string `json:"canvasId" mapstructure:"canvasId"`
+	RunID      string `json:"runId" mapstructure:"runId"`
+	StartedAt  string `json:"startedAt" mapstructure:"startedAt"`
+	FinishedAt string `json:"finishedAt,omitempty" mapstructure:"finishedAt"`
+	Result     string `json:"result,omitempty" mapstructure:"result"`
+}
+
+func (c *CanvasRun) Name() string {
+	return ComponentName
+}
+
+func (c *CanvasRun) Label() string {
+	return "Run Canvas"
+}
+
+func (c *CanvasRun) Description() string {
+	return "Run another canvas and wait for its result"
+}
+
+func (c *CanvasRun) Documentation() string {
+	return `The Run Canvas component starts a run of another canvas in the same organization, and waits for it to finish.
+
+## Use Cases
+
+- **Reusable workflows**: Keep shared processes, like deployments or notifications, in their own canvas and call them from many places
+- **Composing workflows**: Split large workflows into smaller canvases that are easier to maintain
+- **Independent ownership**: Let different teams own the canvases that are called as a step
+
+## How It Works
+
+1. The payload is emitted from the **Manual Run** trigger of the target canvas, starting a new run
+2. The component follows every execution started by that event in the target canvas
+3. Once nothing is left to run, the execution completes with the result of the run
+
+The target canvas must have exactly one Manual Run trigger, and a canvas cannot run itself.
+
+## Configuration
+
+- **Canvas**: ID of the canvas to run
+- **Payload**: Data emitted from the Manual Run trigger of the target canvas. Supports expressions.
+
+## Output Channels
+
+- **Passed**: Every execution in the target canvas passed
+- **Failed**: An execution in the target canvas failed or was cancelled, and was not retried
+
+## Output
+
+The component emits a payload with:
+- **canvas**: The ID of the target canvas
+- **run**: The ID, result and failure message of the run
+- **outputs**: Payloads emitted by the nodes without outgoing connections in the target canvas, by node ID
+
+Cancelling the execution does not stop the run in the target canvas.`
+}
+
+func (c *CanvasRun) Icon() string {
+	return "workflow"
+}
+
+func (c *CanvasRun) Color() string {
+	return "purple"
+}
+
+func (c *CanvasRun) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelPassed, Label: "Passed", Description: "Every execution in the target canvas passed"},
+		{Name: ChannelFailed, Label: "Failed", Description: "An execution in the target canvas failed"},
+	}
+}
+
+func (c *CanvasRun) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "canvas",
+			Label:       "Canvas",
+			Type:        configuration.FieldTypeString,
+			Required:    true,
+			Description: "ID of the canvas to run",
+		},
+		{
+			Name:        "payload",
+			Label:       "Payload",
+			Type:        configuration.FieldTypeObject,
+			Required:    false,
+			Description: "Data emitted from the Manual Run trigger of the target canvas",
+		},
+	}
+}
+
+func (c *CanvasRun) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if spec.Canvas == "" {
+		return fmt.Errorf("canvas is required")
+	}
+
+	if _, err := uuid.Parse(spec.Canvas); err != nil {
+		return fmt.Errorf("invalid canvas: %s", spec.Canvas)
+	}
+
+	return nil
+}
+
+func (c *CanvasRun) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (c *CanvasRun) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if spec.Canvas == "" {
+		return fmt.Errorf("canvas is required")
+	}
+
+	payload := spec.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	runID, err := ctx.Canvases.Start(spec.Canvas, payload)
+	if err != nil {
+		return fmt.Errorf("failed to start canvas run: %w", err)
+	}
+
+	err = ctx.Metadata.Set(Metadata{
+		CanvasID:  spec.Canvas,
+		RunID:     runID,
+		StartedAt: time.Now().Format(time.RFC3339),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return ctx.Requests.ScheduleActionCall(PollAction, map[string]any{}, PollInterval)
+}
+
+func (c *CanvasRun) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name: PollAction,
+		},
+	}
+}
+
+func (c *CanvasRun) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case PollAction:
+		return c.poll(ctx)
+	default:
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+}
+
+func (c *CanvasRun) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := Metadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	run, err := ctx.Canvases.Get(metadata.CanvasID, metadata.RunID)
+	if err != nil {
+		return err
+	}
+
+	if run.State == core.CanvasRunStateRunning {
+		return ctx.Requests.ScheduleActionCall(PollAction, map[string]any{}, PollInterval)
+	}
+
+	metadata.FinishedAt = time.Now().Format(time.RFC3339)
+	metadata.Result = run.State
+	if err := ctx.Metadata.Set(metadata); err != nil {
+		return err
+	}
+
+	channel := ChannelPassed
+	if run.State == core.CanvasRunStateFailed {
+		channel = ChannelFailed
+	}
+
+	return ctx.ExecutionState.Emit(channel, PayloadType, []any{
+		map[string]any{
+			"canvas": map[string]any{
+				"id": metadata.CanvasID,
+			},
+			"run": map[string]any{
+				"id":      run.ID,
+				"result":  run.State,
+				"message": run.Message,
+			},
+			"outputs": run.Outputs,
+		},
+	})
+}
+
+func (c *CanvasRun) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (c *CanvasRun) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (c *CanvasRun) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,137 @@
+package canvasrun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestCanvasRun_Setup(t *testing.T) {
+	c := &CanvasRun{}
+
+	t.Run("canvas is required", func(t *testing.T) {
+		err := c.Setup(core.SetupContext{Configuration: map[string]any{}})
+		require.ErrorContains(t, err, "canvas is required")
+	})
+
+	t.Run("canvas must be an ID", func(t *testing.T) {
+		err := c.Setup(core.SetupContext{Configuration: map[string]any{"canvas": "my-canvas"}})
+		require.ErrorContains(t, err, "invalid canvas: my-canvas")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := c.Setup(core.SetupContext{Configuration: map[string]any{"canvas": uuid.NewString()}})
+		require.NoError(t, err)
+	})
+}
+
+func TestCanvasRun_Execute(t *testing.T) {
+	c := &CanvasRun{}
+	canvasID := uuid.NewString()
+
+	t.Run("run is started and polled", func(t *testing.T) {
+		canvasesCtx := &contexts.CanvasRunContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+
+		err := c.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"canvas":  canvasID,
+				"payload": map[string]any{"version": "v1"},
+			},
+			Canvases:       canvasesCtx,
+			Metadata:       metadataCtx,
+			Requests:       requestCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, canvasesCtx.Started, 1)
+		assert.Equal(t, canvasID, canvasesCtx.Started[0].CanvasID)
+		assert.Equal(t, map[string]any{"version": "v1"}, canvasesCtx.Started[0].Payload)
+		assert.Equal(t, PollAction, requestCtx.Action)
+		assert.Equal(t, PollInterval, requestCtx.Duration)
+
+		metadata, ok := metadataCtx.Get().(Metadata)
+		require.True(t, ok)
+		assert.Equal(t, canvasID, metadata.CanvasID)
+		assert.NotEmpty(t, metadata.RunID)
+	})
+
+	t.Run("run cannot be started -> error", func(t *testing.T) {
+		err := c.Execute(core.ExecutionContext{
+			Configuration:  map[string]any{"canvas": canvasID},
+			Canvases:       &contexts.CanvasRunContext{Err: fmt.Errorf("canvas has no start trigger")},
+			Metadata:       &contexts.MetadataContext{},
+			Requests:       &contexts.RequestContext{},
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		require.ErrorContains(t, err, "canvas has no start trigger")
+	})
+}
+
+func TestCanvasRun_HandleAction_Poll(t *testing.T) {
+	c := &CanvasRun{}
+	canvasID := uuid.NewString()
+	runID := uuid.NewString()
+
+	newActionContext := func(run *core.CanvasRun) (core.ActionContext, *contexts.ExecutionStateContext, *contexts.RequestContext) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		requestCtx := &contexts.RequestContext{}
+		return core.ActionContext{
+			Name:           PollAction,
+			Canvases:       &contexts.CanvasRunContext{Runs: map[string]*core.CanvasRun{runID: run}},
+			Metadata:       &contexts.MetadataContext{Metadata: Metadata{CanvasID: canvasID, RunID: runID}},
+			Requests:       requestCtx,
+			ExecutionState: stateCtx,
+		}, stateCtx, requestCtx
+	}
+
+	t.Run("run still going -> poll again", func(t *testing.T) {
+		ctx, stateCtx, requestCtx := newActionContext(&core.CanvasRun{ID: runID, State: core.CanvasRunStateRunning})
+
+		require.NoError(t, c.HandleAction(ctx))
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, PollAction, requestCtx.Action)
+	})
+
+	t.Run("run passed -> emits outputs on passed channel", func(t *testing.T) {
+		outputs := map[string][]any{"deploy": {map[string]any{"data": map[string]any{"version": "v1"}}}}
+		ctx, stateCtx, requestCtx := newActionContext(&core.CanvasRun{ID: runID, State: core.CanvasRunStatePassed, Outputs: outputs})
+
+		require.NoError(t, c.HandleAction(ctx))
+		assert.True(t, stateCtx.Passed)
+		assert.Equal(t, ChannelPassed, stateCtx.Channel)
+		assert.Empty(t, requestCtx.Action)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		payload := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, outputs, payload["outputs"])
+		assert.Equal(t, core.CanvasRunStatePassed, payload["run"].(map[string]any)["result"])
+	})
+
+	t.Run("run failed -> emits on failed channel", func(t *testing.T) {
+		ctx, stateCtx, _ := newActionContext(&core.CanvasRun{ID: runID, State: core.CanvasRunStateFailed, Message: "node deploy failed: boom"})
+
+		require.NoError(t, c.HandleAction(ctx))
+		assert.Equal(t, ChannelFailed, stateCtx.Channel)
+
+		payload := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, "node deploy failed: boom", payload["run"].(map[string]any)["message"])
+	})
+
+	t.Run("finished execution is ignored", func(t *testing.T) {
+		ctx, stateCtx, requestCtx := newActionContext(&core.CanvasRun{ID: runID, State: core.CanvasRunStatePassed})
+		stateCtx.Finished = true
+
+		require.NoError(t, c.HandleAction(ctx))
+		assert.Empty(t, stateCtx.Channel)
+		assert.Empty(t, requestCtx.Action)
+	})
+}
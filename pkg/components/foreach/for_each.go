@@ -0,0 +1,428 @@
+package foreach
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	ComponentName   = "forEach"
+	ItemPayloadType = "forEach.item"
+	DonePayloadType = "forEach.done"
+	PollAction      = "poll"
+	PollInterval    = 10 * time.Second
+	MaxItems        = 1000
+
+	ChannelNameItem = "item"
+	ChannelNameDone = "done"
+)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &ForEach{})
+}
+
+type ForEach struct{}
+
+type Spec struct {
+	Items          string `json:"items" mapstructure:"items"`
+	MaxParallelism int    `json:"maxParallelism" mapstructure:"maxParallelism"`
+}
+
+type Metadata struct {
+	Expression     string `json:"expression" mapstructure:"expression"`
+	MaxParallelism int    `json:"maxParallelism,omitempty" mapstructure:"maxParallelism"`
+	Items          []Item `json:"items" mapstructure:"items"`
+}
+
+type Item struct {
+	Index int `json:"index" mapstructure:"index"`
+	Value any `json:"value" mapstructure:"value"`
+
+	//
+	// ID of the event emitted for this item on the item channel.
+	// Empty until the item is started.
+	//
+	EventID string `json:"eventId,omitempty" mapstructure:"eventId"`
+
+	//
+	// Result of everything started from the item event.
+	// Empty until nothing is left to run for the item.
+	//
+	Result  string           `json:"result,omitempty" mapstructure:"result"`
+	Message string           `json:"message,omitempty" mapstructure:"message"`
+	Outputs map[string][]any `json:"outputs,omitempty" mapstructure:"outputs"`
+}
+
+func (i *Item) IsStarted() bool {
+	return i.EventID != ""
+}
+
+func (i *Item) IsFinished() bool {
+	return i.Result != ""
+}
+
+func (f *ForEach) Name() string {
+	return ComponentName
+}
+
+func (f *ForEach) Label() string {
+	return "For Each"
+}
+
+func (f *ForEach) Description() string {
+	return "Run the next steps once for every item in a list"
+}
+
+func (f *ForEach) Documentation() string {
+	return `The For Each component evaluates an expression that returns a list, and emits one event for every item in it.
+
+## Use Cases
+
+- **Fan-out**: Run the same steps for every repository, region or environment in a payload
+- **Batch processing**: Process the items of a list without hard-wiring parallel branches
+- **Throttled rollouts**: Limit how many items are processed at the same time
+
+## How It Works
+
+1. The items expression is evaluated against the incoming event data
+2. One event per item is emitted on the **Item** channel, up to the maximum parallelism
+3. The component follows every execution started from each item event
+4. Once nothing is left to run for an item, the next item is emitted
+5. When every item is finished, a single event aggregating the per-item results is emitted on the **Done** channel
+
+The Done event is emitted once per incoming event, so a Merge component after it
+groups it with events from other branches of the same run, like any other event.
+
+## Configuration
+
+- **Items**: Expression that returns the list to iterate over
+- **Max Parallelism**: Maximum number of items processed at the same time. Leave empty to process all items at once.
+
+## Output Channels
+
+- **Item**: One event per item, with the ` + "`item`" + `, its ` + "`index`" + ` and the ` + "`count`" + ` of items
+- **Done**: Emitted once every item is finished, with the result and outputs of every item
+
+## Expression Environment
+
+The expression has access to:
+- **$**: The run context data
+- **root()**: Access to the root event data
+- **previous()**: Access to previous node outputs (optionally with depth parameter)
+
+## Examples
+
+- ` + "`$[\"Node Name\"].repositories`" + `: Iterate over a list of repositories
+- ` + "`[\"us-east-1\", \"eu-west-1\"]`" + `: Iterate over a fixed list of regions`
+}
+
+func (f *ForEach) Icon() string {
+	return "repeat"
+}
+
+func (f *ForEach) Color() string {
+	return "blue"
+}
+
+func (f *ForEach) OutputChannels(configuration any) []core.OutputChannel {
+	return []core.OutputChannel{
+		{Name: ChannelNameItem, Label: "Item", Description: "One event for every item in the list"},
+		{Name: ChannelNameDone, Label: "Done", Description: "Every item is finished"},
+	}
+}
+
+func (f *ForEach) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "items",
+			Label:       "Items",
+			Type:        configuration.FieldTypeExpression,
+			Description: "Expression that returns the list to iterate over",
+			Placeholder: "e.g. $[\"Node Name\"].repositories",
+			Required:    true,
+		},
+		{
+			Name:        "maxParallelism",
+			Label:       "Max Parallelism",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Maximum number of items processed at the same time",
+			Required:    false,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+					Max: func() *int { max := MaxItems; return &max }(),
+				},
+			},
+		},
+	}
+}
+
+func (f *ForEach) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if spec.Items == "" {
+		return fmt.Errorf("items is required")
+	}
+
+	if spec.MaxParallelism < 0 {
+		return fmt.Errorf("max parallelism must be greater than zero")
+	}
+
+	return nil
+}
+
+func (f *ForEach) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (f *ForEach) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	values, err := evaluateItems(ctx, spec.Items)
+	if err != nil {
+		return err
+	}
+
+	if len(values) > MaxItems {
+		return fmt.Errorf("too many items: %d (max %d)", len(values), MaxItems)
+	}
+
+	metadata := Metadata{
+		Expression:     spec.Items,
+		MaxParallelism: spec.MaxParallelism,
+		Items:          make([]Item, 0, len(values)),
+	}
+
+	for i, value := range values {
+		metadata.Items = append(metadata.Items, Item{Index: i, Value: value})
+	}
+
+	return f.advance(ctx.ExecutionState, ctx.Metadata, ctx.Downstream, ctx.Requests, &metadata)
+}
+
+func (f *ForEach) Actions() []core.Action {
+	return []core.Action{
+		{
+			Name: PollAction,
+		},
+	}
+}
+
+func (f *ForEach) HandleAction(ctx core.ActionContext) error {
+	switch ctx.Name {
+	case PollAction:
+		return f.poll(ctx)
+	default:
+		return fmt.Errorf("unknown action: %s", ctx.Name)
+	}
+}
+
+func (f *ForEach) poll(ctx core.ActionContext) error {
+	if ctx.ExecutionState.IsFinished() {
+		return nil
+	}
+
+	metadata := Metadata{}
+	if err := mapstructure.Decode(ctx.Metadata.Get(), &metadata); err != nil {
+		return fmt.Errorf("failed to decode metadata: %w", err)
+	}
+
+	return f.advance(ctx.ExecutionState, ctx.Metadata, ctx.Downstream, ctx.Requests, &metadata)
+}
+
+// advance records the result of the items that finished since the last check,
+// starts as many items as the max parallelism allows, and emits
+// the done event once every item is finished.
+func (f *ForEach) advance(
+	state core.ExecutionStateContext,
+	metadataCtx core.MetadataContext,
+	downstream core.DownstreamContext,
+	requests core.RequestContext,
+	metadata *Metadata,
+) error {
+	running := 0
+	for i := range metadata.Items {
+		item := &metadata.Items[i]
+		if !item.IsStarted() || item.IsFinished() {
+			continue
+		}
+
+		result, err := downstream.Get(item.EventID)
+		if err != nil {
+			return fmt.Errorf("failed to check item %d: %w", item.Index, err)
+		}
+
+		if result.State == core.DownstreamStateRunning {
+			running++
+			continue
+		}
+
+		item.Result = result.State
+		item.Message = result.Message
+		item.Outputs = result.Outputs
+	}
+
+	limit := metadata.MaxParallelism
+	if limit <= 0 {
+		limit = len(metadata.Items)
+	}
+
+	next := []*Item{}
+	for i := range metadata.Items {
+		if running+len(next) >= limit {
+			break
+		}
+
+		if !metadata.Items[i].IsStarted() {
+			next = append(next, &metadata.Items[i])
+		}
+	}
+
+	if len(next) > 0 {
+		payloads := make([]any, 0, len(next))
+		for _, item := range next {
+			payloads = append(payloads, map[string]any{
+				"item":  item.Value,
+				"index": item.Index,
+				"count": len(metadata.Items),
+			})
+		}
+
+		eventIDs, err := state.EmitAndContinue(ChannelNameItem, ItemPayloadType, payloads)
+		if err != nil {
+			return fmt.Errorf("failed to emit items: %w", err)
+		}
+
+		for i, item := range next {
+			item.EventID = eventIDs[i]
+		}
+	}
+
+	if err := metadataCtx.Set(*metadata); err != nil {
+		return err
+	}
+
+	for _, item := range metadata.Items {
+		if !item.IsFinished() {
+			return requests.ScheduleActionCall(PollAction, map[string]any{}, PollInterval)
+		}
+	}
+
+	return state.Emit(ChannelNameDone, DonePayloadType, []any{donePayload(metadata)})
+}
+
+func donePayload(metadata *Metadata) map[string]any {
+	passed := 0
+	failed := 0
+	results := make([]any, 0, len(metadata.Items))
+	for _, item := range metadata.Items {
+		if item.Result == core.DownstreamStatePassed {
+			passed++
+		} else {
+			failed++
+		}
+
+		results = append(results, map[string]any{
+			"index":   item.Index,
+			"item":    item.Value,
+			"result":  item.Result,
+			"message": item.Message,
+			"outputs": item.Outputs,
+		})
+	}
+
+	return map[string]any{
+		"count":   len(metadata.Items),
+		"passed":  passed,
+		"failed":  failed,
+		"results": results,
+	}
+}
+
+func evaluateItems(ctx core.ExecutionContext, expression string) ([]any, error) {
+	if expression == "" {
+		return nil, fmt.Errorf("items is required")
+	}
+
+	env, err := expressionEnv(ctx, expression)
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := expressions.Compile(expression, env, nil)
+	if err != nil {
+		return nil, fmt.Errorf("items expression compilation failed: %w", err)
+	}
+
+	output, err := expr.Run(vm, env)
+	if err != nil {
+		return nil, fmt.Errorf("items expression evaluation failed: %w", err)
+	}
+
+	value := reflect.ValueOf(output)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, fmt.Errorf("items expression must evaluate to a list, got %T", output)
+	}
+
+	items := make([]any, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		items = append(items, value.Index(i).Interface())
+	}
+
+	return items, nil
+}
+
+func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
+	if ctx.ExpressionEnv != nil {
+		return ctx.ExpressionEnv(expression)
+	}
+
+	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
+}
+
+func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+func (f *ForEach) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (f *ForEach) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (f *ForEach) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
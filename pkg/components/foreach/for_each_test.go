@@ -0,0 +1,206 @@
+package foreach
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func TestForEach_Setup(t *testing.T) {
+	f := &ForEach{}
+
+	t.Run("items is required", func(t *testing.T) {
+		err := f.Setup(core.SetupContext{Configuration: map[string]any{}})
+		require.ErrorContains(t, err, "items is required")
+	})
+
+	t.Run("valid configuration", func(t *testing.T) {
+		err := f.Setup(core.SetupContext{Configuration: map[string]any{"items": "$.regions", "maxParallelism": 2}})
+		require.NoError(t, err)
+	})
+}
+
+func TestForEach_Execute(t *testing.T) {
+	f := &ForEach{}
+
+	t.Run("expression must return a list", func(t *testing.T) {
+		err := f.Execute(core.ExecutionContext{
+			Data:           map[string]any{"regions": "us-east-1"},
+			Configuration:  map[string]any{"items": "$.regions"},
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Metadata:       &contexts.MetadataContext{},
+			Requests:       &contexts.RequestContext{},
+			Downstream:     &contexts.DownstreamContext{},
+		})
+
+		require.ErrorContains(t, err, "items expression must evaluate to a list, got string")
+	})
+
+	t.Run("empty list -> done is emitted", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := f.Execute(core.ExecutionContext{
+			Data:           map[string]any{"regions": []any{}},
+			Configuration:  map[string]any{"items": "$.regions"},
+			ExecutionState: stateCtx,
+			Metadata:       &contexts.MetadataContext{},
+			Requests:       &contexts.RequestContext{},
+			Downstream:     &contexts.DownstreamContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, stateCtx.EmittedEvents)
+		assert.Equal(t, ChannelNameDone, stateCtx.Channel)
+	})
+
+	t.Run("one event per item is emitted and poll is scheduled", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		requestCtx := &contexts.RequestContext{}
+		err := f.Execute(core.ExecutionContext{
+			Data:           map[string]any{"regions": []any{"us-east-1", "eu-west-1", "ap-south-1"}},
+			Configuration:  map[string]any{"items": "$.regions"},
+			ExecutionState: stateCtx,
+			Metadata:       metadataCtx,
+			Requests:       requestCtx,
+			Downstream:     &contexts.DownstreamContext{},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, PollAction, requestCtx.Action)
+		require.Len(t, stateCtx.EmittedEvents, 3)
+		for i, event := range stateCtx.EmittedEvents {
+			assert.Equal(t, ChannelNameItem, event.Channel)
+			assert.Equal(t, ItemPayloadType, event.Type)
+			assert.Equal(t, i, event.Data.(map[string]any)["index"])
+			assert.Equal(t, 3, event.Data.(map[string]any)["count"])
+		}
+
+		assert.Equal(t, "us-east-1", stateCtx.EmittedEvents[0].Data.(map[string]any)["item"])
+	})
+
+	t.Run("max parallelism limits the items emitted", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{}
+		err := f.Execute(core.ExecutionContext{
+			Data:           map[string]any{"regions": []any{"us-east-1", "eu-west-1", "ap-south-1"}},
+			Configuration:  map[string]any{"items": "$.regions", "maxParallelism": 2},
+			ExecutionState: stateCtx,
+			Metadata:       metadataCtx,
+			Requests:       &contexts.RequestContext{},
+			Downstream:     &contexts.DownstreamContext{},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, stateCtx.EmittedEvents, 2)
+
+		metadata := metadataCtx.Get().(Metadata)
+		require.Len(t, metadata.Items, 3)
+		assert.True(t, metadata.Items[1].IsStarted())
+		assert.False(t, metadata.Items[2].IsStarted())
+	})
+}
+
+func TestForEach_HandleAction_Poll(t *testing.T) {
+	f := &ForEach{}
+
+	newMetadata := func() Metadata {
+		return Metadata{
+			Expression:     "$.regions",
+			MaxParallelism: 2,
+			Items: []Item{
+				{Index: 0, Value: "us-east-1", EventID: "event-0"},
+				{Index: 1, Value: "eu-west-1", EventID: "event-1"},
+				{Index: 2, Value: "ap-south-1"},
+			},
+		}
+	}
+
+	t.Run("finished item -> next item is emitted", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{}
+		metadataCtx := &contexts.MetadataContext{Metadata: newMetadata()}
+		requestCtx := &contexts.RequestContext{}
+		err := f.HandleAction(core.ActionContext{
+			Name:           PollAction,
+			ExecutionState: stateCtx,
+			Metadata:       metadataCtx,
+			Requests:       requestCtx,
+			Downstream: &contexts.DownstreamContext{
+				Results: map[string]*core.DownstreamResult{
+					"event-0": {State: core.DownstreamStatePassed},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, stateCtx.Finished)
+		assert.Equal(t, PollAction, requestCtx.Action)
+		require.Len(t, stateCtx.EmittedEvents, 1)
+		assert.Equal(t, "ap-south-1", stateCtx.EmittedEvents[0].Data.(map[string]any)["item"])
+
+		metadata := metadataCtx.Get().(Metadata)
+		assert.Equal(t, core.DownstreamStatePassed, metadata.Items[0].Result)
+		assert.True(t, metadata.Items[2].IsStarted())
+	})
+
+	t.Run("every item finished -> done is emitted with results", func(t *testing.T) {
+		metadata := newMetadata()
+		metadata.Items[0].Result = core.DownstreamStatePassed
+		metadata.Items[2].EventID = "event-2"
+
+		outputs := map[string][]any{"deploy": {map[string]any{"data": map[string]any{"status": "deployed"}}}}
+		stateCtx := &contexts.ExecutionStateContext{}
+		err := f.HandleAction(core.ActionContext{
+			Name:           PollAction,
+			ExecutionState: stateCtx,
+			Metadata:       &contexts.MetadataContext{Metadata: metadata},
+			Requests:       &contexts.RequestContext{},
+			Downstream: &contexts.DownstreamContext{
+				Results: map[string]*core.DownstreamResult{
+					"event-1": {State: core.DownstreamStateFailed, Message: "node deploy failed: boom"},
+					"event-2": {State: core.DownstreamStatePassed, Outputs: outputs},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, stateCtx.Finished)
+		assert.Equal(t, ChannelNameDone, stateCtx.Channel)
+		assert.Equal(t, DonePayloadType, stateCtx.Type)
+		require.Len(t, stateCtx.Payloads, 1)
+
+		payload := stateCtx.Payloads[0].(map[string]any)["data"].(map[string]any)
+		assert.Equal(t, 3, payload["count"])
+		assert.Equal(t, 2, payload["passed"])
+		assert.Equal(t, 1, payload["failed"])
+
+		results := payload["results"].([]any)
+		require.Len(t, results, 3)
+		assert.Equal(t, "node deploy failed: boom", results[1].(map[string]any)["message"])
+		assert.Equal(t, outputs, results[2].(map[string]any)["outputs"])
+	})
+
+	t.Run("finished execution is ignored", func(t *testing.T) {
+		stateCtx := &contexts.ExecutionStateContext{Finished: true}
+		requestCtx := &contexts.RequestContext{}
+		err := f.HandleAction(core.ActionContext{
+			Name:           PollAction,
+			ExecutionState: stateCtx,
+			Metadata:       &contexts.MetadataContext{Metadata: newMetadata()},
+			Requests:       requestCtx,
+			Downstream:     &contexts.DownstreamContext{},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, stateCtx.EmittedEvents)
+		assert.Empty(t, requestCtx.Action)
+	})
+
+	t.Run("unknown action", func(t *testing.T) {
+		err := f.HandleAction(core.ActionContext{Name: "nope"})
+		require.ErrorContains(t, err, "unknown action: nope")
+	})
+}
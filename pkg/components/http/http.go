@@ -494,8 +494,14 @@ func (e *HTTP) Execute(ctx core.ExecutionContext) error {
 func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMetadata RetryMetadata) error {
 	currentTimeout := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, retryMetadata.TimeoutSeconds, retryMetadata.Attempt)
 
+	ctx.Logs.Info(
+		fmt.Sprintf("%s %s", spec.Method, spec.URL),
+		map[string]any{"attempt": retryMetadata.Attempt + 1, "timeoutSeconds": int(currentTimeout.Seconds())},
+	)
+
 	resp, err := e.executeRequest(ctx.HTTP, spec, currentTimeout)
 	if err != nil {
+		ctx.Logs.Error(fmt.Sprintf("Request failed: %v", err), map[string]any{"attempt": retryMetadata.Attempt + 1})
 		if retryMetadata.Attempt < retryMetadata.MaxRetries {
 			return e.scheduleRetry(ctx, err.Error(), retryMetadata)
 		}
@@ -510,6 +516,7 @@ func (e *HTTP) executeHTTPRequest(ctx core.ExecutionContext, spec Spec, retryMet
 		isSuccess = e.matchesSuccessCode(resp.StatusCode, "2xx")
 	}
 
+	ctx.Logs.Info(fmt.Sprintf("Received HTTP status %d", resp.StatusCode), map[string]any{"status": resp.StatusCode})
 	if !isSuccess && retryMetadata.Attempt < retryMetadata.MaxRetries {
 
 		return e.scheduleRetry(ctx, fmt.Sprintf("HTTP status %d", resp.StatusCode), retryMetadata)
@@ -528,7 +535,9 @@ func (e *HTTP) scheduleRetry(ctx core.ExecutionContext, lastError string, retryM
 		return err
 	}
 
-	return ctx.Requests.ScheduleActionCall("retryRequest", map[string]any{}, e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, 1, retryMetadata.Attempt-1))
+	interval := e.calculateTimeoutForAttempt(retryMetadata.TimeoutStrategy, 1, retryMetadata.Attempt-1)
+	ctx.Logs.Warn(fmt.Sprintf("Retrying in %s", interval), map[string]any{"attempt": retryMetadata.Attempt + 1, "error": lastError})
+	return ctx.Requests.ScheduleActionCall("retryRequest", map[string]any{}, interval)
 }
 
 func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
@@ -557,6 +566,7 @@ func (e *HTTP) handleRetryRequest(ctx core.ActionContext) error {
 		Requests:       ctx.Requests,
 		Auth:           ctx.Auth,
 		HTTP:           ctx.HTTP,
+		Logs:           ctx.Logs,
 	}
 
 	return e.executeHTTPRequest(execCtx, spec, retryMetadata)
@@ -24,6 +24,7 @@ func createExecutionContext(config map[string]any) (core.ExecutionContext, *cont
 	return core.ExecutionContext{
 		Configuration:  config,
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		HTTP:           &http.Client{},
 	}, stateCtx, metadataCtx
@@ -262,6 +263,7 @@ func TestHTTP__Execute__ReadsResponseBodyBeforeContextCancellation(t *testing.T)
 			"url":    "https://api.example.com/test",
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		HTTP:           &contextBoundHTTPClient{},
 	}
@@ -725,6 +727,7 @@ func TestHTTP__Execute__WithoutRetryStrategy(t *testing.T) {
 			"url":    server.URL,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		HTTP:           &http.Client{},
 	}
@@ -773,6 +776,7 @@ func TestHTTP__Execute__FixedTimeoutStrategy_Success(t *testing.T) {
 			"retries":         2,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		HTTP:           &http.Client{},
 	}
@@ -819,6 +823,7 @@ func TestHTTP__Execute__ExponentialTimeoutStrategy_Success(t *testing.T) {
 			"retries":         3,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		HTTP:           &http.Client{},
 	}
@@ -868,6 +873,7 @@ func TestHTTP__HandleAction__RetryRequest_SuccessOnRetry(t *testing.T) {
 			"retries":         1,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx,
 		HTTP:           httpCtx,
@@ -884,6 +890,7 @@ func TestHTTP__HandleAction__RetryRequest_SuccessOnRetry(t *testing.T) {
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx,
 		HTTP:           httpCtx,
@@ -934,6 +941,7 @@ func TestHTTP__HandleAction__RetryRequest_ExhaustedRetries(t *testing.T) {
 			"retries":         2,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx,
 		HTTP:           httpCtx,
@@ -946,6 +954,7 @@ func TestHTTP__HandleAction__RetryRequest_ExhaustedRetries(t *testing.T) {
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       &contexts.RequestContext{},
 		HTTP:           httpCtx,
@@ -1062,6 +1071,7 @@ func TestHTTP__RetryProgression_ExponentialStrategy(t *testing.T) {
 			"retries":         3,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx,
 		HTTP:           httpCtx,
@@ -1078,6 +1088,7 @@ func TestHTTP__RetryProgression_ExponentialStrategy(t *testing.T) {
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx1,
 		HTTP:           httpCtx,
@@ -1139,6 +1150,7 @@ func TestHTTP__RetryProgression_NetworkError(t *testing.T) {
 			"retries":         2,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       requestCtx,
 		HTTP:           httpCtx,
@@ -1158,6 +1170,7 @@ func TestHTTP__RetryProgression_NetworkError(t *testing.T) {
 			Name:           "retryRequest",
 			Configuration:  ctx.Configuration,
 			ExecutionState: stateCtx,
+			Logs:           &contexts.ExecutionLogContext{},
 			Metadata:       metadataCtx,
 			Requests:       &contexts.RequestContext{},
 			HTTP:           httpCtx,
@@ -1202,6 +1215,7 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 			"retries":         2,
 		},
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       &contexts.RequestContext{},
 		HTTP:           httpCtx,
@@ -1227,6 +1241,7 @@ func TestHTTP__RetryMetadata_Progression(t *testing.T) {
 		Name:           "retryRequest",
 		Configuration:  ctx.Configuration,
 		ExecutionState: stateCtx,
+		Logs:           &contexts.ExecutionLogContext{},
 		Metadata:       metadataCtx,
 		Requests:       &contexts.RequestContext{},
 		HTTP:           httpCtx,
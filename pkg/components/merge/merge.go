@@ -3,7 +3,6 @@ package merge
 import (
 	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 
 	"github.com/expr-lang/expr"
@@ -11,6 +10,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/registry"
 )
@@ -243,7 +243,7 @@ func (m *Merge) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, erro
 			return nil, err
 		}
 
-		vm, err := expr.Compile(spec.StopIfExpression, expressionOptions(env)...)
+		vm, err := expressions.Compile(spec.StopIfExpression, env, nil, expr.AsBool())
 		if err != nil {
 			return nil, fmt.Errorf("stopIfExpression compilation failed: %w", err)
 		}
@@ -321,78 +321,6 @@ func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
 	return map[string]any{"$": map[string]any{sourceNodeID: input}}
 }
 
-func expressionOptions(env map[string]any) []expr.Option {
-	return []expr.Option{
-		expr.Env(env),
-		expr.AsBool(),
-		expr.WithContext("ctx"),
-		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			rootPayload, ok := env["__root"]
-			if !ok {
-				return nil, fmt.Errorf("no root event found")
-			}
-			return rootPayload, nil
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepthValue(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			previousByDepth, ok := env["__previousByDepth"]
-			if !ok {
-				return nil, nil
-			}
-			if values, ok := previousByDepth.(map[string]any); ok {
-				return values[strconv.Itoa(depth)], nil
-			}
-			if values, ok := previousByDepth.(map[int]any); ok {
-				return values[depth], nil
-			}
-
-			return nil, nil
-		}),
-	}
-}
-
-func parseDepthValue(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
-}
-
 func (m *Merge) findOrCreateExecution(ctx core.ProcessQueueContext, mergeGroup string) (*core.ExecutionContext, error) {
 	executionCtx, err := ctx.FindExecutionByKV("merge_group", mergeGroup)
 	if err != nil {
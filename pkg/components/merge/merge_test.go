@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/expressions"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"gorm.io/datatypes"
@@ -169,7 +170,7 @@ func TestMerge_ExpressionOptions_RootAndPrevious(t *testing.T) {
 	env, err := expressionEnv(ctx, `root().data.ref == "main" && previous().data.ok == true`)
 	require.NoError(t, err)
 
-	vm, err := expr.Compile(`root().data.ref == "main" && previous().data.ok == true`, expressionOptions(env)...)
+	vm, err := expressions.Compile(`root().data.ref == "main" && previous().data.ok == true`, env, nil, expr.AsBool())
 	require.NoError(t, err)
 
 	out, err := expr.Run(vm, env)
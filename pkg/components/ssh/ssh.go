@@ -419,6 +419,7 @@ func (c *SSHCommand) Execute(ctx core.ExecutionContext) error {
 		requestsCtx:  ctx.Requests,
 		stateCtx:     ctx.ExecutionState,
 		metadataCtx:  ctx.Metadata,
+		logsCtx:      ctx.Logs,
 		execMetadata: metadata,
 	}
 
@@ -442,6 +443,7 @@ func (c *SSHCommand) HandleAction(ctx core.ActionContext) error {
 			requestsCtx:  ctx.Requests,
 			stateCtx:     ctx.ExecutionState,
 			metadataCtx:  ctx.Metadata,
+			logsCtx:      ctx.Logs,
 			execMetadata: metadata,
 		}
 
@@ -456,6 +458,7 @@ type ExecuteSSHContext struct {
 	requestsCtx core.RequestContext
 	stateCtx    core.ExecutionStateContext
 	metadataCtx core.MetadataContext
+	logsCtx     core.ExecutionLogContext
 
 	execMetadata ExecutionMetadata
 }
@@ -472,9 +475,16 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 		ctx.execMetadata.Environment,
 		ctx.execMetadata.Command,
 	)
+
+	ctx.logsCtx.Info(fmt.Sprintf("Running command on %s@%s:%d", ctx.execMetadata.User, ctx.execMetadata.Host, ctx.execMetadata.Port), nil)
 	result, err := client.ExecuteCommand(command, time.Duration(ctx.execMetadata.Timeout)*time.Second)
 	if c.isConnectError(err) {
 		if c.shouldRetry(ctx.execMetadata.ConnectionRetry, ctx.metadataCtx) {
+			ctx.logsCtx.Warn(
+				fmt.Sprintf("Connection failed, retrying in %ds", ctx.execMetadata.ConnectionRetry.IntervalSeconds),
+				map[string]any{"error": err.Error()},
+			)
+
 			err = c.incrementRetryCount(ctx.metadataCtx)
 			if err != nil {
 				return err
@@ -491,6 +501,7 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 			ExitCode: -1,
 		}
 
+		ctx.logsCtx.Error(failResult.Stderr, nil)
 		err = c.setResultMetadata(ctx.metadataCtx, failResult)
 		if err != nil {
 			return err
@@ -503,6 +514,7 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 		return err
 	}
 
+	writeOutputLogs(ctx.logsCtx, result)
 	err = c.setResultMetadata(ctx.metadataCtx, result)
 	if err != nil {
 		return err
@@ -516,6 +528,34 @@ func (c *SSHCommand) executeSSH(ctx ExecuteSSHContext) error {
 	return ctx.stateCtx.Emit(channel, "ssh.command.executed", []any{result})
 }
 
+// writeOutputLogs writes one log line for every line of output,
+// followed by the exit code of the command.
+func writeOutputLogs(logs core.ExecutionLogContext, result *CommandResult) {
+	for _, line := range outputLines(result.Stdout) {
+		logs.Info(line, map[string]any{"stream": "stdout"})
+	}
+
+	for _, line := range outputLines(result.Stderr) {
+		logs.Warn(line, map[string]any{"stream": "stderr"})
+	}
+
+	if result.ExitCode == 0 {
+		logs.Info("Command exited with code 0", map[string]any{"exitCode": result.ExitCode})
+		return
+	}
+
+	logs.Error(fmt.Sprintf("Command exited with code %d", result.ExitCode), map[string]any{"exitCode": result.ExitCode})
+}
+
+func outputLines(output string) []string {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return nil
+	}
+
+	return strings.Split(output, "\n")
+}
+
 func (c *SSHCommand) shouldRetry(retrySpec *ConnectionRetrySpec, metadata core.MetadataContext) bool {
 	if retrySpec == nil || !retrySpec.Enabled {
 		return false
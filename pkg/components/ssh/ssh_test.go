@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
 )
 
 type testMetadataContext struct {
@@ -207,3 +208,28 @@ func TestSSHCommand_BuildRemoteCommand(t *testing.T) {
 		)
 	})
 }
+
+func TestSSHCommand_WriteOutputLogs(t *testing.T) {
+	t.Run("one line for every line of output, and the exit code", func(t *testing.T) {
+		logs := &contexts.ExecutionLogContext{}
+		writeOutputLogs(logs, &CommandResult{Stdout: "first\nsecond\n", Stderr: "oops\n", ExitCode: 0})
+
+		require.Len(t, logs.Lines, 4)
+		assert.Equal(t, "first", logs.Lines[0].Message)
+		assert.Equal(t, "second", logs.Lines[1].Message)
+		assert.Equal(t, "stdout", logs.Lines[1].Fields["stream"])
+		assert.Equal(t, "warn", logs.Lines[2].Level)
+		assert.Equal(t, "stderr", logs.Lines[2].Fields["stream"])
+		assert.Equal(t, "Command exited with code 0", logs.Lines[3].Message)
+		assert.Equal(t, "info", logs.Lines[3].Level)
+	})
+
+	t.Run("non-zero exit code is an error", func(t *testing.T) {
+		logs := &contexts.ExecutionLogContext{}
+		writeOutputLogs(logs, &CommandResult{ExitCode: 2})
+
+		require.Len(t, logs.Lines, 1)
+		assert.Equal(t, "error", logs.Lines[0].Level)
+		assert.Equal(t, "Command exited with code 2", logs.Lines[0].Message)
+	})
+}
@@ -0,0 +1,312 @@
+package switchp
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/expr-lang/expr"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/expressions"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const ComponentName = "switch"
+const ChannelNameDefault = "default"
+const PayloadType = "switch.executed"
+const MaxCases = 20
+
+var caseNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func init() {
+	registry.RegisterComponent(ComponentName, &Switch{})
+}
+
+type Switch struct{}
+
+type Spec struct {
+	Cases []Case `json:"cases" mapstructure:"cases"`
+}
+
+type Case struct {
+	Name       string `json:"name" mapstructure:"name"`
+	Expression string `json:"expression" mapstructure:"expression"`
+}
+
+func (s *Switch) Name() string {
+	return ComponentName
+}
+
+func (s *Switch) Label() string {
+	return "Switch"
+}
+
+func (s *Switch) Description() string {
+	return "Route events to one of many channels based on expressions"
+}
+
+func (s *Switch) Documentation() string {
+	return `The Switch component evaluates a list of cases in order, and routes the event to the output channel of the first case that matches.
+
+## Use Cases
+
+- **Multi-way branching**: Route events down one of many paths, without chaining If components
+- **Environment routing**: Send events to different deployment paths based on the target environment
+- **Severity routing**: Handle alerts differently based on their severity
+
+## How It Works
+
+1. Each case has a name and a boolean expression
+2. Cases are evaluated in order, against the incoming event data
+3. The event is emitted to the output channel named after the first case that evaluates to ` + "`true`" + `
+4. If no case matches, the event is emitted to the "Default" output channel
+
+## Output Channels
+
+- One output channel for every case, named after it
+- **Default**: Events where no case matches
+
+## Expression Environment
+
+The expressions have access to:
+- **$**: The run context data
+- **root()**: Access to the root event data
+- **previous()**: Access to previous node outputs (optionally with depth parameter)
+
+## Examples
+
+- **production**: ` + "`$[\"Node Name\"].environment == \"production\"`" + `
+- **staging**: ` + "`$[\"Node Name\"].environment == \"staging\"`"
+}
+
+func (s *Switch) Icon() string {
+	return "split"
+}
+
+func (s *Switch) Color() string {
+	return "red"
+}
+
+// OutputChannels has one channel for every configured case,
+// in the order they are evaluated, followed by the default channel.
+func (s *Switch) OutputChannels(configuration any) []core.OutputChannel {
+	spec := Spec{}
+	if err := mapstructure.Decode(configuration, &spec); err != nil {
+		return []core.OutputChannel{defaultChannel()}
+	}
+
+	channels := []core.OutputChannel{}
+	seen := map[string]bool{}
+	for _, c := range spec.Cases {
+		if c.Name == "" || c.Name == ChannelNameDefault || seen[c.Name] {
+			continue
+		}
+
+		seen[c.Name] = true
+		channels = append(channels, core.OutputChannel{Name: c.Name, Label: c.Name})
+	}
+
+	return append(channels, defaultChannel())
+}
+
+func defaultChannel() core.OutputChannel {
+	return core.OutputChannel{Name: ChannelNameDefault, Label: "Default", Description: "No case matched"}
+}
+
+func (s *Switch) Configuration() []configuration.Field {
+	return []configuration.Field{
+		{
+			Name:        "cases",
+			Label:       "Cases",
+			Type:        configuration.FieldTypeList,
+			Description: "Cases evaluated in order. The event is routed to the first one that matches.",
+			Required:    true,
+			TypeOptions: &configuration.TypeOptions{
+				List: &configuration.ListTypeOptions{
+					ItemLabel: "Case",
+					MaxItems:  func() *int { max := MaxCases; return &max }(),
+					ItemDefinition: &configuration.ListItemDefinition{
+						Type: configuration.FieldTypeObject,
+						Schema: []configuration.Field{
+							{
+								Name:               "name",
+								Type:               configuration.FieldTypeString,
+								Label:              "Name",
+								Description:        "Name of the output channel for this case",
+								Required:           true,
+								Placeholder:        "production",
+								DisallowExpression: true,
+							},
+							{
+								Name:        "expression",
+								Type:        configuration.FieldTypeExpression,
+								Label:       "Expression",
+								Description: "Boolean expression to evaluate",
+								Required:    true,
+								Placeholder: "e.g. $[\"Node Name\"].environment == \"production\"",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *Switch) Setup(ctx core.SetupContext) error {
+	spec := Spec{}
+	if err := mapstructure.Decode(ctx.Configuration, &spec); err != nil {
+		return fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	if len(spec.Cases) == 0 {
+		return fmt.Errorf("at least one case is required")
+	}
+
+	if len(spec.Cases) > MaxCases {
+		return fmt.Errorf("too many cases: %d (max %d)", len(spec.Cases), MaxCases)
+	}
+
+	names := map[string]bool{}
+	for i, c := range spec.Cases {
+		if c.Name == "" {
+			return fmt.Errorf("case %d: name is required", i+1)
+		}
+
+		if !caseNameRegex.MatchString(c.Name) {
+			return fmt.Errorf("case %d: invalid name %s: only letters, numbers, dashes and underscores are allowed", i+1, c.Name)
+		}
+
+		if c.Name == ChannelNameDefault {
+			return fmt.Errorf("case %d: %s is reserved for events where no case matches", i+1, ChannelNameDefault)
+		}
+
+		if names[c.Name] {
+			return fmt.Errorf("case %d: duplicate name %s", i+1, c.Name)
+		}
+
+		if c.Expression == "" {
+			return fmt.Errorf("case %s: expression is required", c.Name)
+		}
+
+		names[c.Name] = true
+	}
+
+	return nil
+}
+
+func (s *Switch) ProcessQueueItem(ctx core.ProcessQueueContext) (*uuid.UUID, error) {
+	return ctx.DefaultProcessing()
+}
+
+func (s *Switch) Execute(ctx core.ExecutionContext) error {
+	spec := Spec{}
+	err := mapstructure.Decode(ctx.Configuration, &spec)
+	if err != nil {
+		return err
+	}
+
+	channel, err := s.match(ctx, spec.Cases)
+	if err != nil {
+		return err
+	}
+
+	// Store the cases and the matched one in metadata,
+	// so they can be retrieved later, even if the node configuration changes.
+	err = ctx.Metadata.Set(map[string]any{
+		"cases":   spec.Cases,
+		"matched": channel,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error setting metadata: %w", err)
+	}
+
+	return ctx.ExecutionState.Emit(
+		channel,
+		PayloadType,
+		[]any{map[string]any{"case": channel}},
+	)
+}
+
+// match returns the name of the first case whose expression
+// evaluates to true, or the default channel if none does.
+func (s *Switch) match(ctx core.ExecutionContext, cases []Case) (string, error) {
+	for _, c := range cases {
+		env, err := expressionEnv(ctx, c.Expression)
+		if err != nil {
+			return "", err
+		}
+
+		vm, err := expressions.Compile(c.Expression, env, nil, expr.AsBool())
+		if err != nil {
+			return "", fmt.Errorf("case %s: %w", c.Name, err)
+		}
+
+		output, err := expr.Run(vm, env)
+		if err != nil {
+			return "", fmt.Errorf("case %s: expression evaluation failed: %w", c.Name, err)
+		}
+
+		matches, ok := output.(bool)
+		if !ok {
+			return "", fmt.Errorf("case %s: expression must evaluate to boolean, got %T", c.Name, output)
+		}
+
+		if matches {
+			return c.Name, nil
+		}
+	}
+
+	return ChannelNameDefault, nil
+}
+
+func expressionEnv(ctx core.ExecutionContext, expression string) (map[string]any, error) {
+	if ctx.ExpressionEnv != nil {
+		return ctx.ExpressionEnv(expression)
+	}
+
+	return buildExpressionEnv(ctx.Data, ctx.SourceNodeID), nil
+}
+
+func buildExpressionEnv(input any, sourceNodeID string) map[string]any {
+	if sourceNodeID == "" {
+		return map[string]any{"$": input}
+	}
+
+	if inputMap, ok := input.(map[string]any); ok {
+		envData := make(map[string]any, len(inputMap)+1)
+		for key, value := range inputMap {
+			envData[key] = value
+		}
+		if _, exists := envData[sourceNodeID]; !exists {
+			envData[sourceNodeID] = input
+		}
+		return map[string]any{"$": envData}
+	}
+
+	return map[string]any{"$": map[string]any{sourceNodeID: input}}
+}
+
+func (s *Switch) Actions() []core.Action {
+	return []core.Action{}
+}
+
+func (s *Switch) HandleAction(ctx core.ActionContext) error {
+	return fmt.Errorf("switch does not support actions")
+}
+
+func (s *Switch) Cancel(ctx core.ExecutionContext) error {
+	return nil
+}
+
+func (s *Switch) HandleWebhook(ctx core.WebhookRequestContext) (int, *core.WebhookResponseBody, error) {
+	return http.StatusOK, nil, nil
+}
+
+func (s *Switch) Cleanup(ctx core.SetupContext) error {
+	return nil
+}
@@ -0,0 +1,149 @@
+package switchp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/test/support/contexts"
+)
+
+func cases(pairs ...string) map[string]any {
+	items := []any{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		items = append(items, map[string]any{"name": pairs[i], "expression": pairs[i+1]})
+	}
+
+	return map[string]any{"cases": items}
+}
+
+func TestSwitch_OutputChannels(t *testing.T) {
+	s := &Switch{}
+
+	t.Run("no configuration -> only default channel", func(t *testing.T) {
+		channels := s.OutputChannels(nil)
+		require.Len(t, channels, 1)
+		assert.Equal(t, ChannelNameDefault, channels[0].Name)
+	})
+
+	t.Run("one channel per case, in order, followed by default", func(t *testing.T) {
+		channels := s.OutputChannels(cases("production", "true", "staging", "true", "production", "false"))
+		names := []string{}
+		for _, channel := range channels {
+			names = append(names, channel.Name)
+		}
+
+		assert.Equal(t, []string{"production", "staging", ChannelNameDefault}, names)
+	})
+}
+
+func TestSwitch_Setup(t *testing.T) {
+	s := &Switch{}
+
+	tests := []struct {
+		name          string
+		configuration map[string]any
+		expectedError string
+	}{
+		{
+			name:          "no cases",
+			configuration: map[string]any{},
+			expectedError: "at least one case is required",
+		},
+		{
+			name:          "invalid name",
+			configuration: cases("prod env", "true"),
+			expectedError: "case 1: invalid name prod env",
+		},
+		{
+			name:          "reserved name",
+			configuration: cases("default", "true"),
+			expectedError: "case 1: default is reserved",
+		},
+		{
+			name:          "duplicate name",
+			configuration: cases("production", "true", "production", "false"),
+			expectedError: "case 2: duplicate name production",
+		},
+		{
+			name:          "missing expression",
+			configuration: cases("production", ""),
+			expectedError: "case production: expression is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Setup(core.SetupContext{Configuration: tt.configuration})
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+
+	t.Run("valid cases", func(t *testing.T) {
+		err := s.Setup(core.SetupContext{Configuration: cases("production", "true", "staging", "false")})
+		require.NoError(t, err)
+	})
+}
+
+func TestSwitch_Execute(t *testing.T) {
+	tests := []struct {
+		name            string
+		configuration   map[string]any
+		inputData       any
+		expectedChannel string
+	}{
+		{
+			name:            "first matching case wins",
+			configuration:   cases("production", "$.env == 'production'", "any", "true"),
+			inputData:       map[string]any{"env": "production"},
+			expectedChannel: "production",
+		},
+		{
+			name:            "cases are evaluated in order",
+			configuration:   cases("production", "$.env == 'production'", "staging", "$.env == 'staging'"),
+			inputData:       map[string]any{"env": "staging"},
+			expectedChannel: "staging",
+		},
+		{
+			name:            "no matching case -> default",
+			configuration:   cases("production", "$.env == 'production'"),
+			inputData:       map[string]any{"env": "dev"},
+			expectedChannel: ChannelNameDefault,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Switch{}
+			stateCtx := &contexts.ExecutionStateContext{}
+			metadataCtx := &contexts.MetadataContext{}
+
+			err := s.Execute(core.ExecutionContext{
+				Data:           tt.inputData,
+				Configuration:  tt.configuration,
+				ExecutionState: stateCtx,
+				Metadata:       metadataCtx,
+			})
+
+			require.NoError(t, err)
+			assert.True(t, stateCtx.Passed)
+			assert.Equal(t, tt.expectedChannel, stateCtx.Channel)
+			assert.Equal(t, PayloadType, stateCtx.Type)
+			assert.Equal(t, tt.expectedChannel, metadataCtx.Metadata.(map[string]any)["matched"])
+		})
+	}
+
+	t.Run("expression error -> error", func(t *testing.T) {
+		s := &Switch{}
+		err := s.Execute(core.ExecutionContext{
+			Data:           map[string]any{},
+			Configuration:  cases("production", "$.env +"),
+			ExecutionState: &contexts.ExecutionStateContext{},
+			Metadata:       &contexts.MetadataContext{},
+		})
+
+		require.ErrorContains(t, err, "case production")
+	})
+}
@@ -3,7 +3,9 @@ package upsertmemory
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
@@ -17,6 +19,12 @@ const PayloadType = "memory.upserted"
 const OperationUpdated = "updated"
 const OperationCreated = "created"
 
+const (
+	TTLUnitMinutes = "minutes"
+	TTLUnitHours   = "hours"
+	TTLUnitDays    = "days"
+)
+
 func init() {
 	registry.RegisterComponent(ComponentName, &UpsertMemory{})
 }
@@ -27,6 +35,8 @@ type Spec struct {
 	Namespace string      `json:"namespace"`
 	MatchList []FieldPair `json:"matchList"`
 	ValueList []FieldPair `json:"valueList"`
+	TTL       any         `json:"ttl,omitempty"`
+	TTLUnit   string      `json:"ttlUnit,omitempty"`
 }
 
 type FieldPair struct {
@@ -38,6 +48,11 @@ type canvasMemoryUpdateContext interface {
 	Update(namespace string, matches map[string]any, values map[string]any) ([]any, error)
 }
 
+type canvasMemoryTTLContext interface {
+	AddWithTTL(namespace string, values any, ttl time.Duration) error
+	UpdateWithTTL(namespace string, matches map[string]any, values map[string]any, ttl time.Duration) ([]any, error)
+}
+
 func (c *UpsertMemory) Name() string {
 	return ComponentName
 }
@@ -66,6 +81,12 @@ func (c *UpsertMemory) Documentation() string {
 3. If no rows were updated, inserts a new memory row with the values
 4. Emits ` + "`memory.upserted`" + ` to the default channel with ` + "`operation`" + ` set to ` + "`updated`" + ` or ` + "`created`" + `
 
+## Expiry
+
+When ` + "`Expire After`" + ` is set, updated and created rows are deleted once that time has passed.
+Otherwise, the default TTL of the namespace is used, if it has one. Updating a row renews its expiry.
+Expired rows are never matched, so an upsert on an expired row creates a new one.
+
 ## Simplified Matching
 
 If ` + "`matchList`" + ` is empty, the component treats the namespace as a singleton record and upserts at namespace level.
@@ -164,6 +185,34 @@ func (c *UpsertMemory) Configuration() []configuration.Field {
 				},
 			},
 		},
+		{
+			Name:        "ttl",
+			Label:       "Expire After",
+			Type:        configuration.FieldTypeNumber,
+			Description: "Delete the record after this amount of time. The namespace default is used when not set.",
+			Togglable:   true,
+			TypeOptions: &configuration.TypeOptions{
+				Number: &configuration.NumberTypeOptions{
+					Min: func() *int { min := 1; return &min }(),
+				},
+			},
+		},
+		{
+			Name:        "ttlUnit",
+			Label:       "Expiry Unit",
+			Type:        configuration.FieldTypeSelect,
+			Description: "Time unit for the expiry",
+			Default:     TTLUnitHours,
+			TypeOptions: &configuration.TypeOptions{
+				Select: &configuration.SelectTypeOptions{
+					Options: []configuration.FieldOption{
+						{Label: "Minutes", Value: TTLUnitMinutes},
+						{Label: "Hours", Value: TTLUnitHours},
+						{Label: "Days", Value: TTLUnitDays},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -173,7 +222,20 @@ func (c *UpsertMemory) Setup(ctx core.SetupContext) error {
 		return err
 	}
 	spec = normalizeSpec(spec)
-	return validateSpec(spec)
+	if err := validateSpec(spec); err != nil {
+		return err
+	}
+
+	//
+	// The TTL can be an expression,
+	// so it is only validated on execution.
+	//
+	if ttl, ok := spec.TTL.(string); ok && strings.Contains(ttl, "{{") {
+		return nil
+	}
+
+	_, err = parseTTL(spec.TTL, spec.TTLUnit)
+	return err
 }
 
 func (c *UpsertMemory) Execute(ctx core.ExecutionContext) error {
@@ -186,14 +248,14 @@ func (c *UpsertMemory) Execute(ctx core.ExecutionContext) error {
 		return err
 	}
 
-	updateCtx, ok := ctx.CanvasMemory.(canvasMemoryUpdateContext)
-	if !ok {
-		return fmt.Errorf("canvas memory update operations are not supported")
+	ttl, err := parseTTL(spec.TTL, spec.TTLUnit)
+	if err != nil {
+		return err
 	}
 
 	matches := buildPairs(spec.MatchList)
 	values := buildPairs(spec.ValueList)
-	updatedValues, updateErr := updateCtx.Update(spec.Namespace, matches, values)
+	updatedValues, updateErr := updateMemory(ctx.CanvasMemory, spec.Namespace, matches, values, ttl)
 	if updateErr != nil {
 		return fmt.Errorf("failed to upsert canvas memory: %w", updateErr)
 	}
@@ -202,7 +264,7 @@ func (c *UpsertMemory) Execute(ctx core.ExecutionContext) error {
 	affectedValues := updatedValues
 
 	if len(updatedValues) == 0 {
-		if err := ctx.CanvasMemory.Add(spec.Namespace, values); err != nil {
+		if err := addMemory(ctx.CanvasMemory, spec.Namespace, values, ttl); err != nil {
 			return fmt.Errorf("failed to upsert canvas memory: %w", err)
 		}
 		operation = OperationCreated
@@ -242,6 +304,82 @@ func (c *UpsertMemory) Execute(ctx core.ExecutionContext) error {
 	)
 }
 
+// updateMemory renews the expiry of the updated rows when possible.
+// A zero ttl leaves it to the memory context to use the namespace default.
+func updateMemory(memory core.CanvasMemoryContext, namespace string, matches, values map[string]any, ttl time.Duration) ([]any, error) {
+	if ttlCtx, ok := memory.(canvasMemoryTTLContext); ok {
+		return ttlCtx.UpdateWithTTL(namespace, matches, values, ttl)
+	}
+
+	if ttl > 0 {
+		return nil, fmt.Errorf("canvas memory expiry is not supported")
+	}
+
+	updateCtx, ok := memory.(canvasMemoryUpdateContext)
+	if !ok {
+		return nil, fmt.Errorf("canvas memory update operations are not supported")
+	}
+
+	return updateCtx.Update(namespace, matches, values)
+}
+
+func addMemory(memory core.CanvasMemoryContext, namespace string, values any, ttl time.Duration) error {
+	if ttl == 0 {
+		return memory.Add(namespace, values)
+	}
+
+	ttlCtx, ok := memory.(canvasMemoryTTLContext)
+	if !ok {
+		return fmt.Errorf("canvas memory expiry is not supported")
+	}
+
+	return ttlCtx.AddWithTTL(namespace, values, ttl)
+}
+
+// parseTTL returns zero when no TTL is configured,
+// so the default TTL of the namespace is used.
+func parseTTL(value any, unit string) (time.Duration, error) {
+	var amount int
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		amount = v
+	case int64:
+		amount = int(v)
+	case float64:
+		amount = int(v)
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return 0, nil
+		}
+
+		parsed, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("ttl is not a valid integer: %s", v)
+		}
+
+		amount = parsed
+	default:
+		return 0, fmt.Errorf("ttl must be an integer, got %T", value)
+	}
+
+	if amount <= 0 {
+		return 0, fmt.Errorf("ttl must be positive, got: %d", amount)
+	}
+
+	switch unit {
+	case TTLUnitMinutes:
+		return time.Duration(amount) * time.Minute, nil
+	case "", TTLUnitHours:
+		return time.Duration(amount) * time.Hour, nil
+	case TTLUnitDays:
+		return time.Duration(amount) * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid ttl unit: %s", unit)
+	}
+}
+
 func decodeSpec(raw any) (Spec, error) {
 	var spec Spec
 	if err := mapstructure.Decode(raw, &spec); err != nil {
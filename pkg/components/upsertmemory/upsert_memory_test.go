@@ -3,6 +3,7 @@ package upsertmemory
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/superplanehq/superplane/pkg/core"
@@ -49,6 +50,21 @@ func (c *canvasMemoryContext) Update(namespace string, matches map[string]any, v
 	return c.updatedValues, nil
 }
 
+type expiringCanvasMemoryContext struct {
+	canvasMemoryContext
+	ttl time.Duration
+}
+
+func (c *expiringCanvasMemoryContext) AddWithTTL(namespace string, values any, ttl time.Duration) error {
+	c.ttl = ttl
+	return c.Add(namespace, values)
+}
+
+func (c *expiringCanvasMemoryContext) UpdateWithTTL(namespace string, matches map[string]any, values map[string]any, ttl time.Duration) ([]any, error) {
+	c.ttl = ttl
+	return c.Update(namespace, matches, values)
+}
+
 func TestUpsertMemoryExecute(t *testing.T) {
 	t.Run("updates matches and emits updated channel", func(t *testing.T) {
 		component := &UpsertMemory{}
@@ -222,6 +238,61 @@ func TestUpsertMemoryExecute(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to upsert canvas memory")
 	})
+
+	t.Run("creates row with ttl when no match exists", func(t *testing.T) {
+		component := &UpsertMemory{}
+		memoryCtx := &expiringCanvasMemoryContext{
+			canvasMemoryContext: canvasMemoryContext{updatedValues: []any{}},
+		}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "incidents",
+				"matchList": []map[string]any{
+					{"name": "id", "value": "INC-1"},
+				},
+				"valueList": []map[string]any{
+					{"name": "state", "value": "open"},
+				},
+				"ttl":     30,
+				"ttlUnit": TTLUnitMinutes,
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, memoryCtx.updateCalls)
+		assert.Equal(t, 1, memoryCtx.addCalls)
+		assert.Equal(t, 30*time.Minute, memoryCtx.ttl)
+	})
+
+	t.Run("ttl requires expiry support", func(t *testing.T) {
+		component := &UpsertMemory{}
+		memoryCtx := &canvasMemoryContext{updatedValues: []any{}}
+
+		err := component.Execute(core.ExecutionContext{
+			Configuration: map[string]any{
+				"namespace": "incidents",
+				"matchList": []map[string]any{
+					{"name": "id", "value": "INC-1"},
+				},
+				"valueList": []map[string]any{
+					{"name": "state", "value": "open"},
+				},
+				"ttl": 1,
+			},
+			Metadata:       &contexts.MetadataContext{},
+			NodeMetadata:   &contexts.MetadataContext{},
+			CanvasMemory:   memoryCtx,
+			ExecutionState: &contexts.ExecutionStateContext{},
+		})
+
+		assert.ErrorContains(t, err, "canvas memory expiry is not supported")
+		assert.Equal(t, 0, memoryCtx.updateCalls)
+	})
 }
 
 func TestUpsertMemorySetup(t *testing.T) {
@@ -284,4 +355,19 @@ func TestUpsertMemorySetup(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	})
+
+	t.Run("invalid ttl unit fails", func(t *testing.T) {
+		err := component.Setup(core.SetupContext{
+			Configuration: map[string]any{
+				"namespace": "deployments",
+				"valueList": []map[string]any{
+					{"name": "latest_deployment", "value": "v1.0.1"},
+				},
+				"ttl":     1,
+				"ttlUnit": "weeks",
+			},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid ttl unit")
+	})
 }
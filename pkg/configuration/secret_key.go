@@ -8,3 +8,66 @@ type SecretKeyRef struct {
 func (r SecretKeyRef) IsSet() bool {
 	return r.Secret != "" && r.Key != ""
 }
+
+// SecretKeyRefs returns the secret keys a configuration uses,
+// including the ones in fields of objects and list items.
+func SecretKeyRefs(fields []Field, config map[string]any) []SecretKeyRef {
+	refs := []SecretKeyRef{}
+	for _, field := range fields {
+		value, ok := config[field.Name]
+		if !ok || value == nil {
+			continue
+		}
+
+		refs = append(refs, secretKeyRefsForField(field, value)...)
+	}
+
+	return refs
+}
+
+func secretKeyRefsForField(field Field, value any) []SecretKeyRef {
+	switch field.Type {
+	case FieldTypeSecretKey:
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		secret, _ := m["secret"].(string)
+		key, _ := m["key"].(string)
+		ref := SecretKeyRef{Secret: secret, Key: key}
+		if !ref.IsSet() {
+			return nil
+		}
+
+		return []SecretKeyRef{ref}
+
+	case FieldTypeObject:
+		m, ok := value.(map[string]any)
+		if !ok || field.TypeOptions == nil || field.TypeOptions.Object == nil {
+			return nil
+		}
+
+		return SecretKeyRefs(field.TypeOptions.Object.Schema, m)
+
+	case FieldTypeList:
+		items, ok := value.([]any)
+		if !ok || field.TypeOptions == nil || field.TypeOptions.List == nil || field.TypeOptions.List.ItemDefinition == nil {
+			return nil
+		}
+
+		itemField := Field{
+			Type:        field.TypeOptions.List.ItemDefinition.Type,
+			TypeOptions: &TypeOptions{Object: &ObjectTypeOptions{Schema: field.TypeOptions.List.ItemDefinition.Schema}},
+		}
+
+		refs := []SecretKeyRef{}
+		for _, item := range items {
+			refs = append(refs, secretKeyRefsForField(itemField, item)...)
+		}
+
+		return refs
+	}
+
+	return nil
+}
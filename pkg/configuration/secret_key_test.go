@@ -0,0 +1,53 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretKeyRefs(t *testing.T) {
+	fields := []Field{
+		{Name: "token", Type: FieldTypeSecretKey},
+		{
+			Name: "auth",
+			Type: FieldTypeObject,
+			TypeOptions: &TypeOptions{
+				Object: &ObjectTypeOptions{
+					Schema: []Field{{Name: "password", Type: FieldTypeSecretKey}},
+				},
+			},
+		},
+		{
+			Name: "env",
+			Type: FieldTypeList,
+			TypeOptions: &TypeOptions{
+				List: &ListTypeOptions{
+					ItemDefinition: &ListItemDefinition{
+						Type:   FieldTypeObject,
+						Schema: []Field{{Name: "value", Type: FieldTypeSecretKey}},
+					},
+				},
+			},
+		},
+		{Name: "name", Type: FieldTypeString},
+	}
+
+	refs := SecretKeyRefs(fields, map[string]any{
+		"token": map[string]any{"secret": "github", "key": "token"},
+		"auth":  map[string]any{"password": map[string]any{"secret": "ssh", "key": "password"}},
+		"env": []any{
+			map[string]any{"value": map[string]any{"secret": "app", "key": "API_KEY"}},
+			map[string]any{"value": map[string]any{"secret": "app", "key": ""}},
+		},
+		"name": "deploy",
+	})
+
+	assert.Equal(t, []SecretKeyRef{
+		{Secret: "github", Key: "token"},
+		{Secret: "ssh", Key: "password"},
+		{Secret: "app", Key: "API_KEY"},
+	}, refs)
+
+	assert.Empty(t, SecretKeyRefs(fields, map[string]any{}))
+}
@@ -154,6 +154,9 @@ type ExecutionContext struct {
 	Notifications  NotificationContext
 	Secrets        SecretsContext
 	CanvasMemory   CanvasMemoryContext
+	Canvases       CanvasRunContext
+	Downstream     DownstreamContext
+	Logs           ExecutionLogContext
 	Webhook        NodeWebhookContext
 }
 
@@ -198,6 +201,86 @@ type CanvasMemoryContext interface {
 	FindFirst(namespace string, matches map[string]any) (any, error)
 }
 
+const (
+	CanvasRunStateRunning = "running"
+	CanvasRunStatePassed  = "passed"
+	CanvasRunStateFailed  = "failed"
+)
+
+/*
+ * CanvasRunContext allows components to start runs
+ * of other canvases in the same organization, and follow them.
+ */
+type CanvasRunContext interface {
+
+	/*
+	 * Emits the payload from the start trigger of the canvas,
+	 * returning the ID of the root event for the new run.
+	 */
+	Start(canvasID string, payload any) (string, error)
+
+	/*
+	 * Returns the current state of a run.
+	 */
+	Get(canvasID, runID string) (*CanvasRun, error)
+}
+
+type CanvasRun struct {
+	ID      string
+	State   string
+	Message string
+
+	//
+	// Payloads emitted by the terminal nodes of the canvas, by node ID.
+	// Only available once the run is finished.
+	//
+	Outputs map[string][]any
+}
+
+const (
+	DownstreamStateRunning = "running"
+	DownstreamStatePassed  = "passed"
+	DownstreamStateFailed  = "failed"
+)
+
+/*
+ * DownstreamContext allows components to follow
+ * the executions started by the events they emit.
+ */
+type DownstreamContext interface {
+
+	/*
+	 * Returns the current state of everything
+	 * started in the canvas from the given event.
+	 */
+	Get(eventID string) (*DownstreamResult, error)
+}
+
+type DownstreamResult struct {
+	State   string
+	Message string
+
+	//
+	// Payloads emitted on channels that lead nowhere, by node ID.
+	// Only available once nothing is left to run.
+	//
+	Outputs map[string][]any
+}
+
+/*
+ * ExecutionLogContext allows components to write log lines
+ * for an execution, which users can follow while it runs.
+ *
+ * Writing logs never fails the execution:
+ * errors are reported in the server logs only.
+ */
+type ExecutionLogContext interface {
+	Debug(message string, fields map[string]any)
+	Info(message string, fields map[string]any)
+	Warn(message string, fields map[string]any)
+	Error(message string, fields map[string]any)
+}
+
 /*
  * ExecutionStateContext allows components to control execution lifecycle.
  */
@@ -210,6 +293,12 @@ type ExecutionStateContext interface {
 	 */
 	Emit(channel, payloadType string, payloads []any) error
 
+	/*
+	 * Emit payloads to the specified channel, without finishing the execution.
+	 * Returns the IDs of the emitted events.
+	 */
+	EmitAndContinue(channel, payloadType string, payloads []any) ([]string, error)
+
 	/*
 	 * Pass the execution, without emitting any payloads from it.
 	 */
@@ -261,6 +350,9 @@ type ActionContext struct {
 	Integration    IntegrationContext
 	Notifications  NotificationContext
 	Secrets        SecretsContext
+	Canvases       CanvasRunContext
+	Downstream     DownstreamContext
+	Logs           ExecutionLogContext
 }
 
 /*
@@ -0,0 +1,153 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const dataKeySize = 32
+
+// envelopeMagic marks ciphertexts produced by the EnvelopeEncryptor,
+// so they can be told apart from the ones produced by the AESGCMEncryptor.
+var envelopeMagic = []byte{'S', 'P', 'E', 1}
+
+// EnvelopeHeaderLength is the maximum length of the header
+// of a ciphertext, which has the ID of its master key.
+const EnvelopeHeaderLength = 4 + 1 + MaxKeyIDLength
+
+// RotatableEncryptor is implemented by encryptors
+// which can tell if a ciphertext uses an old key.
+type RotatableEncryptor interface {
+	Encryptor
+	NeedsRotation(ciphertext []byte) bool
+	CurrentPrefix() []byte
+}
+
+// EnvelopeEncryptor encrypts every value with its own data key,
+// which is wrapped by the current master key of the KeyManager
+// and stored together with the ciphertext, as:
+//
+//	magic | key ID length (1) | key ID | wrapped key length (2) | wrapped key | nonce + ciphertext
+//
+// Ciphertexts without the magic prefix were produced by the AESGCMEncryptor
+// before envelope encryption was enabled, and are decrypted with the legacy encryptor.
+type EnvelopeEncryptor struct {
+	keyManager KeyManager
+	legacy     Encryptor
+}
+
+func NewEnvelopeEncryptor(keyManager KeyManager, legacy Encryptor) *EnvelopeEncryptor {
+	return &EnvelopeEncryptor{keyManager: keyManager, legacy: legacy}
+}
+
+func (e *EnvelopeEncryptor) Encrypt(ctx context.Context, data []byte, associatedData []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := NewAESGCMEncryptor(dataKey).Encrypt(ctx, data, associatedData)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := e.keyManager.CurrentKeyID()
+	wrapped, err := e.keyManager.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data key: %w", err)
+	}
+
+	if len(wrapped) > 0xFFFF {
+		return nil, errors.New("wrapped data key too long")
+	}
+
+	out := bytes.NewBuffer(envelopePrefix(keyID))
+	_ = binary.Write(out, binary.BigEndian, uint16(len(wrapped)))
+	out.Write(wrapped)
+	out.Write(ciphertext)
+	return out.Bytes(), nil
+}
+
+func (e *EnvelopeEncryptor) Decrypt(ctx context.Context, ciphertext []byte, associatedData []byte) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, envelopeMagic) {
+		if e.legacy == nil {
+			return nil, errors.New("ciphertext was not encrypted with envelope encryption")
+		}
+
+		return e.legacy.Decrypt(ctx, ciphertext, associatedData)
+	}
+
+	keyID, wrapped, data, err := parseEnvelope(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := e.keyManager.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key: %w", err)
+	}
+
+	return NewAESGCMEncryptor(dataKey).Decrypt(ctx, data, associatedData)
+}
+
+// NeedsRotation tells if a ciphertext was not encrypted with the current master key.
+func (e *EnvelopeEncryptor) NeedsRotation(ciphertext []byte) bool {
+	return !bytes.HasPrefix(ciphertext, e.CurrentPrefix())
+}
+
+// CurrentPrefix is the prefix of every ciphertext encrypted with the current master key.
+func (e *EnvelopeEncryptor) CurrentPrefix() []byte {
+	return envelopePrefix(e.keyManager.CurrentKeyID())
+}
+
+// KeyID returns the ID of the master key used for a ciphertext,
+// or false if the ciphertext does not use envelope encryption.
+// Only the header is read, so it works on the first EnvelopeHeaderLength bytes too.
+func KeyID(ciphertext []byte) (string, bool) {
+	if !bytes.HasPrefix(ciphertext, envelopeMagic) || len(ciphertext) <= len(envelopeMagic) {
+		return "", false
+	}
+
+	start := len(envelopeMagic) + 1
+	end := start + int(ciphertext[len(envelopeMagic)])
+	if len(ciphertext) < end {
+		return "", false
+	}
+
+	return string(ciphertext[start:end]), true
+}
+
+func envelopePrefix(keyID string) []byte {
+	prefix := make([]byte, 0, len(envelopeMagic)+1+len(keyID))
+	prefix = append(prefix, envelopeMagic...)
+	prefix = append(prefix, byte(len(keyID)))
+	return append(prefix, keyID...)
+}
+
+func parseEnvelope(ciphertext []byte) (string, []byte, []byte, error) {
+	rest := ciphertext[len(envelopeMagic):]
+	if len(rest) < 1 {
+		return "", nil, nil, errors.New("ciphertext too short for key ID")
+	}
+
+	keyIDLength := int(rest[0])
+	rest = rest[1:]
+	if len(rest) < keyIDLength+2 {
+		return "", nil, nil, errors.New("ciphertext too short for key ID")
+	}
+
+	keyID := string(rest[:keyIDLength])
+	rest = rest[keyIDLength:]
+
+	wrappedLength := int(binary.BigEndian.Uint16(rest[:2]))
+	rest = rest[2:]
+	if len(rest) < wrappedLength {
+		return "", nil, nil, errors.New("ciphertext too short for data key")
+	}
+
+	return keyID, rest[:wrappedLength], rest[wrappedLength:], nil
+}
@@ -0,0 +1,123 @@
+package crypto
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__EnvelopeEncryptor(t *testing.T) {
+	ctx := context.Background()
+	legacy := NewAESGCMEncryptor([]byte("0123456789abcdef0123456789abcdef"))
+	keys := map[string][]byte{
+		"v1": []byte("abcdefghijklmnopqrstuvwxyz012345"),
+		"v2": []byte("543210zyxwvutsrqponmlkjihgfedcba"),
+	}
+
+	v1, err := NewLocalKeyManager("v1", keys)
+	require.NoError(t, err)
+	v2, err := NewLocalKeyManager("v2", keys)
+	require.NoError(t, err)
+
+	t.Run("encrypts and decrypts properly", func(t *testing.T) {
+		encryptor := NewEnvelopeEncryptor(v1, legacy)
+		ciphertext, err := encryptor.Encrypt(ctx, []byte("testing encryption"), []byte("aaaa"))
+		require.NoError(t, err)
+
+		keyID, ok := KeyID(ciphertext)
+		require.True(t, ok)
+		assert.Equal(t, "v1", keyID)
+
+		keyID, ok = KeyID(ciphertext[:EnvelopeHeaderLength])
+		require.True(t, ok)
+		assert.Equal(t, "v1", keyID)
+
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, []byte("aaaa"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("testing encryption"), plaintext)
+
+		_, err = encryptor.Decrypt(ctx, ciphertext, []byte("bbbb"))
+		require.Error(t, err)
+	})
+
+	t.Run("every value gets its own data key", func(t *testing.T) {
+		encryptor := NewEnvelopeEncryptor(v1, legacy)
+		a, err := encryptor.Encrypt(ctx, []byte("same"), nil)
+		require.NoError(t, err)
+		b, err := encryptor.Encrypt(ctx, []byte("same"), nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("values encrypted with an old master key can be decrypted and rotated", func(t *testing.T) {
+		old := NewEnvelopeEncryptor(v1, legacy)
+		ciphertext, err := old.Encrypt(ctx, []byte("rotate me"), []byte("aaaa"))
+		require.NoError(t, err)
+
+		encryptor := NewEnvelopeEncryptor(v2, legacy)
+		assert.True(t, encryptor.NeedsRotation(ciphertext))
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, []byte("aaaa"))
+		require.NoError(t, err)
+
+		rotated, err := encryptor.Encrypt(ctx, plaintext, []byte("aaaa"))
+		require.NoError(t, err)
+		assert.False(t, encryptor.NeedsRotation(rotated))
+	})
+
+	t.Run("legacy values are decrypted with the legacy encryptor", func(t *testing.T) {
+		ciphertext, err := legacy.Encrypt(ctx, []byte("legacy"), []byte("aaaa"))
+		require.NoError(t, err)
+
+		encryptor := NewEnvelopeEncryptor(v1, legacy)
+		assert.True(t, encryptor.NeedsRotation(ciphertext))
+		plaintext, err := encryptor.Decrypt(ctx, ciphertext, []byte("aaaa"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("legacy"), plaintext)
+
+		_, ok := KeyID(ciphertext)
+		assert.False(t, ok)
+	})
+
+	t.Run("values from a removed master key cannot be decrypted", func(t *testing.T) {
+		ciphertext, err := NewEnvelopeEncryptor(v1, legacy).Encrypt(ctx, []byte("data"), nil)
+		require.NoError(t, err)
+
+		onlyV2, err := NewLocalKeyManager("v2", map[string][]byte{"v2": keys["v2"]})
+		require.NoError(t, err)
+
+		_, err = NewEnvelopeEncryptor(onlyV2, legacy).Decrypt(ctx, ciphertext, nil)
+		require.ErrorIs(t, err, ErrUnknownKeyID)
+	})
+
+	t.Run("truncated ciphertext", func(t *testing.T) {
+		ciphertext, err := NewEnvelopeEncryptor(v1, legacy).Encrypt(ctx, []byte("data"), nil)
+		require.NoError(t, err)
+
+		_, err = NewEnvelopeEncryptor(v1, legacy).Decrypt(ctx, ciphertext[:8], nil)
+		require.Error(t, err)
+	})
+}
+
+func Test__ParseMasterKeys(t *testing.T) {
+	keys, err := ParseMasterKeys("v1=abcdefghijklmnopqrstuvwxyz012345, v2=543210zyxwvutsrqponmlkjihgfedcba")
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	manager, err := NewLocalKeyManager("v2", keys)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2"}, manager.KeyIDs())
+
+	_, err = NewLocalKeyManager("v3", keys)
+	require.ErrorContains(t, err, "current key v3 not found")
+
+	_, err = ParseMasterKeys("v1")
+	require.ErrorContains(t, err, "expected id=key")
+
+	_, err = ParseMasterKeys("v1=a,v1=b")
+	require.ErrorContains(t, err, "duplicate master key v1")
+
+	_, err = NewLocalKeyManager("v1", map[string][]byte{"v1": []byte("short")})
+	require.ErrorContains(t, err, "must have 16, 24 or 32 bytes")
+}
@@ -0,0 +1,153 @@
+package crypto
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	KeyManagerLocal = "local"
+
+	MaxKeyIDLength = 64
+)
+
+var ErrUnknownKeyID = errors.New("unknown key ID")
+
+// KeyManager wraps and unwraps the data keys used by the EnvelopeEncryptor
+// with master keys which never leave it. Master keys are identified by an ID,
+// so data keys wrapped with older master keys can still be unwrapped
+// after a new one becomes the current one.
+type KeyManager interface {
+	CurrentKeyID() string
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// LocalKeyManager keeps the master keys in memory,
+// and wraps data keys with AES-GCM.
+type LocalKeyManager struct {
+	currentKeyID string
+	keys         map[string][]byte
+}
+
+func NewLocalKeyManager(currentKeyID string, keys map[string][]byte) (*LocalKeyManager, error) {
+	if _, ok := keys[currentKeyID]; !ok {
+		return nil, fmt.Errorf("current key %s not found", currentKeyID)
+	}
+
+	for id, key := range keys {
+		if err := validateKeyID(id); err != nil {
+			return nil, err
+		}
+
+		if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+			return nil, fmt.Errorf("key %s must have 16, 24 or 32 bytes", id)
+		}
+	}
+
+	return &LocalKeyManager{currentKeyID: currentKeyID, keys: keys}, nil
+}
+
+// ParseMasterKeys parses a comma-separated list of id=key pairs,
+// the format used by the ENCRYPTION_MASTER_KEYS environment variable.
+func ParseMasterKeys(value string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		id, key, ok := strings.Cut(entry, "=")
+		if !ok || id == "" || key == "" {
+			return nil, fmt.Errorf("invalid master key entry, expected id=key")
+		}
+
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("duplicate master key %s", id)
+		}
+
+		keys[id] = []byte(key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no master keys found")
+	}
+
+	return keys, nil
+}
+
+func (m *LocalKeyManager) CurrentKeyID() string {
+	return m.currentKeyID
+}
+
+// KeyIDs returns the IDs of all the master keys, sorted.
+func (m *LocalKeyManager) KeyIDs() []string {
+	ids := make([]string, 0, len(m.keys))
+	for id := range m.keys {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+	return ids
+}
+
+func (m *LocalKeyManager) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	gcm, err := m.cipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (m *LocalKeyManager) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	gcm, err := m.cipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(wrapped) < nonceSize {
+		return nil, errors.New("wrapped key too short for nonce")
+	}
+
+	return gcm.Open(nil, wrapped[:nonceSize], wrapped[nonceSize:], []byte(keyID))
+}
+
+func (m *LocalKeyManager) cipher(keyID string) (cipher.AEAD, error) {
+	key, ok := m.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyID, keyID)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func validateKeyID(id string) error {
+	if id == "" || len(id) > MaxKeyIDLength {
+		return fmt.Errorf("key ID must have between 1 and %d characters", MaxKeyIDLength)
+	}
+
+	if strings.ContainsAny(id, ",= ") {
+		return fmt.Errorf("invalid key ID %q", id)
+	}
+
+	return nil
+}
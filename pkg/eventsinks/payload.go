@@ -0,0 +1,122 @@
+package eventsinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	EventTypeCanvasEventCreated    = "canvas.event_created"
+	EventTypeExecutionStateChanged = "execution.state_changed"
+	EventTypeAuditEventRecorded    = "audit.event_recorded"
+)
+
+// Envelope is the JSON document sent to sinks for every event.
+type Envelope struct {
+	ID             string         `json:"id"`
+	Type           string         `json:"type"`
+	OrganizationID string         `json:"organizationId"`
+	Timestamp      time.Time      `json:"timestamp"`
+	Data           map[string]any `json:"data"`
+}
+
+// Enqueue adds an event to the backlog of the sink.
+// The dedup key identifies the event, so the same event is only delivered once.
+func Enqueue(sink *models.EventSink, eventType string, dedupKey string, data map[string]any) error {
+	id := uuid.New()
+	payload, err := json.Marshal(Envelope{
+		ID:             id.String(),
+		Type:           eventType,
+		OrganizationID: sink.OrganizationID.String(),
+		Timestamp:      time.Now().UTC(),
+		Data:           data,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error building payload: %v", err)
+	}
+
+	return models.CreateEventSinkDelivery(&models.EventSinkDelivery{
+		ID:        id,
+		SinkID:    sink.ID,
+		EventType: eventType,
+		DedupKey:  dedupKey,
+		Payload:   payload,
+	})
+}
+
+func CanvasEventData(canvas *models.Canvas, event *models.CanvasEvent) map[string]any {
+	data := map[string]any{
+		"id":         event.ID.String(),
+		"canvasId":   canvas.ID.String(),
+		"canvasName": canvas.Name,
+		"nodeId":     event.NodeID,
+		"channel":    event.Channel,
+		"data":       event.Data.Data(),
+		"createdAt":  event.CreatedAt,
+	}
+
+	if event.CustomName != nil {
+		data["customName"] = *event.CustomName
+	}
+
+	if event.ExecutionID != nil {
+		data["executionId"] = event.ExecutionID.String()
+	}
+
+	return data
+}
+
+func ExecutionData(canvas *models.Canvas, execution *models.CanvasNodeExecution) map[string]any {
+	data := map[string]any{
+		"id":            execution.ID.String(),
+		"canvasId":      canvas.ID.String(),
+		"canvasName":    canvas.Name,
+		"nodeId":        execution.NodeID,
+		"rootEventId":   execution.RootEventID.String(),
+		"state":         execution.State,
+		"result":        execution.Result,
+		"resultReason":  execution.ResultReason,
+		"resultMessage": execution.ResultMessage,
+		"createdAt":     execution.CreatedAt,
+		"updatedAt":     execution.UpdatedAt,
+	}
+
+	if execution.ParentExecutionID != nil {
+		data["parentExecutionId"] = execution.ParentExecutionID.String()
+	}
+
+	return data
+}
+
+// ExecutionDedupKey identifies a state of an execution,
+// since the same execution is reported once for every state it goes through.
+func ExecutionDedupKey(execution *models.CanvasNodeExecution) string {
+	return fmt.Sprintf("execution:%s:%s:%s", execution.ID, execution.State, execution.Result)
+}
+
+func AuditEventData(event *models.AuditEvent) map[string]any {
+	data := map[string]any{
+		"id":           event.ID.String(),
+		"resourceType": event.ResourceType,
+		"resourceId":   event.ResourceID,
+		"action":       event.Action,
+		"method":       event.Method,
+		"status":       event.Status,
+		"error":        event.Error,
+		"before":       event.Before.Data(),
+		"after":        event.After.Data(),
+		"metadata":     event.Metadata.Data(),
+		"createdAt":    event.CreatedAt,
+	}
+
+	if event.ActorID != nil {
+		data["actorId"] = event.ActorID.String()
+	}
+
+	return data
+}
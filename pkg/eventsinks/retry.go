@@ -0,0 +1,34 @@
+package eventsinks
+
+import (
+	"time"
+)
+
+const (
+	MaxAttempts = 12
+
+	initialBackoff = 5 * time.Second
+	maxBackoff     = time.Hour
+)
+
+// NextAttemptAt returns when a delivery which failed for the given number of times
+// should be attempted again, or nil when it should not be retried anymore.
+// The delay doubles on every attempt, so a sink which is down
+// is retried for a few hours before deliveries are given up on.
+func NextAttemptAt(now time.Time, attempts int) *time.Time {
+	if attempts >= MaxAttempts {
+		return nil
+	}
+
+	backoff := initialBackoff
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	next := now.Add(backoff)
+	return &next
+}
@@ -0,0 +1,37 @@
+package eventsinks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__NextAttemptAt(t *testing.T) {
+	now := time.Now()
+
+	t.Run("delay doubles on every attempt", func(t *testing.T) {
+		next := NextAttemptAt(now, 1)
+		require.NotNil(t, next)
+		assert.Equal(t, 5*time.Second, next.Sub(now))
+
+		next = NextAttemptAt(now, 2)
+		require.NotNil(t, next)
+		assert.Equal(t, 10*time.Second, next.Sub(now))
+
+		next = NextAttemptAt(now, 4)
+		require.NotNil(t, next)
+		assert.Equal(t, 40*time.Second, next.Sub(now))
+	})
+
+	t.Run("delay is capped", func(t *testing.T) {
+		next := NextAttemptAt(now, MaxAttempts-1)
+		require.NotNil(t, next)
+		assert.Equal(t, time.Hour, next.Sub(now))
+	})
+
+	t.Run("gives up after the max attempts", func(t *testing.T) {
+		assert.Nil(t, NextAttemptAt(now, MaxAttempts))
+	})
+}
@@ -0,0 +1,160 @@
+package eventsinks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+const (
+	SignatureHeader = "X-Signature-256"
+	EventTypeHeader = "X-Superplane-Event"
+	DeliveryHeader  = "X-Superplane-Delivery"
+
+	sendTimeout = 10 * time.Second
+
+	//
+	// Facility 13 (log audit) and severity 6 (informational).
+	//
+	syslogPriority = 13*8 + 6
+
+	//
+	// Private enterprise number reserved for documentation by RFC 5612,
+	// used to namespace the structured data of the syslog messages.
+	//
+	syslogStructuredDataID = "superplane@32473"
+)
+
+// Signature returns the HMAC-SHA256 signature of a payload,
+// in the same format the webhook trigger verifies.
+func Signature(secret []byte, payload []byte) string {
+	return "sha256=" + crypto.Sign(secret, payload)
+}
+
+// Send delivers one event to the sink.
+// Sink URLs are user-provided, so connections go through the protected HTTP context,
+// which rejects blocked hosts and private IP ranges.
+func Send(ctx context.Context, httpCtx *registry.HTTPContext, sink *models.EventSink, secret []byte, delivery *models.EventSinkDelivery) error {
+	switch sink.Type {
+	case models.EventSinkTypeWebhook:
+		return sendWebhook(ctx, httpCtx, sink.URL, secret, delivery)
+	case models.EventSinkTypeSyslog:
+		return sendSyslog(ctx, httpCtx, sink.URL, secret, delivery)
+	default:
+		return fmt.Errorf("unsupported event sink type %s", sink.Type)
+	}
+}
+
+func sendWebhook(ctx context.Context, httpCtx *registry.HTTPContext, sinkURL string, secret []byte, delivery *models.EventSinkDelivery) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sinkURL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Signature(secret, delivery.Payload))
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+
+	resp, err := httpCtx.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sink responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func sendSyslog(ctx context.Context, httpCtx *registry.HTTPContext, sinkURL string, secret []byte, delivery *models.EventSinkDelivery) error {
+	u, err := url.Parse(sinkURL)
+	if err != nil {
+		return err
+	}
+
+	network := "tcp"
+	switch u.Scheme {
+	case "udp":
+		network = "udp"
+	case "tcp", "tls":
+	default:
+		return fmt.Errorf("unsupported syslog scheme %s", u.Scheme)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	conn, err := httpCtx.DialContext(ctx, network, u.Host)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme == "tls" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: u.Hostname()})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return err
+		}
+
+		conn = tlsConn
+	}
+
+	defer conn.Close()
+
+	message := SyslogMessage(time.Now(), hostname(), secret, delivery)
+	_ = conn.SetWriteDeadline(time.Now().Add(sendTimeout))
+
+	//
+	// Stream transports use octet counting framing (RFC 6587),
+	// so the JSON payload does not need to be escaped.
+	//
+	if u.Scheme != "udp" {
+		message = fmt.Appendf(nil, "%d %s", len(message), message)
+	}
+
+	_, err = conn.Write(message)
+	return err
+}
+
+// SyslogMessage formats an event as an RFC 5424 message,
+// with the event type as the message ID and the signature as structured data.
+func SyslogMessage(now time.Time, host string, secret []byte, delivery *models.EventSinkDelivery) []byte {
+	return fmt.Appendf(nil,
+		"<%d>1 %s %s superplane - %s [%s id=\"%s\" signature=\"%s\"] %s",
+		syslogPriority,
+		now.UTC().Format(time.RFC3339Nano),
+		host,
+		delivery.EventType,
+		syslogStructuredDataID,
+		delivery.ID.String(),
+		Signature(secret, delivery.Payload),
+		delivery.Payload,
+	)
+}
+
+func hostname() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		return "-"
+	}
+
+	return host
+}
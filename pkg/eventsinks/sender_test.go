@@ -0,0 +1,166 @@
+package eventsinks
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+func Test__SendWebhook(t *testing.T) {
+	httpCtx, err := registry.NewHTTPContext(registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	blockedCtx, err := registry.NewHTTPContext(registry.HTTPOptions{PrivateIPRanges: []string{"127.0.0.0/8"}})
+	require.NoError(t, err)
+
+	secret := []byte("0123456789abcdef")
+	delivery := &models.EventSinkDelivery{
+		ID:        uuid.New(),
+		EventType: EventTypeAuditEventRecorded,
+		Payload:   []byte(`{"type":"audit.event_recorded"}`),
+	}
+
+	t.Run("posts the signed payload", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sink := &models.EventSink{Type: models.EventSinkTypeWebhook, URL: server.URL}
+		require.NoError(t, Send(context.Background(), httpCtx, sink, secret, delivery))
+		require.NotNil(t, received)
+
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, EventTypeAuditEventRecorded, received.Header.Get(EventTypeHeader))
+		assert.Equal(t, delivery.ID.String(), received.Header.Get(DeliveryHeader))
+		assert.Equal(t, "sha256="+crypto.Sign(secret, delivery.Payload), received.Header.Get(SignatureHeader))
+		assert.JSONEq(t, string(delivery.Payload), string(body))
+	})
+
+	t.Run("non-2xx responses are errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sink := &models.EventSink{Type: models.EventSinkTypeWebhook, URL: server.URL}
+		err := Send(context.Background(), httpCtx, sink, secret, delivery)
+		require.ErrorContains(t, err, "status 503")
+	})
+
+	t.Run("private addresses are not allowed", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sink := &models.EventSink{Type: models.EventSinkTypeWebhook, URL: server.URL}
+		err := Send(context.Background(), blockedCtx, sink, secret, delivery)
+		require.ErrorContains(t, err, "private IP address 127.0.0.1 is not allowed")
+		assert.Zero(t, requests)
+	})
+}
+
+func Test__SendSyslog(t *testing.T) {
+	httpCtx, err := registry.NewHTTPContext(registry.HTTPOptions{})
+	require.NoError(t, err)
+
+	blockedCtx, err := registry.NewHTTPContext(registry.HTTPOptions{PrivateIPRanges: []string{"127.0.0.0/8"}})
+	require.NoError(t, err)
+
+	secret := []byte("0123456789abcdef")
+	delivery := &models.EventSinkDelivery{
+		ID:        uuid.New(),
+		EventType: EventTypeExecutionStateChanged,
+		Payload:   []byte(`{"type":"execution.state_changed"}`),
+	}
+
+	t.Run("tcp messages are octet counted", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		received := make(chan string, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				received <- ""
+				return
+			}
+
+			defer conn.Close()
+			data, _ := io.ReadAll(bufio.NewReader(conn))
+			received <- string(data)
+		}()
+
+		sink := &models.EventSink{Type: models.EventSinkTypeSyslog, URL: "tcp://" + listener.Addr().String()}
+		require.NoError(t, Send(context.Background(), httpCtx, sink, secret, delivery))
+
+		message := <-received
+		length, rest, found := strings.Cut(message, " ")
+		require.True(t, found)
+		assert.Equal(t, fmt.Sprintf("%d", len(rest)), length)
+		assert.True(t, strings.HasPrefix(rest, "<110>1 "))
+		assert.True(t, strings.HasSuffix(rest, string(delivery.Payload)))
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		sink := &models.EventSink{Type: models.EventSinkTypeSyslog, URL: "http://localhost:514"}
+		err := Send(context.Background(), httpCtx, sink, secret, delivery)
+		require.ErrorContains(t, err, "unsupported syslog scheme")
+	})
+
+	t.Run("private addresses are not allowed", func(t *testing.T) {
+		for _, scheme := range []string{"udp", "tcp", "tls"} {
+			sink := &models.EventSink{Type: models.EventSinkTypeSyslog, URL: scheme + "://127.0.0.1:514"}
+			err := Send(context.Background(), blockedCtx, sink, secret, delivery)
+			require.ErrorContains(t, err, "private IP address 127.0.0.1 is not allowed", scheme)
+		}
+
+		sink := &models.EventSink{Type: models.EventSinkTypeSyslog, URL: "tcp://localhost:514"}
+		err := Send(context.Background(), blockedCtx, sink, secret, delivery)
+		require.ErrorContains(t, err, "connection blocked")
+	})
+}
+
+func Test__SyslogMessage(t *testing.T) {
+	secret := []byte("0123456789abcdef")
+	delivery := &models.EventSinkDelivery{
+		ID:        uuid.MustParse("4f6c3a8e-7f2b-4b63-9a55-0c1d2e3f4a5b"),
+		EventType: EventTypeCanvasEventCreated,
+		Payload:   []byte(`{"id":"4f6c3a8e-7f2b-4b63-9a55-0c1d2e3f4a5b"}`),
+	}
+
+	now := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+	message := SyslogMessage(now, "worker-1", secret, delivery)
+
+	expected := fmt.Sprintf(
+		`<110>1 2026-10-16T12:30:00Z worker-1 superplane - canvas.event_created [superplane@32473 id="%s" signature="%s"] %s`,
+		delivery.ID,
+		Signature(secret, delivery.Payload),
+		delivery.Payload,
+	)
+
+	assert.Equal(t, expected, string(message))
+}
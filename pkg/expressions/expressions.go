@@ -0,0 +1,226 @@
+package expressions
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+)
+
+// Keys of the environment holding the data read by root(), previous() and env(),
+// when it is prepared before the expression runs instead of loaded on demand.
+const (
+	RootKey            = "__root"
+	PreviousByDepthKey = "__previousByDepth"
+	VariablesKey       = "__variables"
+)
+
+var previousDepthRegex = regexp.MustCompile(`\bprevious\s*\(([^)]*)\)`)
+var variableNameRegex = regexp.MustCompile(`\benv\s*\(\s*["']([^"']+)["']\s*\)`)
+
+// Resolvers load the data behind root(), previous() and env() on demand.
+// When a resolver is not set, the function reads what was prepared in the environment.
+type Resolvers struct {
+	Root     func() (any, error)
+	Previous func(depth int) (any, error)
+	Variable func(name string) (any, error)
+}
+
+// Options returns the options every canvas expression is compiled with:
+// the environment, the UTC timezone and the functions of the library.
+func Options(env map[string]any, resolvers *Resolvers) []expr.Option {
+	if resolvers == nil {
+		resolvers = &Resolvers{}
+	}
+
+	options := []expr.Option{
+		expr.Env(env),
+		expr.WithContext("ctx"),
+		expr.Timezone(time.UTC.String()),
+		expr.Function("root", rootFunction(env, resolvers.Root)),
+		expr.Function("previous", previousFunction(env, resolvers.Previous)),
+		expr.Function("env", envFunction(env, resolvers.Variable)),
+	}
+
+	for _, f := range library {
+		options = append(options, expr.Function(f.Name, f.call))
+	}
+
+	return options
+}
+
+// Compile compiles an expression with the library. Extra options,
+// like expr.AsBool(), are applied after the library ones.
+func Compile(expression string, env map[string]any, resolvers *Resolvers, options ...expr.Option) (*vm.Program, error) {
+	return expr.Compile(expression, append(Options(env, resolvers), options...)...)
+}
+
+// Evaluate compiles and runs an expression against env.
+func Evaluate(expression string, env map[string]any, resolvers *Resolvers, options ...expr.Option) (any, error) {
+	program, err := Compile(expression, env, resolvers, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return expr.Run(program, env)
+}
+
+func rootFunction(env map[string]any, resolver func() (any, error)) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if len(params) != 0 {
+			return nil, fmt.Errorf("root() takes no arguments")
+		}
+
+		if resolver != nil {
+			return resolver()
+		}
+
+		rootPayload, ok := env[RootKey]
+		if !ok {
+			return nil, fmt.Errorf("no root event found")
+		}
+
+		return rootPayload, nil
+	}
+}
+
+func previousFunction(env map[string]any, resolver func(depth int) (any, error)) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		depth := 1
+		if len(params) > 1 {
+			return nil, fmt.Errorf("previous() accepts zero or one argument")
+		}
+
+		if len(params) == 1 {
+			parsedDepth, err := ParseDepth(params[0])
+			if err != nil {
+				return nil, err
+			}
+			depth = parsedDepth
+		}
+
+		if resolver != nil {
+			return resolver(depth)
+		}
+
+		previousByDepth, ok := env[PreviousByDepthKey]
+		if !ok {
+			return nil, nil
+		}
+
+		if values, ok := previousByDepth.(map[string]any); ok {
+			return values[strconv.Itoa(depth)], nil
+		}
+
+		if values, ok := previousByDepth.(map[int]any); ok {
+			return values[depth], nil
+		}
+
+		return nil, nil
+	}
+}
+
+func envFunction(env map[string]any, resolver func(name string) (any, error)) func(params ...any) (any, error) {
+	return func(params ...any) (any, error) {
+		if len(params) != 1 {
+			return nil, fmt.Errorf("env() takes one argument")
+		}
+
+		name, ok := params[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("env() variable name must be a string")
+		}
+
+		if resolver != nil {
+			return resolver(name)
+		}
+
+		if variables, ok := env[VariablesKey].(map[string]any); ok {
+			if value, ok := variables[name]; ok {
+				return value, nil
+			}
+		}
+
+		return nil, fmt.Errorf("variable %s is not defined", name)
+	}
+}
+
+// ParseDepth validates the depth given to previous().
+func ParseDepth(param any) (int, error) {
+	switch value := param.(type) {
+	case int:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return value, nil
+	case int64:
+		if value < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return int(value), nil
+	case float64:
+		parsed := int(value)
+		if value != float64(parsed) {
+			return 0, fmt.Errorf("depth must be an integer")
+		}
+		if parsed < 1 {
+			return 0, fmt.Errorf("depth must be >= 1")
+		}
+		return parsed, nil
+	default:
+		return 0, fmt.Errorf("depth must be an integer")
+	}
+}
+
+// PreviousDepths returns the depths used with previous() in an expression,
+// so their payloads can be loaded before it runs.
+func PreviousDepths(expression string) ([]int, error) {
+	matches := previousDepthRegex.FindAllStringSubmatch(expression, -1)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	seen := map[int]struct{}{}
+	for _, match := range matches {
+		raw := strings.TrimSpace(match[1])
+		if raw == "" {
+			seen[1] = struct{}{}
+			continue
+		}
+
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("depth must be an integer")
+		}
+		if parsed < 1 {
+			return nil, fmt.Errorf("depth must be >= 1")
+		}
+		seen[parsed] = struct{}{}
+	}
+
+	depths := make([]int, 0, len(seen))
+	for depth := range seen {
+		depths = append(depths, depth)
+	}
+	sort.Ints(depths)
+	return depths, nil
+}
+
+// VariableNames returns the names given to env() as literals in an expression,
+// so their values can be loaded before it runs.
+func VariableNames(expression string) []string {
+	names := []string{}
+	for _, match := range variableNameRegex.FindAllStringSubmatch(expression, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+
+	return names
+}
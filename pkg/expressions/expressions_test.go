@@ -0,0 +1,239 @@
+package expressions
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func evaluate(t *testing.T, expression string, env map[string]any) any {
+	t.Helper()
+
+	if env == nil {
+		env = map[string]any{"$": map[string]any{}}
+	}
+
+	output, err := Evaluate(expression, env, nil)
+	require.NoError(t, err, expression)
+	return output
+}
+
+func Test__RootAndPrevious(t *testing.T) {
+	env := map[string]any{
+		"$":     map[string]any{},
+		RootKey: map[string]any{"ref": "main"},
+		PreviousByDepthKey: map[string]any{
+			"1": map[string]any{"ok": true},
+			"2": map[string]any{"version": "1.2.3"},
+		},
+	}
+
+	t.Run("prepared in the environment", func(t *testing.T) {
+		assert.Equal(t, "main", evaluate(t, `root().ref`, env))
+		assert.Equal(t, true, evaluate(t, `previous().ok`, env))
+		assert.Equal(t, "1.2.3", evaluate(t, `previous(2).version`, env))
+		assert.Nil(t, evaluate(t, `previous(3)`, env))
+	})
+
+	t.Run("loaded by resolvers", func(t *testing.T) {
+		resolvers := &Resolvers{
+			Root:     func() (any, error) { return "root", nil },
+			Previous: func(depth int) (any, error) { return depth * 10, nil },
+		}
+
+		output, err := Evaluate(`root() + string(previous(3))`, env, resolvers)
+		require.NoError(t, err)
+		assert.Equal(t, "root30", output)
+	})
+
+	t.Run("invalid depth", func(t *testing.T) {
+		_, err := Evaluate(`previous(0)`, env, nil)
+		require.ErrorContains(t, err, "depth must be >= 1")
+	})
+
+	t.Run("without a root event", func(t *testing.T) {
+		_, err := Evaluate(`root()`, map[string]any{"$": map[string]any{}}, nil)
+		require.ErrorContains(t, err, "no root event found")
+	})
+}
+
+func Test__Env(t *testing.T) {
+	env := map[string]any{
+		"$":          map[string]any{},
+		VariablesKey: map[string]any{"REPOSITORY": "superplane"},
+	}
+
+	assert.Equal(t, "superplane", evaluate(t, `env("REPOSITORY")`, env))
+
+	_, err := Evaluate(`env("MISSING")`, env, nil)
+	require.ErrorContains(t, err, "variable MISSING is not defined")
+
+	output, err := Evaluate(`env("MISSING")`, env, &Resolvers{
+		Variable: func(name string) (any, error) { return "resolved " + name, nil },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "resolved MISSING", output)
+
+	assert.Equal(t, []string{"REPOSITORY", "CHANNEL"}, VariableNames(`env("REPOSITORY") + env('CHANNEL') + env("REPOSITORY")`))
+	assert.Empty(t, VariableNames(`env($.name)`))
+}
+
+func Test__Semver(t *testing.T) {
+	assert.Equal(t, 1, evaluate(t, `semverCompare("v1.10.0", "v1.9.3")`, nil))
+	assert.Equal(t, -1, evaluate(t, `semverCompare("1.0.0-rc.1", "1.0.0")`, nil))
+	assert.Equal(t, -1, evaluate(t, `semverCompare("1.0.0-alpha", "1.0.0-alpha.1")`, nil))
+	assert.Equal(t, -1, evaluate(t, `semverCompare("1.0.0-alpha.2", "1.0.0-alpha.10")`, nil))
+	assert.Equal(t, 1, evaluate(t, `semverCompare("1.0.0-beta", "1.0.0-alpha.5")`, nil))
+	assert.Equal(t, 0, evaluate(t, `semverCompare("v2.0.0+build.5", "2.0.0")`, nil))
+
+	assert.Equal(t, "v2.0.0", evaluate(t, `semverBump("v1.4.2", "major")`, nil))
+	assert.Equal(t, "1.5.0", evaluate(t, `semverBump("1.4.2-rc.1", "minor")`, nil))
+	assert.Equal(t, "1.4.3", evaluate(t, `semverBump("1.4.2", "patch")`, nil))
+
+	_, err := Evaluate(`semverCompare("1.2", "1.2.0")`, map[string]any{}, nil)
+	require.ErrorContains(t, err, `invalid semantic version "1.2"`)
+
+	_, err = Evaluate(`semverBump("1.2.0", "build")`, map[string]any{}, nil)
+	require.ErrorContains(t, err, "part must be major, minor or patch")
+}
+
+func Test__Regex(t *testing.T) {
+	assert.Equal(t, []any{"1", "2"}, evaluate(t, `regexCapture("release-1.2", "release-(\\d+)\\.(\\d+)")`, nil))
+	assert.Nil(t, evaluate(t, `regexCapture("main", "release-(\\d+)")`, nil))
+	assert.Equal(t, []any{[]any{"a", "1"}, []any{"b", "2"}}, evaluate(t, `regexCaptureAll("a=1 b=2", "(\\w)=(\\d)")`, nil))
+	assert.Equal(t, "login-page", evaluate(t, `regexReplace("feature/login/page", "^feature/(\\w+)/(\\w+)$", "$1-$2")`, nil))
+
+	_, err := Evaluate(`regexCapture("x", "(")`, map[string]any{}, nil)
+	require.ErrorContains(t, err, "regexCapture(): invalid pattern")
+}
+
+func Test__JSONPath(t *testing.T) {
+	env := map[string]any{
+		"$": map[string]any{
+			"commits": []any{
+				map[string]any{"id": "a", "author": map[string]any{"email": "a@example.com"}},
+				map[string]any{"id": "b", "author": map[string]any{"email": "b@example.com"}},
+			},
+			"labels": map[string]any{"first label": "bug"},
+		},
+	}
+
+	assert.Equal(t, "a@example.com", evaluate(t, `jsonPath($, "$.commits[0].author.email")`, env))
+	assert.Equal(t, "b", evaluate(t, `jsonPath($, "commits[-1].id")`, env))
+	assert.Equal(t, []any{"a", "b"}, evaluate(t, `jsonPath($, "$.commits[*].id")`, env))
+	assert.Equal(t, "bug", evaluate(t, `jsonPath($, "$.labels['first label']")`, env))
+	assert.Nil(t, evaluate(t, `jsonPath($, "$.commits[5].id")`, env))
+	assert.Equal(t, 2.0, evaluate(t, `jsonPath("{\"a\": [1, 2]}", "$.a[1]")`, env))
+
+	_, err := Evaluate(`jsonPath($, "$.commits[x]")`, env, nil)
+	require.ErrorContains(t, err, `"x" is not an index`)
+}
+
+func Test__Encoding(t *testing.T) {
+	assert.Equal(t, "6869", evaluate(t, `toHex("hi")`, nil))
+	assert.Equal(t, "hi", evaluate(t, `fromHex("6869")`, nil))
+	assert.Equal(t, "aGk_", evaluate(t, `toBase64URL("hi?")`, nil))
+	assert.Equal(t, "hi?", evaluate(t, `fromBase64URL("aGk_")`, nil))
+
+	_, err := Evaluate(`fromHex("xyz")`, map[string]any{}, nil)
+	require.ErrorContains(t, err, "fromHex()")
+}
+
+func Test__Hashes(t *testing.T) {
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", evaluate(t, `sha256("hello")`, nil))
+	assert.Equal(t,
+		"9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043",
+		evaluate(t, `sha512("hello")`, nil),
+	)
+	assert.Equal(t,
+		"f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		evaluate(t, `hmacSHA256("The quick brown fox jumps over the lazy dog", "key")`, nil),
+	)
+}
+
+func Test__Time(t *testing.T) {
+	env := map[string]any{
+		"$": map[string]any{"createdAt": "2026-03-28T22:30:00Z"},
+	}
+
+	t.Run("durations are added to times and RFC 3339 strings", func(t *testing.T) {
+		output := evaluate(t, `timeAdd($.createdAt, "90m")`, env)
+		assert.Equal(t, time.Date(2026, 3, 29, 0, 0, 0, 0, time.UTC), output)
+
+		output = evaluate(t, `timeAdd(date("2026-01-01"), duration("1h"))`, env)
+		assert.True(t, time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC).Equal(output.(time.Time)))
+	})
+
+	t.Run("days are added keeping the wall clock of the timezone", func(t *testing.T) {
+		//
+		// Daylight saving time starts on 2026-03-29 in Europe,
+		// so the day has 23 hours there.
+		//
+		output := evaluate(t, `formatTime(addDays($.createdAt, 1, "Europe/Belgrade"), "2006-01-02 15:04", "Europe/Belgrade")`, env)
+		assert.Equal(t, "2026-03-29 23:30", output)
+
+		output = evaluate(t, `formatTime(addDays($.createdAt, 1), "2006-01-02 15:04")`, env)
+		assert.Equal(t, "2026-03-29 22:30", output)
+	})
+
+	t.Run("start of day in a timezone", func(t *testing.T) {
+		output := evaluate(t, `formatTime(startOfDay($.createdAt, "Asia/Tokyo"), "2006-01-02T15:04:05Z07:00")`, env)
+		assert.Equal(t, "2026-03-28T15:00:00Z", output)
+	})
+
+	t.Run("unknown timezone", func(t *testing.T) {
+		_, err := Evaluate(`startOfDay($.createdAt, "Mars/Olympus")`, env, nil)
+		require.ErrorContains(t, err, "unknown timezone Mars/Olympus")
+	})
+}
+
+func Test__ParseURL(t *testing.T) {
+	output := evaluate(t, `parseURL("https://bot@example.com:8443/hooks/a?b=c&b=d#top")`, nil)
+
+	assert.Equal(t, map[string]any{
+		"scheme":   "https",
+		"host":     "example.com:8443",
+		"hostname": "example.com",
+		"port":     "8443",
+		"path":     "/hooks/a",
+		"rawQuery": "b=c&b=d",
+		"query":    map[string]any{"b": "c"},
+		"fragment": "top",
+		"username": "bot",
+	}, output)
+}
+
+func Test__Compile(t *testing.T) {
+	env := map[string]any{"$": map[string]any{"version": "v1.2.0"}}
+
+	program, err := Compile(`semverCompare($.version, "v1.0.0") > 0`, env, nil, expr.AsBool())
+	require.NoError(t, err)
+
+	output, err := expr.Run(program, env)
+	require.NoError(t, err)
+	assert.Equal(t, true, output)
+}
+
+func Test__Functions(t *testing.T) {
+	names := []string{}
+	for _, f := range Functions() {
+		assert.NotEmpty(t, f.Description, f.Name)
+		assert.NotEmpty(t, f.Example, f.Name)
+		names = append(names, f.Name)
+	}
+
+	assert.Subset(t, names, []string{"root", "previous", "env", "semverCompare", "jsonPath", "sha256", "parseURL"})
+
+	//
+	// Examples written as comparisons must hold.
+	//
+	for _, f := range library {
+		if strings.Contains(f.Example, "==") {
+			assert.Equal(t, true, evaluate(t, f.Example, nil), f.Name)
+		}
+	}
+}
@@ -0,0 +1,571 @@
+package expressions
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// Function documents a function available in canvas expressions,
+// on top of the expr-lang builtins.
+type Function struct {
+	Name        string
+	Description string
+	Example     string
+}
+
+type libraryFunction struct {
+	Function
+	call func(params ...any) (any, error)
+}
+
+// root(), previous() and env() depend on the environment,
+// so they are bound in Options() and only documented here.
+var boundFunctions = []Function{
+	{
+		Name:        "root",
+		Description: "Returns the root payload that started the run.",
+		Example:     "root().github.ref",
+	},
+	{
+		Name:        "previous",
+		Description: "Returns the payload from the immediate predecessor. Provide depth to walk upstream.",
+		Example:     "previous(2).data.image.version",
+	},
+	{
+		Name:        "env",
+		Description: "Returns the value of a canvas variable.",
+		Example:     `env("SLACK_CHANNEL")`,
+	},
+}
+
+var library = []libraryFunction{
+	{
+		Function: Function{
+			Name:        "semverCompare",
+			Description: "Compares two semantic versions. Returns -1, 0 or 1.",
+			Example:     `semverCompare("v1.10.0", "v1.9.3") == 1`,
+		},
+		call: semverCompare,
+	},
+	{
+		Function: Function{
+			Name:        "semverBump",
+			Description: "Increments the major, minor or patch part of a semantic version.",
+			Example:     `semverBump("v1.4.2", "minor") == "v1.5.0"`,
+		},
+		call: semverBump,
+	},
+	{
+		Function: Function{
+			Name:        "regexCapture",
+			Description: "Returns the capture groups of the first match of a regular expression, or nil.",
+			Example:     `regexCapture("release-1.2", "release-(\\d+)\\.(\\d+)") == ["1", "2"]`,
+		},
+		call: regexCapture,
+	},
+	{
+		Function: Function{
+			Name:        "regexCaptureAll",
+			Description: "Returns the capture groups of every match of a regular expression.",
+			Example:     `regexCaptureAll("a=1 b=2", "(\\w)=(\\d)") == [["a", "1"], ["b", "2"]]`,
+		},
+		call: regexCaptureAll,
+	},
+	{
+		Function: Function{
+			Name:        "regexReplace",
+			Description: "Replaces the matches of a regular expression. The replacement can use $1 for groups.",
+			Example:     `regexReplace("feature/login", "^feature/", "") == "login"`,
+		},
+		call: regexReplace,
+	},
+	{
+		Function: Function{
+			Name:        "jsonPath",
+			Description: "Reads a value with a JSONPath like $.items[0].name. JSON strings are parsed first.",
+			Example:     `jsonPath(root().data, "$.commits[0].author.email")`,
+		},
+		call: jsonPath,
+	},
+	{
+		Function: Function{
+			Name:        "toHex",
+			Description: "Encodes the string into hexadecimal format.",
+			Example:     `toHex("hi") == "6869"`,
+		},
+		call: toHex,
+	},
+	{
+		Function: Function{
+			Name:        "fromHex",
+			Description: "Decodes the hexadecimal encoded string back to its original form.",
+			Example:     `fromHex("6869") == "hi"`,
+		},
+		call: fromHex,
+	},
+	{
+		Function: Function{
+			Name:        "toBase64URL",
+			Description: "Encodes the string into URL-safe Base64 format, without padding.",
+			Example:     `toBase64URL("hi?") == "aGk_"`,
+		},
+		call: toBase64URL,
+	},
+	{
+		Function: Function{
+			Name:        "fromBase64URL",
+			Description: "Decodes the URL-safe Base64 encoded string back to its original form.",
+			Example:     `fromBase64URL("aGk_") == "hi?"`,
+		},
+		call: fromBase64URL,
+	},
+	{
+		Function: Function{
+			Name:        "sha256",
+			Description: "Returns the hex encoded SHA-256 digest of the string.",
+			Example:     `sha256("hello")`,
+		},
+		call: sha256Digest,
+	},
+	{
+		Function: Function{
+			Name:        "sha512",
+			Description: "Returns the hex encoded SHA-512 digest of the string.",
+			Example:     `sha512("hello")`,
+		},
+		call: sha512Digest,
+	},
+	{
+		Function: Function{
+			Name:        "hmacSHA256",
+			Description: "Returns the hex encoded HMAC-SHA256 of the string, signed with the key.",
+			Example:     `hmacSHA256(toJSON(root().data), env("WEBHOOK_SECRET"))`,
+		},
+		call: hmacSHA256,
+	},
+	{
+		Function: Function{
+			Name:        "timeAdd",
+			Description: "Adds a duration, like 90m, to a time or an RFC 3339 string.",
+			Example:     `timeAdd(root().data.createdAt, "2h")`,
+		},
+		call: timeAdd,
+	},
+	{
+		Function: Function{
+			Name:        "addDays",
+			Description: "Adds calendar days to a time in a timezone, keeping the wall clock across DST changes.",
+			Example:     `addDays(now(), 1, "Europe/Belgrade")`,
+		},
+		call: addDays,
+	},
+	{
+		Function: Function{
+			Name:        "startOfDay",
+			Description: "Returns the midnight starting the day of a time in a timezone.",
+			Example:     `startOfDay(now(), "America/New_York")`,
+		},
+		call: startOfDay,
+	},
+	{
+		Function: Function{
+			Name:        "formatTime",
+			Description: "Formats a time with a Go layout in a timezone.",
+			Example:     `formatTime(now(), "2006-01-02 15:04", "Asia/Tokyo")`,
+		},
+		call: formatTime,
+	},
+	{
+		Function: Function{
+			Name:        "parseURL",
+			Description: "Parses a URL into its scheme, host, hostname, port, path, query and fragment.",
+			Example:     `parseURL("https://example.com:8443/a?b=c").query.b == "c"`,
+		},
+		call: parseURL,
+	},
+}
+
+// Functions lists the functions canvas expressions have on top of the expr-lang builtins.
+func Functions() []Function {
+	functions := make([]Function, 0, len(boundFunctions)+len(library))
+	functions = append(functions, boundFunctions...)
+	for _, f := range library {
+		functions = append(functions, f.Function)
+	}
+
+	return functions
+}
+
+func regexCapture(params ...any) (any, error) {
+	s, re, err := stringAndRegex("regexCapture", params)
+	if err != nil {
+		return nil, err
+	}
+
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return nil, nil
+	}
+
+	return groups(match), nil
+}
+
+func regexCaptureAll(params ...any) (any, error) {
+	s, re, err := stringAndRegex("regexCaptureAll", params)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []any{}
+	for _, match := range re.FindAllStringSubmatch(s, -1) {
+		matches = append(matches, groups(match))
+	}
+
+	return matches, nil
+}
+
+func regexReplace(params ...any) (any, error) {
+	if len(params) != 3 {
+		return nil, fmt.Errorf("regexReplace() takes three arguments")
+	}
+
+	s, re, err := stringAndRegex("regexReplace", params[:2])
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := stringParam("regexReplace", "replacement", params[2])
+	if err != nil {
+		return nil, err
+	}
+
+	return re.ReplaceAllString(s, replacement), nil
+}
+
+func stringAndRegex(name string, params []any) (string, *regexp.Regexp, error) {
+	if len(params) != 2 {
+		return "", nil, fmt.Errorf("%s() takes two arguments", name)
+	}
+
+	s, err := stringParam(name, "string", params[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	pattern, err := stringParam(name, "pattern", params[1])
+	if err != nil {
+		return "", nil, err
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s(): invalid pattern: %w", name, err)
+	}
+
+	return s, re, nil
+}
+
+func groups(match []string) []any {
+	groups := make([]any, 0, len(match)-1)
+	for _, group := range match[1:] {
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+func toHex(params ...any) (any, error) {
+	s, err := singleString("toHex", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.EncodeToString([]byte(s)), nil
+}
+
+func fromHex(params ...any) (any, error) {
+	s, err := singleString("fromHex", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("fromHex(): %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+func toBase64URL(params ...any) (any, error) {
+	s, err := singleString("toBase64URL", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(s)), nil
+}
+
+func fromBase64URL(params ...any) (any, error) {
+	s, err := singleString("fromBase64URL", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(trimPadding(s))
+	if err != nil {
+		return nil, fmt.Errorf("fromBase64URL(): %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+func trimPadding(s string) string {
+	for len(s) > 0 && s[len(s)-1] == '=' {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+func sha256Digest(params ...any) (any, error) {
+	s, err := singleString("sha256", params)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(digest[:]), nil
+}
+
+func sha512Digest(params ...any) (any, error) {
+	s, err := singleString("sha512", params)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(digest[:]), nil
+}
+
+func hmacSHA256(params ...any) (any, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("hmacSHA256() takes two arguments")
+	}
+
+	s, err := stringParam("hmacSHA256", "string", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := stringParam("hmacSHA256", "key", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func timeAdd(params ...any) (any, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("timeAdd() takes two arguments")
+	}
+
+	t, err := timeParam("timeAdd", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := durationParam("timeAdd", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Add(d), nil
+}
+
+func addDays(params ...any) (any, error) {
+	if len(params) != 2 && len(params) != 3 {
+		return nil, fmt.Errorf("addDays() takes two or three arguments")
+	}
+
+	t, err := timeParam("addDays", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	days, err := intParam("addDays", "days", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := locationParam("addDays", params[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	return t.In(location).AddDate(0, 0, days), nil
+}
+
+func startOfDay(params ...any) (any, error) {
+	if len(params) != 1 && len(params) != 2 {
+		return nil, fmt.Errorf("startOfDay() takes one or two arguments")
+	}
+
+	t, err := timeParam("startOfDay", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := locationParam("startOfDay", params[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	t = t.In(location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location), nil
+}
+
+func formatTime(params ...any) (any, error) {
+	if len(params) != 2 && len(params) != 3 {
+		return nil, fmt.Errorf("formatTime() takes two or three arguments")
+	}
+
+	t, err := timeParam("formatTime", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := stringParam("formatTime", "layout", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := locationParam("formatTime", params[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	return t.In(location).Format(layout), nil
+}
+
+func parseURL(params ...any) (any, error) {
+	s, err := singleString("parseURL", params)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("parseURL(): %w", err)
+	}
+
+	query := map[string]any{}
+	for key, values := range u.Query() {
+		if len(values) > 0 {
+			query[key] = values[0]
+		}
+	}
+
+	return map[string]any{
+		"scheme":   u.Scheme,
+		"host":     u.Host,
+		"hostname": u.Hostname(),
+		"port":     u.Port(),
+		"path":     u.Path,
+		"rawQuery": u.RawQuery,
+		"query":    query,
+		"fragment": u.Fragment,
+		"username": u.User.Username(),
+	}, nil
+}
+
+func singleString(name string, params []any) (string, error) {
+	if len(params) != 1 {
+		return "", fmt.Errorf("%s() takes one argument", name)
+	}
+
+	return stringParam(name, "argument", params[0])
+}
+
+func stringParam(name, param string, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s(): %s must be a string, got %T", name, param, value)
+	}
+
+	return s, nil
+}
+
+func intParam(name, param string, value any) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("%s(): %s must be an integer", name, param)
+		}
+		return int(v), nil
+	default:
+		return 0, fmt.Errorf("%s(): %s must be an integer, got %T", name, param, value)
+	}
+}
+
+func timeParam(name string, value any) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%s(): time must be in RFC 3339 format: %w", name, err)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("%s(): expected a time, got %T", name, value)
+	}
+}
+
+func durationParam(name string, value any) (time.Duration, error) {
+	switch v := value.(type) {
+	case time.Duration:
+		return v, nil
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("%s(): %w", name, err)
+		}
+		return d, nil
+	default:
+		return 0, fmt.Errorf("%s(): expected a duration, got %T", name, value)
+	}
+}
+
+func locationParam(name string, params []any) (*time.Location, error) {
+	if len(params) == 0 {
+		return time.UTC, nil
+	}
+
+	timezone, err := stringParam(name, "timezone", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): unknown timezone %s", name, timezone)
+	}
+
+	return location, nil
+}
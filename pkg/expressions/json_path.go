@@ -0,0 +1,212 @@
+package expressions
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath supports the subset of JSONPath used to read payloads:
+// child keys with dots or brackets, array indexes, negative indexes from
+// the end and the [*] wildcard, which returns a list with a value per item.
+func jsonPath(params ...any) (any, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("jsonPath() takes two arguments")
+	}
+
+	path, err := stringParam("jsonPath", "path", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("jsonPath(): %w", err)
+	}
+
+	value := params[0]
+	if s, ok := value.(string); ok {
+		if err := json.Unmarshal([]byte(s), &value); err != nil {
+			return nil, fmt.Errorf("jsonPath(): invalid JSON: %w", err)
+		}
+	}
+
+	return walkJSONPath(value, segments), nil
+}
+
+type jsonPathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	rest := strings.TrimSpace(path)
+	rest = strings.TrimPrefix(rest, "$")
+
+	segments := []jsonPathSegment{}
+	for rest != "" {
+		switch {
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+
+			key := rest[:end]
+			if key == "" {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+
+			if key == "*" {
+				segments = append(segments, jsonPathSegment{wildcard: true})
+			} else {
+				segments = append(segments, jsonPathSegment{key: key})
+			}
+			rest = rest[end:]
+
+		case rest[0] == '[':
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+
+			segment, err := parseJSONPathBracket(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: %w", path, err)
+			}
+
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+
+		default:
+			//
+			// Paths can omit the leading "$." like in items[0].name.
+			//
+			if len(segments) > 0 {
+				return nil, fmt.Errorf("invalid path %q", path)
+			}
+			rest = "." + rest
+		}
+	}
+
+	return segments, nil
+}
+
+func parseJSONPathBracket(content string) (jsonPathSegment, error) {
+	if content == "*" {
+		return jsonPathSegment{wildcard: true}, nil
+	}
+
+	if len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0] {
+		return jsonPathSegment{key: content[1 : len(content)-1]}, nil
+	}
+
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return jsonPathSegment{}, fmt.Errorf("%q is not an index", content)
+	}
+
+	return jsonPathSegment{index: index, isIndex: true}, nil
+}
+
+func walkJSONPath(value any, segments []jsonPathSegment) any {
+	for i, segment := range segments {
+		if value == nil {
+			return nil
+		}
+
+		if segment.wildcard {
+			items := []any{}
+			for _, item := range jsonPathChildren(value) {
+				items = append(items, walkJSONPath(item, segments[i+1:]))
+			}
+			return items
+		}
+
+		if segment.isIndex {
+			value = jsonPathIndex(value, segment.index)
+			continue
+		}
+
+		value = jsonPathKey(value, segment.key)
+	}
+
+	return value
+}
+
+func jsonPathKey(value any, key string) any {
+	if m, ok := value.(map[string]any); ok {
+		return m[key]
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+
+	item := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+	if !item.IsValid() {
+		return nil
+	}
+
+	return item.Interface()
+}
+
+func jsonPathIndex(value any, index int) any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil
+	}
+
+	if index < 0 {
+		index += v.Len()
+	}
+
+	if index < 0 || index >= v.Len() {
+		return nil
+	}
+
+	return v.Index(index).Interface()
+}
+
+func jsonPathChildren(value any) []any {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		children := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			children = append(children, v.Index(i).Interface())
+		}
+		return children
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		names := make([]string, 0, len(keys))
+		byName := make(map[string]any, len(keys))
+		for _, key := range keys {
+			name := fmt.Sprint(key.Interface())
+			names = append(names, name)
+			byName[name] = v.MapIndex(key).Interface()
+		}
+
+		//
+		// Map order is random, so children are returned sorted by key.
+		//
+		sort.Strings(names)
+		children := make([]any, 0, len(names))
+		for _, name := range names {
+			children = append(children, byName[name])
+		}
+		return children
+
+	default:
+		return nil
+	}
+}
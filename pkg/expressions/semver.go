@@ -0,0 +1,188 @@
+package expressions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type semver struct {
+	prefix     string
+	major      int
+	minor      int
+	patch      int
+	prerelease []string
+}
+
+// parseSemver accepts semantic versions with an optional "v" prefix.
+// Build metadata is accepted, but ignored.
+func parseSemver(version string) (*semver, error) {
+	v := &semver{}
+	rest := version
+	if strings.HasPrefix(rest, "v") {
+		v.prefix = "v"
+		rest = rest[1:]
+	}
+
+	rest, _, _ = strings.Cut(rest, "+")
+	core, prerelease, hasPrerelease := strings.Cut(rest, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid semantic version %q", version)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := parseSemverNumber(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semantic version %q", version)
+		}
+		numbers[i] = n
+	}
+
+	v.major, v.minor, v.patch = numbers[0], numbers[1], numbers[2]
+
+	if hasPrerelease {
+		if prerelease == "" {
+			return nil, fmt.Errorf("invalid semantic version %q", version)
+		}
+
+		v.prerelease = strings.Split(prerelease, ".")
+		for _, identifier := range v.prerelease {
+			if identifier == "" {
+				return nil, fmt.Errorf("invalid semantic version %q", version)
+			}
+		}
+	}
+
+	return v, nil
+}
+
+func parseSemverNumber(s string) (int, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("invalid number")
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid number")
+		}
+	}
+
+	return strconv.Atoi(s)
+}
+
+func (v *semver) String() string {
+	s := fmt.Sprintf("%s%d.%d.%d", v.prefix, v.major, v.minor, v.patch)
+	if len(v.prerelease) > 0 {
+		s += "-" + strings.Join(v.prerelease, ".")
+	}
+
+	return s
+}
+
+// compare follows the precedence rules of semantic versioning:
+// a version without pre-release identifiers comes after one with them.
+func (v *semver) compare(other *semver) int {
+	for _, pair := range [][2]int{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if c := compareInts(pair[0], pair[1]); c != 0 {
+			return c
+		}
+	}
+
+	if len(v.prerelease) == 0 || len(other.prerelease) == 0 {
+		return compareInts(len(other.prerelease), len(v.prerelease))
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrereleaseIdentifiers(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(v.prerelease), len(other.prerelease))
+}
+
+func comparePrereleaseIdentifiers(a, b string) int {
+	aNumber, aErr := strconv.Atoi(a)
+	bNumber, bErr := strconv.Atoi(b)
+
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareInts(aNumber, bNumber)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func semverCompare(params ...any) (any, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("semverCompare() takes two arguments")
+	}
+
+	versions := make([]*semver, 2)
+	for i, param := range params {
+		s, err := stringParam("semverCompare", "version", param)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[i], err = parseSemver(s)
+		if err != nil {
+			return nil, fmt.Errorf("semverCompare(): %w", err)
+		}
+	}
+
+	return versions[0].compare(versions[1]), nil
+}
+
+func semverBump(params ...any) (any, error) {
+	if len(params) != 2 {
+		return nil, fmt.Errorf("semverBump() takes two arguments")
+	}
+
+	s, err := stringParam("semverBump", "version", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	part, err := stringParam("semverBump", "part", params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := parseSemver(s)
+	if err != nil {
+		return nil, fmt.Errorf("semverBump(): %w", err)
+	}
+
+	switch part {
+	case "major":
+		v.major, v.minor, v.patch = v.major+1, 0, 0
+	case "minor":
+		v.minor, v.patch = v.minor+1, 0
+	case "patch":
+		v.patch++
+	default:
+		return nil, fmt.Errorf("semverBump(): part must be major, minor or patch")
+	}
+
+	v.prerelease = nil
+	return v.String(), nil
+}
@@ -16,9 +16,29 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
 func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizationID string, blueprint *pb.Blueprint) (*pb.CreateBlueprintResponse, error) {
+	var model *models.Blueprint
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		var err error
+		model, err = CreateBlueprintInTransaction(ctx, tx, registry, organizationID, blueprint)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateBlueprintResponse{
+		Blueprint: SerializeBlueprint(model),
+	}, nil
+}
+
+// CreateBlueprintInTransaction creates a blueprint in the transaction,
+// so that it can be created along with the canvas using it.
+func CreateBlueprintInTransaction(ctx context.Context, tx *gorm.DB, registry *registry.Registry, organizationID string, blueprint *pb.Blueprint) (*models.Blueprint, error) {
 	userID, ok := authentication.GetUserIdFromMetadata(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
@@ -61,7 +81,7 @@ func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizat
 		OutputChannels: datatypes.NewJSONSlice(outputChannels),
 	}
 
-	err = database.Conn().Create(model).Error
+	_, err = models.CreateBlueprintWithVersionInTransaction(tx, model)
 	if err != nil {
 		if strings.Contains(err.Error(), "unique constraint") {
 			return nil, status.Error(codes.InvalidArgument, "A component with this name already exists")
@@ -70,9 +90,7 @@ func CreateBlueprint(ctx context.Context, registry *registry.Registry, organizat
 		return nil, err
 	}
 
-	return &pb.CreateBlueprintResponse{
-		Blueprint: SerializeBlueprint(model),
-	}, nil
+	return model, nil
 }
 
 func ParseOutputChannels(registry *registry.Registry, nodes []*componentpb.Node, outputChannels []*pb.OutputChannel) ([]models.BlueprintOutputChannel, error) {
@@ -122,7 +140,7 @@ func validateOutputChannelReference(registry *registry.Registry, nodes []*compon
 		return err
 	}
 
-	for _, c := range component.OutputChannels(nil) {
+	for _, c := range component.OutputChannels(node.Configuration.AsMap()) {
 		if c.Name == outputChannel.NodeOutputChannel {
 			return nil
 		}
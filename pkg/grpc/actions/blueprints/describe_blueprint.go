@@ -2,6 +2,7 @@ package blueprints
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/database"
@@ -10,20 +11,52 @@ import (
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
-func DescribeBlueprint(ctx context.Context, registry *registry.Registry, organizationID string, id string) (*pb.DescribeBlueprintResponse, error) {
+// DescribeBlueprint returns the latest published version of a blueprint,
+// a specific version, or its draft. Without a draft, the latest version is returned.
+func DescribeBlueprint(ctx context.Context, registry *registry.Registry, organizationID string, id string, version int32, draft bool) (*pb.DescribeBlueprintResponse, error) {
 	blueprintID, err := uuid.Parse(id)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint id: %v", err)
 	}
 
+	if version < 0 {
+		return nil, status.Error(codes.InvalidArgument, "version must not be negative")
+	}
+
+	if version > 0 && draft {
+		return nil, status.Error(codes.InvalidArgument, "version and draft cannot be used together")
+	}
+
 	var blueprint models.Blueprint
 	if err := database.Conn().Where("id = ? AND organization_id = ?", blueprintID, organizationID).First(&blueprint).Error; err != nil {
 		return nil, status.Errorf(codes.NotFound, "blueprint not found: %v", err)
 	}
 
+	result := &blueprint
+	switch {
+	case draft:
+		draftVersion, err := models.FindBlueprintDraft(blueprint.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		if draftVersion != nil {
+			result = blueprint.WithVersion(draftVersion)
+		}
+
+	case version > 0 && int(version) != blueprint.Version:
+		blueprintVersion, err := models.FindBlueprintVersion(blueprint.ID, int(version))
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "version %d of blueprint not found", version)
+		}
+
+		result = blueprint.WithVersion(blueprintVersion)
+	}
+
 	return &pb.DescribeBlueprintResponse{
-		Blueprint: SerializeBlueprint(&blueprint),
+		Blueprint: SerializeBlueprint(result),
 	}, nil
 }
@@ -0,0 +1,190 @@
+package blueprints
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func DiffBlueprintVersions(ctx context.Context, organizationID string, id string, fromVersion, toVersion int32) (*pb.DiffBlueprintVersionsResponse, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint id: %v", err)
+	}
+
+	if fromVersion < 0 || toVersion < 0 {
+		return nil, status.Error(codes.InvalidArgument, "versions must not be negative")
+	}
+
+	blueprint, err := models.FindBlueprint(organizationID, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blueprint not found: %v", err)
+	}
+
+	if fromVersion == 0 {
+		fromVersion = int32(blueprint.Version)
+	}
+
+	from, err := findBlueprintContent(blueprint, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := findBlueprintContent(blueprint, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := DiffBlueprintContent(from, to)
+	diff.FromVersion = fromVersion
+	diff.ToVersion = toVersion
+
+	return &pb.DiffBlueprintVersionsResponse{Diff: diff}, nil
+}
+
+// findBlueprintContent returns the content of a published version, or of the draft for version 0.
+func findBlueprintContent(blueprint *models.Blueprint, version int32) (models.BlueprintContent, error) {
+	if version == 0 {
+		draft, err := models.FindBlueprintDraft(blueprint.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return models.BlueprintContent{}, status.Error(codes.NotFound, "blueprint has no unpublished changes")
+			}
+			return models.BlueprintContent{}, err
+		}
+
+		return draft.Content(), nil
+	}
+
+	if int(version) == blueprint.Version {
+		return blueprint.Content(), nil
+	}
+
+	blueprintVersion, err := models.FindBlueprintVersion(blueprint.ID, int(version))
+	if err != nil {
+		return models.BlueprintContent{}, status.Errorf(codes.NotFound, "version %d of blueprint not found", version)
+	}
+
+	return blueprintVersion.Content(), nil
+}
+
+// DiffBlueprintContent compares two versions of a blueprint.
+// Node positions are left out, since they do not change how the blueprint runs.
+func DiffBlueprintContent(from, to models.BlueprintContent) *pb.BlueprintDiff {
+	diff := &pb.BlueprintDiff{}
+
+	fromNodes := map[string]models.Node{}
+	for _, node := range from.Nodes {
+		fromNodes[node.ID] = node
+	}
+
+	toNodes := map[string]models.Node{}
+	for _, node := range to.Nodes {
+		toNodes[node.ID] = node
+		previous, ok := fromNodes[node.ID]
+		if !ok {
+			diff.AddedNodeIds = append(diff.AddedNodeIds, node.ID)
+			continue
+		}
+
+		if !reflect.DeepEqual(comparableBlueprintNode(previous), comparableBlueprintNode(node)) {
+			diff.ChangedNodeIds = append(diff.ChangedNodeIds, node.ID)
+		}
+	}
+
+	for _, node := range from.Nodes {
+		if _, ok := toNodes[node.ID]; !ok {
+			diff.RemovedNodeIds = append(diff.RemovedNodeIds, node.ID)
+		}
+	}
+
+	diff.EdgesChanged = !sameEdges(from.Edges, to.Edges)
+
+	fromFields := map[string]configuration.Field{}
+	for _, field := range from.Configuration {
+		fromFields[field.Name] = field
+	}
+
+	toFields := map[string]bool{}
+	for _, field := range to.Configuration {
+		toFields[field.Name] = true
+		previous, ok := fromFields[field.Name]
+		if !ok {
+			diff.AddedConfigurationFields = append(diff.AddedConfigurationFields, field.Name)
+			continue
+		}
+
+		if !reflect.DeepEqual(previous, field) {
+			diff.ChangedConfigurationFields = append(diff.ChangedConfigurationFields, field.Name)
+		}
+	}
+
+	for _, field := range from.Configuration {
+		if !toFields[field.Name] {
+			diff.RemovedConfigurationFields = append(diff.RemovedConfigurationFields, field.Name)
+		}
+	}
+
+	fromChannels := map[string]models.BlueprintOutputChannel{}
+	for _, channel := range from.OutputChannels {
+		fromChannels[channel.Name] = channel
+	}
+
+	toChannels := map[string]bool{}
+	for _, channel := range to.OutputChannels {
+		toChannels[channel.Name] = true
+		previous, ok := fromChannels[channel.Name]
+		if !ok {
+			diff.AddedOutputChannels = append(diff.AddedOutputChannels, channel.Name)
+			continue
+		}
+
+		if previous != channel {
+			diff.ChangedOutputChannels = append(diff.ChangedOutputChannels, channel.Name)
+		}
+	}
+
+	for _, channel := range from.OutputChannels {
+		if !toChannels[channel.Name] {
+			diff.RemovedOutputChannels = append(diff.RemovedOutputChannels, channel.Name)
+		}
+	}
+
+	return diff
+}
+
+func comparableBlueprintNode(node models.Node) models.Node {
+	node.Position = models.Position{}
+	node.IsCollapsed = false
+	node.ErrorMessage = nil
+	node.WarningMessage = nil
+	return node
+}
+
+func sameEdges(a, b []models.Edge) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	edges := map[models.Edge]int{}
+	for _, edge := range a {
+		edges[edge]++
+	}
+
+	for _, edge := range b {
+		if edges[edge] == 0 {
+			return false
+		}
+		edges[edge]--
+	}
+
+	return true
+}
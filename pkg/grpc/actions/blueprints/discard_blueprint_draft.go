@@ -0,0 +1,36 @@
+package blueprints
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DiscardBlueprintDraft(ctx context.Context, organizationID string, id string) (*pb.DiscardBlueprintDraftResponse, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint id: %v", err)
+	}
+
+	blueprint, err := models.FindBlueprint(organizationID, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blueprint not found: %v", err)
+	}
+
+	err = models.DiscardBlueprintDraftInTransaction(database.Conn(), blueprint.ID)
+	if err != nil {
+		if errors.Is(err, models.ErrBlueprintDraftNotFound) {
+			return nil, status.Error(codes.NotFound, "blueprint has no unpublished changes")
+		}
+
+		return nil, err
+	}
+
+	return &pb.DiscardBlueprintDraftResponse{}, nil
+}
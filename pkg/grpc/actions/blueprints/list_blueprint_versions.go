@@ -0,0 +1,48 @@
+package blueprints
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListBlueprintVersions(ctx context.Context, organizationID string, id string) (*pb.ListBlueprintVersionsResponse, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint id: %v", err)
+	}
+
+	blueprint, err := models.FindBlueprint(organizationID, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blueprint not found: %v", err)
+	}
+
+	versions, err := models.ListPublishedBlueprintVersions(blueprint.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.BlueprintVersion, 0, len(versions))
+	for _, version := range versions {
+		item := &pb.BlueprintVersion{Version: int32(version.Version)}
+		if version.PublishedAt != nil {
+			item.PublishedAt = timestamppb.New(*version.PublishedAt)
+		}
+
+		if version.OwnerID != nil {
+			item.PublishedBy = &pb.UserRef{Id: version.OwnerID.String()}
+			if user, err := models.FindMaybeDeletedUserByID(organizationID, version.OwnerID.String()); err == nil && user != nil {
+				item.PublishedBy.Name = user.Name
+			}
+		}
+
+		result = append(result, item)
+	}
+
+	return &pb.ListBlueprintVersionsResponse{Versions: result}, nil
+}
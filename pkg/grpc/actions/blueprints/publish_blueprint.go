@@ -0,0 +1,44 @@
+package blueprints
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func PublishBlueprint(ctx context.Context, organizationID string, id string) (*pb.PublishBlueprintResponse, error) {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint id: %v", err)
+	}
+
+	blueprint, err := models.FindBlueprint(organizationID, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "blueprint not found: %v", err)
+	}
+
+	var published *models.Blueprint
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		published, _, err = models.PublishBlueprintDraftInTransaction(tx, blueprint.ID)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, models.ErrBlueprintDraftNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "blueprint has no unpublished changes")
+		}
+
+		return nil, err
+	}
+
+	return &pb.PublishBlueprintResponse{
+		Blueprint: SerializeBlueprint(published),
+	}, nil
+}
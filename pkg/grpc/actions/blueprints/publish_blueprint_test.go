@@ -0,0 +1,139 @@
+package blueprints
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__BlueprintVersioning(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	noop := func(id string) *componentpb.Node {
+		return &componentpb.Node{
+			Id:        id,
+			Name:      id,
+			Type:      componentpb.Node_TYPE_COMPONENT,
+			Component: &componentpb.Node_ComponentRef{Name: "noop"},
+		}
+	}
+
+	created, err := CreateBlueprint(ctx, r.Registry, orgID, &pb.Blueprint{
+		Name:  "Deploy",
+		Nodes: []*componentpb.Node{noop("first")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), created.Blueprint.Version)
+	require.False(t, created.Blueprint.HasDraft)
+
+	id := created.Blueprint.Id
+
+	t.Run("publishing without a draft -> error", func(t *testing.T) {
+		_, err := PublishBlueprint(ctx, orgID, id)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("update saves a draft and keeps the published version", func(t *testing.T) {
+		updated, err := UpdateBlueprint(ctx, r.Registry, orgID, id, &pb.Blueprint{
+			Name:  "Deploy v2",
+			Nodes: []*componentpb.Node{noop("first"), noop("second")},
+			Edges: []*componentpb.Edge{{SourceId: "first", TargetId: "second", Channel: "default"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), updated.Blueprint.Version)
+		assert.True(t, updated.Blueprint.HasDraft)
+		assert.Len(t, updated.Blueprint.Nodes, 2)
+
+		described, err := DescribeBlueprint(ctx, r.Registry, orgID, id, 0, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Deploy v2", described.Blueprint.Name)
+		assert.Equal(t, int32(1), described.Blueprint.Version)
+		assert.Len(t, described.Blueprint.Nodes, 1)
+
+		described, err = DescribeBlueprint(ctx, r.Registry, orgID, id, 0, true)
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), described.Blueprint.Version)
+		assert.Len(t, described.Blueprint.Nodes, 2)
+	})
+
+	t.Run("diff between the published version and the draft", func(t *testing.T) {
+		response, err := DiffBlueprintVersions(ctx, orgID, id, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), response.Diff.FromVersion)
+		assert.Equal(t, int32(0), response.Diff.ToVersion)
+		assert.Equal(t, []string{"second"}, response.Diff.AddedNodeIds)
+		assert.Empty(t, response.Diff.RemovedNodeIds)
+		assert.Empty(t, response.Diff.ChangedNodeIds)
+		assert.True(t, response.Diff.EdgesChanged)
+	})
+
+	t.Run("publish turns the draft into the next version", func(t *testing.T) {
+		published, err := PublishBlueprint(ctx, orgID, id)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), published.Blueprint.Version)
+		assert.False(t, published.Blueprint.HasDraft)
+		assert.Len(t, published.Blueprint.Nodes, 2)
+
+		versions, err := ListBlueprintVersions(ctx, orgID, id)
+		require.NoError(t, err)
+		require.Len(t, versions.Versions, 2)
+		assert.Equal(t, int32(2), versions.Versions[0].Version)
+		assert.Equal(t, int32(1), versions.Versions[1].Version)
+
+		described, err := DescribeBlueprint(ctx, r.Registry, orgID, id, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), described.Blueprint.Version)
+		assert.Len(t, described.Blueprint.Nodes, 1)
+	})
+
+	t.Run("pinned references keep the content of their version", func(t *testing.T) {
+		blueprint, err := models.FindBlueprintForRefInTransaction(database.Conn(), &models.BlueprintRef{ID: id, Version: 1})
+		require.NoError(t, err)
+		assert.Len(t, blueprint.Nodes, 1)
+
+		blueprint, err = models.FindBlueprintForRefInTransaction(database.Conn(), &models.BlueprintRef{ID: id})
+		require.NoError(t, err)
+		assert.Equal(t, 2, blueprint.Version)
+		assert.Len(t, blueprint.Nodes, 2)
+
+		_, err = models.FindBlueprintForRefInTransaction(database.Conn(), &models.BlueprintRef{ID: id, Version: 5})
+		require.ErrorContains(t, err, "version 5")
+	})
+
+	t.Run("discarding the draft", func(t *testing.T) {
+		_, err := UpdateBlueprint(ctx, r.Registry, orgID, id, &pb.Blueprint{
+			Name:  "Deploy v2",
+			Nodes: []*componentpb.Node{noop("first")},
+		})
+		require.NoError(t, err)
+
+		_, err = DiscardBlueprintDraft(ctx, orgID, id)
+		require.NoError(t, err)
+
+		described, err := DescribeBlueprint(ctx, r.Registry, orgID, id, 0, true)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), described.Blueprint.Version)
+		assert.False(t, described.Blueprint.HasDraft)
+
+		_, err = DiscardBlueprintDraft(ctx, orgID, id)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
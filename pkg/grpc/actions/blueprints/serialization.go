@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -28,6 +29,11 @@ func SerializeBlueprint(in *models.Blueprint) *pb.Blueprint {
 		createdBy = &pb.UserRef{Id: idStr, Name: name}
 	}
 
+	hasDraft, err := models.HasBlueprintDraft(in.ID)
+	if err != nil {
+		log.Errorf("error checking draft of blueprint %s: %v", in.ID, err)
+	}
+
 	return &pb.Blueprint{
 		Id:             in.ID.String(),
 		OrganizationId: in.OrganizationID.String(),
@@ -42,6 +48,8 @@ func SerializeBlueprint(in *models.Blueprint) *pb.Blueprint {
 		Configuration:  ConfigurationToProto(in.Configuration),
 		OutputChannels: OutputChannelsToProto(in.OutputChannels),
 		CreatedBy:      createdBy,
+		Version:        int32(in.Version),
+		HasDraft:       hasDraft,
 	}
 }
 
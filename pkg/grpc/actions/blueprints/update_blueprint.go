@@ -5,15 +5,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	"github.com/superplanehq/superplane/pkg/registry"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"gorm.io/datatypes"
+	"gorm.io/gorm"
 )
 
+// UpdateBlueprint saves the nodes, edges, configuration and output channels into the draft
+// of the blueprint, so canvases using it do not change until the draft is published.
+// Name, description, icon and color are not versioned, and are updated in place.
 func UpdateBlueprint(ctx context.Context, registry *registry.Registry, organizationID string, id string, blueprint *pb.Blueprint) (*pb.UpdateBlueprintResponse, error) {
 	_, err := uuid.Parse(id)
 	if err != nil {
@@ -42,22 +46,45 @@ func UpdateBlueprint(ctx context.Context, registry *registry.Registry, organizat
 		return nil, status.Errorf(codes.InvalidArgument, "invalid configuration: %v", err)
 	}
 
-	now := time.Now()
-	existing.Name = blueprint.Name
-	existing.Description = blueprint.Description
-	existing.Icon = blueprint.Icon
-	existing.Color = blueprint.Color
-	existing.Nodes = nodes
-	existing.Edges = edges
-	existing.Configuration = datatypes.NewJSONSlice(configuration)
-	existing.UpdatedAt = &now
-	existing.OutputChannels = datatypes.NewJSONSlice(outputChannels)
-
-	if err := database.Conn().Save(&existing).Error; err != nil {
+	var ownerID *uuid.UUID
+	if userID, ok := authentication.GetUserIdFromMetadata(ctx); ok {
+		if parsed, err := uuid.Parse(userID); err == nil {
+			ownerID = &parsed
+		}
+	}
+
+	var draft *models.BlueprintVersion
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		existing.Name = blueprint.Name
+		existing.Description = blueprint.Description
+		existing.Icon = blueprint.Icon
+		existing.Color = blueprint.Color
+		existing.UpdatedAt = &now
+
+		err := tx.Model(existing).
+			Select("name", "description", "icon", "color", "updated_at").
+			Updates(existing).
+			Error
+		if err != nil {
+			return err
+		}
+
+		draft, err = models.SaveBlueprintDraftInTransaction(tx, existing.ID, ownerID, models.BlueprintContent{
+			Nodes:          nodes,
+			Edges:          edges,
+			Configuration:  configuration,
+			OutputChannels: outputChannels,
+		})
+
+		return err
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
 	return &pb.UpdateBlueprintResponse{
-		Blueprint: SerializeBlueprint(existing),
+		Blueprint: SerializeBlueprint(existing.WithVersion(draft)),
 	}, nil
 }
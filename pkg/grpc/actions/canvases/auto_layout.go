@@ -8,6 +8,7 @@ import (
 	"github.com/nulab/autog"
 	"github.com/nulab/autog/graph"
 	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
 	"github.com/superplanehq/superplane/pkg/registry"
@@ -476,6 +477,8 @@ func buildOutputChannelRankByNodeID(
 			outputChannels = []core.OutputChannel{core.DefaultOutputChannel}
 		}
 
+		outputChannels = actions.NodeOutputChannels(outputChannels, node.TimeoutSeconds)
+
 		channelRanks := make(map[string]int, len(outputChannels))
 		for i, outputChannel := range outputChannels {
 			channelName := strings.TrimSpace(outputChannel.Name)
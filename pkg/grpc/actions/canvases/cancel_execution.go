@@ -95,6 +95,7 @@ func cancelExecutionInTransaction(tx *gorm.DB, authService authorization.Authori
 				Auth:           contexts.NewAuthContext(tx, orgUUID, authService, user),
 				Notifications:  contexts.NewNotificationContext(tx, orgUUID, execution.WorkflowID),
 				CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
+				Logs:           contexts.NewExecutionLogContext(execution),
 			}
 
 			if node.AppInstallationID != nil {
@@ -0,0 +1,224 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func ListCanvasMemoryNamespaces(ctx context.Context, organizationID, canvasID string) (*pb.ListCanvasMemoryNamespacesResponse, error) {
+	canvas, err := findCanvasForMemoryNamespaces(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := models.ListCanvasMemoryNamespaces(canvas.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list canvas memory namespaces")
+	}
+
+	namespaces := make([]*pb.CanvasMemoryNamespace, 0, len(records))
+	for _, record := range records {
+		namespaces = append(namespaces, serializeCanvasMemoryNamespace(&record))
+	}
+
+	return &pb.ListCanvasMemoryNamespacesResponse{
+		Namespaces: namespaces,
+	}, nil
+}
+
+// UpdateCanvasMemoryNamespace updates the default TTL and the schema of a memory namespace.
+// Both only apply to records written after the update, but the unique key
+// of the existing records is recomputed when it changes, which fails
+// if some of them have the same values for it.
+func UpdateCanvasMemoryNamespace(
+	ctx context.Context,
+	organizationID, canvasID, namespace string,
+	defaultTTLSeconds uint32,
+	pbSchema *pb.CanvasMemorySchema,
+) (*pb.UpdateCanvasMemoryNamespaceResponse, error) {
+	canvas, err := findCanvasForMemoryNamespaces(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace = strings.TrimSpace(namespace)
+	if namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	defaultTTL := time.Duration(defaultTTLSeconds) * time.Second
+	if err := models.ValidateCanvasMemoryTTL(defaultTTL); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	schema, err := deserializeCanvasMemorySchema(pbSchema)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	record := &models.CanvasMemoryNamespace{
+		CanvasID:          canvas.ID,
+		Namespace:         namespace,
+		DefaultTTLSeconds: int(defaultTTLSeconds),
+	}
+
+	if schema != nil {
+		s := datatypes.NewJSONType(*schema)
+		record.Schema = &s
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		var previous *models.CanvasMemorySchema
+		existing, err := models.FindCanvasMemoryNamespaceInTransaction(tx, canvas.ID, namespace)
+		if err == nil {
+			previous = existing.MemorySchema()
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err := models.UpsertCanvasMemoryNamespaceInTransaction(tx, record); err != nil {
+			return err
+		}
+
+		if err := models.UpdateCanvasMemorySchemaInTransaction(tx, canvas.ID, namespace, previous, schema); err != nil {
+			return err
+		}
+
+		record, err = models.FindCanvasMemoryNamespaceInTransaction(tx, canvas.ID, namespace)
+		return err
+	})
+
+	if err != nil {
+		if errors.Is(err, models.ErrCanvasMemoryDuplicateUniqueKey) {
+			return nil, status.Errorf(codes.FailedPrecondition, "namespace %s: %v", namespace, err)
+		}
+
+		return nil, status.Error(codes.Internal, "failed to update canvas memory namespace")
+	}
+
+	return &pb.UpdateCanvasMemoryNamespaceResponse{
+		Namespace: serializeCanvasMemoryNamespace(record),
+	}, nil
+}
+
+func findCanvasForMemoryNamespaces(organizationID, canvasID string) (*models.Canvas, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvas, nil
+}
+
+func serializeCanvasMemoryNamespace(record *models.CanvasMemoryNamespace) *pb.CanvasMemoryNamespace {
+	return &pb.CanvasMemoryNamespace{
+		Namespace:         record.Namespace,
+		DefaultTtlSeconds: uint32(record.DefaultTTLSeconds),
+		UpdatedAt:         timestamppb.New(record.UpdatedAt),
+		Schema:            serializeCanvasMemorySchema(record.MemorySchema()),
+	}
+}
+
+var canvasMemoryFieldTypes = map[pb.CanvasMemorySchema_Field_Type]string{
+	pb.CanvasMemorySchema_Field_TYPE_STRING:  models.CanvasMemoryFieldTypeString,
+	pb.CanvasMemorySchema_Field_TYPE_NUMBER:  models.CanvasMemoryFieldTypeNumber,
+	pb.CanvasMemorySchema_Field_TYPE_BOOLEAN: models.CanvasMemoryFieldTypeBoolean,
+	pb.CanvasMemorySchema_Field_TYPE_OBJECT:  models.CanvasMemoryFieldTypeObject,
+	pb.CanvasMemorySchema_Field_TYPE_LIST:    models.CanvasMemoryFieldTypeList,
+}
+
+func serializeCanvasMemorySchema(schema *models.CanvasMemorySchema) *pb.CanvasMemorySchema {
+	if schema == nil {
+		return nil
+	}
+
+	fields := make([]*pb.CanvasMemorySchema_Field, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fieldType := pb.CanvasMemorySchema_Field_TYPE_UNSPECIFIED
+		for t, name := range canvasMemoryFieldTypes {
+			if name == field.Type {
+				fieldType = t
+			}
+		}
+
+		fields = append(fields, &pb.CanvasMemorySchema_Field{
+			Name:     field.Name,
+			Type:     fieldType,
+			Required: field.Required,
+		})
+	}
+
+	lookupKeys := make([]*pb.CanvasMemorySchema_LookupKey, 0, len(schema.LookupKeys))
+	for _, key := range schema.LookupKeys {
+		lookupKeys = append(lookupKeys, &pb.CanvasMemorySchema_LookupKey{Fields: key})
+	}
+
+	return &pb.CanvasMemorySchema{
+		Fields:     fields,
+		UniqueKey:  schema.UniqueKey,
+		LookupKeys: lookupKeys,
+	}
+}
+
+func deserializeCanvasMemorySchema(schema *pb.CanvasMemorySchema) (*models.CanvasMemorySchema, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	fields := make([]models.CanvasMemoryField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fieldType, ok := canvasMemoryFieldTypes[field.Type]
+		if !ok {
+			return nil, fmt.Errorf("field %s: type is required", field.Name)
+		}
+
+		fields = append(fields, models.CanvasMemoryField{
+			Name:     field.Name,
+			Type:     fieldType,
+			Required: field.Required,
+		})
+	}
+
+	lookupKeys := make([][]string, 0, len(schema.LookupKeys))
+	for _, key := range schema.LookupKeys {
+		lookupKeys = append(lookupKeys, key.Fields)
+	}
+
+	result := &models.CanvasMemorySchema{
+		Fields:     fields,
+		UniqueKey:  schema.UniqueKey,
+		LookupKeys: lookupKeys,
+	}
+
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
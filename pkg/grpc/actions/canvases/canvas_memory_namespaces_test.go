@@ -0,0 +1,132 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CanvasMemoryNamespaces(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := context.Background()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	t.Run("canvas without namespace settings", func(t *testing.T) {
+		resp, err := ListCanvasMemoryNamespaces(ctx, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		assert.Empty(t, resp.Namespaces)
+	})
+
+	t.Run("default TTL is created and updated", func(t *testing.T) {
+		resp, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "deploys", 3600, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "deploys", resp.Namespace.Namespace)
+		assert.Equal(t, uint32(3600), resp.Namespace.DefaultTtlSeconds)
+
+		resp, err = UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "deploys", 60, nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(60), resp.Namespace.DefaultTtlSeconds)
+
+		list, err := ListCanvasMemoryNamespaces(ctx, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		require.Len(t, list.Namespaces, 1)
+		assert.Equal(t, uint32(60), list.Namespaces[0].DefaultTtlSeconds)
+	})
+
+	t.Run("TTL longer than the maximum is rejected", func(t *testing.T) {
+		_, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "deploys", 400*24*3600, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("empty namespace is rejected", func(t *testing.T) {
+		_, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), " ", 60, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("canvas from another organization is not found", func(t *testing.T) {
+		_, err := ListCanvasMemoryNamespaces(ctx, uuid.NewString(), canvas.ID.String())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("schema is created and returned", func(t *testing.T) {
+		schema := &pb.CanvasMemorySchema{
+			Fields: []*pb.CanvasMemorySchema_Field{
+				{Name: "environment", Type: pb.CanvasMemorySchema_Field_TYPE_STRING, Required: true},
+				{Name: "version", Type: pb.CanvasMemorySchema_Field_TYPE_STRING},
+			},
+			UniqueKey:  []string{"environment"},
+			LookupKeys: []*pb.CanvasMemorySchema_LookupKey{{Fields: []string{"version"}}},
+		}
+
+		resp, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "environments", 0, schema)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Namespace.Schema)
+		require.Len(t, resp.Namespace.Schema.Fields, 2)
+		assert.Equal(t, pb.CanvasMemorySchema_Field_TYPE_STRING, resp.Namespace.Schema.Fields[0].Type)
+		assert.True(t, resp.Namespace.Schema.Fields[0].Required)
+		assert.Equal(t, []string{"environment"}, resp.Namespace.Schema.UniqueKey)
+		require.Len(t, resp.Namespace.Schema.LookupKeys, 1)
+		assert.Equal(t, []string{"version"}, resp.Namespace.Schema.LookupKeys[0].Fields)
+
+		resp, err = UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "environments", 0, nil)
+		require.NoError(t, err)
+		assert.Nil(t, resp.Namespace.Schema)
+	})
+
+	t.Run("invalid schema is rejected", func(t *testing.T) {
+		schema := &pb.CanvasMemorySchema{
+			Fields:    []*pb.CanvasMemorySchema_Field{{Name: "environment", Type: pb.CanvasMemorySchema_Field_TYPE_STRING}},
+			UniqueKey: []string{"environment"},
+		}
+
+		_, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "environments", 0, schema)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("unique key with duplicate records is rejected", func(t *testing.T) {
+		require.NoError(t, models.AddCanvasMemoryInTransaction(database.Conn(), canvas.ID, "clusters", map[string]any{"name": "a"}))
+		require.NoError(t, models.AddCanvasMemoryInTransaction(database.Conn(), canvas.ID, "clusters", map[string]any{"name": "a"}))
+
+		schema := &pb.CanvasMemorySchema{
+			Fields:    []*pb.CanvasMemorySchema_Field{{Name: "name", Type: pb.CanvasMemorySchema_Field_TYPE_STRING, Required: true}},
+			UniqueKey: []string{"name"},
+		}
+
+		_, err := UpdateCanvasMemoryNamespace(ctx, r.Organization.ID.String(), canvas.ID.String(), "clusters", 0, schema)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("memories expose their expiry", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		require.NoError(t, models.AddExpiringCanvasMemoryInTransaction(database.Conn(), canvas.ID, "deploys", map[string]any{"id": "1"}, expiresAt))
+		require.NoError(t, models.AddExpiringCanvasMemoryInTransaction(database.Conn(), canvas.ID, "deploys", map[string]any{"id": "2"}, time.Now().Add(-time.Hour)))
+
+		resp, err := ListCanvasMemories(ctx, nil, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		require.NotNil(t, resp.Items[0].ExpiresAt)
+		assert.WithinDuration(t, expiresAt, resp.Items[0].ExpiresAt.AsTime(), time.Second)
+	})
+}
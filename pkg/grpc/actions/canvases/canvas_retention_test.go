@@ -0,0 +1,106 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasRetention(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: "component-1",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	event := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+	for _, age := range []time.Duration{time.Hour, 48 * time.Hour, 72 * time.Hour} {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", event.ID, event.ID, nil)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":      models.CanvasNodeExecutionStateFinished,
+			"result":     models.CanvasNodeExecutionResultPassed,
+			"created_at": time.Now().Add(-age),
+		}).Error)
+	}
+
+	t.Run("without policy, nothing would be deleted", func(t *testing.T) {
+		resp, err := GetCanvasRetentionReport(ctx, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, pb.RetentionPolicy_SOURCE_NONE, resp.Source)
+		assert.Empty(t, resp.Nodes)
+		assert.Zero(t, resp.Executions)
+	})
+
+	t.Run("organization policy is reported and nothing is deleted", func(t *testing.T) {
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID: r.Organization.ID,
+			MaxAgeDays:     1,
+		}))
+
+		resp, err := GetCanvasRetentionReport(ctx, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, pb.RetentionPolicy_SOURCE_ORGANIZATION, resp.Source)
+		assert.Equal(t, int32(1), resp.RetentionPolicy.MaxAgeDays)
+		assert.Equal(t, uint32(2), resp.Executions)
+		require.Len(t, resp.Nodes, 1)
+		assert.Equal(t, "component-1", resp.Nodes[0].NodeId)
+		assert.Equal(t, uint32(2), resp.Nodes[0].Executions)
+
+		support.VerifyNodeExecutionsCount(t, canvas.ID, 3)
+	})
+
+	t.Run("canvas policy overrides the organization one", func(t *testing.T) {
+		updated, err := UpdateCanvasRetentionPolicy(ctx, r.Organization.ID.String(), canvas.ID.String(), &pb.RetentionPolicy{
+			MaxExecutionsPerNode: 2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pb.RetentionPolicy_SOURCE_CANVAS, updated.Source)
+		assert.Equal(t, int32(2), updated.RetentionPolicy.MaxExecutionsPerNode)
+
+		resp, err := GetCanvasRetentionReport(ctx, r.Organization.ID.String(), canvas.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, pb.RetentionPolicy_SOURCE_CANVAS, resp.Source)
+		assert.Equal(t, uint32(1), resp.Executions)
+	})
+
+	t.Run("removing the canvas policy inherits the organization one again", func(t *testing.T) {
+		updated, err := UpdateCanvasRetentionPolicy(ctx, r.Organization.ID.String(), canvas.ID.String(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, pb.RetentionPolicy_SOURCE_ORGANIZATION, updated.Source)
+		assert.Equal(t, int32(1), updated.RetentionPolicy.MaxAgeDays)
+	})
+
+	t.Run("invalid policy is rejected", func(t *testing.T) {
+		_, err := UpdateCanvasRetentionPolicy(ctx, r.Organization.ID.String(), canvas.ID.String(), &pb.RetentionPolicy{
+			MaxAgeDays: -1,
+		})
+
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+}
@@ -0,0 +1,263 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ListCanvasRoleBindings(ctx context.Context, authService authorization.Authorization, organizationID string, canvasID string) (*pb.ListCanvasRoleBindingsResponse, error) {
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := authService.ListCanvasRoleBindings(organizationID, canvas.ID.String())
+	if err != nil {
+		log.Errorf("failed to list role bindings for canvas %s: %v", canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to list canvas role bindings")
+	}
+
+	response := &pb.ListCanvasRoleBindingsResponse{
+		RoleBindings: []*pb.CanvasRoleBinding{},
+	}
+
+	for _, binding := range bindings {
+		response.RoleBindings = append(response.RoleBindings, serializeCanvasRoleBinding(organizationID, binding))
+	}
+
+	return response, nil
+}
+
+func AssignCanvasRole(
+	ctx context.Context,
+	authService authorization.Authorization,
+	organizationID string,
+	canvasID string,
+	subjectType pb.CanvasRoleBinding_SubjectType,
+	subjectID string,
+	role pb.CanvasRole,
+) (*pb.AssignCanvasRoleResponse, error) {
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName, err := canvasRoleFromProto(role)
+	if err != nil {
+		return nil, err
+	}
+
+	authSubjectType, err := findCanvasRoleSubject(authService, organizationID, subjectType, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authService.AssignCanvasRole(organizationID, canvas.ID.String(), authSubjectType, subjectID, roleName)
+	if err != nil {
+		log.Errorf("failed to assign role %s on canvas %s: %v", roleName, canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to assign canvas role")
+	}
+
+	binding := &authorization.CanvasRoleBinding{
+		SubjectType: authSubjectType,
+		SubjectID:   subjectID,
+		Role:        roleName,
+	}
+
+	return &pb.AssignCanvasRoleResponse{
+		RoleBinding: serializeCanvasRoleBinding(organizationID, binding),
+	}, nil
+}
+
+func RemoveCanvasRole(
+	ctx context.Context,
+	authService authorization.Authorization,
+	organizationID string,
+	canvasID string,
+	subjectType pb.CanvasRoleBinding_SubjectType,
+	subjectID string,
+) (*pb.RemoveCanvasRoleResponse, error) {
+	canvas, err := findCanvasForRoleBindings(organizationID, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	authSubjectType, err := canvasRoleSubjectTypeFromProto(subjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if subjectID == "" {
+		return nil, status.Error(codes.InvalidArgument, "subject_id is required")
+	}
+
+	bindings, err := authService.ListCanvasRoleBindings(organizationID, canvas.ID.String())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list canvas role bindings")
+	}
+
+	found := false
+	for _, binding := range bindings {
+		if binding.SubjectType == authSubjectType && binding.SubjectID == subjectID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, status.Error(codes.NotFound, "role binding not found")
+	}
+
+	err = authService.RemoveCanvasRole(organizationID, canvas.ID.String(), authSubjectType, subjectID)
+	if err != nil {
+		log.Errorf("failed to remove role on canvas %s: %v", canvas.ID, err)
+		return nil, status.Error(codes.Internal, "failed to remove canvas role")
+	}
+
+	return &pb.RemoveCanvasRoleResponse{}, nil
+}
+
+func findCanvasForRoleBindings(organizationID string, canvasID string) (*models.Canvas, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	return canvas, nil
+}
+
+// findCanvasRoleSubject checks the subject of a role binding belongs to the organization.
+// Service accounts are users for authorization, so they are bound as users.
+func findCanvasRoleSubject(authService authorization.Authorization, organizationID string, subjectType pb.CanvasRoleBinding_SubjectType, subjectID string) (string, error) {
+	authSubjectType, err := canvasRoleSubjectTypeFromProto(subjectType)
+	if err != nil {
+		return "", err
+	}
+
+	if subjectID == "" {
+		return "", status.Error(codes.InvalidArgument, "subject_id is required")
+	}
+
+	if authSubjectType == authorization.SubjectTypeGroup {
+		groups, err := authService.GetGroups(organizationID, models.DomainTypeOrganization)
+		if err != nil {
+			return "", status.Error(codes.Internal, "failed to list groups")
+		}
+
+		for _, group := range groups {
+			if group == subjectID {
+				return authSubjectType, nil
+			}
+		}
+
+		return "", status.Error(codes.NotFound, "group not found")
+	}
+
+	if _, err := uuid.Parse(subjectID); err != nil {
+		return "", status.Error(codes.InvalidArgument, "invalid subject_id")
+	}
+
+	user, err := models.FindActiveUserByID(organizationID, subjectID)
+	if err != nil {
+		if subjectType == pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT {
+			return "", status.Error(codes.NotFound, "service account not found")
+		}
+		return "", status.Error(codes.NotFound, "user not found")
+	}
+
+	if subjectType == pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT && !user.IsServiceAccount() {
+		return "", status.Error(codes.NotFound, "service account not found")
+	}
+
+	return authSubjectType, nil
+}
+
+func serializeCanvasRoleBinding(organizationID string, binding *authorization.CanvasRoleBinding) *pb.CanvasRoleBinding {
+	result := &pb.CanvasRoleBinding{
+		SubjectId: binding.SubjectID,
+		Role:      canvasRoleToProto(binding.Role),
+	}
+
+	switch binding.SubjectType {
+	case authorization.SubjectTypeGroup:
+		result.SubjectType = pb.CanvasRoleBinding_SUBJECT_TYPE_GROUP
+		result.SubjectName = binding.SubjectID
+		metadata, err := models.FindGroupMetadata(binding.SubjectID, models.DomainTypeOrganization, organizationID)
+		if err == nil && metadata.DisplayName != "" {
+			result.SubjectName = metadata.DisplayName
+		}
+
+	case authorization.SubjectTypeUser:
+		result.SubjectType = pb.CanvasRoleBinding_SUBJECT_TYPE_USER
+		user, err := models.FindMaybeDeletedUserByID(organizationID, binding.SubjectID)
+		if err != nil {
+			break
+		}
+
+		result.SubjectName = user.Name
+		if user.IsServiceAccount() {
+			result.SubjectType = pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT
+		}
+	}
+
+	return result
+}
+
+func canvasRoleSubjectTypeFromProto(subjectType pb.CanvasRoleBinding_SubjectType) (string, error) {
+	switch subjectType {
+	case pb.CanvasRoleBinding_SUBJECT_TYPE_USER, pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT:
+		return authorization.SubjectTypeUser, nil
+	case pb.CanvasRoleBinding_SUBJECT_TYPE_GROUP:
+		return authorization.SubjectTypeGroup, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid subject_type")
+	}
+}
+
+func canvasRoleFromProto(role pb.CanvasRole) (string, error) {
+	switch role {
+	case pb.CanvasRole_CANVAS_ROLE_VIEWER:
+		return models.RoleCanvasViewer, nil
+	case pb.CanvasRole_CANVAS_ROLE_OPERATOR:
+		return models.RoleCanvasOperator, nil
+	case pb.CanvasRole_CANVAS_ROLE_EDITOR:
+		return models.RoleCanvasEditor, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "invalid role")
+	}
+}
+
+func canvasRoleToProto(role string) pb.CanvasRole {
+	switch role {
+	case models.RoleCanvasViewer:
+		return pb.CanvasRole_CANVAS_ROLE_VIEWER
+	case models.RoleCanvasOperator:
+		return pb.CanvasRole_CANVAS_ROLE_OPERATOR
+	case models.RoleCanvasEditor:
+		return pb.CanvasRole_CANVAS_ROLE_EDITOR
+	default:
+		return pb.CanvasRole_CANVAS_ROLE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,105 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CanvasRoleBindings(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	canvasID := canvas.ID.String()
+
+	t.Run("user can be bound to a canvas role", func(t *testing.T) {
+		user := support.CreateUser(t, r, r.Organization.ID)
+		resp, err := AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, user.ID.String(), pb.CanvasRole_CANVAS_ROLE_OPERATOR)
+		require.NoError(t, err)
+		assert.Equal(t, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, resp.RoleBinding.SubjectType)
+		assert.Equal(t, user.Name, resp.RoleBinding.SubjectName)
+		assert.Equal(t, pb.CanvasRole_CANVAS_ROLE_OPERATOR, resp.RoleBinding.Role)
+
+		allowed, err := r.AuthService.CheckCanvasPermission(user.ID.String(), orgID, canvasID, authorization.ActionOperate)
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		list, err := ListCanvasRoleBindings(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		require.Len(t, list.RoleBindings, 1)
+		assert.Equal(t, user.ID.String(), list.RoleBindings[0].SubjectId)
+
+		_, err = RemoveCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, user.ID.String())
+		require.NoError(t, err)
+
+		list, err = ListCanvasRoleBindings(ctx, r.AuthService, orgID, canvasID)
+		require.NoError(t, err)
+		assert.Empty(t, list.RoleBindings)
+	})
+
+	t.Run("service account can be bound to a canvas role", func(t *testing.T) {
+		serviceAccount, err := models.CreateServiceAccount(database.Conn(), r.Organization.ID, support.RandomName("sa"), nil, r.User)
+		require.NoError(t, err)
+
+		resp, err := AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT, serviceAccount.ID.String(), pb.CanvasRole_CANVAS_ROLE_VIEWER)
+		require.NoError(t, err)
+		assert.Equal(t, pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT, resp.RoleBinding.SubjectType)
+
+		//
+		// Regular users cannot be bound as service accounts.
+		//
+		_, err = AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT, r.User.String(), pb.CanvasRole_CANVAS_ROLE_VIEWER)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("group can be bound to a canvas role", func(t *testing.T) {
+		require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "canvas-editors", models.RoleOrgViewer, "Canvas Editors", ""))
+		require.NoError(t, models.UpsertGroupMetadata("canvas-editors", models.DomainTypeOrganization, orgID, "Canvas Editors", ""))
+
+		resp, err := AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_GROUP, "canvas-editors", pb.CanvasRole_CANVAS_ROLE_EDITOR)
+		require.NoError(t, err)
+		assert.Equal(t, "Canvas Editors", resp.RoleBinding.SubjectName)
+	})
+
+	t.Run("subjects must belong to the organization", func(t *testing.T) {
+		_, err := AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, uuid.NewString(), pb.CanvasRole_CANVAS_ROLE_VIEWER)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+
+		_, err = AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_GROUP, "does-not-exist", pb.CanvasRole_CANVAS_ROLE_VIEWER)
+		s, ok = status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("invalid role is rejected", func(t *testing.T) {
+		_, err := AssignCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, r.User.String(), pb.CanvasRole_CANVAS_ROLE_UNSPECIFIED)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("removing a role that was not bound returns not found", func(t *testing.T) {
+		_, err := RemoveCanvasRole(ctx, r.AuthService, orgID, canvasID, pb.CanvasRoleBinding_SUBJECT_TYPE_USER, uuid.NewString())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,134 @@
+package canvases
+
+import (
+	"reflect"
+
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func ParseCanvasVariables(spec *pb.Canvas_Spec) (models.CanvasVariableSet, error) {
+	set := models.CanvasVariableSet{
+		Variables:    []models.CanvasVariable{},
+		Environments: []models.CanvasEnvironment{},
+	}
+
+	if spec == nil {
+		return set, nil
+	}
+
+	set.Variables = protoToCanvasVariables(spec.Variables)
+	for _, environment := range spec.Environments {
+		set.Environments = append(set.Environments, models.CanvasEnvironment{
+			Name:      environment.Name,
+			Variables: protoToCanvasVariables(environment.Variables),
+		})
+	}
+
+	if err := set.Validate(); err != nil {
+		return models.CanvasVariableSet{}, status.Errorf(codes.InvalidArgument, "invalid variables: %v", err)
+	}
+
+	return set, nil
+}
+
+func protoToCanvasVariables(in []*pb.CanvasVariable) []models.CanvasVariable {
+	variables := make([]models.CanvasVariable, 0, len(in))
+	for _, variable := range in {
+		v := models.CanvasVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Value:       variable.Value,
+		}
+
+		if variable.Secret != nil {
+			v.Secret = &models.CanvasVariableSecret{
+				Name: variable.Secret.Name,
+				Key:  variable.Secret.Key,
+			}
+		}
+
+		variables = append(variables, v)
+	}
+
+	return variables
+}
+
+func canvasVariablesToProto(in []models.CanvasVariable) []*pb.CanvasVariable {
+	variables := make([]*pb.CanvasVariable, 0, len(in))
+	for _, variable := range in {
+		v := &pb.CanvasVariable{
+			Name:        variable.Name,
+			Description: variable.Description,
+			Value:       variable.Value,
+		}
+
+		if variable.Secret != nil {
+			v.Secret = &pb.CanvasVariable_SecretRef{
+				Name: variable.Secret.Name,
+				Key:  variable.Secret.Key,
+			}
+		}
+
+		variables = append(variables, v)
+	}
+
+	return variables
+}
+
+func canvasEnvironmentsToProto(in []models.CanvasEnvironment) []*pb.CanvasEnvironment {
+	environments := make([]*pb.CanvasEnvironment, 0, len(in))
+	for _, environment := range in {
+		environments = append(environments, &pb.CanvasEnvironment{
+			Name:      environment.Name,
+			Variables: canvasVariablesToProto(environment.Variables),
+		})
+	}
+
+	return environments
+}
+
+// mergeCanvasVariablesIntoLive picks the variables to publish for a change request.
+// Variables are not merged one by one: the change request ones are used
+// if it changed them, and the live ones are kept otherwise.
+func mergeCanvasVariablesIntoLive(base, live, version models.CanvasVariableSet) models.CanvasVariableSet {
+	if canvasVariableSetsEqual(base, version) {
+		return live
+	}
+
+	return version
+}
+
+func canvasVariableSetsEqual(a, b models.CanvasVariableSet) bool {
+	return reflect.DeepEqual(normalizeCanvasVariableSet(a), normalizeCanvasVariableSet(b))
+}
+
+// normalizeCanvasVariableSet makes empty and missing lists the same,
+// since versions created before variables existed have neither.
+func normalizeCanvasVariableSet(set models.CanvasVariableSet) models.CanvasVariableSet {
+	normalized := models.CanvasVariableSet{
+		Variables:    append([]models.CanvasVariable{}, set.Variables...),
+		Environments: []models.CanvasEnvironment{},
+	}
+
+	for _, environment := range set.Environments {
+		normalized.Environments = append(normalized.Environments, models.CanvasEnvironment{
+			Name:      environment.Name,
+			Variables: append([]models.CanvasVariable{}, environment.Variables...),
+		})
+	}
+
+	return normalized
+}
+
+// validateCanvasEnvironmentIsDefined prevents publishing a version
+// without the environment the canvas uses, since its nodes could not resolve variables.
+func validateCanvasEnvironmentIsDefined(canvas *models.Canvas, variables models.CanvasVariableSet) error {
+	if canvas.Environment == "" || variables.HasEnvironment(canvas.Environment) {
+		return nil
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "environment %s is used by the canvas and cannot be removed", canvas.Environment)
+}
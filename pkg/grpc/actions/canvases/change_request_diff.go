@@ -8,14 +8,18 @@ import (
 )
 
 type comparableCanvasNode struct {
-	ID            string
-	Name          string
-	Type          string
-	Ref           models.NodeRef
-	Configuration map[string]any
-	Position      models.Position
-	IsCollapsed   bool
-	IntegrationID *string
+	ID             string
+	Name           string
+	Type           string
+	Ref            models.NodeRef
+	Configuration  map[string]any
+	Position       models.Position
+	IsCollapsed    bool
+	IntegrationID  *string
+	RetryPolicy    *models.RetryPolicy
+	TimeoutSeconds int
+	MaxConcurrency int
+	QueuePolicy    string
 }
 
 type canvasChangeRequestDiff struct {
@@ -211,14 +215,27 @@ func mapEdgesByKey(edges []models.Edge) map[string]models.Edge {
 }
 
 func toComparableCanvasNode(node models.Node) comparableCanvasNode {
+	//
+	// Nodes saved before concurrency settings existed
+	// have no queue policy, which is the same as FIFO.
+	//
+	queuePolicy := node.QueuePolicy
+	if queuePolicy == "" {
+		queuePolicy = models.QueuePolicyFIFO
+	}
+
 	return comparableCanvasNode{
-		ID:            node.ID,
-		Name:          node.Name,
-		Type:          node.Type,
-		Ref:           node.Ref,
-		Configuration: node.Configuration,
-		Position:      node.Position,
-		IsCollapsed:   node.IsCollapsed,
-		IntegrationID: node.IntegrationID,
+		ID:             node.ID,
+		Name:           node.Name,
+		Type:           node.Type,
+		Ref:            node.Ref,
+		Configuration:  node.Configuration,
+		Position:       node.Position,
+		IsCollapsed:    node.IsCollapsed,
+		IntegrationID:  node.IntegrationID,
+		RetryPolicy:    node.RetryPolicy,
+		TimeoutSeconds: node.TimeoutSeconds,
+		MaxConcurrency: max(node.MaxConcurrency, 1),
+		QueuePolicy:    queuePolicy,
 	}
 }
@@ -48,3 +48,32 @@ func resolveCanvasChangeRequestBaseAndLiveInTransaction(
 	baseEdges = append([]models.Edge(nil), baseVersion.Edges...)
 	return baseNodes, baseEdges, liveNodes, liveEdges, nil
 }
+
+func resolveCanvasChangeRequestVariablesInTransaction(
+	tx *gorm.DB,
+	canvas *models.Canvas,
+	request *models.CanvasChangeRequest,
+	version *models.CanvasVersion,
+) (models.CanvasVariableSet, error) {
+	liveVersion, err := models.FindLiveCanvasVersionByCanvasInTransaction(tx, canvas)
+	if err != nil {
+		return models.CanvasVariableSet{}, err
+	}
+
+	base := liveVersion.VariableSet()
+	if request.BasedOnVersionID != nil {
+		baseVersion, err := models.FindCanvasVersionInTransaction(tx, canvas.ID, *request.BasedOnVersionID)
+		if err != nil {
+			return models.CanvasVariableSet{}, err
+		}
+
+		base = baseVersion.VariableSet()
+	}
+
+	variables := mergeCanvasVariablesIntoLive(base, liveVersion.VariableSet(), version.VariableSet())
+	if err := validateCanvasEnvironmentIsDefined(canvas, variables); err != nil {
+		return models.CanvasVariableSet{}, err
+	}
+
+	return variables, nil
+}
@@ -119,6 +119,7 @@ func CreateCanvasChangeRequestWithMetadata(
 			userUUID,
 			draftVersion.Nodes,
 			draftVersion.Edges,
+			draftVersion.VariableSet(),
 		)
 		if err != nil {
 			return err
@@ -70,3 +70,138 @@ func TestCreateCanvasInheritsOrganizationVersioningWhenEnabled(t *testing.T) {
 	require.NoError(t, findErr)
 	require.True(t, createdCanvas.CanvasVersioningEnabled)
 }
+
+func TestCreateCanvasWithTimeoutEdges(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	newCanvas := func(name string, timeoutSeconds int32) *pb.Canvas {
+		return &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: name},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*componentpb.Node{
+					{
+						Id:             "deploy",
+						Name:           "deploy",
+						Type:           componentpb.Node_TYPE_COMPONENT,
+						Component:      &componentpb.Node_ComponentRef{Name: "noop"},
+						TimeoutSeconds: timeoutSeconds,
+					},
+					{
+						Id:        "alert",
+						Name:      "alert",
+						Type:      componentpb.Node_TYPE_COMPONENT,
+						Component: &componentpb.Node_ComponentRef{Name: "noop"},
+					},
+				},
+				Edges: []*componentpb.Edge{
+					{SourceId: "deploy", TargetId: "alert", Channel: models.CanvasNodeExecutionTimeoutChannel},
+				},
+			},
+		}
+	}
+
+	response, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas("With timeout", 60))
+	require.NoError(t, err)
+	require.Len(t, response.Canvas.Spec.Edges, 1)
+	require.Equal(t, models.CanvasNodeExecutionTimeoutChannel, response.Canvas.Spec.Edges[0].Channel)
+
+	_, err = CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas("Without timeout", 0))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateCanvasWithVariables(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	newCanvas := func(name, environment string, environments []*pb.CanvasEnvironment) *pb.Canvas {
+		return &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: name, Environment: environment},
+			Spec: &pb.Canvas_Spec{
+				Nodes: []*componentpb.Node{},
+				Edges: []*componentpb.Edge{},
+				Variables: []*pb.CanvasVariable{
+					{Name: "CHANNEL", Value: "#staging"},
+					{Name: "TOKEN", Secret: &pb.CanvasVariable_SecretRef{Name: "credentials", Key: "token"}},
+				},
+				Environments: environments,
+			},
+		}
+	}
+
+	production := []*pb.CanvasEnvironment{
+		{Name: "production", Variables: []*pb.CanvasVariable{{Name: "CHANNEL", Value: "#deploys"}}},
+	}
+
+	response, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas("Production canvas", "production", production))
+	require.NoError(t, err)
+	require.Equal(t, "production", response.Canvas.Metadata.Environment)
+	require.Len(t, response.Canvas.Spec.Variables, 2)
+	require.Equal(t, "credentials", response.Canvas.Spec.Variables[1].Secret.Name)
+	require.Len(t, response.Canvas.Spec.Environments, 1)
+
+	_, err = CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas("Staging canvas", "staging", production))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	invalid := []*pb.CanvasEnvironment{
+		{Name: "production", Variables: []*pb.CanvasVariable{{Name: "UNKNOWN", Value: "x"}}},
+	}
+	_, err = CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), newCanvas("Invalid canvas", "", invalid))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateCanvasPinsBlueprintVersion(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+
+	noop := func(id string) models.Node {
+		return models.Node{
+			ID:   id,
+			Name: id,
+			Type: models.NodeTypeComponent,
+			Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+		}
+	}
+
+	blueprint := support.CreateBlueprint(t, r.Organization.ID, []models.Node{noop("first")}, []models.Edge{}, []models.BlueprintOutputChannel{
+		{Name: "default", NodeID: "first", NodeOutputChannel: "default"},
+	})
+
+	response, err := CreateCanvas(ctx, r.Registry, r.Organization.ID.String(), &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{Name: "Pinned canvas"},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:        "deploy",
+					Name:      "deploy",
+					Type:      componentpb.Node_TYPE_BLUEPRINT,
+					Blueprint: &componentpb.Node_BlueprintRef{Id: blueprint.ID.String()},
+				},
+			},
+			Edges: []*componentpb.Edge{},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Canvas.Spec.Nodes, 1)
+	require.Equal(t, int32(1), response.Canvas.Spec.Nodes[0].Blueprint.Version)
+	require.Equal(t, int32(1), response.Canvas.Spec.Nodes[0].Blueprint.LatestVersion)
+
+	_, err = models.SaveBlueprintDraftInTransaction(database.Conn(), blueprint.ID, &r.User, models.BlueprintContent{
+		Nodes:          []models.Node{noop("first"), noop("second")},
+		Edges:          []models.Edge{{SourceID: "first", TargetID: "second", Channel: "default"}},
+		OutputChannels: blueprint.OutputChannels,
+	})
+	require.NoError(t, err)
+
+	_, _, err = models.PublishBlueprintDraftInTransaction(database.Conn(), blueprint.ID)
+	require.NoError(t, err)
+
+	described, err := DescribeCanvas(ctx, r.Registry, r.Organization.ID.String(), response.Canvas.Metadata.Id)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), described.Canvas.Spec.Nodes[0].Blueprint.Version)
+	require.Equal(t, int32(2), described.Canvas.Spec.Nodes[0].Blueprint.LatestVersion)
+
+	pinned, err := models.FindBlueprintForRefInTransaction(database.Conn(), &models.BlueprintRef{ID: blueprint.ID.String(), Version: 1})
+	require.NoError(t, err)
+	require.Len(t, pinned.Nodes, 1)
+}
@@ -0,0 +1,348 @@
+package canvases
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+)
+
+// EvaluateExpression resolves an expression or a configuration the same way
+// the node would when processing an event, so templates can be checked before running a canvas.
+// Problems with the expression itself are returned in the response, not as errors.
+func EvaluateExpression(ctx context.Context, registry *registry.Registry, organizationID string, req *pb.EvaluateExpressionRequest) (*pb.EvaluateExpressionResponse, error) {
+	orgID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas id")
+	}
+
+	hasExpression := strings.TrimSpace(req.Expression) != ""
+	hasConfiguration := req.Configuration != nil
+	if hasExpression == hasConfiguration {
+		return nil, status.Error(codes.InvalidArgument, "either an expression or a configuration is required")
+	}
+
+	if req.EventId != "" && req.ExecutionId != "" {
+		return nil, status.Error(codes.InvalidArgument, "event and execution cannot be used together")
+	}
+
+	if _, err := models.FindCanvas(orgID, canvasID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+
+		return nil, err
+	}
+
+	response := &pb.EvaluateExpressionResponse{}
+
+	//
+	// Nothing is written while building configurations,
+	// but the transaction is read-only to guarantee it.
+	//
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, canvasID, req.NodeId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "node not found")
+			}
+
+			return err
+		}
+
+		builder, err := evaluationBuilder(tx, node, req.EventId, req.ExecutionId)
+		if err != nil {
+			return err
+		}
+
+		if hasExpression {
+			return evaluateExpression(builder, req.Expression, response)
+		}
+
+		return evaluateConfiguration(tx, registry, builder, node, req.Configuration.AsMap(), response)
+	}, &sql.TxOptions{ReadOnly: true})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// evaluationBuilder prepares the builder with the data the node
+// received for the event or execution, like the node queue worker does.
+func evaluationBuilder(tx *gorm.DB, node *models.CanvasNode, eventID, executionID string) (*contexts.NodeConfigurationBuilder, error) {
+	builder := contexts.NewNodeConfigurationBuilder(tx, node.WorkflowID).WithNodeID(node.NodeID).WithMaskedSecrets()
+
+	if node.ParentNodeID != nil {
+		parent, err := models.FindCanvasNode(tx, node.WorkflowID, *node.ParentNodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		builder = builder.ForBlueprintNode(parent)
+	}
+
+	switch {
+	case executionID != "":
+		id, err := uuid.Parse(executionID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid execution id")
+		}
+
+		execution, err := models.FindNodeExecutionWithNodeIDInTransaction(tx, node.WorkflowID, id, node.NodeID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "execution not found")
+			}
+
+			return nil, err
+		}
+
+		input, err := models.FindCanvasEventInTransaction(tx, execution.EventID)
+		if err != nil {
+			return nil, err
+		}
+
+		return builder.
+			WithRootEvent(&execution.RootEventID).
+			WithPreviousExecution(execution.PreviousExecutionID).
+			WithInput(map[string]any{input.NodeID: input.Data.Data()}), nil
+
+	case eventID != "":
+		id, err := uuid.Parse(eventID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid event id")
+		}
+
+		event, err := models.FindCanvasEventForCanvasInTransaction(tx, node.WorkflowID, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "event not found")
+			}
+
+			return nil, err
+		}
+
+		rootEventID := event.ID
+		if event.ExecutionID != nil {
+			execution, err := models.FindNodeExecutionInTransaction(tx, node.WorkflowID, *event.ExecutionID)
+			if err != nil {
+				return nil, err
+			}
+
+			rootEventID = execution.RootEventID
+		}
+
+		return builder.
+			WithRootEvent(&rootEventID).
+			WithPreviousExecution(event.ExecutionID).
+			WithInput(map[string]any{event.NodeID: event.Data.Data()}), nil
+
+	default:
+		return builder, nil
+	}
+}
+
+func evaluateExpression(builder *contexts.NodeConfigurationBuilder, expression string, response *pb.EvaluateExpressionResponse) error {
+	referencedNodes, err := contexts.ReferencedNodes(expression)
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+		return nil
+	}
+
+	response.ReferencedNodes = referencedNodes
+
+	var value any
+	if strings.Contains(expression, "{{") {
+		value, err = builder.ResolveExpression(expression)
+	} else {
+		value, err = builder.Evaluate(expression)
+	}
+
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+		return nil
+	}
+
+	response.Value, err = toProtoValue(value)
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+	}
+
+	return nil
+}
+
+func evaluateConfiguration(
+	tx *gorm.DB,
+	registry *registry.Registry,
+	builder *contexts.NodeConfigurationBuilder,
+	node *models.CanvasNode,
+	config map[string]any,
+	response *pb.EvaluateExpressionResponse,
+) error {
+	referencedNodes, err := configurationReferencedNodes(config)
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+		return nil
+	}
+
+	response.ReferencedNodes = referencedNodes
+
+	fields, err := configurationFieldsForNode(tx, registry, node)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) > 0 {
+		builder = builder.WithConfigurationFields(fields)
+	}
+
+	resolved, err := builder.Build(config)
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+		return nil
+	}
+
+	//
+	// Values are only typed once resolved,
+	// so this is where type errors show up.
+	//
+	if err := configuration.ValidateConfiguration(fields, resolved); err != nil {
+		response.Errors = append(response.Errors, err.Error())
+	}
+
+	value, err := toProtoValue(resolved)
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+		return nil
+	}
+
+	response.Configuration = value.GetStructValue()
+	return nil
+}
+
+func configurationReferencedNodes(value any) ([]string, error) {
+	nodes := []string{}
+
+	switch v := value.(type) {
+	case string:
+		if !strings.Contains(v, "{{") {
+			return nodes, nil
+		}
+
+		return contexts.ReferencedNodes(v)
+
+	case map[string]any:
+		for _, item := range v {
+			referenced, err := configurationReferencedNodes(item)
+			if err != nil {
+				return nil, err
+			}
+			nodes = appendMissing(nodes, referenced)
+		}
+
+	case []any:
+		for _, item := range v {
+			referenced, err := configurationReferencedNodes(item)
+			if err != nil {
+				return nil, err
+			}
+			nodes = appendMissing(nodes, referenced)
+		}
+	}
+
+	slices.Sort(nodes)
+	return nodes, nil
+}
+
+func appendMissing(values []string, items []string) []string {
+	for _, item := range items {
+		if !slices.Contains(values, item) {
+			values = append(values, item)
+		}
+	}
+
+	return values
+}
+
+func configurationFieldsForNode(tx *gorm.DB, registry *registry.Registry, node *models.CanvasNode) ([]configuration.Field, error) {
+	ref := node.Ref.Data()
+
+	switch node.Type {
+	case models.NodeTypeComponent:
+		if ref.Component == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s has no component reference", node.NodeID)
+		}
+
+		component, err := registry.GetComponent(ref.Component.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "component %s not found", ref.Component.Name)
+		}
+
+		return component.Configuration(), nil
+
+	case models.NodeTypeTrigger:
+		if ref.Trigger == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s has no trigger reference", node.NodeID)
+		}
+
+		trigger, err := registry.GetTrigger(ref.Trigger.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "trigger %s not found", ref.Trigger.Name)
+		}
+
+		return trigger.Configuration(), nil
+
+	case models.NodeTypeBlueprint:
+		if ref.Blueprint == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s has no blueprint reference", node.NodeID)
+		}
+
+		blueprint, err := models.FindBlueprintForRefInTransaction(tx, ref.Blueprint)
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "blueprint %s not found", ref.Blueprint.ID)
+		}
+
+		return blueprint.Configuration, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// toProtoValue goes through JSON, since values like times
+// are not supported by structpb, but are in expression results.
+func toProtoValue(value any) (*structpb.Value, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("value cannot be serialized: %w", err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("value cannot be serialized: %w", err)
+	}
+
+	return structpb.NewValue(decoded)
+}
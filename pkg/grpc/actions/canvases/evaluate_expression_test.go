@@ -0,0 +1,160 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/datatypes"
+)
+
+func Test__EvaluateExpression(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	orgID := r.Organization.ID.String()
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "trigger-1", Name: "Trigger", Type: models.NodeTypeTrigger},
+			{
+				NodeID: "component-1",
+				Name:   "Deploy",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: "wait-1",
+				Name:   "Wait",
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "wait"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: "trigger-1", TargetID: "component-1", Channel: "default"},
+			{SourceID: "trigger-1", TargetID: "wait-1", Channel: "default"},
+		},
+	)
+
+	canvasID := canvas.ID.String()
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, "trigger-1", "default", nil)
+
+	evaluate := func(req *pb.EvaluateExpressionRequest) (*pb.EvaluateExpressionResponse, error) {
+		req.CanvasId = canvasID
+		return EvaluateExpression(context.Background(), r.Registry, orgID, req)
+	}
+
+	t.Run("expression and configuration are exclusive", func(t *testing.T) {
+		configuration, err := structpb.NewStruct(map[string]any{})
+		require.NoError(t, err)
+
+		_, err = evaluate(&pb.EvaluateExpressionRequest{NodeId: "component-1", Expression: "1", Configuration: configuration})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+
+		_, err = evaluate(&pb.EvaluateExpressionRequest{NodeId: "component-1"})
+		s, ok = status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("node that does not exist -> error", func(t *testing.T) {
+		_, err := evaluate(&pb.EvaluateExpressionRequest{NodeId: "unknown", Expression: "1"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("event that does not exist -> error", func(t *testing.T) {
+		_, err := evaluate(&pb.EvaluateExpressionRequest{NodeId: "component-1", Expression: "1", EventId: uuid.NewString()})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("expression is evaluated against an event", func(t *testing.T) {
+		response, err := evaluate(&pb.EvaluateExpressionRequest{
+			NodeId:     "component-1",
+			Expression: `$["Trigger"].key + "-" + sha256("x")[0:4]`,
+			EventId:    rootEvent.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "value-2d71", response.Value.GetStringValue())
+		assert.Equal(t, []string{"Trigger"}, response.ReferencedNodes)
+	})
+
+	t.Run("template is evaluated against an execution", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "component-1", rootEvent.ID, rootEvent.ID, nil)
+
+		response, err := evaluate(&pb.EvaluateExpressionRequest{
+			NodeId:      "component-1",
+			Expression:  `root: {{ root().key }}, previous: {{ previous().key }}`,
+			ExecutionId: execution.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, response.Errors)
+		assert.Equal(t, "root: value, previous: value", response.Value.GetStringValue())
+		assert.Empty(t, response.ReferencedNodes)
+	})
+
+	t.Run("execution of another node -> error", func(t *testing.T) {
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, "wait-1", rootEvent.ID, rootEvent.ID, nil)
+
+		_, err := evaluate(&pb.EvaluateExpressionRequest{
+			NodeId:      "component-1",
+			Expression:  "1",
+			ExecutionId: execution.ID.String(),
+		})
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("errors are returned in the response", func(t *testing.T) {
+		response, err := evaluate(&pb.EvaluateExpressionRequest{NodeId: "component-1", Expression: `$["Trigger"`})
+		require.NoError(t, err)
+		assert.NotEmpty(t, response.Errors)
+		assert.Nil(t, response.Value)
+
+		response, err = evaluate(&pb.EvaluateExpressionRequest{NodeId: "component-1", Expression: `root().key`})
+		require.NoError(t, err)
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0], "no root event found")
+	})
+
+	t.Run("configuration is resolved and validated", func(t *testing.T) {
+		configuration, err := structpb.NewStruct(map[string]any{
+			"mode":    `{{ $["Trigger"].key }}`,
+			"waitFor": "10",
+			"unit":    "seconds",
+		})
+		require.NoError(t, err)
+
+		response, err := evaluate(&pb.EvaluateExpressionRequest{
+			NodeId:        "wait-1",
+			Configuration: configuration,
+			EventId:       rootEvent.ID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Trigger"}, response.ReferencedNodes)
+		assert.Equal(t, "value", response.Configuration.AsMap()["mode"])
+		require.Len(t, response.Errors, 1)
+		assert.Contains(t, response.Errors[0], "field 'mode'")
+	})
+}
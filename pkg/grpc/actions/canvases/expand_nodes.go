@@ -3,21 +3,31 @@ package canvases
 import (
 	"fmt"
 
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
 )
 
 /*
  * Expand nodes takes top-level workflow nodes and returns an expanded list including
  * internal nodes from referenced blueprints. Internal nodes are namespaced as
  * "<parentNodeID>:<internalNodeID>".
+ *
+ * Blueprint references without a version are pinned to the latest published
+ * version of the blueprint, in the nodes given, so that publishing a new
+ * blueprint version does not change the canvas until it is upgraded.
  */
 func expandNodes(organizationID string, nodes []models.Node) ([]models.Node, error) {
-	expanded := make([]models.Node, 0, len(nodes))
+	return expandNodesInTransaction(database.Conn(), organizationID, nodes)
+}
 
-	for _, n := range nodes {
-		expanded = append(expanded, n)
+func expandNodesInTransaction(tx *gorm.DB, organizationID string, nodes []models.Node) ([]models.Node, error) {
+	expanded := make([]models.Node, 0, len(nodes))
 
+	for i := range nodes {
+		n := &nodes[i]
 		if n.Type != models.NodeTypeBlueprint || n.Ref.Blueprint == nil {
+			expanded = append(expanded, *n)
 			continue
 		}
 
@@ -26,22 +36,32 @@ func expandNodes(organizationID string, nodes []models.Node) ([]models.Node, err
 			return nil, fmt.Errorf("blueprint node %s missing blueprint id", n.ID)
 		}
 
-		b, err := models.FindBlueprint(organizationID, blueprintID)
+		b, err := models.FindOrganizationBlueprintForRefInTransaction(tx, organizationID, n.Ref.Blueprint)
 		if err != nil {
 			return nil, fmt.Errorf("blueprint %s not found: %w", blueprintID, err)
 		}
 
+		if n.Ref.Blueprint.Version == 0 {
+			n.Ref.Blueprint = &models.BlueprintRef{ID: blueprintID, Version: b.Version}
+		}
+
+		expanded = append(expanded, *n)
+
 		for _, bn := range b.Nodes {
 			internal := models.Node{
-				ID:            n.ID + ":" + bn.ID,
-				Name:          bn.Name,
-				Type:          bn.Type,
-				Ref:           bn.Ref,
-				Configuration: bn.Configuration,
-				Metadata:      cloneMetadata(bn.Metadata),
-				Position:      bn.Position,
-				IsCollapsed:   bn.IsCollapsed,
-				IntegrationID: bn.IntegrationID,
+				ID:             n.ID + ":" + bn.ID,
+				Name:           bn.Name,
+				Type:           bn.Type,
+				Ref:            bn.Ref,
+				Configuration:  bn.Configuration,
+				Metadata:       cloneMetadata(bn.Metadata),
+				Position:       bn.Position,
+				IsCollapsed:    bn.IsCollapsed,
+				IntegrationID:  bn.IntegrationID,
+				RetryPolicy:    bn.RetryPolicy,
+				TimeoutSeconds: bn.TimeoutSeconds,
+				MaxConcurrency: bn.MaxConcurrency,
+				QueuePolicy:    bn.QueuePolicy,
 			}
 
 			expanded = append(expanded, internal)
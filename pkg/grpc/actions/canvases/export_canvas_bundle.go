@@ -0,0 +1,289 @@
+package canvases
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	blueprintactions "github.com/superplanehq/superplane/pkg/grpc/actions/blueprints"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CanvasBundleFormatVersion is the version of the bundle format exports use.
+// Imports reject bundles with a newer format than the one they know.
+const CanvasBundleFormatVersion = 1
+
+/*
+ * ExportCanvasBundle returns a canvas in a form that can be imported
+ * into another organization or instance. The bundle has the canvas spec,
+ * the versions of the blueprints it uses, and the integrations and secrets
+ * it depends on. Secrets are exported by name only, never with their values.
+ */
+func ExportCanvasBundle(ctx context.Context, registry *registry.Registry, organizationID string, id string) (*pb.ExportCanvasBundleResponse, error) {
+	canvasID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid canvas id: %v", err)
+	}
+
+	orgID := uuid.MustParse(organizationID)
+	canvas, err := models.FindCanvas(orgID, canvasID)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "canvas not found: %v", err)
+	}
+
+	serialized, err := SerializeCanvas(canvas, false)
+	if err != nil {
+		log.Errorf("failed to serialize canvas %s: %v", canvas.ID.String(), err)
+		return nil, status.Error(codes.Internal, "failed to serialize canvas")
+	}
+
+	nodes := portableCanvasNodes(serialized.Spec.Nodes)
+	blueprints, err := exportBundleBlueprints(organizationID, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	allNodes := append([]*compb.Node{}, nodes...)
+	for _, blueprint := range blueprints {
+		allNodes = append(allNodes, blueprint.Nodes...)
+	}
+
+	return &pb.ExportCanvasBundleResponse{
+		Bundle: &pb.CanvasBundle{
+			FormatVersion: CanvasBundleFormatVersion,
+			Canvas: &pb.Canvas{
+				Metadata: &pb.Canvas_Metadata{
+					Name:        canvas.Name,
+					Description: canvas.Description,
+					Environment: canvas.Environment,
+				},
+				Spec: &pb.Canvas_Spec{
+					Nodes:        nodes,
+					Edges:        serialized.Spec.Edges,
+					Variables:    serialized.Spec.Variables,
+					Environments: serialized.Spec.Environments,
+				},
+			},
+			Blueprints:   blueprints,
+			Integrations: exportBundleIntegrations(orgID, allNodes),
+			Secrets:      exportBundleSecrets(registry, serialized.Spec, allNodes),
+		},
+	}, nil
+}
+
+// portableCanvasNodes returns the top-level nodes of a canvas without state
+// tied to the organization they run in. Blueprint internal nodes are left out,
+// since creating the canvas expands blueprint nodes again.
+func portableCanvasNodes(nodes []*compb.Node) []*compb.Node {
+	portable := []*compb.Node{}
+	for _, node := range nodes {
+		if strings.Contains(node.Id, ":") {
+			continue
+		}
+
+		portable = append(portable, portableNode(node))
+	}
+
+	return portable
+}
+
+// portableNode clears validation messages and the pause state of a node.
+// Metadata of nodes bound to an integration is also cleared, since it describes
+// resources of that integration and is recreated when the node is set up again.
+func portableNode(node *compb.Node) *compb.Node {
+	node.ErrorMessage = ""
+	node.WarningMessage = ""
+	node.Paused = false
+
+	if node.Blueprint != nil {
+		node.Blueprint.LatestVersion = 0
+	}
+
+	if node.Integration != nil && node.Integration.Id != "" {
+		node.Metadata = nil
+	}
+
+	return node
+}
+
+func exportBundleBlueprints(organizationID string, nodes []*compb.Node) ([]*blueprintpb.Blueprint, error) {
+	versions := map[string]int32{}
+	blueprints := []*blueprintpb.Blueprint{}
+
+	for _, node := range nodes {
+		if node.Blueprint == nil || node.Blueprint.Id == "" {
+			continue
+		}
+
+		ref := node.Blueprint
+		if version, ok := versions[ref.Id]; ok {
+			if version != ref.Version {
+				return nil, status.Errorf(
+					codes.FailedPrecondition,
+					"nodes use different versions of blueprint %s, upgrade them to the same version before exporting",
+					ref.Id,
+				)
+			}
+
+			continue
+		}
+
+		blueprint, err := models.FindBlueprintForRef(organizationID, &models.BlueprintRef{ID: ref.Id, Version: int(ref.Version)})
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "blueprint %s used by node %s not found: %v", ref.Id, node.Id, err)
+		}
+
+		versions[ref.Id] = ref.Version
+
+		serialized := blueprintactions.SerializeBlueprint(blueprint)
+		serialized.OrganizationId = ""
+		serialized.CreatedAt = nil
+		serialized.UpdatedAt = nil
+		serialized.CreatedBy = nil
+		serialized.HasDraft = false
+
+		for _, blueprintNode := range serialized.Nodes {
+			portableNode(blueprintNode)
+		}
+
+		blueprints = append(blueprints, serialized)
+	}
+
+	return blueprints, nil
+}
+
+func exportBundleIntegrations(orgID uuid.UUID, nodes []*compb.Node) []*pb.CanvasBundle_Integration {
+	integrations := []*pb.CanvasBundle_Integration{}
+	exported := map[string]bool{}
+
+	for _, node := range nodes {
+		if node.Integration == nil || node.Integration.Id == "" || exported[node.Integration.Id] {
+			continue
+		}
+
+		exported[node.Integration.Id] = true
+		integration := &pb.CanvasBundle_Integration{
+			Id:              node.Integration.Id,
+			Name:            node.Integration.Name,
+			IntegrationName: nodeIntegrationName(node),
+		}
+
+		if integrationID, err := uuid.Parse(node.Integration.Id); err == nil {
+			if found, err := models.FindIntegration(orgID, integrationID); err == nil {
+				integration.Name = found.InstallationName
+				integration.IntegrationName = found.AppName
+			}
+		}
+
+		integrations = append(integrations, integration)
+	}
+
+	return integrations
+}
+
+// nodeIntegrationName returns the integration a node belongs to,
+// from the prefix of its component or trigger name, e.g. "github" for "github.onPush".
+func nodeIntegrationName(node *compb.Node) string {
+	name := ""
+	switch {
+	case node.Component != nil:
+		name = node.Component.Name
+	case node.Trigger != nil:
+		name = node.Trigger.Name
+	}
+
+	parts := strings.SplitN(name, ".", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+
+	return parts[0]
+}
+
+func exportBundleSecrets(registry *registry.Registry, spec *pb.Canvas_Spec, nodes []*compb.Node) []*pb.CanvasBundle_Secret {
+	keys := map[string]map[string]bool{}
+	add := func(name, key string) {
+		if name == "" {
+			return
+		}
+
+		if keys[name] == nil {
+			keys[name] = map[string]bool{}
+		}
+
+		if key != "" {
+			keys[name][key] = true
+		}
+	}
+
+	variables := append([]*pb.CanvasVariable{}, spec.Variables...)
+	for _, environment := range spec.Environments {
+		variables = append(variables, environment.Variables...)
+	}
+
+	for _, variable := range variables {
+		if variable.Secret != nil {
+			add(variable.Secret.Name, variable.Secret.Key)
+		}
+	}
+
+	for _, node := range nodes {
+		for _, ref := range nodeSecretKeyRefs(registry, node) {
+			add(ref.Secret, ref.Key)
+		}
+	}
+
+	secrets := make([]*pb.CanvasBundle_Secret, 0, len(keys))
+	for name, set := range keys {
+		secret := &pb.CanvasBundle_Secret{Name: name, Keys: []string{}}
+		for key := range set {
+			secret.Keys = append(secret.Keys, key)
+		}
+
+		sort.Strings(secret.Keys)
+		secrets = append(secrets, secret)
+	}
+
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+
+	return secrets
+}
+
+func nodeSecretKeyRefs(registry *registry.Registry, node *compb.Node) []configuration.SecretKeyRef {
+	if node.Configuration == nil {
+		return nil
+	}
+
+	var fields []configuration.Field
+	switch {
+	case node.Component != nil:
+		component, err := registry.GetComponent(node.Component.Name)
+		if err != nil {
+			return nil
+		}
+		fields = component.Configuration()
+
+	case node.Trigger != nil:
+		trigger, err := registry.GetTrigger(node.Trigger.Name)
+		if err != nil {
+			return nil
+		}
+		fields = trigger.Configuration()
+
+	default:
+		return nil
+	}
+
+	return configuration.SecretKeyRefs(fields, node.Configuration.AsMap())
+}
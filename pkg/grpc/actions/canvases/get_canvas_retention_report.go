@@ -0,0 +1,100 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// GetCanvasRetentionReport counts what the retention worker would delete
+// from a canvas right now, using the same queries, without deleting anything.
+func GetCanvasRetentionReport(ctx context.Context, organizationID string, canvasID string) (*pb.GetCanvasRetentionReportResponse, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	tx := database.Conn()
+	policy, source, err := models.FindEffectiveRetentionPolicyInTransaction(tx, canvas.OrganizationID, canvas.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find retention policy")
+	}
+
+	response := &pb.GetCanvasRetentionReportResponse{
+		RetentionPolicy: SerializeRetentionPolicy(policy),
+		Source:          RetentionPolicySourceToProto(source),
+		Nodes:           []*pb.GetCanvasRetentionReportResponse_Node{},
+	}
+
+	if policy == nil || !policy.IsEnabled() {
+		return response, nil
+	}
+
+	var nodes []models.CanvasNode
+	err = tx.Unscoped().Where("workflow_id = ?", canvas.ID).Order("node_id ASC").Find(&nodes).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to find canvas nodes")
+	}
+
+	now := time.Now()
+	for _, node := range nodes {
+		report := &pb.GetCanvasRetentionReportResponse_Node{NodeId: node.NodeID}
+
+		report.Executions, err = countExpired(policy.ExpiredExecutionsQuery(tx, canvas.ID, node.NodeID, now))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to count expired executions")
+		}
+
+		report.Events, err = countExpired(policy.ExpiredEventsQuery(tx, canvas.ID, node.NodeID, now))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to count expired events")
+		}
+
+		report.QueueItems, err = countExpired(policy.ExpiredQueueItemsQuery(tx, canvas.ID, node.NodeID, now))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to count expired queue items")
+		}
+
+		if report.Executions == 0 && report.Events == 0 && report.QueueItems == 0 {
+			continue
+		}
+
+		response.Nodes = append(response.Nodes, report)
+		response.Executions += report.Executions
+		response.Events += report.Events
+		response.QueueItems += report.QueueItems
+	}
+
+	return response, nil
+}
+
+func countExpired(query *gorm.DB) (uint32, error) {
+	if query == nil {
+		return 0, nil
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return uint32(count), err
+}
@@ -0,0 +1,107 @@
+package canvases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetExecutionLogs returns the log lines of an execution, oldest first.
+// Clients follow a running execution by passing the last timestamp they received as `after`,
+// until the execution is finished.
+func GetExecutionLogs(ctx context.Context, workflowID, executionID uuid.UUID, limit uint32, after *timestamppb.Timestamp) (*pb.GetExecutionLogsResponse, error) {
+	execution, err := models.FindNodeExecution(workflowID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	limit = getLimit(limit)
+	afterTime := getBefore(after)
+
+	logs, err := models.ListNodeExecutionLogs(workflowID, executionID, int(limit), afterTime)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := models.CountNodeExecutionLogs(workflowID, executionID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := models.CountNodeExecutionLogs(workflowID, executionID, afterTime)
+	if err != nil {
+		return nil, err
+	}
+
+	serialized, err := SerializeNodeExecutionLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetExecutionLogsResponse{
+		Logs:           serialized,
+		TotalCount:     uint32(totalCount),
+		HasNextPage:    hasNextPage(len(logs), int(limit), remaining),
+		LastTimestamp:  getLastLogTimestamp(logs),
+		ExecutionState: NodeExecutionStateToProto(execution.State),
+	}, nil
+}
+
+func SerializeNodeExecutionLogs(logs []models.CanvasNodeExecutionLog) ([]*pb.CanvasNodeExecutionLog, error) {
+	result := make([]*pb.CanvasNodeExecutionLog, 0, len(logs))
+	for _, log := range logs {
+		serialized, err := SerializeNodeExecutionLog(&log)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, serialized)
+	}
+
+	return result, nil
+}
+
+func SerializeNodeExecutionLog(log *models.CanvasNodeExecutionLog) (*pb.CanvasNodeExecutionLog, error) {
+	fields, err := structpb.NewStruct(log.Fields.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CanvasNodeExecutionLog{
+		Id:          log.ID.String(),
+		ExecutionId: log.ExecutionID.String(),
+		NodeId:      log.NodeID,
+		Level:       NodeExecutionLogLevelToProto(log.Level),
+		Message:     log.Message,
+		Fields:      fields,
+		CreatedAt:   timestamppb.New(*log.CreatedAt),
+	}, nil
+}
+
+func NodeExecutionLogLevelToProto(level string) pb.CanvasNodeExecutionLog_Level {
+	switch level {
+	case models.CanvasNodeExecutionLogLevelDebug:
+		return pb.CanvasNodeExecutionLog_LEVEL_DEBUG
+	case models.CanvasNodeExecutionLogLevelInfo:
+		return pb.CanvasNodeExecutionLog_LEVEL_INFO
+	case models.CanvasNodeExecutionLogLevelWarn:
+		return pb.CanvasNodeExecutionLog_LEVEL_WARN
+	case models.CanvasNodeExecutionLogLevelError:
+		return pb.CanvasNodeExecutionLog_LEVEL_ERROR
+	default:
+		return pb.CanvasNodeExecutionLog_LEVEL_UNKNOWN
+	}
+}
+
+func getLastLogTimestamp(logs []models.CanvasNodeExecutionLog) *timestamppb.Timestamp {
+	if len(logs) > 0 {
+		return timestamppb.New(*logs[len(logs)-1].CreatedAt)
+	}
+	return nil
+}
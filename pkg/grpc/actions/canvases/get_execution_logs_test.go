@@ -0,0 +1,106 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__GetExecutionLogs(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+
+	t.Run("execution that does not exist -> error", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), canvas.ID, uuid.New(), 0, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("execution without logs -> empty list", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), canvas.ID, execution.ID, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, response.Logs)
+		assert.Equal(t, uint32(0), response.TotalCount)
+		assert.False(t, response.HasNextPage)
+		assert.Nil(t, response.LastTimestamp)
+		assert.Equal(t, pb.CanvasNodeExecution_STATE_PENDING, response.ExecutionState)
+	})
+
+	_, err := models.CreateNodeExecutionLogInTransaction(database.Conn(), execution, models.CanvasNodeExecutionLogLevelInfo, "first", map[string]any{"step": 1})
+	require.NoError(t, err)
+	_, err = models.CreateNodeExecutionLogInTransaction(database.Conn(), execution, models.CanvasNodeExecutionLogLevelWarn, "second", nil)
+	require.NoError(t, err)
+	_, err = models.CreateNodeExecutionLogInTransaction(database.Conn(), execution, models.CanvasNodeExecutionLogLevelError, "third", nil)
+	require.NoError(t, err)
+
+	t.Run("logs are returned oldest first", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), canvas.ID, execution.ID, 0, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 3)
+		assert.Equal(t, uint32(3), response.TotalCount)
+		assert.False(t, response.HasNextPage)
+
+		assert.Equal(t, "first", response.Logs[0].Message)
+		assert.Equal(t, pb.CanvasNodeExecutionLog_LEVEL_INFO, response.Logs[0].Level)
+		assert.Equal(t, float64(1), response.Logs[0].Fields.AsMap()["step"])
+		assert.Equal(t, componentNode, response.Logs[0].NodeId)
+		assert.Equal(t, execution.ID.String(), response.Logs[0].ExecutionId)
+		assert.Equal(t, pb.CanvasNodeExecutionLog_LEVEL_WARN, response.Logs[1].Level)
+		assert.Equal(t, pb.CanvasNodeExecutionLog_LEVEL_ERROR, response.Logs[2].Level)
+	})
+
+	t.Run("pages are followed with the last timestamp", func(t *testing.T) {
+		response, err := GetExecutionLogs(context.Background(), canvas.ID, execution.ID, 2, nil)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 2)
+		assert.True(t, response.HasNextPage)
+		require.NotNil(t, response.LastTimestamp)
+
+		response, err = GetExecutionLogs(context.Background(), canvas.ID, execution.ID, 2, response.LastTimestamp)
+		require.NoError(t, err)
+		require.Len(t, response.Logs, 1)
+		assert.Equal(t, "third", response.Logs[0].Message)
+		assert.Equal(t, uint32(3), response.TotalCount)
+		assert.False(t, response.HasNextPage)
+	})
+
+	t.Run("logs from other canvases are not returned", func(t *testing.T) {
+		_, err := GetExecutionLogs(context.Background(), uuid.New(), execution.ID, 0, nil)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,365 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions"
+	blueprintactions "github.com/superplanehq/superplane/pkg/grpc/actions/blueprints"
+	"github.com/superplanehq/superplane/pkg/models"
+	blueprintpb "github.com/superplanehq/superplane/pkg/protos/blueprints"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	compb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+/*
+ * ImportCanvasBundle creates a canvas from a bundle exported by ExportCanvasBundle.
+ *
+ * Integrations in the bundle are mapped to integrations of the organization,
+ * using the mappings given in the request first, and then the same ID,
+ * the same name, or the only integration of the same type.
+ * Secrets must exist with the same name. Blueprints are created,
+ * unless a blueprint with the same name and content already exists.
+ *
+ * Nothing is created if a dependency cannot be resolved;
+ * the unresolved dependencies are returned instead.
+ * Creating blueprints requires the blueprints:create permission,
+ * and the blueprints are created in the same transaction as the canvas.
+ */
+func ImportCanvasBundle(
+	ctx context.Context,
+	authService authorization.Authorization,
+	registry *registry.Registry,
+	organizationID string,
+	req *pb.ImportCanvasBundleRequest,
+) (*pb.ImportCanvasBundleResponse, error) {
+	bundle := req.Bundle
+	if bundle == nil || bundle.Canvas == nil || bundle.Canvas.Metadata == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundle with a canvas is required")
+	}
+
+	if bundle.FormatVersion > CanvasBundleFormatVersion {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"bundle format version %d is not supported, the latest supported version is %d",
+			bundle.FormatVersion,
+			CanvasBundleFormatVersion,
+		)
+	}
+
+	orgID := uuid.MustParse(organizationID)
+	integrations, err := models.ListIntegrations(orgID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list integrations: %v", err)
+	}
+
+	response := &pb.ImportCanvasBundleResponse{
+		Unresolved:     []*pb.CanvasBundleDependency{},
+		IntegrationIds: map[string]string{},
+		BlueprintIds:   map[string]string{},
+	}
+
+	for _, integration := range bundleIntegrations(bundle) {
+		targetID, dependency := resolveBundleIntegration(integration, req.IntegrationIds[integration.Id], integrations)
+		if dependency != nil {
+			response.Unresolved = append(response.Unresolved, dependency)
+			continue
+		}
+
+		response.IntegrationIds[integration.Id] = targetID
+	}
+
+	for _, secret := range bundle.Secrets {
+		_, err := models.FindSecretByName(models.DomainTypeOrganization, orgID, secret.Name)
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Internal, "failed to find secret %s: %v", secret.Name, err)
+		}
+
+		response.Unresolved = append(response.Unresolved, &pb.CanvasBundleDependency{
+			Type:    pb.CanvasBundleDependency_TYPE_SECRET,
+			Id:      secret.Name,
+			Name:    secret.Name,
+			Message: fmt.Sprintf("secret %s not found", secret.Name),
+		})
+	}
+
+	blueprints := []*blueprintpb.Blueprint{}
+	blueprintVersions := map[string]int32{}
+	for _, bundleBlueprint := range bundle.Blueprints {
+		blueprint := proto.Clone(bundleBlueprint).(*blueprintpb.Blueprint)
+		remapNodeIntegrations(blueprint.Nodes, response.IntegrationIds)
+
+		existing, err := models.FindBlueprintByName(blueprint.Name, orgID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.Internal, "failed to find blueprint %s: %v", blueprint.Name, err)
+			}
+
+			blueprints = append(blueprints, blueprint)
+			continue
+		}
+
+		same, err := sameBlueprintContent(existing, blueprint)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid blueprint %s: %v", blueprint.Name, err)
+		}
+
+		if !same {
+			response.Unresolved = append(response.Unresolved, &pb.CanvasBundleDependency{
+				Type:    pb.CanvasBundleDependency_TYPE_BLUEPRINT,
+				Id:      bundleBlueprint.Id,
+				Name:    blueprint.Name,
+				Message: fmt.Sprintf("a different blueprint named %s already exists", blueprint.Name),
+			})
+			continue
+		}
+
+		response.BlueprintIds[bundleBlueprint.Id] = existing.ID.String()
+		blueprintVersions[bundleBlueprint.Id] = int32(existing.Version)
+	}
+
+	if len(response.Unresolved) > 0 || req.DryRun {
+		return response, nil
+	}
+
+	if len(blueprints) > 0 {
+		if err := checkCanCreateBlueprints(ctx, authService, organizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	var canvas *models.Canvas
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		for _, blueprint := range blueprints {
+			bundleID := blueprint.Id
+			blueprint.Id = ""
+			blueprint.Version = 0
+
+			created, err := blueprintactions.CreateBlueprintInTransaction(ctx, tx, registry, organizationID, blueprint)
+			if err != nil {
+				return err
+			}
+
+			response.BlueprintIds[bundleID] = created.ID.String()
+			blueprintVersions[bundleID] = int32(created.Version)
+		}
+
+		pbCanvas := proto.Clone(bundle.Canvas).(*pb.Canvas)
+		if req.Name != "" {
+			pbCanvas.Metadata.Name = req.Name
+		}
+
+		if pbCanvas.Spec != nil {
+			remapNodeIntegrations(pbCanvas.Spec.Nodes, response.IntegrationIds)
+			for _, node := range pbCanvas.Spec.Nodes {
+				if node.Blueprint == nil {
+					continue
+				}
+
+				if id, ok := response.BlueprintIds[node.Blueprint.Id]; ok {
+					node.Blueprint.Version = blueprintVersions[node.Blueprint.Id]
+					node.Blueprint.Id = id
+				}
+			}
+		}
+
+		var err error
+		canvas, err = CreateCanvasInTransaction(ctx, tx, registry, organizationID, pbCanvas)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response.Canvas, err = SerializeCanvas(canvas, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// checkCanCreateBlueprints checks the permission the import needs
+// on top of canvases:create, which is checked by the interceptor.
+func checkCanCreateBlueprints(ctx context.Context, authService authorization.Authorization, organizationID string) error {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	allowed, err := authService.CheckOrganizationPermission(userID, organizationID, "blueprints", "create")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permissions: %v", err)
+	}
+
+	if !allowed {
+		return status.Error(codes.PermissionDenied, "importing the bundle creates blueprints, which requires the blueprints:create permission")
+	}
+
+	return nil
+}
+
+// bundleIntegrations returns the integrations listed in a bundle, and the ones
+// its nodes use without being listed, so that they are reported if not mapped.
+func bundleIntegrations(bundle *pb.CanvasBundle) []*pb.CanvasBundle_Integration {
+	integrations := append([]*pb.CanvasBundle_Integration{}, bundle.Integrations...)
+	listed := map[string]bool{}
+	for _, integration := range bundle.Integrations {
+		listed[integration.Id] = true
+	}
+
+	nodes := []*compb.Node{}
+	if bundle.Canvas.Spec != nil {
+		nodes = append(nodes, bundle.Canvas.Spec.Nodes...)
+	}
+
+	for _, blueprint := range bundle.Blueprints {
+		nodes = append(nodes, blueprint.Nodes...)
+	}
+
+	for _, node := range nodes {
+		if node.Integration == nil || node.Integration.Id == "" || listed[node.Integration.Id] {
+			continue
+		}
+
+		listed[node.Integration.Id] = true
+		integrations = append(integrations, &pb.CanvasBundle_Integration{
+			Id:              node.Integration.Id,
+			Name:            node.Integration.Name,
+			IntegrationName: nodeIntegrationName(node),
+		})
+	}
+
+	return integrations
+}
+
+func resolveBundleIntegration(integration *pb.CanvasBundle_Integration, mappedID string, integrations []models.Integration) (string, *pb.CanvasBundleDependency) {
+	unresolved := func(message string, args ...any) (string, *pb.CanvasBundleDependency) {
+		return "", &pb.CanvasBundleDependency{
+			Type:    pb.CanvasBundleDependency_TYPE_INTEGRATION,
+			Id:      integration.Id,
+			Name:    integration.Name,
+			Message: fmt.Sprintf(message, args...),
+		}
+	}
+
+	sameType := func(candidate models.Integration) bool {
+		return integration.IntegrationName == "" || candidate.AppName == integration.IntegrationName
+	}
+
+	if mappedID != "" {
+		for _, candidate := range integrations {
+			if candidate.ID.String() != mappedID {
+				continue
+			}
+
+			if !sameType(candidate) {
+				return unresolved("integration %s is a %s integration, not %s", mappedID, candidate.AppName, integration.IntegrationName)
+			}
+
+			return mappedID, nil
+		}
+
+		return unresolved("integration %s not found", mappedID)
+	}
+
+	for _, candidate := range integrations {
+		if candidate.ID.String() == integration.Id && sameType(candidate) {
+			return integration.Id, nil
+		}
+	}
+
+	for _, candidate := range integrations {
+		if candidate.InstallationName == integration.Name && sameType(candidate) {
+			return candidate.ID.String(), nil
+		}
+	}
+
+	matches := []models.Integration{}
+	for _, candidate := range integrations {
+		if integration.IntegrationName != "" && candidate.AppName == integration.IntegrationName {
+			matches = append(matches, candidate)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID.String(), nil
+	case 0:
+		return unresolved("no %s integration found", integration.IntegrationName)
+	default:
+		return unresolved("%d %s integrations found, choose one to use", len(matches), integration.IntegrationName)
+	}
+}
+
+func remapNodeIntegrations(nodes []*compb.Node, integrationIDs map[string]string) {
+	for _, node := range nodes {
+		if node.Integration == nil {
+			continue
+		}
+
+		if id, ok := integrationIDs[node.Integration.Id]; ok {
+			node.Integration = &compb.IntegrationRef{Id: id}
+		}
+	}
+}
+
+// sameBlueprintContent tells if a bundle blueprint can reuse a blueprint
+// of the organization. Positions and metadata are ignored,
+// since they do not change how the blueprint runs.
+func sameBlueprintContent(existing *models.Blueprint, blueprint *blueprintpb.Blueprint) (bool, error) {
+	configuration, err := blueprintactions.ProtoToConfiguration(blueprint.Configuration)
+	if err != nil {
+		return false, err
+	}
+
+	outputChannels := []models.BlueprintOutputChannel{}
+	for _, channel := range blueprint.OutputChannels {
+		outputChannels = append(outputChannels, models.BlueprintOutputChannel{
+			Name:              channel.Name,
+			NodeID:            channel.NodeId,
+			NodeOutputChannel: channel.NodeOutputChannel,
+		})
+	}
+
+	from := existing.Content()
+	to := models.BlueprintContent{
+		Nodes:          actions.ProtoToNodes(blueprint.Nodes),
+		Edges:          actions.ProtoToEdges(blueprint.Edges),
+		Configuration:  configuration,
+		OutputChannels: outputChannels,
+	}
+
+	//
+	// Nodes of the organization blueprint go through the same
+	// conversion as the bundle ones, so that defaults are filled in the same way.
+	//
+	from.Nodes = actions.ProtoToNodes(actions.NodesToProto(from.Nodes))
+
+	diff := blueprintactions.DiffBlueprintContent(from, to)
+	return len(diff.AddedNodeIds) == 0 &&
+		len(diff.RemovedNodeIds) == 0 &&
+		len(diff.ChangedNodeIds) == 0 &&
+		!diff.EdgesChanged &&
+		len(diff.AddedConfigurationFields) == 0 &&
+		len(diff.RemovedConfigurationFields) == 0 &&
+		len(diff.ChangedConfigurationFields) == 0 &&
+		len(diff.AddedOutputChannels) == 0 &&
+		len(diff.RemovedOutputChannels) == 0 &&
+		len(diff.ChangedOutputChannels) == 0, nil
+}
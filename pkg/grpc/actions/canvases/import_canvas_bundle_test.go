@@ -0,0 +1,199 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	componentpb "github.com/superplanehq/superplane/pkg/protos/components"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestExportAndImportCanvasBundle(t *testing.T) {
+	r := support.Setup(t)
+	ctx := authentication.SetUserIdInMetadata(context.Background(), r.User.String())
+	orgID := r.Organization.ID.String()
+
+	blueprint := support.CreateBlueprint(t, r.Organization.ID, []models.Node{
+		{
+			ID:   "first",
+			Name: "first",
+			Type: models.NodeTypeComponent,
+			Ref:  models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+		},
+	}, []models.Edge{}, []models.BlueprintOutputChannel{
+		{Name: "default", NodeID: "first", NodeOutputChannel: "default"},
+	})
+
+	created, err := CreateCanvas(ctx, r.Registry, orgID, &pb.Canvas{
+		Metadata: &pb.Canvas_Metadata{Name: "Release", Description: "Releases the app"},
+		Spec: &pb.Canvas_Spec{
+			Nodes: []*componentpb.Node{
+				{
+					Id:        "deploy",
+					Name:      "deploy",
+					Type:      componentpb.Node_TYPE_BLUEPRINT,
+					Blueprint: &componentpb.Node_BlueprintRef{Id: blueprint.ID.String()},
+				},
+				{
+					Id:        "notify",
+					Name:      "notify",
+					Type:      componentpb.Node_TYPE_COMPONENT,
+					Component: &componentpb.Node_ComponentRef{Name: "noop"},
+				},
+			},
+			Edges: []*componentpb.Edge{{SourceId: "deploy", TargetId: "notify", Channel: "default"}},
+			Variables: []*pb.CanvasVariable{
+				{Name: "TOKEN", Secret: &pb.CanvasVariable_SecretRef{Name: "deploy", Key: "token"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	exported, err := ExportCanvasBundle(ctx, r.Registry, orgID, created.Canvas.Metadata.Id)
+	require.NoError(t, err)
+
+	bundle := exported.Bundle
+	require.Equal(t, int32(CanvasBundleFormatVersion), bundle.FormatVersion)
+	assert.Empty(t, bundle.Canvas.Metadata.Id)
+	assert.Equal(t, "Release", bundle.Canvas.Metadata.Name)
+	assert.Len(t, bundle.Canvas.Spec.Nodes, 2)
+	require.Len(t, bundle.Blueprints, 1)
+	assert.Equal(t, blueprint.ID.String(), bundle.Blueprints[0].Id)
+	assert.Empty(t, bundle.Blueprints[0].OrganizationId)
+	require.Len(t, bundle.Secrets, 1)
+	assert.Equal(t, "deploy", bundle.Secrets[0].Name)
+	assert.Equal(t, []string{"token"}, bundle.Secrets[0].Keys)
+
+	//
+	// The exported canvas does not use integrations,
+	// so one is added to check how they are resolved.
+	//
+	bundle.Integrations = []*pb.CanvasBundle_Integration{
+		{Id: uuid.NewString(), Name: "GitHub", IntegrationName: "github"},
+	}
+
+	other := support.CreateOrganization(t, r, r.User)
+	otherID := other.ID.String()
+
+	t.Run("unresolved dependencies are reported", func(t *testing.T) {
+		response, err := ImportCanvasBundle(ctx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{Bundle: bundle})
+		require.NoError(t, err)
+		assert.Nil(t, response.Canvas)
+		require.Len(t, response.Unresolved, 2)
+		assert.Equal(t, pb.CanvasBundleDependency_TYPE_INTEGRATION, response.Unresolved[0].Type)
+		assert.Equal(t, "no github integration found", response.Unresolved[0].Message)
+		assert.Equal(t, pb.CanvasBundleDependency_TYPE_SECRET, response.Unresolved[1].Type)
+		assert.Equal(t, "deploy", response.Unresolved[1].Name)
+	})
+
+	first, err := models.CreateIntegration(uuid.New(), other.ID, "github", "Personal", map[string]any{})
+	require.NoError(t, err)
+	second, err := models.CreateIntegration(uuid.New(), other.ID, "github", "Company", map[string]any{})
+	require.NoError(t, err)
+	_, err = models.CreateSecret("deploy", secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, other.ID, []byte(`{"token":"x"}`))
+	require.NoError(t, err)
+
+	t.Run("several integrations of the same type need a mapping", func(t *testing.T) {
+		response, err := ImportCanvasBundle(ctx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{Bundle: bundle, DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, response.Unresolved, 1)
+		assert.Equal(t, "2 github integrations found, choose one to use", response.Unresolved[0].Message)
+
+		response, err = ImportCanvasBundle(ctx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{
+			Bundle:         bundle,
+			DryRun:         true,
+			IntegrationIds: map[string]string{bundle.Integrations[0].Id: second.ID.String()},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, response.Unresolved)
+		assert.Nil(t, response.Canvas)
+		assert.Equal(t, second.ID.String(), response.IntegrationIds[bundle.Integrations[0].Id])
+	})
+
+	t.Run("creating blueprints requires the blueprints:create permission", func(t *testing.T) {
+		viewer := support.CreateUser(t, r, other.ID)
+		viewerCtx := authentication.SetUserIdInMetadata(context.Background(), viewer.ID.String())
+
+		_, err := ImportCanvasBundle(viewerCtx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{
+			Bundle:         bundle,
+			IntegrationIds: map[string]string{bundle.Integrations[0].Id: first.ID.String()},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = models.FindBlueprintByName(blueprint.Name, other.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("blueprints are not created if the canvas cannot be created", func(t *testing.T) {
+		_, err := CreateCanvas(ctx, r.Registry, otherID, &pb.Canvas{
+			Metadata: &pb.Canvas_Metadata{Name: "Taken"},
+			Spec:     &pb.Canvas_Spec{},
+		})
+		require.NoError(t, err)
+
+		_, err = ImportCanvasBundle(ctx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{
+			Bundle:         bundle,
+			Name:           "Taken",
+			IntegrationIds: map[string]string{bundle.Integrations[0].Id: first.ID.String()},
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = models.FindBlueprintByName(blueprint.Name, other.ID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("import creates the canvas and its blueprints", func(t *testing.T) {
+		response, err := ImportCanvasBundle(ctx, r.AuthService, r.Registry, otherID, &pb.ImportCanvasBundleRequest{
+			Bundle:         bundle,
+			Name:           "Imported release",
+			IntegrationIds: map[string]string{bundle.Integrations[0].Id: first.ID.String()},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, response.Unresolved)
+		require.NotNil(t, response.Canvas)
+		assert.Equal(t, "Imported release", response.Canvas.Metadata.Name)
+		assert.Equal(t, otherID, response.Canvas.Metadata.OrganizationId)
+
+		blueprintID := response.BlueprintIds[blueprint.ID.String()]
+		require.NotEmpty(t, blueprintID)
+		assert.NotEqual(t, blueprint.ID.String(), blueprintID)
+
+		imported, err := models.FindBlueprint(otherID, blueprintID)
+		require.NoError(t, err)
+		assert.Equal(t, blueprint.Name, imported.Name)
+
+		for _, node := range response.Canvas.Spec.Nodes {
+			if node.Id == "deploy" {
+				assert.Equal(t, blueprintID, node.Blueprint.Id)
+				assert.Equal(t, int32(1), node.Blueprint.Version)
+			}
+		}
+	})
+
+	t.Run("blueprints with the same name and content are reused", func(t *testing.T) {
+		bundle.Integrations = nil
+		bundle.Secrets = nil
+
+		response, err := ImportCanvasBundle(ctx, r.AuthService, r.Registry, orgID, &pb.ImportCanvasBundleRequest{Bundle: bundle, Name: "Release copy"})
+		require.NoError(t, err)
+		assert.Empty(t, response.Unresolved)
+		require.NotNil(t, response.Canvas)
+		assert.Equal(t, blueprint.ID.String(), response.BlueprintIds[blueprint.ID.String()])
+	})
+
+	t.Run("unsupported format version -> error", func(t *testing.T) {
+		bundle.FormatVersion = CanvasBundleFormatVersion + 1
+		_, err := ImportCanvasBundle(ctx, r.AuthService, r.Registry, orgID, &pb.ImportCanvasBundleRequest{Bundle: bundle})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/audit"
 	"github.com/superplanehq/superplane/pkg/authentication"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/configuration"
@@ -96,6 +97,7 @@ func InvokeNodeExecutionAction(
 		Auth:           contexts.NewAuthContext(tx, orgID, authService, user),
 		Requests:       contexts.NewExecutionRequestContext(tx, execution),
 		Notifications:  contexts.NewNotificationContext(tx, orgID, canvas.ID),
+		Logs:           contexts.NewExecutionLogContext(execution),
 	}
 
 	if node.AppInstallationID != nil {
@@ -110,11 +112,37 @@ func InvokeNodeExecutionAction(
 	}
 
 	actionCtx.Logger = logger
+	auditEvent := audit.Event{
+		OrganizationID: orgID,
+		ActorID:        user.ID.String(),
+		ResourceType:   audit.ResourceExecutions,
+		ResourceID:     execution.ID.String(),
+		Action:         actionName,
+		Method:         pb.Canvases_InvokeNodeExecutionAction_FullMethodName,
+	}
+
+	before := audit.ExecutionSnapshot(execution)
 	err = component.HandleAction(actionCtx)
 	if err != nil {
+		auditEvent.Status = codes.InvalidArgument.String()
+		auditEvent.Error = err.Error()
+		auditEvent.After = map[string]any{"parameters": audit.Redact(parameters)}
+		audit.Record(ctx, auditEvent)
 		return nil, status.Errorf(codes.InvalidArgument, "action execution failed: %v", err)
 	}
 
+	//
+	// The parameters are recorded with the changes to the execution,
+	// since they hold things like the comment left on an approval.
+	//
+	auditEvent.After = map[string]any{}
+	if updated, err := models.FindNodeExecution(canvas.ID, execution.ID); err == nil {
+		auditEvent.Before, auditEvent.After = audit.Diff(before, audit.ExecutionSnapshot(updated))
+	}
+
+	auditEvent.After["parameters"] = audit.Redact(parameters)
+	audit.Record(ctx, auditEvent)
+
 	messages.NewCanvasExecutionMessage(
 		execution.WorkflowID.String(),
 		execution.ID.String(),
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
@@ -45,11 +46,17 @@ func ListCanvasMemories(ctx context.Context, registry *registry.Registry, organi
 			return nil, status.Error(codes.Internal, "failed to serialize canvas memory")
 		}
 
-		items = append(items, &pb.CanvasMemory{
+		item := &pb.CanvasMemory{
 			Id:        record.ID.String(),
 			Namespace: record.Namespace,
 			Values:    values,
-		})
+		}
+
+		if record.ExpiresAt != nil {
+			item.ExpiresAt = timestamppb.New(*record.ExpiresAt)
+		}
+
+		items = append(items, item)
 	}
 
 	return &pb.ListCanvasMemoriesResponse{
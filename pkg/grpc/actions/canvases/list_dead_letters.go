@@ -0,0 +1,127 @@
+package canvases
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ListDeadLetters(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, limit uint32, before *timestamppb.Timestamp) (*pb.ListDeadLettersResponse, error) {
+	limit = getLimit(limit)
+	beforeTime := getBefore(before)
+
+	events, err := models.ListDeadLetterCanvasEvents(canvasID, int(limit), beforeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	queueItems, err := models.ListDeadLetterQueueItems(canvasID, int(limit), beforeTime)
+	if err != nil {
+		return nil, err
+	}
+
+	eventCount, err := models.CountDeadLetterCanvasEvents(canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	queueItemCount, err := models.CountDeadLetterQueueItems(canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetters, err := SerializeDeadLetters(events, queueItems)
+	if err != nil {
+		return nil, err
+	}
+
+	//
+	// Dead-lettered events and queue items are listed separately,
+	// so we need to merge them, keeping only the most recent ones.
+	//
+	slices.SortFunc(deadLetters, func(a, b *pb.DeadLetter) int {
+		return b.CreatedAt.AsTime().Compare(a.CreatedAt.AsTime())
+	})
+
+	if len(deadLetters) > int(limit) {
+		deadLetters = deadLetters[:limit]
+	}
+
+	count := eventCount + queueItemCount
+	return &pb.ListDeadLettersResponse{
+		DeadLetters:   deadLetters,
+		TotalCount:    uint32(count),
+		HasNextPage:   hasNextPage(len(deadLetters), int(limit), count),
+		LastTimestamp: getLastDeadLetterTimestamp(deadLetters),
+	}, nil
+}
+
+func SerializeDeadLetters(events []models.CanvasEvent, queueItems []models.CanvasNodeQueueItem) ([]*pb.DeadLetter, error) {
+	result := make([]*pb.DeadLetter, 0, len(events)+len(queueItems))
+
+	for _, event := range events {
+		serializedEvent, err := SerializeCanvasEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &pb.DeadLetter{
+			Id:            event.ID.String(),
+			Type:          pb.DeadLetter_TYPE_EVENT,
+			CanvasId:      event.WorkflowID.String(),
+			NodeId:        event.NodeID,
+			Event:         serializedEvent,
+			Attempts:      int32(event.Attempts),
+			FailureReason: valueOrEmpty(event.FailureReason),
+			CreatedAt:     timestamppb.New(*event.CreatedAt),
+		})
+	}
+
+	if len(queueItems) == 0 {
+		return result, nil
+	}
+
+	inputEvents, err := models.FindCanvasEvents(eventIDsFromQueueItems(queueItems))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, queueItem := range queueItems {
+		deadLetter := &pb.DeadLetter{
+			Id:            queueItem.ID.String(),
+			Type:          pb.DeadLetter_TYPE_QUEUE_ITEM,
+			CanvasId:      queueItem.WorkflowID.String(),
+			NodeId:        queueItem.NodeID,
+			Attempts:      int32(queueItem.Attempts),
+			FailureReason: valueOrEmpty(queueItem.FailureReason),
+			CreatedAt:     timestamppb.New(*queueItem.CreatedAt),
+		}
+
+		for _, event := range inputEvents {
+			if event.ID != queueItem.EventID {
+				continue
+			}
+
+			deadLetter.Event, err = SerializeCanvasEvent(event)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, deadLetter)
+	}
+
+	return result, nil
+}
+
+func getLastDeadLetterTimestamp(deadLetters []*pb.DeadLetter) *timestamppb.Timestamp {
+	if len(deadLetters) > 0 {
+		return deadLetters[len(deadLetters)-1].CreatedAt
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__ListDeadLetters(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		nil,
+	)
+
+	t.Run("no dead letters -> empty list", func(t *testing.T) {
+		response, err := ListDeadLetters(context.Background(), r.Registry, canvas.ID, 0, nil)
+		require.NoError(t, err)
+		assert.Empty(t, response.DeadLetters)
+		assert.Equal(t, uint32(0), response.TotalCount)
+	})
+
+	t.Run("dead-lettered events and queue items are listed", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		require.NoError(t, database.Conn().Model(event).Updates(map[string]any{
+			"state":          models.CanvasEventStateDeadLetter,
+			"attempts":       models.MaxProcessingAttempts,
+			"failure_reason": "record not found",
+		}).Error)
+
+		inputEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		queueItem := support.CreateQueueItem(t, canvas.ID, componentNode, inputEvent.ID, inputEvent.ID)
+		require.NoError(t, database.Conn().Model(queueItem).Updates(map[string]any{
+			"state":          models.CanvasNodeQueueItemStateDeadLetter,
+			"attempts":       models.MaxProcessingAttempts,
+			"failure_reason": "component not found",
+		}).Error)
+
+		response, err := ListDeadLetters(context.Background(), r.Registry, canvas.ID, 0, nil)
+		require.NoError(t, err)
+		require.Len(t, response.DeadLetters, 2)
+		assert.Equal(t, uint32(2), response.TotalCount)
+		assert.False(t, response.HasNextPage)
+
+		//
+		// Most recent dead letters come first.
+		//
+		assert.Equal(t, queueItem.ID.String(), response.DeadLetters[0].Id)
+		assert.Equal(t, pb.DeadLetter_TYPE_QUEUE_ITEM, response.DeadLetters[0].Type)
+		assert.Equal(t, componentNode, response.DeadLetters[0].NodeId)
+		assert.Equal(t, inputEvent.ID.String(), response.DeadLetters[0].Event.Id)
+		assert.Equal(t, "component not found", response.DeadLetters[0].FailureReason)
+
+		assert.Equal(t, event.ID.String(), response.DeadLetters[1].Id)
+		assert.Equal(t, pb.DeadLetter_TYPE_EVENT, response.DeadLetters[1].Type)
+		assert.Equal(t, triggerNode, response.DeadLetters[1].NodeId)
+		assert.Equal(t, int32(models.MaxProcessingAttempts), response.DeadLetters[1].Attempts)
+		assert.Equal(t, "record not found", response.DeadLetters[1].FailureReason)
+	})
+}
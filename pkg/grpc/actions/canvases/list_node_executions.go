@@ -200,6 +200,13 @@ func SerializeNodeExecutions(executions []models.CanvasNodeExecution, childExecu
 			Outputs:             outputs,
 			RootEvent:           rootEvent,
 			CancelledBy:         cancelledByRef(execution.CancelledBy, cancelledByUsersByID),
+			Attempt:             int32(max(execution.Attempt, 1)),
+			RetryOfExecutionId:  execution.GetRetryOfExecutionID(),
+			RerunOfExecutionId:  execution.GetRerunOfExecutionID(),
+		}
+
+		if execution.RunAt != nil {
+			pbExecution.RunAt = timestamppb.New(*execution.RunAt)
 		}
 
 		if len(childExecutions) == 0 {
@@ -323,6 +330,8 @@ func NodeExecutionResultReasonToProto(reason string) pb.CanvasNodeExecution_Resu
 		return pb.CanvasNodeExecution_RESULT_REASON_ERROR
 	case models.CanvasNodeExecutionResultReasonErrorResolved:
 		return pb.CanvasNodeExecution_RESULT_REASON_ERROR_RESOLVED
+	case models.CanvasNodeExecutionResultReasonTimedOut:
+		return pb.CanvasNodeExecution_RESULT_REASON_TIMED_OUT
 	default:
 		return pb.CanvasNodeExecution_RESULT_REASON_OK
 	}
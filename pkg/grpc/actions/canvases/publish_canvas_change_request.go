@@ -131,6 +131,11 @@ func PublishCanvasChangeRequest(
 			request.ChangedNodeIDs,
 		)
 
+		mergedVariables, variablesErr := resolveCanvasChangeRequestVariablesInTransaction(tx, canvasForUpdate, request, version)
+		if variablesErr != nil {
+			return variablesErr
+		}
+
 		existingNodesUnscoped, findNodesErr := models.FindCanvasNodesUnscopedInTransaction(tx, canvasUUID)
 		if findNodesErr != nil {
 			return findNodesErr
@@ -211,6 +216,7 @@ func PublishCanvasChangeRequest(
 			request.OwnerID,
 			mergedNodes,
 			mergedEdges,
+			mergedVariables,
 		)
 		if err != nil {
 			return err
@@ -236,6 +242,7 @@ func PublishCanvasChangeRequest(
 				*request.OwnerID,
 				liveVersion.Nodes,
 				liveVersion.Edges,
+				liveVersion.VariableSet(),
 			)
 			if err != nil {
 				return err
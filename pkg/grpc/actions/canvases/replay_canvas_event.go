@@ -0,0 +1,127 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ReplayCanvasEvent(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, eventID uuid.UUID, nodeID string) (*pb.ReplayCanvasEventResponse, error) {
+	original, err := models.FindCanvasEventForCanvas(canvasID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "event not found")
+		}
+
+		return nil, err
+	}
+
+	if original.ExecutionID != nil {
+		return nil, status.Error(codes.InvalidArgument, "only root events can be replayed")
+	}
+
+	var replay *models.CanvasEvent
+	var queueItem *models.CanvasNodeQueueItem
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		replay, err = original.CreateReplayInTransaction(tx)
+		if err != nil {
+			return err
+		}
+
+		//
+		// Without a starting node, the replayed event
+		// is routed just like a new root event would be.
+		//
+		if nodeID == "" {
+			return nil
+		}
+
+		queueItem, err = enqueueReplayForNode(tx, replay, original, nodeID)
+		if err != nil {
+			return err
+		}
+
+		return replay.RoutedInTransaction(tx)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if queueItem != nil {
+		err = messages.NewCanvasQueueItemMessage(
+			queueItem.WorkflowID.String(),
+			queueItem.ID.String(),
+			queueItem.NodeID,
+		).Publish(false)
+	} else {
+		err = messages.NewCanvasEventCreatedMessage(canvasID.String(), replay).Publish()
+	}
+
+	if err != nil {
+		log.Errorf("failed to publish replay of event %s: %v", original.ID, err)
+	}
+
+	serialized, err := SerializeCanvasEvent(*replay)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReplayCanvasEventResponse{Event: serialized}, nil
+}
+
+// enqueueReplayForNode puts the replayed event directly in the queue of the starting node,
+// using the same input the node received when the original event was processed.
+func enqueueReplayForNode(tx *gorm.DB, replay, original *models.CanvasEvent, nodeID string) (*models.CanvasNodeQueueItem, error) {
+	node, err := models.FindCanvasNode(tx, replay.WorkflowID, nodeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "node not found")
+		}
+
+		return nil, err
+	}
+
+	if node.Type != models.NodeTypeComponent && node.Type != models.NodeTypeBlueprint {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot replay event from %s node", node.Type)
+	}
+
+	execution, err := models.FindLastNodeExecutionForRootEventInTransaction(tx, original.WorkflowID, original.ID, nodeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "node %s was not executed for event %s", nodeID, original.ID)
+		}
+
+		return nil, err
+	}
+
+	queueItem := models.CanvasNodeQueueItem{
+		WorkflowID:  replay.WorkflowID,
+		NodeID:      node.NodeID,
+		RootEventID: replay.ID,
+		EventID:     execution.EventID,
+		CreatedAt:   replay.CreatedAt,
+	}
+
+	enqueued, err := node.EnqueueInTransaction(tx, &queueItem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue replay: %w", err)
+	}
+
+	if !enqueued {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s is busy", nodeID)
+	}
+
+	return &queueItem, nil
+}
@@ -0,0 +1,116 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ReplayCanvasEvent(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode1 := "component-1"
+	componentNode2 := "component-2"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{
+				NodeID: componentNode1,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: componentNode2,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode1, Channel: "default"},
+			{SourceID: componentNode1, TargetID: componentNode2, Channel: "default"},
+		},
+	)
+
+	t.Run("event that does not exist -> error", func(t *testing.T) {
+		_, err := ReplayCanvasEvent(context.Background(), r.Registry, canvas.ID, uuid.New(), "")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("event that is not a root event -> error", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode1, rootEvent.ID, rootEvent.ID, nil)
+		event := support.EmitCanvasEventForNode(t, canvas.ID, componentNode1, "default", &execution.ID)
+
+		_, err := ReplayCanvasEvent(context.Background(), r.Registry, canvas.ID, event.ID, "")
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("root event is replayed from the trigger", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+
+		response, err := ReplayCanvasEvent(context.Background(), r.Registry, canvas.ID, rootEvent.ID, "")
+		require.NoError(t, err)
+		require.NotNil(t, response.Event)
+		assert.NotEqual(t, rootEvent.ID.String(), response.Event.Id)
+		assert.Equal(t, rootEvent.ID.String(), response.Event.ReplayOfId)
+		assert.Equal(t, triggerNode, response.Event.NodeId)
+		assert.Equal(t, "value", response.Event.Data.AsMap()["key"])
+
+		replay, err := models.FindCanvasEvent(uuid.MustParse(response.Event.Id))
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasEventStatePending, replay.State)
+		assert.Nil(t, replay.ExecutionID)
+	})
+
+	t.Run("root event is replayed from a node which did not run -> error", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+
+		_, err := ReplayCanvasEvent(context.Background(), r.Registry, canvas.ID, rootEvent.ID, componentNode2)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("root event is replayed from a specific node", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		execution1 := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode1, rootEvent.ID, rootEvent.ID, nil)
+		input := support.EmitCanvasEventForNode(t, canvas.ID, componentNode1, "default", &execution1.ID)
+		support.CreateCanvasNodeExecution(t, canvas.ID, componentNode2, rootEvent.ID, input.ID, nil)
+
+		response, err := ReplayCanvasEvent(context.Background(), r.Registry, canvas.ID, rootEvent.ID, componentNode2)
+		require.NoError(t, err)
+
+		//
+		// The replayed event is not routed from the trigger again,
+		// and the node receives the same input it received before.
+		//
+		replay, err := models.FindCanvasEvent(uuid.MustParse(response.Event.Id))
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasEventStateRouted, replay.State)
+		require.NotNil(t, replay.ReplayOfID)
+		assert.Equal(t, rootEvent.ID, *replay.ReplayOfID)
+
+		queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode2, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, queueItems, 1)
+		assert.Equal(t, replay.ID, queueItems[0].RootEventID)
+		assert.Equal(t, input.ID, queueItems[0].EventID)
+	})
+}
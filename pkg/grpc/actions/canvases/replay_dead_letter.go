@@ -0,0 +1,86 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func ReplayDeadLetter(ctx context.Context, registry *registry.Registry, canvasID uuid.UUID, id string) (*pb.ReplayDeadLetterResponse, error) {
+	deadLetterID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid id")
+	}
+
+	//
+	// Dead letters can either be events or queue items,
+	// so we look for an event first, and then for a queue item.
+	//
+	event, err := models.FindCanvasEventForCanvas(canvasID, deadLetterID)
+	if err == nil {
+		return replayDeadLetterEvent(event)
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	queueItem, err := models.FindNodeQueueItem(canvasID, deadLetterID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "dead letter not found")
+		}
+
+		return nil, err
+	}
+
+	return replayDeadLetterQueueItem(queueItem)
+}
+
+func replayDeadLetterEvent(event *models.CanvasEvent) (*pb.ReplayDeadLetterResponse, error) {
+	if event.State != models.CanvasEventStateDeadLetter {
+		return nil, status.Error(codes.FailedPrecondition, "event is not a dead letter")
+	}
+
+	if err := event.Replay(); err != nil {
+		return nil, err
+	}
+
+	err := messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), event).Publish()
+	if err != nil {
+		log.Errorf("failed to publish replayed event %s: %v", event.ID, err)
+	}
+
+	return &pb.ReplayDeadLetterResponse{}, nil
+}
+
+func replayDeadLetterQueueItem(queueItem *models.CanvasNodeQueueItem) (*pb.ReplayDeadLetterResponse, error) {
+	if queueItem.State != models.CanvasNodeQueueItemStateDeadLetter {
+		return nil, status.Error(codes.FailedPrecondition, "queue item is not a dead letter")
+	}
+
+	if err := queueItem.Replay(); err != nil {
+		return nil, err
+	}
+
+	err := messages.NewCanvasQueueItemMessage(
+		queueItem.WorkflowID.String(),
+		queueItem.ID.String(),
+		queueItem.NodeID,
+	).Publish(false)
+
+	if err != nil {
+		log.Errorf("failed to publish replayed queue item %s: %v", queueItem.ID, err)
+	}
+
+	return &pb.ReplayDeadLetterResponse{}, nil
+}
@@ -0,0 +1,90 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__ReplayDeadLetter(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		nil,
+	)
+
+	t.Run("dead letter that does not exist -> error", func(t *testing.T) {
+		_, err := ReplayDeadLetter(context.Background(), r.Registry, canvas.ID, uuid.NewString())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("event that is not a dead letter -> error", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		_, err := ReplayDeadLetter(context.Background(), r.Registry, canvas.ID, event.ID.String())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("dead-lettered event is moved back to pending", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		require.NoError(t, database.Conn().Model(event).Updates(map[string]any{
+			"state":          models.CanvasEventStateDeadLetter,
+			"attempts":       models.MaxProcessingAttempts,
+			"failure_reason": "record not found",
+		}).Error)
+
+		_, err := ReplayDeadLetter(context.Background(), r.Registry, canvas.ID, event.ID.String())
+		require.NoError(t, err)
+
+		updatedEvent, err := models.FindCanvasEvent(event.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CanvasEventStatePending, updatedEvent.State)
+		assert.Equal(t, 0, updatedEvent.Attempts)
+		assert.Nil(t, updatedEvent.FailureReason)
+	})
+
+	t.Run("dead-lettered queue item is moved back to the queue", func(t *testing.T) {
+		event := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		queueItem := support.CreateQueueItem(t, canvas.ID, componentNode, event.ID, event.ID)
+		require.NoError(t, database.Conn().Model(queueItem).Updates(map[string]any{
+			"state":          models.CanvasNodeQueueItemStateDeadLetter,
+			"attempts":       models.MaxProcessingAttempts,
+			"failure_reason": "component not found",
+		}).Error)
+
+		_, err := ReplayDeadLetter(context.Background(), r.Registry, canvas.ID, queueItem.ID.String())
+		require.NoError(t, err)
+
+		queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, queueItems, 1)
+		assert.Equal(t, queueItem.ID, queueItems[0].ID)
+		assert.Equal(t, 0, queueItems[0].Attempts)
+	})
+}
@@ -0,0 +1,89 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// RerunExecution puts the input event of a finished execution back in the queue of its node.
+// The execution created from it uses the current node configuration, and since it keeps
+// the same root event, its outputs are routed to the downstream nodes again.
+func RerunExecution(ctx context.Context, workflowID, executionID uuid.UUID) (*pb.RerunExecutionResponse, error) {
+	execution, err := models.FindNodeExecution(workflowID, executionID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "execution not found")
+	}
+
+	if execution.ParentExecutionID != nil {
+		return nil, status.Error(codes.InvalidArgument, "cannot rerun child execution directly, rerun the parent execution instead")
+	}
+
+	if execution.State != models.CanvasNodeExecutionStateFinished {
+		return nil, status.Error(codes.FailedPrecondition, "only finished executions can be rerun")
+	}
+
+	now := time.Now()
+	queueItem := models.CanvasNodeQueueItem{
+		WorkflowID:         execution.WorkflowID,
+		NodeID:             execution.NodeID,
+		RootEventID:        execution.RootEventID,
+		EventID:            execution.EventID,
+		RerunOfExecutionID: &execution.ID,
+		CreatedAt:          &now,
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		node, err := models.FindCanvasNode(tx, workflowID, execution.NodeID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "node not found")
+			}
+
+			return err
+		}
+
+		enqueued, err := node.EnqueueInTransaction(tx, &queueItem)
+		if err != nil {
+			return fmt.Errorf("failed to enqueue rerun: %w", err)
+		}
+
+		if !enqueued {
+			return status.Errorf(codes.FailedPrecondition, "node %s is busy", node.NodeID)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = messages.NewCanvasQueueItemMessage(
+		queueItem.WorkflowID.String(),
+		queueItem.ID.String(),
+		queueItem.NodeID,
+	).Publish(false)
+
+	if err != nil {
+		log.Errorf("failed to publish rerun of execution %s: %v", execution.ID, err)
+	}
+
+	serialized, err := SerializeNodeQueueItems([]models.CanvasNodeQueueItem{queueItem})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RerunExecutionResponse{QueueItem: serialized[0]}, nil
+}
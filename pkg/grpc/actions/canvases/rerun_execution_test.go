@@ -0,0 +1,90 @@
+package canvases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+func Test__RerunExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: triggerNode, Type: models.NodeTypeTrigger},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	t.Run("execution that does not exist -> error", func(t *testing.T) {
+		_, err := RerunExecution(context.Background(), canvas.ID, uuid.New())
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+
+	t.Run("execution that is not finished -> error", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+
+		_, err := RerunExecution(context.Background(), canvas.ID, execution.ID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("child execution -> error", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		parent := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+		child := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, &parent.ID)
+
+		_, err := RerunExecution(context.Background(), canvas.ID, child.ID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("finished execution is put back in the node queue", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+		require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		response, err := RerunExecution(context.Background(), canvas.ID, execution.ID)
+		require.NoError(t, err)
+		require.NotNil(t, response.QueueItem)
+		assert.Equal(t, componentNode, response.QueueItem.NodeId)
+
+		//
+		// The queue item keeps the same root event and input as the original execution,
+		// so the rerun shows up together with it when listing the event executions.
+		//
+		queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, queueItems, 1)
+		assert.Equal(t, rootEvent.ID, queueItems[0].RootEventID)
+		assert.Equal(t, execution.EventID, queueItems[0].EventID)
+		require.NotNil(t, queueItems[0].RerunOfExecutionID)
+		assert.Equal(t, execution.ID, *queueItems[0].RerunOfExecutionID)
+	})
+}
@@ -63,6 +63,11 @@ func ResolveCanvasChangeRequest(
 	if err != nil {
 		return nil, err
 	}
+
+	variables, err := ParseCanvasVariables(pbCanvas.Spec)
+	if err != nil {
+		return nil, err
+	}
 	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout, registry)
 	if err != nil {
 		return nil, err
@@ -113,6 +118,7 @@ func ResolveCanvasChangeRequest(
 		now := time.Now()
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.SetVariableSet(variables)
 		version.UpdatedAt = &now
 		if saveErr := tx.Save(version).Error; saveErr != nil {
 			return saveErr
@@ -85,6 +85,17 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		appInstallationID = &parsedID
 	}
 
+	var retryPolicy *datatypes.JSONType[models.RetryPolicy]
+	if node.RetryPolicy != nil {
+		policy := datatypes.NewJSONType(*node.RetryPolicy)
+		retryPolicy = &policy
+	}
+
+	queuePolicy := node.QueuePolicy
+	if queuePolicy == "" {
+		queuePolicy = models.QueuePolicyFIFO
+	}
+
 	existingNode := findNode(existingNodes, node.ID)
 	if existingNode != nil {
 		existingNode.Name = node.Name
@@ -93,6 +104,10 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		existingNode.Configuration = datatypes.NewJSONType(node.Configuration)
 		existingNode.Position = datatypes.NewJSONType(node.Position)
 		existingNode.IsCollapsed = node.IsCollapsed
+		existingNode.RetryPolicy = retryPolicy
+		existingNode.TimeoutSeconds = node.TimeoutSeconds
+		existingNode.MaxConcurrency = max(node.MaxConcurrency, 1)
+		existingNode.QueuePolicy = queuePolicy
 		existingNode.AppInstallationID = appInstallationID
 
 		if node.ErrorMessage != nil && *node.ErrorMessage != "" {
@@ -143,6 +158,10 @@ func upsertNode(tx *gorm.DB, existingNodes []models.CanvasNode, node models.Node
 		Configuration:     datatypes.NewJSONType(node.Configuration),
 		Position:          datatypes.NewJSONType(node.Position),
 		IsCollapsed:       node.IsCollapsed,
+		RetryPolicy:       retryPolicy,
+		TimeoutSeconds:    node.TimeoutSeconds,
+		MaxConcurrency:    max(node.MaxConcurrency, 1),
+		QueuePolicy:       queuePolicy,
 		Metadata:          datatypes.NewJSONType(node.Metadata),
 		AppInstallationID: appInstallationID,
 		CreatedAt:         &now,
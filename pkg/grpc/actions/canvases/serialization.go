@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, error) {
@@ -56,13 +57,16 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 				CreatedBy:               createdBy,
 				IsTemplate:              canvas.IsTemplate,
 				CanvasVersioningEnabled: canvasVersioningEnabled,
+				Environment:             canvas.Environment,
 				ChangeRequestApprovalConfig: serializeCanvasChangeRequestApprovalConfig(
 					canvas.EffectiveChangeRequestApprovers(),
 				),
 			},
 			Spec: &pb.Canvas_Spec{
-				Nodes: serializedNodes,
-				Edges: actions.EdgesToProto(liveVersion.Edges),
+				Nodes:        serializedNodes,
+				Edges:        actions.EdgesToProto(liveVersion.Edges),
+				Variables:    canvasVariablesToProto(liveVersion.Variables),
+				Environments: canvasEnvironmentsToProto(liveVersion.Environments),
 			},
 			Status: nil,
 		}, nil
@@ -122,13 +126,16 @@ func SerializeCanvas(canvas *models.Canvas, includeStatus bool) (*pb.Canvas, err
 			CreatedBy:               createdBy,
 			IsTemplate:              canvas.IsTemplate,
 			CanvasVersioningEnabled: canvasVersioningEnabled,
+			Environment:             canvas.Environment,
 			ChangeRequestApprovalConfig: serializeCanvasChangeRequestApprovalConfig(
 				canvas.EffectiveChangeRequestApprovers(),
 			),
 		},
 		Spec: &pb.Canvas_Spec{
-			Nodes: serializedNodes,
-			Edges: actions.EdgesToProto(liveVersion.Edges),
+			Nodes:        serializedNodes,
+			Edges:        actions.EdgesToProto(liveVersion.Edges),
+			Variables:    canvasVariablesToProto(liveVersion.Variables),
+			Environments: canvasEnvironmentsToProto(liveVersion.Environments),
 		},
 		Status: &pb.Canvas_Status{
 			LastExecutions: serializedExecutions,
@@ -191,10 +198,51 @@ func serializeCanvasNodes(canvasID uuid.UUID, nodes []models.Node) ([]*compb.Nod
 		}
 	}
 
+	if err := setLatestBlueprintVersions(serialized); err != nil {
+		return nil, err
+	}
+
 	return serialized, nil
 }
 
+// setLatestBlueprintVersions tells which blueprint nodes can be upgraded,
+// by setting the latest published version next to the pinned one.
+func setLatestBlueprintVersions(nodes []*compb.Node) error {
+	ids := []string{}
+	for _, node := range nodes {
+		if node.Blueprint != nil {
+			if _, err := uuid.Parse(node.Blueprint.Id); err == nil {
+				ids = append(ids, node.Blueprint.Id)
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	versions, err := models.FindLatestBlueprintVersions(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if node.Blueprint != nil {
+			node.Blueprint.LatestVersion = int32(versions[node.Blueprint.Id])
+		}
+	}
+
+	return nil
+}
+
 func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
+	return ParseCanvasInTransaction(database.Conn(), registry, orgID, canvas)
+}
+
+// ParseCanvasInTransaction parses a canvas, finding the blueprints
+// its nodes use in the transaction, so that blueprints created
+// in the same transaction can be used.
+func ParseCanvasInTransaction(tx *gorm.DB, registry *registry.Registry, orgID string, canvas *pb.Canvas) ([]models.Node, []models.Edge, error) {
 	if canvas.Metadata == nil {
 		return nil, nil, status.Error(codes.InvalidArgument, "canvas metadata is required")
 	}
@@ -214,6 +262,7 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 
 	nodeIDs := make(map[string]bool)
 	nodeTypeByID := make(map[string]compb.Node_Type)
+	nodeTimeoutByID := make(map[string]int32)
 	nodeValidationErrors := make(map[string]string)
 
 	for i, node := range canvas.Spec.Nodes {
@@ -231,8 +280,23 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 
 		nodeIDs[node.Id] = true
 		nodeTypeByID[node.Id] = node.Type
+		nodeTimeoutByID[node.Id] = node.TimeoutSeconds
+
+		if node.RetryPolicy != nil {
+			if err := actions.ProtoToRetryPolicy(node.RetryPolicy).Validate(); err != nil {
+				return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: invalid retry policy: %v", node.Id, err)
+			}
+		}
+
+		if node.TimeoutSeconds < 0 || node.TimeoutSeconds > models.MaxNodeTimeoutSeconds {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: timeout must be between 0 and %d seconds", node.Id, models.MaxNodeTimeoutSeconds)
+		}
 
-		if err := validateNodeRef(registry, orgID, node); err != nil {
+		if node.MaxConcurrency < 0 || node.MaxConcurrency > models.MaxNodeConcurrency {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "node %s: max concurrency must be between 0 and %d", node.Id, models.MaxNodeConcurrency)
+		}
+
+		if err := validateNodeRef(tx, registry, orgID, node); err != nil {
 			nodeValidationErrors[node.Id] = err.Error()
 		}
 	}
@@ -260,6 +324,10 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 		if nodeTypeByID[edge.TargetId] == compb.Node_TYPE_WIDGET {
 			return nil, nil, status.Errorf(codes.InvalidArgument, "edge %d: widget nodes cannot be used as target nodes", i)
 		}
+
+		if edge.Channel == models.CanvasNodeExecutionTimeoutChannel && nodeTimeoutByID[edge.SourceId] <= 0 {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "edge %d: source node %s has no timeout configured", i, edge.SourceId)
+		}
 	}
 
 	if err := actions.CheckForCycles(canvas.Spec.Nodes, canvas.Spec.Edges); err != nil {
@@ -285,7 +353,7 @@ func ParseCanvas(registry *registry.Registry, orgID string, canvas *pb.Canvas) (
 	return nodes, actions.ProtoToEdges(canvas.Spec.Edges), nil
 }
 
-func validateNodeRef(registry *registry.Registry, organizationID string, node *compb.Node) error {
+func validateNodeRef(tx *gorm.DB, registry *registry.Registry, organizationID string, node *compb.Node) error {
 	switch node.Type {
 	case compb.Node_TYPE_COMPONENT:
 		if node.Component == nil {
@@ -312,9 +380,16 @@ func validateNodeRef(registry *registry.Registry, organizationID string, node *c
 			return fmt.Errorf("blueprint ID is required")
 		}
 
-		blueprint, err := models.FindBlueprint(organizationID, node.Blueprint.Id)
+		if node.Blueprint.Version < 0 {
+			return fmt.Errorf("blueprint version must not be negative")
+		}
+
+		blueprint, err := models.FindOrganizationBlueprintForRefInTransaction(tx, organizationID, &models.BlueprintRef{
+			ID:      node.Blueprint.Id,
+			Version: int(node.Blueprint.Version),
+		})
 		if err != nil {
-			return fmt.Errorf("blueprint %s not found", node.Blueprint.Id)
+			return fmt.Errorf("blueprint %s not found: %v", node.Blueprint.Id, err)
 		}
 
 		return configuration.ValidateConfiguration(blueprint.Configuration, node.Configuration.AsMap())
@@ -29,6 +29,7 @@ func UpdateCanvas(
 	description *string,
 	canvasVersioningEnabled *bool,
 	changeRequestApprovalConfig *pb.CanvasChangeRequestApprovalConfig,
+	environment *string,
 ) (*pb.UpdateCanvasResponse, error) {
 	canvasID, err := uuid.Parse(id)
 	if err != nil {
@@ -90,6 +91,23 @@ func UpdateCanvas(
 		}
 	}
 
+	if environment != nil && canvas.Environment != strings.TrimSpace(*environment) {
+		nextEnvironment := strings.TrimSpace(*environment)
+		if nextEnvironment != "" {
+			liveVersion, liveVersionErr := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+			if liveVersionErr != nil {
+				return nil, status.Error(codes.FailedPrecondition, "canvas live version not found")
+			}
+
+			if !liveVersion.VariableSet().HasEnvironment(nextEnvironment) {
+				return nil, status.Errorf(codes.InvalidArgument, "environment %s is not defined", nextEnvironment)
+			}
+		}
+
+		canvas.Environment = nextEnvironment
+		changed = true
+	}
+
 	if canvasVersioningEnabled != nil && canvas.CanvasVersioningEnabled != *canvasVersioningEnabled {
 		canvas.CanvasVersioningEnabled = *canvasVersioningEnabled
 		changed = true
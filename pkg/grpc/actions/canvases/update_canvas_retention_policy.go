@@ -0,0 +1,115 @@
+package canvases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// UpdateCanvasRetentionPolicy overrides the organization retention policy for a canvas.
+// Without a policy, the override is removed, and the canvas inherits the organization policy again.
+func UpdateCanvasRetentionPolicy(ctx context.Context, organizationID string, canvasID string, policy *pb.RetentionPolicy) (*pb.UpdateCanvasRetentionPolicyResponse, error) {
+	userID, ok := authentication.GetUserIdFromMetadata(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization_id")
+	}
+
+	canvasUUID, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid canvas_id")
+	}
+
+	canvas, err := models.FindCanvas(orgUUID, canvasUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "canvas not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to load canvas")
+	}
+
+	var effective *models.RetentionPolicy
+	var source string
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		if policy == nil {
+			if err := models.DeleteCanvasRetentionPolicyInTransaction(tx, canvas.ID); err != nil {
+				return status.Error(codes.Internal, "failed to update retention policy")
+			}
+		} else {
+			now := time.Now()
+			updatedBy := uuid.MustParse(userID)
+			override := &models.RetentionPolicy{
+				OrganizationID:       canvas.OrganizationID,
+				WorkflowID:           &canvas.ID,
+				MaxAgeDays:           int(policy.MaxAgeDays),
+				MaxExecutionsPerNode: int(policy.MaxExecutionsPerNode),
+				FailedMaxAgeDays:     int(policy.FailedMaxAgeDays),
+				UpdatedBy:            &updatedBy,
+				CreatedAt:            now,
+				UpdatedAt:            now,
+			}
+
+			if err := override.Validate(); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			if err := models.UpsertRetentionPolicyInTransaction(tx, override); err != nil {
+				return status.Error(codes.Internal, "failed to update retention policy")
+			}
+		}
+
+		var txErr error
+		effective, source, txErr = models.FindEffectiveRetentionPolicyInTransaction(tx, canvas.OrganizationID, canvas.ID)
+		if txErr != nil {
+			return status.Error(codes.Internal, "failed to find retention policy")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCanvasRetentionPolicyResponse{
+		RetentionPolicy: SerializeRetentionPolicy(effective),
+		Source:          RetentionPolicySourceToProto(source),
+	}, nil
+}
+
+func SerializeRetentionPolicy(policy *models.RetentionPolicy) *pb.RetentionPolicy {
+	if policy == nil {
+		return &pb.RetentionPolicy{}
+	}
+
+	return &pb.RetentionPolicy{
+		MaxAgeDays:           int32(policy.MaxAgeDays),
+		MaxExecutionsPerNode: int32(policy.MaxExecutionsPerNode),
+		FailedMaxAgeDays:     int32(policy.FailedMaxAgeDays),
+	}
+}
+
+func RetentionPolicySourceToProto(source string) pb.RetentionPolicy_Source {
+	switch source {
+	case models.RetentionPolicySourceOrganization:
+		return pb.RetentionPolicy_SOURCE_ORGANIZATION
+	case models.RetentionPolicySourceCanvas:
+		return pb.RetentionPolicy_SOURCE_CANVAS
+	default:
+		return pb.RetentionPolicy_SOURCE_NONE
+	}
+}
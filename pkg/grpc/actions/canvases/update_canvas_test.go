@@ -21,7 +21,7 @@ func Test__UpdateCanvas(t *testing.T) {
 	t.Run("invalid canvas id -> error", func(t *testing.T) {
 		name := "name"
 		description := "description"
-		_, err := UpdateCanvas(context.Background(), r.AuthService, r.Organization.ID.String(), "invalid-id", &name, &description, nil, nil)
+		_, err := UpdateCanvas(context.Background(), r.AuthService, r.Organization.ID.String(), "invalid-id", &name, &description, nil, nil, nil)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.InvalidArgument, s.Code())
@@ -37,6 +37,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			stringPointer("updated-description"),
 			nil,
 			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -55,6 +56,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			stringPointer("description"),
 			nil,
 			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -75,6 +77,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			&newDescription,
 			nil,
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -103,6 +106,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			&targetCanvas.Description,
 			nil,
 			nil,
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -126,6 +130,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -155,6 +160,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -168,6 +174,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&disabled,
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -197,6 +204,7 @@ func Test__UpdateCanvas(t *testing.T) {
 			nil,
 			&enabled,
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -229,6 +237,7 @@ func Test__UpdateCanvas(t *testing.T) {
 					},
 				},
 			},
+			nil,
 		)
 		require.NoError(t, err)
 		require.NotNil(t, response)
@@ -259,6 +268,7 @@ func Test__UpdateCanvas(t *testing.T) {
 					},
 				},
 			},
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
@@ -282,6 +292,7 @@ func Test__UpdateCanvas(t *testing.T) {
 					{Type: pb.CanvasChangeRequestApprover_TYPE_ANYONE},
 				},
 			},
+			nil,
 		)
 		s, ok := status.FromError(err)
 		assert.True(t, ok)
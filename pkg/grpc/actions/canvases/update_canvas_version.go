@@ -57,6 +57,11 @@ func UpdateCanvasVersion(
 		return nil, err
 	}
 
+	variables, err := ParseCanvasVariables(pbCanvas.Spec)
+	if err != nil {
+		return nil, err
+	}
+
 	nodes, edges, err = applyCanvasAutoLayout(nodes, edges, autoLayout, registry)
 	if err != nil {
 		return nil, err
@@ -81,6 +86,7 @@ func UpdateCanvasVersion(
 			canvas,
 			nodes,
 			edges,
+			variables,
 			webhookBaseURL,
 		)
 	}
@@ -124,6 +130,7 @@ func UpdateCanvasVersion(
 		now := time.Now()
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.SetVariableSet(variables)
 		version.UpdatedAt = &now
 
 		if err := tx.Save(version).Error; err != nil {
@@ -159,6 +166,7 @@ func updateLiveCanvasWithoutVersioning(
 	canvas *models.Canvas,
 	nodes []models.Node,
 	edges []models.Edge,
+	variables models.CanvasVariableSet,
 	webhookBaseURL string,
 ) (*pb.UpdateCanvasVersionResponse, error) {
 	organizationID := organizationUUID.String()
@@ -174,6 +182,10 @@ func updateLiveCanvasWithoutVersioning(
 			return status.Error(codes.FailedPrecondition, "templates are read-only")
 		}
 
+		if err := validateCanvasEnvironmentIsDefined(canvasInTx, variables); err != nil {
+			return err
+		}
+
 		liveVersion, liveVersionErr := models.FindLiveCanvasVersionByCanvasInTransaction(tx, canvasInTx)
 		if liveVersionErr != nil {
 			if errors.Is(liveVersionErr, gorm.ErrRecordNotFound) {
@@ -247,6 +259,7 @@ func updateLiveCanvasWithoutVersioning(
 
 		liveVersion.Nodes = datatypes.NewJSONSlice(nodes)
 		liveVersion.Edges = datatypes.NewJSONSlice(edges)
+		liveVersion.SetVariableSet(variables)
 		liveVersion.UpdatedAt = &now
 		if saveErr := tx.Save(liveVersion).Error; saveErr != nil {
 			return saveErr
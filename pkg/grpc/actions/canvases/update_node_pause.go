@@ -88,15 +88,19 @@ func serializeCanvasNode(node *models.CanvasNode) (*compb.Node, error) {
 	}
 
 	modelNode := models.Node{
-		ID:            node.NodeID,
-		Name:          node.Name,
-		Type:          node.Type,
-		Ref:           node.Ref.Data(),
-		Configuration: node.Configuration.Data(),
-		Metadata:      node.Metadata.Data(),
-		Position:      node.Position.Data(),
-		IsCollapsed:   node.IsCollapsed,
-		IntegrationID: integrationID,
+		ID:             node.NodeID,
+		Name:           node.Name,
+		Type:           node.Type,
+		Ref:            node.Ref.Data(),
+		Configuration:  node.Configuration.Data(),
+		Metadata:       node.Metadata.Data(),
+		Position:       node.Position.Data(),
+		IsCollapsed:    node.IsCollapsed,
+		IntegrationID:  integrationID,
+		RetryPolicy:    node.GetRetryPolicy(),
+		TimeoutSeconds: node.TimeoutSeconds,
+		MaxConcurrency: node.MaxConcurrency,
+		QueuePolicy:    node.QueuePolicy,
 	}
 
 	serialized := actions.NodesToProto([]models.Node{modelNode})
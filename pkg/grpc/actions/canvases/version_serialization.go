@@ -1,6 +1,7 @@
 package canvases
 
 import (
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/grpc/actions"
 	"github.com/superplanehq/superplane/pkg/models"
 	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
@@ -35,11 +36,18 @@ func SerializeCanvasVersion(version *models.CanvasVersion, organizationID string
 		metadata.UpdatedAt = timestamppb.New(*version.UpdatedAt)
 	}
 
+	nodes := actions.NodesToProto(version.Nodes)
+	if err := setLatestBlueprintVersions(nodes); err != nil {
+		log.Errorf("error finding latest blueprint versions for canvas version %s: %v", version.ID, err)
+	}
+
 	return &pb.CanvasVersion{
 		Metadata: metadata,
 		Spec: &pb.Canvas_Spec{
-			Nodes: actions.NodesToProto(version.Nodes),
-			Edges: actions.EdgesToProto(version.Edges),
+			Nodes:        nodes,
+			Edges:        actions.EdgesToProto(version.Edges),
+			Variables:    canvasVariablesToProto(version.Variables),
+			Environments: canvasEnvironmentsToProto(version.Environments),
 		},
 	}
 }
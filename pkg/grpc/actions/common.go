@@ -713,6 +713,10 @@ func ProtoToNodes(nodes []*componentpb.Node) []models.Node {
 			IntegrationID:  integrationID,
 			ErrorMessage:   errorMessage,
 			WarningMessage: warningMessage,
+			RetryPolicy:    ProtoToRetryPolicy(node.RetryPolicy),
+			TimeoutSeconds: int(node.TimeoutSeconds),
+			MaxConcurrency: int(node.MaxConcurrency),
+			QueuePolicy:    ProtoToQueuePolicy(node.QueuePolicy),
 		}
 	}
 	return result
@@ -737,7 +741,8 @@ func NodesToProto(nodes []models.Node) []*componentpb.Node {
 
 		if node.Ref.Blueprint != nil {
 			result[i].Blueprint = &componentpb.Node_BlueprintRef{
-				Id: node.Ref.Blueprint.ID,
+				Id:      node.Ref.Blueprint.ID,
+				Version: int32(node.Ref.Blueprint.Version),
 			}
 		}
 
@@ -774,11 +779,59 @@ func NodesToProto(nodes []models.Node) []*componentpb.Node {
 		if node.WarningMessage != nil && *node.WarningMessage != "" {
 			result[i].WarningMessage = *node.WarningMessage
 		}
+
+		if node.RetryPolicy != nil {
+			result[i].RetryPolicy = RetryPolicyToProto(node.RetryPolicy)
+		}
+
+		if node.TimeoutSeconds > 0 {
+			result[i].TimeoutSeconds = int32(node.TimeoutSeconds)
+		}
+
+		if node.MaxConcurrency > 0 {
+			result[i].MaxConcurrency = int32(node.MaxConcurrency)
+		}
+
+		result[i].QueuePolicy = QueuePolicyToProto(node.QueuePolicy)
 	}
 
 	return result
 }
 
+func ProtoToRetryPolicy(policy *componentpb.RetryPolicy) *models.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	backoff := models.RetryBackoffFixed
+	if policy.Backoff == componentpb.RetryPolicy_BACKOFF_EXPONENTIAL {
+		backoff = models.RetryBackoffExponential
+	}
+
+	return &models.RetryPolicy{
+		MaxAttempts:     int(policy.MaxAttempts),
+		Backoff:         backoff,
+		DelaySeconds:    int(policy.DelaySeconds),
+		MaxDelaySeconds: int(policy.MaxDelaySeconds),
+		RetryOn:         policy.RetryOn,
+	}
+}
+
+func RetryPolicyToProto(policy *models.RetryPolicy) *componentpb.RetryPolicy {
+	backoff := componentpb.RetryPolicy_BACKOFF_FIXED
+	if policy.Backoff == models.RetryBackoffExponential {
+		backoff = componentpb.RetryPolicy_BACKOFF_EXPONENTIAL
+	}
+
+	return &componentpb.RetryPolicy{
+		MaxAttempts:     int32(policy.MaxAttempts),
+		Backoff:         backoff,
+		DelaySeconds:    int32(policy.DelaySeconds),
+		MaxDelaySeconds: int32(policy.MaxDelaySeconds),
+		RetryOn:         policy.RetryOn,
+	}
+}
+
 func ProtoToEdges(edges []*componentpb.Edge) []models.Edge {
 	result := make([]models.Edge, len(edges))
 	for i, edge := range edges {
@@ -912,6 +965,28 @@ func NodeTypeToProto(nodeType string) componentpb.Node_Type {
 	}
 }
 
+func ProtoToQueuePolicy(policy componentpb.Node_QueuePolicy) string {
+	switch policy {
+	case componentpb.Node_QUEUE_POLICY_LATEST_WINS:
+		return models.QueuePolicyLatestWins
+	case componentpb.Node_QUEUE_POLICY_REJECT_WHEN_BUSY:
+		return models.QueuePolicyRejectWhenBusy
+	default:
+		return models.QueuePolicyFIFO
+	}
+}
+
+func QueuePolicyToProto(policy string) componentpb.Node_QueuePolicy {
+	switch policy {
+	case models.QueuePolicyLatestWins:
+		return componentpb.Node_QUEUE_POLICY_LATEST_WINS
+	case models.QueuePolicyRejectWhenBusy:
+		return componentpb.Node_QUEUE_POLICY_REJECT_WHEN_BUSY
+	default:
+		return componentpb.Node_QUEUE_POLICY_FIFO
+	}
+}
+
 func ProtoToNodeRef(node *componentpb.Node) models.NodeRef {
 	ref := models.NodeRef{}
 
@@ -925,7 +1000,8 @@ func ProtoToNodeRef(node *componentpb.Node) models.NodeRef {
 	case componentpb.Node_TYPE_BLUEPRINT:
 		if node.Blueprint != nil {
 			ref.Blueprint = &models.BlueprintRef{
-				ID: node.Blueprint.Id,
+				ID:      node.Blueprint.Id,
+				Version: int(node.Blueprint.Version),
 			}
 		}
 	case componentpb.Node_TYPE_TRIGGER:
@@ -1110,6 +1186,30 @@ func defaultValueFromProto(fieldType, defaultValue string) any {
 	}
 }
 
+// TimeoutOutputChannel is where nodes with a timeout emit
+// an event when one of their executions times out.
+var TimeoutOutputChannel = core.OutputChannel{
+	Name:        models.CanvasNodeExecutionTimeoutChannel,
+	Label:       "Timeout",
+	Description: "Emitted when an execution of the node times out",
+}
+
+// NodeOutputChannels returns the output channels of a node from the ones
+// of its component, adding the timeout channel if the node has a timeout.
+func NodeOutputChannels(channels []core.OutputChannel, timeoutSeconds int) []core.OutputChannel {
+	if timeoutSeconds <= 0 {
+		return channels
+	}
+
+	for _, channel := range channels {
+		if channel.Name == TimeoutOutputChannel.Name {
+			return channels
+		}
+	}
+
+	return append(slices.Clone(channels), TimeoutOutputChannel)
+}
+
 func SerializeComponents(in []core.Component) []*componentpb.Component {
 	out := make([]*componentpb.Component, len(in))
 	for i, component := range in {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/core"
 )
 
 func TestConfigurationFieldToProto(t *testing.T) {
@@ -96,3 +97,18 @@ func TestConfigurationFieldToProto(t *testing.T) {
 		assert.Equal(t, maxItems, *field2.TypeOptions.List.MaxItems)
 	})
 }
+
+func TestNodeOutputChannels(t *testing.T) {
+	channels := []core.OutputChannel{core.DefaultOutputChannel}
+
+	t.Run("nodes without timeout keep the component channels", func(t *testing.T) {
+		assert.Equal(t, channels, NodeOutputChannels(channels, 0))
+	})
+
+	t.Run("nodes with timeout have the timeout channel", func(t *testing.T) {
+		result := NodeOutputChannels(channels, 60)
+		assert.Equal(t, []core.OutputChannel{core.DefaultOutputChannel, TimeoutOutputChannel}, result)
+		assert.Len(t, channels, 1)
+		assert.Equal(t, result, NodeOutputChannels(result, 60))
+	})
+}
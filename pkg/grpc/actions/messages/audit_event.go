@@ -0,0 +1,27 @@
+package messages
+
+import (
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const AuditEventCreatedRoutingKey = "audit-event-created"
+
+type AuditEventCreatedMessage struct {
+	message *pb.AuditEventCreated
+}
+
+func NewAuditEventCreatedMessage(event *models.AuditEvent) AuditEventCreatedMessage {
+	return AuditEventCreatedMessage{
+		message: &pb.AuditEventCreated{
+			AuditEventId:   event.ID.String(),
+			OrganizationId: event.OrganizationID.String(),
+			Timestamp:      timestamppb.Now(),
+		},
+	}
+}
+
+func (m AuditEventCreatedMessage) Publish() error {
+	return Publish(WorkflowExchange, AuditEventCreatedRoutingKey, toBytes(m.message))
+}
@@ -0,0 +1,28 @@
+package messages
+
+import (
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const WorkflowExecutionLogRoutingKey = "workflow-execution-log"
+
+type CanvasExecutionLogMessage struct {
+	message *pb.CanvasNodeExecutionLogMessage
+}
+
+func NewCanvasExecutionLogMessage(canvasId, executionID, nodeID, logID string) CanvasExecutionLogMessage {
+	return CanvasExecutionLogMessage{
+		message: &pb.CanvasNodeExecutionLogMessage{
+			Id:          logID,
+			CanvasId:    canvasId,
+			ExecutionId: executionID,
+			NodeId:      nodeID,
+			Timestamp:   timestamppb.Now(),
+		},
+	}
+}
+
+func (m CanvasExecutionLogMessage) Publish() error {
+	return Publish(WorkflowExchange, WorkflowExecutionLogRoutingKey, toBytes(m.message))
+}
@@ -0,0 +1,25 @@
+package organizations
+
+import (
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func DeleteEventSink(orgID string) (*pb.DeleteEventSinkResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	//
+	// Deliveries which were not sent yet are deleted with the sink.
+	//
+	if err := models.DeleteEventSink(organizationID); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete event sink")
+	}
+
+	return &pb.DeleteEventSinkResponse{}, nil
+}
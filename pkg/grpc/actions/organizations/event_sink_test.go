@@ -0,0 +1,179 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/eventsinks"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__EventSink(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	t.Run("without sink, nothing is streamed", func(t *testing.T) {
+		resp, err := GetEventSink(orgID)
+		require.NoError(t, err)
+		require.NotNil(t, resp.EventSink)
+		assert.Empty(t, resp.EventSink.Type)
+		assert.False(t, resp.EventSink.Enabled)
+	})
+
+	t.Run("secret is required when creating the sink", func(t *testing.T) {
+		_, err := UpdateEventSink(context.Background(), r.Encryptor, r.Registry.HTTPContext(), orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeWebhook,
+			Url:        "https://example.com/events",
+			Categories: []string{models.EventSinkCategoryAuditEvents},
+			Enabled:    true,
+		}, r.User.String())
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+		assert.Equal(t, "secret is required", s.Message())
+	})
+
+	t.Run("invalid sinks are rejected", func(t *testing.T) {
+		_, err := UpdateEventSink(context.Background(), r.Encryptor, r.Registry.HTTPContext(), orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeSyslog,
+			Url:        "https://example.com/events",
+			Categories: []string{models.EventSinkCategoryAuditEvents},
+			Secret:     "0123456789abcdef",
+		}, r.User.String())
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+
+		_, err = UpdateEventSink(context.Background(), r.Encryptor, r.Registry.HTTPContext(), orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeWebhook,
+			Url:        "https://example.com/events",
+			Categories: []string{"unknown"},
+			Secret:     "0123456789abcdef",
+		}, r.User.String())
+
+		s, ok = status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("private addresses are rejected", func(t *testing.T) {
+		httpCtx, err := registry.NewHTTPContext(registry.HTTPOptions{
+			BlockedHosts:    []string{"metadata.google.internal"},
+			PrivateIPRanges: []string{"10.0.0.0/8"},
+		})
+		require.NoError(t, err)
+
+		for _, url := range []string{"https://10.0.0.1/events", "https://metadata.google.internal/events"} {
+			_, err := UpdateEventSink(context.Background(), r.Encryptor, httpCtx, orgID, &pb.UpdateEventSinkRequest{
+				Type:       models.EventSinkTypeWebhook,
+				Url:        url,
+				Categories: []string{models.EventSinkCategoryAuditEvents},
+				Secret:     "0123456789abcdef",
+			}, r.User.String())
+
+			s, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, s.Code(), url)
+		}
+
+		_, err = UpdateEventSink(context.Background(), r.Encryptor, httpCtx, orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeSyslog,
+			Url:        "tls://10.1.2.3:6514",
+			Categories: []string{models.EventSinkCategoryAuditEvents},
+			Secret:     "0123456789abcdef",
+		}, r.User.String())
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("sink is created and updated", func(t *testing.T) {
+		resp, err := UpdateEventSink(context.Background(), r.Encryptor, r.Registry.HTTPContext(), orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeWebhook,
+			Url:        "https://example.com/events",
+			Categories: []string{models.EventSinkCategoryExecutions, models.EventSinkCategoryAuditEvents},
+			Enabled:    true,
+			Secret:     "0123456789abcdef",
+		}, r.User.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, models.EventSinkTypeWebhook, resp.EventSink.Type)
+		assert.Equal(t, []string{models.EventSinkCategoryAuditEvents, models.EventSinkCategoryExecutions}, resp.EventSink.Categories)
+		assert.True(t, resp.EventSink.HasSecret)
+		assert.Equal(t, r.User.String(), resp.EventSink.UpdatedBy)
+
+		//
+		// The secret is kept when it is not sent again.
+		//
+		resp, err = UpdateEventSink(context.Background(), r.Encryptor, r.Registry.HTTPContext(), orgID, &pb.UpdateEventSinkRequest{
+			Type:       models.EventSinkTypeSyslog,
+			Url:        "tcp://logs.example.com:6514",
+			Categories: []string{models.EventSinkCategoryCanvasEvents},
+			Enabled:    true,
+		}, r.User.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, models.EventSinkTypeSyslog, resp.EventSink.Type)
+		assert.Equal(t, "tcp://logs.example.com:6514", resp.EventSink.Url)
+		assert.True(t, resp.EventSink.HasSecret)
+	})
+
+	t.Run("failed deliveries are retried", func(t *testing.T) {
+		sink, err := models.FindEventSink(r.Organization.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, eventsinks.Enqueue(sink, eventsinks.EventTypeAuditEventRecorded, "audit-event:1", map[string]any{}))
+		require.NoError(t, eventsinks.Enqueue(sink, eventsinks.EventTypeAuditEventRecorded, "audit-event:2", map[string]any{}))
+
+		//
+		// The same event is only added to the backlog once.
+		//
+		require.NoError(t, eventsinks.Enqueue(sink, eventsinks.EventTypeAuditEventRecorded, "audit-event:2", map[string]any{}))
+
+		require.NoError(t, database.Conn().
+			Model(&models.EventSinkDelivery{}).
+			Where("dedup_key = ?", "audit-event:1").
+			Update("state", models.EventSinkDeliveryStateFailed).
+			Error)
+
+		resp, err := GetEventSink(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), resp.EventSink.Backlog.Pending)
+		assert.Equal(t, int64(1), resp.EventSink.Backlog.Failed)
+		assert.NotNil(t, resp.EventSink.Backlog.OldestPendingAt)
+
+		retryResp, err := RetryEventSinkDeliveries(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), retryResp.Requeued)
+
+		resp, err = GetEventSink(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), resp.EventSink.Backlog.Pending)
+		assert.Zero(t, resp.EventSink.Backlog.Failed)
+	})
+
+	t.Run("sink is deleted", func(t *testing.T) {
+		_, err := DeleteEventSink(orgID)
+		require.NoError(t, err)
+
+		resp, err := GetEventSink(orgID)
+		require.NoError(t, err)
+		assert.Empty(t, resp.EventSink.Type)
+
+		_, err = RetryEventSinkDeliveries(orgID)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.NotFound, s.Code())
+	})
+}
@@ -0,0 +1,83 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func GetEventSink(orgID string) (*pb.GetEventSinkResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	sink, err := models.FindEventSink(organizationID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to find event sink")
+		}
+
+		//
+		// Without a sink, nothing is streamed.
+		//
+		return &pb.GetEventSinkResponse{
+			EventSink: &pb.EventSink{},
+		}, nil
+	}
+
+	return &pb.GetEventSinkResponse{
+		EventSink: serializeEventSink(sink),
+	}, nil
+}
+
+func serializeEventSink(sink *models.EventSink) *pb.EventSink {
+	serialized := &pb.EventSink{
+		Type:       sink.Type,
+		Url:        sink.URL,
+		Categories: sink.Categories,
+		Enabled:    sink.Enabled,
+		HasSecret:  len(sink.SecretCiphertext) > 0,
+		UpdatedAt:  timestamppb.New(sink.UpdatedAt),
+	}
+
+	if sink.UpdatedBy != nil {
+		serialized.UpdatedBy = sink.UpdatedBy.String()
+	}
+
+	if sink.LastDeliveredAt != nil {
+		serialized.LastDeliveredAt = timestamppb.New(*sink.LastDeliveredAt)
+	}
+
+	if sink.LastError != nil {
+		serialized.LastError = *sink.LastError
+	}
+
+	if sink.LastErrorAt != nil {
+		serialized.LastErrorAt = timestamppb.New(*sink.LastErrorAt)
+	}
+
+	backlog, err := models.FindEventSinkBacklog(sink.ID)
+	if err != nil {
+		log.Errorf("failed to find backlog of event sink %s: %v", sink.ID, err)
+		return serialized
+	}
+
+	serialized.Backlog = &pb.EventSink_Backlog{
+		Pending: backlog.Pending,
+		Failed:  backlog.Failed,
+	}
+
+	if backlog.OldestPendingAt != nil {
+		serialized.Backlog.OldestPendingAt = timestamppb.New(*backlog.OldestPendingAt)
+	}
+
+	return serialized
+}
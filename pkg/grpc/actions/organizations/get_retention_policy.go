@@ -0,0 +1,53 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func GetRetentionPolicy(orgID string) (*pb.GetRetentionPolicyResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	policy, err := models.FindOrganizationRetentionPolicy(organizationID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to find retention policy")
+		}
+
+		//
+		// Without a policy, everything is kept forever.
+		//
+		return &pb.GetRetentionPolicyResponse{
+			RetentionPolicy: &pb.RetentionPolicy{},
+		}, nil
+	}
+
+	return &pb.GetRetentionPolicyResponse{
+		RetentionPolicy: serializeRetentionPolicy(policy),
+	}, nil
+}
+
+func serializeRetentionPolicy(policy *models.RetentionPolicy) *pb.RetentionPolicy {
+	serialized := &pb.RetentionPolicy{
+		MaxAgeDays:           int32(policy.MaxAgeDays),
+		MaxExecutionsPerNode: int32(policy.MaxExecutionsPerNode),
+		FailedMaxAgeDays:     int32(policy.FailedMaxAgeDays),
+		UpdatedAt:            timestamppb.New(policy.UpdatedAt),
+	}
+
+	if policy.UpdatedBy != nil {
+		serialized.UpdatedBy = policy.UpdatedBy.String()
+	}
+
+	return serialized
+}
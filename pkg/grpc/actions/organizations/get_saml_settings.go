@@ -0,0 +1,84 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+func GetSAMLSettings(baseURL, orgID string) (*pb.GetSAMLSettingsResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	settings, err := models.FindOrganizationSAMLSettings(organizationID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "failed to find SAML settings")
+		}
+
+		//
+		// The service provider details are returned even before SAML is configured,
+		// since they are needed to register the organization in the identity provider.
+		//
+		return &pb.GetSAMLSettingsResponse{
+			Settings: serializeSAMLSettings(baseURL, orgID, &models.OrganizationSAMLSettings{}),
+		}, nil
+	}
+
+	return &pb.GetSAMLSettingsResponse{
+		Settings: serializeSAMLSettings(baseURL, orgID, settings),
+	}, nil
+}
+
+func serializeSAMLSettings(baseURL, orgID string, settings *models.OrganizationSAMLSettings) *pb.SAMLSettings {
+	serialized := &pb.SAMLSettings{
+		Enabled:        settings.Enabled,
+		Enforced:       settings.Enforced,
+		IdpMetadataXml: settings.IdpMetadata,
+		IdpEntityId:    settings.IdpEntityID,
+		IdpSsoUrl:      settings.IdpSSOURL,
+		GroupMappings:  []*pb.SAMLSettings_GroupMapping{},
+		SpEntityId:     authentication.SAMLEntityID(baseURL, orgID),
+		SpAcsUrl:       authentication.SAMLACSURL(baseURL, orgID),
+		SpMetadataUrl:  authentication.SAMLEntityID(baseURL, orgID),
+		LoginUrl:       authentication.SAMLLoginPath(orgID),
+	}
+
+	if settings.EmailAttribute != nil {
+		serialized.EmailAttribute = *settings.EmailAttribute
+	}
+
+	if settings.NameAttribute != nil {
+		serialized.NameAttribute = *settings.NameAttribute
+	}
+
+	if settings.GroupsAttribute != nil {
+		serialized.GroupsAttribute = *settings.GroupsAttribute
+	}
+
+	for _, mapping := range settings.GroupMappings {
+		serialized.GroupMappings = append(serialized.GroupMappings, &pb.SAMLSettings_GroupMapping{
+			IdpGroup: mapping.IdpGroup,
+			Group:    mapping.Group,
+		})
+	}
+
+	if !settings.UpdatedAt.IsZero() {
+		serialized.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+
+	if settings.UpdatedBy != nil {
+		serialized.UpdatedBy = settings.UpdatedBy.String()
+	}
+
+	return serialized
+}
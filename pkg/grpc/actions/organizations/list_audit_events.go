@@ -0,0 +1,155 @@
+package organizations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	DefaultAuditEventsLimit = 50
+	MaxAuditEventsLimit     = 500
+)
+
+func ListAuditEvents(orgID string, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	filters := models.AuditEventFilters{
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		Action:       req.Action,
+	}
+
+	if req.ActorId != "" {
+		actorID, err := uuid.Parse(req.ActorId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid actor id")
+		}
+		filters.ActorID = &actorID
+	}
+
+	if req.Since != nil {
+		since := req.Since.AsTime()
+		filters.Since = &since
+	}
+
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		filters.Before = &before
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultAuditEventsLimit
+	}
+
+	if limit > MaxAuditEventsLimit {
+		limit = MaxAuditEventsLimit
+	}
+
+	//
+	// One more event is loaded to know if there is a next page.
+	//
+	events, err := models.ListAuditEvents(organizationID, filters, limit+1)
+	if err != nil {
+		log.Errorf("failed to list audit events for organization %s: %v", organizationID, err)
+		return nil, status.Error(codes.Internal, "failed to list audit events")
+	}
+
+	hasNextPage := len(events) > limit
+	if hasNextPage {
+		events = events[:limit]
+	}
+
+	actors := findAuditEventActors(orgID, events)
+	response := &pb.ListAuditEventsResponse{
+		Events:      make([]*pb.AuditEvent, 0, len(events)),
+		HasNextPage: hasNextPage,
+	}
+
+	for _, event := range events {
+		response.Events = append(response.Events, serializeAuditEvent(event, actors))
+	}
+
+	if len(events) > 0 {
+		response.LastTimestamp = timestamppb.New(*events[len(events)-1].CreatedAt)
+	}
+
+	return response, nil
+}
+
+func findAuditEventActors(orgID string, events []models.AuditEvent) map[string]models.User {
+	ids := []string{}
+	for _, event := range events {
+		if event.ActorID != nil {
+			ids = append(ids, event.ActorID.String())
+		}
+	}
+
+	actors := map[string]models.User{}
+	if len(ids) == 0 {
+		return actors
+	}
+
+	users, err := models.ListActiveUsersByID(orgID, ids)
+	if err != nil {
+		log.Errorf("failed to find actors of audit events: %v", err)
+		return actors
+	}
+
+	for _, user := range users {
+		actors[user.ID.String()] = user
+	}
+
+	return actors
+}
+
+func serializeAuditEvent(event models.AuditEvent, actors map[string]models.User) *pb.AuditEvent {
+	serialized := &pb.AuditEvent{
+		Id:           event.ID.String(),
+		ResourceType: event.ResourceType,
+		ResourceId:   event.ResourceID,
+		Action:       event.Action,
+		Method:       event.Method,
+		Status:       event.Status,
+		Error:        event.Error,
+		Metadata:     event.Metadata.Data(),
+		CreatedAt:    timestamppb.New(valueOrZero(event.CreatedAt)),
+	}
+
+	if event.ActorID != nil {
+		serialized.ActorId = event.ActorID.String()
+		if actor, ok := actors[serialized.ActorId]; ok {
+			serialized.ActorName = actor.Name
+			serialized.ActorEmail = actor.GetEmail()
+		}
+	}
+
+	if before, err := structpb.NewStruct(event.Before.Data()); err == nil {
+		serialized.Before = before
+	}
+
+	if after, err := structpb.NewStruct(event.After.Data()); err == nil {
+		serialized.After = after
+	}
+
+	return serialized
+}
+
+func valueOrZero(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return *t
+}
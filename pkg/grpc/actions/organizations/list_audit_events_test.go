@@ -0,0 +1,99 @@
+package organizations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/audit"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test__ListAuditEvents(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+
+	audit.Record(context.Background(), audit.Event{
+		OrganizationID: r.Organization.ID,
+		ActorID:        r.User.String(),
+		ResourceType:   "canvases",
+		ResourceID:     "canvas-1",
+		Action:         "update",
+		Before:         map[string]any{"name": "old"},
+		After:          map[string]any{"name": "new"},
+	})
+
+	audit.Record(context.Background(), audit.Event{
+		OrganizationID: r.Organization.ID,
+		ActorID:        r.User.String(),
+		ResourceType:   "secrets",
+		ResourceID:     "secret-1",
+		Action:         "delete",
+		Status:         codes.NotFound.String(),
+		Error:          "secret not found",
+	})
+
+	t.Run("returns events most recent first, with the actor", func(t *testing.T) {
+		resp, err := ListAuditEvents(orgID, &pb.ListAuditEventsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 2)
+		assert.False(t, resp.HasNextPage)
+
+		assert.Equal(t, "secrets", resp.Events[0].ResourceType)
+		assert.Equal(t, codes.NotFound.String(), resp.Events[0].Status)
+		assert.Equal(t, "secret not found", resp.Events[0].Error)
+
+		event := resp.Events[1]
+		assert.Equal(t, "canvases", event.ResourceType)
+		assert.Equal(t, "canvas-1", event.ResourceId)
+		assert.Equal(t, "update", event.Action)
+		assert.Equal(t, audit.StatusOK, event.Status)
+		assert.Equal(t, r.User.String(), event.ActorId)
+		assert.NotEmpty(t, event.ActorName)
+		assert.Equal(t, "old", event.Before.AsMap()["name"])
+		assert.Equal(t, "new", event.After.AsMap()["name"])
+	})
+
+	t.Run("events can be filtered", func(t *testing.T) {
+		resp, err := ListAuditEvents(orgID, &pb.ListAuditEventsRequest{ResourceType: "canvases"})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, "canvas-1", resp.Events[0].ResourceId)
+
+		resp, err = ListAuditEvents(orgID, &pb.ListAuditEventsRequest{Action: "delete"})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, "secret-1", resp.Events[0].ResourceId)
+
+		resp, err = ListAuditEvents(orgID, &pb.ListAuditEventsRequest{Since: timestamppb.New(time.Now().Add(time.Hour))})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Events)
+	})
+
+	t.Run("events are paginated", func(t *testing.T) {
+		resp, err := ListAuditEvents(orgID, &pb.ListAuditEventsRequest{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		assert.True(t, resp.HasNextPage)
+		require.NotNil(t, resp.LastTimestamp)
+
+		resp, err = ListAuditEvents(orgID, &pb.ListAuditEventsRequest{Limit: 1, Before: resp.LastTimestamp})
+		require.NoError(t, err)
+		require.Len(t, resp.Events, 1)
+		assert.Equal(t, "canvases", resp.Events[0].ResourceType)
+		assert.False(t, resp.HasNextPage)
+	})
+
+	t.Run("invalid actor id is rejected", func(t *testing.T) {
+		_, err := ListAuditEvents(orgID, &pb.ListAuditEventsRequest{ActorId: "not-a-uuid"})
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+}
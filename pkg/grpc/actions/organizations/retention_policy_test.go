@@ -0,0 +1,77 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__GetRetentionPolicy(t *testing.T) {
+	r := support.Setup(t)
+
+	t.Run("without policy, nothing is expired", func(t *testing.T) {
+		resp, err := GetRetentionPolicy(r.Organization.ID.String())
+		require.NoError(t, err)
+		require.NotNil(t, resp.RetentionPolicy)
+		assert.Zero(t, resp.RetentionPolicy.MaxAgeDays)
+		assert.Zero(t, resp.RetentionPolicy.MaxExecutionsPerNode)
+		assert.Zero(t, resp.RetentionPolicy.FailedMaxAgeDays)
+	})
+
+	t.Run("returns updated policy", func(t *testing.T) {
+		_, err := UpdateRetentionPolicy(r.Organization.ID.String(), &pb.UpdateRetentionPolicyRequest{
+			MaxAgeDays:           30,
+			MaxExecutionsPerNode: 100,
+			FailedMaxAgeDays:     90,
+		}, r.User.String())
+		require.NoError(t, err)
+
+		resp, err := GetRetentionPolicy(r.Organization.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, int32(30), resp.RetentionPolicy.MaxAgeDays)
+		assert.Equal(t, int32(100), resp.RetentionPolicy.MaxExecutionsPerNode)
+		assert.Equal(t, int32(90), resp.RetentionPolicy.FailedMaxAgeDays)
+		assert.Equal(t, r.User.String(), resp.RetentionPolicy.UpdatedBy)
+	})
+}
+
+func Test__UpdateRetentionPolicy(t *testing.T) {
+	r := support.Setup(t)
+
+	t.Run("failed executions cannot be kept for less than the max age", func(t *testing.T) {
+		_, err := UpdateRetentionPolicy(r.Organization.ID.String(), &pb.UpdateRetentionPolicyRequest{
+			MaxAgeDays:       30,
+			FailedMaxAgeDays: 7,
+		}, r.User.String())
+
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("negative values are rejected", func(t *testing.T) {
+		_, err := UpdateRetentionPolicy(r.Organization.ID.String(), &pb.UpdateRetentionPolicyRequest{
+			MaxExecutionsPerNode: -1,
+		}, r.User.String())
+
+		require.Error(t, err)
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("updating twice keeps a single policy", func(t *testing.T) {
+		_, err := UpdateRetentionPolicy(r.Organization.ID.String(), &pb.UpdateRetentionPolicyRequest{MaxAgeDays: 10}, r.User.String())
+		require.NoError(t, err)
+
+		resp, err := UpdateRetentionPolicy(r.Organization.ID.String(), &pb.UpdateRetentionPolicyRequest{MaxAgeDays: 20}, r.User.String())
+		require.NoError(t, err)
+		assert.Equal(t, int32(20), resp.RetentionPolicy.MaxAgeDays)
+	})
+}
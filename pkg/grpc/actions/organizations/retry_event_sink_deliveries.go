@@ -0,0 +1,37 @@
+package organizations
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func RetryEventSinkDeliveries(orgID string) (*pb.RetryEventSinkDeliveriesResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	sink, err := models.FindEventSink(organizationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "event sink not found")
+		}
+
+		return nil, status.Error(codes.Internal, "failed to find event sink")
+	}
+
+	requeued, err := models.RetryFailedEventSinkDeliveries(sink.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to retry event sink deliveries")
+	}
+
+	return &pb.RetryEventSinkDeliveriesResponse{
+		Requeued: requeued,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package organizations
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication/saml"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/test/support"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testBaseURL = "https://superplane.example.com"
+
+func Test__SAMLSettings(t *testing.T) {
+	r := support.Setup(t)
+	orgID := r.Organization.ID.String()
+	metadata := testIDPMetadata(t)
+
+	require.NoError(t, r.AuthService.CreateGroup(orgID, models.DomainTypeOrganization, "engineers", models.RoleOrgViewer, "Engineers", ""))
+
+	t.Run("without settings, only the service provider details are returned", func(t *testing.T) {
+		resp, err := GetSAMLSettings(testBaseURL, orgID)
+		require.NoError(t, err)
+		assert.False(t, resp.Settings.Enabled)
+		assert.Empty(t, resp.Settings.IdpEntityId)
+		assert.Equal(t, testBaseURL+"/auth/saml/"+orgID+"/metadata", resp.Settings.SpEntityId)
+		assert.Equal(t, testBaseURL+"/auth/saml/"+orgID+"/acs", resp.Settings.SpAcsUrl)
+		assert.Equal(t, "/auth/saml/"+orgID, resp.Settings.LoginUrl)
+	})
+
+	t.Run("metadata is required when configuring SAML", func(t *testing.T) {
+		_, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{Enabled: true}, r.User.String())
+		assertInvalidArgument(t, err, "identity provider metadata is required")
+	})
+
+	t.Run("invalid metadata is rejected", func(t *testing.T) {
+		_, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{
+			Enabled:        true,
+			IdpMetadataXml: "<foo/>",
+		}, r.User.String())
+
+		s, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
+
+	t.Run("enforcing requires SAML to be enabled", func(t *testing.T) {
+		_, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{
+			Enforced:       true,
+			IdpMetadataXml: metadata,
+		}, r.User.String())
+
+		assertInvalidArgument(t, err, "SAML single sign-on must be enabled to be enforced")
+	})
+
+	t.Run("group mappings must use existing groups", func(t *testing.T) {
+		_, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{
+			Enabled:        true,
+			IdpMetadataXml: metadata,
+			GroupMappings:  []*pb.SAMLSettings_GroupMapping{{IdpGroup: "eng", Group: "unknown"}},
+		}, r.User.String())
+
+		assertInvalidArgument(t, err, "group unknown not found")
+	})
+
+	t.Run("settings are created", func(t *testing.T) {
+		resp, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{
+			Enabled:         true,
+			Enforced:        true,
+			IdpMetadataXml:  metadata,
+			EmailAttribute:  "email",
+			GroupsAttribute: "groups",
+			GroupMappings:   []*pb.SAMLSettings_GroupMapping{{IdpGroup: "eng", Group: "engineers"}},
+		}, r.User.String())
+
+		require.NoError(t, err)
+		assert.True(t, resp.Settings.Enabled)
+		assert.True(t, resp.Settings.Enforced)
+		assert.Equal(t, "https://idp.example.com/metadata", resp.Settings.IdpEntityId)
+		assert.Equal(t, "https://idp.example.com/sso", resp.Settings.IdpSsoUrl)
+		assert.Equal(t, "email", resp.Settings.EmailAttribute)
+		assert.Empty(t, resp.Settings.NameAttribute)
+		require.Len(t, resp.Settings.GroupMappings, 1)
+		assert.Equal(t, "engineers", resp.Settings.GroupMappings[0].Group)
+		assert.Equal(t, r.User.String(), resp.Settings.UpdatedBy)
+
+		enforced, err := models.IsSAMLEnforced(r.Organization.ID)
+		require.NoError(t, err)
+		assert.True(t, enforced)
+	})
+
+	t.Run("metadata is kept when left empty on updates", func(t *testing.T) {
+		resp, err := UpdateSAMLSettings(r.AuthService, testBaseURL, orgID, &pb.UpdateSAMLSettingsRequest{
+			Enabled: true,
+		}, r.User.String())
+
+		require.NoError(t, err)
+		assert.False(t, resp.Settings.Enforced)
+		assert.Equal(t, "https://idp.example.com/metadata", resp.Settings.IdpEntityId)
+		assert.Equal(t, metadata, resp.Settings.IdpMetadataXml)
+		assert.Empty(t, resp.Settings.GroupMappings)
+
+		enforced, err := models.IsSAMLEnforced(r.Organization.ID)
+		require.NoError(t, err)
+		assert.False(t, enforced)
+	})
+}
+
+func assertInvalidArgument(t *testing.T, err error, message string) {
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, s.Code())
+	assert.Equal(t, message, s.Message())
+}
+
+func testIDPMetadata(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return `<md:EntityDescriptor xmlns:md="` + saml.NamespaceMetadata + `" entityID="https://idp.example.com/metadata">` +
+		`<md:IDPSSODescriptor protocolSupportEnumeration="` + saml.NamespaceProtocol + `">` +
+		`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="` + saml.NamespaceDSig + `"><ds:X509Data>` +
+		`<ds:X509Certificate>` + base64.StdEncoding.EncodeToString(der) + `</ds:X509Certificate>` +
+		`</ds:X509Data></ds:KeyInfo></md:KeyDescriptor>` +
+		`<md:SingleSignOnService Binding="` + saml.BindingHTTPRedirect + `" Location="https://idp.example.com/sso"/>` +
+		`</md:IDPSSODescriptor></md:EntityDescriptor>`
+}
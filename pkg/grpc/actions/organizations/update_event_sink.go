@@ -0,0 +1,125 @@
+package organizations
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const MinEventSinkSecretLength = 16
+
+func UpdateEventSink(
+	ctx context.Context,
+	encryptor crypto.Encryptor,
+	httpCtx *registry.HTTPContext,
+	orgID string,
+	req *pb.UpdateEventSinkRequest,
+	requesterUserID string,
+) (*pb.UpdateEventSinkResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := slices.Clone(req.Categories)
+	slices.Sort(categories)
+
+	now := time.Now()
+	sink := &models.EventSink{
+		OrganizationID: organizationID,
+		Type:           strings.TrimSpace(req.Type),
+		URL:            strings.TrimSpace(req.Url),
+		Categories:     datatypes.JSONSlice[string](slices.Compact(categories)),
+		Enabled:        req.Enabled,
+		UpdatedBy:      updatedBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := sink.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	//
+	// Hostnames are only resolved when events are sent,
+	// so this rejects blocked hosts and private IP addresses early,
+	// and the sender checks the resolved addresses again.
+	//
+	sinkURL, err := url.Parse(sink.URL)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid url")
+	}
+
+	if err := httpCtx.ValidateHost(sinkURL.Hostname()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url: %v", err)
+	}
+
+	secret := strings.TrimSpace(req.Secret)
+	if secret != "" {
+		if len(secret) < MinEventSinkSecretLength {
+			return nil, status.Errorf(codes.InvalidArgument, "secret must have at least %d characters", MinEventSinkSecretLength)
+		}
+
+		sink.SecretCiphertext, err = encryptor.Encrypt(ctx, []byte(secret), []byte(organizationID.String()))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to encrypt event sink secret")
+		}
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		existing, err := models.FindEventSinkInTransaction(tx, organizationID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.Internal, "failed to update event sink")
+		}
+
+		//
+		// Events are always signed, so the secret is only optional
+		// when one was already configured.
+		//
+		if sink.SecretCiphertext == nil {
+			if existing == nil {
+				return status.Error(codes.InvalidArgument, "secret is required")
+			}
+
+			sink.SecretCiphertext = existing.SecretCiphertext
+		}
+
+		if err := models.UpsertEventSinkInTransaction(tx, sink); err != nil {
+			return status.Error(codes.Internal, "failed to update event sink")
+		}
+
+		sink, err = models.FindEventSinkInTransaction(tx, organizationID)
+		if err != nil {
+			return status.Error(codes.Internal, "failed to update event sink")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateEventSinkResponse{
+		EventSink: serializeEventSink(sink),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package organizations
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func UpdateRetentionPolicy(
+	orgID string,
+	req *pb.UpdateRetentionPolicyRequest,
+	requesterUserID string,
+) (*pb.UpdateRetentionPolicyResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	policy := &models.RetentionPolicy{
+		OrganizationID:       organizationID,
+		MaxAgeDays:           int(req.MaxAgeDays),
+		MaxExecutionsPerNode: int(req.MaxExecutionsPerNode),
+		FailedMaxAgeDays:     int(req.FailedMaxAgeDays),
+		UpdatedBy:            updatedBy,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		if err := models.UpsertRetentionPolicyInTransaction(tx, policy); err != nil {
+			return status.Error(codes.Internal, "failed to update retention policy")
+		}
+
+		var txErr error
+		policy, txErr = models.FindOrganizationRetentionPolicyInTransaction(tx, organizationID)
+		if txErr != nil {
+			return status.Error(codes.Internal, "failed to update retention policy")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateRetentionPolicyResponse{
+		RetentionPolicy: serializeRetentionPolicy(policy),
+	}, nil
+}
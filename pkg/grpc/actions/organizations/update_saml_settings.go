@@ -0,0 +1,142 @@
+package organizations
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/authentication/saml"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func UpdateSAMLSettings(
+	authService authorization.Authorization,
+	baseURL string,
+	orgID string,
+	req *pb.UpdateSAMLSettingsRequest,
+	requesterUserID string,
+) (*pb.UpdateSAMLSettingsResponse, error) {
+	organizationID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	updatedBy, err := optionalUUID(requesterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Enforced && !req.Enabled {
+		return nil, status.Error(codes.InvalidArgument, "SAML single sign-on must be enabled to be enforced")
+	}
+
+	mappings, err := validateSAMLGroupMappings(authService, orgID, req.GroupMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	settings := &models.OrganizationSAMLSettings{
+		OrganizationID:  organizationID,
+		Enabled:         req.Enabled,
+		Enforced:        req.Enforced,
+		EmailAttribute:  optionalString(req.EmailAttribute),
+		NameAttribute:   optionalString(req.NameAttribute),
+		GroupsAttribute: optionalString(req.GroupsAttribute),
+		GroupMappings:   mappings,
+		UpdatedBy:       updatedBy,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	metadata := strings.TrimSpace(req.IdpMetadataXml)
+	if metadata != "" {
+		idp, err := saml.ParseMetadata([]byte(metadata))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid identity provider metadata: %v", err)
+		}
+
+		settings.IdpMetadata = metadata
+		settings.IdpEntityID = idp.EntityID
+		settings.IdpSSOURL = idp.SSOURL
+		settings.IdpCertificates = datatypes.JSONSlice[string](idp.Certificates)
+	}
+
+	err = database.Conn().Transaction(func(tx *gorm.DB) error {
+		var existing models.OrganizationSAMLSettings
+		err := tx.Where("organization_id = ?", organizationID).First(&existing).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.Internal, "failed to update SAML settings")
+		}
+
+		//
+		// The metadata is only optional when it was already uploaded,
+		// so the other settings can be changed without uploading it again.
+		//
+		if metadata == "" {
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "identity provider metadata is required")
+			}
+
+			settings.IdpMetadata = existing.IdpMetadata
+			settings.IdpEntityID = existing.IdpEntityID
+			settings.IdpSSOURL = existing.IdpSSOURL
+			settings.IdpCertificates = existing.IdpCertificates
+		}
+
+		if err := models.UpsertOrganizationSAMLSettingsInTransaction(tx, settings); err != nil {
+			return status.Error(codes.Internal, "failed to update SAML settings")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err = models.FindOrganizationSAMLSettings(organizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to update SAML settings")
+	}
+
+	return &pb.UpdateSAMLSettingsResponse{
+		Settings: serializeSAMLSettings(baseURL, orgID, settings),
+	}, nil
+}
+
+func validateSAMLGroupMappings(authService authorization.Authorization, orgID string, mappings []*pb.SAMLSettings_GroupMapping) (datatypes.JSONSlice[models.SAMLGroupMapping], error) {
+	result := datatypes.JSONSlice[models.SAMLGroupMapping]{}
+	for _, mapping := range mappings {
+		idpGroup := strings.TrimSpace(mapping.IdpGroup)
+		group := strings.TrimSpace(mapping.Group)
+		if idpGroup == "" || group == "" {
+			return nil, status.Error(codes.InvalidArgument, "group mappings need an IdP group and a group")
+		}
+
+		if _, err := authService.GetGroupRole(orgID, models.DomainTypeOrganization, group); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "group %s not found", group)
+		}
+
+		result = append(result, models.SAMLGroupMapping{IdpGroup: idpGroup, Group: group})
+	}
+
+	return result, nil
+}
+
+func optionalString(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -40,7 +40,7 @@ func CreateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType st
 		return nil, status.Error(codes.InvalidArgument, "invalid provider")
 	}
 
-	data, err := prepareSecretData(ctx, encryptor, spec)
+	data, err := prepareSecretData(ctx, encryptor, spec, nil)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -67,6 +67,8 @@ func protoToSecretProvider(provider pb.Secret_Provider) string {
 	switch provider {
 	case pb.Secret_PROVIDER_LOCAL:
 		return secrets.ProviderLocal
+	case pb.Secret_PROVIDER_VAULT:
+		return secrets.ProviderVault
 	default:
 		return ""
 	}
@@ -76,12 +78,14 @@ func secretProviderToProto(provider string) pb.Secret_Provider {
 	switch provider {
 	case secrets.ProviderLocal:
 		return pb.Secret_PROVIDER_LOCAL
+	case secrets.ProviderVault:
+		return pb.Secret_PROVIDER_VAULT
 	default:
 		return pb.Secret_PROVIDER_UNKNOWN
 	}
 }
 
-func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *pb.Secret) ([]byte, error) {
+func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *pb.Secret, existing *models.Secret) ([]byte, error) {
 	if secret.Spec == nil {
 		return nil, fmt.Errorf("missing secret spec")
 	}
@@ -103,13 +107,31 @@ func prepareSecretData(ctx context.Context, encryptor crypto.Encryptor, secret *
 
 		return encrypted, nil
 
+	case pb.Secret_PROVIDER_VAULT:
+		var existingConfig *secrets.VaultConfig
+		if existing != nil {
+			config, err := secrets.DecryptVaultConfig(ctx, encryptor, existing)
+			if err != nil {
+				return nil, err
+			}
+
+			existingConfig = config
+		}
+
+		return prepareVaultSecretData(ctx, encryptor, secret.Metadata.Name, secret.Spec.Vault, existingConfig)
+
 	default:
 		return nil, fmt.Errorf("provider not supported")
 	}
 }
 
 // decryptSecretData decrypts a secret's stored data and returns the key-value map.
+// Only local secrets have their keys managed by SuperPlane.
 func decryptSecretData(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (map[string]string, error) {
+	if secret.Provider != secrets.ProviderLocal {
+		return nil, status.Errorf(codes.FailedPrecondition, "keys of %s secrets cannot be managed by SuperPlane", secret.Provider)
+	}
+
 	data, err := encryptor.Decrypt(ctx, secret.Data, []byte(secret.Name))
 	if err != nil {
 		return nil, err
@@ -105,4 +105,57 @@ func Test__CreateSecret(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, s.Code())
 		assert.Equal(t, "name already used", s.Message())
 	})
+
+	t.Run("vault secret is created without returning credentials", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault: &protos.Secret_Vault{
+					Address:    "http://127.0.0.1:1",
+					Path:       "apps/api",
+					AuthMethod: protos.Secret_Vault_AUTH_METHOD_TOKEN,
+					Token:      "root",
+				},
+			},
+		}
+
+		response, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		require.NoError(t, err)
+		assert.Equal(t, protos.Secret_PROVIDER_VAULT, response.Secret.Spec.Provider)
+		require.NotNil(t, response.Secret.Spec.Vault)
+		assert.Equal(t, "secret", response.Secret.Spec.Vault.Mount)
+		assert.Equal(t, "apps/api", response.Secret.Spec.Vault.Path)
+		assert.Empty(t, response.Secret.Spec.Vault.Token)
+		assert.Empty(t, response.Secret.Spec.Vault.Keys)
+
+		_, err = SetSecretKey(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret.Metadata.Name, "key", "value")
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, s.Code())
+	})
+
+	t.Run("vault secret without credentials is rejected", func(t *testing.T) {
+		secret := &protos.Secret{
+			Metadata: &protos.Secret_Metadata{
+				Name: support.RandomName("secret"),
+			},
+			Spec: &protos.Secret_Spec{
+				Provider: protos.Secret_PROVIDER_VAULT,
+				Vault: &protos.Secret_Vault{
+					Address:    "http://127.0.0.1:1",
+					Path:       "apps/api",
+					AuthMethod: protos.Secret_Vault_AUTH_METHOD_APPROLE,
+					RoleId:     "role",
+				},
+			},
+		}
+
+		_, err := CreateSecret(ctx, encryptor, models.DomainTypeOrganization, r.Organization.ID.String(), secret)
+		s, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, s.Code())
+	})
 }
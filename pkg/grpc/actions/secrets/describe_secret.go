@@ -38,6 +38,12 @@ func DescribeSecret(ctx context.Context, encryptor crypto.Encryptor, domainType,
 }
 
 func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret) (*pb.Secret, error) {
+	return serializeSecretWithVaultKeys(ctx, encryptor, secret, true)
+}
+
+// serializeSecretWithVaultKeys serializes a secret, reading the keys
+// of Vault secrets from Vault only when vaultKeys is set.
+func serializeSecretWithVaultKeys(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret, vaultKeys bool) (*pb.Secret, error) {
 	s := &pb.Secret{
 		Metadata: &pb.Secret_Metadata{
 			Id:         secret.ID.String(),
@@ -61,6 +67,15 @@ func serializeSecret(ctx context.Context, encryptor crypto.Encryptor, secret mod
 		s.Spec.Local = local
 		return s, nil
 
+	case pb.Secret_PROVIDER_VAULT:
+		vault, err := serializeVaultSecretData(ctx, encryptor, secret, vaultKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		s.Spec.Vault = vault
+		return s, nil
+
 	default:
 		return s, nil
 	}
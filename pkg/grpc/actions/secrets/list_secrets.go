@@ -25,11 +25,13 @@ func ListSecrets(ctx context.Context, encryptor crypto.Encryptor, domainType, do
 	}, nil
 }
 
+// serializeSecrets does not read the keys of Vault secrets,
+// so listing secrets does not make a request to Vault for each of them.
 func serializeSecrets(ctx context.Context, encryptor crypto.Encryptor, secrets []models.Secret) ([]*pb.Secret, error) {
 	out := []*pb.Secret{}
 
 	for _, s := range secrets {
-		secret, err := serializeSecret(ctx, encryptor, s)
+		secret, err := serializeSecretWithVaultKeys(ctx, encryptor, s, false)
 		if err != nil {
 			return nil, err
 		}
@@ -3,6 +3,9 @@ package secrets
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/google/uuid"
@@ -45,4 +48,39 @@ func Test__ListSecrets(t *testing.T) {
 		require.NotNil(t, secret.Spec.Local)
 		require.Equal(t, map[string]string{"test": "***"}, secret.Spec.Local.Data)
 	})
+
+	t.Run("vault secret keys are not read from vault", func(t *testing.T) {
+		requests := atomic.Int32{}
+		vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer vault.Close()
+
+		secrets.DefaultVaultClient.UseHTTPContext(vault.Client())
+		defer secrets.DefaultVaultClient.UseHTTPContext(nil)
+
+		data, err := json.Marshal(secrets.VaultConfig{
+			Address: vault.URL,
+			Path:    "apps/api",
+			Auth:    secrets.VaultAuth{Method: secrets.VaultAuthMethodToken, Token: "root"},
+		})
+		require.NoError(t, err)
+
+		_, err = models.CreateSecret("vault", secrets.ProviderVault, uuid.NewString(), models.DomainTypeOrganization, r.Organization.ID, data)
+		require.NoError(t, err)
+
+		response, err := ListSecrets(context.Background(), encryptor, models.DomainTypeOrganization, r.Organization.ID.String())
+		require.NoError(t, err)
+		require.Len(t, response.Secrets, 2)
+
+		for _, secret := range response.Secrets {
+			if secret.Spec.Provider == protos.Secret_PROVIDER_VAULT {
+				assert.Equal(t, vault.URL, secret.Spec.Vault.Address)
+				assert.Empty(t, secret.Spec.Vault.Keys)
+			}
+		}
+
+		assert.Equal(t, int32(0), requests.Load())
+	})
 }
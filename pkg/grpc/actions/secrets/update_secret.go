@@ -42,9 +42,9 @@ func UpdateSecret(ctx context.Context, encryptor crypto.Encryptor, domainType, d
 		return nil, status.Error(codes.InvalidArgument, "cannot update provider")
 	}
 
-	data, err := prepareSecretData(ctx, encryptor, spec)
+	data, err := prepareSecretData(ctx, encryptor, spec, secret)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	secret, err = secret.UpdateData(data)
@@ -0,0 +1,121 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/secrets"
+	"github.com/superplanehq/superplane/pkg/secrets"
+)
+
+// prepareVaultSecretData builds the encrypted Vault configuration for a secret.
+// Credentials are write-only, so the ones from the existing configuration
+// are kept when an update does not include them.
+func prepareVaultSecretData(ctx context.Context, encryptor crypto.Encryptor, name string, spec *pb.Secret_Vault, existing *secrets.VaultConfig) ([]byte, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("missing vault configuration")
+	}
+
+	config := secrets.VaultConfig{
+		Address:   spec.Address,
+		Namespace: spec.Namespace,
+		Mount:     spec.Mount,
+		Path:      spec.Path,
+		Auth: secrets.VaultAuth{
+			Method:   protoToVaultAuthMethod(spec.AuthMethod),
+			Token:    spec.Token,
+			Mount:    spec.ApproleMount,
+			RoleID:   spec.RoleId,
+			SecretID: spec.SecretId,
+		},
+	}
+
+	if existing != nil && existing.Auth.Method == config.Auth.Method {
+		if config.Auth.Token == "" {
+			config.Auth.Token = existing.Auth.Token
+		}
+
+		if config.Auth.SecretID == "" {
+			config.Auth.SecretID = existing.Auth.SecretID
+		}
+	}
+
+	config = config.WithDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptor.Encrypt(ctx, data, []byte(name))
+}
+
+func serializeVaultSecretData(ctx context.Context, encryptor crypto.Encryptor, secret models.Secret, withKeys bool) (*pb.Secret_Vault, error) {
+	config, err := secrets.DecryptVaultConfig(ctx, encryptor, &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	vault := &pb.Secret_Vault{
+		Address:      config.Address,
+		Namespace:    config.Namespace,
+		Mount:        config.Mount,
+		Path:         config.Path,
+		AuthMethod:   vaultAuthMethodToProto(config.Auth.Method),
+		ApproleMount: config.Auth.Mount,
+		RoleId:       config.Auth.RoleID,
+		Keys:         []string{},
+	}
+
+	if !withKeys {
+		return vault, nil
+	}
+
+	//
+	// The keys are read from Vault, so they can be picked
+	// in secret key fields, but Vault being unavailable
+	// should not prevent the secret from being shown.
+	//
+	values, err := secrets.NewVaultProvider(encryptor, &secret).Load(ctx)
+	if err != nil {
+		log.Warnf("failed to read keys for secret %s: %v", secret.Name, err)
+		return vault, nil
+	}
+
+	for k := range values {
+		vault.Keys = append(vault.Keys, k)
+	}
+
+	sort.Strings(vault.Keys)
+	return vault, nil
+}
+
+func protoToVaultAuthMethod(method pb.Secret_Vault_AuthMethod) string {
+	switch method {
+	case pb.Secret_Vault_AUTH_METHOD_TOKEN:
+		return secrets.VaultAuthMethodToken
+	case pb.Secret_Vault_AUTH_METHOD_APPROLE:
+		return secrets.VaultAuthMethodAppRole
+	default:
+		return ""
+	}
+}
+
+func vaultAuthMethodToProto(method string) pb.Secret_Vault_AuthMethod {
+	switch method {
+	case secrets.VaultAuthMethodToken:
+		return pb.Secret_Vault_AUTH_METHOD_TOKEN
+	case secrets.VaultAuthMethodAppRole:
+		return pb.Secret_Vault_AUTH_METHOD_APPROLE
+	default:
+		return pb.Secret_Vault_AUTH_METHOD_UNSPECIFIED
+	}
+}
@@ -26,7 +26,7 @@ func (s *BlueprintService) ListBlueprints(ctx context.Context, req *pb.ListBluep
 
 func (s *BlueprintService) DescribeBlueprint(ctx context.Context, req *pb.DescribeBlueprintRequest) (*pb.DescribeBlueprintResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
-	return blueprints.DescribeBlueprint(ctx, s.registry, organizationID, req.Id)
+	return blueprints.DescribeBlueprint(ctx, s.registry, organizationID, req.Id, req.Version, req.Draft)
 }
 
 func (s *BlueprintService) CreateBlueprint(ctx context.Context, req *pb.CreateBlueprintRequest) (*pb.CreateBlueprintResponse, error) {
@@ -49,3 +49,23 @@ func (s *BlueprintService) DeleteBlueprint(ctx context.Context, req *pb.DeleteBl
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
 	return blueprints.DeleteBlueprint(ctx, organizationID, req.Id)
 }
+
+func (s *BlueprintService) PublishBlueprint(ctx context.Context, req *pb.PublishBlueprintRequest) (*pb.PublishBlueprintResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return blueprints.PublishBlueprint(ctx, organizationID, req.Id)
+}
+
+func (s *BlueprintService) DiscardBlueprintDraft(ctx context.Context, req *pb.DiscardBlueprintDraftRequest) (*pb.DiscardBlueprintDraftResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return blueprints.DiscardBlueprintDraft(ctx, organizationID, req.Id)
+}
+
+func (s *BlueprintService) ListBlueprintVersions(ctx context.Context, req *pb.ListBlueprintVersionsRequest) (*pb.ListBlueprintVersionsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return blueprints.ListBlueprintVersions(ctx, organizationID, req.Id)
+}
+
+func (s *BlueprintService) DiffBlueprintVersions(ctx context.Context, req *pb.DiffBlueprintVersionsRequest) (*pb.DiffBlueprintVersionsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return blueprints.DiffBlueprintVersions(ctx, organizationID, req.Id, req.FromVersion, req.ToVersion)
+}
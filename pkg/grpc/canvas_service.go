@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"github.com/superplanehq/superplane/pkg/authorization"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
@@ -50,6 +51,7 @@ func (s *CanvasService) UpdateCanvas(ctx context.Context, req *pb.UpdateCanvasRe
 		req.Description,
 		req.CanvasVersioningEnabled,
 		req.ChangeRequestApprovalConfig,
+		req.Environment,
 	)
 }
 
@@ -160,7 +162,16 @@ func (s *CanvasService) ResolveCanvasChangeRequest(ctx context.Context, req *pb.
 
 func (s *CanvasService) DeleteCanvas(ctx context.Context, req *pb.DeleteCanvasRequest) (*pb.DeleteCanvasResponse, error) {
 	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
-	return canvases.DeleteCanvas(ctx, s.registry, uuid.MustParse(organizationID), req.Id)
+	response, err := canvases.DeleteCanvas(ctx, s.registry, uuid.MustParse(organizationID), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.authService.DestroyCanvas(req.Id); err != nil {
+		log.Errorf("failed to remove role bindings of canvas %s: %v", req.Id, err)
+	}
+
+	return response, nil
 }
 
 func (s *CanvasService) ListNodeQueueItems(ctx context.Context, req *pb.ListNodeQueueItemsRequest) (*pb.ListNodeQueueItemsResponse, error) {
@@ -293,10 +304,92 @@ func (s *CanvasService) DeleteCanvasMemory(ctx context.Context, req *pb.DeleteCa
 	return canvases.DeleteCanvasMemory(ctx, s.registry, organizationID, req.CanvasId, req.MemoryId)
 }
 
+func (s *CanvasService) ListCanvasMemoryNamespaces(ctx context.Context, req *pb.ListCanvasMemoryNamespacesRequest) (*pb.ListCanvasMemoryNamespacesResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasMemoryNamespaces(ctx, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) UpdateCanvasMemoryNamespace(ctx context.Context, req *pb.UpdateCanvasMemoryNamespaceRequest) (*pb.UpdateCanvasMemoryNamespaceResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasMemoryNamespace(ctx, organizationID, req.CanvasId, req.Namespace, req.DefaultTtlSeconds, req.Schema)
+}
+
+func (s *CanvasService) UpdateCanvasRetentionPolicy(ctx context.Context, req *pb.UpdateCanvasRetentionPolicyRequest) (*pb.UpdateCanvasRetentionPolicyResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.UpdateCanvasRetentionPolicy(ctx, organizationID, req.CanvasId, req.RetentionPolicy)
+}
+
+func (s *CanvasService) GetCanvasRetentionReport(ctx context.Context, req *pb.GetCanvasRetentionReportRequest) (*pb.GetCanvasRetentionReportResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.GetCanvasRetentionReport(ctx, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) ListCanvasRoleBindings(ctx context.Context, req *pb.ListCanvasRoleBindingsRequest) (*pb.ListCanvasRoleBindingsResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ListCanvasRoleBindings(ctx, s.authService, organizationID, req.CanvasId)
+}
+
+func (s *CanvasService) AssignCanvasRole(ctx context.Context, req *pb.AssignCanvasRoleRequest) (*pb.AssignCanvasRoleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.AssignCanvasRole(ctx, s.authService, organizationID, req.CanvasId, req.SubjectType, req.SubjectId, req.Role)
+}
+
+func (s *CanvasService) RemoveCanvasRole(ctx context.Context, req *pb.RemoveCanvasRoleRequest) (*pb.RemoveCanvasRoleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.RemoveCanvasRole(ctx, s.authService, organizationID, req.CanvasId, req.SubjectType, req.SubjectId)
+}
+
 func (s *CanvasService) ListEventExecutions(ctx context.Context, req *pb.ListEventExecutionsRequest) (*pb.ListEventExecutionsResponse, error) {
 	return canvases.ListEventExecutions(ctx, s.registry, req.CanvasId, req.EventId)
 }
 
+func (s *CanvasService) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	return canvases.ListDeadLetters(ctx, s.registry, canvasID, req.Limit, req.Before)
+}
+
+func (s *CanvasService) ReplayDeadLetter(ctx context.Context, req *pb.ReplayDeadLetterRequest) (*pb.ReplayDeadLetterResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	return canvases.ReplayDeadLetter(ctx, s.registry, canvasID, req.Id)
+}
+
+func (s *CanvasService) ReplayCanvasEvent(ctx context.Context, req *pb.ReplayCanvasEventRequest) (*pb.ReplayCanvasEventResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	eventID, err := uuid.Parse(req.EventId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid event_id")
+	}
+
+	return canvases.ReplayCanvasEvent(ctx, s.registry, canvasID, eventID, req.NodeId)
+}
+
+func (s *CanvasService) EvaluateExpression(ctx context.Context, req *pb.EvaluateExpressionRequest) (*pb.EvaluateExpressionResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.EvaluateExpression(ctx, s.registry, organizationID, req)
+}
+
+func (s *CanvasService) ExportCanvasBundle(ctx context.Context, req *pb.ExportCanvasBundleRequest) (*pb.ExportCanvasBundleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ExportCanvasBundle(ctx, s.registry, organizationID, req.Id)
+}
+
+func (s *CanvasService) ImportCanvasBundle(ctx context.Context, req *pb.ImportCanvasBundleRequest) (*pb.ImportCanvasBundleResponse, error) {
+	organizationID := ctx.Value(authorization.OrganizationContextKey).(string)
+	return canvases.ImportCanvasBundle(ctx, s.authService, s.registry, organizationID, req)
+}
+
 func (s *CanvasService) ListChildExecutions(ctx context.Context, req *pb.ListChildExecutionsRequest) (*pb.ListChildExecutionsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
@@ -327,6 +420,34 @@ func (s *CanvasService) CancelExecution(ctx context.Context, req *pb.CancelExecu
 	return canvases.CancelExecution(ctx, s.authService, s.encryptor, organizationID, s.registry, canvasID, executionID)
 }
 
+func (s *CanvasService) RerunExecution(ctx context.Context, req *pb.RerunExecutionRequest) (*pb.RerunExecutionResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.RerunExecution(ctx, canvasID, executionID)
+}
+
+func (s *CanvasService) GetExecutionLogs(ctx context.Context, req *pb.GetExecutionLogsRequest) (*pb.GetExecutionLogsResponse, error) {
+	canvasID, err := uuid.Parse(req.CanvasId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid workflow_id")
+	}
+
+	executionID, err := uuid.Parse(req.ExecutionId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid execution_id")
+	}
+
+	return canvases.GetExecutionLogs(ctx, canvasID, executionID, req.Limit, req.After)
+}
+
 func (s *CanvasService) ResolveExecutionErrors(ctx context.Context, req *pb.ResolveExecutionErrorsRequest) (*pb.ResolveExecutionErrorsResponse, error) {
 	canvasID, err := uuid.Parse(req.CanvasId)
 	if err != nil {
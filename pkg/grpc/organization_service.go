@@ -112,6 +112,90 @@ func (s *OrganizationService) UpdateAgentSettings(
 	return organizations.UpdateAgentSettings(orgID, req.AgentModeEnabled, userID)
 }
 
+func (s *OrganizationService) GetRetentionPolicy(
+	ctx context.Context,
+	req *pb.GetRetentionPolicyRequest,
+) (*pb.GetRetentionPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetRetentionPolicy(orgID)
+}
+
+func (s *OrganizationService) UpdateRetentionPolicy(
+	ctx context.Context,
+	req *pb.UpdateRetentionPolicyRequest,
+) (*pb.UpdateRetentionPolicyResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateRetentionPolicy(orgID, req, userID)
+}
+
+func (s *OrganizationService) ListAuditEvents(
+	ctx context.Context,
+	req *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.ListAuditEvents(orgID, req)
+}
+
+func (s *OrganizationService) GetEventSink(
+	ctx context.Context,
+	req *pb.GetEventSinkRequest,
+) (*pb.GetEventSinkResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetEventSink(orgID)
+}
+
+func (s *OrganizationService) UpdateEventSink(
+	ctx context.Context,
+	req *pb.UpdateEventSinkRequest,
+) (*pb.UpdateEventSinkResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateEventSink(ctx, s.encryptor, s.registry.HTTPContext(), orgID, req, userID)
+}
+
+func (s *OrganizationService) DeleteEventSink(
+	ctx context.Context,
+	req *pb.DeleteEventSinkRequest,
+) (*pb.DeleteEventSinkResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.DeleteEventSink(orgID)
+}
+
+func (s *OrganizationService) RetryEventSinkDeliveries(
+	ctx context.Context,
+	req *pb.RetryEventSinkDeliveriesRequest,
+) (*pb.RetryEventSinkDeliveriesResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.RetryEventSinkDeliveries(orgID)
+}
+
+func (s *OrganizationService) GetSAMLSettings(
+	ctx context.Context,
+	req *pb.GetSAMLSettingsRequest,
+) (*pb.GetSAMLSettingsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	return organizations.GetSAMLSettings(s.baseURL, orgID)
+}
+
+func (s *OrganizationService) UpdateSAMLSettings(
+	ctx context.Context,
+	req *pb.UpdateSAMLSettingsRequest,
+) (*pb.UpdateSAMLSettingsResponse, error) {
+	orgID := ctx.Value(authorization.DomainIdContextKey).(string)
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return organizations.UpdateSAMLSettings(s.authorizationService, s.baseURL, orgID, req, userID)
+}
+
 func (s *OrganizationService) SetAgentOpenAIKey(
 	ctx context.Context,
 	req *pb.SetAgentOpenAIKeyRequest,
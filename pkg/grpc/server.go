@@ -72,7 +72,7 @@ func RunServer(baseURL, webhooksBaseURL, basePath string, encryptor crypto.Encry
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(opts...),
-			authorization.NewAuthorizationInterceptor(authService).UnaryInterceptor(),
+			authorization.NewAuthorizationInterceptor(authService, registry).UnaryInterceptor(),
 			sanitizeErrorUnaryInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
@@ -245,6 +245,8 @@ func (e *ExecuteCommand) Execute(ctx core.ExecutionContext) error {
 		return fmt.Errorf("failed to execute command: %v", err)
 	}
 
+	ctx.Logs.Info(fmt.Sprintf("Running command in sandbox %s", spec.Sandbox), map[string]any{"cmdId": response.CmdID})
+
 	timeout := spec.Timeout
 	if timeout == 0 {
 		timeout = 60
@@ -297,6 +299,7 @@ func (e *ExecuteCommand) poll(ctx core.ActionContext) error {
 	}
 
 	if time.Now().Unix()-metadata.StartedAt > int64(metadata.Timeout) {
+		ctx.Logs.Error(fmt.Sprintf("Command timed out after %d seconds", metadata.Timeout), nil)
 		return ctx.ExecutionState.Emit(ExecuteCommandOutputChannelFailed, ExecuteCommandPayloadType, []any{map[string]any{
 			"exitCode": nil,
 			"timeout":  true,
@@ -324,6 +327,18 @@ func (e *ExecuteCommand) poll(ctx core.ActionContext) error {
 		logs = ""
 	}
 
+	for _, line := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+		if line != "" {
+			ctx.Logs.Info(line, nil)
+		}
+	}
+
+	if *cmd.ExitCode == 0 {
+		ctx.Logs.Info("Command exited with code 0", map[string]any{"exitCode": *cmd.ExitCode})
+	} else {
+		ctx.Logs.Error(fmt.Sprintf("Command exited with code %d", *cmd.ExitCode), map[string]any{"exitCode": *cmd.ExitCode})
+	}
+
 	result := &ExecuteCommandResponse{
 		ExitCode: *cmd.ExitCode,
 		Timeout:  false,
@@ -138,6 +138,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 			HTTP:           httpContext,
 			Integration:    appCtx,
 			ExecutionState: execCtx,
+			Logs:           &contexts.ExecutionLogContext{},
 			Metadata:       metadataCtx,
 			Requests:       requestCtx,
 		})
@@ -183,6 +184,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 			HTTP:           httpContext,
 			Integration:    appCtx,
 			ExecutionState: execCtx,
+			Logs:           &contexts.ExecutionLogContext{},
 			Metadata:       metadataCtx,
 			Requests:       requestCtx,
 		})
@@ -223,6 +225,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 			HTTP:           httpContext,
 			Integration:    appCtx,
 			ExecutionState: &contexts.ExecutionStateContext{},
+			Logs:           &contexts.ExecutionLogContext{},
 			Metadata:       &contexts.MetadataContext{},
 			Requests:       &contexts.RequestContext{},
 		})
@@ -261,6 +264,7 @@ func Test__ExecuteCommand__Execute(t *testing.T) {
 			HTTP:           httpContext,
 			Integration:    appCtx,
 			ExecutionState: &contexts.ExecutionStateContext{},
+			Logs:           &contexts.ExecutionLogContext{},
 			Metadata:       &contexts.MetadataContext{},
 			Requests:       &contexts.RequestContext{},
 		})
@@ -310,6 +314,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 				},
 			},
 			ExecutionState: &contexts.ExecutionStateContext{},
+			Logs:           &contexts.ExecutionLogContext{},
 			Requests:       requestCtx,
 		})
 
@@ -338,6 +343,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 		}
 
 		execCtx := &contexts.ExecutionStateContext{}
+		logsCtx := &contexts.ExecutionLogContext{}
 		err := component.HandleAction(core.ActionContext{
 			Name: "poll",
 			HTTP: httpContext,
@@ -351,6 +357,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 				},
 			},
 			ExecutionState: execCtx,
+			Logs:           logsCtx,
 			Requests:       &contexts.RequestContext{},
 			Integration:    appCtx,
 		})
@@ -368,6 +375,9 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 		assert.Equal(t, 0, data.ExitCode)
 		assert.False(t, data.Timeout)
 		assert.Equal(t, "hello world", data.Result)
+		require.Len(t, logsCtx.Lines, 2)
+		assert.Equal(t, "hello world", logsCtx.Lines[0].Message)
+		assert.Equal(t, "Command exited with code 0", logsCtx.Lines[1].Message)
 	})
 
 	t.Run("poll emits failed channel when command exits non-zero", func(t *testing.T) {
@@ -400,6 +410,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 				},
 			},
 			ExecutionState: execCtx,
+			Logs:           &contexts.ExecutionLogContext{},
 			Requests:       &contexts.RequestContext{},
 			Integration:    appCtx,
 		})
@@ -440,6 +451,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 				},
 			},
 			ExecutionState: execCtx,
+			Logs:           &contexts.ExecutionLogContext{},
 			Requests:       &contexts.RequestContext{},
 			Integration:    appCtx,
 		})
@@ -487,6 +499,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 				},
 			},
 			ExecutionState: &contexts.ExecutionStateContext{},
+			Logs:           &contexts.ExecutionLogContext{},
 			Requests:       requestCtx,
 			Integration:    appCtx,
 		})
@@ -499,6 +512,7 @@ func Test__ExecuteCommand__HandleAction(t *testing.T) {
 		err := component.HandleAction(core.ActionContext{
 			Name:           "poll",
 			ExecutionState: &contexts.ExecutionStateContext{Finished: true},
+			Logs:           &contexts.ExecutionLogContext{},
 		})
 
 		require.NoError(t, err)
@@ -1,6 +1,9 @@
 package jwt
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"time"
@@ -16,14 +19,33 @@ func NewSigner(secret string) *Signer {
 	return &Signer{Secret: secret}
 }
 
+// Derive returns a signer with a key derived from this one for a purpose,
+// so tokens issued for that purpose are not accepted as any other token.
+func (s *Signer) Derive(purpose string) *Signer {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(purpose))
+	return &Signer{Secret: hex.EncodeToString(mac.Sum(nil))}
+}
+
 func (s *Signer) Generate(subject string, duration time.Duration) (string, error) {
+	return s.GenerateWithClaims(subject, duration, nil)
+}
+
+// GenerateWithClaims generates a token with additional claims.
+// The registered claims always take precedence over the additional ones.
+func (s *Signer) GenerateWithClaims(subject string, duration time.Duration, claims map[string]any) (string, error) {
 	now := time.Now()
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iat": now.Unix(),
-		"nbf": now.Unix(),
-		"exp": now.Add(duration).Unix(),
-		"sub": subject,
-	})
+	tokenClaims := jwt.MapClaims{}
+	for key, value := range claims {
+		tokenClaims[key] = value
+	}
+
+	tokenClaims["iat"] = now.Unix()
+	tokenClaims["nbf"] = now.Unix()
+	tokenClaims["exp"] = now.Add(duration).Unix()
+	tokenClaims["sub"] = subject
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims)
 
 	tokenString, err := token.SignedString([]byte(s.Secret))
 	if err != nil {
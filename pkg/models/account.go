@@ -43,9 +43,13 @@ func FindAccountByID(id string) (*Account, error) {
 }
 
 func FindAccountByEmail(email string) (*Account, error) {
+	return FindAccountByEmailInTransaction(database.Conn(), email)
+}
+
+func FindAccountByEmailInTransaction(tx *gorm.DB, email string) (*Account, error) {
 	var account Account
 
-	err := database.Conn().
+	err := tx.
 		Where("email = ?", utils.NormalizeEmail(email)).
 		First(&account).
 		Error
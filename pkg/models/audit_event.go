@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+//
+// AuditEvent records a change made in an organization,
+// through the API or by acting on an execution.
+//
+// Audit events are append-only: they are never updated,
+// and only deleted together with their organization.
+// Before and After only hold the fields which changed.
+//
+
+type AuditEvent struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID
+	ActorID        *uuid.UUID
+	ResourceType   string
+	ResourceID     string
+	Action         string
+	Method         string
+	Status         string
+	Error          string
+	Before         datatypes.JSONType[map[string]any]
+	After          datatypes.JSONType[map[string]any]
+	Metadata       datatypes.JSONType[map[string]string]
+	CreatedAt      *time.Time
+}
+
+type AuditEventFilters struct {
+	ActorID      *uuid.UUID
+	ResourceType string
+	ResourceID   string
+	Action       string
+	Since        *time.Time
+	Before       *time.Time
+}
+
+func CreateAuditEvent(event *AuditEvent) error {
+	return CreateAuditEventInTransaction(database.Conn(), event)
+}
+
+func CreateAuditEventInTransaction(tx *gorm.DB, event *AuditEvent) error {
+	if event.CreatedAt == nil {
+		now := time.Now()
+		event.CreatedAt = &now
+	}
+
+	return tx.Create(event).Error
+}
+
+func FindAuditEvent(orgID, id uuid.UUID) (*AuditEvent, error) {
+	var event AuditEvent
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("id = ?", id).
+		First(&event).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func ListAuditEvents(orgID uuid.UUID, filters AuditEventFilters, limit int) ([]AuditEvent, error) {
+	var events []AuditEvent
+	query := database.Conn().
+		Where("organization_id = ?", orgID)
+
+	if filters.ActorID != nil {
+		query = query.Where("actor_id = ?", *filters.ActorID)
+	}
+
+	if filters.ResourceType != "" {
+		query = query.Where("resource_type = ?", filters.ResourceType)
+	}
+
+	if filters.ResourceID != "" {
+		query = query.Where("resource_id = ?", filters.ResourceID)
+	}
+
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+
+	if filters.Since != nil {
+		query = query.Where("created_at >= ?", filters.Since)
+	}
+
+	if filters.Before != nil {
+		query = query.Where("created_at < ?", filters.Before)
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Order("created_at DESC").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
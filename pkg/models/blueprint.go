@@ -25,6 +25,10 @@ type Blueprint struct {
 	Edges          datatypes.JSONSlice[Edge]
 	Configuration  datatypes.JSONSlice[configuration.Field]
 	OutputChannels datatypes.JSONSlice[BlueprintOutputChannel]
+
+	// Version is the published version of the content above.
+	// Older versions are kept in BlueprintVersion.
+	Version int
 }
 
 type BlueprintOutputChannel struct {
@@ -71,8 +75,12 @@ func (b *Blueprint) FindRootNode() *Node {
 }
 
 func FindBlueprint(orgID, id string) (*Blueprint, error) {
+	return FindBlueprintInTransaction(database.Conn(), orgID, id)
+}
+
+func FindBlueprintInTransaction(tx *gorm.DB, orgID, id string) (*Blueprint, error) {
 	var blueprint Blueprint
-	err := database.Conn().
+	err := tx.
 		Where("organization_id = ?", orgID).
 		Where("id = ?", id).
 		First(&blueprint).
@@ -118,6 +126,27 @@ func FindUnscopedBlueprintInTransaction(tx *gorm.DB, id string) (*Blueprint, err
 	return &blueprint, nil
 }
 
+// FindLatestBlueprintVersions returns the latest published version of each blueprint, by ID.
+func FindLatestBlueprintVersions(ids []string) (map[string]int, error) {
+	var blueprints []Blueprint
+	err := database.Conn().
+		Select("id", "version").
+		Where("id IN ?", ids).
+		Find(&blueprints).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]int, len(blueprints))
+	for _, blueprint := range blueprints {
+		versions[blueprint.ID.String()] = blueprint.Version
+	}
+
+	return versions, nil
+}
+
 type Node struct {
 	ID             string         `json:"id"`
 	Name           string         `json:"name"`
@@ -130,6 +159,10 @@ type Node struct {
 	IntegrationID  *string        `json:"integrationId,omitempty"`
 	ErrorMessage   *string        `json:"errorMessage,omitempty"`
 	WarningMessage *string        `json:"warningMessage,omitempty"`
+	RetryPolicy    *RetryPolicy   `json:"retryPolicy,omitempty"`
+	TimeoutSeconds int            `json:"timeoutSeconds,omitempty"`
+	MaxConcurrency int            `json:"maxConcurrency,omitempty"`
+	QueuePolicy    string         `json:"queuePolicy,omitempty"`
 }
 
 type Position struct {
@@ -156,8 +189,11 @@ type WidgetRef struct {
 	Name string `json:"name"`
 }
 
+// BlueprintRef pins a canvas node to a published version of a blueprint.
+// A zero version follows the latest published version.
 type BlueprintRef struct {
-	ID string `json:"id"`
+	ID      string `json:"id"`
+	Version int    `json:"version,omitempty"`
 }
 
 type Edge struct {
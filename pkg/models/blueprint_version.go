@@ -0,0 +1,328 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrBlueprintDraftNotFound = errors.New("blueprint draft not found")
+
+// BlueprintVersion is a snapshot of the content of a blueprint.
+// Published versions are immutable and numbered from 1.
+// The draft is the only unpublished version of a blueprint, with version 0.
+type BlueprintVersion struct {
+	ID             uuid.UUID
+	BlueprintID    uuid.UUID
+	Version        int
+	OwnerID        *uuid.UUID
+	IsPublished    bool
+	PublishedAt    *time.Time
+	Nodes          datatypes.JSONSlice[Node]
+	Edges          datatypes.JSONSlice[Edge]
+	Configuration  datatypes.JSONSlice[configuration.Field]
+	OutputChannels datatypes.JSONSlice[BlueprintOutputChannel]
+	CreatedAt      *time.Time
+	UpdatedAt      *time.Time
+}
+
+// BlueprintContent is the part of a blueprint that is versioned.
+// Name, description, icon and color are not, since they do not change
+// how the blueprint runs in the canvases using it.
+type BlueprintContent struct {
+	Nodes          []Node
+	Edges          []Edge
+	Configuration  []configuration.Field
+	OutputChannels []BlueprintOutputChannel
+}
+
+func (v *BlueprintVersion) Content() BlueprintContent {
+	return BlueprintContent{
+		Nodes:          v.Nodes,
+		Edges:          v.Edges,
+		Configuration:  v.Configuration,
+		OutputChannels: v.OutputChannels,
+	}
+}
+
+func (v *BlueprintVersion) setContent(content BlueprintContent) {
+	v.Nodes = datatypes.NewJSONSlice(content.Nodes)
+	v.Edges = datatypes.NewJSONSlice(content.Edges)
+	v.Configuration = datatypes.NewJSONSlice(content.Configuration)
+	v.OutputChannels = datatypes.NewJSONSlice(content.OutputChannels)
+}
+
+func (b *Blueprint) Content() BlueprintContent {
+	return BlueprintContent{
+		Nodes:          b.Nodes,
+		Edges:          b.Edges,
+		Configuration:  b.Configuration,
+		OutputChannels: b.OutputChannels,
+	}
+}
+
+// WithVersion returns a copy of the blueprint with the content of a version.
+func (b *Blueprint) WithVersion(version *BlueprintVersion) *Blueprint {
+	pinned := *b
+	pinned.Version = version.Version
+	pinned.Nodes = version.Nodes
+	pinned.Edges = version.Edges
+	pinned.Configuration = version.Configuration
+	pinned.OutputChannels = version.OutputChannels
+	return &pinned
+}
+
+func FindBlueprintVersionInTransaction(tx *gorm.DB, blueprintID uuid.UUID, version int) (*BlueprintVersion, error) {
+	var blueprintVersion BlueprintVersion
+	err := tx.
+		Where("blueprint_id = ?", blueprintID).
+		Where("version = ?", version).
+		Where("is_published = ?", true).
+		First(&blueprintVersion).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &blueprintVersion, nil
+}
+
+func FindBlueprintVersion(blueprintID uuid.UUID, version int) (*BlueprintVersion, error) {
+	return FindBlueprintVersionInTransaction(database.Conn(), blueprintID, version)
+}
+
+func FindBlueprintDraftInTransaction(tx *gorm.DB, blueprintID uuid.UUID) (*BlueprintVersion, error) {
+	var draft BlueprintVersion
+	err := tx.
+		Where("blueprint_id = ?", blueprintID).
+		Where("is_published = ?", false).
+		First(&draft).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+func FindBlueprintDraft(blueprintID uuid.UUID) (*BlueprintVersion, error) {
+	return FindBlueprintDraftInTransaction(database.Conn(), blueprintID)
+}
+
+func HasBlueprintDraft(blueprintID uuid.UUID) (bool, error) {
+	var count int64
+	err := database.Conn().
+		Model(&BlueprintVersion{}).
+		Where("blueprint_id = ?", blueprintID).
+		Where("is_published = ?", false).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func ListPublishedBlueprintVersions(blueprintID uuid.UUID) ([]BlueprintVersion, error) {
+	var versions []BlueprintVersion
+	err := database.Conn().
+		Where("blueprint_id = ?", blueprintID).
+		Where("is_published = ?", true).
+		Order("version DESC").
+		Find(&versions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+func lockBlueprintForVersioningInTransaction(tx *gorm.DB, blueprintID uuid.UUID) (*Blueprint, error) {
+	var blueprint Blueprint
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", blueprintID).
+		First(&blueprint).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &blueprint, nil
+}
+
+// CreateBlueprintWithVersionInTransaction creates a blueprint
+// with its content published as version 1.
+func CreateBlueprintWithVersionInTransaction(tx *gorm.DB, blueprint *Blueprint) (*BlueprintVersion, error) {
+	blueprint.Version = 1
+	if err := tx.Create(blueprint).Error; err != nil {
+		return nil, err
+	}
+
+	version := BlueprintVersion{
+		ID:          uuid.New(),
+		BlueprintID: blueprint.ID,
+		Version:     1,
+		OwnerID:     blueprint.CreatedBy,
+		IsPublished: true,
+		PublishedAt: blueprint.CreatedAt,
+		CreatedAt:   blueprint.CreatedAt,
+		UpdatedAt:   blueprint.CreatedAt,
+	}
+	version.setContent(blueprint.Content())
+
+	if err := tx.Create(&version).Error; err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// SaveBlueprintDraftInTransaction saves content into the draft of a blueprint,
+// creating the draft if the blueprint does not have one.
+func SaveBlueprintDraftInTransaction(tx *gorm.DB, blueprintID uuid.UUID, ownerID *uuid.UUID, content BlueprintContent) (*BlueprintVersion, error) {
+	if _, err := lockBlueprintForVersioningInTransaction(tx, blueprintID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	draft, err := FindBlueprintDraftInTransaction(tx, blueprintID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if draft == nil {
+		draft = &BlueprintVersion{
+			ID:          uuid.New(),
+			BlueprintID: blueprintID,
+			OwnerID:     ownerID,
+			CreatedAt:   &now,
+			UpdatedAt:   &now,
+		}
+		draft.setContent(content)
+
+		if err := tx.Create(draft).Error; err != nil {
+			return nil, err
+		}
+
+		return draft, nil
+	}
+
+	draft.OwnerID = ownerID
+	draft.UpdatedAt = &now
+	draft.setContent(content)
+
+	if err := tx.Save(draft).Error; err != nil {
+		return nil, err
+	}
+
+	return draft, nil
+}
+
+// PublishBlueprintDraftInTransaction turns the draft of a blueprint into its next version.
+// Canvases keep using the version they are pinned to until they are upgraded.
+func PublishBlueprintDraftInTransaction(tx *gorm.DB, blueprintID uuid.UUID) (*Blueprint, *BlueprintVersion, error) {
+	blueprint, err := lockBlueprintForVersioningInTransaction(tx, blueprintID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	draft, err := FindBlueprintDraftInTransaction(tx, blueprintID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrBlueprintDraftNotFound
+		}
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	draft.Version = blueprint.Version + 1
+	draft.IsPublished = true
+	draft.PublishedAt = &now
+	draft.UpdatedAt = &now
+
+	if err := tx.Save(draft).Error; err != nil {
+		return nil, nil, err
+	}
+
+	published := blueprint.WithVersion(draft)
+	published.UpdatedAt = &now
+
+	if err := tx.Save(published).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return published, draft, nil
+}
+
+func DiscardBlueprintDraftInTransaction(tx *gorm.DB, blueprintID uuid.UUID) error {
+	result := tx.
+		Where("blueprint_id = ?", blueprintID).
+		Where("is_published = ?", false).
+		Delete(&BlueprintVersion{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrBlueprintDraftNotFound
+	}
+
+	return nil
+}
+
+// FindBlueprintForRefInTransaction returns the blueprint a canvas node references,
+// with the content of the version the node is pinned to.
+// References without a version use the latest published version.
+func FindBlueprintForRefInTransaction(tx *gorm.DB, ref *BlueprintRef) (*Blueprint, error) {
+	blueprint, err := FindUnscopedBlueprintInTransaction(tx, ref.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveBlueprintVersionInTransaction(tx, blueprint, ref.Version)
+}
+
+func FindBlueprintForRef(orgID string, ref *BlueprintRef) (*Blueprint, error) {
+	return FindOrganizationBlueprintForRefInTransaction(database.Conn(), orgID, ref)
+}
+
+func FindOrganizationBlueprintForRefInTransaction(tx *gorm.DB, orgID string, ref *BlueprintRef) (*Blueprint, error) {
+	blueprint, err := FindBlueprintInTransaction(tx, orgID, ref.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveBlueprintVersionInTransaction(tx, blueprint, ref.Version)
+}
+
+func resolveBlueprintVersionInTransaction(tx *gorm.DB, blueprint *Blueprint, version int) (*Blueprint, error) {
+	if version == 0 || version == blueprint.Version {
+		return blueprint, nil
+	}
+
+	blueprintVersion, err := FindBlueprintVersionInTransaction(tx, blueprint.ID, version)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("version %d of blueprint %s not found", version, blueprint.ID)
+		}
+		return nil, err
+	}
+
+	return blueprint.WithVersion(blueprintVersion), nil
+}
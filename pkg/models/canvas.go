@@ -18,6 +18,7 @@ type Canvas struct {
 	IsTemplate              bool
 	CanvasVersioningEnabled bool
 	ChangeRequestApprovers  datatypes.JSONSlice[CanvasChangeRequestApprover]
+	Environment             string
 	Name                    string
 	Description             string
 	CreatedBy               *uuid.UUID
@@ -11,10 +11,16 @@ import (
 )
 
 const (
-	CanvasEventStatePending = "pending"
-	CanvasEventStateRouted  = "routed"
+	CanvasEventStatePending    = "pending"
+	CanvasEventStateRouted     = "routed"
+	CanvasEventStateDeadLetter = "dead_letter"
 )
 
+// Number of failed processing attempts after which events
+// and queue items are moved to the dead-letter state,
+// instead of being retried forever.
+const MaxProcessingAttempts = 5
+
 type CanvasEvent struct {
 	ID          uuid.UUID `gorm:"primaryKey;default:uuid_generate_v4()"`
 	WorkflowID  uuid.UUID
@@ -25,6 +31,19 @@ type CanvasEvent struct {
 	ExecutionID *uuid.UUID
 	State       string
 	CreatedAt   *time.Time
+
+	//
+	// Number of failed routing attempts,
+	// and the reason for the last failure.
+	//
+	Attempts      int
+	FailureReason *string
+
+	//
+	// For root events created by replaying another root event,
+	// the reference to the original event.
+	//
+	ReplayOfID *uuid.UUID
 }
 
 func (e *CanvasEvent) TableName() string {
@@ -60,8 +79,12 @@ func FindCanvasEventsForExecutions(executionIDs []string) ([]CanvasEvent, error)
 }
 
 func FindCanvasEventForCanvas(canvasID uuid.UUID, id uuid.UUID) (*CanvasEvent, error) {
+	return FindCanvasEventForCanvasInTransaction(database.Conn(), canvasID, id)
+}
+
+func FindCanvasEventForCanvasInTransaction(tx *gorm.DB, canvasID uuid.UUID, id uuid.UUID) (*CanvasEvent, error) {
 	var event CanvasEvent
-	err := database.Conn().
+	err := tx.
 		Where("workflow_id = ?", canvasID).
 		Where("id = ?", id).
 		First(&event).
@@ -74,6 +97,43 @@ func FindCanvasEventForCanvas(canvasID uuid.UUID, id uuid.UUID) (*CanvasEvent, e
 	return &event, nil
 }
 
+// ListRoutedEventIDsInTransaction returns which of the given events
+// reached other nodes, either as queue items or as executions.
+func ListRoutedEventIDsInTransaction(tx *gorm.DB, workflowID uuid.UUID, eventIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	var queued []uuid.UUID
+	err := tx.
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ?", workflowID).
+		Where("event_id IN ?", eventIDs).
+		Distinct().
+		Pluck("event_id", &queued).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var executed []uuid.UUID
+	err = tx.
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_id = ?", workflowID).
+		Where("event_id IN ?", eventIDs).
+		Distinct().
+		Pluck("event_id", &executed).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	routed := map[uuid.UUID]bool{}
+	for _, id := range append(queued, executed...) {
+		routed[id] = true
+	}
+
+	return routed, nil
+}
+
 func FindCanvasEvent(id uuid.UUID) (*CanvasEvent, error) {
 	return FindCanvasEventInTransaction(database.Conn(), id)
 }
@@ -212,6 +272,105 @@ func (e *CanvasEvent) RoutedInTransaction(tx *gorm.DB) error {
 	return tx.Save(e).Error
 }
 
+func (e *CanvasEvent) GetReplayOfID() string {
+	if e.ReplayOfID == nil {
+		return ""
+	}
+
+	return e.ReplayOfID.String()
+}
+
+// CreateReplayInTransaction creates a new pending root event
+// with the same payload as this one, referencing it as the original.
+func (e *CanvasEvent) CreateReplayInTransaction(tx *gorm.DB) (*CanvasEvent, error) {
+	now := time.Now()
+	replay := CanvasEvent{
+		WorkflowID: e.WorkflowID,
+		NodeID:     e.NodeID,
+		Channel:    e.Channel,
+		CustomName: e.CustomName,
+		Data:       e.Data,
+		State:      CanvasEventStatePending,
+		ReplayOfID: &e.ID,
+		CreatedAt:  &now,
+	}
+
+	if err := tx.Create(&replay).Error; err != nil {
+		return nil, err
+	}
+
+	return &replay, nil
+}
+
+// RecordFailure increments the number of failed routing attempts for the event,
+// moving it to the dead-letter state once MaxProcessingAttempts is reached.
+func (e *CanvasEvent) RecordFailure(reason string) error {
+	return database.Conn().
+		Model(e).
+		Clauses(clause.Returning{}).
+		Where("state = ?", CanvasEventStatePending).
+		Updates(map[string]any{
+			"attempts":       gorm.Expr("attempts + 1"),
+			"failure_reason": reason,
+			"state":          gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE state END", MaxProcessingAttempts, CanvasEventStateDeadLetter),
+		}).
+		Error
+}
+
+func (e *CanvasEvent) Replay() error {
+	e.State = CanvasEventStatePending
+	e.Attempts = 0
+	e.FailureReason = nil
+
+	return database.Conn().
+		Model(e).
+		Updates(map[string]any{
+			"state":          e.State,
+			"attempts":       e.Attempts,
+			"failure_reason": nil,
+		}).
+		Error
+}
+
+func ListDeadLetterCanvasEvents(canvasID uuid.UUID, limit int, before *time.Time) ([]CanvasEvent, error) {
+	var events []CanvasEvent
+	query := database.Conn().
+		Where("workflow_id = ?", canvasID).
+		Where("state = ?", CanvasEventStateDeadLetter)
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if before != nil {
+		query = query.Where("created_at < ?", before)
+	}
+
+	err := query.Order("created_at DESC").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func CountDeadLetterCanvasEvents(canvasID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := database.Conn().
+		Model(&CanvasEvent{}).
+		Where("workflow_id = ?", canvasID).
+		Where("state = ?", CanvasEventStateDeadLetter).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // FindLastEventPerNode finds the most recent event for each node in a workflow
 // using DISTINCT ON to get one event per node_id, ordered by created_at DESC
 // Only returns events for nodes that have not been deleted
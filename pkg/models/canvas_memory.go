@@ -9,6 +9,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/database"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CanvasMemory struct {
@@ -18,8 +19,14 @@ type CanvasMemory struct {
 	CanvasID  uuid.UUID
 	Namespace string
 	Values    datatypes.JSONType[any]
+	ExpiresAt *time.Time
+	UniqueKey datatypes.JSON
 }
 
+// Expired records are kept until the memory cleanup worker deletes them,
+// so every read and write must ignore them.
+const canvasMemoryNotExpired = "(expires_at IS NULL OR expires_at > NOW())"
+
 func (CanvasMemory) TableName() string {
 	return "canvas_memories"
 }
@@ -34,6 +41,51 @@ func AddCanvasMemoryInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace str
 	return tx.Create(&record).Error
 }
 
+func AddExpiringCanvasMemoryInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, values any, expiresAt time.Time) error {
+	record := CanvasMemory{
+		CanvasID:  canvasID,
+		Namespace: namespace,
+		Values:    datatypes.NewJSONType(values),
+		ExpiresAt: &expiresAt,
+	}
+
+	return tx.Create(&record).Error
+}
+
+// UpsertCanvasMemoryByUniqueKeyInTransaction adds a record, or replaces
+// the values and the expiry of the one with the same unique key.
+func UpsertCanvasMemoryByUniqueKeyInTransaction(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	values any,
+	uniqueKey []any,
+	expiresAt *time.Time,
+) error {
+	uniqueKeyJSON, err := json.Marshal(uniqueKey)
+	if err != nil {
+		return err
+	}
+
+	record := CanvasMemory{
+		CanvasID:  canvasID,
+		Namespace: namespace,
+		Values:    datatypes.NewJSONType(values),
+		ExpiresAt: expiresAt,
+		UniqueKey: datatypes.JSON(uniqueKeyJSON),
+	}
+
+	return tx.Clauses(clause.OnConflict{
+		Columns:     []clause.Column{{Name: "canvas_id"}, {Name: "namespace"}, {Name: "unique_key"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "unique_key IS NOT NULL"}}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"values":     gorm.Expr("EXCLUDED.values"),
+			"expires_at": gorm.Expr("EXCLUDED.expires_at"),
+			"updated_at": gorm.Expr("NOW()"),
+		}),
+	}).Create(&record).Error
+}
+
 func AddCanvasMemory(canvasID uuid.UUID, namespace string, values any) error {
 	return AddCanvasMemoryInTransaction(database.Conn(), canvasID, namespace, values)
 }
@@ -42,6 +94,7 @@ func ListCanvasMemoriesInTransaction(tx *gorm.DB, canvasID uuid.UUID) ([]CanvasM
 	var records []CanvasMemory
 	err := tx.
 		Where("canvas_id = ?", canvasID).
+		Where(canvasMemoryNotExpired).
 		Order("created_at DESC").
 		Find(&records).Error
 	if err != nil {
@@ -59,6 +112,7 @@ func ListCanvasMemoriesByNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID,
 	var records []CanvasMemory
 	err := tx.
 		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		Where(canvasMemoryNotExpired).
 		Order("created_at DESC").
 		Find(&records).Error
 	if err != nil {
@@ -87,6 +141,8 @@ func ListCanvasMemoriesByNamespaceAndMatchesInTransaction(tx *gorm.DB, canvasID
 	err = tx.
 		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
 		Where("values @> ?::jsonb", matchesJSON).
+		Scopes(matchScalarFields(matches)).
+		Where(canvasMemoryNotExpired).
 		Order("created_at DESC").
 		Find(&records).
 		Error
@@ -98,6 +154,29 @@ func ListCanvasMemoriesByNamespaceAndMatchesInTransaction(tx *gorm.DB, canvasID
 	return records, nil
 }
 
+// matchScalarFields repeats the scalar matches as equality conditions.
+// They select the same records as the containment condition,
+// but can use the indexes generated for the namespace lookup keys.
+func matchScalarFields(matches map[string]any) func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		for name, value := range matches {
+			switch value.(type) {
+			case map[string]any, []any:
+				continue
+			}
+
+			valueJSON, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+
+			tx = tx.Where("values -> ? = ?::jsonb", name, string(valueJSON))
+		}
+
+		return tx
+	}
+}
+
 func ListCanvasMemoriesByNamespaceAndMatches(canvasID uuid.UUID, namespace string, matches map[string]any) ([]CanvasMemory, error) {
 	return ListCanvasMemoriesByNamespaceAndMatchesInTransaction(database.Conn(), canvasID, namespace, matches)
 }
@@ -117,6 +196,8 @@ func FindFirstCanvasMemoryByNamespaceAndMatchesInTransaction(tx *gorm.DB, canvas
 	err = tx.
 		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
 		Where("values @> ?::jsonb", matchesJSON).
+		Scopes(matchScalarFields(matches)).
+		Where(canvasMemoryNotExpired).
 		Order("created_at DESC").
 		Limit(1).
 		First(&record).
@@ -164,6 +245,7 @@ func DeleteCanvasMemoriesByNamespaceAndMatchesInTransaction(
 		`WITH deleted AS (
 			DELETE FROM canvas_memories
 			WHERE canvas_id = ? AND namespace = ? AND values @> ?::jsonb
+			AND `+canvasMemoryNotExpired+`
 			RETURNING *
 		)
 		SELECT * FROM deleted ORDER BY created_at DESC`,
@@ -188,6 +270,30 @@ func UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(
 	namespace string,
 	matches map[string]any,
 	values map[string]any,
+) ([]CanvasMemory, error) {
+	return updateCanvasMemoriesInTransaction(tx, canvasID, namespace, matches, values, nil)
+}
+
+// UpdateExpiringCanvasMemoriesByNamespaceAndMatchesInTransaction
+// updates the matching records, and moves their expiry to expiresAt.
+func UpdateExpiringCanvasMemoriesByNamespaceAndMatchesInTransaction(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	values map[string]any,
+	expiresAt time.Time,
+) ([]CanvasMemory, error) {
+	return updateCanvasMemoriesInTransaction(tx, canvasID, namespace, matches, values, &expiresAt)
+}
+
+func updateCanvasMemoriesInTransaction(
+	tx *gorm.DB,
+	canvasID uuid.UUID,
+	namespace string,
+	matches map[string]any,
+	values map[string]any,
+	expiresAt *time.Time,
 ) ([]CanvasMemory, error) {
 	if len(matches) == 0 {
 		return []CanvasMemory{}, fmt.Errorf("at least one match expression is required")
@@ -211,6 +317,7 @@ func UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(
 			UPDATE canvas_memories
 			SET values = values || ?::jsonb, updated_at = NOW()
 			WHERE canvas_id = ? AND namespace = ? AND values @> ?::jsonb
+			AND `+canvasMemoryNotExpired+`
 			RETURNING *
 		)
 		SELECT * FROM updated ORDER BY created_at DESC`,
@@ -229,3 +336,24 @@ func UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(
 func UpdateCanvasMemoriesByNamespaceAndMatches(canvasID uuid.UUID, namespace string, matches map[string]any, values map[string]any) ([]CanvasMemory, error) {
 	return UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(database.Conn(), canvasID, namespace, matches, values)
 }
+
+// DeleteExpiredCanvasMemories deletes up to limit records
+// which expired before now, and returns how many were deleted.
+func DeleteExpiredCanvasMemories(now time.Time, limit int) (int, error) {
+	result := database.Conn().Exec(
+		`DELETE FROM canvas_memories WHERE id IN (
+			SELECT id FROM canvas_memories
+			WHERE expires_at IS NOT NULL AND expires_at <= ?
+			ORDER BY expires_at ASC
+			LIMIT ?
+		)`,
+		now,
+		limit,
+	)
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxCanvasMemoryTTL is the longest time a memory record can be kept for.
+const MaxCanvasMemoryTTL = 365 * 24 * time.Hour
+
+// CanvasMemoryNamespace holds the settings of a memory namespace in a canvas.
+// Namespaces without settings do not need a record.
+type CanvasMemoryNamespace struct {
+	ID                uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CanvasID          uuid.UUID
+	Namespace         string
+	DefaultTTLSeconds int `gorm:"column:default_ttl_seconds"`
+	Schema            *datatypes.JSONType[CanvasMemorySchema]
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (CanvasMemoryNamespace) TableName() string {
+	return "canvas_memory_namespaces"
+}
+
+// DefaultTTL is the TTL applied to records written
+// without one. Zero means records do not expire.
+func (n *CanvasMemoryNamespace) DefaultTTL() time.Duration {
+	return time.Duration(n.DefaultTTLSeconds) * time.Second
+}
+
+// MemorySchema returns the declared schema of the namespace, if there is one.
+func (n *CanvasMemoryNamespace) MemorySchema() *CanvasMemorySchema {
+	if n.Schema == nil {
+		return nil
+	}
+
+	schema := n.Schema.Data()
+	return &schema
+}
+
+func ValidateCanvasMemoryTTL(ttl time.Duration) error {
+	if ttl < 0 {
+		return fmt.Errorf("ttl cannot be negative")
+	}
+
+	if ttl > MaxCanvasMemoryTTL {
+		return fmt.Errorf("ttl cannot be longer than %d days", int(MaxCanvasMemoryTTL.Hours()/24))
+	}
+
+	return nil
+}
+
+func FindCanvasMemoryNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string) (*CanvasMemoryNamespace, error) {
+	var record CanvasMemoryNamespace
+	err := tx.
+		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		First(&record).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func ListCanvasMemoryNamespaces(canvasID uuid.UUID) ([]CanvasMemoryNamespace, error) {
+	var records []CanvasMemoryNamespace
+	err := database.Conn().
+		Where("canvas_id = ?", canvasID).
+		Order("namespace ASC").
+		Find(&records).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func UpsertCanvasMemoryNamespaceInTransaction(tx *gorm.DB, record *CanvasMemoryNamespace) error {
+	record.UpdatedAt = time.Now()
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "canvas_id"}, {Name: "namespace"}},
+		DoUpdates: clause.AssignmentColumns([]string{"default_ttl_seconds", "schema", "updated_at"}),
+	}).Create(record).Error
+}
+
+func DeleteCanvasMemoryNamespaceInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string) error {
+	return tx.
+		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		Delete(&CanvasMemoryNamespace{}).
+		Error
+}
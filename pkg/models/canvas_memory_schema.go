@@ -0,0 +1,416 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	CanvasMemoryFieldTypeString  = "string"
+	CanvasMemoryFieldTypeNumber  = "number"
+	CanvasMemoryFieldTypeBoolean = "boolean"
+	CanvasMemoryFieldTypeObject  = "object"
+	CanvasMemoryFieldTypeList    = "list"
+
+	MaxCanvasMemorySchemaFields     = 50
+	MaxCanvasMemoryLookupKeys       = 5
+	MaxCanvasMemoryFieldsPerKey     = 3
+	canvasMemoryLookupIndexPrefix   = "idx_canvas_memories_lookup_"
+	canvasMemoryLookupIndexHashSize = 16
+)
+
+var ErrCanvasMemoryDuplicateUniqueKey = errors.New("records with the same unique key already exist")
+
+var canvasMemoryFieldNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// CanvasMemorySchema declares the shape of the records in a memory namespace.
+// Records with fields that are not declared are rejected.
+type CanvasMemorySchema struct {
+	Fields []CanvasMemoryField `json:"fields"`
+
+	//
+	// Records with the same values for the unique key fields
+	// replace each other, instead of being added next to each other.
+	//
+	UniqueKey []string `json:"uniqueKey,omitempty"`
+
+	//
+	// Every lookup key gets its own index,
+	// so matches on those fields do not scan the whole namespace.
+	//
+	LookupKeys [][]string `json:"lookupKeys,omitempty"`
+}
+
+type CanvasMemoryField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+func (s *CanvasMemorySchema) Validate() error {
+	if len(s.Fields) == 0 {
+		return fmt.Errorf("at least one field is required")
+	}
+
+	if len(s.Fields) > MaxCanvasMemorySchemaFields {
+		return fmt.Errorf("too many fields: %d (max %d)", len(s.Fields), MaxCanvasMemorySchemaFields)
+	}
+
+	for i, field := range s.Fields {
+		if !canvasMemoryFieldNameRegex.MatchString(field.Name) {
+			return fmt.Errorf("field %d: invalid name %q: only letters, numbers and underscores are allowed", i+1, field.Name)
+		}
+
+		if s.findField(field.Name) != &s.Fields[i] {
+			return fmt.Errorf("field %d: duplicate name %s", i+1, field.Name)
+		}
+
+		if !isCanvasMemoryFieldType(field.Type) {
+			return fmt.Errorf("field %s: invalid type %q", field.Name, field.Type)
+		}
+	}
+
+	if err := s.validateKey("unique key", s.UniqueKey); err != nil {
+		return err
+	}
+
+	for _, name := range s.UniqueKey {
+		field := s.findField(name)
+		if !field.Required {
+			return fmt.Errorf("unique key: field %s must be required", name)
+		}
+
+		if !isScalarCanvasMemoryFieldType(field.Type) {
+			return fmt.Errorf("unique key: field %s must be a string, number or boolean", name)
+		}
+	}
+
+	if len(s.LookupKeys) > MaxCanvasMemoryLookupKeys {
+		return fmt.Errorf("too many lookup keys: %d (max %d)", len(s.LookupKeys), MaxCanvasMemoryLookupKeys)
+	}
+
+	for i, key := range s.LookupKeys {
+		if len(key) == 0 {
+			return fmt.Errorf("lookup key %d: at least one field is required", i+1)
+		}
+
+		if err := s.validateKey(fmt.Sprintf("lookup key %d", i+1), key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CanvasMemorySchema) validateKey(name string, key []string) error {
+	if len(key) > MaxCanvasMemoryFieldsPerKey {
+		return fmt.Errorf("%s: too many fields: %d (max %d)", name, len(key), MaxCanvasMemoryFieldsPerKey)
+	}
+
+	for i, field := range key {
+		if s.findField(field) == nil {
+			return fmt.Errorf("%s: field %s is not declared", name, field)
+		}
+
+		if slices.Index(key, field) != i {
+			return fmt.Errorf("%s: duplicate field %s", name, field)
+		}
+	}
+
+	return nil
+}
+
+func (s *CanvasMemorySchema) findField(name string) *CanvasMemoryField {
+	for i := range s.Fields {
+		if s.Fields[i].Name == name {
+			return &s.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// ValidateRecord checks the values of a new record against the schema.
+func (s *CanvasMemorySchema) ValidateRecord(values any) error {
+	record, ok := values.(map[string]any)
+	if !ok {
+		return fmt.Errorf("values must be an object, got %T", values)
+	}
+
+	if err := s.validateValues(record); err != nil {
+		return err
+	}
+
+	for _, field := range s.Fields {
+		if field.Required && record[field.Name] == nil {
+			return fmt.Errorf("field %s is required", field.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateUpdate checks the values set on existing records against the schema.
+// The unique key fields cannot be updated, since they identify the records.
+func (s *CanvasMemorySchema) ValidateUpdate(values map[string]any) error {
+	if err := s.validateValues(values); err != nil {
+		return err
+	}
+
+	for name, value := range values {
+		if slices.Contains(s.UniqueKey, name) {
+			return fmt.Errorf("field %s is part of the unique key and cannot be updated", name)
+		}
+
+		if value == nil && s.findField(name).Required {
+			return fmt.Errorf("field %s is required", name)
+		}
+	}
+
+	return nil
+}
+
+func (s *CanvasMemorySchema) validateValues(values map[string]any) error {
+	for name, value := range values {
+		field := s.findField(name)
+		if field == nil {
+			return fmt.Errorf("field %s is not declared in the namespace schema", name)
+		}
+
+		if value != nil && !canvasMemoryValueHasType(value, field.Type) {
+			return fmt.Errorf("field %s must be a %s, got %T", name, field.Type, value)
+		}
+	}
+
+	return nil
+}
+
+// UniqueKeyValues returns the values of the unique key fields of a record,
+// or nil if the schema has no unique key.
+func (s *CanvasMemorySchema) UniqueKeyValues(values any) []any {
+	if len(s.UniqueKey) == 0 {
+		return nil
+	}
+
+	record, _ := values.(map[string]any)
+	key := make([]any, 0, len(s.UniqueKey))
+	for _, name := range s.UniqueKey {
+		key = append(key, record[name])
+	}
+
+	return key
+}
+
+func isCanvasMemoryFieldType(fieldType string) bool {
+	switch fieldType {
+	case CanvasMemoryFieldTypeString,
+		CanvasMemoryFieldTypeNumber,
+		CanvasMemoryFieldTypeBoolean,
+		CanvasMemoryFieldTypeObject,
+		CanvasMemoryFieldTypeList:
+		return true
+	default:
+		return false
+	}
+}
+
+func isScalarCanvasMemoryFieldType(fieldType string) bool {
+	return fieldType == CanvasMemoryFieldTypeString ||
+		fieldType == CanvasMemoryFieldTypeNumber ||
+		fieldType == CanvasMemoryFieldTypeBoolean
+}
+
+func canvasMemoryValueHasType(value any, fieldType string) bool {
+	switch fieldType {
+	case CanvasMemoryFieldTypeString:
+		_, ok := value.(string)
+		return ok
+	case CanvasMemoryFieldTypeNumber:
+		switch value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
+			return true
+		}
+		return false
+	case CanvasMemoryFieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case CanvasMemoryFieldTypeObject:
+		_, ok := value.(map[string]any)
+		return ok
+	case CanvasMemoryFieldTypeList:
+		_, ok := value.([]any)
+		return ok
+	default:
+		return false
+	}
+}
+
+// canvasMemoryLookupIndexName is derived from the canvas, namespace and fields,
+// so the index for a lookup key can be found again without storing its name.
+func canvasMemoryLookupIndexName(canvasID uuid.UUID, namespace string, fields []string) string {
+	hash := sha256.Sum256([]byte(canvasID.String() + "\x00" + namespace + "\x00" + strings.Join(fields, "\x00")))
+	return canvasMemoryLookupIndexPrefix + hex.EncodeToString(hash[:])[:canvasMemoryLookupIndexHashSize]
+}
+
+func quoteSQLLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// createCanvasMemoryLookupIndexInTransaction creates a partial index
+// on the lookup key fields, only for the records in the namespace.
+// The field names are validated by the schema, so they can be inlined.
+func createCanvasMemoryLookupIndexInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, fields []string) error {
+	expressions := make([]string, 0, len(fields))
+	for _, field := range fields {
+		expressions = append(expressions, fmt.Sprintf("(values -> %s)", quoteSQLLiteral(field)))
+	}
+
+	return tx.Exec(fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS %s ON canvas_memories USING btree (%s) WHERE canvas_id = %s AND namespace = %s",
+		canvasMemoryLookupIndexName(canvasID, namespace, fields),
+		strings.Join(expressions, ", "),
+		quoteSQLLiteral(canvasID.String()),
+		quoteSQLLiteral(namespace),
+	)).Error
+}
+
+func dropCanvasMemoryLookupIndexInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, fields []string) error {
+	return tx.Exec("DROP INDEX IF EXISTS " + canvasMemoryLookupIndexName(canvasID, namespace, fields)).Error
+}
+
+// UpdateCanvasMemorySchemaInTransaction applies a new schema to a namespace.
+// The unique key of the existing records is recomputed, which fails if
+// some of them have the same values for it, and the lookup indexes are
+// created and dropped to match the declared lookup keys.
+func UpdateCanvasMemorySchemaInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, previous, schema *CanvasMemorySchema) error {
+	var uniqueKey []string
+	if schema != nil {
+		uniqueKey = schema.UniqueKey
+	}
+
+	var previousUniqueKey []string
+	if previous != nil {
+		previousUniqueKey = previous.UniqueKey
+	}
+
+	if !slices.Equal(uniqueKey, previousUniqueKey) {
+		if err := updateCanvasMemoryUniqueKeysInTransaction(tx, canvasID, namespace, uniqueKey); err != nil {
+			return err
+		}
+	}
+
+	var lookupKeys [][]string
+	if schema != nil {
+		lookupKeys = schema.LookupKeys
+	}
+
+	if previous != nil {
+		for _, key := range previous.LookupKeys {
+			if containsCanvasMemoryKey(lookupKeys, key) {
+				continue
+			}
+
+			if err := dropCanvasMemoryLookupIndexInTransaction(tx, canvasID, namespace, key); err != nil {
+				return fmt.Errorf("failed to drop lookup index: %w", err)
+			}
+		}
+	}
+
+	for _, key := range lookupKeys {
+		if err := createCanvasMemoryLookupIndexInTransaction(tx, canvasID, namespace, key); err != nil {
+			return fmt.Errorf("failed to create lookup index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func updateCanvasMemoryUniqueKeysInTransaction(tx *gorm.DB, canvasID uuid.UUID, namespace string, uniqueKey []string) error {
+	//
+	// Expired records are deleted first,
+	// so they do not conflict with the ones still in use.
+	//
+	err := tx.
+		Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+		Where("expires_at IS NOT NULL AND expires_at <= NOW()").
+		Delete(&CanvasMemory{}).
+		Error
+
+	if err != nil {
+		return err
+	}
+
+	if len(uniqueKey) == 0 {
+		return tx.Model(&CanvasMemory{}).
+			Where("canvas_id = ? AND namespace = ?", canvasID, namespace).
+			Update("unique_key", nil).
+			Error
+	}
+
+	placeholders := make([]string, 0, len(uniqueKey))
+	args := make([]any, 0, len(uniqueKey)+2)
+	for _, field := range uniqueKey {
+		placeholders = append(placeholders, "values -> ?")
+		args = append(args, field)
+	}
+
+	args = append(args, canvasID, namespace)
+	err = tx.Exec(
+		fmt.Sprintf("UPDATE canvas_memories SET unique_key = jsonb_build_array(%s) WHERE canvas_id = ? AND namespace = ?", strings.Join(placeholders, ", ")),
+		args...,
+	).Error
+
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
+			return ErrCanvasMemoryDuplicateUniqueKey
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// DropCanvasMemoryLookupIndexesInTransaction drops the lookup indexes of every
+// namespace in a canvas, since they are not dropped together with the canvas.
+func DropCanvasMemoryLookupIndexesInTransaction(tx *gorm.DB, canvasID uuid.UUID) error {
+	var namespaces []CanvasMemoryNamespace
+	err := tx.Where("canvas_id = ? AND schema IS NOT NULL", canvasID).Find(&namespaces).Error
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		schema := namespace.MemorySchema()
+		if schema == nil {
+			continue
+		}
+
+		for _, key := range schema.LookupKeys {
+			if err := dropCanvasMemoryLookupIndexInTransaction(tx, canvasID, namespace.Namespace, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func containsCanvasMemoryKey(keys [][]string, key []string) bool {
+	for _, k := range keys {
+		if slices.Equal(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
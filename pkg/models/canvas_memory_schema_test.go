@@ -0,0 +1,74 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__CanvasMemorySchema(t *testing.T) {
+	schema := CanvasMemorySchema{
+		Fields: []CanvasMemoryField{
+			{Name: "environment", Type: CanvasMemoryFieldTypeString, Required: true},
+			{Name: "version", Type: CanvasMemoryFieldTypeNumber},
+			{Name: "healthy", Type: CanvasMemoryFieldTypeBoolean},
+			{Name: "labels", Type: CanvasMemoryFieldTypeObject},
+		},
+		UniqueKey:  []string{"environment"},
+		LookupKeys: [][]string{{"version"}},
+	}
+
+	t.Run("valid schema", func(t *testing.T) {
+		require.NoError(t, schema.Validate())
+	})
+
+	t.Run("invalid schemas", func(t *testing.T) {
+		invalid := CanvasMemorySchema{}
+		require.ErrorContains(t, invalid.Validate(), "at least one field is required")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{{Name: "a-b", Type: CanvasMemoryFieldTypeString}}}
+		require.ErrorContains(t, invalid.Validate(), "invalid name")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{{Name: "a", Type: "date"}}}
+		require.ErrorContains(t, invalid.Validate(), "invalid type")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{
+			{Name: "a", Type: CanvasMemoryFieldTypeString},
+			{Name: "a", Type: CanvasMemoryFieldTypeNumber},
+		}}
+		require.ErrorContains(t, invalid.Validate(), "duplicate name a")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{{Name: "a", Type: CanvasMemoryFieldTypeString}}, UniqueKey: []string{"a"}}
+		require.ErrorContains(t, invalid.Validate(), "field a must be required")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{{Name: "a", Type: CanvasMemoryFieldTypeList, Required: true}}, UniqueKey: []string{"a"}}
+		require.ErrorContains(t, invalid.Validate(), "must be a string, number or boolean")
+
+		invalid = CanvasMemorySchema{Fields: []CanvasMemoryField{{Name: "a", Type: CanvasMemoryFieldTypeString}}, LookupKeys: [][]string{{"b"}}}
+		require.ErrorContains(t, invalid.Validate(), "lookup key 1: field b is not declared")
+	})
+
+	t.Run("records are validated", func(t *testing.T) {
+		require.NoError(t, schema.ValidateRecord(map[string]any{"environment": "production", "version": 3}))
+		require.NoError(t, schema.ValidateRecord(map[string]any{"environment": "production", "version": 3.5, "healthy": true}))
+
+		require.ErrorContains(t, schema.ValidateRecord("production"), "values must be an object")
+		require.ErrorContains(t, schema.ValidateRecord(map[string]any{"version": 3}), "field environment is required")
+		require.ErrorContains(t, schema.ValidateRecord(map[string]any{"environment": 1}), "field environment must be a string")
+		require.ErrorContains(t, schema.ValidateRecord(map[string]any{"environment": "production", "owner": "alex"}), "field owner is not declared")
+	})
+
+	t.Run("updates are validated", func(t *testing.T) {
+		require.NoError(t, schema.ValidateUpdate(map[string]any{"healthy": false}))
+		require.ErrorContains(t, schema.ValidateUpdate(map[string]any{"environment": "staging"}), "part of the unique key")
+		require.ErrorContains(t, schema.ValidateUpdate(map[string]any{"labels": "a"}), "field labels must be a object")
+	})
+
+	t.Run("unique key values", func(t *testing.T) {
+		assert.Equal(t, []any{"production"}, schema.UniqueKeyValues(map[string]any{"environment": "production", "version": 3}))
+
+		withoutKey := CanvasMemorySchema{Fields: schema.Fields}
+		assert.Nil(t, withoutKey.UniqueKeyValues(map[string]any{"environment": "production"}))
+	})
+}
@@ -24,6 +24,13 @@ const (
 	NodeTypeComponent = "component"
 	NodeTypeBlueprint = "blueprint"
 	NodeTypeWidget    = "widget"
+
+	MaxNodeTimeoutSeconds = 7 * 24 * 60 * 60
+	MaxNodeConcurrency    = 100
+
+	QueuePolicyFIFO           = "fifo"
+	QueuePolicyLatestWins     = "latest-wins"
+	QueuePolicyRejectWhenBusy = "reject-when-busy"
 )
 
 type CanvasNode struct {
@@ -39,6 +46,10 @@ type CanvasNode struct {
 	Configuration     datatypes.JSONType[map[string]any]
 	Metadata          datatypes.JSONType[map[string]any]
 	IsCollapsed       bool
+	RetryPolicy       *datatypes.JSONType[RetryPolicy]
+	TimeoutSeconds    int
+	MaxConcurrency    int    `gorm:"default:1"`
+	QueuePolicy       string `gorm:"default:fifo"`
 	WebhookID         *uuid.UUID
 	AppInstallationID *uuid.UUID
 	CreatedAt         *time.Time
@@ -188,6 +199,7 @@ func ListCanvasNodesReady() ([]CanvasNode, error) {
 		Joins("JOIN workflows ON workflow_nodes.workflow_id = workflows.id").
 		Where("workflow_nodes.state = ?", CanvasNodeStateReady).
 		Where("workflow_nodes.type IN ?", []string{NodeTypeComponent, NodeTypeBlueprint}).
+		Where("workflow_node_queue_items.state = ?", CanvasNodeQueueItemStatePending).
 		Where("workflows.deleted_at IS NULL").
 		Find(&nodes).
 		Error
@@ -269,11 +281,71 @@ func (c *CanvasNode) UpdateState(tx *gorm.DB, state string) error {
 		Error
 }
 
+func (c *CanvasNode) GetRetryPolicy() *RetryPolicy {
+	if c.RetryPolicy == nil {
+		return nil
+	}
+
+	policy := c.RetryPolicy.Data()
+	return &policy
+}
+
+func (c *CanvasNode) GetMaxConcurrency() int {
+	return max(c.MaxConcurrency, 1)
+}
+
+// IsBusyInTransaction returns true if the node
+// cannot take any more executions right now.
+func (c *CanvasNode) IsBusyInTransaction(tx *gorm.DB) (bool, error) {
+	inFlight, err := CountInFlightExecutionsForNodeInTransaction(tx, c.WorkflowID, c.NodeID)
+	if err != nil {
+		return false, err
+	}
+
+	return inFlight >= int64(c.GetMaxConcurrency()), nil
+}
+
+// EnqueueInTransaction adds a new item to the node queue,
+// following the node queue policy. If the item is rejected
+// because the node is busy, false is returned.
+func (c *CanvasNode) EnqueueInTransaction(tx *gorm.DB, queueItem *CanvasNodeQueueItem) (bool, error) {
+	switch c.QueuePolicy {
+	case QueuePolicyRejectWhenBusy:
+		busy, err := c.IsBusyInTransaction(tx)
+		if err != nil {
+			return false, err
+		}
+
+		if busy {
+			return false, nil
+		}
+
+	case QueuePolicyLatestWins:
+		err := tx.
+			Where("workflow_id = ?", c.WorkflowID).
+			Where("node_id = ?", c.NodeID).
+			Where("state = ?", CanvasNodeQueueItemStatePending).
+			Delete(&CanvasNodeQueueItem{}).
+			Error
+
+		if err != nil {
+			return false, fmt.Errorf("failed to drop superseded queue items: %w", err)
+		}
+	}
+
+	if err := tx.Create(queueItem).Error; err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *CanvasNode) FirstQueueItem(tx *gorm.DB) (*CanvasNodeQueueItem, error) {
 	var queueItem CanvasNodeQueueItem
 	err := tx.
 		Where("workflow_id = ?", c.WorkflowID).
 		Where("node_id = ?", c.NodeID).
+		Where("state = ?", CanvasNodeQueueItemStatePending).
 		Order("created_at ASC").
 		First(&queueItem).
 		Error
@@ -299,12 +371,25 @@ func (c *CanvasNode) CreateRequest(tx *gorm.DB, reqType string, spec NodeExecuti
 	}).Error
 }
 
+const (
+	CanvasNodeQueueItemStatePending    = "pending"
+	CanvasNodeQueueItemStateDeadLetter = "dead_letter"
+)
+
 type CanvasNodeQueueItem struct {
 	ID         uuid.UUID `gorm:"primaryKey;default:uuid_generate_v4()"`
 	WorkflowID uuid.UUID
 	NodeID     string
+	State      string `gorm:"default:pending"`
 	CreatedAt  *time.Time
 
+	//
+	// Number of failed processing attempts,
+	// and the reason for the last failure.
+	//
+	Attempts      int
+	FailureReason *string
+
 	//
 	// Reference to the root WorkflowEvent record that started
 	// this whole execution chain.
@@ -320,6 +405,12 @@ type CanvasNodeQueueItem struct {
 	// which holds the input for this queue item.
 	//
 	EventID uuid.UUID
+
+	//
+	// Set when the queue item was created to re-run a finished execution.
+	// The execution created from it references the original one.
+	//
+	RerunOfExecutionID *uuid.UUID
 }
 
 func (i *CanvasNodeQueueItem) TableName() string {
@@ -330,12 +421,43 @@ func (i *CanvasNodeQueueItem) Delete(tx *gorm.DB) error {
 	return tx.Delete(i).Error
 }
 
+// RecordFailure increments the number of failed processing attempts for the queue item,
+// moving it to the dead-letter state once MaxProcessingAttempts is reached.
+func (i *CanvasNodeQueueItem) RecordFailure(reason string) error {
+	return database.Conn().
+		Model(i).
+		Clauses(clause.Returning{}).
+		Where("state = ?", CanvasNodeQueueItemStatePending).
+		Updates(map[string]any{
+			"attempts":       gorm.Expr("attempts + 1"),
+			"failure_reason": reason,
+			"state":          gorm.Expr("CASE WHEN attempts + 1 >= ? THEN ? ELSE state END", MaxProcessingAttempts, CanvasNodeQueueItemStateDeadLetter),
+		}).
+		Error
+}
+
+func (i *CanvasNodeQueueItem) Replay() error {
+	i.State = CanvasNodeQueueItemStatePending
+	i.Attempts = 0
+	i.FailureReason = nil
+
+	return database.Conn().
+		Model(i).
+		Updates(map[string]any{
+			"state":          i.State,
+			"attempts":       i.Attempts,
+			"failure_reason": nil,
+		}).
+		Error
+}
+
 func ListNodeQueueItems(workflowID uuid.UUID, nodeID string, limit int, beforeTime *time.Time) ([]CanvasNodeQueueItem, error) {
 	var queueItems []CanvasNodeQueueItem
 	query := database.Conn().
 		Preload("RootEvent").
 		Where("workflow_id = ?", workflowID).
 		Where("node_id = ?", nodeID).
+		Where("state = ?", CanvasNodeQueueItemStatePending).
 		Order("created_at DESC").
 		Limit(int(limit))
 
@@ -356,7 +478,8 @@ func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	countQuery := database.Conn().
 		Model(&CanvasNodeQueueItem{}).
 		Where("workflow_id = ?", workflowID).
-		Where("node_id = ?", nodeID)
+		Where("node_id = ?", nodeID).
+		Where("state = ?", CanvasNodeQueueItemStatePending)
 
 	if err := countQuery.Count(&totalCount).Error; err != nil {
 		return 0, err
@@ -365,6 +488,40 @@ func CountNodeQueueItems(workflowID uuid.UUID, nodeID string) (int64, error) {
 	return totalCount, nil
 }
 
+func CountQueueItemsForRootEventInTransaction(tx *gorm.DB, workflowID, rootEventID uuid.UUID, state string) (int64, error) {
+	var totalCount int64
+	err := tx.
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ?", workflowID).
+		Where("root_event_id = ?", rootEventID).
+		Where("state = ?", state).
+		Count(&totalCount).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
+func CountQueueItemsForEventsInTransaction(tx *gorm.DB, workflowID uuid.UUID, eventIDs []uuid.UUID, state string) (int64, error) {
+	var totalCount int64
+	err := tx.
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ?", workflowID).
+		Where("event_id IN ?", eventIDs).
+		Where("state = ?", state).
+		Count(&totalCount).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
+
 // FindNextQueueItemPerNode finds the next (oldest) queue item for each node in a workflow
 // using DISTINCT ON to get one queue item per node_id, ordered by created_at ASC
 // Only returns queue items for nodes that have not been deleted
@@ -378,9 +535,10 @@ func FindNextQueueItemPerNode(workflowID uuid.UUID) ([]CanvasNodeQueueItem, erro
 				ON qi.workflow_id = wn.workflow_id
 				AND qi.node_id = wn.node_id
 			WHERE qi.workflow_id = ?
+			AND qi.state = ?
 			AND wn.deleted_at IS NULL
 			ORDER BY qi.node_id, qi.created_at ASC
-		`, workflowID).
+		`, workflowID, CanvasNodeQueueItemStatePending).
 		Scan(&queueItems).
 		Error
 
@@ -405,3 +563,43 @@ func FindNodeQueueItem(workflowID uuid.UUID, queueItemID uuid.UUID) (*CanvasNode
 
 	return &queueItem, nil
 }
+
+func ListDeadLetterQueueItems(workflowID uuid.UUID, limit int, beforeTime *time.Time) ([]CanvasNodeQueueItem, error) {
+	var queueItems []CanvasNodeQueueItem
+	query := database.Conn().
+		Preload("RootEvent").
+		Where("workflow_id = ?", workflowID).
+		Where("state = ?", CanvasNodeQueueItemStateDeadLetter).
+		Order("created_at DESC")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if beforeTime != nil {
+		query = query.Where("created_at < ?", beforeTime)
+	}
+
+	err := query.Find(&queueItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return queueItems, nil
+}
+
+func CountDeadLetterQueueItems(workflowID uuid.UUID) (int64, error) {
+	var totalCount int64
+	err := database.Conn().
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ?", workflowID).
+		Where("state = ?", CanvasNodeQueueItemStateDeadLetter).
+		Count(&totalCount).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	return totalCount, nil
+}
@@ -24,6 +24,10 @@ const (
 	CanvasNodeExecutionResultReasonOk            = "ok"
 	CanvasNodeExecutionResultReasonError         = "error"
 	CanvasNodeExecutionResultReasonErrorResolved = "error_resolved"
+	CanvasNodeExecutionResultReasonTimedOut      = "timed_out"
+
+	CanvasNodeExecutionTimeoutChannel     = "timeout"
+	CanvasNodeExecutionTimeoutPayloadType = "execution.timedOut"
 )
 
 type CanvasNodeExecution struct {
@@ -56,6 +60,23 @@ type CanvasNodeExecution struct {
 	//
 	ParentExecutionID *uuid.UUID
 
+	//
+	// Retry management fields.
+	// When an execution fails and the node has a retry policy,
+	// a new pending execution is created for the next attempt,
+	// referencing the failed one, and only running after RunAt.
+	//
+	Attempt            int `gorm:"default:1"`
+	RetryOfExecutionID *uuid.UUID
+	RunAt              *time.Time
+
+	//
+	// Reference to the execution this one re-runs.
+	// Re-runs use the same input event and root event as the original
+	// execution, but the current configuration of the node.
+	//
+	RerunOfExecutionID *uuid.UUID
+
 	//
 	// The reference to a WorkflowEvent record,
 	// which holds the input for this execution.
@@ -133,6 +154,7 @@ func ListPendingNodeExecutions() ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	query := database.Conn().
 		Where("state = ?", CanvasNodeExecutionStatePending).
+		Where("run_at IS NULL OR run_at <= ?", time.Now()).
 		Order("created_at DESC")
 
 	err := query.Find(&executions).Error
@@ -231,6 +253,24 @@ func CountRunningExecutionsForNodeInTransaction(tx *gorm.DB, workflowID uuid.UUI
 	return runningCount, nil
 }
 
+// CountInFlightExecutionsForNodeInTransaction counts the executions
+// of a node that are not finished yet, including the pending ones.
+func CountInFlightExecutionsForNodeInTransaction(tx *gorm.DB, workflowID uuid.UUID, nodeID string) (int64, error) {
+	var count int64
+	err := tx.
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_id = ?", workflowID).
+		Where("node_id = ?", nodeID).
+		Where("state IN ?", []string{CanvasNodeExecutionStatePending, CanvasNodeExecutionStateStarted}).
+		Count(&count).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func FindNodeExecution(workflowID, id uuid.UUID) (*CanvasNodeExecution, error) {
 	return FindNodeExecutionInTransaction(database.Conn(), workflowID, id)
 }
@@ -270,6 +310,64 @@ func FindNodeExecutionWithNodeIDInTransaction(tx *gorm.DB, workflowID, id uuid.U
 	return &execution, nil
 }
 
+// FindLastNodeExecutionForRootEventInTransaction finds the most recent
+// top-level execution of a node in the execution chain started by a root event.
+func FindLastNodeExecutionForRootEventInTransaction(tx *gorm.DB, workflowID, rootEventID uuid.UUID, nodeID string) (*CanvasNodeExecution, error) {
+	var execution CanvasNodeExecution
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("root_event_id = ?", rootEventID).
+		Where("node_id = ?", nodeID).
+		Where("parent_execution_id IS NULL").
+		Order("created_at DESC").
+		First(&execution).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &execution, nil
+}
+
+// ListRootEventExecutionsInTransaction lists all the top-level executions
+// in the execution chain started by a root event, oldest first.
+func ListRootEventExecutionsInTransaction(tx *gorm.DB, workflowID, rootEventID uuid.UUID) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("root_event_id = ?", rootEventID).
+		Where("parent_execution_id IS NULL").
+		Order("created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// ListExecutionsForEventsInTransaction returns the top-level
+// executions started by the given events, oldest first.
+func ListExecutionsForEventsInTransaction(tx *gorm.DB, workflowID uuid.UUID, eventIDs []uuid.UUID) ([]CanvasNodeExecution, error) {
+	var executions []CanvasNodeExecution
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("event_id IN ?", eventIDs).
+		Where("parent_execution_id IS NULL").
+		Order("created_at ASC").
+		Find(&executions).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
 func FindNodeExecutionsByIDsInTransaction(tx *gorm.DB, workflowID uuid.UUID, executionIDs []uuid.UUID) ([]CanvasNodeExecution, error) {
 	var executions []CanvasNodeExecution
 	err := tx.
@@ -345,6 +443,22 @@ func (e *CanvasNodeExecution) GetPreviousExecutionID() string {
 	return e.PreviousExecutionID.String()
 }
 
+func (e *CanvasNodeExecution) GetRetryOfExecutionID() string {
+	if e.RetryOfExecutionID == nil {
+		return ""
+	}
+
+	return e.RetryOfExecutionID.String()
+}
+
+func (e *CanvasNodeExecution) GetRerunOfExecutionID() string {
+	if e.RerunOfExecutionID == nil {
+		return ""
+	}
+
+	return e.RerunOfExecutionID.String()
+}
+
 func (e *CanvasNodeExecution) GetParentExecutionID() string {
 	if e.ParentExecutionID == nil {
 		return ""
@@ -392,26 +506,9 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	//
 	// Create events for outputs
 	//
-	events := []CanvasEvent{}
-	for channel, outputs := range channelOutputs {
-		for _, event := range outputs {
-			events = append(events, CanvasEvent{
-				WorkflowID:  e.WorkflowID,
-				NodeID:      e.NodeID,
-				Channel:     channel,
-				Data:        datatypes.NewJSONType(event),
-				ExecutionID: &e.ID,
-				State:       CanvasEventStatePending,
-				CreatedAt:   &now,
-			})
-		}
-	}
-
-	if len(events) > 0 {
-		err := tx.Create(&events).Error
-		if err != nil {
-			return nil, fmt.Errorf("failed to create events: %w", err)
-		}
+	events, err := e.EmitInTransaction(tx, channelOutputs)
+	if err != nil {
+		return nil, err
 	}
 
 	//
@@ -448,6 +545,37 @@ func (e *CanvasNodeExecution) PassInTransaction(tx *gorm.DB, channelOutputs map[
 	return events, nil
 }
 
+// EmitInTransaction creates events for the execution outputs,
+// without changing the state of the execution.
+func (e *CanvasNodeExecution) EmitInTransaction(tx *gorm.DB, channelOutputs map[string][]any) ([]CanvasEvent, error) {
+	now := time.Now()
+	events := []CanvasEvent{}
+	for channel, outputs := range channelOutputs {
+		for _, event := range outputs {
+			events = append(events, CanvasEvent{
+				WorkflowID:  e.WorkflowID,
+				NodeID:      e.NodeID,
+				Channel:     channel,
+				Data:        datatypes.NewJSONType(event),
+				ExecutionID: &e.ID,
+				State:       CanvasEventStatePending,
+				CreatedAt:   &now,
+			})
+		}
+	}
+
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	err := tx.Create(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create events: %w", err)
+	}
+
+	return events, nil
+}
+
 func (e *CanvasNodeExecution) Fail(reason, message string) error {
 	return database.Conn().Transaction(func(tx *gorm.DB) error {
 		return e.FailInTransaction(tx, reason, message)
@@ -455,6 +583,14 @@ func (e *CanvasNodeExecution) Fail(reason, message string) error {
 }
 
 func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message string) error {
+	_, err := e.failInTransaction(tx, reason, message)
+	return err
+}
+
+// failInTransaction finishes the execution as failed,
+// returning the execution created for the next attempt,
+// if the node retry policy covers this failure.
+func (e *CanvasNodeExecution) failInTransaction(tx *gorm.DB, reason, message string) (*CanvasNodeExecution, error) {
 	now := time.Now()
 
 	err := tx.Model(e).
@@ -467,22 +603,39 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 		}).Error
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	//
-	// Update the workflow node state to ready.
-	//
 	node, err := FindCanvasNode(tx, e.WorkflowID, e.NodeID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return err
+		return nil, err
 	}
 
+	//
+	// If the node retry policy covers this failure,
+	// a new attempt is scheduled, and the node stays busy
+	// until that attempt finishes, so we don't touch
+	// the node state or the parent execution here.
+	//
+	if node != nil {
+		retry, err := e.scheduleRetryInTransaction(tx, node, reason)
+		if err != nil {
+			return nil, err
+		}
+
+		if retry != nil {
+			return retry, nil
+		}
+	}
+
+	//
+	// Update the workflow node state to ready.
+	//
 	if node != nil {
 		if node.State != CanvasNodeStatePaused {
 			err := node.UpdateState(tx, CanvasNodeStateReady)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
@@ -495,13 +648,88 @@ func (e *CanvasNodeExecution) FailInTransaction(tx *gorm.DB, reason, message str
 	if e.ParentExecutionID != nil {
 		parent, err := FindNodeExecution(e.WorkflowID, *e.ParentExecutionID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		return parent.FailInTransaction(tx, reason, message)
+		return nil, parent.FailInTransaction(tx, reason, message)
 	}
 
-	return nil
+	return nil, nil
+}
+
+// TimeoutInTransaction finishes the execution as timed out.
+// Unless a new attempt is scheduled for it, an event is emitted
+// on the timeout channel, so the canvas can react to it.
+// Child executions do not emit anything, since their failure
+// is propagated to the parent execution.
+func (e *CanvasNodeExecution) TimeoutInTransaction(tx *gorm.DB, timeout time.Duration) ([]CanvasEvent, error) {
+	message := fmt.Sprintf("execution timed out after %s", timeout)
+	retry, err := e.failInTransaction(tx, CanvasNodeExecutionResultReasonTimedOut, message)
+	if err != nil {
+		return nil, err
+	}
+
+	if retry != nil || e.ParentExecutionID != nil {
+		return []CanvasEvent{}, nil
+	}
+
+	now := time.Now()
+	event := CanvasEvent{
+		WorkflowID: e.WorkflowID,
+		NodeID:     e.NodeID,
+		Channel:    CanvasNodeExecutionTimeoutChannel,
+		Data: datatypes.NewJSONType[any](map[string]any{
+			"type":      CanvasNodeExecutionTimeoutPayloadType,
+			"timestamp": now,
+			"data": map[string]any{
+				"executionId":    e.ID.String(),
+				"timeoutSeconds": int(timeout.Seconds()),
+			},
+		}),
+		ExecutionID: &e.ID,
+		State:       CanvasEventStatePending,
+		CreatedAt:   &now,
+	}
+
+	err = tx.Create(&event).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create timeout event: %w", err)
+	}
+
+	return []CanvasEvent{event}, nil
+}
+
+func (e *CanvasNodeExecution) scheduleRetryInTransaction(tx *gorm.DB, node *CanvasNode, reason string) (*CanvasNodeExecution, error) {
+	attempt := max(e.Attempt, 1)
+	policy := node.GetRetryPolicy()
+	if !policy.ShouldRetry(attempt, reason) {
+		return nil, nil
+	}
+
+	now := time.Now()
+	runAt := now.Add(policy.DelayAfterAttempt(attempt))
+	retry := CanvasNodeExecution{
+		WorkflowID:          e.WorkflowID,
+		NodeID:              e.NodeID,
+		RootEventID:         e.RootEventID,
+		EventID:             e.EventID,
+		PreviousExecutionID: e.PreviousExecutionID,
+		ParentExecutionID:   e.ParentExecutionID,
+		State:               CanvasNodeExecutionStatePending,
+		Configuration:       e.Configuration,
+		Attempt:             attempt + 1,
+		RetryOfExecutionID:  &e.ID,
+		RunAt:               &runAt,
+		CreatedAt:           &now,
+		UpdatedAt:           &now,
+	}
+
+	err := tx.Create(&retry).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to create retry execution: %w", err)
+	}
+
+	return &retry, nil
 }
 
 func (e *CanvasNodeExecution) Cancel(cancelledBy *uuid.UUID) error {
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	CanvasNodeExecutionLogLevelDebug = "debug"
+	CanvasNodeExecutionLogLevelInfo  = "info"
+	CanvasNodeExecutionLogLevelWarn  = "warn"
+	CanvasNodeExecutionLogLevelError = "error"
+)
+
+//
+// CanvasNodeExecutionLog is a structured log line written by
+// a component while running an execution.
+//
+// Log lines are written outside of the transaction used by the execution,
+// so they are visible as soon as they are written, even while
+// the execution is still running.
+//
+
+type CanvasNodeExecutionLog struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	WorkflowID  uuid.UUID `gorm:"type:uuid;not null"`
+	NodeID      string    `gorm:"type:varchar(128);not null"`
+	ExecutionID uuid.UUID `gorm:"type:uuid;not null"`
+	Level       string
+	Message     string
+	Fields      datatypes.JSONType[map[string]any]
+	CreatedAt   *time.Time
+}
+
+func (l *CanvasNodeExecutionLog) TableName() string {
+	return "workflow_node_execution_logs"
+}
+
+func CreateNodeExecutionLogInTransaction(tx *gorm.DB, execution *CanvasNodeExecution, level, message string, fields map[string]any) (*CanvasNodeExecutionLog, error) {
+	if fields == nil {
+		fields = map[string]any{}
+	}
+
+	now := time.Now()
+	log := CanvasNodeExecutionLog{
+		WorkflowID:  execution.WorkflowID,
+		NodeID:      execution.NodeID,
+		ExecutionID: execution.ID,
+		Level:       level,
+		Message:     message,
+		Fields:      datatypes.NewJSONType(fields),
+		CreatedAt:   &now,
+	}
+
+	err := tx.Create(&log).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &log, nil
+}
+
+func FindNodeExecutionLog(workflowID, id uuid.UUID) (*CanvasNodeExecutionLog, error) {
+	var log CanvasNodeExecutionLog
+	err := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Where("id = ?", id).
+		First(&log).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &log, nil
+}
+
+// ListNodeExecutionLogs returns the log lines of an execution, oldest first.
+// If afterTime is set, only log lines written after it are returned,
+// which allows clients to follow the logs of a running execution.
+func ListNodeExecutionLogs(workflowID, executionID uuid.UUID, limit int, afterTime *time.Time) ([]CanvasNodeExecutionLog, error) {
+	var logs []CanvasNodeExecutionLog
+	query := database.Conn().
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID).
+		Order("created_at ASC").
+		Limit(limit)
+
+	if afterTime != nil {
+		query = query.Where("created_at > ?", afterTime)
+	}
+
+	err := query.Find(&logs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func CountNodeExecutionLogs(workflowID, executionID uuid.UUID, afterTime *time.Time) (int64, error) {
+	var count int64
+	query := database.Conn().
+		Model(&CanvasNodeExecutionLog{}).
+		Where("workflow_id = ?", workflowID).
+		Where("execution_id = ?", executionID)
+
+	if afterTime != nil {
+		query = query.Where("created_at > ?", afterTime)
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -12,6 +12,7 @@ import (
 
 const (
 	NodeRequestTypeInvokeAction = "invoke-action"
+	NodeRequestTypeTimeout      = "timeout"
 
 	NodeExecutionRequestStatePending   = "pending"
 	NodeExecutionRequestStateCompleted = "completed"
@@ -0,0 +1,195 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	MaxCanvasVariables    = 100
+	MaxCanvasEnvironments = 20
+)
+
+var canvasVariableNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+var canvasEnvironmentNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,127}$`)
+
+// CanvasVariable is a value expressions read with env("NAME").
+// The value is given in plain text, or read from a key of an organization secret.
+type CanvasVariable struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description,omitempty"`
+	Value       string                `json:"value,omitempty"`
+	Secret      *CanvasVariableSecret `json:"secret,omitempty"`
+}
+
+type CanvasVariableSecret struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+func (v *CanvasVariable) IsSecret() bool {
+	return v.Secret != nil
+}
+
+// CanvasEnvironment overrides the values of some of the canvas variables.
+// Variables it does not mention keep the values of the spec.
+type CanvasEnvironment struct {
+	Name      string           `json:"name"`
+	Variables []CanvasVariable `json:"variables"`
+}
+
+// CanvasVariableSet groups the variables and environments of a canvas version,
+// since they are always validated, copied and merged together.
+type CanvasVariableSet struct {
+	Variables    []CanvasVariable
+	Environments []CanvasEnvironment
+}
+
+func (s CanvasVariableSet) Validate() error {
+	if len(s.Variables) > MaxCanvasVariables {
+		return fmt.Errorf("too many variables: %d (max %d)", len(s.Variables), MaxCanvasVariables)
+	}
+
+	names := map[string]bool{}
+	for i, variable := range s.Variables {
+		if err := validateCanvasVariable(variable); err != nil {
+			return fmt.Errorf("variable %d: %w", i+1, err)
+		}
+
+		if names[variable.Name] {
+			return fmt.Errorf("variable %d: duplicate name %s", i+1, variable.Name)
+		}
+
+		names[variable.Name] = true
+	}
+
+	if len(s.Environments) > MaxCanvasEnvironments {
+		return fmt.Errorf("too many environments: %d (max %d)", len(s.Environments), MaxCanvasEnvironments)
+	}
+
+	environments := map[string]bool{}
+	for i, environment := range s.Environments {
+		name := environment.Name
+		if !canvasEnvironmentNameRegex.MatchString(name) {
+			return fmt.Errorf("environment %d: invalid name %q: only letters, numbers, dots, dashes and underscores are allowed", i+1, name)
+		}
+
+		if environments[name] {
+			return fmt.Errorf("environment %d: duplicate name %s", i+1, name)
+		}
+
+		environments[name] = true
+
+		overridden := map[string]bool{}
+		for _, variable := range environment.Variables {
+			if err := validateCanvasVariable(variable); err != nil {
+				return fmt.Errorf("environment %s: %w", name, err)
+			}
+
+			//
+			// Environments only change values,
+			// so every variable is declared once, in the spec.
+			//
+			if !names[variable.Name] {
+				return fmt.Errorf("environment %s: variable %s is not defined", name, variable.Name)
+			}
+
+			if overridden[variable.Name] {
+				return fmt.Errorf("environment %s: duplicate variable %s", name, variable.Name)
+			}
+
+			overridden[variable.Name] = true
+		}
+	}
+
+	return nil
+}
+
+func validateCanvasVariable(variable CanvasVariable) error {
+	if !canvasVariableNameRegex.MatchString(variable.Name) {
+		return fmt.Errorf("invalid name %q: only letters, numbers and underscores are allowed", variable.Name)
+	}
+
+	if variable.Secret == nil {
+		return nil
+	}
+
+	if variable.Value != "" {
+		return fmt.Errorf("variable %s: value and secret cannot be used together", variable.Name)
+	}
+
+	if strings.TrimSpace(variable.Secret.Name) == "" || strings.TrimSpace(variable.Secret.Key) == "" {
+		return fmt.Errorf("variable %s: secret name and key are required", variable.Name)
+	}
+
+	return nil
+}
+
+func (s CanvasVariableSet) HasEnvironment(name string) bool {
+	for _, environment := range s.Environments {
+		if environment.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForEnvironment returns the variables with the values of an environment applied.
+// An empty name returns the values of the spec.
+func (s CanvasVariableSet) ForEnvironment(name string) (map[string]CanvasVariable, error) {
+	variables := make(map[string]CanvasVariable, len(s.Variables))
+	for _, variable := range s.Variables {
+		variables[variable.Name] = variable
+	}
+
+	if name == "" {
+		return variables, nil
+	}
+
+	for _, environment := range s.Environments {
+		if environment.Name != name {
+			continue
+		}
+
+		for _, override := range environment.Variables {
+			variable, ok := variables[override.Name]
+			if !ok {
+				continue
+			}
+
+			variable.Value = override.Value
+			variable.Secret = override.Secret
+			variables[override.Name] = variable
+		}
+
+		return variables, nil
+	}
+
+	return nil, fmt.Errorf("environment %s not found", name)
+}
+
+func (v *CanvasVersion) VariableSet() CanvasVariableSet {
+	return CanvasVariableSet{
+		Variables:    append([]CanvasVariable(nil), v.Variables...),
+		Environments: append([]CanvasEnvironment(nil), v.Environments...),
+	}
+}
+
+func (v *CanvasVersion) SetVariableSet(set CanvasVariableSet) {
+	variables := set.Variables
+	if variables == nil {
+		variables = []CanvasVariable{}
+	}
+
+	environments := set.Environments
+	if environments == nil {
+		environments = []CanvasEnvironment{}
+	}
+
+	v.Variables = datatypes.NewJSONSlice(variables)
+	v.Environments = datatypes.NewJSONSlice(environments)
+}
@@ -0,0 +1,98 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__CanvasVariableSet(t *testing.T) {
+	set := CanvasVariableSet{
+		Variables: []CanvasVariable{
+			{Name: "CHANNEL", Value: "#staging"},
+			{Name: "TOKEN", Secret: &CanvasVariableSecret{Name: "staging", Key: "token"}},
+			{Name: "REGION", Value: "us-east-1"},
+		},
+		Environments: []CanvasEnvironment{
+			{
+				Name: "production",
+				Variables: []CanvasVariable{
+					{Name: "CHANNEL", Value: "#deploys"},
+					{Name: "TOKEN", Secret: &CanvasVariableSecret{Name: "production", Key: "token"}},
+				},
+			},
+		},
+	}
+
+	t.Run("valid set", func(t *testing.T) {
+		require.NoError(t, set.Validate())
+		assert.True(t, set.HasEnvironment("production"))
+		assert.False(t, set.HasEnvironment("staging"))
+	})
+
+	t.Run("invalid sets", func(t *testing.T) {
+		invalid := CanvasVariableSet{Variables: []CanvasVariable{{Name: "a-b"}}}
+		require.ErrorContains(t, invalid.Validate(), "invalid name")
+
+		invalid = CanvasVariableSet{Variables: []CanvasVariable{{Name: "A"}, {Name: "A"}}}
+		require.ErrorContains(t, invalid.Validate(), "duplicate name A")
+
+		invalid = CanvasVariableSet{Variables: []CanvasVariable{
+			{Name: "A", Value: "x", Secret: &CanvasVariableSecret{Name: "s", Key: "k"}},
+		}}
+		require.ErrorContains(t, invalid.Validate(), "cannot be used together")
+
+		invalid = CanvasVariableSet{Variables: []CanvasVariable{{Name: "A", Secret: &CanvasVariableSecret{Name: "s"}}}}
+		require.ErrorContains(t, invalid.Validate(), "secret name and key are required")
+
+		invalid = CanvasVariableSet{
+			Variables:    []CanvasVariable{{Name: "A"}},
+			Environments: []CanvasEnvironment{{Name: "prod env"}},
+		}
+		require.ErrorContains(t, invalid.Validate(), "invalid name")
+
+		invalid = CanvasVariableSet{
+			Variables:    []CanvasVariable{{Name: "A"}},
+			Environments: []CanvasEnvironment{{Name: "prod"}, {Name: "prod"}},
+		}
+		require.ErrorContains(t, invalid.Validate(), "duplicate name prod")
+
+		invalid = CanvasVariableSet{
+			Variables:    []CanvasVariable{{Name: "A"}},
+			Environments: []CanvasEnvironment{{Name: "prod", Variables: []CanvasVariable{{Name: "B", Value: "x"}}}},
+		}
+		require.ErrorContains(t, invalid.Validate(), "variable B is not defined")
+
+		invalid = CanvasVariableSet{
+			Variables: []CanvasVariable{{Name: "A"}},
+			Environments: []CanvasEnvironment{{Name: "prod", Variables: []CanvasVariable{
+				{Name: "A", Value: "x"},
+				{Name: "A", Value: "y"},
+			}}},
+		}
+		require.ErrorContains(t, invalid.Validate(), "duplicate variable A")
+	})
+
+	t.Run("variables without environment use the spec values", func(t *testing.T) {
+		variables, err := set.ForEnvironment("")
+		require.NoError(t, err)
+		require.Len(t, variables, 3)
+		assert.Equal(t, "#staging", variables["CHANNEL"].Value)
+		assert.Equal(t, "staging", variables["TOKEN"].Secret.Name)
+	})
+
+	t.Run("environment overrides values", func(t *testing.T) {
+		variables, err := set.ForEnvironment("production")
+		require.NoError(t, err)
+		require.Len(t, variables, 3)
+		assert.Equal(t, "#deploys", variables["CHANNEL"].Value)
+		assert.Equal(t, "production", variables["TOKEN"].Secret.Name)
+		assert.Equal(t, "us-east-1", variables["REGION"].Value)
+	})
+
+	t.Run("unknown environment -> error", func(t *testing.T) {
+		_, err := set.ForEnvironment("staging")
+		require.ErrorContains(t, err, "environment staging not found")
+	})
+}
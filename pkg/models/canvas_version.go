@@ -14,15 +14,17 @@ import (
 var ErrCanvasDraftNotFound = errors.New("canvas draft not found")
 
 type CanvasVersion struct {
-	ID          uuid.UUID
-	WorkflowID  uuid.UUID
-	OwnerID     *uuid.UUID
-	IsPublished bool
-	PublishedAt *time.Time
-	Nodes       datatypes.JSONSlice[Node]
-	Edges       datatypes.JSONSlice[Edge]
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	ID           uuid.UUID
+	WorkflowID   uuid.UUID
+	OwnerID      *uuid.UUID
+	IsPublished  bool
+	PublishedAt  *time.Time
+	Nodes        datatypes.JSONSlice[Node]
+	Edges        datatypes.JSONSlice[Edge]
+	Variables    datatypes.JSONSlice[CanvasVariable]
+	Environments datatypes.JSONSlice[CanvasEnvironment]
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
 }
 
 func (c *CanvasVersion) TableName() string {
@@ -172,6 +174,7 @@ func CreatePublishedCanvasVersionInTransaction(
 	ownerID *uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables CanvasVariableSet,
 ) (*CanvasVersion, error) {
 	canvas, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -190,6 +193,7 @@ func CreatePublishedCanvasVersionInTransaction(
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
+	version.SetVariableSet(variables)
 
 	if err := tx.Create(&version).Error; err != nil {
 		return nil, err
@@ -211,6 +215,7 @@ func SaveCanvasDraftInTransaction(
 	userID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables CanvasVariableSet,
 ) (*CanvasVersion, error) {
 	_, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -228,6 +233,7 @@ func SaveCanvasDraftInTransaction(
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
+	version.SetVariableSet(variables)
 
 	if err := tx.Create(&version).Error; err != nil {
 		return nil, err
@@ -260,6 +266,7 @@ func CreateOrResetCanvasDraftInTransaction(
 	userID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables CanvasVariableSet,
 ) (*CanvasVersion, error) {
 	canvas, err := lockCanvasForVersioningInTransaction(tx, workflowID)
 	if err != nil {
@@ -281,6 +288,7 @@ func CreateOrResetCanvasDraftInTransaction(
 		version.OwnerID = &userID
 		version.Nodes = datatypes.NewJSONSlice(nodes)
 		version.Edges = datatypes.NewJSONSlice(edges)
+		version.SetVariableSet(variables)
 		version.IsPublished = false
 		version.PublishedAt = nil
 		version.UpdatedAt = &now
@@ -311,6 +319,7 @@ func CreateOrResetCanvasDraftInTransaction(
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
+	version.SetVariableSet(variables)
 	if err := tx.Create(&version).Error; err != nil {
 		return nil, err
 	}
@@ -335,6 +344,7 @@ func CreateCanvasSnapshotVersionInTransaction(
 	ownerID uuid.UUID,
 	nodes []Node,
 	edges []Edge,
+	variables CanvasVariableSet,
 ) (*CanvasVersion, error) {
 	if _, err := lockCanvasForVersioningInTransaction(tx, workflowID); err != nil {
 		return nil, err
@@ -351,6 +361,7 @@ func CreateCanvasSnapshotVersionInTransaction(
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
+	version.SetVariableSet(variables)
 
 	if err := tx.Create(&version).Error; err != nil {
 		return nil, err
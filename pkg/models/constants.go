@@ -7,6 +7,7 @@ const (
 	ProviderGoogle = "google"
 
 	DomainTypeOrganization = "org"
+	DomainTypeCanvas       = "canvas"
 
 	DisplayNameOwner  = "Owner"
 	DisplayNameAdmin  = "Admin"
@@ -16,11 +17,19 @@ const (
 	RoleOrgAdmin  = "org_admin"
 	RoleOrgViewer = "org_viewer"
 
+	RoleCanvasViewer   = "canvas_viewer"
+	RoleCanvasOperator = "canvas_operator"
+	RoleCanvasEditor   = "canvas_editor"
+
 	// Role descriptions
 	DescOrgOwner  = "Complete control over the organization including settings and deletion"
 	DescOrgAdmin  = "Full management access to organization resources including canvases and users"
 	DescOrgViewer = "Read-only access to organization resources"
 
+	DescCanvasViewer   = "Read-only access to the canvas"
+	DescCanvasOperator = "Can approve, cancel and invoke actions on the canvas, without editing it"
+	DescCanvasEditor   = "Can edit the canvas and operate it"
+
 	// Metadata descriptions
 	MetaDescOrgOwner  = "Full control over organization settings, billing, and member management."
 	MetaDescOrgAdmin  = "Can manage canvases, users, groups, and roles within the organization."
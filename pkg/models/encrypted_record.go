@@ -0,0 +1,218 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+)
+
+// EncryptedColumn is a column holding values encrypted with the crypto.Encryptor.
+// The associated data used for the encryption comes from another column of the same record,
+// or is the same for every record.
+type EncryptedColumn struct {
+	Table                string
+	Column               string
+	AssociatedDataColumn string
+	AssociatedData       string
+
+	// Base64 is set for text columns holding base64-encoded ciphertexts.
+	Base64 bool
+
+	// JSONObject is set for JSON columns whose fields can hold base64-encoded ciphertexts,
+	// like the sensitive fields of integration configurations. Only the fields
+	// encrypted with envelope encryption are found, since other values cannot be told apart.
+	JSONObject bool
+}
+
+// EncryptedColumns lists the columns re-encrypted when the master key changes.
+var EncryptedColumns = []EncryptedColumn{
+	{Table: "secrets", Column: "data", AssociatedDataColumn: "name"},
+	{Table: "app_installation_secrets", Column: "value", AssociatedDataColumn: "installation_id"},
+	{Table: "app_installations", Column: "configuration", AssociatedDataColumn: "id", JSONObject: true},
+	{Table: "webhooks", Column: "secret", AssociatedDataColumn: "id"},
+	{Table: "event_sinks", Column: "secret_ciphertext", AssociatedDataColumn: "organization_id"},
+	{Table: "account_providers", Column: "access_token", AssociatedDataColumn: "email", Base64: true},
+	{Table: "email_settings", Column: "smtp_password", AssociatedData: "smtp_password"},
+	{Table: "organization_agent_settings", Column: "openai_api_key_ciphertext", AssociatedData: "agent_mode_openai_api_key"},
+}
+
+func (c EncryptedColumn) String() string {
+	return fmt.Sprintf("%s.%s", c.Table, c.Column)
+}
+
+// values returns a query for the encrypted values of the column,
+// with the ID of their record, their field for JSON columns,
+// the ciphertext and the associated data.
+//
+// Table and column names come from EncryptedColumns,
+// never from user input, so they can be inlined.
+func (c EncryptedColumn) values() string {
+	associatedData := "''"
+	if c.AssociatedDataColumn != "" {
+		associatedData = fmt.Sprintf("t.%s::text", c.AssociatedDataColumn)
+	}
+
+	switch {
+	case c.JSONObject:
+		//
+		// Envelope ciphertexts start with "SPE", which is "U1BF" in base64.
+		//
+		return fmt.Sprintf(
+			`SELECT t.id, f.key AS field, decode(f.value, 'base64') AS data, %s AS associated_data
+			FROM %s t, jsonb_each_text(CASE WHEN jsonb_typeof(t.%s) = 'object' THEN t.%s ELSE '{}'::jsonb END) f
+			WHERE f.value ~ '^U1BF[A-Za-z0-9+/]*={0,2}$' AND length(f.value) %% 4 = 0`,
+			associatedData,
+			c.Table,
+			c.Column,
+			c.Column,
+		)
+
+	case c.Base64:
+		return fmt.Sprintf(
+			`SELECT t.id, '' AS field, decode(t.%s, 'base64') AS data, %s AS associated_data
+			FROM %s t WHERE length(t.%s) > 0`,
+			c.Column,
+			associatedData,
+			c.Table,
+			c.Column,
+		)
+
+	default:
+		return fmt.Sprintf(
+			`SELECT t.id, '' AS field, t.%s AS data, %s AS associated_data
+			FROM %s t WHERE length(t.%s) > 0`,
+			c.Column,
+			associatedData,
+			c.Table,
+			c.Column,
+		)
+	}
+}
+
+type EncryptedRecord struct {
+	ID             uuid.UUID
+	Field          string
+	Data           []byte
+	AssociatedData string
+}
+
+// EncryptedRecordCursor is the position of a record in its column,
+// used to go through the records of a column in order.
+type EncryptedRecordCursor struct {
+	ID    uuid.UUID
+	Field string
+}
+
+func (r EncryptedRecord) Cursor() EncryptedRecordCursor {
+	return EncryptedRecordCursor{ID: r.ID, Field: r.Field}
+}
+
+// ListEncryptedRecordsWithoutPrefix lists the records after the given cursor
+// whose encrypted value does not start with the prefix.
+func ListEncryptedRecordsWithoutPrefix(column EncryptedColumn, prefix []byte, after EncryptedRecordCursor, limit int) ([]EncryptedRecord, error) {
+	var records []EncryptedRecord
+
+	query := fmt.Sprintf(
+		`SELECT id, field, data, associated_data FROM (%s) v
+		WHERE (id, field) > (?, ?) AND substring(data from 1 for ?) <> ?
+		ORDER BY id ASC, field ASC
+		LIMIT ?`,
+		column.values(),
+	)
+
+	err := database.Conn().Raw(query, after.ID, after.Field, len(prefix), prefix, limit).Scan(&records).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if column.AssociatedData != "" {
+		for i := range records {
+			records[i].AssociatedData = column.AssociatedData
+		}
+	}
+
+	return records, nil
+}
+
+// ListMasterKeyIDs returns the IDs of the master keys
+// used by the values of a column, sorted.
+func ListMasterKeyIDs(column EncryptedColumn) ([]string, error) {
+	var headers []struct {
+		Header []byte
+	}
+
+	query := fmt.Sprintf(`SELECT DISTINCT substring(data from 1 for ?) AS header FROM (%s) v`, column.values())
+	err := database.Conn().Raw(query, crypto.EnvelopeHeaderLength).Scan(&headers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]bool{}
+	for _, row := range headers {
+		if keyID, ok := crypto.KeyID(row.Header); ok {
+			found[keyID] = true
+		}
+	}
+
+	keyIDs := make([]string, 0, len(found))
+	for keyID := range found {
+		keyIDs = append(keyIDs, keyID)
+	}
+
+	sort.Strings(keyIDs)
+	return keyIDs, nil
+}
+
+// ReplaceEncryptedRecord updates the encrypted value of a record,
+// only if neither the value nor its associated data changed since it was read.
+// It returns false if the record was changed in the meantime.
+func ReplaceEncryptedRecord(column EncryptedColumn, record EncryptedRecord, data []byte) (bool, error) {
+	var query string
+	var args []any
+
+	switch {
+	case column.JSONObject:
+		query = fmt.Sprintf(
+			"UPDATE %s SET %s = jsonb_set(%s, ARRAY[?]::text[], to_jsonb(?::text)) WHERE id = ? AND %s->>? = ?",
+			column.Table,
+			column.Column,
+			column.Column,
+			column.Column,
+		)
+		args = []any{
+			record.Field,
+			base64.StdEncoding.EncodeToString(data),
+			record.ID,
+			record.Field,
+			base64.StdEncoding.EncodeToString(record.Data),
+		}
+
+	case column.Base64:
+		query = fmt.Sprintf("UPDATE %s SET %s = ? WHERE id = ? AND %s = ?", column.Table, column.Column, column.Column)
+		args = []any{
+			base64.StdEncoding.EncodeToString(data),
+			record.ID,
+			base64.StdEncoding.EncodeToString(record.Data),
+		}
+
+	default:
+		query = fmt.Sprintf("UPDATE %s SET %s = ? WHERE id = ? AND %s = ?", column.Table, column.Column, column.Column)
+		args = []any{data, record.ID, record.Data}
+	}
+
+	if column.AssociatedDataColumn != "" {
+		query += fmt.Sprintf(" AND %s::text = ?", column.AssociatedDataColumn)
+		args = append(args, record.AssociatedData)
+	}
+
+	result := database.Conn().Exec(query, args...)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,340 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	EventSinkTypeWebhook = "webhook"
+	EventSinkTypeSyslog  = "syslog"
+
+	EventSinkCategoryCanvasEvents = "canvas_events"
+	EventSinkCategoryExecutions   = "executions"
+	EventSinkCategoryAuditEvents  = "audit_events"
+
+	EventSinkDeliveryStatePending   = "pending"
+	EventSinkDeliveryStateDelivered = "delivered"
+	EventSinkDeliveryStateFailed    = "failed"
+)
+
+var EventSinkCategories = []string{
+	EventSinkCategoryCanvasEvents,
+	EventSinkCategoryExecutions,
+	EventSinkCategoryAuditEvents,
+}
+
+// EventSink streams the activity of an organization to an external system.
+// Webhook sinks receive each event as a signed JSON POST request,
+// and syslog sinks receive it as an RFC 5424 message over udp, tcp or tls.
+type EventSink struct {
+	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID   uuid.UUID `gorm:"type:uuid"`
+	Type             string
+	URL              string
+	SecretCiphertext []byte
+	Categories       datatypes.JSONSlice[string]
+	Enabled          bool
+
+	//
+	// Outcome of the last delivery attempts,
+	// so problems with the sink are visible without looking at every delivery.
+	//
+	LastDeliveredAt *time.Time
+	LastError       *string
+	LastErrorAt     *time.Time
+
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (s *EventSink) TableName() string {
+	return "event_sinks"
+}
+
+func (s *EventSink) Subscribes(category string) bool {
+	return s.Enabled && slices.Contains(s.Categories, category)
+}
+
+func (s *EventSink) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid url")
+	}
+
+	switch s.Type {
+	case EventSinkTypeWebhook:
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("webhook url must use http or https")
+		}
+	case EventSinkTypeSyslog:
+		if u.Scheme != "udp" && u.Scheme != "tcp" && u.Scheme != "tls" {
+			return fmt.Errorf("syslog url must use udp, tcp or tls")
+		}
+
+		if u.Port() == "" {
+			return fmt.Errorf("syslog url must include a port")
+		}
+	default:
+		return fmt.Errorf("unsupported event sink type %s", s.Type)
+	}
+
+	if len(s.Categories) == 0 {
+		return fmt.Errorf("at least one category is required")
+	}
+
+	for _, category := range s.Categories {
+		if !slices.Contains(EventSinkCategories, category) {
+			return fmt.Errorf("unsupported category %s", category)
+		}
+	}
+
+	return nil
+}
+
+func FindEventSink(organizationID uuid.UUID) (*EventSink, error) {
+	return FindEventSinkInTransaction(database.Conn(), organizationID)
+}
+
+func FindEventSinkInTransaction(tx *gorm.DB, organizationID uuid.UUID) (*EventSink, error) {
+	var sink EventSink
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		First(&sink).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sink, nil
+}
+
+func FindEventSinkByID(id uuid.UUID) (*EventSink, error) {
+	var sink EventSink
+
+	err := database.Conn().
+		Where("id = ?", id).
+		First(&sink).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sink, nil
+}
+
+func UpsertEventSinkInTransaction(tx *gorm.DB, sink *EventSink) error {
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"type",
+					"url",
+					"secret_ciphertext",
+					"categories",
+					"enabled",
+					"updated_by",
+					"updated_at",
+				}),
+			},
+		).
+		Create(sink).
+		Error
+}
+
+func DeleteEventSink(organizationID uuid.UUID) error {
+	return database.Conn().
+		Where("organization_id = ?", organizationID).
+		Delete(&EventSink{}).
+		Error
+}
+
+func (s *EventSink) RecordDelivery(now time.Time) error {
+	return database.Conn().
+		Model(s).
+		Update("last_delivered_at", now).
+		Error
+}
+
+func (s *EventSink) RecordError(now time.Time, message string) error {
+	return database.Conn().
+		Model(s).
+		Updates(map[string]any{
+			"last_error":    message,
+			"last_error_at": now,
+		}).
+		Error
+}
+
+// EventSinkDelivery is one event waiting to be delivered to a sink,
+// or already delivered to it.
+type EventSinkDelivery struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SinkID        uuid.UUID `gorm:"type:uuid"`
+	EventType     string
+	DedupKey      string
+	Payload       datatypes.JSON
+	State         string
+	Attempts      int
+	NextAttemptAt *time.Time
+	LastError     *string
+	DeliveredAt   *time.Time
+	CreatedAt     *time.Time
+}
+
+func (d *EventSinkDelivery) TableName() string {
+	return "event_sink_deliveries"
+}
+
+type EventSinkBacklog struct {
+	Pending         int64
+	Failed          int64
+	OldestPendingAt *time.Time
+}
+
+// CreateEventSinkDelivery adds an event to the backlog of a sink.
+// Events which were already added are ignored.
+func CreateEventSinkDelivery(delivery *EventSinkDelivery) error {
+	now := time.Now()
+	if delivery.State == "" {
+		delivery.State = EventSinkDeliveryStatePending
+	}
+
+	if delivery.NextAttemptAt == nil {
+		delivery.NextAttemptAt = &now
+	}
+
+	if delivery.CreatedAt == nil {
+		delivery.CreatedAt = &now
+	}
+
+	return database.Conn().
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "sink_id"}, {Name: "dedup_key"}},
+			DoNothing: true,
+		}).
+		Create(delivery).
+		Error
+}
+
+// ListPendingEventSinkDeliveries returns the deliveries due for an attempt.
+// Deliveries for disabled sinks stay in the backlog until the sink is enabled again.
+func ListPendingEventSinkDeliveries(limit int) ([]EventSinkDelivery, error) {
+	var deliveries []EventSinkDelivery
+
+	err := database.Conn().
+		Joins("JOIN event_sinks ON event_sinks.id = event_sink_deliveries.sink_id").
+		Where("event_sinks.enabled = ?", true).
+		Where("event_sink_deliveries.state = ?", EventSinkDeliveryStatePending).
+		Where("event_sink_deliveries.next_attempt_at <= ?", time.Now()).
+		Order("event_sink_deliveries.created_at ASC").
+		Limit(limit).
+		Find(&deliveries).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func LockEventSinkDelivery(tx *gorm.DB, id uuid.UUID) (*EventSinkDelivery, error) {
+	var delivery EventSinkDelivery
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		Where("state = ?", EventSinkDeliveryStatePending).
+		First(&delivery).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (d *EventSinkDelivery) MarkDeliveredInTransaction(tx *gorm.DB, now time.Time) error {
+	d.State = EventSinkDeliveryStateDelivered
+	d.Attempts++
+	d.DeliveredAt = &now
+	d.LastError = nil
+
+	return tx.Save(d).Error
+}
+
+// MarkFailedInTransaction records a failed attempt.
+// The delivery is retried at nextAttemptAt, or given up on when it is nil.
+func (d *EventSinkDelivery) MarkFailedInTransaction(tx *gorm.DB, message string, nextAttemptAt *time.Time) error {
+	d.Attempts++
+	d.LastError = &message
+	if nextAttemptAt == nil {
+		d.State = EventSinkDeliveryStateFailed
+	} else {
+		d.NextAttemptAt = nextAttemptAt
+	}
+
+	return tx.Save(d).Error
+}
+
+func FindEventSinkBacklog(sinkID uuid.UUID) (*EventSinkBacklog, error) {
+	backlog := EventSinkBacklog{}
+
+	err := database.Conn().
+		Model(&EventSinkDelivery{}).
+		Select(
+			"COUNT(*) FILTER (WHERE state = ?) AS pending, COUNT(*) FILTER (WHERE state = ?) AS failed, MIN(created_at) FILTER (WHERE state = ?) AS oldest_pending_at",
+			EventSinkDeliveryStatePending,
+			EventSinkDeliveryStateFailed,
+			EventSinkDeliveryStatePending,
+		).
+		Where("sink_id = ?", sinkID).
+		Scan(&backlog).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &backlog, nil
+}
+
+// RetryFailedEventSinkDeliveries puts the deliveries which were given up on
+// back into the backlog, and returns how many were requeued.
+func RetryFailedEventSinkDeliveries(sinkID uuid.UUID) (int64, error) {
+	result := database.Conn().
+		Model(&EventSinkDelivery{}).
+		Where("sink_id = ?", sinkID).
+		Where("state = ?", EventSinkDeliveryStateFailed).
+		Updates(map[string]any{
+			"state":           EventSinkDeliveryStatePending,
+			"attempts":        0,
+			"next_attempt_at": time.Now(),
+		})
+
+	return result.RowsAffected, result.Error
+}
+
+func DeleteDeliveredEventSinkDeliveries(before time.Time) (int64, error) {
+	result := database.Conn().
+		Where("state = ?", EventSinkDeliveryStateDelivered).
+		Where("delivered_at < ?", before).
+		Delete(&EventSinkDelivery{})
+
+	return result.RowsAffected, result.Error
+}
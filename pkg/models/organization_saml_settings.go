@@ -0,0 +1,137 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OrganizationSAMLSettings configures single sign-on with a SAML identity provider.
+// When enforced, people can only use the organization after signing in with the IdP.
+type OrganizationSAMLSettings struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid"`
+	Enabled        bool
+	Enforced       bool
+
+	IdpMetadata     string
+	IdpEntityID     string
+	IdpSSOURL       string `gorm:"column:idp_sso_url"`
+	IdpCertificates datatypes.JSONSlice[string]
+
+	//
+	// Names of the assertion attributes holding the email, the name and the groups.
+	// When no email attribute is configured, the NameID is used as the email.
+	//
+	EmailAttribute  *string
+	NameAttribute   *string
+	GroupsAttribute *string
+	GroupMappings   datatypes.JSONSlice[SAMLGroupMapping]
+
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SAMLGroupMapping adds the members of an IdP group to an organization group.
+type SAMLGroupMapping struct {
+	IdpGroup string `json:"idpGroup"`
+	Group    string `json:"group"`
+}
+
+func (s *OrganizationSAMLSettings) TableName() string {
+	return "organization_saml_settings"
+}
+
+func (s *OrganizationSAMLSettings) IsEnforced() bool {
+	return s.Enabled && s.Enforced
+}
+
+// MappedGroups returns the organization groups for the IdP groups of a person.
+func (s *OrganizationSAMLSettings) MappedGroups(idpGroups []string) []string {
+	groups := []string{}
+	for _, mapping := range s.GroupMappings {
+		if slices.Contains(idpGroups, mapping.IdpGroup) && !slices.Contains(groups, mapping.Group) {
+			groups = append(groups, mapping.Group)
+		}
+	}
+
+	return groups
+}
+
+// ManagedGroups returns all the organization groups with a mapping,
+// whose membership is kept in sync with the IdP.
+func (s *OrganizationSAMLSettings) ManagedGroups() []string {
+	groups := []string{}
+	for _, mapping := range s.GroupMappings {
+		if !slices.Contains(groups, mapping.Group) {
+			groups = append(groups, mapping.Group)
+		}
+	}
+
+	return groups
+}
+
+func FindOrganizationSAMLSettings(organizationID uuid.UUID) (*OrganizationSAMLSettings, error) {
+	var settings OrganizationSAMLSettings
+
+	err := database.Conn().
+		Where("organization_id = ?", organizationID).
+		First(&settings).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &settings, nil
+}
+
+// IsSAMLEnforced reports whether people must sign in with the IdP of an organization.
+func IsSAMLEnforced(organizationID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := database.Conn().
+		Model(&OrganizationSAMLSettings{}).
+		Where("organization_id = ?", organizationID).
+		Where("enabled = ?", true).
+		Where("enforced = ?", true).
+		Count(&count).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func UpsertOrganizationSAMLSettingsInTransaction(tx *gorm.DB, settings *OrganizationSAMLSettings) error {
+	return tx.
+		Clauses(
+			clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"enabled",
+					"enforced",
+					"idp_metadata",
+					"idp_entity_id",
+					"idp_sso_url",
+					"idp_certificates",
+					"email_attribute",
+					"name_attribute",
+					"groups_attribute",
+					"group_mappings",
+					"updated_by",
+					"updated_at",
+				}),
+			},
+		).
+		Create(settings).
+		Error
+}
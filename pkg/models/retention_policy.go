@@ -0,0 +1,367 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const MaxRetentionDays = 3650
+
+const (
+	RetentionPolicySourceNone         = "none"
+	RetentionPolicySourceOrganization = "organization"
+	RetentionPolicySourceCanvas       = "canvas"
+)
+
+// RetentionPolicy controls how long the history of canvases is kept.
+// A policy without a workflow ID is the default for every canvas in the organization,
+// and a policy with a workflow ID overrides it for that canvas.
+// A zero value disables the corresponding rule.
+type RetentionPolicy struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid"`
+	WorkflowID     *uuid.UUID
+
+	//
+	// Finished executions, events and dead-lettered queue items
+	// older than this are deleted.
+	//
+	MaxAgeDays int
+
+	//
+	// Only the latest finished executions of each node are kept.
+	//
+	MaxExecutionsPerNode int
+
+	//
+	// When set, failed executions are only deleted once they are older than this,
+	// regardless of the other rules, so they can be kept around for longer.
+	//
+	FailedMaxAgeDays int
+
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (p *RetentionPolicy) TableName() string {
+	return "retention_policies"
+}
+
+func (p *RetentionPolicy) IsEnabled() bool {
+	return p.MaxAgeDays > 0 || p.MaxExecutionsPerNode > 0 || p.FailedMaxAgeDays > 0
+}
+
+func (p *RetentionPolicy) Validate() error {
+	if p.MaxAgeDays < 0 || p.MaxAgeDays > MaxRetentionDays {
+		return fmt.Errorf("max age must be between 0 and %d days", MaxRetentionDays)
+	}
+
+	if p.FailedMaxAgeDays < 0 || p.FailedMaxAgeDays > MaxRetentionDays {
+		return fmt.Errorf("failed max age must be between 0 and %d days", MaxRetentionDays)
+	}
+
+	if p.MaxExecutionsPerNode < 0 {
+		return fmt.Errorf("max executions per node must not be negative")
+	}
+
+	if p.FailedMaxAgeDays > 0 && p.FailedMaxAgeDays < p.MaxAgeDays {
+		return fmt.Errorf("failed executions must be kept at least as long as the max age")
+	}
+
+	return nil
+}
+
+func FindOrganizationRetentionPolicy(organizationID uuid.UUID) (*RetentionPolicy, error) {
+	return FindOrganizationRetentionPolicyInTransaction(database.Conn(), organizationID)
+}
+
+func FindOrganizationRetentionPolicyInTransaction(tx *gorm.DB, organizationID uuid.UUID) (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		Where("workflow_id IS NULL").
+		First(&policy).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func FindCanvasRetentionPolicy(workflowID uuid.UUID) (*RetentionPolicy, error) {
+	return FindCanvasRetentionPolicyInTransaction(database.Conn(), workflowID)
+}
+
+func FindCanvasRetentionPolicyInTransaction(tx *gorm.DB, workflowID uuid.UUID) (*RetentionPolicy, error) {
+	var policy RetentionPolicy
+
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		First(&policy).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// FindEffectiveRetentionPolicyInTransaction returns the policy applied to a canvas,
+// and where it comes from. The canvas override takes precedence over the organization default.
+// A nil policy is returned if neither exists.
+func FindEffectiveRetentionPolicyInTransaction(tx *gorm.DB, organizationID, workflowID uuid.UUID) (*RetentionPolicy, string, error) {
+	var policies []RetentionPolicy
+
+	err := tx.
+		Where("organization_id = ?", organizationID).
+		Where("workflow_id = ? OR workflow_id IS NULL", workflowID).
+		Find(&policies).
+		Error
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	var organizationPolicy *RetentionPolicy
+	for i := range policies {
+		if policies[i].WorkflowID != nil {
+			return &policies[i], RetentionPolicySourceCanvas, nil
+		}
+
+		organizationPolicy = &policies[i]
+	}
+
+	if organizationPolicy != nil {
+		return organizationPolicy, RetentionPolicySourceOrganization, nil
+	}
+
+	return nil, RetentionPolicySourceNone, nil
+}
+
+func UpsertRetentionPolicyInTransaction(tx *gorm.DB, policy *RetentionPolicy) error {
+	onConflict := clause.OnConflict{
+		Columns: []clause.Column{{Name: "organization_id"}},
+		TargetWhere: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "workflow_id IS NULL"},
+		}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"max_age_days",
+			"max_executions_per_node",
+			"failed_max_age_days",
+			"updated_by",
+			"updated_at",
+		}),
+	}
+
+	if policy.WorkflowID != nil {
+		onConflict.Columns = []clause.Column{{Name: "workflow_id"}}
+		onConflict.TargetWhere = clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "workflow_id IS NOT NULL"},
+		}}
+	}
+
+	return tx.Clauses(onConflict).Create(policy).Error
+}
+
+func DeleteCanvasRetentionPolicyInTransaction(tx *gorm.DB, workflowID uuid.UUID) error {
+	return tx.
+		Where("workflow_id = ?", workflowID).
+		Delete(&RetentionPolicy{}).
+		Error
+}
+
+// ListCanvasesWithRetentionPolicy returns the canvases which have
+// a retention policy applied to them, either their own or their organization's.
+func ListCanvasesWithRetentionPolicy() ([]Canvas, error) {
+	var canvases []Canvas
+
+	err := database.Conn().
+		Where("is_template = ?", false).
+		Where(
+			"EXISTS (?)",
+			database.Conn().
+				Model(&RetentionPolicy{}).
+				Select("1").
+				Where("retention_policies.workflow_id = workflows.id OR (retention_policies.workflow_id IS NULL AND retention_policies.organization_id = workflows.organization_id)"),
+		).
+		Find(&canvases).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return canvases, nil
+}
+
+// LockCanvasForRetention locks a canvas being processed by the retention worker.
+// NO KEY UPDATE is used, so new executions and events can still be created while it is held.
+func LockCanvasForRetention(tx *gorm.DB, id uuid.UUID) (*Canvas, error) {
+	var canvas Canvas
+
+	err := tx.
+		Clauses(clause.Locking{Strength: "NO KEY UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ?", id).
+		First(&canvas).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &canvas, nil
+}
+
+// ExpiredExecutionsQuery returns a query for the top-level finished executions of a node
+// which are not retained by the policy anymore. Child executions, KVs, requests and
+// output events of those executions are deleted with them through foreign keys.
+//
+// Executions whose output events are still used by a queue item or by any other
+// execution are never returned. Deleting them would remove the input of something
+// that has not run yet, or break the history of a downstream execution that is kept.
+// Chains are expired from the end: once the downstream executions are gone,
+// the upstream ones are returned on the next run.
+//
+// A nil query is returned if the policy does not expire executions.
+func (p *RetentionPolicy) ExpiredExecutionsQuery(tx *gorm.DB, workflowID uuid.UUID, nodeID string, now time.Time) *gorm.DB {
+	rules := []string{}
+	args := []any{}
+
+	if p.MaxAgeDays > 0 {
+		rules = append(rules, "workflow_node_executions.created_at < ?")
+		args = append(args, p.cutoff(now, p.MaxAgeDays))
+	}
+
+	if p.MaxExecutionsPerNode > 0 {
+		rules = append(rules, `workflow_node_executions.id NOT IN (
+			SELECT latest.id FROM workflow_node_executions AS latest
+			WHERE latest.workflow_id = ? AND latest.node_id = ? AND latest.parent_execution_id IS NULL
+			ORDER BY latest.created_at DESC
+			LIMIT ?
+		)`)
+		args = append(args, workflowID, nodeID, p.MaxExecutionsPerNode)
+	}
+
+	if len(rules) == 0 && p.FailedMaxAgeDays == 0 {
+		return nil
+	}
+
+	query := tx.
+		Model(&CanvasNodeExecution{}).
+		Where("workflow_node_executions.workflow_id = ?", workflowID).
+		Where("workflow_node_executions.node_id = ?", nodeID).
+		Where("workflow_node_executions.parent_execution_id IS NULL").
+		Where("workflow_node_executions.state = ?", CanvasNodeExecutionStateFinished).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_events AS output
+			WHERE output.execution_id = workflow_node_executions.id
+			AND (
+				EXISTS (SELECT 1 FROM workflow_node_queue_items AS qi WHERE qi.event_id = output.id)
+				OR EXISTS (SELECT 1 FROM workflow_node_executions AS next WHERE next.event_id = output.id)
+			)
+		)`)
+
+	if p.FailedMaxAgeDays == 0 {
+		return query.Where("("+strings.Join(rules, " OR ")+")", args...)
+	}
+
+	failedRule := "(workflow_node_executions.result = ? AND workflow_node_executions.created_at < ?)"
+	failedArgs := []any{CanvasNodeExecutionResultFailed, p.cutoff(now, p.FailedMaxAgeDays)}
+	if len(rules) == 0 {
+		return query.Where(failedRule, failedArgs...)
+	}
+
+	otherRule := "(workflow_node_executions.result != ? AND (" + strings.Join(rules, " OR ") + "))"
+	otherArgs := append([]any{CanvasNodeExecutionResultFailed}, args...)
+	return query.Where(otherRule+" OR "+failedRule, append(otherArgs, failedArgs...)...)
+}
+
+// ExpiredEventsQuery returns a query for the events of a node older than the max age,
+// which are not used by any execution or queue item anymore.
+// A nil query is returned if the policy does not expire events.
+func (p *RetentionPolicy) ExpiredEventsQuery(tx *gorm.DB, workflowID uuid.UUID, nodeID string, now time.Time) *gorm.DB {
+	if p.MaxAgeDays == 0 {
+		return nil
+	}
+
+	return tx.
+		Model(&CanvasEvent{}).
+		Where("workflow_events.workflow_id = ?", workflowID).
+		Where("workflow_events.node_id = ?", nodeID).
+		Where("workflow_events.state != ?", CanvasEventStatePending).
+		Where("workflow_events.created_at < ?", p.cutoff(now, p.MaxAgeDays)).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_executions AS e
+			WHERE e.event_id = workflow_events.id OR e.root_event_id = workflow_events.id
+		)`).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM workflow_node_queue_items AS qi
+			WHERE qi.event_id = workflow_events.id OR qi.root_event_id = workflow_events.id
+		)`)
+}
+
+// ExpiredQueueItemsQuery returns a query for the dead-lettered queue items
+// of a node older than the max age. Pending queue items are never expired.
+// A nil query is returned if the policy does not expire queue items.
+func (p *RetentionPolicy) ExpiredQueueItemsQuery(tx *gorm.DB, workflowID uuid.UUID, nodeID string, now time.Time) *gorm.DB {
+	if p.MaxAgeDays == 0 {
+		return nil
+	}
+
+	return tx.
+		Model(&CanvasNodeQueueItem{}).
+		Where("workflow_id = ?", workflowID).
+		Where("node_id = ?", nodeID).
+		Where("state = ?", CanvasNodeQueueItemStateDeadLetter).
+		Where("created_at < ?", p.cutoff(now, p.MaxAgeDays))
+}
+
+func (p *RetentionPolicy) cutoff(now time.Time, days int) time.Time {
+	return now.Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+// DeleteExecutionsInTransaction deletes the given executions,
+// together with the logs of those executions and of their child executions,
+// which are not removed through foreign keys.
+func DeleteExecutionsInTransaction(tx *gorm.DB, workflowID uuid.UUID, ids []uuid.UUID) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	err := tx.
+		Where("workflow_id = ?", workflowID).
+		Where(
+			"execution_id IN (?)",
+			tx.Model(&CanvasNodeExecution{}).
+				Select("id").
+				Where("id IN ? OR parent_execution_id IN ?", ids, ids),
+		).
+		Delete(&CanvasNodeExecutionLog{}).
+		Error
+
+	if err != nil {
+		return 0, err
+	}
+
+	result := tx.
+		Where("workflow_id = ?", workflowID).
+		Where("id IN ?", ids).
+		Delete(&CanvasNodeExecution{})
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	RetryBackoffFixed       = "fixed"
+	RetryBackoffExponential = "exponential"
+
+	MaxRetryPolicyAttempts     = 10
+	MaxRetryPolicyDelaySeconds = 24 * 60 * 60
+)
+
+// Result reasons that can be used in a retry policy.
+// If a retry policy does not specify any, only errors are retried.
+var RetryableResultReasons = []string{
+	CanvasNodeExecutionResultReasonError,
+	CanvasNodeExecutionResultReasonTimedOut,
+}
+
+type RetryPolicy struct {
+	//
+	// Total number of attempts, including the first one.
+	//
+	MaxAttempts int `json:"maxAttempts"`
+
+	//
+	// How the delay between attempts grows: fixed or exponential.
+	//
+	Backoff string `json:"backoff"`
+
+	//
+	// Delay before the first retry.
+	// With exponential backoff, the delay doubles on every attempt.
+	//
+	DelaySeconds int `json:"delaySeconds"`
+
+	//
+	// Upper bound for the delay between attempts.
+	// Zero means no upper bound.
+	//
+	MaxDelaySeconds int `json:"maxDelaySeconds,omitempty"`
+
+	//
+	// Result reasons that trigger a retry.
+	//
+	RetryOn []string `json:"retryOn,omitempty"`
+}
+
+func (p *RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 || p.MaxAttempts > MaxRetryPolicyAttempts {
+		return fmt.Errorf("max attempts must be between 1 and %d", MaxRetryPolicyAttempts)
+	}
+
+	if p.Backoff != RetryBackoffFixed && p.Backoff != RetryBackoffExponential {
+		return fmt.Errorf("invalid backoff: %s", p.Backoff)
+	}
+
+	if p.DelaySeconds < 0 || p.DelaySeconds > MaxRetryPolicyDelaySeconds {
+		return fmt.Errorf("delay must be between 0 and %d seconds", MaxRetryPolicyDelaySeconds)
+	}
+
+	if p.MaxDelaySeconds < 0 || p.MaxDelaySeconds > MaxRetryPolicyDelaySeconds {
+		return fmt.Errorf("max delay must be between 0 and %d seconds", MaxRetryPolicyDelaySeconds)
+	}
+
+	for _, reason := range p.RetryOn {
+		if !slices.Contains(RetryableResultReasons, reason) {
+			return fmt.Errorf("invalid retry reason: %s", reason)
+		}
+	}
+
+	return nil
+}
+
+// ShouldRetry returns true if an execution on its given attempt,
+// which failed with the given reason, should be retried.
+func (p *RetryPolicy) ShouldRetry(attempt int, reason string) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	retryOn := p.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = []string{CanvasNodeExecutionResultReasonError}
+	}
+
+	return slices.Contains(retryOn, reason)
+}
+
+// DelayAfterAttempt returns how long to wait before
+// starting the attempt that comes after the given one.
+func (p *RetryPolicy) DelayAfterAttempt(attempt int) time.Duration {
+	delay := time.Duration(p.DelaySeconds) * time.Second
+	maxDelay := time.Duration(p.MaxDelaySeconds) * time.Second
+	if maxDelay == 0 {
+		maxDelay = time.Duration(MaxRetryPolicyDelaySeconds) * time.Second
+	}
+
+	if p.Backoff == RetryBackoffExponential {
+		for i := 1; i < attempt && delay < maxDelay; i++ {
+			delay *= 2
+		}
+	}
+
+	if delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
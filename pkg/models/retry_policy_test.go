@@ -0,0 +1,61 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__RetryPolicy(t *testing.T) {
+	t.Run("valid policy", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 3, Backoff: RetryBackoffFixed, DelaySeconds: 10}
+		require.NoError(t, policy.Validate())
+	})
+
+	t.Run("invalid max attempts", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 0, Backoff: RetryBackoffFixed}
+		require.ErrorContains(t, policy.Validate(), "max attempts must be between 1 and 10")
+
+		policy.MaxAttempts = MaxRetryPolicyAttempts + 1
+		require.ErrorContains(t, policy.Validate(), "max attempts must be between 1 and 10")
+	})
+
+	t.Run("invalid backoff", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 3, Backoff: "linear"}
+		require.ErrorContains(t, policy.Validate(), "invalid backoff: linear")
+	})
+
+	t.Run("invalid retry reason", func(t *testing.T) {
+		policy := RetryPolicy{MaxAttempts: 3, Backoff: RetryBackoffFixed, RetryOn: []string{"cancelled"}}
+		require.ErrorContains(t, policy.Validate(), "invalid retry reason: cancelled")
+	})
+
+	t.Run("should retry errors until max attempts is reached", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 3, Backoff: RetryBackoffFixed}
+		assert.True(t, policy.ShouldRetry(1, CanvasNodeExecutionResultReasonError))
+		assert.True(t, policy.ShouldRetry(2, CanvasNodeExecutionResultReasonError))
+		assert.False(t, policy.ShouldRetry(3, CanvasNodeExecutionResultReasonError))
+		assert.False(t, policy.ShouldRetry(1, CanvasNodeExecutionResultReasonOk))
+	})
+
+	t.Run("nil policy never retries", func(t *testing.T) {
+		var policy *RetryPolicy
+		assert.False(t, policy.ShouldRetry(1, CanvasNodeExecutionResultReasonError))
+	})
+
+	t.Run("fixed backoff", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 5, Backoff: RetryBackoffFixed, DelaySeconds: 30}
+		assert.Equal(t, 30*time.Second, policy.DelayAfterAttempt(1))
+		assert.Equal(t, 30*time.Second, policy.DelayAfterAttempt(4))
+	})
+
+	t.Run("exponential backoff is capped by max delay", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 5, Backoff: RetryBackoffExponential, DelaySeconds: 10, MaxDelaySeconds: 60}
+		assert.Equal(t, 10*time.Second, policy.DelayAfterAttempt(1))
+		assert.Equal(t, 20*time.Second, policy.DelayAfterAttempt(2))
+		assert.Equal(t, 40*time.Second, policy.DelayAfterAttempt(3))
+		assert.Equal(t, 60*time.Second, policy.DelayAfterAttempt(4))
+	})
+}
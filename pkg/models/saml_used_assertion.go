@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/database"
+	"gorm.io/gorm/clause"
+)
+
+// SAMLUsedAssertion is a SAML assertion already used to sign in.
+// It is kept until the assertion expires, since expired ones are rejected anyway.
+type SAMLUsedAssertion struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	AssertionID    string    `gorm:"primaryKey"`
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+func (a *SAMLUsedAssertion) TableName() string {
+	return "saml_used_assertions"
+}
+
+// UseSAMLAssertion records an assertion, and returns false if it was already used.
+// Assertions are recorded in the database, so a response posted to one
+// API instance cannot be posted again to another one.
+func UseSAMLAssertion(organizationID uuid.UUID, assertionID string, expiresAt time.Time, now time.Time) (bool, error) {
+	err := database.Conn().
+		Where("expires_at < ?", now).
+		Delete(&SAMLUsedAssertion{}).
+		Error
+
+	if err != nil {
+		return false, err
+	}
+
+	result := database.Conn().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&SAMLUsedAssertion{
+			OrganizationID: organizationID,
+			AssertionID:    assertionID,
+			ExpiresAt:      expiresAt,
+			CreatedAt:      now,
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
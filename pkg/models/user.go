@@ -56,6 +56,12 @@ func (u *User) RestoreInTransaction(tx *gorm.DB) error {
 		Error
 }
 
+func (u *User) UpdateName(name string) error {
+	u.UpdatedAt = time.Now()
+	u.Name = name
+	return database.Conn().Save(u).Error
+}
+
 func (u *User) UpdateTokenHash(tokenHash string) error {
 	u.UpdatedAt = time.Now()
 	u.TokenHash = tokenHash
@@ -117,6 +123,21 @@ func FindServiceAccountsByOrganizationInTransaction(tx *gorm.DB, orgID string) (
 	return users, err
 }
 
+// FindHumanUsersByOrganization returns the people in the organization.
+// People removed from the organization are soft-deleted, so they are not included.
+func FindHumanUsersByOrganization(orgID string) ([]User, error) {
+	var users []User
+
+	err := database.Conn().
+		Where("organization_id = ?", orgID).
+		Where("type = ?", UserTypeHuman).
+		Order("created_at ASC").
+		Find(&users).
+		Error
+
+	return users, err
+}
+
 func FindUnscopedUserByID(id string) (*User, error) {
 	var user User
 	userUUID, err := uuid.Parse(id)
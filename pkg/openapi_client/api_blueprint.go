@@ -258,6 +258,20 @@ type ApiBlueprintsDescribeBlueprintRequest struct {
 	ctx        context.Context
 	ApiService *BlueprintAPIService
 	id         string
+	version    *int32
+	draft      *bool
+}
+
+// A published version to return instead of the latest one.
+func (r ApiBlueprintsDescribeBlueprintRequest) Version(version int32) ApiBlueprintsDescribeBlueprintRequest {
+	r.version = &version
+	return r
+}
+
+// Return the draft, if the blueprint has one.
+func (r ApiBlueprintsDescribeBlueprintRequest) Draft(draft bool) ApiBlueprintsDescribeBlueprintRequest {
+	r.draft = &draft
+	return r
 }
 
 func (r ApiBlueprintsDescribeBlueprintRequest) Execute() (*BlueprintsDescribeBlueprintResponse, *http.Response, error) {
@@ -300,6 +314,368 @@ func (a *BlueprintAPIService) BlueprintsDescribeBlueprintExecute(r ApiBlueprints
 	localVarPath := localBasePath + "/api/v1/blueprints/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.version != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "version", r.version, "", "")
+	}
+	if r.draft != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "draft", r.draft, "", "")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBlueprintsDiffBlueprintVersionsRequest struct {
+	ctx         context.Context
+	ApiService  *BlueprintAPIService
+	id          string
+	fromVersion *int32
+	toVersion   *int32
+}
+
+// The version to compare from. Zero means the latest published version.
+func (r ApiBlueprintsDiffBlueprintVersionsRequest) FromVersion(fromVersion int32) ApiBlueprintsDiffBlueprintVersionsRequest {
+	r.fromVersion = &fromVersion
+	return r
+}
+
+// The version to compare to. Zero means the draft.
+func (r ApiBlueprintsDiffBlueprintVersionsRequest) ToVersion(toVersion int32) ApiBlueprintsDiffBlueprintVersionsRequest {
+	r.toVersion = &toVersion
+	return r
+}
+
+func (r ApiBlueprintsDiffBlueprintVersionsRequest) Execute() (*BlueprintsDiffBlueprintVersionsResponse, *http.Response, error) {
+	return r.ApiService.BlueprintsDiffBlueprintVersionsExecute(r)
+}
+
+/*
+BlueprintsDiffBlueprintVersions Diff blueprint versions
+
+Returns what changed between two versions of a blueprint
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiBlueprintsDiffBlueprintVersionsRequest
+*/
+func (a *BlueprintAPIService) BlueprintsDiffBlueprintVersions(ctx context.Context, id string) ApiBlueprintsDiffBlueprintVersionsRequest {
+	return ApiBlueprintsDiffBlueprintVersionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BlueprintsDiffBlueprintVersionsResponse
+func (a *BlueprintAPIService) BlueprintsDiffBlueprintVersionsExecute(r ApiBlueprintsDiffBlueprintVersionsRequest) (*BlueprintsDiffBlueprintVersionsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BlueprintsDiffBlueprintVersionsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BlueprintAPIService.BlueprintsDiffBlueprintVersions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/blueprints/{id}/diff"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.fromVersion != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "fromVersion", r.fromVersion, "", "")
+	}
+	if r.toVersion != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "toVersion", r.toVersion, "", "")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBlueprintsDiscardBlueprintDraftRequest struct {
+	ctx        context.Context
+	ApiService *BlueprintAPIService
+	id         string
+}
+
+func (r ApiBlueprintsDiscardBlueprintDraftRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.BlueprintsDiscardBlueprintDraftExecute(r)
+}
+
+/*
+BlueprintsDiscardBlueprintDraft Discard blueprint draft
+
+Discards the unpublished changes of a blueprint
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiBlueprintsDiscardBlueprintDraftRequest
+*/
+func (a *BlueprintAPIService) BlueprintsDiscardBlueprintDraft(ctx context.Context, id string) ApiBlueprintsDiscardBlueprintDraftRequest {
+	return ApiBlueprintsDiscardBlueprintDraftRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *BlueprintAPIService) BlueprintsDiscardBlueprintDraftExecute(r ApiBlueprintsDiscardBlueprintDraftRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BlueprintAPIService.BlueprintsDiscardBlueprintDraft")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/blueprints/{id}/draft"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiBlueprintsListBlueprintVersionsRequest struct {
+	ctx        context.Context
+	ApiService *BlueprintAPIService
+	id         string
+}
+
+func (r ApiBlueprintsListBlueprintVersionsRequest) Execute() (*BlueprintsListBlueprintVersionsResponse, *http.Response, error) {
+	return r.ApiService.BlueprintsListBlueprintVersionsExecute(r)
+}
+
+/*
+BlueprintsListBlueprintVersions List blueprint versions
+
+Returns the published versions of a blueprint, newest first
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiBlueprintsListBlueprintVersionsRequest
+*/
+func (a *BlueprintAPIService) BlueprintsListBlueprintVersions(ctx context.Context, id string) ApiBlueprintsListBlueprintVersionsRequest {
+	return ApiBlueprintsListBlueprintVersionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BlueprintsListBlueprintVersionsResponse
+func (a *BlueprintAPIService) BlueprintsListBlueprintVersionsExecute(r ApiBlueprintsListBlueprintVersionsRequest) (*BlueprintsListBlueprintVersionsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BlueprintsListBlueprintVersionsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BlueprintAPIService.BlueprintsListBlueprintVersions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/blueprints/{id}/versions"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
@@ -474,6 +850,129 @@ func (a *BlueprintAPIService) BlueprintsListBlueprintsExecute(r ApiBlueprintsLis
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiBlueprintsPublishBlueprintRequest struct {
+	ctx        context.Context
+	ApiService *BlueprintAPIService
+	id         string
+	body       *map[string]interface{}
+}
+
+func (r ApiBlueprintsPublishBlueprintRequest) Body(body map[string]interface{}) ApiBlueprintsPublishBlueprintRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiBlueprintsPublishBlueprintRequest) Execute() (*BlueprintsPublishBlueprintResponse, *http.Response, error) {
+	return r.ApiService.BlueprintsPublishBlueprintExecute(r)
+}
+
+/*
+BlueprintsPublishBlueprint Publish blueprint draft
+
+Publishes the draft of a blueprint as its next version
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiBlueprintsPublishBlueprintRequest
+*/
+func (a *BlueprintAPIService) BlueprintsPublishBlueprint(ctx context.Context, id string) ApiBlueprintsPublishBlueprintRequest {
+	return ApiBlueprintsPublishBlueprintRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return BlueprintsPublishBlueprintResponse
+func (a *BlueprintAPIService) BlueprintsPublishBlueprintExecute(r ApiBlueprintsPublishBlueprintRequest) (*BlueprintsPublishBlueprintResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *BlueprintsPublishBlueprintResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BlueprintAPIService.BlueprintsPublishBlueprint")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/blueprints/{id}/publish"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiBlueprintsUpdateBlueprintRequest struct {
 	ctx        context.Context
 	ApiService *BlueprintAPIService
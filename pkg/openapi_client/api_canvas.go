@@ -23,6 +23,129 @@ import (
 // CanvasAPIService CanvasAPI service
 type CanvasAPIService service
 
+type ApiCanvasesAssignCanvasRoleRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesAssignCanvasRoleBody
+}
+
+func (r ApiCanvasesAssignCanvasRoleRequest) Body(body CanvasesAssignCanvasRoleBody) ApiCanvasesAssignCanvasRoleRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesAssignCanvasRoleRequest) Execute() (*CanvasesAssignCanvasRoleResponse, *http.Response, error) {
+	return r.ApiService.CanvasesAssignCanvasRoleExecute(r)
+}
+
+/*
+CanvasesAssignCanvasRole Assign canvas role
+
+Binds a canvas role to a user, group or service account, replacing the role previously bound to them
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesAssignCanvasRoleRequest
+*/
+func (a *CanvasAPIService) CanvasesAssignCanvasRole(ctx context.Context, canvasId string) ApiCanvasesAssignCanvasRoleRequest {
+	return ApiCanvasesAssignCanvasRoleRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesAssignCanvasRoleResponse
+func (a *CanvasAPIService) CanvasesAssignCanvasRoleExecute(r ApiCanvasesAssignCanvasRoleRequest) (*CanvasesAssignCanvasRoleResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesAssignCanvasRoleResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesAssignCanvasRole")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesCreateCanvasRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
@@ -482,58 +605,71 @@ func (a *CanvasAPIService) CanvasesDescribeCanvasExecute(r ApiCanvasesDescribeCa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesListCanvasMemoriesRequest struct {
+type ApiCanvasesEvaluateExpressionRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
 	canvasId   string
+	nodeId     string
+	body       *CanvasesEvaluateExpressionBody
 }
 
-func (r ApiCanvasesListCanvasMemoriesRequest) Execute() (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
-	return r.ApiService.CanvasesListCanvasMemoriesExecute(r)
+func (r ApiCanvasesEvaluateExpressionRequest) Body(body CanvasesEvaluateExpressionBody) ApiCanvasesEvaluateExpressionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesEvaluateExpressionRequest) Execute() (*CanvasesEvaluateExpressionResponse, *http.Response, error) {
+	return r.ApiService.CanvasesEvaluateExpressionExecute(r)
 }
 
 /*
-CanvasesListCanvasMemories List canvas memories
+CanvasesEvaluateExpression Evaluate expression
 
-Returns append-only memory records for a canvas
+Resolves an expression or a configuration for a node, against an existing event or execution
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param canvasId
-	@return ApiCanvasesListCanvasMemoriesRequest
+	@param nodeId
+	@return ApiCanvasesEvaluateExpressionRequest
 */
-func (a *CanvasAPIService) CanvasesListCanvasMemories(ctx context.Context, canvasId string) ApiCanvasesListCanvasMemoriesRequest {
-	return ApiCanvasesListCanvasMemoriesRequest{
+func (a *CanvasAPIService) CanvasesEvaluateExpression(ctx context.Context, canvasId string, nodeId string) ApiCanvasesEvaluateExpressionRequest {
+	return ApiCanvasesEvaluateExpressionRequest{
 		ApiService: a,
 		ctx:        ctx,
 		canvasId:   canvasId,
+		nodeId:     nodeId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return CanvasesListCanvasMemoriesResponse
-func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCanvasMemoriesRequest) (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
+//	@return CanvasesEvaluateExpressionResponse
+func (a *CanvasAPIService) CanvasesEvaluateExpressionExecute(r ApiCanvasesEvaluateExpressionRequest) (*CanvasesEvaluateExpressionResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesListCanvasMemoriesResponse
+		localVarReturnValue *CanvasesEvaluateExpressionResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasMemories")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesEvaluateExpression")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory"
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/nodes/{nodeId}/evaluate"
 	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"nodeId"+"}", url.PathEscape(parameterValueToString(r.nodeId, "nodeId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -549,6 +685,8 @@ func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCa
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -594,61 +732,56 @@ func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesListCanvasesRequest struct {
-	ctx              context.Context
-	ApiService       *CanvasAPIService
-	includeTemplates *bool
-}
-
-func (r ApiCanvasesListCanvasesRequest) IncludeTemplates(includeTemplates bool) ApiCanvasesListCanvasesRequest {
-	r.includeTemplates = &includeTemplates
-	return r
+type ApiCanvasesExportCanvasBundleRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	id         string
 }
 
-func (r ApiCanvasesListCanvasesRequest) Execute() (*CanvasesListCanvasesResponse, *http.Response, error) {
-	return r.ApiService.CanvasesListCanvasesExecute(r)
+func (r ApiCanvasesExportCanvasBundleRequest) Execute() (*CanvasesExportCanvasBundleResponse, *http.Response, error) {
+	return r.ApiService.CanvasesExportCanvasBundleExecute(r)
 }
 
 /*
-CanvasesListCanvases List canvases
+CanvasesExportCanvasBundle Export canvas bundle
 
-Returns a list of all canvases
+Returns a portable bundle with the canvas, the blueprints it uses, and the integrations and secrets it needs
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiCanvasesListCanvasesRequest
+	@param id
+	@return ApiCanvasesExportCanvasBundleRequest
 */
-func (a *CanvasAPIService) CanvasesListCanvases(ctx context.Context) ApiCanvasesListCanvasesRequest {
-	return ApiCanvasesListCanvasesRequest{
+func (a *CanvasAPIService) CanvasesExportCanvasBundle(ctx context.Context, id string) ApiCanvasesExportCanvasBundleRequest {
+	return ApiCanvasesExportCanvasBundleRequest{
 		ApiService: a,
 		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return CanvasesListCanvasesResponse
-func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvasesRequest) (*CanvasesListCanvasesResponse, *http.Response, error) {
+//	@return CanvasesExportCanvasBundleResponse
+func (a *CanvasAPIService) CanvasesExportCanvasBundleExecute(r ApiCanvasesExportCanvasBundleRequest) (*CanvasesExportCanvasBundleResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesListCanvasesResponse
+		localVarReturnValue *CanvasesExportCanvasBundleResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvases")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesExportCanvasBundle")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases"
+	localVarPath := localBasePath + "/api/v1/canvases/{id}/bundle"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.includeTemplates != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTemplates", r.includeTemplates, "", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -711,33 +844,27 @@ func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvases
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesSendAiMessageRequest struct {
+type ApiCanvasesGetCanvasRetentionReportRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
 	canvasId   string
-	body       *CanvasesSendAiMessageBody
-}
-
-func (r ApiCanvasesSendAiMessageRequest) Body(body CanvasesSendAiMessageBody) ApiCanvasesSendAiMessageRequest {
-	r.body = &body
-	return r
 }
 
-func (r ApiCanvasesSendAiMessageRequest) Execute() (*CanvasesSendAiMessageResponse, *http.Response, error) {
-	return r.ApiService.CanvasesSendAiMessageExecute(r)
+func (r ApiCanvasesGetCanvasRetentionReportRequest) Execute() (*CanvasesGetCanvasRetentionReportResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetCanvasRetentionReportExecute(r)
 }
 
 /*
-CanvasesSendAiMessage Generate AI canvas proposal
+CanvasesGetCanvasRetentionReport Get canvas retention report
 
-Generates a structured, non-persistent canvas proposal from a natural language prompt
+Returns the retention policy applied to a canvas, and what it would delete right now, without deleting anything
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param canvasId
-	@return ApiCanvasesSendAiMessageRequest
+	@return ApiCanvasesGetCanvasRetentionReportRequest
 */
-func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId string) ApiCanvasesSendAiMessageRequest {
-	return ApiCanvasesSendAiMessageRequest{
+func (a *CanvasAPIService) CanvasesGetCanvasRetentionReport(ctx context.Context, canvasId string) ApiCanvasesGetCanvasRetentionReportRequest {
+	return ApiCanvasesGetCanvasRetentionReportRequest{
 		ApiService: a,
 		ctx:        ctx,
 		canvasId:   canvasId,
@@ -746,32 +873,29 @@ func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId s
 
 // Execute executes the request
 //
-//	@return CanvasesSendAiMessageResponse
-func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessageRequest) (*CanvasesSendAiMessageResponse, *http.Response, error) {
+//	@return CanvasesGetCanvasRetentionReportResponse
+func (a *CanvasAPIService) CanvasesGetCanvasRetentionReportExecute(r ApiCanvasesGetCanvasRetentionReportRequest) (*CanvasesGetCanvasRetentionReportResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesSendAiMessageResponse
+		localVarReturnValue *CanvasesGetCanvasRetentionReportResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesSendAiMessage")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesGetCanvasRetentionReport")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/ai/messages"
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/retention-report"
 	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.body == nil {
-		return localVarReturnValue, nil, reportError("body is required and must be specified")
-	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{"application/json"}
+	localVarHTTPContentTypes := []string{}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -787,8 +911,6 @@ func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessa
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
-	// body params
-	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -834,57 +956,1135 @@ func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessa
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiCanvasesUpdateCanvasRequest struct {
+type ApiCanvasesImportCanvasBundleRequest struct {
 	ctx        context.Context
 	ApiService *CanvasAPIService
-	id         string
-	body       *CanvasesUpdateCanvasBody
+	body       *CanvasesImportCanvasBundleRequest
 }
 
-func (r ApiCanvasesUpdateCanvasRequest) Body(body CanvasesUpdateCanvasBody) ApiCanvasesUpdateCanvasRequest {
+func (r ApiCanvasesImportCanvasBundleRequest) Body(body CanvasesImportCanvasBundleRequest) ApiCanvasesImportCanvasBundleRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiCanvasesUpdateCanvasRequest) Execute() (*CanvasesUpdateCanvasResponse, *http.Response, error) {
-	return r.ApiService.CanvasesUpdateCanvasExecute(r)
+func (r ApiCanvasesImportCanvasBundleRequest) Execute() (*CanvasesImportCanvasBundleResponse, *http.Response, error) {
+	return r.ApiService.CanvasesImportCanvasBundleExecute(r)
 }
 
 /*
-CanvasesUpdateCanvas Update canvas
+CanvasesImportCanvasBundle Import canvas bundle
 
-Updates canvas metadata
+Creates a canvas from a bundle, remapping integrations and reporting dependencies missing in the organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@param id
-	@return ApiCanvasesUpdateCanvasRequest
+	@return ApiCanvasesImportCanvasBundleRequest
 */
-func (a *CanvasAPIService) CanvasesUpdateCanvas(ctx context.Context, id string) ApiCanvasesUpdateCanvasRequest {
-	return ApiCanvasesUpdateCanvasRequest{
+func (a *CanvasAPIService) CanvasesImportCanvasBundle(ctx context.Context) ApiCanvasesImportCanvasBundleRequest {
+	return ApiCanvasesImportCanvasBundleRequest{
 		ApiService: a,
 		ctx:        ctx,
-		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return CanvasesUpdateCanvasResponse
-func (a *CanvasAPIService) CanvasesUpdateCanvasExecute(r ApiCanvasesUpdateCanvasRequest) (*CanvasesUpdateCanvasResponse, *http.Response, error) {
+//	@return CanvasesImportCanvasBundleResponse
+func (a *CanvasAPIService) CanvasesImportCanvasBundleExecute(r ApiCanvasesImportCanvasBundleRequest) (*CanvasesImportCanvasBundleResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPut
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *CanvasesUpdateCanvasResponse
+		localVarReturnValue *CanvasesImportCanvasBundleResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvas")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesImportCanvasBundle")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/canvases/{id}"
-	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath := localBasePath + "/api/v1/canvases/import"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListCanvasMemoriesRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+}
+
+func (r ApiCanvasesListCanvasMemoriesRequest) Execute() (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasMemoriesExecute(r)
+}
+
+/*
+CanvasesListCanvasMemories List canvas memories
+
+Returns append-only memory records for a canvas
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesListCanvasMemoriesRequest
+*/
+func (a *CanvasAPIService) CanvasesListCanvasMemories(ctx context.Context, canvasId string) ApiCanvasesListCanvasMemoriesRequest {
+	return ApiCanvasesListCanvasMemoriesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListCanvasMemoriesResponse
+func (a *CanvasAPIService) CanvasesListCanvasMemoriesExecute(r ApiCanvasesListCanvasMemoriesRequest) (*CanvasesListCanvasMemoriesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListCanvasMemoriesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasMemories")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListCanvasMemoryNamespacesRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+}
+
+func (r ApiCanvasesListCanvasMemoryNamespacesRequest) Execute() (*CanvasesListCanvasMemoryNamespacesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasMemoryNamespacesExecute(r)
+}
+
+/*
+CanvasesListCanvasMemoryNamespaces List canvas memory namespaces
+
+Returns the memory namespaces of a canvas which have settings
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesListCanvasMemoryNamespacesRequest
+*/
+func (a *CanvasAPIService) CanvasesListCanvasMemoryNamespaces(ctx context.Context, canvasId string) ApiCanvasesListCanvasMemoryNamespacesRequest {
+	return ApiCanvasesListCanvasMemoryNamespacesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListCanvasMemoryNamespacesResponse
+func (a *CanvasAPIService) CanvasesListCanvasMemoryNamespacesExecute(r ApiCanvasesListCanvasMemoryNamespacesRequest) (*CanvasesListCanvasMemoryNamespacesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListCanvasMemoryNamespacesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasMemoryNamespaces")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory-namespaces"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListCanvasRoleBindingsRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+}
+
+func (r ApiCanvasesListCanvasRoleBindingsRequest) Execute() (*CanvasesListCanvasRoleBindingsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasRoleBindingsExecute(r)
+}
+
+/*
+CanvasesListCanvasRoleBindings List canvas role bindings
+
+Returns the roles bound to users, groups and service accounts on a canvas
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesListCanvasRoleBindingsRequest
+*/
+func (a *CanvasAPIService) CanvasesListCanvasRoleBindings(ctx context.Context, canvasId string) ApiCanvasesListCanvasRoleBindingsRequest {
+	return ApiCanvasesListCanvasRoleBindingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListCanvasRoleBindingsResponse
+func (a *CanvasAPIService) CanvasesListCanvasRoleBindingsExecute(r ApiCanvasesListCanvasRoleBindingsRequest) (*CanvasesListCanvasRoleBindingsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListCanvasRoleBindingsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvasRoleBindings")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesListCanvasesRequest struct {
+	ctx              context.Context
+	ApiService       *CanvasAPIService
+	includeTemplates *bool
+}
+
+func (r ApiCanvasesListCanvasesRequest) IncludeTemplates(includeTemplates bool) ApiCanvasesListCanvasesRequest {
+	r.includeTemplates = &includeTemplates
+	return r
+}
+
+func (r ApiCanvasesListCanvasesRequest) Execute() (*CanvasesListCanvasesResponse, *http.Response, error) {
+	return r.ApiService.CanvasesListCanvasesExecute(r)
+}
+
+/*
+CanvasesListCanvases List canvases
+
+Returns a list of all canvases
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiCanvasesListCanvasesRequest
+*/
+func (a *CanvasAPIService) CanvasesListCanvases(ctx context.Context) ApiCanvasesListCanvasesRequest {
+	return ApiCanvasesListCanvasesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesListCanvasesResponse
+func (a *CanvasAPIService) CanvasesListCanvasesExecute(r ApiCanvasesListCanvasesRequest) (*CanvasesListCanvasesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesListCanvasesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesListCanvases")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.includeTemplates != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "includeTemplates", r.includeTemplates, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesRemoveCanvasRoleRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasAPIService
+	canvasId    string
+	subjectType *string
+	subjectId   *string
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) SubjectType(subjectType string) ApiCanvasesRemoveCanvasRoleRequest {
+	r.subjectType = &subjectType
+	return r
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) SubjectId(subjectId string) ApiCanvasesRemoveCanvasRoleRequest {
+	r.subjectId = &subjectId
+	return r
+}
+
+func (r ApiCanvasesRemoveCanvasRoleRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.CanvasesRemoveCanvasRoleExecute(r)
+}
+
+/*
+CanvasesRemoveCanvasRole Remove canvas role
+
+Removes the canvas role bound to a user, group or service account
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesRemoveCanvasRoleRequest
+*/
+func (a *CanvasAPIService) CanvasesRemoveCanvasRole(ctx context.Context, canvasId string) ApiCanvasesRemoveCanvasRoleRequest {
+	return ApiCanvasesRemoveCanvasRoleRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *CanvasAPIService) CanvasesRemoveCanvasRoleExecute(r ApiCanvasesRemoveCanvasRoleRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesRemoveCanvasRole")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/role-bindings"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.subjectType != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "subjectType", r.subjectType, "", "")
+	} else {
+		var defaultValue string = "SUBJECT_TYPE_UNSPECIFIED"
+		r.subjectType = &defaultValue
+	}
+	if r.subjectId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "subjectId", r.subjectId, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesSendAiMessageRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesSendAiMessageBody
+}
+
+func (r ApiCanvasesSendAiMessageRequest) Body(body CanvasesSendAiMessageBody) ApiCanvasesSendAiMessageRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesSendAiMessageRequest) Execute() (*CanvasesSendAiMessageResponse, *http.Response, error) {
+	return r.ApiService.CanvasesSendAiMessageExecute(r)
+}
+
+/*
+CanvasesSendAiMessage Generate AI canvas proposal
+
+Generates a structured, non-persistent canvas proposal from a natural language prompt
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesSendAiMessageRequest
+*/
+func (a *CanvasAPIService) CanvasesSendAiMessage(ctx context.Context, canvasId string) ApiCanvasesSendAiMessageRequest {
+	return ApiCanvasesSendAiMessageRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesSendAiMessageResponse
+func (a *CanvasAPIService) CanvasesSendAiMessageExecute(r ApiCanvasesSendAiMessageRequest) (*CanvasesSendAiMessageResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesSendAiMessageResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesSendAiMessage")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/ai/messages"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesUpdateCanvasRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	id         string
+	body       *CanvasesUpdateCanvasBody
+}
+
+func (r ApiCanvasesUpdateCanvasRequest) Body(body CanvasesUpdateCanvasBody) ApiCanvasesUpdateCanvasRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateCanvasRequest) Execute() (*CanvasesUpdateCanvasResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateCanvasExecute(r)
+}
+
+/*
+CanvasesUpdateCanvas Update canvas
+
+Updates canvas metadata
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiCanvasesUpdateCanvasRequest
+*/
+func (a *CanvasAPIService) CanvasesUpdateCanvas(ctx context.Context, id string) ApiCanvasesUpdateCanvasRequest {
+	return ApiCanvasesUpdateCanvasRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateCanvasResponse
+func (a *CanvasAPIService) CanvasesUpdateCanvasExecute(r ApiCanvasesUpdateCanvasRequest) (*CanvasesUpdateCanvasResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateCanvasResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvas")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesUpdateCanvasMemoryNamespaceRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	namespace  string
+	body       *CanvasesUpdateCanvasMemoryNamespaceBody
+}
+
+func (r ApiCanvasesUpdateCanvasMemoryNamespaceRequest) Body(body CanvasesUpdateCanvasMemoryNamespaceBody) ApiCanvasesUpdateCanvasMemoryNamespaceRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateCanvasMemoryNamespaceRequest) Execute() (*CanvasesUpdateCanvasMemoryNamespaceResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateCanvasMemoryNamespaceExecute(r)
+}
+
+/*
+CanvasesUpdateCanvasMemoryNamespace Update canvas memory namespace
+
+Updates the settings of a memory namespace, like the default TTL and the schema of its records
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param namespace
+	@return ApiCanvasesUpdateCanvasMemoryNamespaceRequest
+*/
+func (a *CanvasAPIService) CanvasesUpdateCanvasMemoryNamespace(ctx context.Context, canvasId string, namespace string) ApiCanvasesUpdateCanvasMemoryNamespaceRequest {
+	return ApiCanvasesUpdateCanvasMemoryNamespaceRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+		namespace:  namespace,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateCanvasMemoryNamespaceResponse
+func (a *CanvasAPIService) CanvasesUpdateCanvasMemoryNamespaceExecute(r ApiCanvasesUpdateCanvasMemoryNamespaceRequest) (*CanvasesUpdateCanvasMemoryNamespaceResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateCanvasMemoryNamespaceResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvasMemoryNamespace")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"namespace"+"}", url.PathEscape(parameterValueToString(r.namespace, "namespace")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiCanvasesUpdateCanvasRetentionPolicyRequest struct {
+	ctx        context.Context
+	ApiService *CanvasAPIService
+	canvasId   string
+	body       *CanvasesUpdateCanvasRetentionPolicyBody
+}
+
+func (r ApiCanvasesUpdateCanvasRetentionPolicyRequest) Body(body CanvasesUpdateCanvasRetentionPolicyBody) ApiCanvasesUpdateCanvasRetentionPolicyRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesUpdateCanvasRetentionPolicyRequest) Execute() (*CanvasesUpdateCanvasRetentionPolicyResponse, *http.Response, error) {
+	return r.ApiService.CanvasesUpdateCanvasRetentionPolicyExecute(r)
+}
+
+/*
+CanvasesUpdateCanvasRetentionPolicy Update canvas retention policy
+
+Overrides the organization retention policy for a canvas, or inherits it again when no policy is given
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@return ApiCanvasesUpdateCanvasRetentionPolicyRequest
+*/
+func (a *CanvasAPIService) CanvasesUpdateCanvasRetentionPolicy(ctx context.Context, canvasId string) ApiCanvasesUpdateCanvasRetentionPolicyRequest {
+	return ApiCanvasesUpdateCanvasRetentionPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		canvasId:   canvasId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesUpdateCanvasRetentionPolicyResponse
+func (a *CanvasAPIService) CanvasesUpdateCanvasRetentionPolicyExecute(r ApiCanvasesUpdateCanvasRetentionPolicyRequest) (*CanvasesUpdateCanvasRetentionPolicyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesUpdateCanvasRetentionPolicyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasAPIService.CanvasesUpdateCanvasRetentionPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/retention-policy"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 // CanvasNodeExecutionAPIService CanvasNodeExecutionAPI service
@@ -150,6 +151,140 @@ func (a *CanvasNodeExecutionAPIService) CanvasesCancelExecutionExecute(r ApiCanv
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesGetExecutionLogsRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+	limit       *int64
+	after       *time.Time
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) Limit(limit int64) ApiCanvasesGetExecutionLogsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) After(after time.Time) ApiCanvasesGetExecutionLogsRequest {
+	r.after = &after
+	return r
+}
+
+func (r ApiCanvasesGetExecutionLogsRequest) Execute() (*CanvasesGetExecutionLogsResponse, *http.Response, error) {
+	return r.ApiService.CanvasesGetExecutionLogsExecute(r)
+}
+
+/*
+CanvasesGetExecutionLogs Get execution logs
+
+Returns the log lines written by a canvas node execution, oldest first
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesGetExecutionLogsRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesGetExecutionLogs(ctx context.Context, canvasId string, executionId string) ApiCanvasesGetExecutionLogsRequest {
+	return ApiCanvasesGetExecutionLogsRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesGetExecutionLogsResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesGetExecutionLogsExecute(r ApiCanvasesGetExecutionLogsRequest) (*CanvasesGetExecutionLogsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesGetExecutionLogsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesGetExecutionLogs")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/logs"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
+	if r.after != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "after", r.after, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesInvokeNodeExecutionActionRequest struct {
 	ctx         context.Context
 	ApiService  *CanvasNodeExecutionAPIService
@@ -408,6 +543,133 @@ func (a *CanvasNodeExecutionAPIService) CanvasesListChildExecutionsExecute(r Api
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiCanvasesRerunExecutionRequest struct {
+	ctx         context.Context
+	ApiService  *CanvasNodeExecutionAPIService
+	canvasId    string
+	executionId string
+	body        *map[string]interface{}
+}
+
+func (r ApiCanvasesRerunExecutionRequest) Body(body map[string]interface{}) ApiCanvasesRerunExecutionRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiCanvasesRerunExecutionRequest) Execute() (*CanvasesRerunExecutionResponse, *http.Response, error) {
+	return r.ApiService.CanvasesRerunExecutionExecute(r)
+}
+
+/*
+CanvasesRerunExecution Rerun execution
+
+Re-runs a finished execution with its original input and the current node configuration
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param canvasId
+	@param executionId
+	@return ApiCanvasesRerunExecutionRequest
+*/
+func (a *CanvasNodeExecutionAPIService) CanvasesRerunExecution(ctx context.Context, canvasId string, executionId string) ApiCanvasesRerunExecutionRequest {
+	return ApiCanvasesRerunExecutionRequest{
+		ApiService:  a,
+		ctx:         ctx,
+		canvasId:    canvasId,
+		executionId: executionId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return CanvasesRerunExecutionResponse
+func (a *CanvasNodeExecutionAPIService) CanvasesRerunExecutionExecute(r ApiCanvasesRerunExecutionRequest) (*CanvasesRerunExecutionResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *CanvasesRerunExecutionResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "CanvasNodeExecutionAPIService.CanvasesRerunExecution")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/canvases/{canvasId}/executions/{executionId}/rerun"
+	localVarPath = strings.Replace(localVarPath, "{"+"canvasId"+"}", url.PathEscape(parameterValueToString(r.canvasId, "canvasId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"executionId"+"}", url.PathEscape(parameterValueToString(r.executionId, "executionId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiCanvasesResolveExecutionErrorsRequest struct {
 	ctx        context.Context
 	ApiService *CanvasNodeExecutionAPIService
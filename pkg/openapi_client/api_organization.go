@@ -539,6 +539,118 @@ func (a *OrganizationAPIService) OrganizationsDeleteAgentOpenAIKeyExecute(r ApiO
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiOrganizationsDeleteEventSinkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsDeleteEventSinkRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsDeleteEventSinkExecute(r)
+}
+
+/*
+OrganizationsDeleteEventSink Delete organization event sink
+
+Stops streaming the organization activity, and drops the events not delivered yet
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsDeleteEventSinkRequest
+*/
+func (a *OrganizationAPIService) OrganizationsDeleteEventSink(ctx context.Context, id string) ApiOrganizationsDeleteEventSinkRequest {
+	return ApiOrganizationsDeleteEventSinkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsDeleteEventSinkExecute(r ApiOrganizationsDeleteEventSinkRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsDeleteEventSink")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/event-sink"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiOrganizationsDeleteIntegrationRequest struct {
 	ctx           context.Context
 	ApiService    *OrganizationAPIService
@@ -1107,27 +1219,27 @@ func (a *OrganizationAPIService) OrganizationsGetAgentSettingsExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsGetInviteLinkRequest struct {
+type ApiOrganizationsGetEventSinkRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
+func (r ApiOrganizationsGetEventSinkRequest) Execute() (*OrganizationsGetEventSinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetEventSinkExecute(r)
 }
 
 /*
-OrganizationsGetInviteLink Get an organization invite link
+OrganizationsGetEventSink Get organization event sink
 
-Returns the invite link for an organization
+Returns the sink the organization activity is streamed to, and its delivery backlog
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsGetInviteLinkRequest
+	@return ApiOrganizationsGetEventSinkRequest
 */
-func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
-	return ApiOrganizationsGetInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsGetEventSink(ctx context.Context, id string) ApiOrganizationsGetEventSinkRequest {
+	return ApiOrganizationsGetEventSinkRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1136,21 +1248,21 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context,
 
 // Execute executes the request
 //
-//	@return OrganizationsGetInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+//	@return OrganizationsGetEventSinkResponse
+func (a *OrganizationAPIService) OrganizationsGetEventSinkExecute(r ApiOrganizationsGetEventSinkRequest) (*OrganizationsGetEventSinkResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsGetInviteLinkResponse
+		localVarReturnValue *OrganizationsGetEventSinkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetEventSink")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/event-sink"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1219,69 +1331,56 @@ func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganiza
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationResourcesRequest struct {
-	ctx           context.Context
-	ApiService    *OrganizationAPIService
-	id            string
-	integrationId string
-	parameters    *string
-}
-
-func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
-	r.parameters = &parameters
-	return r
+type ApiOrganizationsGetInviteLinkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
 }
 
-func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+func (r ApiOrganizationsGetInviteLinkRequest) Execute() (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetInviteLinkExecute(r)
 }
 
 /*
-OrganizationsListIntegrationResources List integration resources
+OrganizationsGetInviteLink Get an organization invite link
 
-Lists resources for an integration
+Returns the invite link for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param integrationId
-	@return ApiOrganizationsListIntegrationResourcesRequest
+	@return ApiOrganizationsGetInviteLinkRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
-	return ApiOrganizationsListIntegrationResourcesRequest{
-		ApiService:    a,
-		ctx:           ctx,
-		id:            id,
-		integrationId: integrationId,
+func (a *OrganizationAPIService) OrganizationsGetInviteLink(ctx context.Context, id string) ApiOrganizationsGetInviteLinkRequest {
+	return ApiOrganizationsGetInviteLinkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsListIntegrationResourcesResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+//	@return OrganizationsGetInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsGetInviteLinkExecute(r ApiOrganizationsGetInviteLinkRequest) (*OrganizationsGetInviteLinkResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
+		localVarReturnValue *OrganizationsGetInviteLinkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetInviteLink")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.parameters != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
-	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1344,27 +1443,27 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListIntegrationsRequest struct {
+type ApiOrganizationsGetRetentionPolicyRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListIntegrationsExecute(r)
+func (r ApiOrganizationsGetRetentionPolicyRequest) Execute() (*OrganizationsGetRetentionPolicyResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetRetentionPolicyExecute(r)
 }
 
 /*
-OrganizationsListIntegrations List integrations in an organization
+OrganizationsGetRetentionPolicy Get organization retention policy
 
-Returns a list of integrations in an organization
+Returns the retention policy applied to every canvas in the organization without its own
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListIntegrationsRequest
+	@return ApiOrganizationsGetRetentionPolicyRequest
 */
-func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
-	return ApiOrganizationsListIntegrationsRequest{
+func (a *OrganizationAPIService) OrganizationsGetRetentionPolicy(ctx context.Context, id string) ApiOrganizationsGetRetentionPolicyRequest {
+	return ApiOrganizationsGetRetentionPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1373,21 +1472,21 @@ func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Conte
 
 // Execute executes the request
 //
-//	@return SuperplaneOrganizationsListIntegrationsResponse
-func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+//	@return OrganizationsGetRetentionPolicyResponse
+func (a *OrganizationAPIService) OrganizationsGetRetentionPolicyExecute(r ApiOrganizationsGetRetentionPolicyRequest) (*OrganizationsGetRetentionPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
+		localVarReturnValue *OrganizationsGetRetentionPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetRetentionPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/retention-policy"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1456,27 +1555,27 @@ func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsListInvitationsRequest struct {
+type ApiOrganizationsGetSAMLSettingsRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
 }
 
-func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsListInvitationsExecute(r)
+func (r ApiOrganizationsGetSAMLSettingsRequest) Execute() (*OrganizationsGetSAMLSettingsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsGetSAMLSettingsExecute(r)
 }
 
 /*
-OrganizationsListInvitations List organization invitations
+OrganizationsGetSAMLSettings Get organization SAML settings
 
-Returns pending invitations for an organization
+Returns the SAML single sign-on settings, and the service provider details to register in the identity provider
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsListInvitationsRequest
+	@return ApiOrganizationsGetSAMLSettingsRequest
 */
-func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
-	return ApiOrganizationsListInvitationsRequest{
+func (a *OrganizationAPIService) OrganizationsGetSAMLSettings(ctx context.Context, id string) ApiOrganizationsGetSAMLSettingsRequest {
+	return ApiOrganizationsGetSAMLSettingsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1485,21 +1584,21 @@ func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Contex
 
 // Execute executes the request
 //
-//	@return OrganizationsListInvitationsResponse
-func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
+//	@return OrganizationsGetSAMLSettingsResponse
+func (a *OrganizationAPIService) OrganizationsGetSAMLSettingsExecute(r ApiOrganizationsGetSAMLSettingsRequest) (*OrganizationsGetSAMLSettingsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsListInvitationsResponse
+		localVarReturnValue *OrganizationsGetSAMLSettingsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsGetSAMLSettings")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/saml"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -1568,60 +1667,119 @@ func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrgani
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsRemoveInvitationRequest struct {
+type ApiOrganizationsListAuditEventsRequest struct {
 	ctx          context.Context
 	ApiService   *OrganizationAPIService
 	id           string
-	invitationId string
+	actorId      *string
+	resourceType *string
+	resourceId   *string
+	action       *string
+	since        *time.Time
+	before       *time.Time
+	limit        *int64
 }
 
-func (r ApiOrganizationsRemoveInvitationRequest) Execute() (map[string]interface{}, *http.Response, error) {
-	return r.ApiService.OrganizationsRemoveInvitationExecute(r)
+func (r ApiOrganizationsListAuditEventsRequest) ActorId(actorId string) ApiOrganizationsListAuditEventsRequest {
+	r.actorId = &actorId
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) ResourceType(resourceType string) ApiOrganizationsListAuditEventsRequest {
+	r.resourceType = &resourceType
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) ResourceId(resourceId string) ApiOrganizationsListAuditEventsRequest {
+	r.resourceId = &resourceId
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Action(action string) ApiOrganizationsListAuditEventsRequest {
+	r.action = &action
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Since(since time.Time) ApiOrganizationsListAuditEventsRequest {
+	r.since = &since
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Before(before time.Time) ApiOrganizationsListAuditEventsRequest {
+	r.before = &before
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Limit(limit int64) ApiOrganizationsListAuditEventsRequest {
+	r.limit = &limit
+	return r
+}
+
+func (r ApiOrganizationsListAuditEventsRequest) Execute() (*OrganizationsListAuditEventsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListAuditEventsExecute(r)
 }
 
 /*
-OrganizationsRemoveInvitation Remove an organization invitation
+OrganizationsListAuditEvents List audit events
 
-Removes an organization invitation
+Returns the changes made in the organization, most recent first
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param invitationId
-	@return ApiOrganizationsRemoveInvitationRequest
+	@return ApiOrganizationsListAuditEventsRequest
 */
-func (a *OrganizationAPIService) OrganizationsRemoveInvitation(ctx context.Context, id string, invitationId string) ApiOrganizationsRemoveInvitationRequest {
-	return ApiOrganizationsRemoveInvitationRequest{
-		ApiService:   a,
-		ctx:          ctx,
-		id:           id,
-		invitationId: invitationId,
+func (a *OrganizationAPIService) OrganizationsListAuditEvents(ctx context.Context, id string) ApiOrganizationsListAuditEventsRequest {
+	return ApiOrganizationsListAuditEventsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
-func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrganizationsRemoveInvitationRequest) (map[string]interface{}, *http.Response, error) {
+//	@return OrganizationsListAuditEventsResponse
+func (a *OrganizationAPIService) OrganizationsListAuditEventsExecute(r ApiOrganizationsListAuditEventsRequest) (*OrganizationsListAuditEventsResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodDelete
+		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *OrganizationsListAuditEventsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveInvitation")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListAuditEvents")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations/{invitationId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/audit-events"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"invitationId"+"}", url.PathEscape(parameterValueToString(r.invitationId, "invitationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.actorId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "actorId", r.actorId, "", "")
+	}
+	if r.resourceType != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "resourceType", r.resourceType, "", "")
+	}
+	if r.resourceId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "resourceId", r.resourceId, "", "")
+	}
+	if r.action != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "action", r.action, "", "")
+	}
+	if r.since != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "since", r.since, "", "")
+	}
+	if r.before != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "before", r.before, "", "")
+	}
+	if r.limit != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "limit", r.limit, "", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1684,62 +1842,1006 @@ func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsRemoveUserRequest struct {
-	ctx        context.Context
-	ApiService *OrganizationAPIService
-	id         string
-	userId     string
+type ApiOrganizationsListIntegrationResourcesRequest struct {
+	ctx           context.Context
+	ApiService    *OrganizationAPIService
+	id            string
+	integrationId string
+	parameters    *string
 }
 
-func (r ApiOrganizationsRemoveUserRequest) Execute() (map[string]interface{}, *http.Response, error) {
+func (r ApiOrganizationsListIntegrationResourcesRequest) Parameters(parameters string) ApiOrganizationsListIntegrationResourcesRequest {
+	r.parameters = &parameters
+	return r
+}
+
+func (r ApiOrganizationsListIntegrationResourcesRequest) Execute() (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationResourcesExecute(r)
+}
+
+/*
+OrganizationsListIntegrationResources List integration resources
+
+Lists resources for an integration
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param integrationId
+	@return ApiOrganizationsListIntegrationResourcesRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListIntegrationResources(ctx context.Context, id string, integrationId string) ApiOrganizationsListIntegrationResourcesRequest {
+	return ApiOrganizationsListIntegrationResourcesRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		id:            id,
+		integrationId: integrationId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListIntegrationResourcesResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationResourcesExecute(r ApiOrganizationsListIntegrationResourcesRequest) (*OrganizationsListIntegrationResourcesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListIntegrationResourcesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrationResources")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}/resources"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.parameters != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "parameters", r.parameters, "", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListIntegrationsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsListIntegrationsRequest) Execute() (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListIntegrationsExecute(r)
+}
+
+/*
+OrganizationsListIntegrations List integrations in an organization
+
+Returns a list of integrations in an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListIntegrationsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListIntegrations(ctx context.Context, id string) ApiOrganizationsListIntegrationsRequest {
+	return ApiOrganizationsListIntegrationsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return SuperplaneOrganizationsListIntegrationsResponse
+func (a *OrganizationAPIService) OrganizationsListIntegrationsExecute(r ApiOrganizationsListIntegrationsRequest) (*SuperplaneOrganizationsListIntegrationsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SuperplaneOrganizationsListIntegrationsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListIntegrations")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsListInvitationsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsListInvitationsRequest) Execute() (*OrganizationsListInvitationsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsListInvitationsExecute(r)
+}
+
+/*
+OrganizationsListInvitations List organization invitations
+
+Returns pending invitations for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsListInvitationsRequest
+*/
+func (a *OrganizationAPIService) OrganizationsListInvitations(ctx context.Context, id string) ApiOrganizationsListInvitationsRequest {
+	return ApiOrganizationsListInvitationsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsListInvitationsResponse
+func (a *OrganizationAPIService) OrganizationsListInvitationsExecute(r ApiOrganizationsListInvitationsRequest) (*OrganizationsListInvitationsResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsListInvitationsResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsListInvitations")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsRemoveInvitationRequest struct {
+	ctx          context.Context
+	ApiService   *OrganizationAPIService
+	id           string
+	invitationId string
+}
+
+func (r ApiOrganizationsRemoveInvitationRequest) Execute() (map[string]interface{}, *http.Response, error) {
+	return r.ApiService.OrganizationsRemoveInvitationExecute(r)
+}
+
+/*
+OrganizationsRemoveInvitation Remove an organization invitation
+
+Removes an organization invitation
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param invitationId
+	@return ApiOrganizationsRemoveInvitationRequest
+*/
+func (a *OrganizationAPIService) OrganizationsRemoveInvitation(ctx context.Context, id string, invitationId string) ApiOrganizationsRemoveInvitationRequest {
+	return ApiOrganizationsRemoveInvitationRequest{
+		ApiService:   a,
+		ctx:          ctx,
+		id:           id,
+		invitationId: invitationId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsRemoveInvitationExecute(r ApiOrganizationsRemoveInvitationRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveInvitation")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invitations/{invitationId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"invitationId"+"}", url.PathEscape(parameterValueToString(r.invitationId, "invitationId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsRemoveUserRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	userId     string
+}
+
+func (r ApiOrganizationsRemoveUserRequest) Execute() (map[string]interface{}, *http.Response, error) {
 	return r.ApiService.OrganizationsRemoveUserExecute(r)
 }
 
 /*
-OrganizationsRemoveUser Remove a user from an organization
+OrganizationsRemoveUser Remove a user from an organization
+
+Removes a user from an organization (can be referenced by ID or name)
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@param userId
+	@return ApiOrganizationsRemoveUserRequest
+*/
+func (a *OrganizationAPIService) OrganizationsRemoveUser(ctx context.Context, id string, userId string) ApiOrganizationsRemoveUserRequest {
+	return ApiOrganizationsRemoveUserRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+		userId:     userId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return map[string]interface{}
+func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizationsRemoveUserRequest) (map[string]interface{}, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue map[string]interface{}
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveUser")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/users/{userId}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"userId"+"}", url.PathEscape(parameterValueToString(r.userId, "userId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsResetInviteLinkRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+}
+
+func (r ApiOrganizationsResetInviteLinkRequest) Execute() (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsResetInviteLinkExecute(r)
+}
+
+/*
+OrganizationsResetInviteLink Reset an organization invite link
+
+Generates a new invite link token for an organization
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsResetInviteLinkRequest
+*/
+func (a *OrganizationAPIService) OrganizationsResetInviteLink(ctx context.Context, id string) ApiOrganizationsResetInviteLinkRequest {
+	return ApiOrganizationsResetInviteLinkRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsResetInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrganizationsResetInviteLinkRequest) (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsResetInviteLinkResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetInviteLink")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link/reset"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsRetryEventSinkDeliveriesRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *map[string]interface{}
+}
+
+func (r ApiOrganizationsRetryEventSinkDeliveriesRequest) Body(body map[string]interface{}) ApiOrganizationsRetryEventSinkDeliveriesRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsRetryEventSinkDeliveriesRequest) Execute() (*OrganizationsRetryEventSinkDeliveriesResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsRetryEventSinkDeliveriesExecute(r)
+}
+
+/*
+OrganizationsRetryEventSinkDeliveries Retry failed event sink deliveries
+
+Puts the events which could not be delivered to the sink back into its backlog
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsRetryEventSinkDeliveriesRequest
+*/
+func (a *OrganizationAPIService) OrganizationsRetryEventSinkDeliveries(ctx context.Context, id string) ApiOrganizationsRetryEventSinkDeliveriesRequest {
+	return ApiOrganizationsRetryEventSinkDeliveriesRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsRetryEventSinkDeliveriesResponse
+func (a *OrganizationAPIService) OrganizationsRetryEventSinkDeliveriesExecute(r ApiOrganizationsRetryEventSinkDeliveriesRequest) (*OrganizationsRetryEventSinkDeliveriesResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsRetryEventSinkDeliveriesResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRetryEventSinkDeliveries")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/event-sink/retry"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsSetAgentOpenAIKeyRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsSetAgentOpenAIKeyBody
+}
+
+func (r ApiOrganizationsSetAgentOpenAIKeyRequest) Body(body OrganizationsSetAgentOpenAIKeyBody) ApiOrganizationsSetAgentOpenAIKeyRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsSetAgentOpenAIKeyRequest) Execute() (*OrganizationsSetAgentOpenAIKeyResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsSetAgentOpenAIKeyExecute(r)
+}
+
+/*
+OrganizationsSetAgentOpenAIKey Create or update organization OpenAI key for Agent Mode
+
+Sets the OpenAI key used by organization Agent Mode
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id
+	@return ApiOrganizationsSetAgentOpenAIKeyRequest
+*/
+func (a *OrganizationAPIService) OrganizationsSetAgentOpenAIKey(ctx context.Context, id string) ApiOrganizationsSetAgentOpenAIKeyRequest {
+	return ApiOrganizationsSetAgentOpenAIKeyRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OrganizationsSetAgentOpenAIKeyResponse
+func (a *OrganizationAPIService) OrganizationsSetAgentOpenAIKeyExecute(r ApiOrganizationsSetAgentOpenAIKeyRequest) (*OrganizationsSetAgentOpenAIKeyResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OrganizationsSetAgentOpenAIKeyResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsSetAgentOpenAIKey")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings/openai-key"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.body
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GooglerpcStatus
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiOrganizationsUpdateAgentSettingsRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsUpdateAgentSettingsBody
+}
+
+func (r ApiOrganizationsUpdateAgentSettingsRequest) Body(body OrganizationsUpdateAgentSettingsBody) ApiOrganizationsUpdateAgentSettingsRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsUpdateAgentSettingsRequest) Execute() (*OrganizationsUpdateAgentSettingsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateAgentSettingsExecute(r)
+}
+
+/*
+OrganizationsUpdateAgentSettings Update organization Agent Mode settings
 
-Removes a user from an organization (can be referenced by ID or name)
+Updates Agent Mode enablement for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param userId
-	@return ApiOrganizationsRemoveUserRequest
+	@return ApiOrganizationsUpdateAgentSettingsRequest
 */
-func (a *OrganizationAPIService) OrganizationsRemoveUser(ctx context.Context, id string, userId string) ApiOrganizationsRemoveUserRequest {
-	return ApiOrganizationsRemoveUserRequest{
+func (a *OrganizationAPIService) OrganizationsUpdateAgentSettings(ctx context.Context, id string) ApiOrganizationsUpdateAgentSettingsRequest {
+	return ApiOrganizationsUpdateAgentSettingsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
-		userId:     userId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
-func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizationsRemoveUserRequest) (map[string]interface{}, *http.Response, error) {
+//	@return OrganizationsUpdateAgentSettingsResponse
+func (a *OrganizationAPIService) OrganizationsUpdateAgentSettingsExecute(r ApiOrganizationsUpdateAgentSettingsRequest) (*OrganizationsUpdateAgentSettingsResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodDelete
+		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *OrganizationsUpdateAgentSettingsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsRemoveUser")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateAgentSettings")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/users/{userId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"userId"+"}", url.PathEscape(parameterValueToString(r.userId, "userId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1755,6 +2857,8 @@ func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizatio
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1800,27 +2904,33 @@ func (a *OrganizationAPIService) OrganizationsRemoveUserExecute(r ApiOrganizatio
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsResetInviteLinkRequest struct {
+type ApiOrganizationsUpdateEventSinkRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
+	body       *OrganizationsUpdateEventSinkBody
 }
 
-func (r ApiOrganizationsResetInviteLinkRequest) Execute() (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsResetInviteLinkExecute(r)
+func (r ApiOrganizationsUpdateEventSinkRequest) Body(body OrganizationsUpdateEventSinkBody) ApiOrganizationsUpdateEventSinkRequest {
+	r.body = &body
+	return r
+}
+
+func (r ApiOrganizationsUpdateEventSinkRequest) Execute() (*OrganizationsUpdateEventSinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateEventSinkExecute(r)
 }
 
 /*
-OrganizationsResetInviteLink Reset an organization invite link
+OrganizationsUpdateEventSink Update organization event sink
 
-Generates a new invite link token for an organization
+Configures the webhook or syslog sink the organization activity is streamed to
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsResetInviteLinkRequest
+	@return ApiOrganizationsUpdateEventSinkRequest
 */
-func (a *OrganizationAPIService) OrganizationsResetInviteLink(ctx context.Context, id string) ApiOrganizationsResetInviteLinkRequest {
-	return ApiOrganizationsResetInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsUpdateEventSink(ctx context.Context, id string) ApiOrganizationsUpdateEventSinkRequest {
+	return ApiOrganizationsUpdateEventSinkRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -1829,29 +2939,32 @@ func (a *OrganizationAPIService) OrganizationsResetInviteLink(ctx context.Contex
 
 // Execute executes the request
 //
-//	@return OrganizationsResetInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrganizationsResetInviteLinkRequest) (*OrganizationsResetInviteLinkResponse, *http.Response, error) {
+//	@return OrganizationsUpdateEventSinkResponse
+func (a *OrganizationAPIService) OrganizationsUpdateEventSinkExecute(r ApiOrganizationsUpdateEventSinkRequest) (*OrganizationsUpdateEventSinkResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPost
+		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsResetInviteLinkResponse
+		localVarReturnValue *OrganizationsUpdateEventSinkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsResetInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateEventSink")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link/reset"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/event-sink"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
+	if r.body == nil {
+		return localVarReturnValue, nil, reportError("body is required and must be specified")
+	}
 
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -1867,6 +2980,8 @@ func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrgani
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.body
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -1912,57 +3027,61 @@ func (a *OrganizationAPIService) OrganizationsResetInviteLinkExecute(r ApiOrgani
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsSetAgentOpenAIKeyRequest struct {
-	ctx        context.Context
-	ApiService *OrganizationAPIService
-	id         string
-	body       *OrganizationsSetAgentOpenAIKeyBody
+type ApiOrganizationsUpdateIntegrationRequest struct {
+	ctx           context.Context
+	ApiService    *OrganizationAPIService
+	id            string
+	integrationId string
+	body          *OrganizationsUpdateIntegrationBody
 }
 
-func (r ApiOrganizationsSetAgentOpenAIKeyRequest) Body(body OrganizationsSetAgentOpenAIKeyBody) ApiOrganizationsSetAgentOpenAIKeyRequest {
+func (r ApiOrganizationsUpdateIntegrationRequest) Body(body OrganizationsUpdateIntegrationBody) ApiOrganizationsUpdateIntegrationRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiOrganizationsSetAgentOpenAIKeyRequest) Execute() (*OrganizationsSetAgentOpenAIKeyResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsSetAgentOpenAIKeyExecute(r)
+func (r ApiOrganizationsUpdateIntegrationRequest) Execute() (*OrganizationsUpdateIntegrationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateIntegrationExecute(r)
 }
 
 /*
-OrganizationsSetAgentOpenAIKey Create or update organization OpenAI key for Agent Mode
+OrganizationsUpdateIntegration Update integration
 
-Sets the OpenAI key used by organization Agent Mode
+Updates the configuration for an organization integration
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsSetAgentOpenAIKeyRequest
+	@param integrationId
+	@return ApiOrganizationsUpdateIntegrationRequest
 */
-func (a *OrganizationAPIService) OrganizationsSetAgentOpenAIKey(ctx context.Context, id string) ApiOrganizationsSetAgentOpenAIKeyRequest {
-	return ApiOrganizationsSetAgentOpenAIKeyRequest{
-		ApiService: a,
-		ctx:        ctx,
-		id:         id,
+func (a *OrganizationAPIService) OrganizationsUpdateIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsUpdateIntegrationRequest {
+	return ApiOrganizationsUpdateIntegrationRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		id:            id,
+		integrationId: integrationId,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsSetAgentOpenAIKeyResponse
-func (a *OrganizationAPIService) OrganizationsSetAgentOpenAIKeyExecute(r ApiOrganizationsSetAgentOpenAIKeyRequest) (*OrganizationsSetAgentOpenAIKeyResponse, *http.Response, error) {
+//	@return OrganizationsUpdateIntegrationResponse
+func (a *OrganizationAPIService) OrganizationsUpdateIntegrationExecute(r ApiOrganizationsUpdateIntegrationRequest) (*OrganizationsUpdateIntegrationResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodPut
+		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsSetAgentOpenAIKeyResponse
+		localVarReturnValue *OrganizationsUpdateIntegrationResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsSetAgentOpenAIKey")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateIntegration")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings/openai-key"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -2035,33 +3154,33 @@ func (a *OrganizationAPIService) OrganizationsSetAgentOpenAIKeyExecute(r ApiOrga
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsUpdateAgentSettingsRequest struct {
+type ApiOrganizationsUpdateInviteLinkRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
-	body       *OrganizationsUpdateAgentSettingsBody
+	body       *OrganizationsUpdateInviteLinkBody
 }
 
-func (r ApiOrganizationsUpdateAgentSettingsRequest) Body(body OrganizationsUpdateAgentSettingsBody) ApiOrganizationsUpdateAgentSettingsRequest {
+func (r ApiOrganizationsUpdateInviteLinkRequest) Body(body OrganizationsUpdateInviteLinkBody) ApiOrganizationsUpdateInviteLinkRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiOrganizationsUpdateAgentSettingsRequest) Execute() (*OrganizationsUpdateAgentSettingsResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsUpdateAgentSettingsExecute(r)
+func (r ApiOrganizationsUpdateInviteLinkRequest) Execute() (*OrganizationsUpdateInviteLinkResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateInviteLinkExecute(r)
 }
 
 /*
-OrganizationsUpdateAgentSettings Update organization Agent Mode settings
+OrganizationsUpdateInviteLink Update an organization invite link
 
-Updates Agent Mode enablement for an organization
+Enables or disables the invite link for an organization
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsUpdateAgentSettingsRequest
+	@return ApiOrganizationsUpdateInviteLinkRequest
 */
-func (a *OrganizationAPIService) OrganizationsUpdateAgentSettings(ctx context.Context, id string) ApiOrganizationsUpdateAgentSettingsRequest {
-	return ApiOrganizationsUpdateAgentSettingsRequest{
+func (a *OrganizationAPIService) OrganizationsUpdateInviteLink(ctx context.Context, id string) ApiOrganizationsUpdateInviteLinkRequest {
+	return ApiOrganizationsUpdateInviteLinkRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -2070,21 +3189,21 @@ func (a *OrganizationAPIService) OrganizationsUpdateAgentSettings(ctx context.Co
 
 // Execute executes the request
 //
-//	@return OrganizationsUpdateAgentSettingsResponse
-func (a *OrganizationAPIService) OrganizationsUpdateAgentSettingsExecute(r ApiOrganizationsUpdateAgentSettingsRequest) (*OrganizationsUpdateAgentSettingsResponse, *http.Response, error) {
+//	@return OrganizationsUpdateInviteLinkResponse
+func (a *OrganizationAPIService) OrganizationsUpdateInviteLinkExecute(r ApiOrganizationsUpdateInviteLinkRequest) (*OrganizationsUpdateInviteLinkResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsUpdateAgentSettingsResponse
+		localVarReturnValue *OrganizationsUpdateInviteLinkResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateAgentSettings")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateInviteLink")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/agent-settings"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2158,61 +3277,57 @@ func (a *OrganizationAPIService) OrganizationsUpdateAgentSettingsExecute(r ApiOr
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsUpdateIntegrationRequest struct {
-	ctx           context.Context
-	ApiService    *OrganizationAPIService
-	id            string
-	integrationId string
-	body          *OrganizationsUpdateIntegrationBody
+type ApiOrganizationsUpdateOrganizationRequest struct {
+	ctx        context.Context
+	ApiService *OrganizationAPIService
+	id         string
+	body       *OrganizationsUpdateOrganizationBody
 }
 
-func (r ApiOrganizationsUpdateIntegrationRequest) Body(body OrganizationsUpdateIntegrationBody) ApiOrganizationsUpdateIntegrationRequest {
+func (r ApiOrganizationsUpdateOrganizationRequest) Body(body OrganizationsUpdateOrganizationBody) ApiOrganizationsUpdateOrganizationRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiOrganizationsUpdateIntegrationRequest) Execute() (*OrganizationsUpdateIntegrationResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsUpdateIntegrationExecute(r)
+func (r ApiOrganizationsUpdateOrganizationRequest) Execute() (*OrganizationsUpdateOrganizationResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateOrganizationExecute(r)
 }
 
 /*
-OrganizationsUpdateIntegration Update integration
+OrganizationsUpdateOrganization Update an organization
 
-Updates the configuration for an organization integration
+Updates the specified organization (can be referenced by ID or name)
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@param integrationId
-	@return ApiOrganizationsUpdateIntegrationRequest
+	@return ApiOrganizationsUpdateOrganizationRequest
 */
-func (a *OrganizationAPIService) OrganizationsUpdateIntegration(ctx context.Context, id string, integrationId string) ApiOrganizationsUpdateIntegrationRequest {
-	return ApiOrganizationsUpdateIntegrationRequest{
-		ApiService:    a,
-		ctx:           ctx,
-		id:            id,
-		integrationId: integrationId,
+func (a *OrganizationAPIService) OrganizationsUpdateOrganization(ctx context.Context, id string) ApiOrganizationsUpdateOrganizationRequest {
+	return ApiOrganizationsUpdateOrganizationRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
 	}
 }
 
 // Execute executes the request
 //
-//	@return OrganizationsUpdateIntegrationResponse
-func (a *OrganizationAPIService) OrganizationsUpdateIntegrationExecute(r ApiOrganizationsUpdateIntegrationRequest) (*OrganizationsUpdateIntegrationResponse, *http.Response, error) {
+//	@return OrganizationsUpdateOrganizationResponse
+func (a *OrganizationAPIService) OrganizationsUpdateOrganizationExecute(r ApiOrganizationsUpdateOrganizationRequest) (*OrganizationsUpdateOrganizationResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsUpdateIntegrationResponse
+		localVarReturnValue *OrganizationsUpdateOrganizationResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateIntegration")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateOrganization")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/integrations/{integrationId}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
-	localVarPath = strings.Replace(localVarPath, "{"+"integrationId"+"}", url.PathEscape(parameterValueToString(r.integrationId, "integrationId")), -1)
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
@@ -2285,33 +3400,33 @@ func (a *OrganizationAPIService) OrganizationsUpdateIntegrationExecute(r ApiOrga
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsUpdateInviteLinkRequest struct {
+type ApiOrganizationsUpdateRetentionPolicyRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
-	body       *OrganizationsUpdateInviteLinkBody
+	body       *OrganizationsUpdateRetentionPolicyBody
 }
 
-func (r ApiOrganizationsUpdateInviteLinkRequest) Body(body OrganizationsUpdateInviteLinkBody) ApiOrganizationsUpdateInviteLinkRequest {
+func (r ApiOrganizationsUpdateRetentionPolicyRequest) Body(body OrganizationsUpdateRetentionPolicyBody) ApiOrganizationsUpdateRetentionPolicyRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiOrganizationsUpdateInviteLinkRequest) Execute() (*OrganizationsUpdateInviteLinkResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsUpdateInviteLinkExecute(r)
+func (r ApiOrganizationsUpdateRetentionPolicyRequest) Execute() (*OrganizationsUpdateRetentionPolicyResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateRetentionPolicyExecute(r)
 }
 
 /*
-OrganizationsUpdateInviteLink Update an organization invite link
+OrganizationsUpdateRetentionPolicy Update organization retention policy
 
-Enables or disables the invite link for an organization
+Updates the retention policy applied to every canvas in the organization without its own
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsUpdateInviteLinkRequest
+	@return ApiOrganizationsUpdateRetentionPolicyRequest
 */
-func (a *OrganizationAPIService) OrganizationsUpdateInviteLink(ctx context.Context, id string) ApiOrganizationsUpdateInviteLinkRequest {
-	return ApiOrganizationsUpdateInviteLinkRequest{
+func (a *OrganizationAPIService) OrganizationsUpdateRetentionPolicy(ctx context.Context, id string) ApiOrganizationsUpdateRetentionPolicyRequest {
+	return ApiOrganizationsUpdateRetentionPolicyRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -2320,21 +3435,21 @@ func (a *OrganizationAPIService) OrganizationsUpdateInviteLink(ctx context.Conte
 
 // Execute executes the request
 //
-//	@return OrganizationsUpdateInviteLinkResponse
-func (a *OrganizationAPIService) OrganizationsUpdateInviteLinkExecute(r ApiOrganizationsUpdateInviteLinkRequest) (*OrganizationsUpdateInviteLinkResponse, *http.Response, error) {
+//	@return OrganizationsUpdateRetentionPolicyResponse
+func (a *OrganizationAPIService) OrganizationsUpdateRetentionPolicyExecute(r ApiOrganizationsUpdateRetentionPolicyRequest) (*OrganizationsUpdateRetentionPolicyResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsUpdateInviteLinkResponse
+		localVarReturnValue *OrganizationsUpdateRetentionPolicyResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateInviteLink")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateRetentionPolicy")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}/invite-link"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/retention-policy"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -2408,33 +3523,33 @@ func (a *OrganizationAPIService) OrganizationsUpdateInviteLinkExecute(r ApiOrgan
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiOrganizationsUpdateOrganizationRequest struct {
+type ApiOrganizationsUpdateSAMLSettingsRequest struct {
 	ctx        context.Context
 	ApiService *OrganizationAPIService
 	id         string
-	body       *OrganizationsUpdateOrganizationBody
+	body       *OrganizationsUpdateSAMLSettingsBody
 }
 
-func (r ApiOrganizationsUpdateOrganizationRequest) Body(body OrganizationsUpdateOrganizationBody) ApiOrganizationsUpdateOrganizationRequest {
+func (r ApiOrganizationsUpdateSAMLSettingsRequest) Body(body OrganizationsUpdateSAMLSettingsBody) ApiOrganizationsUpdateSAMLSettingsRequest {
 	r.body = &body
 	return r
 }
 
-func (r ApiOrganizationsUpdateOrganizationRequest) Execute() (*OrganizationsUpdateOrganizationResponse, *http.Response, error) {
-	return r.ApiService.OrganizationsUpdateOrganizationExecute(r)
+func (r ApiOrganizationsUpdateSAMLSettingsRequest) Execute() (*OrganizationsUpdateSAMLSettingsResponse, *http.Response, error) {
+	return r.ApiService.OrganizationsUpdateSAMLSettingsExecute(r)
 }
 
 /*
-OrganizationsUpdateOrganization Update an organization
+OrganizationsUpdateSAMLSettings Update organization SAML settings
 
-Updates the specified organization (can be referenced by ID or name)
+Configures SAML single sign-on with the identity provider metadata, attribute mapping and group mappings
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param id
-	@return ApiOrganizationsUpdateOrganizationRequest
+	@return ApiOrganizationsUpdateSAMLSettingsRequest
 */
-func (a *OrganizationAPIService) OrganizationsUpdateOrganization(ctx context.Context, id string) ApiOrganizationsUpdateOrganizationRequest {
-	return ApiOrganizationsUpdateOrganizationRequest{
+func (a *OrganizationAPIService) OrganizationsUpdateSAMLSettings(ctx context.Context, id string) ApiOrganizationsUpdateSAMLSettingsRequest {
+	return ApiOrganizationsUpdateSAMLSettingsRequest{
 		ApiService: a,
 		ctx:        ctx,
 		id:         id,
@@ -2443,21 +3558,21 @@ func (a *OrganizationAPIService) OrganizationsUpdateOrganization(ctx context.Con
 
 // Execute executes the request
 //
-//	@return OrganizationsUpdateOrganizationResponse
-func (a *OrganizationAPIService) OrganizationsUpdateOrganizationExecute(r ApiOrganizationsUpdateOrganizationRequest) (*OrganizationsUpdateOrganizationResponse, *http.Response, error) {
+//	@return OrganizationsUpdateSAMLSettingsResponse
+func (a *OrganizationAPIService) OrganizationsUpdateSAMLSettingsExecute(r ApiOrganizationsUpdateSAMLSettingsRequest) (*OrganizationsUpdateSAMLSettingsResponse, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodPatch
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OrganizationsUpdateOrganizationResponse
+		localVarReturnValue *OrganizationsUpdateSAMLSettingsResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateOrganization")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OrganizationAPIService.OrganizationsUpdateSAMLSettings")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/api/v1/organizations/{id}"
+	localVarPath := localBasePath + "/api/v1/organizations/{id}/saml"
 	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
 
 	localVarHeaderParams := make(map[string]string)
@@ -34,6 +34,8 @@ type BlueprintsBlueprint struct {
 	Icon           *string                             `json:"icon,omitempty"`
 	Color          *string                             `json:"color,omitempty"`
 	CreatedBy      *SuperplaneBlueprintsUserRef        `json:"createdBy,omitempty"`
+	Version        *int32                              `json:"version,omitempty"`
+	HasDraft       *bool                               `json:"hasDraft,omitempty"`
 }
 
 // NewBlueprintsBlueprint instantiates a new BlueprintsBlueprint object
@@ -469,6 +471,70 @@ func (o *BlueprintsBlueprint) SetCreatedBy(v SuperplaneBlueprintsUserRef) {
 	o.CreatedBy = &v
 }
 
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *BlueprintsBlueprint) GetVersion() int32 {
+	if o == nil || IsNil(o.Version) {
+		var ret int32
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BlueprintsBlueprint) GetVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.Version) {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *BlueprintsBlueprint) HasVersion() bool {
+	if o != nil && !IsNil(o.Version) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given int32 and assigns it to the Version field.
+func (o *BlueprintsBlueprint) SetVersion(v int32) {
+	o.Version = &v
+}
+
+// GetHasDraft returns the HasDraft field value if set, zero value otherwise.
+func (o *BlueprintsBlueprint) GetHasDraft() bool {
+	if o == nil || IsNil(o.HasDraft) {
+		var ret bool
+		return ret
+	}
+	return *o.HasDraft
+}
+
+// GetHasDraftOk returns a tuple with the HasDraft field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *BlueprintsBlueprint) GetHasDraftOk() (*bool, bool) {
+	if o == nil || IsNil(o.HasDraft) {
+		return nil, false
+	}
+	return o.HasDraft, true
+}
+
+// HasHasDraft returns a boolean if a field has been set.
+func (o *BlueprintsBlueprint) HasHasDraft() bool {
+	if o != nil && !IsNil(o.HasDraft) {
+		return true
+	}
+
+	return false
+}
+
+// SetHasDraft gets a reference to the given bool and assigns it to the HasDraft field.
+func (o *BlueprintsBlueprint) SetHasDraft(v bool) {
+	o.HasDraft = &v
+}
+
 func (o BlueprintsBlueprint) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -518,6 +584,12 @@ func (o BlueprintsBlueprint) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CreatedBy) {
 		toSerialize["createdBy"] = o.CreatedBy
 	}
+	if !IsNil(o.Version) {
+		toSerialize["version"] = o.Version
+	}
+	if !IsNil(o.HasDraft) {
+		toSerialize["hasDraft"] = o.HasDraft
+	}
 	return toSerialize, nil
 }
 
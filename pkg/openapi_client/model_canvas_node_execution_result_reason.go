@@ -24,6 +24,7 @@ const (
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_OK             CanvasNodeExecutionResultReason = "RESULT_REASON_OK"
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_ERROR          CanvasNodeExecutionResultReason = "RESULT_REASON_ERROR"
 	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_ERROR_RESOLVED CanvasNodeExecutionResultReason = "RESULT_REASON_ERROR_RESOLVED"
+	CANVASNODEEXECUTIONRESULTREASON_RESULT_REASON_TIMED_OUT      CanvasNodeExecutionResultReason = "RESULT_REASON_TIMED_OUT"
 )
 
 // All allowed values of CanvasNodeExecutionResultReason enum
@@ -31,6 +32,7 @@ var AllowedCanvasNodeExecutionResultReasonEnumValues = []CanvasNodeExecutionResu
 	"RESULT_REASON_OK",
 	"RESULT_REASON_ERROR",
 	"RESULT_REASON_ERROR_RESOLVED",
+	"RESULT_REASON_TIMED_OUT",
 }
 
 func (v *CanvasNodeExecutionResultReason) UnmarshalJSON(src []byte) error {
@@ -28,6 +28,7 @@ type CanvasesCanvasEvent struct {
 	CustomName *string                `json:"customName,omitempty"`
 	Data       map[string]interface{} `json:"data,omitempty"`
 	CreatedAt  *time.Time             `json:"createdAt,omitempty"`
+	ReplayOfId *string                `json:"replayOfId,omitempty"`
 }
 
 // NewCanvasesCanvasEvent instantiates a new CanvasesCanvasEvent object
@@ -271,6 +272,38 @@ func (o *CanvasesCanvasEvent) SetCreatedAt(v time.Time) {
 	o.CreatedAt = &v
 }
 
+// GetReplayOfId returns the ReplayOfId field value if set, zero value otherwise.
+func (o *CanvasesCanvasEvent) GetReplayOfId() string {
+	if o == nil || IsNil(o.ReplayOfId) {
+		var ret string
+		return ret
+	}
+	return *o.ReplayOfId
+}
+
+// GetReplayOfIdOk returns a tuple with the ReplayOfId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasEvent) GetReplayOfIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ReplayOfId) {
+		return nil, false
+	}
+	return o.ReplayOfId, true
+}
+
+// HasReplayOfId returns a boolean if a field has been set.
+func (o *CanvasesCanvasEvent) HasReplayOfId() bool {
+	if o != nil && !IsNil(o.ReplayOfId) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplayOfId gets a reference to the given string and assigns it to the ReplayOfId field.
+func (o *CanvasesCanvasEvent) SetReplayOfId(v string) {
+	o.ReplayOfId = &v
+}
+
 func (o CanvasesCanvasEvent) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -302,6 +335,9 @@ func (o CanvasesCanvasEvent) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CreatedAt) {
 		toSerialize["createdAt"] = o.CreatedAt
 	}
+	if !IsNil(o.ReplayOfId) {
+		toSerialize["replayOfId"] = o.ReplayOfId
+	}
 	return toSerialize, nil
 }
 
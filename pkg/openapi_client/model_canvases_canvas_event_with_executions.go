@@ -29,6 +29,7 @@ type CanvasesCanvasEventWithExecutions struct {
 	CreatedAt  *time.Time                    `json:"createdAt,omitempty"`
 	Executions []CanvasesCanvasNodeExecution `json:"executions,omitempty"`
 	CustomName *string                       `json:"customName,omitempty"`
+	ReplayOfId *string                       `json:"replayOfId,omitempty"`
 }
 
 // NewCanvasesCanvasEventWithExecutions instantiates a new CanvasesCanvasEventWithExecutions object
@@ -304,6 +305,38 @@ func (o *CanvasesCanvasEventWithExecutions) SetCustomName(v string) {
 	o.CustomName = &v
 }
 
+// GetReplayOfId returns the ReplayOfId field value if set, zero value otherwise.
+func (o *CanvasesCanvasEventWithExecutions) GetReplayOfId() string {
+	if o == nil || IsNil(o.ReplayOfId) {
+		var ret string
+		return ret
+	}
+	return *o.ReplayOfId
+}
+
+// GetReplayOfIdOk returns a tuple with the ReplayOfId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasEventWithExecutions) GetReplayOfIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ReplayOfId) {
+		return nil, false
+	}
+	return o.ReplayOfId, true
+}
+
+// HasReplayOfId returns a boolean if a field has been set.
+func (o *CanvasesCanvasEventWithExecutions) HasReplayOfId() bool {
+	if o != nil && !IsNil(o.ReplayOfId) {
+		return true
+	}
+
+	return false
+}
+
+// SetReplayOfId gets a reference to the given string and assigns it to the ReplayOfId field.
+func (o *CanvasesCanvasEventWithExecutions) SetReplayOfId(v string) {
+	o.ReplayOfId = &v
+}
+
 func (o CanvasesCanvasEventWithExecutions) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -338,6 +371,9 @@ func (o CanvasesCanvasEventWithExecutions) ToMap() (map[string]interface{}, erro
 	if !IsNil(o.CustomName) {
 		toSerialize["customName"] = o.CustomName
 	}
+	if !IsNil(o.ReplayOfId) {
+		toSerialize["replayOfId"] = o.ReplayOfId
+	}
 	return toSerialize, nil
 }
 
@@ -13,6 +13,7 @@ package openapi_client
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // checks if the CanvasesCanvasMemory type satisfies the MappedNullable interface at compile time
@@ -23,6 +24,7 @@ type CanvasesCanvasMemory struct {
 	Id        *string                `json:"id,omitempty"`
 	Namespace *string                `json:"namespace,omitempty"`
 	Values    map[string]interface{} `json:"values,omitempty"`
+	ExpiresAt *time.Time             `json:"expiresAt,omitempty"`
 }
 
 // NewCanvasesCanvasMemory instantiates a new CanvasesCanvasMemory object
@@ -138,6 +140,38 @@ func (o *CanvasesCanvasMemory) SetValues(v map[string]interface{}) {
 	o.Values = v
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *CanvasesCanvasMemory) GetExpiresAt() time.Time {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMemory) GetExpiresAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *CanvasesCanvasMemory) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given time.Time and assigns it to the ExpiresAt field.
+func (o *CanvasesCanvasMemory) SetExpiresAt(v time.Time) {
+	o.ExpiresAt = &v
+}
+
 func (o CanvasesCanvasMemory) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -157,6 +191,9 @@ func (o CanvasesCanvasMemory) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Values) {
 		toSerialize["values"] = o.Values
 	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	return toSerialize, nil
 }
 
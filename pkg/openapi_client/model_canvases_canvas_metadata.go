@@ -31,6 +31,7 @@ type CanvasesCanvasMetadata struct {
 	IsTemplate                  *bool                                      `json:"isTemplate,omitempty"`
 	CanvasVersioningEnabled     *bool                                      `json:"canvasVersioningEnabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasesCanvasChangeRequestApprovalConfig `json:"changeRequestApprovalConfig,omitempty"`
+	Environment                 *string                                    `json:"environment,omitempty"`
 }
 
 // NewCanvasesCanvasMetadata instantiates a new CanvasesCanvasMetadata object
@@ -370,6 +371,38 @@ func (o *CanvasesCanvasMetadata) SetChangeRequestApprovalConfig(v CanvasesCanvas
 	o.ChangeRequestApprovalConfig = &v
 }
 
+// GetEnvironment returns the Environment field value if set, zero value otherwise.
+func (o *CanvasesCanvasMetadata) GetEnvironment() string {
+	if o == nil || IsNil(o.Environment) {
+		var ret string
+		return ret
+	}
+	return *o.Environment
+}
+
+// GetEnvironmentOk returns a tuple with the Environment field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasMetadata) GetEnvironmentOk() (*string, bool) {
+	if o == nil || IsNil(o.Environment) {
+		return nil, false
+	}
+	return o.Environment, true
+}
+
+// HasEnvironment returns a boolean if a field has been set.
+func (o *CanvasesCanvasMetadata) HasEnvironment() bool {
+	if o != nil && !IsNil(o.Environment) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironment gets a reference to the given string and assigns it to the Environment field.
+func (o *CanvasesCanvasMetadata) SetEnvironment(v string) {
+	o.Environment = &v
+}
+
 func (o CanvasesCanvasMetadata) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -410,6 +443,9 @@ func (o CanvasesCanvasMetadata) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ChangeRequestApprovalConfig) {
 		toSerialize["changeRequestApprovalConfig"] = o.ChangeRequestApprovalConfig
 	}
+	if !IsNil(o.Environment) {
+		toSerialize["environment"] = o.Environment
+	}
 	return toSerialize, nil
 }
 
@@ -39,6 +39,10 @@ type CanvasesCanvasNodeExecution struct {
 	ChildExecutions     []CanvasesCanvasNodeExecution     `json:"childExecutions,omitempty"`
 	RootEvent           *CanvasesCanvasEvent              `json:"rootEvent,omitempty"`
 	CancelledBy         *SuperplaneCanvasesUserRef        `json:"cancelledBy,omitempty"`
+	Attempt             *int32                            `json:"attempt,omitempty"`
+	RetryOfExecutionId  *string                           `json:"retryOfExecutionId,omitempty"`
+	RunAt               *time.Time                        `json:"runAt,omitempty"`
+	RerunOfExecutionId  *string                           `json:"rerunOfExecutionId,omitempty"`
 }
 
 // NewCanvasesCanvasNodeExecution instantiates a new CanvasesCanvasNodeExecution object
@@ -646,6 +650,134 @@ func (o *CanvasesCanvasNodeExecution) SetCancelledBy(v SuperplaneCanvasesUserRef
 	o.CancelledBy = &v
 }
 
+// GetAttempt returns the Attempt field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetAttempt() int32 {
+	if o == nil || IsNil(o.Attempt) {
+		var ret int32
+		return ret
+	}
+	return *o.Attempt
+}
+
+// GetAttemptOk returns a tuple with the Attempt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetAttemptOk() (*int32, bool) {
+	if o == nil || IsNil(o.Attempt) {
+		return nil, false
+	}
+	return o.Attempt, true
+}
+
+// HasAttempt returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasAttempt() bool {
+	if o != nil && !IsNil(o.Attempt) {
+		return true
+	}
+
+	return false
+}
+
+// SetAttempt gets a reference to the given int32 and assigns it to the Attempt field.
+func (o *CanvasesCanvasNodeExecution) SetAttempt(v int32) {
+	o.Attempt = &v
+}
+
+// GetRetryOfExecutionId returns the RetryOfExecutionId field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetRetryOfExecutionId() string {
+	if o == nil || IsNil(o.RetryOfExecutionId) {
+		var ret string
+		return ret
+	}
+	return *o.RetryOfExecutionId
+}
+
+// GetRetryOfExecutionIdOk returns a tuple with the RetryOfExecutionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetRetryOfExecutionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RetryOfExecutionId) {
+		return nil, false
+	}
+	return o.RetryOfExecutionId, true
+}
+
+// HasRetryOfExecutionId returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasRetryOfExecutionId() bool {
+	if o != nil && !IsNil(o.RetryOfExecutionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryOfExecutionId gets a reference to the given string and assigns it to the RetryOfExecutionId field.
+func (o *CanvasesCanvasNodeExecution) SetRetryOfExecutionId(v string) {
+	o.RetryOfExecutionId = &v
+}
+
+// GetRunAt returns the RunAt field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetRunAt() time.Time {
+	if o == nil || IsNil(o.RunAt) {
+		var ret time.Time
+		return ret
+	}
+	return *o.RunAt
+}
+
+// GetRunAtOk returns a tuple with the RunAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetRunAtOk() (*time.Time, bool) {
+	if o == nil || IsNil(o.RunAt) {
+		return nil, false
+	}
+	return o.RunAt, true
+}
+
+// HasRunAt returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasRunAt() bool {
+	if o != nil && !IsNil(o.RunAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetRunAt gets a reference to the given time.Time and assigns it to the RunAt field.
+func (o *CanvasesCanvasNodeExecution) SetRunAt(v time.Time) {
+	o.RunAt = &v
+}
+
+// GetRerunOfExecutionId returns the RerunOfExecutionId field value if set, zero value otherwise.
+func (o *CanvasesCanvasNodeExecution) GetRerunOfExecutionId() string {
+	if o == nil || IsNil(o.RerunOfExecutionId) {
+		var ret string
+		return ret
+	}
+	return *o.RerunOfExecutionId
+}
+
+// GetRerunOfExecutionIdOk returns a tuple with the RerunOfExecutionId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasNodeExecution) GetRerunOfExecutionIdOk() (*string, bool) {
+	if o == nil || IsNil(o.RerunOfExecutionId) {
+		return nil, false
+	}
+	return o.RerunOfExecutionId, true
+}
+
+// HasRerunOfExecutionId returns a boolean if a field has been set.
+func (o *CanvasesCanvasNodeExecution) HasRerunOfExecutionId() bool {
+	if o != nil && !IsNil(o.RerunOfExecutionId) {
+		return true
+	}
+
+	return false
+}
+
+// SetRerunOfExecutionId gets a reference to the given string and assigns it to the RerunOfExecutionId field.
+func (o *CanvasesCanvasNodeExecution) SetRerunOfExecutionId(v string) {
+	o.RerunOfExecutionId = &v
+}
+
 func (o CanvasesCanvasNodeExecution) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -710,6 +842,18 @@ func (o CanvasesCanvasNodeExecution) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CancelledBy) {
 		toSerialize["cancelledBy"] = o.CancelledBy
 	}
+	if !IsNil(o.Attempt) {
+		toSerialize["attempt"] = o.Attempt
+	}
+	if !IsNil(o.RetryOfExecutionId) {
+		toSerialize["retryOfExecutionId"] = o.RetryOfExecutionId
+	}
+	if !IsNil(o.RunAt) {
+		toSerialize["runAt"] = o.RunAt
+	}
+	if !IsNil(o.RerunOfExecutionId) {
+		toSerialize["rerunOfExecutionId"] = o.RerunOfExecutionId
+	}
 	return toSerialize, nil
 }
 
@@ -20,8 +20,10 @@ var _ MappedNullable = &CanvasesCanvasSpec{}
 
 // CanvasesCanvasSpec struct for CanvasesCanvasSpec
 type CanvasesCanvasSpec struct {
-	Nodes []ComponentsNode `json:"nodes,omitempty"`
-	Edges []ComponentsEdge `json:"edges,omitempty"`
+	Nodes        []ComponentsNode            `json:"nodes,omitempty"`
+	Edges        []ComponentsEdge            `json:"edges,omitempty"`
+	Variables    []CanvasesCanvasVariable    `json:"variables,omitempty"`
+	Environments []CanvasesCanvasEnvironment `json:"environments,omitempty"`
 }
 
 // NewCanvasesCanvasSpec instantiates a new CanvasesCanvasSpec object
@@ -105,6 +107,70 @@ func (o *CanvasesCanvasSpec) SetEdges(v []ComponentsEdge) {
 	o.Edges = v
 }
 
+// GetVariables returns the Variables field value if set, zero value otherwise.
+func (o *CanvasesCanvasSpec) GetVariables() []CanvasesCanvasVariable {
+	if o == nil || IsNil(o.Variables) {
+		var ret []CanvasesCanvasVariable
+		return ret
+	}
+	return o.Variables
+}
+
+// GetVariablesOk returns a tuple with the Variables field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasSpec) GetVariablesOk() ([]CanvasesCanvasVariable, bool) {
+	if o == nil || IsNil(o.Variables) {
+		return nil, false
+	}
+	return o.Variables, true
+}
+
+// HasVariables returns a boolean if a field has been set.
+func (o *CanvasesCanvasSpec) HasVariables() bool {
+	if o != nil && !IsNil(o.Variables) {
+		return true
+	}
+
+	return false
+}
+
+// SetVariables gets a reference to the given []CanvasesCanvasVariable and assigns it to the Variables field.
+func (o *CanvasesCanvasSpec) SetVariables(v []CanvasesCanvasVariable) {
+	o.Variables = v
+}
+
+// GetEnvironments returns the Environments field value if set, zero value otherwise.
+func (o *CanvasesCanvasSpec) GetEnvironments() []CanvasesCanvasEnvironment {
+	if o == nil || IsNil(o.Environments) {
+		var ret []CanvasesCanvasEnvironment
+		return ret
+	}
+	return o.Environments
+}
+
+// GetEnvironmentsOk returns a tuple with the Environments field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesCanvasSpec) GetEnvironmentsOk() ([]CanvasesCanvasEnvironment, bool) {
+	if o == nil || IsNil(o.Environments) {
+		return nil, false
+	}
+	return o.Environments, true
+}
+
+// HasEnvironments returns a boolean if a field has been set.
+func (o *CanvasesCanvasSpec) HasEnvironments() bool {
+	if o != nil && !IsNil(o.Environments) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironments gets a reference to the given []CanvasesCanvasEnvironment and assigns it to the Environments field.
+func (o *CanvasesCanvasSpec) SetEnvironments(v []CanvasesCanvasEnvironment) {
+	o.Environments = v
+}
+
 func (o CanvasesCanvasSpec) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -121,6 +187,12 @@ func (o CanvasesCanvasSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Edges) {
 		toSerialize["edges"] = o.Edges
 	}
+	if !IsNil(o.Variables) {
+		toSerialize["variables"] = o.Variables
+	}
+	if !IsNil(o.Environments) {
+		toSerialize["environments"] = o.Environments
+	}
 	return toSerialize, nil
 }
 
@@ -24,6 +24,7 @@ type CanvasesUpdateCanvasBody struct {
 	Description                 *string                                    `json:"description,omitempty"`
 	CanvasVersioningEnabled     *bool                                      `json:"canvasVersioningEnabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasesCanvasChangeRequestApprovalConfig `json:"changeRequestApprovalConfig,omitempty"`
+	Environment                 *string                                    `json:"environment,omitempty"`
 }
 
 // NewCanvasesUpdateCanvasBody instantiates a new CanvasesUpdateCanvasBody object
@@ -171,6 +172,38 @@ func (o *CanvasesUpdateCanvasBody) SetChangeRequestApprovalConfig(v CanvasesCanv
 	o.ChangeRequestApprovalConfig = &v
 }
 
+// GetEnvironment returns the Environment field value if set, zero value otherwise.
+func (o *CanvasesUpdateCanvasBody) GetEnvironment() string {
+	if o == nil || IsNil(o.Environment) {
+		var ret string
+		return ret
+	}
+	return *o.Environment
+}
+
+// GetEnvironmentOk returns a tuple with the Environment field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CanvasesUpdateCanvasBody) GetEnvironmentOk() (*string, bool) {
+	if o == nil || IsNil(o.Environment) {
+		return nil, false
+	}
+	return o.Environment, true
+}
+
+// HasEnvironment returns a boolean if a field has been set.
+func (o *CanvasesUpdateCanvasBody) HasEnvironment() bool {
+	if o != nil && !IsNil(o.Environment) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironment gets a reference to the given string and assigns it to the Environment field.
+func (o *CanvasesUpdateCanvasBody) SetEnvironment(v string) {
+	o.Environment = &v
+}
+
 func (o CanvasesUpdateCanvasBody) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -193,6 +226,9 @@ func (o CanvasesUpdateCanvasBody) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ChangeRequestApprovalConfig) {
 		toSerialize["changeRequestApprovalConfig"] = o.ChangeRequestApprovalConfig
 	}
+	if !IsNil(o.Environment) {
+		toSerialize["environment"] = o.Environment
+	}
 	return toSerialize, nil
 }
 
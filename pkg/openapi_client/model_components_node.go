@@ -35,6 +35,10 @@ type ComponentsNode struct {
 	ErrorMessage   *string                   `json:"errorMessage,omitempty"`
 	WarningMessage *string                   `json:"warningMessage,omitempty"`
 	Paused         *bool                     `json:"paused,omitempty"`
+	RetryPolicy    *ComponentsRetryPolicy    `json:"retryPolicy,omitempty"`
+	TimeoutSeconds *int32                    `json:"timeoutSeconds,omitempty"`
+	MaxConcurrency *int32                    `json:"maxConcurrency,omitempty"`
+	QueuePolicy    *NodeQueuePolicy          `json:"queuePolicy,omitempty"`
 }
 
 // NewComponentsNode instantiates a new ComponentsNode object
@@ -45,6 +49,8 @@ func NewComponentsNode() *ComponentsNode {
 	this := ComponentsNode{}
 	var type_ ComponentsNodeType = COMPONENTSNODETYPE_TYPE_COMPONENT
 	this.Type = &type_
+	var queuePolicy NodeQueuePolicy = NODEQUEUEPOLICY_QUEUE_POLICY_FIFO
+	this.QueuePolicy = &queuePolicy
 	return &this
 }
 
@@ -55,6 +61,8 @@ func NewComponentsNodeWithDefaults() *ComponentsNode {
 	this := ComponentsNode{}
 	var type_ ComponentsNodeType = COMPONENTSNODETYPE_TYPE_COMPONENT
 	this.Type = &type_
+	var queuePolicy NodeQueuePolicy = NODEQUEUEPOLICY_QUEUE_POLICY_FIFO
+	this.QueuePolicy = &queuePolicy
 	return &this
 }
 
@@ -538,6 +546,134 @@ func (o *ComponentsNode) SetPaused(v bool) {
 	o.Paused = &v
 }
 
+// GetRetryPolicy returns the RetryPolicy field value if set, zero value otherwise.
+func (o *ComponentsNode) GetRetryPolicy() ComponentsRetryPolicy {
+	if o == nil || IsNil(o.RetryPolicy) {
+		var ret ComponentsRetryPolicy
+		return ret
+	}
+	return *o.RetryPolicy
+}
+
+// GetRetryPolicyOk returns a tuple with the RetryPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetRetryPolicyOk() (*ComponentsRetryPolicy, bool) {
+	if o == nil || IsNil(o.RetryPolicy) {
+		return nil, false
+	}
+	return o.RetryPolicy, true
+}
+
+// HasRetryPolicy returns a boolean if a field has been set.
+func (o *ComponentsNode) HasRetryPolicy() bool {
+	if o != nil && !IsNil(o.RetryPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetRetryPolicy gets a reference to the given ComponentsRetryPolicy and assigns it to the RetryPolicy field.
+func (o *ComponentsNode) SetRetryPolicy(v ComponentsRetryPolicy) {
+	o.RetryPolicy = &v
+}
+
+// GetTimeoutSeconds returns the TimeoutSeconds field value if set, zero value otherwise.
+func (o *ComponentsNode) GetTimeoutSeconds() int32 {
+	if o == nil || IsNil(o.TimeoutSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.TimeoutSeconds
+}
+
+// GetTimeoutSecondsOk returns a tuple with the TimeoutSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetTimeoutSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.TimeoutSeconds) {
+		return nil, false
+	}
+	return o.TimeoutSeconds, true
+}
+
+// HasTimeoutSeconds returns a boolean if a field has been set.
+func (o *ComponentsNode) HasTimeoutSeconds() bool {
+	if o != nil && !IsNil(o.TimeoutSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetTimeoutSeconds gets a reference to the given int32 and assigns it to the TimeoutSeconds field.
+func (o *ComponentsNode) SetTimeoutSeconds(v int32) {
+	o.TimeoutSeconds = &v
+}
+
+// GetMaxConcurrency returns the MaxConcurrency field value if set, zero value otherwise.
+func (o *ComponentsNode) GetMaxConcurrency() int32 {
+	if o == nil || IsNil(o.MaxConcurrency) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrency
+}
+
+// GetMaxConcurrencyOk returns a tuple with the MaxConcurrency field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetMaxConcurrencyOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxConcurrency) {
+		return nil, false
+	}
+	return o.MaxConcurrency, true
+}
+
+// HasMaxConcurrency returns a boolean if a field has been set.
+func (o *ComponentsNode) HasMaxConcurrency() bool {
+	if o != nil && !IsNil(o.MaxConcurrency) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrency gets a reference to the given int32 and assigns it to the MaxConcurrency field.
+func (o *ComponentsNode) SetMaxConcurrency(v int32) {
+	o.MaxConcurrency = &v
+}
+
+// GetQueuePolicy returns the QueuePolicy field value if set, zero value otherwise.
+func (o *ComponentsNode) GetQueuePolicy() NodeQueuePolicy {
+	if o == nil || IsNil(o.QueuePolicy) {
+		var ret NodeQueuePolicy
+		return ret
+	}
+	return *o.QueuePolicy
+}
+
+// GetQueuePolicyOk returns a tuple with the QueuePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ComponentsNode) GetQueuePolicyOk() (*NodeQueuePolicy, bool) {
+	if o == nil || IsNil(o.QueuePolicy) {
+		return nil, false
+	}
+	return o.QueuePolicy, true
+}
+
+// HasQueuePolicy returns a boolean if a field has been set.
+func (o *ComponentsNode) HasQueuePolicy() bool {
+	if o != nil && !IsNil(o.QueuePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetQueuePolicy gets a reference to the given NodeQueuePolicy and assigns it to the QueuePolicy field.
+func (o *ComponentsNode) SetQueuePolicy(v NodeQueuePolicy) {
+	o.QueuePolicy = &v
+}
+
 func (o ComponentsNode) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -593,6 +729,18 @@ func (o ComponentsNode) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Paused) {
 		toSerialize["paused"] = o.Paused
 	}
+	if !IsNil(o.RetryPolicy) {
+		toSerialize["retryPolicy"] = o.RetryPolicy
+	}
+	if !IsNil(o.TimeoutSeconds) {
+		toSerialize["timeoutSeconds"] = o.TimeoutSeconds
+	}
+	if !IsNil(o.MaxConcurrency) {
+		toSerialize["maxConcurrency"] = o.MaxConcurrency
+	}
+	if !IsNil(o.QueuePolicy) {
+		toSerialize["queuePolicy"] = o.QueuePolicy
+	}
 	return toSerialize, nil
 }
 
@@ -20,7 +20,9 @@ var _ MappedNullable = &NodeBlueprintRef{}
 
 // NodeBlueprintRef struct for NodeBlueprintRef
 type NodeBlueprintRef struct {
-	Id *string `json:"id,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	Version       *int32  `json:"version,omitempty"`
+	LatestVersion *int32  `json:"latestVersion,omitempty"`
 }
 
 // NewNodeBlueprintRef instantiates a new NodeBlueprintRef object
@@ -72,6 +74,70 @@ func (o *NodeBlueprintRef) SetId(v string) {
 	o.Id = &v
 }
 
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *NodeBlueprintRef) GetVersion() int32 {
+	if o == nil || IsNil(o.Version) {
+		var ret int32
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBlueprintRef) GetVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.Version) {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *NodeBlueprintRef) HasVersion() bool {
+	if o != nil && !IsNil(o.Version) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given int32 and assigns it to the Version field.
+func (o *NodeBlueprintRef) SetVersion(v int32) {
+	o.Version = &v
+}
+
+// GetLatestVersion returns the LatestVersion field value if set, zero value otherwise.
+func (o *NodeBlueprintRef) GetLatestVersion() int32 {
+	if o == nil || IsNil(o.LatestVersion) {
+		var ret int32
+		return ret
+	}
+	return *o.LatestVersion
+}
+
+// GetLatestVersionOk returns a tuple with the LatestVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBlueprintRef) GetLatestVersionOk() (*int32, bool) {
+	if o == nil || IsNil(o.LatestVersion) {
+		return nil, false
+	}
+	return o.LatestVersion, true
+}
+
+// HasLatestVersion returns a boolean if a field has been set.
+func (o *NodeBlueprintRef) HasLatestVersion() bool {
+	if o != nil && !IsNil(o.LatestVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetLatestVersion gets a reference to the given int32 and assigns it to the LatestVersion field.
+func (o *NodeBlueprintRef) SetLatestVersion(v int32) {
+	o.LatestVersion = &v
+}
+
 func (o NodeBlueprintRef) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -85,6 +151,12 @@ func (o NodeBlueprintRef) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.Version) {
+		toSerialize["version"] = o.Version
+	}
+	if !IsNil(o.LatestVersion) {
+		toSerialize["latestVersion"] = o.LatestVersion
+	}
 	return toSerialize, nil
 }
 
@@ -23,12 +23,14 @@ type SecretProvider string
 const (
 	SECRETPROVIDER_PROVIDER_UNKNOWN SecretProvider = "PROVIDER_UNKNOWN"
 	SECRETPROVIDER_PROVIDER_LOCAL   SecretProvider = "PROVIDER_LOCAL"
+	SECRETPROVIDER_PROVIDER_VAULT   SecretProvider = "PROVIDER_VAULT"
 )
 
 // All allowed values of SecretProvider enum
 var AllowedSecretProviderEnumValues = []SecretProvider{
 	"PROVIDER_UNKNOWN",
 	"PROVIDER_LOCAL",
+	"PROVIDER_VAULT",
 }
 
 func (v *SecretProvider) UnmarshalJSON(src []byte) error {
@@ -22,6 +22,7 @@ var _ MappedNullable = &SecretsSecretSpec{}
 type SecretsSecretSpec struct {
 	Provider *SecretProvider `json:"provider,omitempty"`
 	Local    *SecretLocal    `json:"local,omitempty"`
+	Vault    *SecretVault    `json:"vault,omitempty"`
 }
 
 // NewSecretsSecretSpec instantiates a new SecretsSecretSpec object
@@ -109,6 +110,38 @@ func (o *SecretsSecretSpec) SetLocal(v SecretLocal) {
 	o.Local = &v
 }
 
+// GetVault returns the Vault field value if set, zero value otherwise.
+func (o *SecretsSecretSpec) GetVault() SecretVault {
+	if o == nil || IsNil(o.Vault) {
+		var ret SecretVault
+		return ret
+	}
+	return *o.Vault
+}
+
+// GetVaultOk returns a tuple with the Vault field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SecretsSecretSpec) GetVaultOk() (*SecretVault, bool) {
+	if o == nil || IsNil(o.Vault) {
+		return nil, false
+	}
+	return o.Vault, true
+}
+
+// HasVault returns a boolean if a field has been set.
+func (o *SecretsSecretSpec) HasVault() bool {
+	if o != nil && !IsNil(o.Vault) {
+		return true
+	}
+
+	return false
+}
+
+// SetVault gets a reference to the given SecretVault and assigns it to the Vault field.
+func (o *SecretsSecretSpec) SetVault(v SecretVault) {
+	o.Vault = &v
+}
+
 func (o SecretsSecretSpec) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -125,6 +158,9 @@ func (o SecretsSecretSpec) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Local) {
 		toSerialize["local"] = o.Local
 	}
+	if !IsNil(o.Vault) {
+		toSerialize["vault"] = o.Vault
+	}
 	return toSerialize, nil
 }
 
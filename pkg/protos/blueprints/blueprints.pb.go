@@ -107,8 +107,12 @@ func (x *ListBlueprintsResponse) GetBlueprints() []*Blueprint {
 }
 
 type DescribeBlueprintRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A published version to return instead of the latest one.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Return the draft, if the blueprint has one.
+	Draft         bool `protobuf:"varint,3,opt,name=draft,proto3" json:"draft,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,6 +154,20 @@ func (x *DescribeBlueprintRequest) GetId() string {
 	return ""
 }
 
+func (x *DescribeBlueprintRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DescribeBlueprintRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
 type DescribeBlueprintResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Blueprint     *Blueprint             `protobuf:"bytes,1,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
@@ -458,6 +476,561 @@ func (*DeleteBlueprintResponse) Descriptor() ([]byte, []int) {
 	return file_blueprints_proto_rawDescGZIP(), []int{9}
 }
 
+type PublishBlueprintRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishBlueprintRequest) Reset() {
+	*x = PublishBlueprintRequest{}
+	mi := &file_blueprints_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishBlueprintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBlueprintRequest) ProtoMessage() {}
+
+func (x *PublishBlueprintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBlueprintRequest.ProtoReflect.Descriptor instead.
+func (*PublishBlueprintRequest) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PublishBlueprintRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PublishBlueprintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blueprint     *Blueprint             `protobuf:"bytes,1,opt,name=blueprint,proto3" json:"blueprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishBlueprintResponse) Reset() {
+	*x = PublishBlueprintResponse{}
+	mi := &file_blueprints_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishBlueprintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBlueprintResponse) ProtoMessage() {}
+
+func (x *PublishBlueprintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBlueprintResponse.ProtoReflect.Descriptor instead.
+func (*PublishBlueprintResponse) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PublishBlueprintResponse) GetBlueprint() *Blueprint {
+	if x != nil {
+		return x.Blueprint
+	}
+	return nil
+}
+
+type DiscardBlueprintDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardBlueprintDraftRequest) Reset() {
+	*x = DiscardBlueprintDraftRequest{}
+	mi := &file_blueprints_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardBlueprintDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardBlueprintDraftRequest) ProtoMessage() {}
+
+func (x *DiscardBlueprintDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardBlueprintDraftRequest.ProtoReflect.Descriptor instead.
+func (*DiscardBlueprintDraftRequest) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DiscardBlueprintDraftRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DiscardBlueprintDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardBlueprintDraftResponse) Reset() {
+	*x = DiscardBlueprintDraftResponse{}
+	mi := &file_blueprints_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardBlueprintDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardBlueprintDraftResponse) ProtoMessage() {}
+
+func (x *DiscardBlueprintDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardBlueprintDraftResponse.ProtoReflect.Descriptor instead.
+func (*DiscardBlueprintDraftResponse) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{13}
+}
+
+type ListBlueprintVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlueprintVersionsRequest) Reset() {
+	*x = ListBlueprintVersionsRequest{}
+	mi := &file_blueprints_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlueprintVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlueprintVersionsRequest) ProtoMessage() {}
+
+func (x *ListBlueprintVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlueprintVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlueprintVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListBlueprintVersionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListBlueprintVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*BlueprintVersion    `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlueprintVersionsResponse) Reset() {
+	*x = ListBlueprintVersionsResponse{}
+	mi := &file_blueprints_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlueprintVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlueprintVersionsResponse) ProtoMessage() {}
+
+func (x *ListBlueprintVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlueprintVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlueprintVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListBlueprintVersionsResponse) GetVersions() []*BlueprintVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type DiffBlueprintVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The version to compare from. Zero means the latest published version.
+	FromVersion int32 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// The version to compare to. Zero means the draft.
+	ToVersion     int32 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBlueprintVersionsRequest) Reset() {
+	*x = DiffBlueprintVersionsRequest{}
+	mi := &file_blueprints_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBlueprintVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBlueprintVersionsRequest) ProtoMessage() {}
+
+func (x *DiffBlueprintVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBlueprintVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffBlueprintVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiffBlueprintVersionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiffBlueprintVersionsRequest) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffBlueprintVersionsRequest) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+type DiffBlueprintVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diff          *BlueprintDiff         `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffBlueprintVersionsResponse) Reset() {
+	*x = DiffBlueprintVersionsResponse{}
+	mi := &file_blueprints_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffBlueprintVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffBlueprintVersionsResponse) ProtoMessage() {}
+
+func (x *DiffBlueprintVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffBlueprintVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffBlueprintVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiffBlueprintVersionsResponse) GetDiff() *BlueprintDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type BlueprintVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PublishedAt   *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	PublishedBy   *UserRef               `protobuf:"bytes,3,opt,name=published_by,json=publishedBy,proto3" json:"published_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlueprintVersion) Reset() {
+	*x = BlueprintVersion{}
+	mi := &file_blueprints_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintVersion) ProtoMessage() {}
+
+func (x *BlueprintVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintVersion.ProtoReflect.Descriptor instead.
+func (*BlueprintVersion) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlueprintVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlueprintVersion) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *BlueprintVersion) GetPublishedBy() *UserRef {
+	if x != nil {
+		return x.PublishedBy
+	}
+	return nil
+}
+
+// Layout changes, like node positions, are not reported.
+type BlueprintDiff struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	FromVersion                int32                  `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion                  int32                  `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	AddedNodeIds               []string               `protobuf:"bytes,3,rep,name=added_node_ids,json=addedNodeIds,proto3" json:"added_node_ids,omitempty"`
+	RemovedNodeIds             []string               `protobuf:"bytes,4,rep,name=removed_node_ids,json=removedNodeIds,proto3" json:"removed_node_ids,omitempty"`
+	ChangedNodeIds             []string               `protobuf:"bytes,5,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
+	EdgesChanged               bool                   `protobuf:"varint,6,opt,name=edges_changed,json=edgesChanged,proto3" json:"edges_changed,omitempty"`
+	AddedConfigurationFields   []string               `protobuf:"bytes,7,rep,name=added_configuration_fields,json=addedConfigurationFields,proto3" json:"added_configuration_fields,omitempty"`
+	RemovedConfigurationFields []string               `protobuf:"bytes,8,rep,name=removed_configuration_fields,json=removedConfigurationFields,proto3" json:"removed_configuration_fields,omitempty"`
+	ChangedConfigurationFields []string               `protobuf:"bytes,9,rep,name=changed_configuration_fields,json=changedConfigurationFields,proto3" json:"changed_configuration_fields,omitempty"`
+	AddedOutputChannels        []string               `protobuf:"bytes,10,rep,name=added_output_channels,json=addedOutputChannels,proto3" json:"added_output_channels,omitempty"`
+	RemovedOutputChannels      []string               `protobuf:"bytes,11,rep,name=removed_output_channels,json=removedOutputChannels,proto3" json:"removed_output_channels,omitempty"`
+	ChangedOutputChannels      []string               `protobuf:"bytes,12,rep,name=changed_output_channels,json=changedOutputChannels,proto3" json:"changed_output_channels,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *BlueprintDiff) Reset() {
+	*x = BlueprintDiff{}
+	mi := &file_blueprints_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlueprintDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintDiff) ProtoMessage() {}
+
+func (x *BlueprintDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_blueprints_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintDiff.ProtoReflect.Descriptor instead.
+func (*BlueprintDiff) Descriptor() ([]byte, []int) {
+	return file_blueprints_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlueprintDiff) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *BlueprintDiff) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *BlueprintDiff) GetAddedNodeIds() []string {
+	if x != nil {
+		return x.AddedNodeIds
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetRemovedNodeIds() []string {
+	if x != nil {
+		return x.RemovedNodeIds
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetChangedNodeIds() []string {
+	if x != nil {
+		return x.ChangedNodeIds
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetEdgesChanged() bool {
+	if x != nil {
+		return x.EdgesChanged
+	}
+	return false
+}
+
+func (x *BlueprintDiff) GetAddedConfigurationFields() []string {
+	if x != nil {
+		return x.AddedConfigurationFields
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetRemovedConfigurationFields() []string {
+	if x != nil {
+		return x.RemovedConfigurationFields
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetChangedConfigurationFields() []string {
+	if x != nil {
+		return x.ChangedConfigurationFields
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetAddedOutputChannels() []string {
+	if x != nil {
+		return x.AddedOutputChannels
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetRemovedOutputChannels() []string {
+	if x != nil {
+		return x.RemovedOutputChannels
+	}
+	return nil
+}
+
+func (x *BlueprintDiff) GetChangedOutputChannels() []string {
+	if x != nil {
+		return x.ChangedOutputChannels
+	}
+	return nil
+}
+
 type Blueprint struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -473,13 +1046,17 @@ type Blueprint struct {
 	Icon           string                 `protobuf:"bytes,11,opt,name=icon,proto3" json:"icon,omitempty"`
 	Color          string                 `protobuf:"bytes,12,opt,name=color,proto3" json:"color,omitempty"`
 	CreatedBy      *UserRef               `protobuf:"bytes,13,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The version of the content. Zero when the content is the draft.
+	Version int32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// Whether the blueprint has unpublished changes.
+	HasDraft      bool `protobuf:"varint,15,opt,name=has_draft,json=hasDraft,proto3" json:"has_draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Blueprint) Reset() {
 	*x = Blueprint{}
-	mi := &file_blueprints_proto_msgTypes[10]
+	mi := &file_blueprints_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +1068,7 @@ func (x *Blueprint) String() string {
 func (*Blueprint) ProtoMessage() {}
 
 func (x *Blueprint) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprints_proto_msgTypes[10]
+	mi := &file_blueprints_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +1081,7 @@ func (x *Blueprint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Blueprint.ProtoReflect.Descriptor instead.
 func (*Blueprint) Descriptor() ([]byte, []int) {
-	return file_blueprints_proto_rawDescGZIP(), []int{10}
+	return file_blueprints_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Blueprint) GetId() string {
@@ -598,6 +1175,20 @@ func (x *Blueprint) GetCreatedBy() *UserRef {
 	return nil
 }
 
+func (x *Blueprint) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Blueprint) GetHasDraft() bool {
+	if x != nil {
+		return x.HasDraft
+	}
+	return false
+}
+
 type OutputChannel struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -609,7 +1200,7 @@ type OutputChannel struct {
 
 func (x *OutputChannel) Reset() {
 	*x = OutputChannel{}
-	mi := &file_blueprints_proto_msgTypes[11]
+	mi := &file_blueprints_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +1212,7 @@ func (x *OutputChannel) String() string {
 func (*OutputChannel) ProtoMessage() {}
 
 func (x *OutputChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprints_proto_msgTypes[11]
+	mi := &file_blueprints_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +1225,7 @@ func (x *OutputChannel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutputChannel.ProtoReflect.Descriptor instead.
 func (*OutputChannel) Descriptor() ([]byte, []int) {
-	return file_blueprints_proto_rawDescGZIP(), []int{11}
+	return file_blueprints_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OutputChannel) GetName() string {
@@ -668,7 +1259,7 @@ type UserRef struct {
 
 func (x *UserRef) Reset() {
 	*x = UserRef{}
-	mi := &file_blueprints_proto_msgTypes[12]
+	mi := &file_blueprints_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +1271,7 @@ func (x *UserRef) String() string {
 func (*UserRef) ProtoMessage() {}
 
 func (x *UserRef) ProtoReflect() protoreflect.Message {
-	mi := &file_blueprints_proto_msgTypes[12]
+	mi := &file_blueprints_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +1284,7 @@ func (x *UserRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserRef.ProtoReflect.Descriptor instead.
 func (*UserRef) Descriptor() ([]byte, []int) {
-	return file_blueprints_proto_rawDescGZIP(), []int{12}
+	return file_blueprints_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UserRef) GetId() string {
@@ -719,9 +1310,11 @@ const file_blueprints_proto_rawDesc = "" +
 	"\x16ListBlueprintsResponse\x12@\n" +
 	"\n" +
 	"blueprints\x18\x01 \x03(\v2 .Superplane.Blueprints.BlueprintR\n" +
-	"blueprints\"*\n" +
+	"blueprints\"Z\n" +
 	"\x18DescribeBlueprintRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x14\n" +
+	"\x05draft\x18\x03 \x01(\bR\x05draft\"[\n" +
 	"\x19DescribeBlueprintResponse\x12>\n" +
 	"\tblueprint\x18\x01 \x01(\v2 .Superplane.Blueprints.BlueprintR\tblueprint\"X\n" +
 	"\x16CreateBlueprintRequest\x12>\n" +
@@ -735,7 +1328,44 @@ const file_blueprints_proto_rawDesc = "" +
 	"\tblueprint\x18\x01 \x01(\v2 .Superplane.Blueprints.BlueprintR\tblueprint\"(\n" +
 	"\x16DeleteBlueprintRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
-	"\x17DeleteBlueprintResponse\"\xd5\x04\n" +
+	"\x17DeleteBlueprintResponse\")\n" +
+	"\x17PublishBlueprintRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x18PublishBlueprintResponse\x12>\n" +
+	"\tblueprint\x18\x01 \x01(\v2 .Superplane.Blueprints.BlueprintR\tblueprint\".\n" +
+	"\x1cDiscardBlueprintDraftRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1f\n" +
+	"\x1dDiscardBlueprintDraftResponse\".\n" +
+	"\x1cListBlueprintVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"d\n" +
+	"\x1dListBlueprintVersionsResponse\x12C\n" +
+	"\bversions\x18\x01 \x03(\v2'.Superplane.Blueprints.BlueprintVersionR\bversions\"p\n" +
+	"\x1cDiffBlueprintVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\"Y\n" +
+	"\x1dDiffBlueprintVersionsResponse\x128\n" +
+	"\x04diff\x18\x01 \x01(\v2$.Superplane.Blueprints.BlueprintDiffR\x04diff\"\xae\x01\n" +
+	"\x10BlueprintVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12=\n" +
+	"\fpublished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12A\n" +
+	"\fpublished_by\x18\x03 \x01(\v2\x1e.Superplane.Blueprints.UserRefR\vpublishedBy\"\xd6\x04\n" +
+	"\rBlueprintDiff\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\x05R\ttoVersion\x12$\n" +
+	"\x0eadded_node_ids\x18\x03 \x03(\tR\faddedNodeIds\x12(\n" +
+	"\x10removed_node_ids\x18\x04 \x03(\tR\x0eremovedNodeIds\x12(\n" +
+	"\x10changed_node_ids\x18\x05 \x03(\tR\x0echangedNodeIds\x12#\n" +
+	"\redges_changed\x18\x06 \x01(\bR\fedgesChanged\x12<\n" +
+	"\x1aadded_configuration_fields\x18\a \x03(\tR\x18addedConfigurationFields\x12@\n" +
+	"\x1cremoved_configuration_fields\x18\b \x03(\tR\x1aremovedConfigurationFields\x12@\n" +
+	"\x1cchanged_configuration_fields\x18\t \x03(\tR\x1achangedConfigurationFields\x122\n" +
+	"\x15added_output_channels\x18\n" +
+	" \x03(\tR\x13addedOutputChannels\x126\n" +
+	"\x17removed_output_channels\x18\v \x03(\tR\x15removedOutputChannels\x126\n" +
+	"\x17changed_output_channels\x18\f \x03(\tR\x15changedOutputChannels\"\x8c\x05\n" +
 	"\tBlueprint\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -753,14 +1383,16 @@ const file_blueprints_proto_rawDesc = "" +
 	"\x04icon\x18\v \x01(\tR\x04icon\x12\x14\n" +
 	"\x05color\x18\f \x01(\tR\x05color\x12=\n" +
 	"\n" +
-	"created_by\x18\r \x01(\v2\x1e.Superplane.Blueprints.UserRefR\tcreatedBy\"l\n" +
+	"created_by\x18\r \x01(\v2\x1e.Superplane.Blueprints.UserRefR\tcreatedBy\x12\x18\n" +
+	"\aversion\x18\x0e \x01(\x05R\aversion\x12\x1b\n" +
+	"\thas_draft\x18\x0f \x01(\bR\bhasDraft\"l\n" +
 	"\rOutputChannel\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
 	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12.\n" +
 	"\x13node_output_channel\x18\x03 \x01(\tR\x11nodeOutputChannel\"-\n" +
 	"\aUserRef\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name2\x9e\b\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name2\xc5\x10\n" +
 	"\n" +
 	"Blueprints\x12\xca\x01\n" +
 	"\x0eListBlueprints\x12,.Superplane.Blueprints.ListBlueprintsRequest\x1a-.Superplane.Blueprints.ListBlueprintsResponse\"[\x92A>\n" +
@@ -772,7 +1404,15 @@ const file_blueprints_proto_rawDesc = "" +
 	"\x0fUpdateBlueprint\x12-.Superplane.Blueprints.UpdateBlueprintRequest\x1a..Superplane.Blueprints.UpdateBlueprintResponse\"a\x92A<\n" +
 	"\tBlueprint\x12\x10Update blueprint\x1a\x1dUpdates an existing blueprint\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/api/v1/blueprints/{id}\x12\xd0\x01\n" +
 	"\x0fDeleteBlueprint\x12-.Superplane.Blueprints.DeleteBlueprintRequest\x1a..Superplane.Blueprints.DeleteBlueprintResponse\"^\x92A<\n" +
-	"\tBlueprint\x12\x10Delete blueprint\x1a\x1dDeletes an existing blueprint\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/blueprints/{id}B\xce\x01\x92A\x90\x01\x12f\n" +
+	"\tBlueprint\x12\x10Delete blueprint\x1a\x1dDeletes an existing blueprint\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/blueprints/{id}\x12\xff\x01\n" +
+	"\x10PublishBlueprint\x12..Superplane.Blueprints.PublishBlueprintRequest\x1a/.Superplane.Blueprints.PublishBlueprintResponse\"\x89\x01\x92A\\\n" +
+	"\tBlueprint\x12\x17Publish blueprint draft\x1a6Publishes the draft of a blueprint as its next version\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/blueprints/{id}/publish\x12\x81\x02\n" +
+	"\x15DiscardBlueprintDraft\x123.Superplane.Blueprints.DiscardBlueprintDraftRequest\x1a4.Superplane.Blueprints.DiscardBlueprintDraftResponse\"}\x92AU\n" +
+	"\tBlueprint\x12\x17Discard blueprint draft\x1a/Discards the unpublished changes of a blueprint\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/blueprints/{id}/draft\x12\x91\x02\n" +
+	"\x15ListBlueprintVersions\x123.Superplane.Blueprints.ListBlueprintVersionsRequest\x1a4.Superplane.Blueprints.ListBlueprintVersionsResponse\"\x8c\x01\x92Aa\n" +
+	"\tBlueprint\x12\x17List blueprint versions\x1a;Returns the published versions of a blueprint, newest first\x82\xd3\xe4\x93\x02\"\x12 /api/v1/blueprints/{id}/versions\x12\x8a\x02\n" +
+	"\x15DiffBlueprintVersions\x123.Superplane.Blueprints.DiffBlueprintVersionsRequest\x1a4.Superplane.Blueprints.DiffBlueprintVersionsResponse\"\x85\x01\x92A^\n" +
+	"\tBlueprint\x12\x17Diff blueprint versions\x1a8Returns what changed between two versions of a blueprint\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/blueprints/{id}/diffB\xce\x01\x92A\x90\x01\x12f\n" +
 	"\x19Superplane Blueprints API\x12\x1dAPI for Superplane blueprints\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ8github.com/superplanehq/superplane/pkg/protos/blueprintsb\x06proto3"
 
@@ -788,55 +1428,78 @@ func file_blueprints_proto_rawDescGZIP() []byte {
 	return file_blueprints_proto_rawDescData
 }
 
-var file_blueprints_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_blueprints_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_blueprints_proto_goTypes = []any{
-	(*ListBlueprintsRequest)(nil),     // 0: Superplane.Blueprints.ListBlueprintsRequest
-	(*ListBlueprintsResponse)(nil),    // 1: Superplane.Blueprints.ListBlueprintsResponse
-	(*DescribeBlueprintRequest)(nil),  // 2: Superplane.Blueprints.DescribeBlueprintRequest
-	(*DescribeBlueprintResponse)(nil), // 3: Superplane.Blueprints.DescribeBlueprintResponse
-	(*CreateBlueprintRequest)(nil),    // 4: Superplane.Blueprints.CreateBlueprintRequest
-	(*CreateBlueprintResponse)(nil),   // 5: Superplane.Blueprints.CreateBlueprintResponse
-	(*UpdateBlueprintRequest)(nil),    // 6: Superplane.Blueprints.UpdateBlueprintRequest
-	(*UpdateBlueprintResponse)(nil),   // 7: Superplane.Blueprints.UpdateBlueprintResponse
-	(*DeleteBlueprintRequest)(nil),    // 8: Superplane.Blueprints.DeleteBlueprintRequest
-	(*DeleteBlueprintResponse)(nil),   // 9: Superplane.Blueprints.DeleteBlueprintResponse
-	(*Blueprint)(nil),                 // 10: Superplane.Blueprints.Blueprint
-	(*OutputChannel)(nil),             // 11: Superplane.Blueprints.OutputChannel
-	(*UserRef)(nil),                   // 12: Superplane.Blueprints.UserRef
-	(*timestamp.Timestamp)(nil),       // 13: google.protobuf.Timestamp
-	(*components.Node)(nil),           // 14: Superplane.Components.Node
-	(*components.Edge)(nil),           // 15: Superplane.Components.Edge
-	(*configuration.Field)(nil),       // 16: Superplane.Configuration.Field
+	(*ListBlueprintsRequest)(nil),         // 0: Superplane.Blueprints.ListBlueprintsRequest
+	(*ListBlueprintsResponse)(nil),        // 1: Superplane.Blueprints.ListBlueprintsResponse
+	(*DescribeBlueprintRequest)(nil),      // 2: Superplane.Blueprints.DescribeBlueprintRequest
+	(*DescribeBlueprintResponse)(nil),     // 3: Superplane.Blueprints.DescribeBlueprintResponse
+	(*CreateBlueprintRequest)(nil),        // 4: Superplane.Blueprints.CreateBlueprintRequest
+	(*CreateBlueprintResponse)(nil),       // 5: Superplane.Blueprints.CreateBlueprintResponse
+	(*UpdateBlueprintRequest)(nil),        // 6: Superplane.Blueprints.UpdateBlueprintRequest
+	(*UpdateBlueprintResponse)(nil),       // 7: Superplane.Blueprints.UpdateBlueprintResponse
+	(*DeleteBlueprintRequest)(nil),        // 8: Superplane.Blueprints.DeleteBlueprintRequest
+	(*DeleteBlueprintResponse)(nil),       // 9: Superplane.Blueprints.DeleteBlueprintResponse
+	(*PublishBlueprintRequest)(nil),       // 10: Superplane.Blueprints.PublishBlueprintRequest
+	(*PublishBlueprintResponse)(nil),      // 11: Superplane.Blueprints.PublishBlueprintResponse
+	(*DiscardBlueprintDraftRequest)(nil),  // 12: Superplane.Blueprints.DiscardBlueprintDraftRequest
+	(*DiscardBlueprintDraftResponse)(nil), // 13: Superplane.Blueprints.DiscardBlueprintDraftResponse
+	(*ListBlueprintVersionsRequest)(nil),  // 14: Superplane.Blueprints.ListBlueprintVersionsRequest
+	(*ListBlueprintVersionsResponse)(nil), // 15: Superplane.Blueprints.ListBlueprintVersionsResponse
+	(*DiffBlueprintVersionsRequest)(nil),  // 16: Superplane.Blueprints.DiffBlueprintVersionsRequest
+	(*DiffBlueprintVersionsResponse)(nil), // 17: Superplane.Blueprints.DiffBlueprintVersionsResponse
+	(*BlueprintVersion)(nil),              // 18: Superplane.Blueprints.BlueprintVersion
+	(*BlueprintDiff)(nil),                 // 19: Superplane.Blueprints.BlueprintDiff
+	(*Blueprint)(nil),                     // 20: Superplane.Blueprints.Blueprint
+	(*OutputChannel)(nil),                 // 21: Superplane.Blueprints.OutputChannel
+	(*UserRef)(nil),                       // 22: Superplane.Blueprints.UserRef
+	(*timestamp.Timestamp)(nil),           // 23: google.protobuf.Timestamp
+	(*components.Node)(nil),               // 24: Superplane.Components.Node
+	(*components.Edge)(nil),               // 25: Superplane.Components.Edge
+	(*configuration.Field)(nil),           // 26: Superplane.Configuration.Field
 }
 var file_blueprints_proto_depIdxs = []int32{
-	10, // 0: Superplane.Blueprints.ListBlueprintsResponse.blueprints:type_name -> Superplane.Blueprints.Blueprint
-	10, // 1: Superplane.Blueprints.DescribeBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
-	10, // 2: Superplane.Blueprints.CreateBlueprintRequest.blueprint:type_name -> Superplane.Blueprints.Blueprint
-	10, // 3: Superplane.Blueprints.CreateBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
-	10, // 4: Superplane.Blueprints.UpdateBlueprintRequest.blueprint:type_name -> Superplane.Blueprints.Blueprint
-	10, // 5: Superplane.Blueprints.UpdateBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
-	13, // 6: Superplane.Blueprints.Blueprint.created_at:type_name -> google.protobuf.Timestamp
-	13, // 7: Superplane.Blueprints.Blueprint.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 8: Superplane.Blueprints.Blueprint.nodes:type_name -> Superplane.Components.Node
-	15, // 9: Superplane.Blueprints.Blueprint.edges:type_name -> Superplane.Components.Edge
-	16, // 10: Superplane.Blueprints.Blueprint.configuration:type_name -> Superplane.Configuration.Field
-	11, // 11: Superplane.Blueprints.Blueprint.output_channels:type_name -> Superplane.Blueprints.OutputChannel
-	12, // 12: Superplane.Blueprints.Blueprint.created_by:type_name -> Superplane.Blueprints.UserRef
-	0,  // 13: Superplane.Blueprints.Blueprints.ListBlueprints:input_type -> Superplane.Blueprints.ListBlueprintsRequest
-	2,  // 14: Superplane.Blueprints.Blueprints.DescribeBlueprint:input_type -> Superplane.Blueprints.DescribeBlueprintRequest
-	4,  // 15: Superplane.Blueprints.Blueprints.CreateBlueprint:input_type -> Superplane.Blueprints.CreateBlueprintRequest
-	6,  // 16: Superplane.Blueprints.Blueprints.UpdateBlueprint:input_type -> Superplane.Blueprints.UpdateBlueprintRequest
-	8,  // 17: Superplane.Blueprints.Blueprints.DeleteBlueprint:input_type -> Superplane.Blueprints.DeleteBlueprintRequest
-	1,  // 18: Superplane.Blueprints.Blueprints.ListBlueprints:output_type -> Superplane.Blueprints.ListBlueprintsResponse
-	3,  // 19: Superplane.Blueprints.Blueprints.DescribeBlueprint:output_type -> Superplane.Blueprints.DescribeBlueprintResponse
-	5,  // 20: Superplane.Blueprints.Blueprints.CreateBlueprint:output_type -> Superplane.Blueprints.CreateBlueprintResponse
-	7,  // 21: Superplane.Blueprints.Blueprints.UpdateBlueprint:output_type -> Superplane.Blueprints.UpdateBlueprintResponse
-	9,  // 22: Superplane.Blueprints.Blueprints.DeleteBlueprint:output_type -> Superplane.Blueprints.DeleteBlueprintResponse
-	18, // [18:23] is the sub-list for method output_type
-	13, // [13:18] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	20, // 0: Superplane.Blueprints.ListBlueprintsResponse.blueprints:type_name -> Superplane.Blueprints.Blueprint
+	20, // 1: Superplane.Blueprints.DescribeBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	20, // 2: Superplane.Blueprints.CreateBlueprintRequest.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	20, // 3: Superplane.Blueprints.CreateBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	20, // 4: Superplane.Blueprints.UpdateBlueprintRequest.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	20, // 5: Superplane.Blueprints.UpdateBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	20, // 6: Superplane.Blueprints.PublishBlueprintResponse.blueprint:type_name -> Superplane.Blueprints.Blueprint
+	18, // 7: Superplane.Blueprints.ListBlueprintVersionsResponse.versions:type_name -> Superplane.Blueprints.BlueprintVersion
+	19, // 8: Superplane.Blueprints.DiffBlueprintVersionsResponse.diff:type_name -> Superplane.Blueprints.BlueprintDiff
+	23, // 9: Superplane.Blueprints.BlueprintVersion.published_at:type_name -> google.protobuf.Timestamp
+	22, // 10: Superplane.Blueprints.BlueprintVersion.published_by:type_name -> Superplane.Blueprints.UserRef
+	23, // 11: Superplane.Blueprints.Blueprint.created_at:type_name -> google.protobuf.Timestamp
+	23, // 12: Superplane.Blueprints.Blueprint.updated_at:type_name -> google.protobuf.Timestamp
+	24, // 13: Superplane.Blueprints.Blueprint.nodes:type_name -> Superplane.Components.Node
+	25, // 14: Superplane.Blueprints.Blueprint.edges:type_name -> Superplane.Components.Edge
+	26, // 15: Superplane.Blueprints.Blueprint.configuration:type_name -> Superplane.Configuration.Field
+	21, // 16: Superplane.Blueprints.Blueprint.output_channels:type_name -> Superplane.Blueprints.OutputChannel
+	22, // 17: Superplane.Blueprints.Blueprint.created_by:type_name -> Superplane.Blueprints.UserRef
+	0,  // 18: Superplane.Blueprints.Blueprints.ListBlueprints:input_type -> Superplane.Blueprints.ListBlueprintsRequest
+	2,  // 19: Superplane.Blueprints.Blueprints.DescribeBlueprint:input_type -> Superplane.Blueprints.DescribeBlueprintRequest
+	4,  // 20: Superplane.Blueprints.Blueprints.CreateBlueprint:input_type -> Superplane.Blueprints.CreateBlueprintRequest
+	6,  // 21: Superplane.Blueprints.Blueprints.UpdateBlueprint:input_type -> Superplane.Blueprints.UpdateBlueprintRequest
+	8,  // 22: Superplane.Blueprints.Blueprints.DeleteBlueprint:input_type -> Superplane.Blueprints.DeleteBlueprintRequest
+	10, // 23: Superplane.Blueprints.Blueprints.PublishBlueprint:input_type -> Superplane.Blueprints.PublishBlueprintRequest
+	12, // 24: Superplane.Blueprints.Blueprints.DiscardBlueprintDraft:input_type -> Superplane.Blueprints.DiscardBlueprintDraftRequest
+	14, // 25: Superplane.Blueprints.Blueprints.ListBlueprintVersions:input_type -> Superplane.Blueprints.ListBlueprintVersionsRequest
+	16, // 26: Superplane.Blueprints.Blueprints.DiffBlueprintVersions:input_type -> Superplane.Blueprints.DiffBlueprintVersionsRequest
+	1,  // 27: Superplane.Blueprints.Blueprints.ListBlueprints:output_type -> Superplane.Blueprints.ListBlueprintsResponse
+	3,  // 28: Superplane.Blueprints.Blueprints.DescribeBlueprint:output_type -> Superplane.Blueprints.DescribeBlueprintResponse
+	5,  // 29: Superplane.Blueprints.Blueprints.CreateBlueprint:output_type -> Superplane.Blueprints.CreateBlueprintResponse
+	7,  // 30: Superplane.Blueprints.Blueprints.UpdateBlueprint:output_type -> Superplane.Blueprints.UpdateBlueprintResponse
+	9,  // 31: Superplane.Blueprints.Blueprints.DeleteBlueprint:output_type -> Superplane.Blueprints.DeleteBlueprintResponse
+	11, // 32: Superplane.Blueprints.Blueprints.PublishBlueprint:output_type -> Superplane.Blueprints.PublishBlueprintResponse
+	13, // 33: Superplane.Blueprints.Blueprints.DiscardBlueprintDraft:output_type -> Superplane.Blueprints.DiscardBlueprintDraftResponse
+	15, // 34: Superplane.Blueprints.Blueprints.ListBlueprintVersions:output_type -> Superplane.Blueprints.ListBlueprintVersionsResponse
+	17, // 35: Superplane.Blueprints.Blueprints.DiffBlueprintVersions:output_type -> Superplane.Blueprints.DiffBlueprintVersionsResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_blueprints_proto_init() }
@@ -850,7 +1513,7 @@ func file_blueprints_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_blueprints_proto_rawDesc), len(file_blueprints_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
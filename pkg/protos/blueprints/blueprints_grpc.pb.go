@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Blueprints_ListBlueprints_FullMethodName    = "/Superplane.Blueprints.Blueprints/ListBlueprints"
-	Blueprints_DescribeBlueprint_FullMethodName = "/Superplane.Blueprints.Blueprints/DescribeBlueprint"
-	Blueprints_CreateBlueprint_FullMethodName   = "/Superplane.Blueprints.Blueprints/CreateBlueprint"
-	Blueprints_UpdateBlueprint_FullMethodName   = "/Superplane.Blueprints.Blueprints/UpdateBlueprint"
-	Blueprints_DeleteBlueprint_FullMethodName   = "/Superplane.Blueprints.Blueprints/DeleteBlueprint"
+	Blueprints_ListBlueprints_FullMethodName        = "/Superplane.Blueprints.Blueprints/ListBlueprints"
+	Blueprints_DescribeBlueprint_FullMethodName     = "/Superplane.Blueprints.Blueprints/DescribeBlueprint"
+	Blueprints_CreateBlueprint_FullMethodName       = "/Superplane.Blueprints.Blueprints/CreateBlueprint"
+	Blueprints_UpdateBlueprint_FullMethodName       = "/Superplane.Blueprints.Blueprints/UpdateBlueprint"
+	Blueprints_DeleteBlueprint_FullMethodName       = "/Superplane.Blueprints.Blueprints/DeleteBlueprint"
+	Blueprints_PublishBlueprint_FullMethodName      = "/Superplane.Blueprints.Blueprints/PublishBlueprint"
+	Blueprints_DiscardBlueprintDraft_FullMethodName = "/Superplane.Blueprints.Blueprints/DiscardBlueprintDraft"
+	Blueprints_ListBlueprintVersions_FullMethodName = "/Superplane.Blueprints.Blueprints/ListBlueprintVersions"
+	Blueprints_DiffBlueprintVersions_FullMethodName = "/Superplane.Blueprints.Blueprints/DiffBlueprintVersions"
 )
 
 // BlueprintsClient is the client API for Blueprints service.
@@ -35,6 +39,10 @@ type BlueprintsClient interface {
 	CreateBlueprint(ctx context.Context, in *CreateBlueprintRequest, opts ...grpc.CallOption) (*CreateBlueprintResponse, error)
 	UpdateBlueprint(ctx context.Context, in *UpdateBlueprintRequest, opts ...grpc.CallOption) (*UpdateBlueprintResponse, error)
 	DeleteBlueprint(ctx context.Context, in *DeleteBlueprintRequest, opts ...grpc.CallOption) (*DeleteBlueprintResponse, error)
+	PublishBlueprint(ctx context.Context, in *PublishBlueprintRequest, opts ...grpc.CallOption) (*PublishBlueprintResponse, error)
+	DiscardBlueprintDraft(ctx context.Context, in *DiscardBlueprintDraftRequest, opts ...grpc.CallOption) (*DiscardBlueprintDraftResponse, error)
+	ListBlueprintVersions(ctx context.Context, in *ListBlueprintVersionsRequest, opts ...grpc.CallOption) (*ListBlueprintVersionsResponse, error)
+	DiffBlueprintVersions(ctx context.Context, in *DiffBlueprintVersionsRequest, opts ...grpc.CallOption) (*DiffBlueprintVersionsResponse, error)
 }
 
 type blueprintsClient struct {
@@ -95,6 +103,46 @@ func (c *blueprintsClient) DeleteBlueprint(ctx context.Context, in *DeleteBluepr
 	return out, nil
 }
 
+func (c *blueprintsClient) PublishBlueprint(ctx context.Context, in *PublishBlueprintRequest, opts ...grpc.CallOption) (*PublishBlueprintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishBlueprintResponse)
+	err := c.cc.Invoke(ctx, Blueprints_PublishBlueprint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blueprintsClient) DiscardBlueprintDraft(ctx context.Context, in *DiscardBlueprintDraftRequest, opts ...grpc.CallOption) (*DiscardBlueprintDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardBlueprintDraftResponse)
+	err := c.cc.Invoke(ctx, Blueprints_DiscardBlueprintDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blueprintsClient) ListBlueprintVersions(ctx context.Context, in *ListBlueprintVersionsRequest, opts ...grpc.CallOption) (*ListBlueprintVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlueprintVersionsResponse)
+	err := c.cc.Invoke(ctx, Blueprints_ListBlueprintVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blueprintsClient) DiffBlueprintVersions(ctx context.Context, in *DiffBlueprintVersionsRequest, opts ...grpc.CallOption) (*DiffBlueprintVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffBlueprintVersionsResponse)
+	err := c.cc.Invoke(ctx, Blueprints_DiffBlueprintVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlueprintsServer is the server API for Blueprints service.
 // All implementations should embed UnimplementedBlueprintsServer
 // for forward compatibility.
@@ -104,6 +152,10 @@ type BlueprintsServer interface {
 	CreateBlueprint(context.Context, *CreateBlueprintRequest) (*CreateBlueprintResponse, error)
 	UpdateBlueprint(context.Context, *UpdateBlueprintRequest) (*UpdateBlueprintResponse, error)
 	DeleteBlueprint(context.Context, *DeleteBlueprintRequest) (*DeleteBlueprintResponse, error)
+	PublishBlueprint(context.Context, *PublishBlueprintRequest) (*PublishBlueprintResponse, error)
+	DiscardBlueprintDraft(context.Context, *DiscardBlueprintDraftRequest) (*DiscardBlueprintDraftResponse, error)
+	ListBlueprintVersions(context.Context, *ListBlueprintVersionsRequest) (*ListBlueprintVersionsResponse, error)
+	DiffBlueprintVersions(context.Context, *DiffBlueprintVersionsRequest) (*DiffBlueprintVersionsResponse, error)
 }
 
 // UnimplementedBlueprintsServer should be embedded to have
@@ -128,6 +180,18 @@ func (UnimplementedBlueprintsServer) UpdateBlueprint(context.Context, *UpdateBlu
 func (UnimplementedBlueprintsServer) DeleteBlueprint(context.Context, *DeleteBlueprintRequest) (*DeleteBlueprintResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteBlueprint not implemented")
 }
+func (UnimplementedBlueprintsServer) PublishBlueprint(context.Context, *PublishBlueprintRequest) (*PublishBlueprintResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishBlueprint not implemented")
+}
+func (UnimplementedBlueprintsServer) DiscardBlueprintDraft(context.Context, *DiscardBlueprintDraftRequest) (*DiscardBlueprintDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardBlueprintDraft not implemented")
+}
+func (UnimplementedBlueprintsServer) ListBlueprintVersions(context.Context, *ListBlueprintVersionsRequest) (*ListBlueprintVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlueprintVersions not implemented")
+}
+func (UnimplementedBlueprintsServer) DiffBlueprintVersions(context.Context, *DiffBlueprintVersionsRequest) (*DiffBlueprintVersionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffBlueprintVersions not implemented")
+}
 func (UnimplementedBlueprintsServer) testEmbeddedByValue() {}
 
 // UnsafeBlueprintsServer may be embedded to opt out of forward compatibility for this service.
@@ -238,6 +302,78 @@ func _Blueprints_DeleteBlueprint_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blueprints_PublishBlueprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBlueprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlueprintsServer).PublishBlueprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blueprints_PublishBlueprint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlueprintsServer).PublishBlueprint(ctx, req.(*PublishBlueprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blueprints_DiscardBlueprintDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardBlueprintDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlueprintsServer).DiscardBlueprintDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blueprints_DiscardBlueprintDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlueprintsServer).DiscardBlueprintDraft(ctx, req.(*DiscardBlueprintDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blueprints_ListBlueprintVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlueprintVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlueprintsServer).ListBlueprintVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blueprints_ListBlueprintVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlueprintsServer).ListBlueprintVersions(ctx, req.(*ListBlueprintVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blueprints_DiffBlueprintVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffBlueprintVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlueprintsServer).DiffBlueprintVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Blueprints_DiffBlueprintVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlueprintsServer).DiffBlueprintVersions(ctx, req.(*DiffBlueprintVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Blueprints_ServiceDesc is the grpc.ServiceDesc for Blueprints service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -265,6 +401,22 @@ var Blueprints_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBlueprint",
 			Handler:    _Blueprints_DeleteBlueprint_Handler,
 		},
+		{
+			MethodName: "PublishBlueprint",
+			Handler:    _Blueprints_PublishBlueprint_Handler,
+		},
+		{
+			MethodName: "DiscardBlueprintDraft",
+			Handler:    _Blueprints_DiscardBlueprintDraft_Handler,
+		},
+		{
+			MethodName: "ListBlueprintVersions",
+			Handler:    _Blueprints_ListBlueprintVersions_Handler,
+		},
+		{
+			MethodName: "DiffBlueprintVersions",
+			Handler:    _Blueprints_DiffBlueprintVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blueprints.proto",
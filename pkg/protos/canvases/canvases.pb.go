@@ -10,6 +10,7 @@ import (
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	blueprints "github.com/superplanehq/superplane/pkg/protos/blueprints"
 	components "github.com/superplanehq/superplane/pkg/protos/components"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -26,6 +27,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CanvasRole int32
+
+const (
+	CanvasRole_CANVAS_ROLE_UNSPECIFIED CanvasRole = 0
+	CanvasRole_CANVAS_ROLE_VIEWER      CanvasRole = 1
+	CanvasRole_CANVAS_ROLE_OPERATOR    CanvasRole = 2
+	CanvasRole_CANVAS_ROLE_EDITOR      CanvasRole = 3
+)
+
+// Enum value maps for CanvasRole.
+var (
+	CanvasRole_name = map[int32]string{
+		0: "CANVAS_ROLE_UNSPECIFIED",
+		1: "CANVAS_ROLE_VIEWER",
+		2: "CANVAS_ROLE_OPERATOR",
+		3: "CANVAS_ROLE_EDITOR",
+	}
+	CanvasRole_value = map[string]int32{
+		"CANVAS_ROLE_UNSPECIFIED": 0,
+		"CANVAS_ROLE_VIEWER":      1,
+		"CANVAS_ROLE_OPERATOR":    2,
+		"CANVAS_ROLE_EDITOR":      3,
+	}
+)
+
+func (x CanvasRole) Enum() *CanvasRole {
+	p := new(CanvasRole)
+	*p = x
+	return p
+}
+
+func (x CanvasRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[0].Descriptor()
+}
+
+func (CanvasRole) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[0]
+}
+
+func (x CanvasRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasRole.Descriptor instead.
+func (CanvasRole) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{0}
+}
+
 type CanvasAutoLayout_Algorithm int32
 
 const (
@@ -56,11 +109,11 @@ func (x CanvasAutoLayout_Algorithm) String() string {
 }
 
 func (CanvasAutoLayout_Algorithm) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[0].Descriptor()
+	return file_canvases_proto_enumTypes[1].Descriptor()
 }
 
 func (CanvasAutoLayout_Algorithm) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[0]
+	return &file_canvases_proto_enumTypes[1]
 }
 
 func (x CanvasAutoLayout_Algorithm) Number() protoreflect.EnumNumber {
@@ -105,11 +158,11 @@ func (x CanvasAutoLayout_Scope) String() string {
 }
 
 func (CanvasAutoLayout_Scope) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[1].Descriptor()
+	return file_canvases_proto_enumTypes[2].Descriptor()
 }
 
 func (CanvasAutoLayout_Scope) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[1]
+	return &file_canvases_proto_enumTypes[2]
 }
 
 func (x CanvasAutoLayout_Scope) Number() protoreflect.EnumNumber {
@@ -163,11 +216,11 @@ func (x ActOnCanvasChangeRequestRequest_Action) String() string {
 }
 
 func (ActOnCanvasChangeRequestRequest_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[2].Descriptor()
+	return file_canvases_proto_enumTypes[3].Descriptor()
 }
 
 func (ActOnCanvasChangeRequestRequest_Action) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[2]
+	return &file_canvases_proto_enumTypes[3]
 }
 
 func (x ActOnCanvasChangeRequestRequest_Action) Number() protoreflect.EnumNumber {
@@ -215,11 +268,11 @@ func (x CanvasChangeRequestApprover_Type) String() string {
 }
 
 func (CanvasChangeRequestApprover_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[3].Descriptor()
+	return file_canvases_proto_enumTypes[4].Descriptor()
 }
 
 func (CanvasChangeRequestApprover_Type) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[3]
+	return &file_canvases_proto_enumTypes[4]
 }
 
 func (x CanvasChangeRequestApprover_Type) Number() protoreflect.EnumNumber {
@@ -228,7 +281,7 @@ func (x CanvasChangeRequestApprover_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequestApprover_Type.Descriptor instead.
 func (CanvasChangeRequestApprover_Type) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{35, 0}
 }
 
 type CanvasChangeRequestApproval_State int32
@@ -267,11 +320,11 @@ func (x CanvasChangeRequestApproval_State) String() string {
 }
 
 func (CanvasChangeRequestApproval_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[4].Descriptor()
+	return file_canvases_proto_enumTypes[5].Descriptor()
 }
 
 func (CanvasChangeRequestApproval_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[4]
+	return &file_canvases_proto_enumTypes[5]
 }
 
 func (x CanvasChangeRequestApproval_State) Number() protoreflect.EnumNumber {
@@ -280,7 +333,7 @@ func (x CanvasChangeRequestApproval_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequestApproval_State.Descriptor instead.
 func (CanvasChangeRequestApproval_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{37, 0}
 }
 
 type CanvasChangeRequest_Status int32
@@ -319,11 +372,11 @@ func (x CanvasChangeRequest_Status) String() string {
 }
 
 func (CanvasChangeRequest_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[5].Descriptor()
+	return file_canvases_proto_enumTypes[6].Descriptor()
 }
 
 func (CanvasChangeRequest_Status) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[5]
+	return &file_canvases_proto_enumTypes[6]
 }
 
 func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
@@ -332,7 +385,7 @@ func (x CanvasChangeRequest_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasChangeRequest_Status.Descriptor instead.
 func (CanvasChangeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{38, 0}
 }
 
 type CanvasNodeExecution_State int32
@@ -371,11 +424,11 @@ func (x CanvasNodeExecution_State) String() string {
 }
 
 func (CanvasNodeExecution_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[6].Descriptor()
+	return file_canvases_proto_enumTypes[7].Descriptor()
 }
 
 func (CanvasNodeExecution_State) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[6]
+	return &file_canvases_proto_enumTypes[7]
 }
 
 func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
@@ -384,7 +437,7 @@ func (x CanvasNodeExecution_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_State.Descriptor instead.
 func (CanvasNodeExecution_State) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 0}
+	return file_canvases_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type CanvasNodeExecution_Result int32
@@ -423,11 +476,11 @@ func (x CanvasNodeExecution_Result) String() string {
 }
 
 func (CanvasNodeExecution_Result) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[7].Descriptor()
+	return file_canvases_proto_enumTypes[8].Descriptor()
 }
 
 func (CanvasNodeExecution_Result) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[7]
+	return &file_canvases_proto_enumTypes[8]
 }
 
 func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
@@ -436,7 +489,7 @@ func (x CanvasNodeExecution_Result) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_Result.Descriptor instead.
 func (CanvasNodeExecution_Result) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 1}
+	return file_canvases_proto_rawDescGZIP(), []int{53, 1}
 }
 
 type CanvasNodeExecution_ResultReason int32
@@ -445,6 +498,7 @@ const (
 	CanvasNodeExecution_RESULT_REASON_OK             CanvasNodeExecution_ResultReason = 0
 	CanvasNodeExecution_RESULT_REASON_ERROR          CanvasNodeExecution_ResultReason = 1
 	CanvasNodeExecution_RESULT_REASON_ERROR_RESOLVED CanvasNodeExecution_ResultReason = 2
+	CanvasNodeExecution_RESULT_REASON_TIMED_OUT      CanvasNodeExecution_ResultReason = 3
 )
 
 // Enum value maps for CanvasNodeExecution_ResultReason.
@@ -453,11 +507,13 @@ var (
 		0: "RESULT_REASON_OK",
 		1: "RESULT_REASON_ERROR",
 		2: "RESULT_REASON_ERROR_RESOLVED",
+		3: "RESULT_REASON_TIMED_OUT",
 	}
 	CanvasNodeExecution_ResultReason_value = map[string]int32{
 		"RESULT_REASON_OK":             0,
 		"RESULT_REASON_ERROR":          1,
 		"RESULT_REASON_ERROR_RESOLVED": 2,
+		"RESULT_REASON_TIMED_OUT":      3,
 	}
 )
 
@@ -472,11 +528,11 @@ func (x CanvasNodeExecution_ResultReason) String() string {
 }
 
 func (CanvasNodeExecution_ResultReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_canvases_proto_enumTypes[8].Descriptor()
+	return file_canvases_proto_enumTypes[9].Descriptor()
 }
 
 func (CanvasNodeExecution_ResultReason) Type() protoreflect.EnumType {
-	return &file_canvases_proto_enumTypes[8]
+	return &file_canvases_proto_enumTypes[9]
 }
 
 func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
@@ -485,7 +541,322 @@ func (x CanvasNodeExecution_ResultReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CanvasNodeExecution_ResultReason.Descriptor instead.
 func (CanvasNodeExecution_ResultReason) EnumDescriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51, 2}
+	return file_canvases_proto_rawDescGZIP(), []int{53, 2}
+}
+
+type CanvasMemorySchema_Field_Type int32
+
+const (
+	CanvasMemorySchema_Field_TYPE_UNSPECIFIED CanvasMemorySchema_Field_Type = 0
+	CanvasMemorySchema_Field_TYPE_STRING      CanvasMemorySchema_Field_Type = 1
+	CanvasMemorySchema_Field_TYPE_NUMBER      CanvasMemorySchema_Field_Type = 2
+	CanvasMemorySchema_Field_TYPE_BOOLEAN     CanvasMemorySchema_Field_Type = 3
+	CanvasMemorySchema_Field_TYPE_OBJECT      CanvasMemorySchema_Field_Type = 4
+	CanvasMemorySchema_Field_TYPE_LIST        CanvasMemorySchema_Field_Type = 5
+)
+
+// Enum value maps for CanvasMemorySchema_Field_Type.
+var (
+	CanvasMemorySchema_Field_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_STRING",
+		2: "TYPE_NUMBER",
+		3: "TYPE_BOOLEAN",
+		4: "TYPE_OBJECT",
+		5: "TYPE_LIST",
+	}
+	CanvasMemorySchema_Field_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_STRING":      1,
+		"TYPE_NUMBER":      2,
+		"TYPE_BOOLEAN":     3,
+		"TYPE_OBJECT":      4,
+		"TYPE_LIST":        5,
+	}
+)
+
+func (x CanvasMemorySchema_Field_Type) Enum() *CanvasMemorySchema_Field_Type {
+	p := new(CanvasMemorySchema_Field_Type)
+	*p = x
+	return p
+}
+
+func (x CanvasMemorySchema_Field_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasMemorySchema_Field_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[10].Descriptor()
+}
+
+func (CanvasMemorySchema_Field_Type) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[10]
+}
+
+func (x CanvasMemorySchema_Field_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasMemorySchema_Field_Type.Descriptor instead.
+func (CanvasMemorySchema_Field_Type) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67, 0, 0}
+}
+
+type RetentionPolicy_Source int32
+
+const (
+	RetentionPolicy_SOURCE_NONE         RetentionPolicy_Source = 0
+	RetentionPolicy_SOURCE_ORGANIZATION RetentionPolicy_Source = 1
+	RetentionPolicy_SOURCE_CANVAS       RetentionPolicy_Source = 2
+)
+
+// Enum value maps for RetentionPolicy_Source.
+var (
+	RetentionPolicy_Source_name = map[int32]string{
+		0: "SOURCE_NONE",
+		1: "SOURCE_ORGANIZATION",
+		2: "SOURCE_CANVAS",
+	}
+	RetentionPolicy_Source_value = map[string]int32{
+		"SOURCE_NONE":         0,
+		"SOURCE_ORGANIZATION": 1,
+		"SOURCE_CANVAS":       2,
+	}
+)
+
+func (x RetentionPolicy_Source) Enum() *RetentionPolicy_Source {
+	p := new(RetentionPolicy_Source)
+	*p = x
+	return p
+}
+
+func (x RetentionPolicy_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionPolicy_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[11].Descriptor()
+}
+
+func (RetentionPolicy_Source) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[11]
+}
+
+func (x RetentionPolicy_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionPolicy_Source.Descriptor instead.
+func (RetentionPolicy_Source) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72, 0}
+}
+
+type CanvasRoleBinding_SubjectType int32
+
+const (
+	CanvasRoleBinding_SUBJECT_TYPE_UNSPECIFIED     CanvasRoleBinding_SubjectType = 0
+	CanvasRoleBinding_SUBJECT_TYPE_USER            CanvasRoleBinding_SubjectType = 1
+	CanvasRoleBinding_SUBJECT_TYPE_GROUP           CanvasRoleBinding_SubjectType = 2
+	CanvasRoleBinding_SUBJECT_TYPE_SERVICE_ACCOUNT CanvasRoleBinding_SubjectType = 3
+)
+
+// Enum value maps for CanvasRoleBinding_SubjectType.
+var (
+	CanvasRoleBinding_SubjectType_name = map[int32]string{
+		0: "SUBJECT_TYPE_UNSPECIFIED",
+		1: "SUBJECT_TYPE_USER",
+		2: "SUBJECT_TYPE_GROUP",
+		3: "SUBJECT_TYPE_SERVICE_ACCOUNT",
+	}
+	CanvasRoleBinding_SubjectType_value = map[string]int32{
+		"SUBJECT_TYPE_UNSPECIFIED":     0,
+		"SUBJECT_TYPE_USER":            1,
+		"SUBJECT_TYPE_GROUP":           2,
+		"SUBJECT_TYPE_SERVICE_ACCOUNT": 3,
+	}
+)
+
+func (x CanvasRoleBinding_SubjectType) Enum() *CanvasRoleBinding_SubjectType {
+	p := new(CanvasRoleBinding_SubjectType)
+	*p = x
+	return p
+}
+
+func (x CanvasRoleBinding_SubjectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasRoleBinding_SubjectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[12].Descriptor()
+}
+
+func (CanvasRoleBinding_SubjectType) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[12]
+}
+
+func (x CanvasRoleBinding_SubjectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasRoleBinding_SubjectType.Descriptor instead.
+func (CanvasRoleBinding_SubjectType) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{77, 0}
+}
+
+type DeadLetter_Type int32
+
+const (
+	DeadLetter_TYPE_UNSPECIFIED DeadLetter_Type = 0
+	DeadLetter_TYPE_EVENT       DeadLetter_Type = 1
+	DeadLetter_TYPE_QUEUE_ITEM  DeadLetter_Type = 2
+)
+
+// Enum value maps for DeadLetter_Type.
+var (
+	DeadLetter_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_EVENT",
+		2: "TYPE_QUEUE_ITEM",
+	}
+	DeadLetter_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_EVENT":       1,
+		"TYPE_QUEUE_ITEM":  2,
+	}
+)
+
+func (x DeadLetter_Type) Enum() *DeadLetter_Type {
+	p := new(DeadLetter_Type)
+	*p = x
+	return p
+}
+
+func (x DeadLetter_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetter_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[13].Descriptor()
+}
+
+func (DeadLetter_Type) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[13]
+}
+
+func (x DeadLetter_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetter_Type.Descriptor instead.
+func (DeadLetter_Type) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{88, 0}
+}
+
+type CanvasNodeExecutionLog_Level int32
+
+const (
+	CanvasNodeExecutionLog_LEVEL_UNKNOWN CanvasNodeExecutionLog_Level = 0
+	CanvasNodeExecutionLog_LEVEL_DEBUG   CanvasNodeExecutionLog_Level = 1
+	CanvasNodeExecutionLog_LEVEL_INFO    CanvasNodeExecutionLog_Level = 2
+	CanvasNodeExecutionLog_LEVEL_WARN    CanvasNodeExecutionLog_Level = 3
+	CanvasNodeExecutionLog_LEVEL_ERROR   CanvasNodeExecutionLog_Level = 4
+)
+
+// Enum value maps for CanvasNodeExecutionLog_Level.
+var (
+	CanvasNodeExecutionLog_Level_name = map[int32]string{
+		0: "LEVEL_UNKNOWN",
+		1: "LEVEL_DEBUG",
+		2: "LEVEL_INFO",
+		3: "LEVEL_WARN",
+		4: "LEVEL_ERROR",
+	}
+	CanvasNodeExecutionLog_Level_value = map[string]int32{
+		"LEVEL_UNKNOWN": 0,
+		"LEVEL_DEBUG":   1,
+		"LEVEL_INFO":    2,
+		"LEVEL_WARN":    3,
+		"LEVEL_ERROR":   4,
+	}
+)
+
+func (x CanvasNodeExecutionLog_Level) Enum() *CanvasNodeExecutionLog_Level {
+	p := new(CanvasNodeExecutionLog_Level)
+	*p = x
+	return p
+}
+
+func (x CanvasNodeExecutionLog_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasNodeExecutionLog_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[14].Descriptor()
+}
+
+func (CanvasNodeExecutionLog_Level) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[14]
+}
+
+func (x CanvasNodeExecutionLog_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionLog_Level.Descriptor instead.
+func (CanvasNodeExecutionLog_Level) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{101, 0}
+}
+
+type CanvasBundleDependency_Type int32
+
+const (
+	CanvasBundleDependency_TYPE_UNKNOWN     CanvasBundleDependency_Type = 0
+	CanvasBundleDependency_TYPE_INTEGRATION CanvasBundleDependency_Type = 1
+	CanvasBundleDependency_TYPE_SECRET      CanvasBundleDependency_Type = 2
+	CanvasBundleDependency_TYPE_BLUEPRINT   CanvasBundleDependency_Type = 3
+)
+
+// Enum value maps for CanvasBundleDependency_Type.
+var (
+	CanvasBundleDependency_Type_name = map[int32]string{
+		0: "TYPE_UNKNOWN",
+		1: "TYPE_INTEGRATION",
+		2: "TYPE_SECRET",
+		3: "TYPE_BLUEPRINT",
+	}
+	CanvasBundleDependency_Type_value = map[string]int32{
+		"TYPE_UNKNOWN":     0,
+		"TYPE_INTEGRATION": 1,
+		"TYPE_SECRET":      2,
+		"TYPE_BLUEPRINT":   3,
+	}
+)
+
+func (x CanvasBundleDependency_Type) Enum() *CanvasBundleDependency_Type {
+	p := new(CanvasBundleDependency_Type)
+	*p = x
+	return p
+}
+
+func (x CanvasBundleDependency_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanvasBundleDependency_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_canvases_proto_enumTypes[15].Descriptor()
+}
+
+func (CanvasBundleDependency_Type) Type() protoreflect.EnumType {
+	return &file_canvases_proto_enumTypes[15]
+}
+
+func (x CanvasBundleDependency_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanvasBundleDependency_Type.Descriptor instead.
+func (CanvasBundleDependency_Type) EnumDescriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{112, 0}
 }
 
 type ListCanvasesRequest struct {
@@ -671,6 +1042,7 @@ type UpdateCanvasRequest struct {
 	Description                 *string                            `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	CanvasVersioningEnabled     *bool                              `protobuf:"varint,4,opt,name=canvas_versioning_enabled,json=canvasVersioningEnabled,proto3,oneof" json:"canvas_versioning_enabled,omitempty"`
 	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,5,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3,oneof" json:"change_request_approval_config,omitempty"`
+	Environment                 *string                            `protobuf:"bytes,6,opt,name=environment,proto3,oneof" json:"environment,omitempty"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -740,6 +1112,13 @@ func (x *UpdateCanvasRequest) GetChangeRequestApprovalConfig() *CanvasChangeRequ
 	return nil
 }
 
+func (x *UpdateCanvasRequest) GetEnvironment() string {
+	if x != nil && x.Environment != nil {
+		return *x.Environment
+	}
+	return ""
+}
+
 type UpdateCanvasResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Canvas        *Canvas                `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
@@ -2184,28 +2563,32 @@ func (x *CanvasVersion) GetSpec() *Canvas_Spec {
 	return nil
 }
 
-type CanvasChangeRequestDiff struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ChangedNodeIds     []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
-	ConflictingNodeIds []string               `protobuf:"bytes,2,rep,name=conflicting_node_ids,json=conflictingNodeIds,proto3" json:"conflicting_node_ids,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// A variable expressions read with env("NAME").
+// Its value is either given in plain text or read from a key of an organization secret.
+type CanvasVariable struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Name          string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Value         string                    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Secret        *CanvasVariable_SecretRef `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequestDiff) Reset() {
-	*x = CanvasChangeRequestDiff{}
+func (x *CanvasVariable) Reset() {
+	*x = CanvasVariable{}
 	mi := &file_canvases_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequestDiff) String() string {
+func (x *CanvasVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequestDiff) ProtoMessage() {}
+func (*CanvasVariable) ProtoMessage() {}
 
-func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
+func (x *CanvasVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_canvases_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2217,37 +2600,157 @@ func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
+// Deprecated: Use CanvasVariable.ProtoReflect.Descriptor instead.
+func (*CanvasVariable) Descriptor() ([]byte, []int) {
 	return file_canvases_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
+func (x *CanvasVariable) GetName() string {
 	if x != nil {
-		return x.ChangedNodeIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CanvasChangeRequestDiff) GetConflictingNodeIds() []string {
+func (x *CanvasVariable) GetDescription() string {
 	if x != nil {
-		return x.ConflictingNodeIds
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-type CanvasChangeRequestApprover struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Type          CanvasChangeRequestApprover_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasChangeRequestApprover_Type" json:"type,omitempty"`
-	UserId        string                           `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RoleName      string                           `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CanvasVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
 }
 
-func (x *CanvasChangeRequestApprover) Reset() {
-	*x = CanvasChangeRequestApprover{}
-	mi := &file_canvases_proto_msgTypes[33]
+func (x *CanvasVariable) GetSecret() *CanvasVariable_SecretRef {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// A named set of values overriding the canvas variables,
+// so the same spec can run with different values per environment.
+type CanvasEnvironment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Variables     []*CanvasVariable      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEnvironment) Reset() {
+	*x = CanvasEnvironment{}
+	mi := &file_canvases_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEnvironment) ProtoMessage() {}
+
+func (x *CanvasEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEnvironment.ProtoReflect.Descriptor instead.
+func (*CanvasEnvironment) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CanvasEnvironment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasEnvironment) GetVariables() []*CanvasVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type CanvasChangeRequestDiff struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChangedNodeIds     []string               `protobuf:"bytes,1,rep,name=changed_node_ids,json=changedNodeIds,proto3" json:"changed_node_ids,omitempty"`
+	ConflictingNodeIds []string               `protobuf:"bytes,2,rep,name=conflicting_node_ids,json=conflictingNodeIds,proto3" json:"conflicting_node_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequestDiff) Reset() {
+	*x = CanvasChangeRequestDiff{}
+	mi := &file_canvases_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChangeRequestDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChangeRequestDiff) ProtoMessage() {}
+
+func (x *CanvasChangeRequestDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChangeRequestDiff.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequestDiff) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CanvasChangeRequestDiff) GetChangedNodeIds() []string {
+	if x != nil {
+		return x.ChangedNodeIds
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequestDiff) GetConflictingNodeIds() []string {
+	if x != nil {
+		return x.ConflictingNodeIds
+	}
+	return nil
+}
+
+type CanvasChangeRequestApprover struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Type          CanvasChangeRequestApprover_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasChangeRequestApprover_Type" json:"type,omitempty"`
+	UserId        string                           `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoleName      string                           `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequestApprover) Reset() {
+	*x = CanvasChangeRequestApprover{}
+	mi := &file_canvases_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2259,7 +2762,7 @@ func (x *CanvasChangeRequestApprover) String() string {
 func (*CanvasChangeRequestApprover) ProtoMessage() {}
 
 func (x *CanvasChangeRequestApprover) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[33]
+	mi := &file_canvases_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2272,7 +2775,7 @@ func (x *CanvasChangeRequestApprover) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasChangeRequestApprover.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequestApprover) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{33}
+	return file_canvases_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CanvasChangeRequestApprover) GetType() CanvasChangeRequestApprover_Type {
@@ -2305,7 +2808,7 @@ type CanvasChangeRequestApprovalConfig struct {
 
 func (x *CanvasChangeRequestApprovalConfig) Reset() {
 	*x = CanvasChangeRequestApprovalConfig{}
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2317,7 +2820,7 @@ func (x *CanvasChangeRequestApprovalConfig) String() string {
 func (*CanvasChangeRequestApprovalConfig) ProtoMessage() {}
 
 func (x *CanvasChangeRequestApprovalConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[34]
+	mi := &file_canvases_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2330,7 +2833,7 @@ func (x *CanvasChangeRequestApprovalConfig) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CanvasChangeRequestApprovalConfig.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequestApprovalConfig) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{34}
+	return file_canvases_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CanvasChangeRequestApprovalConfig) GetItems() []*CanvasChangeRequestApprover {
@@ -2353,7 +2856,7 @@ type CanvasChangeRequestApproval struct {
 
 func (x *CanvasChangeRequestApproval) Reset() {
 	*x = CanvasChangeRequestApproval{}
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2365,7 +2868,7 @@ func (x *CanvasChangeRequestApproval) String() string {
 func (*CanvasChangeRequestApproval) ProtoMessage() {}
 
 func (x *CanvasChangeRequestApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[35]
+	mi := &file_canvases_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,7 +2881,7 @@ func (x *CanvasChangeRequestApproval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasChangeRequestApproval.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequestApproval) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{35}
+	return file_canvases_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CanvasChangeRequestApproval) GetActor() *UserRef {
@@ -2428,7 +2931,7 @@ type CanvasChangeRequest struct {
 
 func (x *CanvasChangeRequest) Reset() {
 	*x = CanvasChangeRequest{}
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2440,7 +2943,7 @@ func (x *CanvasChangeRequest) String() string {
 func (*CanvasChangeRequest) ProtoMessage() {}
 
 func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[36]
+	mi := &file_canvases_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,7 +2956,7 @@ func (x *CanvasChangeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasChangeRequest.ProtoReflect.Descriptor instead.
 func (*CanvasChangeRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36}
+	return file_canvases_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CanvasChangeRequest) GetMetadata() *CanvasChangeRequest_Metadata {
@@ -2496,7 +2999,7 @@ type ListNodeEventsRequest struct {
 
 func (x *ListNodeEventsRequest) Reset() {
 	*x = ListNodeEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2508,7 +3011,7 @@ func (x *ListNodeEventsRequest) String() string {
 func (*ListNodeEventsRequest) ProtoMessage() {}
 
 func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[37]
+	mi := &file_canvases_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2521,7 +3024,7 @@ func (x *ListNodeEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{37}
+	return file_canvases_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListNodeEventsRequest) GetCanvasId() string {
@@ -2564,7 +3067,7 @@ type ListNodeEventsResponse struct {
 
 func (x *ListNodeEventsResponse) Reset() {
 	*x = ListNodeEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2576,7 +3079,7 @@ func (x *ListNodeEventsResponse) String() string {
 func (*ListNodeEventsResponse) ProtoMessage() {}
 
 func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[38]
+	mi := &file_canvases_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +3092,7 @@ func (x *ListNodeEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{38}
+	return file_canvases_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListNodeEventsResponse) GetEvents() []*CanvasEvent {
@@ -2632,7 +3135,7 @@ type EmitNodeEventRequest struct {
 
 func (x *EmitNodeEventRequest) Reset() {
 	*x = EmitNodeEventRequest{}
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2644,7 +3147,7 @@ func (x *EmitNodeEventRequest) String() string {
 func (*EmitNodeEventRequest) ProtoMessage() {}
 
 func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[39]
+	mi := &file_canvases_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2657,7 +3160,7 @@ func (x *EmitNodeEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitNodeEventRequest.ProtoReflect.Descriptor instead.
 func (*EmitNodeEventRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{39}
+	return file_canvases_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *EmitNodeEventRequest) GetCanvasId() string {
@@ -2697,7 +3200,7 @@ type EmitNodeEventResponse struct {
 
 func (x *EmitNodeEventResponse) Reset() {
 	*x = EmitNodeEventResponse{}
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2709,7 +3212,7 @@ func (x *EmitNodeEventResponse) String() string {
 func (*EmitNodeEventResponse) ProtoMessage() {}
 
 func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[40]
+	mi := &file_canvases_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2722,7 +3225,7 @@ func (x *EmitNodeEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitNodeEventResponse.ProtoReflect.Descriptor instead.
 func (*EmitNodeEventResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{40}
+	return file_canvases_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *EmitNodeEventResponse) GetEventId() string {
@@ -2744,7 +3247,7 @@ type ListNodeQueueItemsRequest struct {
 
 func (x *ListNodeQueueItemsRequest) Reset() {
 	*x = ListNodeQueueItemsRequest{}
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2756,7 +3259,7 @@ func (x *ListNodeQueueItemsRequest) String() string {
 func (*ListNodeQueueItemsRequest) ProtoMessage() {}
 
 func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[41]
+	mi := &file_canvases_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2769,7 +3272,7 @@ func (x *ListNodeQueueItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeQueueItemsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeQueueItemsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{41}
+	return file_canvases_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListNodeQueueItemsRequest) GetCanvasId() string {
@@ -2812,7 +3315,7 @@ type ListNodeQueueItemsResponse struct {
 
 func (x *ListNodeQueueItemsResponse) Reset() {
 	*x = ListNodeQueueItemsResponse{}
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2824,7 +3327,7 @@ func (x *ListNodeQueueItemsResponse) String() string {
 func (*ListNodeQueueItemsResponse) ProtoMessage() {}
 
 func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[42]
+	mi := &file_canvases_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,7 +3340,7 @@ func (x *ListNodeQueueItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeQueueItemsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeQueueItemsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{42}
+	return file_canvases_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListNodeQueueItemsResponse) GetItems() []*CanvasNodeQueueItem {
@@ -2879,7 +3382,7 @@ type DeleteNodeQueueItemRequest struct {
 
 func (x *DeleteNodeQueueItemRequest) Reset() {
 	*x = DeleteNodeQueueItemRequest{}
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2891,7 +3394,7 @@ func (x *DeleteNodeQueueItemRequest) String() string {
 func (*DeleteNodeQueueItemRequest) ProtoMessage() {}
 
 func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[43]
+	mi := &file_canvases_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,7 +3407,7 @@ func (x *DeleteNodeQueueItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeQueueItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteNodeQueueItemRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{43}
+	return file_canvases_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DeleteNodeQueueItemRequest) GetCanvasId() string {
@@ -2936,7 +3439,7 @@ type DeleteNodeQueueItemResponse struct {
 
 func (x *DeleteNodeQueueItemResponse) Reset() {
 	*x = DeleteNodeQueueItemResponse{}
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2948,7 +3451,7 @@ func (x *DeleteNodeQueueItemResponse) String() string {
 func (*DeleteNodeQueueItemResponse) ProtoMessage() {}
 
 func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[44]
+	mi := &file_canvases_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +3464,7 @@ func (x *DeleteNodeQueueItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteNodeQueueItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteNodeQueueItemResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{44}
+	return file_canvases_proto_rawDescGZIP(), []int{46}
 }
 
 type UpdateNodePauseRequest struct {
@@ -2975,7 +3478,7 @@ type UpdateNodePauseRequest struct {
 
 func (x *UpdateNodePauseRequest) Reset() {
 	*x = UpdateNodePauseRequest{}
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2987,7 +3490,7 @@ func (x *UpdateNodePauseRequest) String() string {
 func (*UpdateNodePauseRequest) ProtoMessage() {}
 
 func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[45]
+	mi := &file_canvases_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,7 +3503,7 @@ func (x *UpdateNodePauseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{45}
+	return file_canvases_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UpdateNodePauseRequest) GetCanvasId() string {
@@ -3033,7 +3536,7 @@ type UpdateNodePauseResponse struct {
 
 func (x *UpdateNodePauseResponse) Reset() {
 	*x = UpdateNodePauseResponse{}
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3045,7 +3548,7 @@ func (x *UpdateNodePauseResponse) String() string {
 func (*UpdateNodePauseResponse) ProtoMessage() {}
 
 func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[46]
+	mi := &file_canvases_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3561,7 @@ func (x *UpdateNodePauseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodePauseResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNodePauseResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{46}
+	return file_canvases_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UpdateNodePauseResponse) GetNode() *components.Node {
@@ -3082,7 +3585,7 @@ type ListNodeExecutionsRequest struct {
 
 func (x *ListNodeExecutionsRequest) Reset() {
 	*x = ListNodeExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3094,7 +3597,7 @@ func (x *ListNodeExecutionsRequest) String() string {
 func (*ListNodeExecutionsRequest) ProtoMessage() {}
 
 func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[47]
+	mi := &file_canvases_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,7 +3610,7 @@ func (x *ListNodeExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{47}
+	return file_canvases_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ListNodeExecutionsRequest) GetCanvasId() string {
@@ -3164,7 +3667,7 @@ type ListNodeExecutionsResponse struct {
 
 func (x *ListNodeExecutionsResponse) Reset() {
 	*x = ListNodeExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[48]
+	mi := &file_canvases_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3176,7 +3679,7 @@ func (x *ListNodeExecutionsResponse) String() string {
 func (*ListNodeExecutionsResponse) ProtoMessage() {}
 
 func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[48]
+	mi := &file_canvases_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3189,7 +3692,7 @@ func (x *ListNodeExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodeExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListNodeExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{48}
+	return file_canvases_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListNodeExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -3230,7 +3733,7 @@ type ListChildExecutionsRequest struct {
 
 func (x *ListChildExecutionsRequest) Reset() {
 	*x = ListChildExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[49]
+	mi := &file_canvases_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3242,7 +3745,7 @@ func (x *ListChildExecutionsRequest) String() string {
 func (*ListChildExecutionsRequest) ProtoMessage() {}
 
 func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[49]
+	mi := &file_canvases_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3255,7 +3758,7 @@ func (x *ListChildExecutionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsRequest.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{49}
+	return file_canvases_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListChildExecutionsRequest) GetCanvasId() string {
@@ -3281,7 +3784,7 @@ type ListChildExecutionsResponse struct {
 
 func (x *ListChildExecutionsResponse) Reset() {
 	*x = ListChildExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[50]
+	mi := &file_canvases_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3293,7 +3796,7 @@ func (x *ListChildExecutionsResponse) String() string {
 func (*ListChildExecutionsResponse) ProtoMessage() {}
 
 func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[50]
+	mi := &file_canvases_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3306,7 +3809,7 @@ func (x *ListChildExecutionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChildExecutionsResponse.ProtoReflect.Descriptor instead.
 func (*ListChildExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{50}
+	return file_canvases_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ListChildExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
@@ -3336,13 +3839,17 @@ type CanvasNodeExecution struct {
 	ChildExecutions     []*CanvasNodeExecution           `protobuf:"bytes,16,rep,name=child_executions,json=childExecutions,proto3" json:"child_executions,omitempty"`
 	RootEvent           *CanvasEvent                     `protobuf:"bytes,17,opt,name=root_event,json=rootEvent,proto3" json:"root_event,omitempty"`
 	CancelledBy         *UserRef                         `protobuf:"bytes,18,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Attempt             int32                            `protobuf:"varint,19,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	RetryOfExecutionId  string                           `protobuf:"bytes,20,opt,name=retry_of_execution_id,json=retryOfExecutionId,proto3" json:"retry_of_execution_id,omitempty"`
+	RunAt               *timestamp.Timestamp             `protobuf:"bytes,21,opt,name=run_at,json=runAt,proto3" json:"run_at,omitempty"`
+	RerunOfExecutionId  string                           `protobuf:"bytes,22,opt,name=rerun_of_execution_id,json=rerunOfExecutionId,proto3" json:"rerun_of_execution_id,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CanvasNodeExecution) Reset() {
 	*x = CanvasNodeExecution{}
-	mi := &file_canvases_proto_msgTypes[51]
+	mi := &file_canvases_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3354,7 +3861,7 @@ func (x *CanvasNodeExecution) String() string {
 func (*CanvasNodeExecution) ProtoMessage() {}
 
 func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[51]
+	mi := &file_canvases_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3367,7 +3874,7 @@ func (x *CanvasNodeExecution) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeExecution.ProtoReflect.Descriptor instead.
 func (*CanvasNodeExecution) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{51}
+	return file_canvases_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *CanvasNodeExecution) GetId() string {
@@ -3496,6 +4003,34 @@ func (x *CanvasNodeExecution) GetCancelledBy() *UserRef {
 	return nil
 }
 
+func (x *CanvasNodeExecution) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *CanvasNodeExecution) GetRetryOfExecutionId() string {
+	if x != nil {
+		return x.RetryOfExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecution) GetRunAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecution) GetRerunOfExecutionId() string {
+	if x != nil {
+		return x.RerunOfExecutionId
+	}
+	return ""
+}
+
 type CanvasNodeQueueItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3510,7 +4045,7 @@ type CanvasNodeQueueItem struct {
 
 func (x *CanvasNodeQueueItem) Reset() {
 	*x = CanvasNodeQueueItem{}
-	mi := &file_canvases_proto_msgTypes[52]
+	mi := &file_canvases_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3522,7 +4057,7 @@ func (x *CanvasNodeQueueItem) String() string {
 func (*CanvasNodeQueueItem) ProtoMessage() {}
 
 func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[52]
+	mi := &file_canvases_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3535,7 +4070,7 @@ func (x *CanvasNodeQueueItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasNodeQueueItem.ProtoReflect.Descriptor instead.
 func (*CanvasNodeQueueItem) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{52}
+	return file_canvases_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *CanvasNodeQueueItem) GetId() string {
@@ -3592,7 +4127,7 @@ type InvokeNodeExecutionActionRequest struct {
 
 func (x *InvokeNodeExecutionActionRequest) Reset() {
 	*x = InvokeNodeExecutionActionRequest{}
-	mi := &file_canvases_proto_msgTypes[53]
+	mi := &file_canvases_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3604,7 +4139,7 @@ func (x *InvokeNodeExecutionActionRequest) String() string {
 func (*InvokeNodeExecutionActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[53]
+	mi := &file_canvases_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3617,7 +4152,7 @@ func (x *InvokeNodeExecutionActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeExecutionActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{53}
+	return file_canvases_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *InvokeNodeExecutionActionRequest) GetCanvasId() string {
@@ -3656,7 +4191,7 @@ type InvokeNodeExecutionActionResponse struct {
 
 func (x *InvokeNodeExecutionActionResponse) Reset() {
 	*x = InvokeNodeExecutionActionResponse{}
-	mi := &file_canvases_proto_msgTypes[54]
+	mi := &file_canvases_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3668,7 +4203,7 @@ func (x *InvokeNodeExecutionActionResponse) String() string {
 func (*InvokeNodeExecutionActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[54]
+	mi := &file_canvases_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3681,7 +4216,7 @@ func (x *InvokeNodeExecutionActionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InvokeNodeExecutionActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeExecutionActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{54}
+	return file_canvases_proto_rawDescGZIP(), []int{56}
 }
 
 type InvokeNodeTriggerActionRequest struct {
@@ -3696,7 +4231,7 @@ type InvokeNodeTriggerActionRequest struct {
 
 func (x *InvokeNodeTriggerActionRequest) Reset() {
 	*x = InvokeNodeTriggerActionRequest{}
-	mi := &file_canvases_proto_msgTypes[55]
+	mi := &file_canvases_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3708,7 +4243,7 @@ func (x *InvokeNodeTriggerActionRequest) String() string {
 func (*InvokeNodeTriggerActionRequest) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[55]
+	mi := &file_canvases_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3721,7 +4256,7 @@ func (x *InvokeNodeTriggerActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionRequest.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{55}
+	return file_canvases_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *InvokeNodeTriggerActionRequest) GetCanvasId() string {
@@ -3761,7 +4296,7 @@ type InvokeNodeTriggerActionResponse struct {
 
 func (x *InvokeNodeTriggerActionResponse) Reset() {
 	*x = InvokeNodeTriggerActionResponse{}
-	mi := &file_canvases_proto_msgTypes[56]
+	mi := &file_canvases_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3773,7 +4308,7 @@ func (x *InvokeNodeTriggerActionResponse) String() string {
 func (*InvokeNodeTriggerActionResponse) ProtoMessage() {}
 
 func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[56]
+	mi := &file_canvases_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +4321,7 @@ func (x *InvokeNodeTriggerActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokeNodeTriggerActionResponse.ProtoReflect.Descriptor instead.
 func (*InvokeNodeTriggerActionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{56}
+	return file_canvases_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *InvokeNodeTriggerActionResponse) GetResult() *_struct.Struct {
@@ -3807,7 +4342,7 @@ type ListCanvasEventsRequest struct {
 
 func (x *ListCanvasEventsRequest) Reset() {
 	*x = ListCanvasEventsRequest{}
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3819,7 +4354,7 @@ func (x *ListCanvasEventsRequest) String() string {
 func (*ListCanvasEventsRequest) ProtoMessage() {}
 
 func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[57]
+	mi := &file_canvases_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3832,7 +4367,7 @@ func (x *ListCanvasEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{57}
+	return file_canvases_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ListCanvasEventsRequest) GetCanvasId() string {
@@ -3868,7 +4403,7 @@ type ListCanvasEventsResponse struct {
 
 func (x *ListCanvasEventsResponse) Reset() {
 	*x = ListCanvasEventsResponse{}
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3880,7 +4415,7 @@ func (x *ListCanvasEventsResponse) String() string {
 func (*ListCanvasEventsResponse) ProtoMessage() {}
 
 func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[58]
+	mi := &file_canvases_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3893,7 +4428,7 @@ func (x *ListCanvasEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasEventsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{58}
+	return file_canvases_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListCanvasEventsResponse) GetEvents() []*CanvasEventWithExecutions {
@@ -3929,13 +4464,14 @@ type CanvasMemory struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	Values        *_struct.Value         `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CanvasMemory) Reset() {
 	*x = CanvasMemory{}
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3947,7 +4483,7 @@ func (x *CanvasMemory) String() string {
 func (*CanvasMemory) ProtoMessage() {}
 
 func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[59]
+	mi := &file_canvases_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,7 +4496,7 @@ func (x *CanvasMemory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CanvasMemory.ProtoReflect.Descriptor instead.
 func (*CanvasMemory) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{59}
+	return file_canvases_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *CanvasMemory) GetId() string {
@@ -3984,6 +4520,13 @@ func (x *CanvasMemory) GetValues() *_struct.Value {
 	return nil
 }
 
+func (x *CanvasMemory) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type ListCanvasMemoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
@@ -3993,7 +4536,7 @@ type ListCanvasMemoriesRequest struct {
 
 func (x *ListCanvasMemoriesRequest) Reset() {
 	*x = ListCanvasMemoriesRequest{}
-	mi := &file_canvases_proto_msgTypes[60]
+	mi := &file_canvases_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4005,7 +4548,7 @@ func (x *ListCanvasMemoriesRequest) String() string {
 func (*ListCanvasMemoriesRequest) ProtoMessage() {}
 
 func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[60]
+	mi := &file_canvases_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4018,7 +4561,7 @@ func (x *ListCanvasMemoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasMemoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCanvasMemoriesRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{60}
+	return file_canvases_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListCanvasMemoriesRequest) GetCanvasId() string {
@@ -4037,7 +4580,7 @@ type ListCanvasMemoriesResponse struct {
 
 func (x *ListCanvasMemoriesResponse) Reset() {
 	*x = ListCanvasMemoriesResponse{}
-	mi := &file_canvases_proto_msgTypes[61]
+	mi := &file_canvases_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4049,7 +4592,7 @@ func (x *ListCanvasMemoriesResponse) String() string {
 func (*ListCanvasMemoriesResponse) ProtoMessage() {}
 
 func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[61]
+	mi := &file_canvases_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4062,7 +4605,7 @@ func (x *ListCanvasMemoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCanvasMemoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCanvasMemoriesResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{61}
+	return file_canvases_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ListCanvasMemoriesResponse) GetItems() []*CanvasMemory {
@@ -4082,7 +4625,7 @@ type DeleteCanvasMemoryRequest struct {
 
 func (x *DeleteCanvasMemoryRequest) Reset() {
 	*x = DeleteCanvasMemoryRequest{}
-	mi := &file_canvases_proto_msgTypes[62]
+	mi := &file_canvases_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4094,7 +4637,7 @@ func (x *DeleteCanvasMemoryRequest) String() string {
 func (*DeleteCanvasMemoryRequest) ProtoMessage() {}
 
 func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[62]
+	mi := &file_canvases_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4107,7 +4650,7 @@ func (x *DeleteCanvasMemoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasMemoryRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{62}
+	return file_canvases_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DeleteCanvasMemoryRequest) GetCanvasId() string {
@@ -4132,7 +4675,7 @@ type DeleteCanvasMemoryResponse struct {
 
 func (x *DeleteCanvasMemoryResponse) Reset() {
 	*x = DeleteCanvasMemoryResponse{}
-	mi := &file_canvases_proto_msgTypes[63]
+	mi := &file_canvases_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4144,7 +4687,7 @@ func (x *DeleteCanvasMemoryResponse) String() string {
 func (*DeleteCanvasMemoryResponse) ProtoMessage() {}
 
 func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[63]
+	mi := &file_canvases_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4157,37 +4700,38 @@ func (x *DeleteCanvasMemoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCanvasMemoryResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCanvasMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{63}
+	return file_canvases_proto_rawDescGZIP(), []int{65}
 }
 
-type CanvasEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CanvasMemoryNamespace struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//
+	// TTL applied to records written without one.
+	// Zero means records do not expire.
+	//
+	DefaultTtlSeconds uint32               `protobuf:"varint,2,opt,name=default_ttl_seconds,json=defaultTtlSeconds,proto3" json:"default_ttl_seconds,omitempty"`
+	UpdatedAt         *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Schema            *CanvasMemorySchema  `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CanvasEvent) Reset() {
-	*x = CanvasEvent{}
-	mi := &file_canvases_proto_msgTypes[64]
+func (x *CanvasMemoryNamespace) Reset() {
+	*x = CanvasMemoryNamespace{}
+	mi := &file_canvases_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEvent) String() string {
+func (x *CanvasMemoryNamespace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEvent) ProtoMessage() {}
+func (*CanvasMemoryNamespace) ProtoMessage() {}
 
-func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[64]
+func (x *CanvasMemoryNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4198,89 +4742,69 @@ func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
-func (*CanvasEvent) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *CanvasEvent) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasEvent) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasEvent) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+// Deprecated: Use CanvasMemoryNamespace.ProtoReflect.Descriptor instead.
+func (*CanvasMemoryNamespace) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CanvasEvent) GetChannel() string {
+func (x *CanvasMemoryNamespace) GetNamespace() string {
 	if x != nil {
-		return x.Channel
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *CanvasEvent) GetCustomName() string {
+func (x *CanvasMemoryNamespace) GetDefaultTtlSeconds() uint32 {
 	if x != nil {
-		return x.CustomName
+		return x.DefaultTtlSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *CanvasEvent) GetData() *_struct.Struct {
+func (x *CanvasMemoryNamespace) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+func (x *CanvasMemoryNamespace) GetSchema() *CanvasMemorySchema {
 	if x != nil {
-		return x.CreatedAt
+		return x.Schema
 	}
 	return nil
 }
 
-type CanvasEventWithExecutions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
-	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
-	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+type CanvasMemorySchema struct {
+	state  protoimpl.MessageState      `protogen:"open.v1"`
+	Fields []*CanvasMemorySchema_Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	//
+	// Records with the same values for these fields replace each other.
+	//
+	UniqueKey []string `protobuf:"bytes,2,rep,name=unique_key,json=uniqueKey,proto3" json:"unique_key,omitempty"`
+	//
+	// Every lookup key gets an index, to speed up matches on its fields.
+	//
+	LookupKeys    []*CanvasMemorySchema_LookupKey `protobuf:"bytes,3,rep,name=lookup_keys,json=lookupKeys,proto3" json:"lookup_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasEventWithExecutions) Reset() {
-	*x = CanvasEventWithExecutions{}
-	mi := &file_canvases_proto_msgTypes[65]
+func (x *CanvasMemorySchema) Reset() {
+	*x = CanvasMemorySchema{}
+	mi := &file_canvases_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasEventWithExecutions) String() string {
+func (x *CanvasMemorySchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasEventWithExecutions) ProtoMessage() {}
+func (*CanvasMemorySchema) ProtoMessage() {}
 
-func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[65]
+func (x *CanvasMemorySchema) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4291,90 +4815,54 @@ func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
-func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *CanvasEventWithExecutions) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasEventWithExecutions) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasEventWithExecutions) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
-}
-
-func (x *CanvasEventWithExecutions) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
+// Deprecated: Use CanvasMemorySchema.ProtoReflect.Descriptor instead.
+func (*CanvasMemorySchema) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+func (x *CanvasMemorySchema) GetFields() []*CanvasMemorySchema_Field {
 	if x != nil {
-		return x.Data
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+func (x *CanvasMemorySchema) GetUniqueKey() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UniqueKey
 	}
 	return nil
 }
 
-func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+func (x *CanvasMemorySchema) GetLookupKeys() []*CanvasMemorySchema_LookupKey {
 	if x != nil {
-		return x.Executions
+		return x.LookupKeys
 	}
 	return nil
 }
 
-func (x *CanvasEventWithExecutions) GetCustomName() string {
-	if x != nil {
-		return x.CustomName
-	}
-	return ""
-}
-
-type ListEventExecutionsRequest struct {
+type ListCanvasMemoryNamespacesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventExecutionsRequest) Reset() {
-	*x = ListEventExecutionsRequest{}
-	mi := &file_canvases_proto_msgTypes[66]
+func (x *ListCanvasMemoryNamespacesRequest) Reset() {
+	*x = ListCanvasMemoryNamespacesRequest{}
+	mi := &file_canvases_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventExecutionsRequest) String() string {
+func (x *ListCanvasMemoryNamespacesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventExecutionsRequest) ProtoMessage() {}
+func (*ListCanvasMemoryNamespacesRequest) ProtoMessage() {}
 
-func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[66]
+func (x *ListCanvasMemoryNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,47 +4873,40 @@ func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use ListCanvasMemoryNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoryNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListEventExecutionsRequest) GetCanvasId() string {
+func (x *ListCanvasMemoryNamespacesRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *ListEventExecutionsRequest) GetEventId() string {
-	if x != nil {
-		return x.EventId
-	}
-	return ""
-}
-
-type ListEventExecutionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+type ListCanvasMemoryNamespacesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Namespaces    []*CanvasMemoryNamespace `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEventExecutionsResponse) Reset() {
-	*x = ListEventExecutionsResponse{}
-	mi := &file_canvases_proto_msgTypes[67]
+func (x *ListCanvasMemoryNamespacesResponse) Reset() {
+	*x = ListCanvasMemoryNamespacesResponse{}
+	mi := &file_canvases_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventExecutionsResponse) String() string {
+func (x *ListCanvasMemoryNamespacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventExecutionsResponse) ProtoMessage() {}
+func (*ListCanvasMemoryNamespacesResponse) ProtoMessage() {}
 
-func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[67]
+func (x *ListCanvasMemoryNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4436,41 +4917,46 @@ func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ListCanvasMemoryNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasMemoryNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+func (x *ListCanvasMemoryNamespacesResponse) GetNamespaces() []*CanvasMemoryNamespace {
 	if x != nil {
-		return x.Executions
+		return x.Namespaces
 	}
 	return nil
 }
 
-type CancelExecutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+type UpdateCanvasMemoryNamespaceRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId          string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	DefaultTtlSeconds uint32                 `protobuf:"varint,3,opt,name=default_ttl_seconds,json=defaultTtlSeconds,proto3" json:"default_ttl_seconds,omitempty"`
+	//
+	// Records are not validated when no schema is given.
+	//
+	Schema        *CanvasMemorySchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionRequest) Reset() {
-	*x = CancelExecutionRequest{}
-	mi := &file_canvases_proto_msgTypes[68]
+func (x *UpdateCanvasMemoryNamespaceRequest) Reset() {
+	*x = UpdateCanvasMemoryNamespaceRequest{}
+	mi := &file_canvases_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionRequest) String() string {
+func (x *UpdateCanvasMemoryNamespaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionRequest) ProtoMessage() {}
+func (*UpdateCanvasMemoryNamespaceRequest) ProtoMessage() {}
 
-func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[68]
+func (x *UpdateCanvasMemoryNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4481,46 +4967,61 @@ func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
-func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use UpdateCanvasMemoryNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemoryNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CancelExecutionRequest) GetCanvasId() string {
+func (x *UpdateCanvasMemoryNamespaceRequest) GetCanvasId() string {
 	if x != nil {
 		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CancelExecutionRequest) GetExecutionId() string {
+func (x *UpdateCanvasMemoryNamespaceRequest) GetNamespace() string {
 	if x != nil {
-		return x.ExecutionId
+		return x.Namespace
 	}
 	return ""
 }
 
-type CancelExecutionResponse struct {
+func (x *UpdateCanvasMemoryNamespaceRequest) GetDefaultTtlSeconds() uint32 {
+	if x != nil {
+		return x.DefaultTtlSeconds
+	}
+	return 0
+}
+
+func (x *UpdateCanvasMemoryNamespaceRequest) GetSchema() *CanvasMemorySchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type UpdateCanvasMemoryNamespaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *CanvasMemoryNamespace `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelExecutionResponse) Reset() {
-	*x = CancelExecutionResponse{}
-	mi := &file_canvases_proto_msgTypes[69]
+func (x *UpdateCanvasMemoryNamespaceResponse) Reset() {
+	*x = UpdateCanvasMemoryNamespaceResponse{}
+	mi := &file_canvases_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelExecutionResponse) String() string {
+func (x *UpdateCanvasMemoryNamespaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelExecutionResponse) ProtoMessage() {}
+func (*UpdateCanvasMemoryNamespaceResponse) ProtoMessage() {}
 
-func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[69]
+func (x *UpdateCanvasMemoryNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4531,34 +5032,42 @@ func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
-func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use UpdateCanvasMemoryNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasMemoryNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{71}
 }
 
-type ResolveExecutionErrorsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateCanvasMemoryNamespaceResponse) GetNamespace() *CanvasMemoryNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
 }
 
-func (x *ResolveExecutionErrorsRequest) Reset() {
-	*x = ResolveExecutionErrorsRequest{}
-	mi := &file_canvases_proto_msgTypes[70]
+type RetentionPolicy struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxAgeDays           int32                  `protobuf:"varint,1,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	MaxExecutionsPerNode int32                  `protobuf:"varint,2,opt,name=max_executions_per_node,json=maxExecutionsPerNode,proto3" json:"max_executions_per_node,omitempty"`
+	FailedMaxAgeDays     int32                  `protobuf:"varint,3,opt,name=failed_max_age_days,json=failedMaxAgeDays,proto3" json:"failed_max_age_days,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_canvases_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsRequest) String() string {
+func (x *RetentionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+func (*RetentionPolicy) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[70]
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4569,46 +5078,55 @@ func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+func (x *RetentionPolicy) GetMaxAgeDays() int32 {
 	if x != nil {
-		return x.CanvasId
+		return x.MaxAgeDays
 	}
-	return ""
+	return 0
 }
 
-func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+func (x *RetentionPolicy) GetMaxExecutionsPerNode() int32 {
 	if x != nil {
-		return x.ExecutionIds
+		return x.MaxExecutionsPerNode
 	}
-	return nil
+	return 0
 }
 
-type ResolveExecutionErrorsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RetentionPolicy) GetFailedMaxAgeDays() int32 {
+	if x != nil {
+		return x.FailedMaxAgeDays
+	}
+	return 0
 }
 
-func (x *ResolveExecutionErrorsResponse) Reset() {
-	*x = ResolveExecutionErrorsResponse{}
-	mi := &file_canvases_proto_msgTypes[71]
+type UpdateCanvasRetentionPolicyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId        string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasRetentionPolicyRequest) Reset() {
+	*x = UpdateCanvasRetentionPolicyRequest{}
+	mi := &file_canvases_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveExecutionErrorsResponse) String() string {
+func (x *UpdateCanvasRetentionPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+func (*UpdateCanvasRetentionPolicyRequest) ProtoMessage() {}
 
-func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[71]
+func (x *UpdateCanvasRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4619,36 +5137,48 @@ func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
-func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use UpdateCanvasRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{73}
 }
 
-type CanvasAiNodeContext struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateCanvasRetentionPolicyRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
 }
 
-func (x *CanvasAiNodeContext) Reset() {
-	*x = CanvasAiNodeContext{}
-	mi := &file_canvases_proto_msgTypes[72]
+func (x *UpdateCanvasRetentionPolicyRequest) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+type UpdateCanvasRetentionPolicyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,1,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	Source          RetentionPolicy_Source `protobuf:"varint,2,opt,name=source,proto3,enum=Superplane.Canvases.RetentionPolicy_Source" json:"source,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateCanvasRetentionPolicyResponse) Reset() {
+	*x = UpdateCanvasRetentionPolicyResponse{}
+	mi := &file_canvases_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiNodeContext) String() string {
+func (x *UpdateCanvasRetentionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiNodeContext) ProtoMessage() {}
+func (*UpdateCanvasRetentionPolicyResponse) ProtoMessage() {}
 
-func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[72]
+func (x *UpdateCanvasRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4659,63 +5189,47 @@ func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiNodeContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiNodeContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *CanvasAiNodeContext) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasAiNodeContext) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use UpdateCanvasRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCanvasRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CanvasAiNodeContext) GetLabel() string {
+func (x *UpdateCanvasRetentionPolicyResponse) GetRetentionPolicy() *RetentionPolicy {
 	if x != nil {
-		return x.Label
+		return x.RetentionPolicy
 	}
-	return ""
+	return nil
 }
 
-func (x *CanvasAiNodeContext) GetType() string {
+func (x *UpdateCanvasRetentionPolicyResponse) GetSource() RetentionPolicy_Source {
 	if x != nil {
-		return x.Type
+		return x.Source
 	}
-	return ""
+	return RetentionPolicy_SOURCE_NONE
 }
 
-type CanvasAiBlockContext struct {
+type GetCanvasRetentionReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasAiBlockContext) Reset() {
-	*x = CanvasAiBlockContext{}
-	mi := &file_canvases_proto_msgTypes[73]
+func (x *GetCanvasRetentionReportRequest) Reset() {
+	*x = GetCanvasRetentionReportRequest{}
+	mi := &file_canvases_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiBlockContext) String() string {
+func (x *GetCanvasRetentionReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiBlockContext) ProtoMessage() {}
+func (*GetCanvasRetentionReportRequest) ProtoMessage() {}
 
-func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[73]
+func (x *GetCanvasRetentionReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,55 +5240,45 @@ func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiBlockContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiBlockContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *CanvasAiBlockContext) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CanvasAiBlockContext) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+// Deprecated: Use GetCanvasRetentionReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCanvasRetentionReportRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CanvasAiBlockContext) GetType() string {
+func (x *GetCanvasRetentionReportRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Type
+		return x.CanvasId
 	}
 	return ""
 }
 
-type CanvasAiContext struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	Nodes           []*CanvasAiNodeContext  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	AvailableBlocks []*CanvasAiBlockContext `protobuf:"bytes,2,rep,name=available_blocks,json=availableBlocks,proto3" json:"available_blocks,omitempty"`
+type GetCanvasRetentionReportResponse struct {
+	state           protoimpl.MessageState                   `protogen:"open.v1"`
+	RetentionPolicy *RetentionPolicy                         `protobuf:"bytes,1,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	Source          RetentionPolicy_Source                   `protobuf:"varint,2,opt,name=source,proto3,enum=Superplane.Canvases.RetentionPolicy_Source" json:"source,omitempty"`
+	Nodes           []*GetCanvasRetentionReportResponse_Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Executions      uint32                                   `protobuf:"varint,4,opt,name=executions,proto3" json:"executions,omitempty"`
+	Events          uint32                                   `protobuf:"varint,5,opt,name=events,proto3" json:"events,omitempty"`
+	QueueItems      uint32                                   `protobuf:"varint,6,opt,name=queue_items,json=queueItems,proto3" json:"queue_items,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CanvasAiContext) Reset() {
-	*x = CanvasAiContext{}
-	mi := &file_canvases_proto_msgTypes[74]
+func (x *GetCanvasRetentionReportResponse) Reset() {
+	*x = GetCanvasRetentionReportResponse{}
+	mi := &file_canvases_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasAiContext) String() string {
+func (x *GetCanvasRetentionReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasAiContext) ProtoMessage() {}
+func (*GetCanvasRetentionReportResponse) ProtoMessage() {}
 
-func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[74]
+func (x *GetCanvasRetentionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4785,49 +5289,78 @@ func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasAiContext.ProtoReflect.Descriptor instead.
-func (*CanvasAiContext) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetCanvasRetentionReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCanvasRetentionReportResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CanvasAiContext) GetNodes() []*CanvasAiNodeContext {
+func (x *GetCanvasRetentionReportResponse) GetRetentionPolicy() *RetentionPolicy {
 	if x != nil {
-		return x.Nodes
+		return x.RetentionPolicy
 	}
 	return nil
 }
 
-func (x *CanvasAiContext) GetAvailableBlocks() []*CanvasAiBlockContext {
+func (x *GetCanvasRetentionReportResponse) GetSource() RetentionPolicy_Source {
 	if x != nil {
-		return x.AvailableBlocks
+		return x.Source
+	}
+	return RetentionPolicy_SOURCE_NONE
+}
+
+func (x *GetCanvasRetentionReportResponse) GetNodes() []*GetCanvasRetentionReportResponse_Node {
+	if x != nil {
+		return x.Nodes
 	}
 	return nil
 }
 
-type SendAiMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	CanvasContext *CanvasAiContext       `protobuf:"bytes,3,opt,name=canvas_context,json=canvasContext,proto3" json:"canvas_context,omitempty"`
+func (x *GetCanvasRetentionReportResponse) GetExecutions() uint32 {
+	if x != nil {
+		return x.Executions
+	}
+	return 0
+}
+
+func (x *GetCanvasRetentionReportResponse) GetEvents() uint32 {
+	if x != nil {
+		return x.Events
+	}
+	return 0
+}
+
+func (x *GetCanvasRetentionReportResponse) GetQueueItems() uint32 {
+	if x != nil {
+		return x.QueueItems
+	}
+	return 0
+}
+
+type CanvasRoleBinding struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	SubjectType   CanvasRoleBinding_SubjectType `protobuf:"varint,1,opt,name=subject_type,json=subjectType,proto3,enum=Superplane.Canvases.CanvasRoleBinding_SubjectType" json:"subject_type,omitempty"`
+	SubjectId     string                        `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	SubjectName   string                        `protobuf:"bytes,3,opt,name=subject_name,json=subjectName,proto3" json:"subject_name,omitempty"`
+	Role          CanvasRole                    `protobuf:"varint,4,opt,name=role,proto3,enum=Superplane.Canvases.CanvasRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAiMessageRequest) Reset() {
-	*x = SendAiMessageRequest{}
-	mi := &file_canvases_proto_msgTypes[75]
+func (x *CanvasRoleBinding) Reset() {
+	*x = CanvasRoleBinding{}
+	mi := &file_canvases_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAiMessageRequest) String() string {
+func (x *CanvasRoleBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAiMessageRequest) ProtoMessage() {}
+func (*CanvasRoleBinding) ProtoMessage() {}
 
-func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[75]
+func (x *CanvasRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4838,55 +5371,61 @@ func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAiMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendAiMessageRequest) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use CanvasRoleBinding.ProtoReflect.Descriptor instead.
+func (*CanvasRoleBinding) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *SendAiMessageRequest) GetCanvasId() string {
+func (x *CanvasRoleBinding) GetSubjectType() CanvasRoleBinding_SubjectType {
 	if x != nil {
-		return x.CanvasId
+		return x.SubjectType
+	}
+	return CanvasRoleBinding_SUBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *CanvasRoleBinding) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
 	}
 	return ""
 }
 
-func (x *SendAiMessageRequest) GetPrompt() string {
+func (x *CanvasRoleBinding) GetSubjectName() string {
 	if x != nil {
-		return x.Prompt
+		return x.SubjectName
 	}
 	return ""
 }
 
-func (x *SendAiMessageRequest) GetCanvasContext() *CanvasAiContext {
+func (x *CanvasRoleBinding) GetRole() CanvasRole {
 	if x != nil {
-		return x.CanvasContext
+		return x.Role
 	}
-	return nil
+	return CanvasRole_CANVAS_ROLE_UNSPECIFIED
 }
 
-type SendAiMessageResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AssistantMessage string                 `protobuf:"bytes,1,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"`
-	Operations       []*_struct.Struct      `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListCanvasRoleBindingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendAiMessageResponse) Reset() {
-	*x = SendAiMessageResponse{}
-	mi := &file_canvases_proto_msgTypes[76]
+func (x *ListCanvasRoleBindingsRequest) Reset() {
+	*x = ListCanvasRoleBindingsRequest{}
+	mi := &file_canvases_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendAiMessageResponse) String() string {
+func (x *ListCanvasRoleBindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAiMessageResponse) ProtoMessage() {}
+func (*ListCanvasRoleBindingsRequest) ProtoMessage() {}
 
-func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[76]
+func (x *ListCanvasRoleBindingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4897,50 +5436,40 @@ func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAiMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendAiMessageResponse) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ListCanvasRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListCanvasRoleBindingsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *SendAiMessageResponse) GetAssistantMessage() string {
+func (x *ListCanvasRoleBindingsRequest) GetCanvasId() string {
 	if x != nil {
-		return x.AssistantMessage
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *SendAiMessageResponse) GetOperations() []*_struct.Struct {
-	if x != nil {
-		return x.Operations
-	}
-	return nil
-}
-
-type CanvasNodeEventMessage struct {
+type ListCanvasRoleBindingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RoleBindings  []*CanvasRoleBinding   `protobuf:"bytes,1,rep,name=role_bindings,json=roleBindings,proto3" json:"role_bindings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeEventMessage) Reset() {
-	*x = CanvasNodeEventMessage{}
-	mi := &file_canvases_proto_msgTypes[77]
+func (x *ListCanvasRoleBindingsResponse) Reset() {
+	*x = ListCanvasRoleBindingsResponse{}
+	mi := &file_canvases_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeEventMessage) String() string {
+func (x *ListCanvasRoleBindingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeEventMessage) ProtoMessage() {}
+func (*ListCanvasRoleBindingsResponse) ProtoMessage() {}
 
-func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[77]
+func (x *ListCanvasRoleBindingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4951,64 +5480,43 @@ func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *CanvasNodeEventMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeEventMessage) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasNodeEventMessage) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+// Deprecated: Use ListCanvasRoleBindingsResponse.ProtoReflect.Descriptor instead.
+func (*ListCanvasRoleBindingsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *ListCanvasRoleBindingsResponse) GetRoleBindings() []*CanvasRoleBinding {
 	if x != nil {
-		return x.Timestamp
+		return x.RoleBindings
 	}
 	return nil
 }
 
-type CanvasNodeExecutionMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type AssignCanvasRoleRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	CanvasId      string                        `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	SubjectType   CanvasRoleBinding_SubjectType `protobuf:"varint,2,opt,name=subject_type,json=subjectType,proto3,enum=Superplane.Canvases.CanvasRoleBinding_SubjectType" json:"subject_type,omitempty"`
+	SubjectId     string                        `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Role          CanvasRole                    `protobuf:"varint,4,opt,name=role,proto3,enum=Superplane.Canvases.CanvasRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeExecutionMessage) Reset() {
-	*x = CanvasNodeExecutionMessage{}
-	mi := &file_canvases_proto_msgTypes[78]
+func (x *AssignCanvasRoleRequest) Reset() {
+	*x = AssignCanvasRoleRequest{}
+	mi := &file_canvases_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeExecutionMessage) String() string {
+func (x *AssignCanvasRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+func (*AssignCanvasRoleRequest) ProtoMessage() {}
 
-func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[78]
+func (x *AssignCanvasRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5019,64 +5527,61 @@ func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use AssignCanvasRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignCanvasRoleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CanvasNodeExecutionMessage) GetId() string {
+func (x *AssignCanvasRoleRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+func (x *AssignCanvasRoleRequest) GetSubjectType() CanvasRoleBinding_SubjectType {
 	if x != nil {
-		return x.CanvasId
+		return x.SubjectType
 	}
-	return ""
+	return CanvasRoleBinding_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+func (x *AssignCanvasRoleRequest) GetSubjectId() string {
 	if x != nil {
-		return x.NodeId
+		return x.SubjectId
 	}
 	return ""
 }
 
-func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *AssignCanvasRoleRequest) GetRole() CanvasRole {
 	if x != nil {
-		return x.Timestamp
+		return x.Role
 	}
-	return nil
+	return CanvasRole_CANVAS_ROLE_UNSPECIFIED
 }
 
-type CanvasNodeQueueItemMessage struct {
+type AssignCanvasRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RoleBinding   *CanvasRoleBinding     `protobuf:"bytes,1,opt,name=role_binding,json=roleBinding,proto3" json:"role_binding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasNodeQueueItemMessage) Reset() {
-	*x = CanvasNodeQueueItemMessage{}
-	mi := &file_canvases_proto_msgTypes[79]
+func (x *AssignCanvasRoleResponse) Reset() {
+	*x = AssignCanvasRoleResponse{}
+	mi := &file_canvases_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasNodeQueueItemMessage) String() string {
+func (x *AssignCanvasRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
+func (*AssignCanvasRoleResponse) ProtoMessage() {}
 
-func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[79]
+func (x *AssignCanvasRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5087,63 +5592,42 @@ func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
-func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *CanvasNodeQueueItemMessage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+// Deprecated: Use AssignCanvasRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignCanvasRoleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *AssignCanvasRoleResponse) GetRoleBinding() *CanvasRoleBinding {
 	if x != nil {
-		return x.Timestamp
+		return x.RoleBinding
 	}
 	return nil
 }
 
-type CanvasMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type RemoveCanvasRoleRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	CanvasId      string                        `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	SubjectType   CanvasRoleBinding_SubjectType `protobuf:"varint,2,opt,name=subject_type,json=subjectType,proto3,enum=Superplane.Canvases.CanvasRoleBinding_SubjectType" json:"subject_type,omitempty"`
+	SubjectId     string                        `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasMessage) Reset() {
-	*x = CanvasMessage{}
-	mi := &file_canvases_proto_msgTypes[80]
+func (x *RemoveCanvasRoleRequest) Reset() {
+	*x = RemoveCanvasRoleRequest{}
+	mi := &file_canvases_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasMessage) String() string {
+func (x *RemoveCanvasRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasMessage) ProtoMessage() {}
+func (*RemoveCanvasRoleRequest) ProtoMessage() {}
 
-func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[80]
+func (x *RemoveCanvasRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5154,56 +5638,53 @@ func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
-func (*CanvasMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use RemoveCanvasRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasRoleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CanvasMessage) GetId() string {
+func (x *RemoveCanvasRoleRequest) GetCanvasId() string {
 	if x != nil {
-		return x.Id
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *CanvasMessage) GetCanvasId() string {
+func (x *RemoveCanvasRoleRequest) GetSubjectType() CanvasRoleBinding_SubjectType {
 	if x != nil {
-		return x.CanvasId
+		return x.SubjectType
 	}
-	return ""
+	return CanvasRoleBinding_SUBJECT_TYPE_UNSPECIFIED
 }
 
-func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
+func (x *RemoveCanvasRoleRequest) GetSubjectId() string {
 	if x != nil {
-		return x.Timestamp
+		return x.SubjectId
 	}
-	return nil
+	return ""
 }
 
-type CanvasVersionMessage struct {
+type RemoveCanvasRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersionMessage) Reset() {
-	*x = CanvasVersionMessage{}
-	mi := &file_canvases_proto_msgTypes[81]
+func (x *RemoveCanvasRoleResponse) Reset() {
+	*x = RemoveCanvasRoleResponse{}
+	mi := &file_canvases_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersionMessage) String() string {
+func (x *RemoveCanvasRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersionMessage) ProtoMessage() {}
+func (*RemoveCanvasRoleResponse) ProtoMessage() {}
 
-func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[81]
+func (x *RemoveCanvasRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5214,63 +5695,40 @@ func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersionMessage.ProtoReflect.Descriptor instead.
-func (*CanvasVersionMessage) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *CanvasVersionMessage) GetCanvasId() string {
-	if x != nil {
-		return x.CanvasId
-	}
-	return ""
-}
-
-func (x *CanvasVersionMessage) GetVersionId() string {
-	if x != nil {
-		return x.VersionId
-	}
-	return ""
-}
-
-func (x *CanvasVersionMessage) GetTimestamp() *timestamp.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
+// Deprecated: Use RemoveCanvasRoleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCanvasRoleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{83}
 }
 
-type Canvas_Metadata struct {
-	state                       protoimpl.MessageState             `protogen:"open.v1"`
-	Id                          string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId              string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Name                        string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description                 string                             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt                   *timestamp.Timestamp               `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt                   *timestamp.Timestamp               `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CreatedBy                   *UserRef                           `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	IsTemplate                  bool                               `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
-	CanvasVersioningEnabled     bool                               `protobuf:"varint,9,opt,name=canvas_versioning_enabled,json=canvasVersioningEnabled,proto3" json:"canvas_versioning_enabled,omitempty"`
-	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,10,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3" json:"change_request_approval_config,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type CanvasEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	CustomName    string                 `protobuf:"bytes,5,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReplayOfId    string                 `protobuf:"bytes,8,opt,name=replay_of_id,json=replayOfId,proto3" json:"replay_of_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Metadata) Reset() {
-	*x = Canvas_Metadata{}
-	mi := &file_canvases_proto_msgTypes[82]
+func (x *CanvasEvent) Reset() {
+	*x = CanvasEvent{}
+	mi := &file_canvases_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Metadata) String() string {
+func (x *CanvasEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Metadata) ProtoMessage() {}
+func (*CanvasEvent) ProtoMessage() {}
 
-func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[82]
+func (x *CanvasEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5281,104 +5739,2988 @@ func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
-func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use CanvasEvent.ProtoReflect.Descriptor instead.
+func (*CanvasEvent) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *Canvas_Metadata) GetId() string {
+func (x *CanvasEvent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetOrganizationId() string {
+func (x *CanvasEvent) GetCanvasId() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.CanvasId
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetName() string {
+func (x *CanvasEvent) GetNodeId() string {
 	if x != nil {
-		return x.Name
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetDescription() string {
+func (x *CanvasEvent) GetChannel() string {
 	if x != nil {
-		return x.Description
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *CanvasEvent) GetCustomName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.CustomName
 	}
-	return nil
+	return ""
 }
 
-func (x *Canvas_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *CanvasEvent) GetData() *_struct.Struct {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasEvent) GetReplayOfId() string {
+	if x != nil {
+		return x.ReplayOfId
+	}
+	return ""
+}
+
+type CanvasEventWithExecutions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data          *_struct.Struct        `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,7,rep,name=executions,proto3" json:"executions,omitempty"`
+	CustomName    string                 `protobuf:"bytes,8,opt,name=custom_name,json=customName,proto3" json:"custom_name,omitempty"`
+	ReplayOfId    string                 `protobuf:"bytes,9,opt,name=replay_of_id,json=replayOfId,proto3" json:"replay_of_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasEventWithExecutions) Reset() {
+	*x = CanvasEventWithExecutions{}
+	mi := &file_canvases_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasEventWithExecutions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasEventWithExecutions) ProtoMessage() {}
+
+func (x *CanvasEventWithExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasEventWithExecutions.ProtoReflect.Descriptor instead.
+func (*CanvasEventWithExecutions) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CanvasEventWithExecutions) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetData() *_struct.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+func (x *CanvasEventWithExecutions) GetCustomName() string {
+	if x != nil {
+		return x.CustomName
+	}
+	return ""
+}
+
+func (x *CanvasEventWithExecutions) GetReplayOfId() string {
+	if x != nil {
+		return x.ReplayOfId
+	}
+	return ""
+}
+
+type ListEventExecutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsRequest) Reset() {
+	*x = ListEventExecutionsRequest{}
+	mi := &file_canvases_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventExecutionsRequest) ProtoMessage() {}
+
+func (x *ListEventExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListEventExecutionsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListEventExecutionsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListEventExecutionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Executions    []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventExecutionsResponse) Reset() {
+	*x = ListEventExecutionsResponse{}
+	mi := &file_canvases_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventExecutionsResponse) ProtoMessage() {}
+
+func (x *ListEventExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListEventExecutionsResponse) GetExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          DeadLetter_Type        `protobuf:"varint,2,opt,name=type,proto3,enum=Superplane.Canvases.DeadLetter_Type" json:"type,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,3,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Event         *CanvasEvent           `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailureReason string                 `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_canvases_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetType() DeadLetter_Type {
+	if x != nil {
+		return x.Type
+	}
+	return DeadLetter_TYPE_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEvent() *CanvasEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_canvases_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListDeadLettersRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_canvases_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDeadLettersResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListDeadLettersResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type ReplayDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterRequest) Reset() {
+	*x = ReplayDeadLetterRequest{}
+	mi := &file_canvases_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ReplayDeadLetterRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ReplayDeadLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterResponse) Reset() {
+	*x = ReplayDeadLetterResponse{}
+	mi := &file_canvases_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{92}
+}
+
+type ReplayCanvasEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayCanvasEventRequest) Reset() {
+	*x = ReplayCanvasEventRequest{}
+	mi := &file_canvases_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayCanvasEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCanvasEventRequest) ProtoMessage() {}
+
+func (x *ReplayCanvasEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCanvasEventRequest.ProtoReflect.Descriptor instead.
+func (*ReplayCanvasEventRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ReplayCanvasEventRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ReplayCanvasEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReplayCanvasEventRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type ReplayCanvasEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *CanvasEvent           `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayCanvasEventResponse) Reset() {
+	*x = ReplayCanvasEventResponse{}
+	mi := &file_canvases_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayCanvasEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCanvasEventResponse) ProtoMessage() {}
+
+func (x *ReplayCanvasEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCanvasEventResponse.ProtoReflect.Descriptor instead.
+func (*ReplayCanvasEventResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReplayCanvasEventResponse) GetEvent() *CanvasEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CancelExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionRequest) Reset() {
+	*x = CancelExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionRequest) ProtoMessage() {}
+
+func (x *CancelExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CancelExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CancelExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type CancelExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelExecutionResponse) Reset() {
+	*x = CancelExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelExecutionResponse) ProtoMessage() {}
+
+func (x *CancelExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{96}
+}
+
+type RerunExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunExecutionRequest) Reset() {
+	*x = RerunExecutionRequest{}
+	mi := &file_canvases_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunExecutionRequest) ProtoMessage() {}
+
+func (x *RerunExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RerunExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RerunExecutionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *RerunExecutionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type RerunExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueItem     *CanvasNodeQueueItem   `protobuf:"bytes,1,opt,name=queue_item,json=queueItem,proto3" json:"queue_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RerunExecutionResponse) Reset() {
+	*x = RerunExecutionResponse{}
+	mi := &file_canvases_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RerunExecutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RerunExecutionResponse) ProtoMessage() {}
+
+func (x *RerunExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RerunExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RerunExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RerunExecutionResponse) GetQueueItem() *CanvasNodeQueueItem {
+	if x != nil {
+		return x.QueueItem
+	}
+	return nil
+}
+
+type GetExecutionLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	After         *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsRequest) Reset() {
+	*x = GetExecutionLogsRequest{}
+	mi := &file_canvases_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsRequest) ProtoMessage() {}
+
+func (x *GetExecutionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetExecutionLogsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *GetExecutionLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetExecutionLogsRequest) GetAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type GetExecutionLogsResponse struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Logs           []*CanvasNodeExecutionLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	TotalCount     uint32                    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	HasNextPage    bool                      `protobuf:"varint,3,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp  *timestamp.Timestamp      `protobuf:"bytes,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	ExecutionState CanvasNodeExecution_State `protobuf:"varint,5,opt,name=execution_state,json=executionState,proto3,enum=Superplane.Canvases.CanvasNodeExecution_State" json:"execution_state,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetExecutionLogsResponse) Reset() {
+	*x = GetExecutionLogsResponse{}
+	mi := &file_canvases_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExecutionLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExecutionLogsResponse) ProtoMessage() {}
+
+func (x *GetExecutionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExecutionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetExecutionLogsResponse) GetLogs() []*CanvasNodeExecutionLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *GetExecutionLogsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetExecutionLogsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *GetExecutionLogsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+func (x *GetExecutionLogsResponse) GetExecutionState() CanvasNodeExecution_State {
+	if x != nil {
+		return x.ExecutionState
+	}
+	return CanvasNodeExecution_STATE_UNKNOWN
+}
+
+type CanvasNodeExecutionLog struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Id            string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionId   string                       `protobuf:"bytes,2,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                       `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Level         CanvasNodeExecutionLog_Level `protobuf:"varint,4,opt,name=level,proto3,enum=Superplane.Canvases.CanvasNodeExecutionLog_Level" json:"level,omitempty"`
+	Message       string                       `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Fields        *_struct.Struct              `protobuf:"bytes,6,opt,name=fields,proto3" json:"fields,omitempty"`
+	CreatedAt     *timestamp.Timestamp         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionLog) Reset() {
+	*x = CanvasNodeExecutionLog{}
+	mi := &file_canvases_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionLog) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionLog) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionLog.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionLog) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CanvasNodeExecutionLog) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLog) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLog) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLog) GetLevel() CanvasNodeExecutionLog_Level {
+	if x != nil {
+		return x.Level
+	}
+	return CanvasNodeExecutionLog_LEVEL_UNKNOWN
+}
+
+func (x *CanvasNodeExecutionLog) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLog) GetFields() *_struct.Struct {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CanvasNodeExecutionLog) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ResolveExecutionErrorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionIds  []string               `protobuf:"bytes,2,rep,name=execution_ids,json=executionIds,proto3" json:"execution_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsRequest) Reset() {
+	*x = ResolveExecutionErrorsRequest{}
+	mi := &file_canvases_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsRequest) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveExecutionErrorsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ResolveExecutionErrorsRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *ResolveExecutionErrorsRequest) GetExecutionIds() []string {
+	if x != nil {
+		return x.ExecutionIds
+	}
+	return nil
+}
+
+type ResolveExecutionErrorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveExecutionErrorsResponse) Reset() {
+	*x = ResolveExecutionErrorsResponse{}
+	mi := &file_canvases_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveExecutionErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveExecutionErrorsResponse) ProtoMessage() {}
+
+func (x *ResolveExecutionErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveExecutionErrorsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveExecutionErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{103}
+}
+
+type CanvasAiNodeContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasAiNodeContext) Reset() {
+	*x = CanvasAiNodeContext{}
+	mi := &file_canvases_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiNodeContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiNodeContext) ProtoMessage() {}
+
+func (x *CanvasAiNodeContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiNodeContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiNodeContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CanvasAiNodeContext) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CanvasAiNodeContext) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CanvasAiBlockContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasAiBlockContext) Reset() {
+	*x = CanvasAiBlockContext{}
+	mi := &file_canvases_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiBlockContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiBlockContext) ProtoMessage() {}
+
+func (x *CanvasAiBlockContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiBlockContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiBlockContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CanvasAiBlockContext) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasAiBlockContext) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CanvasAiBlockContext) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type CanvasAiContext struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Nodes           []*CanvasAiNodeContext  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	AvailableBlocks []*CanvasAiBlockContext `protobuf:"bytes,2,rep,name=available_blocks,json=availableBlocks,proto3" json:"available_blocks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CanvasAiContext) Reset() {
+	*x = CanvasAiContext{}
+	mi := &file_canvases_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasAiContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasAiContext) ProtoMessage() {}
+
+func (x *CanvasAiContext) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasAiContext.ProtoReflect.Descriptor instead.
+func (*CanvasAiContext) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CanvasAiContext) GetNodes() []*CanvasAiNodeContext {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CanvasAiContext) GetAvailableBlocks() []*CanvasAiBlockContext {
+	if x != nil {
+		return x.AvailableBlocks
+	}
+	return nil
+}
+
+type SendAiMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Prompt        string                 `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	CanvasContext *CanvasAiContext       `protobuf:"bytes,3,opt,name=canvas_context,json=canvasContext,proto3" json:"canvas_context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAiMessageRequest) Reset() {
+	*x = SendAiMessageRequest{}
+	mi := &file_canvases_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAiMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAiMessageRequest) ProtoMessage() {}
+
+func (x *SendAiMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAiMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendAiMessageRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SendAiMessageRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *SendAiMessageRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *SendAiMessageRequest) GetCanvasContext() *CanvasAiContext {
+	if x != nil {
+		return x.CanvasContext
+	}
+	return nil
+}
+
+type SendAiMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AssistantMessage string                 `protobuf:"bytes,1,opt,name=assistant_message,json=assistantMessage,proto3" json:"assistant_message,omitempty"`
+	Operations       []*_struct.Struct      `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendAiMessageResponse) Reset() {
+	*x = SendAiMessageResponse{}
+	mi := &file_canvases_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAiMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAiMessageResponse) ProtoMessage() {}
+
+func (x *SendAiMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAiMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendAiMessageResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SendAiMessageResponse) GetAssistantMessage() string {
+	if x != nil {
+		return x.AssistantMessage
+	}
+	return ""
+}
+
+func (x *SendAiMessageResponse) GetOperations() []*_struct.Struct {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type EvaluateExpressionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId   string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	//
+	// An expression, like $["Build"].data.ref, or a template with expressions between double braces.
+	// Either an expression or a configuration must be given.
+	//
+	Expression    string          `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Configuration *_struct.Struct `protobuf:"bytes,4,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	//
+	// The data the node is evaluated against: an event it received,
+	// or one of its executions. Without them, only functions and memory can be used.
+	//
+	EventId       string `protobuf:"bytes,5,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ExecutionId   string `protobuf:"bytes,6,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionRequest) Reset() {
+	*x = EvaluateExpressionRequest{}
+	mi := &file_canvases_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionRequest) ProtoMessage() {}
+
+func (x *EvaluateExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *EvaluateExpressionRequest) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+type EvaluateExpressionResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Value           *_struct.Value         `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Configuration   *_struct.Struct        `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	ReferencedNodes []string               `protobuf:"bytes,3,rep,name=referenced_nodes,json=referencedNodes,proto3" json:"referenced_nodes,omitempty"`
+	Errors          []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionResponse) Reset() {
+	*x = EvaluateExpressionResponse{}
+	mi := &file_canvases_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionResponse) ProtoMessage() {}
+
+func (x *EvaluateExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *EvaluateExpressionResponse) GetValue() *_struct.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetConfiguration() *_struct.Struct {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetReferencedNodes() []string {
+	if x != nil {
+		return x.ReferencedNodes
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// A canvas with everything it depends on, so it can be created in another organization or instance.
+// Nodes keep the integration and blueprint IDs of the organization the bundle was exported from,
+// and importing maps them to the ones of the target organization.
+// Secret values are never exported: secrets are listed by name, and must exist where the bundle is imported.
+type CanvasBundle struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	FormatVersion int32                       `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Canvas        *Canvas                     `protobuf:"bytes,2,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Blueprints    []*blueprints.Blueprint     `protobuf:"bytes,3,rep,name=blueprints,proto3" json:"blueprints,omitempty"`
+	Integrations  []*CanvasBundle_Integration `protobuf:"bytes,4,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	Secrets       []*CanvasBundle_Secret      `protobuf:"bytes,5,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasBundle) Reset() {
+	*x = CanvasBundle{}
+	mi := &file_canvases_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasBundle) ProtoMessage() {}
+
+func (x *CanvasBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasBundle.ProtoReflect.Descriptor instead.
+func (*CanvasBundle) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CanvasBundle) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *CanvasBundle) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *CanvasBundle) GetBlueprints() []*blueprints.Blueprint {
+	if x != nil {
+		return x.Blueprints
+	}
+	return nil
+}
+
+func (x *CanvasBundle) GetIntegrations() []*CanvasBundle_Integration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+func (x *CanvasBundle) GetSecrets() []*CanvasBundle_Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type CanvasBundleDependency struct {
+	state protoimpl.MessageState      `protogen:"open.v1"`
+	Type  CanvasBundleDependency_Type `protobuf:"varint,1,opt,name=type,proto3,enum=Superplane.Canvases.CanvasBundleDependency_Type" json:"type,omitempty"`
+	// The ID of the integration or blueprint in the bundle, or the secret name.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasBundleDependency) Reset() {
+	*x = CanvasBundleDependency{}
+	mi := &file_canvases_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasBundleDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasBundleDependency) ProtoMessage() {}
+
+func (x *CanvasBundleDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasBundleDependency.ProtoReflect.Descriptor instead.
+func (*CanvasBundleDependency) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CanvasBundleDependency) GetType() CanvasBundleDependency_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CanvasBundleDependency_TYPE_UNKNOWN
+}
+
+func (x *CanvasBundleDependency) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasBundleDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasBundleDependency) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportCanvasBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCanvasBundleRequest) Reset() {
+	*x = ExportCanvasBundleRequest{}
+	mi := &file_canvases_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCanvasBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCanvasBundleRequest) ProtoMessage() {}
+
+func (x *ExportCanvasBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCanvasBundleRequest.ProtoReflect.Descriptor instead.
+func (*ExportCanvasBundleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExportCanvasBundleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExportCanvasBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *CanvasBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCanvasBundleResponse) Reset() {
+	*x = ExportCanvasBundleResponse{}
+	mi := &file_canvases_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCanvasBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCanvasBundleResponse) ProtoMessage() {}
+
+func (x *ExportCanvasBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCanvasBundleResponse.ProtoReflect.Descriptor instead.
+func (*ExportCanvasBundleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ExportCanvasBundleResponse) GetBundle() *CanvasBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportCanvasBundleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bundle *CanvasBundle          `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// Overrides the name of the canvas in the bundle.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	// Integration IDs of the bundle mapped to integration IDs of the organization.
+	// Integrations without a mapping use the one with the same name and type,
+	// or the only one of the same type.
+	//
+	IntegrationIds map[string]string `protobuf:"bytes,3,rep,name=integration_ids,json=integrationIds,proto3" json:"integration_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Only resolve the dependencies, without creating anything.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCanvasBundleRequest) Reset() {
+	*x = ImportCanvasBundleRequest{}
+	mi := &file_canvases_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCanvasBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCanvasBundleRequest) ProtoMessage() {}
+
+func (x *ImportCanvasBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCanvasBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportCanvasBundleRequest) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ImportCanvasBundleRequest) GetBundle() *CanvasBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportCanvasBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportCanvasBundleRequest) GetIntegrationIds() map[string]string {
+	if x != nil {
+		return x.IntegrationIds
+	}
+	return nil
+}
+
+func (x *ImportCanvasBundleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportCanvasBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Not set when some dependencies are unresolved, or on dry runs.
+	Canvas         *Canvas                   `protobuf:"bytes,1,opt,name=canvas,proto3" json:"canvas,omitempty"`
+	Unresolved     []*CanvasBundleDependency `protobuf:"bytes,2,rep,name=unresolved,proto3" json:"unresolved,omitempty"`
+	IntegrationIds map[string]string         `protobuf:"bytes,3,rep,name=integration_ids,json=integrationIds,proto3" json:"integration_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	BlueprintIds   map[string]string         `protobuf:"bytes,4,rep,name=blueprint_ids,json=blueprintIds,proto3" json:"blueprint_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportCanvasBundleResponse) Reset() {
+	*x = ImportCanvasBundleResponse{}
+	mi := &file_canvases_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCanvasBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCanvasBundleResponse) ProtoMessage() {}
+
+func (x *ImportCanvasBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCanvasBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportCanvasBundleResponse) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ImportCanvasBundleResponse) GetCanvas() *Canvas {
+	if x != nil {
+		return x.Canvas
+	}
+	return nil
+}
+
+func (x *ImportCanvasBundleResponse) GetUnresolved() []*CanvasBundleDependency {
+	if x != nil {
+		return x.Unresolved
+	}
+	return nil
+}
+
+func (x *ImportCanvasBundleResponse) GetIntegrationIds() map[string]string {
+	if x != nil {
+		return x.IntegrationIds
+	}
+	return nil
+}
+
+func (x *ImportCanvasBundleResponse) GetBlueprintIds() map[string]string {
+	if x != nil {
+		return x.BlueprintIds
+	}
+	return nil
+}
+
+type CanvasNodeEventMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeEventMessage) Reset() {
+	*x = CanvasNodeEventMessage{}
+	mi := &file_canvases_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeEventMessage) ProtoMessage() {}
+
+func (x *CanvasNodeEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeEventMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeEventMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CanvasNodeEventMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeEventMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionMessage) Reset() {
+	*x = CanvasNodeExecutionMessage{}
+	mi := &file_canvases_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CanvasNodeExecutionMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeExecutionLogMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	ExecutionId   string                 `protobuf:"bytes,3,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeExecutionLogMessage) Reset() {
+	*x = CanvasNodeExecutionLogMessage{}
+	mi := &file_canvases_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeExecutionLogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeExecutionLogMessage) ProtoMessage() {}
+
+func (x *CanvasNodeExecutionLogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeExecutionLogMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeExecutionLogMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetExecutionId() string {
+	if x != nil {
+		return x.ExecutionId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeExecutionLogMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasNodeQueueItemMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasNodeQueueItemMessage) Reset() {
+	*x = CanvasNodeQueueItemMessage{}
+	mi := &file_canvases_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasNodeQueueItemMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasNodeQueueItemMessage) ProtoMessage() {}
+
+func (x *CanvasNodeQueueItemMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasNodeQueueItemMessage.ProtoReflect.Descriptor instead.
+func (*CanvasNodeQueueItemMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CanvasNodeQueueItemMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CanvasNodeQueueItemMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMessage) Reset() {
+	*x = CanvasMessage{}
+	mi := &file_canvases_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasMessage) ProtoMessage() {}
+
+func (x *CanvasMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasMessage.ProtoReflect.Descriptor instead.
+func (*CanvasMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CanvasMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type CanvasVersionMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CanvasId      string                 `protobuf:"bytes,1,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId     string                 `protobuf:"bytes,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersionMessage) Reset() {
+	*x = CanvasVersionMessage{}
+	mi := &file_canvases_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersionMessage) ProtoMessage() {}
+
+func (x *CanvasVersionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersionMessage.ProtoReflect.Descriptor instead.
+func (*CanvasVersionMessage) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CanvasVersionMessage) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasVersionMessage) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *CanvasVersionMessage) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type Canvas_Metadata struct {
+	state                       protoimpl.MessageState             `protogen:"open.v1"`
+	Id                          string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId              string                             `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name                        string                             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description                 string                             `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt                   *timestamp.Timestamp               `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                   *timestamp.Timestamp               `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy                   *UserRef                           `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	IsTemplate                  bool                               `protobuf:"varint,8,opt,name=is_template,json=isTemplate,proto3" json:"is_template,omitempty"`
+	CanvasVersioningEnabled     bool                               `protobuf:"varint,9,opt,name=canvas_versioning_enabled,json=canvasVersioningEnabled,proto3" json:"canvas_versioning_enabled,omitempty"`
+	ChangeRequestApprovalConfig *CanvasChangeRequestApprovalConfig `protobuf:"bytes,10,opt,name=change_request_approval_config,json=changeRequestApprovalConfig,proto3" json:"change_request_approval_config,omitempty"`
+	//
+	// The environment of the spec whose variable values this canvas uses.
+	// Empty means the values defined in the spec variables.
+	//
+	Environment   string `protobuf:"bytes,11,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Canvas_Metadata) Reset() {
+	*x = Canvas_Metadata{}
+	mi := &file_canvases_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canvas_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas_Metadata) ProtoMessage() {}
+
+func (x *Canvas_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas_Metadata.ProtoReflect.Descriptor instead.
+func (*Canvas_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *Canvas_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Canvas_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Canvas_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
 func (x *Canvas_Metadata) GetCreatedBy() *UserRef {
 	if x != nil {
-		return x.CreatedBy
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *Canvas_Metadata) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *Canvas_Metadata) GetCanvasVersioningEnabled() bool {
+	if x != nil {
+		return x.CanvasVersioningEnabled
+	}
+	return false
+}
+
+func (x *Canvas_Metadata) GetChangeRequestApprovalConfig() *CanvasChangeRequestApprovalConfig {
+	if x != nil {
+		return x.ChangeRequestApprovalConfig
+	}
+	return nil
+}
+
+func (x *Canvas_Metadata) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+type Canvas_Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*components.Node     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges         []*components.Edge     `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Variables     []*CanvasVariable      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	Environments  []*CanvasEnvironment   `protobuf:"bytes,4,rep,name=environments,proto3" json:"environments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Canvas_Spec) Reset() {
+	*x = Canvas_Spec{}
+	mi := &file_canvases_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canvas_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas_Spec) ProtoMessage() {}
+
+func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
+func (*Canvas_Spec) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{30, 1}
+}
+
+func (x *Canvas_Spec) GetNodes() []*components.Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Canvas_Spec) GetEdges() []*components.Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *Canvas_Spec) GetVariables() []*CanvasVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Canvas_Spec) GetEnvironments() []*CanvasEnvironment {
+	if x != nil {
+		return x.Environments
+	}
+	return nil
+}
+
+type Canvas_Status struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LastExecutions []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=last_executions,json=lastExecutions,proto3" json:"last_executions,omitempty"`
+	NextQueueItems []*CanvasNodeQueueItem `protobuf:"bytes,2,rep,name=next_queue_items,json=nextQueueItems,proto3" json:"next_queue_items,omitempty"`
+	LastEvents     []*CanvasEvent         `protobuf:"bytes,3,rep,name=last_events,json=lastEvents,proto3" json:"last_events,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Canvas_Status) Reset() {
+	*x = Canvas_Status{}
+	mi := &file_canvases_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Canvas_Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canvas_Status) ProtoMessage() {}
+
+func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
+func (*Canvas_Status) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{30, 2}
+}
+
+func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
+	if x != nil {
+		return x.LastExecutions
+	}
+	return nil
+}
+
+func (x *Canvas_Status) GetNextQueueItems() []*CanvasNodeQueueItem {
+	if x != nil {
+		return x.NextQueueItems
 	}
 	return nil
 }
 
-func (x *Canvas_Metadata) GetIsTemplate() bool {
+func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
 	if x != nil {
-		return x.IsTemplate
+		return x.LastEvents
 	}
-	return false
+	return nil
 }
 
-func (x *Canvas_Metadata) GetCanvasVersioningEnabled() bool {
+type CanvasVersion_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	Owner         *UserRef               `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	IsPublished   bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	PublishedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVersion_Metadata) Reset() {
+	*x = CanvasVersion_Metadata{}
+	mi := &file_canvases_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVersion_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVersion_Metadata) ProtoMessage() {}
+
+func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[126]
 	if x != nil {
-		return x.CanvasVersioningEnabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVersion_Metadata.ProtoReflect.Descriptor instead.
+func (*CanvasVersion_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *CanvasVersion_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasVersion_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasVersion_Metadata) GetOwner() *UserRef {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CanvasVersion_Metadata) GetIsPublished() bool {
+	if x != nil {
+		return x.IsPublished
 	}
 	return false
 }
 
-func (x *Canvas_Metadata) GetChangeRequestApprovalConfig() *CanvasChangeRequestApprovalConfig {
+func (x *CanvasVersion_Metadata) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *CanvasVersion_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasVersion_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CanvasVariable_SecretRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasVariable_SecretRef) Reset() {
+	*x = CanvasVariable_SecretRef{}
+	mi := &file_canvases_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasVariable_SecretRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasVariable_SecretRef) ProtoMessage() {}
+
+func (x *CanvasVariable_SecretRef) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasVariable_SecretRef.ProtoReflect.Descriptor instead.
+func (*CanvasVariable_SecretRef) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *CanvasVariable_SecretRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CanvasVariable_SecretRef) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type CanvasChangeRequest_Metadata struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	Id               string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CanvasId         string                     `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
+	VersionId        string                     `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	Owner            *UserRef                   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	BasedOnVersionId string                     `protobuf:"bytes,5,opt,name=based_on_version_id,json=basedOnVersionId,proto3" json:"based_on_version_id,omitempty"`
+	Status           CanvasChangeRequest_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Superplane.Canvases.CanvasChangeRequest_Status" json:"status,omitempty"`
+	PublishedAt      *timestamp.Timestamp       `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt        *timestamp.Timestamp       `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamp.Timestamp       `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Title            string                     `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                     `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	IsConflicted     bool                       `protobuf:"varint,12,opt,name=is_conflicted,json=isConflicted,proto3" json:"is_conflicted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CanvasChangeRequest_Metadata) Reset() {
+	*x = CanvasChangeRequest_Metadata{}
+	mi := &file_canvases_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanvasChangeRequest_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanvasChangeRequest_Metadata) ProtoMessage() {}
+
+func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanvasChangeRequest_Metadata.ProtoReflect.Descriptor instead.
+func (*CanvasChangeRequest_Metadata) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *CanvasChangeRequest_Metadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetCanvasId() string {
+	if x != nil {
+		return x.CanvasId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetOwner() *UserRef {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest_Metadata) GetBasedOnVersionId() string {
+	if x != nil {
+		return x.BasedOnVersionId
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetStatus() CanvasChangeRequest_Status {
+	if x != nil {
+		return x.Status
+	}
+	return CanvasChangeRequest_STATUS_UNSPECIFIED
+}
+
+func (x *CanvasChangeRequest_Metadata) GetPublishedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest_Metadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CanvasChangeRequest_Metadata) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CanvasChangeRequest_Metadata) GetIsConflicted() bool {
 	if x != nil {
-		return x.ChangeRequestApprovalConfig
+		return x.IsConflicted
 	}
-	return nil
+	return false
 }
 
-type Canvas_Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*components.Node     `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Edges         []*components.Edge     `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+type CanvasMemorySchema_Field struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Name          string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CanvasMemorySchema_Field_Type `protobuf:"varint,2,opt,name=type,proto3,enum=Superplane.Canvases.CanvasMemorySchema_Field_Type" json:"type,omitempty"`
+	Required      bool                          `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Canvas_Spec) Reset() {
-	*x = Canvas_Spec{}
-	mi := &file_canvases_proto_msgTypes[83]
+func (x *CanvasMemorySchema_Field) Reset() {
+	*x = CanvasMemorySchema_Field{}
+	mi := &file_canvases_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Spec) String() string {
+func (x *CanvasMemorySchema_Field) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Spec) ProtoMessage() {}
+func (*CanvasMemorySchema_Field) ProtoMessage() {}
 
-func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[83]
+func (x *CanvasMemorySchema_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5389,49 +8731,54 @@ func (x *Canvas_Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Spec.ProtoReflect.Descriptor instead.
-func (*Canvas_Spec) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 1}
+// Deprecated: Use CanvasMemorySchema_Field.ProtoReflect.Descriptor instead.
+func (*CanvasMemorySchema_Field) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67, 0}
 }
 
-func (x *Canvas_Spec) GetNodes() []*components.Node {
+func (x *CanvasMemorySchema_Field) GetName() string {
 	if x != nil {
-		return x.Nodes
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Canvas_Spec) GetEdges() []*components.Edge {
+func (x *CanvasMemorySchema_Field) GetType() CanvasMemorySchema_Field_Type {
 	if x != nil {
-		return x.Edges
+		return x.Type
 	}
-	return nil
+	return CanvasMemorySchema_Field_TYPE_UNSPECIFIED
 }
 
-type Canvas_Status struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	LastExecutions []*CanvasNodeExecution `protobuf:"bytes,1,rep,name=last_executions,json=lastExecutions,proto3" json:"last_executions,omitempty"`
-	NextQueueItems []*CanvasNodeQueueItem `protobuf:"bytes,2,rep,name=next_queue_items,json=nextQueueItems,proto3" json:"next_queue_items,omitempty"`
-	LastEvents     []*CanvasEvent         `protobuf:"bytes,3,rep,name=last_events,json=lastEvents,proto3" json:"last_events,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CanvasMemorySchema_Field) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (x *Canvas_Status) Reset() {
-	*x = Canvas_Status{}
-	mi := &file_canvases_proto_msgTypes[84]
+type CanvasMemorySchema_LookupKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []string               `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanvasMemorySchema_LookupKey) Reset() {
+	*x = CanvasMemorySchema_LookupKey{}
+	mi := &file_canvases_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Canvas_Status) String() string {
+func (x *CanvasMemorySchema_LookupKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Canvas_Status) ProtoMessage() {}
+func (*CanvasMemorySchema_LookupKey) ProtoMessage() {}
 
-func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[84]
+func (x *CanvasMemorySchema_LookupKey) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5442,60 +8789,43 @@ func (x *Canvas_Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Canvas_Status.ProtoReflect.Descriptor instead.
-func (*Canvas_Status) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{30, 2}
-}
-
-func (x *Canvas_Status) GetLastExecutions() []*CanvasNodeExecution {
-	if x != nil {
-		return x.LastExecutions
-	}
-	return nil
-}
-
-func (x *Canvas_Status) GetNextQueueItems() []*CanvasNodeQueueItem {
-	if x != nil {
-		return x.NextQueueItems
-	}
-	return nil
+// Deprecated: Use CanvasMemorySchema_LookupKey.ProtoReflect.Descriptor instead.
+func (*CanvasMemorySchema_LookupKey) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{67, 1}
 }
 
-func (x *Canvas_Status) GetLastEvents() []*CanvasEvent {
+func (x *CanvasMemorySchema_LookupKey) GetFields() []string {
 	if x != nil {
-		return x.LastEvents
+		return x.Fields
 	}
 	return nil
 }
 
-type CanvasVersion_Metadata struct {
+type GetCanvasRetentionReportResponse_Node struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId      string                 `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	Owner         *UserRef               `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	IsPublished   bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
-	PublishedAt   *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Executions    uint32                 `protobuf:"varint,2,opt,name=executions,proto3" json:"executions,omitempty"`
+	Events        uint32                 `protobuf:"varint,3,opt,name=events,proto3" json:"events,omitempty"`
+	QueueItems    uint32                 `protobuf:"varint,4,opt,name=queue_items,json=queueItems,proto3" json:"queue_items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasVersion_Metadata) Reset() {
-	*x = CanvasVersion_Metadata{}
-	mi := &file_canvases_proto_msgTypes[85]
+func (x *GetCanvasRetentionReportResponse_Node) Reset() {
+	*x = GetCanvasRetentionReportResponse_Node{}
+	mi := &file_canvases_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasVersion_Metadata) String() string {
+func (x *GetCanvasRetentionReportResponse_Node) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasVersion_Metadata) ProtoMessage() {}
+func (*GetCanvasRetentionReportResponse_Node) ProtoMessage() {}
 
-func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[85]
+func (x *GetCanvasRetentionReportResponse_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5506,93 +8836,64 @@ func (x *CanvasVersion_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasVersion_Metadata.ProtoReflect.Descriptor instead.
-func (*CanvasVersion_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{31, 0}
-}
-
-func (x *CanvasVersion_Metadata) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetCanvasRetentionReportResponse_Node.ProtoReflect.Descriptor instead.
+func (*GetCanvasRetentionReportResponse_Node) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{76, 0}
 }
 
-func (x *CanvasVersion_Metadata) GetCanvasId() string {
+func (x *GetCanvasRetentionReportResponse_Node) GetNodeId() string {
 	if x != nil {
-		return x.CanvasId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *CanvasVersion_Metadata) GetOwner() *UserRef {
-	if x != nil {
-		return x.Owner
-	}
-	return nil
-}
-
-func (x *CanvasVersion_Metadata) GetIsPublished() bool {
-	if x != nil {
-		return x.IsPublished
-	}
-	return false
-}
-
-func (x *CanvasVersion_Metadata) GetPublishedAt() *timestamp.Timestamp {
+func (x *GetCanvasRetentionReportResponse_Node) GetExecutions() uint32 {
 	if x != nil {
-		return x.PublishedAt
+		return x.Executions
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasVersion_Metadata) GetCreatedAt() *timestamp.Timestamp {
+func (x *GetCanvasRetentionReportResponse_Node) GetEvents() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Events
 	}
-	return nil
+	return 0
 }
 
-func (x *CanvasVersion_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *GetCanvasRetentionReportResponse_Node) GetQueueItems() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.QueueItems
 	}
-	return nil
+	return 0
 }
 
-type CanvasChangeRequest_Metadata struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	Id               string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CanvasId         string                     `protobuf:"bytes,2,opt,name=canvas_id,json=canvasId,proto3" json:"canvas_id,omitempty"`
-	VersionId        string                     `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
-	Owner            *UserRef                   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	BasedOnVersionId string                     `protobuf:"bytes,5,opt,name=based_on_version_id,json=basedOnVersionId,proto3" json:"based_on_version_id,omitempty"`
-	Status           CanvasChangeRequest_Status `protobuf:"varint,6,opt,name=status,proto3,enum=Superplane.Canvases.CanvasChangeRequest_Status" json:"status,omitempty"`
-	PublishedAt      *timestamp.Timestamp       `protobuf:"bytes,7,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
-	CreatedAt        *timestamp.Timestamp       `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamp.Timestamp       `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Title            string                     `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
-	Description      string                     `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
-	IsConflicted     bool                       `protobuf:"varint,12,opt,name=is_conflicted,json=isConflicted,proto3" json:"is_conflicted,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type CanvasBundle_Integration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID nodes in the bundle use to reference the integration.
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IntegrationName string `protobuf:"bytes,3,opt,name=integration_name,json=integrationName,proto3" json:"integration_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequest_Metadata) Reset() {
-	*x = CanvasChangeRequest_Metadata{}
-	mi := &file_canvases_proto_msgTypes[86]
+func (x *CanvasBundle_Integration) Reset() {
+	*x = CanvasBundle_Integration{}
+	mi := &file_canvases_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequest_Metadata) String() string {
+func (x *CanvasBundle_Integration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CanvasChangeRequest_Metadata) ProtoMessage() {}
+func (*CanvasBundle_Integration) ProtoMessage() {}
 
-func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_canvases_proto_msgTypes[86]
+func (x *CanvasBundle_Integration) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5603,100 +8904,89 @@ func (x *CanvasChangeRequest_Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CanvasChangeRequest_Metadata.ProtoReflect.Descriptor instead.
-func (*CanvasChangeRequest_Metadata) Descriptor() ([]byte, []int) {
-	return file_canvases_proto_rawDescGZIP(), []int{36, 0}
+// Deprecated: Use CanvasBundle_Integration.ProtoReflect.Descriptor instead.
+func (*CanvasBundle_Integration) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{111, 0}
 }
 
-func (x *CanvasChangeRequest_Metadata) GetId() string {
+func (x *CanvasBundle_Integration) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CanvasChangeRequest_Metadata) GetCanvasId() string {
+func (x *CanvasBundle_Integration) GetName() string {
 	if x != nil {
-		return x.CanvasId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CanvasChangeRequest_Metadata) GetVersionId() string {
+func (x *CanvasBundle_Integration) GetIntegrationName() string {
 	if x != nil {
-		return x.VersionId
+		return x.IntegrationName
 	}
 	return ""
 }
 
-func (x *CanvasChangeRequest_Metadata) GetOwner() *UserRef {
-	if x != nil {
-		return x.Owner
-	}
-	return nil
-}
-
-func (x *CanvasChangeRequest_Metadata) GetBasedOnVersionId() string {
-	if x != nil {
-		return x.BasedOnVersionId
-	}
-	return ""
+type CanvasBundle_Secret struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CanvasChangeRequest_Metadata) GetStatus() CanvasChangeRequest_Status {
-	if x != nil {
-		return x.Status
-	}
-	return CanvasChangeRequest_STATUS_UNSPECIFIED
+func (x *CanvasBundle_Secret) Reset() {
+	*x = CanvasBundle_Secret{}
+	mi := &file_canvases_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CanvasChangeRequest_Metadata) GetPublishedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.PublishedAt
-	}
-	return nil
+func (x *CanvasBundle_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CanvasChangeRequest_Metadata) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
+func (*CanvasBundle_Secret) ProtoMessage() {}
 
-func (x *CanvasChangeRequest_Metadata) GetUpdatedAt() *timestamp.Timestamp {
+func (x *CanvasBundle_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_canvases_proto_msgTypes[133]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CanvasChangeRequest_Metadata) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use CanvasBundle_Secret.ProtoReflect.Descriptor instead.
+func (*CanvasBundle_Secret) Descriptor() ([]byte, []int) {
+	return file_canvases_proto_rawDescGZIP(), []int{111, 1}
 }
 
-func (x *CanvasChangeRequest_Metadata) GetDescription() string {
+func (x *CanvasBundle_Secret) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CanvasChangeRequest_Metadata) GetIsConflicted() bool {
+func (x *CanvasBundle_Secret) GetKeys() []string {
 	if x != nil {
-		return x.IsConflicted
+		return x.Keys
 	}
-	return false
+	return nil
 }
 
 var File_canvases_proto protoreflect.FileDescriptor
 
 const file_canvases_proto_rawDesc = "" +
 	"\n" +
-	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
+	"\x0ecanvases.proto\x12\x13Superplane.Canvases\x1a\x10components.proto\x1a\x10blueprints.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"B\n" +
 	"\x13ListCanvasesRequest\x12+\n" +
 	"\x11include_templates\x18\x01 \x01(\bR\x10includeTemplates\"O\n" +
 	"\x14ListCanvasesResponse\x127\n" +
@@ -5704,17 +8994,19 @@ const file_canvases_proto_rawDesc = "" +
 	"\x15DescribeCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
 	"\x16DescribeCanvasResponse\x123\n" +
-	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\x83\x03\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"\xba\x03\n" +
 	"\x13UpdateCanvasRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12?\n" +
 	"\x19canvas_versioning_enabled\x18\x04 \x01(\bH\x02R\x17canvasVersioningEnabled\x88\x01\x01\x12\x80\x01\n" +
-	"\x1echange_request_approval_config\x18\x05 \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigH\x03R\x1bchangeRequestApprovalConfig\x88\x01\x01B\a\n" +
+	"\x1echange_request_approval_config\x18\x05 \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigH\x03R\x1bchangeRequestApprovalConfig\x88\x01\x01\x12%\n" +
+	"\venvironment\x18\x06 \x01(\tH\x04R\venvironment\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x1c\n" +
 	"\x1a_canvas_versioning_enabledB!\n" +
-	"\x1f_change_request_approval_config\"K\n" +
+	"\x1f_change_request_approval_configB\x0e\n" +
+	"\f_environment\"K\n" +
 	"\x14UpdateCanvasResponse\x123\n" +
 	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\"J\n" +
 	"\x13CreateCanvasRequest\x123\n" +
@@ -5814,11 +9106,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x14DeleteCanvasResponse\"-\n" +
 	"\aUserRef\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xa8\b\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xda\t\n" +
 	"\x06Canvas\x12@\n" +
 	"\bmetadata\x18\x01 \x01(\v2$.Superplane.Canvases.Canvas.MetadataR\bmetadata\x124\n" +
 	"\x04spec\x18\x02 \x01(\v2 .Superplane.Canvases.Canvas.SpecR\x04spec\x12:\n" +
-	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\x86\x04\n" +
+	"\x06status\x18\x03 \x01(\v2\".Superplane.Canvases.Canvas.StatusR\x06status\x1a\xa8\x04\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
@@ -5834,10 +9126,13 @@ const file_canvases_proto_rawDesc = "" +
 	"isTemplate\x12:\n" +
 	"\x19canvas_versioning_enabled\x18\t \x01(\bR\x17canvasVersioningEnabled\x12{\n" +
 	"\x1echange_request_approval_config\x18\n" +
-	" \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigR\x1bchangeRequestApprovalConfig\x1al\n" +
+	" \x01(\v26.Superplane.Canvases.CanvasChangeRequestApprovalConfigR\x1bchangeRequestApprovalConfig\x12 \n" +
+	"\venvironment\x18\v \x01(\tR\venvironment\x1a\xfb\x01\n" +
 	"\x04Spec\x121\n" +
 	"\x05nodes\x18\x01 \x03(\v2\x1b.Superplane.Components.NodeR\x05nodes\x121\n" +
-	"\x05edges\x18\x02 \x03(\v2\x1b.Superplane.Components.EdgeR\x05edges\x1a\xf2\x01\n" +
+	"\x05edges\x18\x02 \x03(\v2\x1b.Superplane.Components.EdgeR\x05edges\x12A\n" +
+	"\tvariables\x18\x03 \x03(\v2#.Superplane.Canvases.CanvasVariableR\tvariables\x12J\n" +
+	"\fenvironments\x18\x04 \x03(\v2&.Superplane.Canvases.CanvasEnvironmentR\fenvironments\x1a\xf2\x01\n" +
 	"\x06Status\x12Q\n" +
 	"\x0flast_executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0elastExecutions\x12R\n" +
 	"\x10next_queue_items\x18\x02 \x03(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\x0enextQueueItems\x12A\n" +
@@ -5855,7 +9150,18 @@ const file_canvases_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"u\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd6\x01\n" +
+	"\x0eCanvasVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12E\n" +
+	"\x06secret\x18\x04 \x01(\v2-.Superplane.Canvases.CanvasVariable.SecretRefR\x06secret\x1a1\n" +
+	"\tSecretRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"j\n" +
+	"\x11CanvasEnvironment\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
+	"\tvariables\x18\x02 \x03(\v2#.Superplane.Canvases.CanvasVariableR\tvariables\"u\n" +
 	"\x17CanvasChangeRequestDiff\x12(\n" +
 	"\x10changed_node_ids\x18\x01 \x03(\tR\x0echangedNodeIds\x120\n" +
 	"\x14conflicting_node_ids\x18\x02 \x03(\tR\x12conflictingNodeIds\"\xeb\x01\n" +
@@ -5970,8 +9276,7 @@ const file_canvases_proto_rawDesc = "" +
 	"\x1bListChildExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
-	"executions\"\x85\n" +
-	"\n" +
+	"executions\"\xd5\v\n" +
 	"\x13CanvasNodeExecution\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -5994,7 +9299,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x10child_executions\x18\x10 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\x0fchildExecutions\x12?\n" +
 	"\n" +
 	"root_event\x18\x11 \x01(\v2 .Superplane.Canvases.CanvasEventR\trootEvent\x12?\n" +
-	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\"T\n" +
+	"\fcancelled_by\x18\x12 \x01(\v2\x1c.Superplane.Canvases.UserRefR\vcancelledBy\x12\x18\n" +
+	"\aattempt\x18\x13 \x01(\x05R\aattempt\x121\n" +
+	"\x15retry_of_execution_id\x18\x14 \x01(\tR\x12retryOfExecutionId\x121\n" +
+	"\x06run_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\x05runAt\x121\n" +
+	"\x15rerun_of_execution_id\x18\x16 \x01(\tR\x12rerunOfExecutionId\"T\n" +
 	"\x05State\x12\x11\n" +
 	"\rSTATE_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rSTATE_PENDING\x10\x01\x12\x11\n" +
@@ -6004,11 +9313,12 @@ const file_canvases_proto_rawDesc = "" +
 	"\x0eRESULT_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rRESULT_PASSED\x10\x01\x12\x11\n" +
 	"\rRESULT_FAILED\x10\x02\x12\x14\n" +
-	"\x10RESULT_CANCELLED\x10\x03\"_\n" +
+	"\x10RESULT_CANCELLED\x10\x03\"|\n" +
 	"\fResultReason\x12\x14\n" +
 	"\x10RESULT_REASON_OK\x10\x00\x12\x17\n" +
 	"\x13RESULT_REASON_ERROR\x10\x01\x12 \n" +
-	"\x1cRESULT_REASON_ERROR_RESOLVED\x10\x02\"\x86\x02\n" +
+	"\x1cRESULT_REASON_ERROR_RESOLVED\x10\x02\x12\x1b\n" +
+	"\x17RESULT_REASON_TIMED_OUT\x10\x03\"\x86\x02\n" +
 	"\x13CanvasNodeQueueItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -6046,11 +9356,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\rR\n" +
 	"totalCount\x12\"\n" +
 	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
-	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"l\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\xa7\x01\n" +
 	"\fCanvasMemory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12.\n" +
-	"\x06values\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x06values\"8\n" +
+	"\x06values\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x06values\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"8\n" +
 	"\x19ListCanvasMemoriesRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"U\n" +
 	"\x1aListCanvasMemoriesResponse\x127\n" +
@@ -6058,7 +9370,109 @@ const file_canvases_proto_rawDesc = "" +
 	"\x19DeleteCanvasMemoryRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1b\n" +
 	"\tmemory_id\x18\x02 \x01(\tR\bmemoryId\"\x1c\n" +
-	"\x1aDeleteCanvasMemoryResponse\"\xf6\x01\n" +
+	"\x1aDeleteCanvasMemoryResponse\"\xe1\x01\n" +
+	"\x15CanvasMemoryNamespace\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12.\n" +
+	"\x13default_ttl_seconds\x18\x02 \x01(\rR\x11defaultTtlSeconds\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12?\n" +
+	"\x06schema\x18\x04 \x01(\v2'.Superplane.Canvases.CanvasMemorySchemaR\x06schema\"\xe7\x03\n" +
+	"\x12CanvasMemorySchema\x12E\n" +
+	"\x06fields\x18\x01 \x03(\v2-.Superplane.Canvases.CanvasMemorySchema.FieldR\x06fields\x12\x1d\n" +
+	"\n" +
+	"unique_key\x18\x02 \x03(\tR\tuniqueKey\x12R\n" +
+	"\vlookup_keys\x18\x03 \x03(\v21.Superplane.Canvases.CanvasMemorySchema.LookupKeyR\n" +
+	"lookupKeys\x1a\xf1\x01\n" +
+	"\x05Field\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12F\n" +
+	"\x04type\x18\x02 \x01(\x0e22.Superplane.Canvases.CanvasMemorySchema.Field.TypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"p\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vTYPE_STRING\x10\x01\x12\x0f\n" +
+	"\vTYPE_NUMBER\x10\x02\x12\x10\n" +
+	"\fTYPE_BOOLEAN\x10\x03\x12\x0f\n" +
+	"\vTYPE_OBJECT\x10\x04\x12\r\n" +
+	"\tTYPE_LIST\x10\x05\x1a#\n" +
+	"\tLookupKey\x12\x16\n" +
+	"\x06fields\x18\x01 \x03(\tR\x06fields\"@\n" +
+	"!ListCanvasMemoryNamespacesRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"p\n" +
+	"\"ListCanvasMemoryNamespacesResponse\x12J\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2*.Superplane.Canvases.CanvasMemoryNamespaceR\n" +
+	"namespaces\"\xd0\x01\n" +
+	"\"UpdateCanvasMemoryNamespaceRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12.\n" +
+	"\x13default_ttl_seconds\x18\x03 \x01(\rR\x11defaultTtlSeconds\x12?\n" +
+	"\x06schema\x18\x04 \x01(\v2'.Superplane.Canvases.CanvasMemorySchemaR\x06schema\"o\n" +
+	"#UpdateCanvasMemoryNamespaceResponse\x12H\n" +
+	"\tnamespace\x18\x01 \x01(\v2*.Superplane.Canvases.CanvasMemoryNamespaceR\tnamespace\"\xe0\x01\n" +
+	"\x0fRetentionPolicy\x12 \n" +
+	"\fmax_age_days\x18\x01 \x01(\x05R\n" +
+	"maxAgeDays\x125\n" +
+	"\x17max_executions_per_node\x18\x02 \x01(\x05R\x14maxExecutionsPerNode\x12-\n" +
+	"\x13failed_max_age_days\x18\x03 \x01(\x05R\x10failedMaxAgeDays\"E\n" +
+	"\x06Source\x12\x0f\n" +
+	"\vSOURCE_NONE\x10\x00\x12\x17\n" +
+	"\x13SOURCE_ORGANIZATION\x10\x01\x12\x11\n" +
+	"\rSOURCE_CANVAS\x10\x02\"\x92\x01\n" +
+	"\"UpdateCanvasRetentionPolicyRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12O\n" +
+	"\x10retention_policy\x18\x02 \x01(\v2$.Superplane.Canvases.RetentionPolicyR\x0fretentionPolicy\"\xbb\x01\n" +
+	"#UpdateCanvasRetentionPolicyResponse\x12O\n" +
+	"\x10retention_policy\x18\x01 \x01(\v2$.Superplane.Canvases.RetentionPolicyR\x0fretentionPolicy\x12C\n" +
+	"\x06source\x18\x02 \x01(\x0e2+.Superplane.Canvases.RetentionPolicy.SourceR\x06source\">\n" +
+	"\x1fGetCanvasRetentionReportRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"\xdd\x03\n" +
+	" GetCanvasRetentionReportResponse\x12O\n" +
+	"\x10retention_policy\x18\x01 \x01(\v2$.Superplane.Canvases.RetentionPolicyR\x0fretentionPolicy\x12C\n" +
+	"\x06source\x18\x02 \x01(\x0e2+.Superplane.Canvases.RetentionPolicy.SourceR\x06source\x12P\n" +
+	"\x05nodes\x18\x03 \x03(\v2:.Superplane.Canvases.GetCanvasRetentionReportResponse.NodeR\x05nodes\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x04 \x01(\rR\n" +
+	"executions\x12\x16\n" +
+	"\x06events\x18\x05 \x01(\rR\x06events\x12\x1f\n" +
+	"\vqueue_items\x18\x06 \x01(\rR\n" +
+	"queueItems\x1ax\n" +
+	"\x04Node\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"executions\x18\x02 \x01(\rR\n" +
+	"executions\x12\x16\n" +
+	"\x06events\x18\x03 \x01(\rR\x06events\x12\x1f\n" +
+	"\vqueue_items\x18\x04 \x01(\rR\n" +
+	"queueItems\"\xdf\x02\n" +
+	"\x11CanvasRoleBinding\x12U\n" +
+	"\fsubject_type\x18\x01 \x01(\x0e22.Superplane.Canvases.CanvasRoleBinding.SubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tR\tsubjectId\x12!\n" +
+	"\fsubject_name\x18\x03 \x01(\tR\vsubjectName\x123\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1f.Superplane.Canvases.CanvasRoleR\x04role\"|\n" +
+	"\vSubjectType\x12\x1c\n" +
+	"\x18SUBJECT_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SUBJECT_TYPE_USER\x10\x01\x12\x16\n" +
+	"\x12SUBJECT_TYPE_GROUP\x10\x02\x12 \n" +
+	"\x1cSUBJECT_TYPE_SERVICE_ACCOUNT\x10\x03\"<\n" +
+	"\x1dListCanvasRoleBindingsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\"m\n" +
+	"\x1eListCanvasRoleBindingsResponse\x12K\n" +
+	"\rrole_bindings\x18\x01 \x03(\v2&.Superplane.Canvases.CanvasRoleBindingR\froleBindings\"\xe1\x01\n" +
+	"\x17AssignCanvasRoleRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12U\n" +
+	"\fsubject_type\x18\x02 \x01(\x0e22.Superplane.Canvases.CanvasRoleBinding.SubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tR\tsubjectId\x123\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x1f.Superplane.Canvases.CanvasRoleR\x04role\"e\n" +
+	"\x18AssignCanvasRoleResponse\x12I\n" +
+	"\frole_binding\x18\x01 \x01(\v2&.Superplane.Canvases.CanvasRoleBindingR\vroleBinding\"\xac\x01\n" +
+	"\x17RemoveCanvasRoleRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12U\n" +
+	"\fsubject_type\x18\x02 \x01(\x0e22.Superplane.Canvases.CanvasRoleBinding.SubjectTypeR\vsubjectType\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tR\tsubjectId\"\x1a\n" +
+	"\x18RemoveCanvasRoleResponse\"\x98\x02\n" +
 	"\vCanvasEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -6068,7 +9482,9 @@ const file_canvases_proto_rawDesc = "" +
 	"customName\x12+\n" +
 	"\x04data\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x04data\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xce\x02\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12 \n" +
+	"\freplay_of_id\x18\b \x01(\tR\n" +
+	"replayOfId\"\xf0\x02\n" +
 	"\x19CanvasEventWithExecutions\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -6081,18 +9497,91 @@ const file_canvases_proto_rawDesc = "" +
 	"executions\x18\a \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
 	"executions\x12\x1f\n" +
 	"\vcustom_name\x18\b \x01(\tR\n" +
-	"customName\"T\n" +
+	"customName\x12 \n" +
+	"\freplay_of_id\x18\t \x01(\tR\n" +
+	"replayOfId\"T\n" +
 	"\x1aListEventExecutionsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\"g\n" +
 	"\x1bListEventExecutionsResponse\x12H\n" +
 	"\n" +
 	"executions\x18\x01 \x03(\v2(.Superplane.Canvases.CanvasNodeExecutionR\n" +
-	"executions\"X\n" +
+	"executions\"\x85\x03\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\x04type\x18\x02 \x01(\x0e2$.Superplane.Canvases.DeadLetter.TypeR\x04type\x12\x1b\n" +
+	"\tcanvas_id\x18\x03 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x126\n" +
+	"\x05event\x18\x05 \x01(\v2 .Superplane.Canvases.CanvasEventR\x05event\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"A\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"TYPE_EVENT\x10\x01\x12\x13\n" +
+	"\x0fTYPE_QUEUE_ITEM\x10\x02\"\x7f\n" +
+	"\x16ListDeadLettersRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x122\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"\xe5\x01\n" +
+	"\x17ListDeadLettersResponse\x12B\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x1f.Superplane.Canvases.DeadLetterR\vdeadLetters\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"F\n" +
+	"\x17ReplayDeadLetterRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1a\n" +
+	"\x18ReplayDeadLetterResponse\"k\n" +
+	"\x18ReplayCanvasEventRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\"S\n" +
+	"\x19ReplayCanvasEventResponse\x126\n" +
+	"\x05event\x18\x01 \x01(\v2 .Superplane.Canvases.CanvasEventR\x05event\"X\n" +
 	"\x16CancelExecutionRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
 	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"\x19\n" +
-	"\x17CancelExecutionResponse\"a\n" +
+	"\x17CancelExecutionResponse\"W\n" +
+	"\x15RerunExecutionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\"a\n" +
+	"\x16RerunExecutionResponse\x12G\n" +
+	"\n" +
+	"queue_item\x18\x01 \x01(\v2(.Superplane.Canvases.CanvasNodeQueueItemR\tqueueItem\"\xa1\x01\n" +
+	"\x17GetExecutionLogsRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x120\n" +
+	"\x05after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\"\xbc\x02\n" +
+	"\x18GetExecutionLogsResponse\x12?\n" +
+	"\x04logs\x18\x01 \x03(\v2+.Superplane.Canvases.CanvasNodeExecutionLogR\x04logs\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\"\n" +
+	"\rhas_next_page\x18\x03 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\x12W\n" +
+	"\x0fexecution_state\x18\x05 \x01(\x0e2..Superplane.Canvases.CanvasNodeExecution.StateR\x0eexecutionState\"\x91\x03\n" +
+	"\x16CanvasNodeExecutionLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fexecution_id\x18\x02 \x01(\tR\vexecutionId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12G\n" +
+	"\x05level\x18\x04 \x01(\x0e21.Superplane.Canvases.CanvasNodeExecutionLog.LevelR\x05level\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12/\n" +
+	"\x06fields\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06fields\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\\\n" +
+	"\x05Level\x12\x11\n" +
+	"\rLEVEL_UNKNOWN\x10\x00\x12\x0f\n" +
+	"\vLEVEL_DEBUG\x10\x01\x12\x0e\n" +
+	"\n" +
+	"LEVEL_INFO\x10\x02\x12\x0e\n" +
+	"\n" +
+	"LEVEL_WARN\x10\x03\x12\x0f\n" +
+	"\vLEVEL_ERROR\x10\x04\"a\n" +
 	"\x1dResolveExecutionErrorsRequest\x12\x1b\n" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12#\n" +
 	"\rexecution_ids\x18\x02 \x03(\tR\fexecutionIds\" \n" +
@@ -6117,7 +9606,71 @@ const file_canvases_proto_rawDesc = "" +
 	"\x11assistant_message\x18\x01 \x01(\tR\x10assistantMessage\x127\n" +
 	"\n" +
 	"operations\x18\x02 \x03(\v2\x17.google.protobuf.StructR\n" +
-	"operations\"\x98\x01\n" +
+	"operations\"\xee\x01\n" +
+	"\x19EvaluateExpressionRequest\x12\x1b\n" +
+	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x12=\n" +
+	"\rconfiguration\x18\x04 \x01(\v2\x17.google.protobuf.StructR\rconfiguration\x12\x19\n" +
+	"\bevent_id\x18\x05 \x01(\tR\aeventId\x12!\n" +
+	"\fexecution_id\x18\x06 \x01(\tR\vexecutionId\"\xcc\x01\n" +
+	"\x1aEvaluateExpressionResponse\x12,\n" +
+	"\x05value\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x05value\x12=\n" +
+	"\rconfiguration\x18\x02 \x01(\v2\x17.google.protobuf.StructR\rconfiguration\x12)\n" +
+	"\x10referenced_nodes\x18\x03 \x03(\tR\x0freferencedNodes\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\xd3\x03\n" +
+	"\fCanvasBundle\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\x05R\rformatVersion\x123\n" +
+	"\x06canvas\x18\x02 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12@\n" +
+	"\n" +
+	"blueprints\x18\x03 \x03(\v2 .Superplane.Blueprints.BlueprintR\n" +
+	"blueprints\x12Q\n" +
+	"\fintegrations\x18\x04 \x03(\v2-.Superplane.Canvases.CanvasBundle.IntegrationR\fintegrations\x12B\n" +
+	"\asecrets\x18\x05 \x03(\v2(.Superplane.Canvases.CanvasBundle.SecretR\asecrets\x1a\\\n" +
+	"\vIntegration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10integration_name\x18\x03 \x01(\tR\x0fintegrationName\x1a0\n" +
+	"\x06Secret\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\"\xf1\x01\n" +
+	"\x16CanvasBundleDependency\x12D\n" +
+	"\x04type\x18\x01 \x01(\x0e20.Superplane.Canvases.CanvasBundleDependency.TypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"S\n" +
+	"\x04Type\x12\x10\n" +
+	"\fTYPE_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10TYPE_INTEGRATION\x10\x01\x12\x0f\n" +
+	"\vTYPE_SECRET\x10\x02\x12\x12\n" +
+	"\x0eTYPE_BLUEPRINT\x10\x03\"+\n" +
+	"\x19ExportCanvasBundleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x1aExportCanvasBundleResponse\x129\n" +
+	"\x06bundle\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasBundleR\x06bundle\"\xb3\x02\n" +
+	"\x19ImportCanvasBundleRequest\x129\n" +
+	"\x06bundle\x18\x01 \x01(\v2!.Superplane.Canvases.CanvasBundleR\x06bundle\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12k\n" +
+	"\x0fintegration_ids\x18\x03 \x03(\v2B.Superplane.Canvases.ImportCanvasBundleRequest.IntegrationIdsEntryR\x0eintegrationIds\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x1aA\n" +
+	"\x13IntegrationIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x03\n" +
+	"\x1aImportCanvasBundleResponse\x123\n" +
+	"\x06canvas\x18\x01 \x01(\v2\x1b.Superplane.Canvases.CanvasR\x06canvas\x12K\n" +
+	"\n" +
+	"unresolved\x18\x02 \x03(\v2+.Superplane.Canvases.CanvasBundleDependencyR\n" +
+	"unresolved\x12l\n" +
+	"\x0fintegration_ids\x18\x03 \x03(\v2C.Superplane.Canvases.ImportCanvasBundleResponse.IntegrationIdsEntryR\x0eintegrationIds\x12f\n" +
+	"\rblueprint_ids\x18\x04 \x03(\v2A.Superplane.Canvases.ImportCanvasBundleResponse.BlueprintIdsEntryR\fblueprintIds\x1aA\n" +
+	"\x13IntegrationIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11BlueprintIdsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x01\n" +
 	"\x16CanvasNodeEventMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -6127,7 +9680,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
 	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xc2\x01\n" +
+	"\x1dCanvasNodeExecutionLogMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12!\n" +
+	"\fexecution_id\x18\x03 \x01(\tR\vexecutionId\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
 	"\x1aCanvasNodeQueueItemMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcanvas_id\x18\x02 \x01(\tR\bcanvasId\x12\x17\n" +
@@ -6141,7 +9700,13 @@ const file_canvases_proto_rawDesc = "" +
 	"\tcanvas_id\x18\x01 \x01(\tR\bcanvasId\x12\x1d\n" +
 	"\n" +
 	"version_id\x18\x02 \x01(\tR\tversionId\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xcb@\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp*s\n" +
+	"\n" +
+	"CanvasRole\x12\x1b\n" +
+	"\x17CANVAS_ROLE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CANVAS_ROLE_VIEWER\x10\x01\x12\x18\n" +
+	"\x14CANVAS_ROLE_OPERATOR\x10\x02\x12\x16\n" +
+	"\x12CANVAS_ROLE_EDITOR\x10\x032\x87e\n" +
 	"\bCanvases\x12\xb7\x01\n" +
 	"\fListCanvases\x12(.Superplane.Canvases.ListCanvasesRequest\x1a).Superplane.Canvases.ListCanvasesResponse\"R\x92A7\n" +
 	"\x06Canvas\x12\rList canvases\x1a\x1eReturns a list of all canvases\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/canvases\x12\xb0\x01\n" +
@@ -6197,7 +9762,11 @@ const file_canvases_proto_rawDesc = "" +
 	"\x13ListChildExecutions\x12/.Superplane.Canvases.ListChildExecutionsRequest\x1a0.Superplane.Canvases.ListChildExecutionsResponse\"\xb2\x01\x92Ae\n" +
 	"\x13CanvasNodeExecution\x12&List child executions for an execution\x1a&List child executions for an execution\x82\xd3\xe4\x93\x02D:\x01*\"?/api/v1/canvases/{canvas_id}/executions/{execution_id}/children\x12\x8a\x02\n" +
 	"\x0fCancelExecution\x12+.Superplane.Canvases.CancelExecutionRequest\x1a,.Superplane.Canvases.CancelExecutionResponse\"\x9b\x01\x92AP\n" +
-	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xa0\x02\n" +
+	"\x13CanvasNodeExecution\x12\x10Cancel execution\x1a'Cancels a running canvas node execution\x82\xd3\xe4\x93\x02B:\x01*2=/api/v1/canvases/{canvas_id}/executions/{execution_id}/cancel\x12\xb5\x02\n" +
+	"\x0eRerunExecution\x12*.Superplane.Canvases.RerunExecutionRequest\x1a+.Superplane.Canvases.RerunExecutionResponse\"\xc9\x01\x92A\x7f\n" +
+	"\x13CanvasNodeExecution\x12\x0fRerun execution\x1aWRe-runs a finished execution with its original input and the current node configuration\x82\xd3\xe4\x93\x02A:\x01*\"</api/v1/canvases/{canvas_id}/executions/{execution_id}/rerun\x12\xa9\x02\n" +
+	"\x10GetExecutionLogs\x12,.Superplane.Canvases.GetExecutionLogsRequest\x1a-.Superplane.Canvases.GetExecutionLogsResponse\"\xb7\x01\x92Aq\n" +
+	"\x13CanvasNodeExecution\x12\x12Get execution logs\x1aFReturns the log lines written by a canvas node execution, oldest first\x82\xd3\xe4\x93\x02=\x12;/api/v1/canvases/{canvas_id}/executions/{execution_id}/logs\x12\xa0\x02\n" +
 	"\x16ResolveExecutionErrors\x122.Superplane.Canvases.ResolveExecutionErrorsRequest\x1a3.Superplane.Canvases.ResolveExecutionErrorsResponse\"\x9c\x01\x92A_\n" +
 	"\x13CanvasNodeExecution\x12\x18Resolve execution errors\x1a.Marks canvas node execution errors as resolved\x82\xd3\xe4\x93\x024:\x01*2//api/v1/canvases/{canvas_id}/executions/resolve\x12\x86\x02\n" +
 	"\x10ListCanvasEvents\x12,.Superplane.Canvases.ListCanvasEventsRequest\x1a-.Superplane.Canvases.ListCanvasEventsResponse\"\x94\x01\x92Af\n" +
@@ -6205,11 +9774,37 @@ const file_canvases_proto_rawDesc = "" +
 	"\x12ListCanvasMemories\x12..Superplane.Canvases.ListCanvasMemoriesRequest\x1a/.Superplane.Canvases.ListCanvasMemoriesResponse\"}\x92AO\n" +
 	"\x06Canvas\x12\x14List canvas memories\x1a/Returns append-only memory records for a canvas\x82\xd3\xe4\x93\x02%\x12#/api/v1/canvases/{canvas_id}/memory\x12\x85\x02\n" +
 	"\x12DeleteCanvasMemory\x12..Superplane.Canvases.DeleteCanvasMemoryRequest\x1a/.Superplane.Canvases.DeleteCanvasMemoryResponse\"\x8d\x01\x92AS\n" +
-	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\xa4\x02\n" +
+	"\x06Canvas\x12\x1aDelete canvas memory entry\x1a-Deletes one memory record by ID from a canvas\x82\xd3\xe4\x93\x021*//api/v1/canvases/{canvas_id}/memory/{memory_id}\x12\xaf\x02\n" +
+	"\x1aListCanvasMemoryNamespaces\x126.Superplane.Canvases.ListCanvasMemoryNamespacesRequest\x1a7.Superplane.Canvases.ListCanvasMemoryNamespacesResponse\"\x9f\x01\x92Af\n" +
+	"\x06Canvas\x12\x1dList canvas memory namespaces\x1a=Returns the memory namespaces of a canvas which have settings\x82\xd3\xe4\x93\x020\x12./api/v1/canvases/{canvas_id}/memory-namespaces\x12\xe4\x02\n" +
+	"\x1bUpdateCanvasMemoryNamespace\x127.Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest\x1a8.Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse\"\xd1\x01\x92A\x88\x01\n" +
+	"\x06Canvas\x12\x1eUpdate canvas memory namespace\x1a^Updates the settings of a memory namespace, like the default TTL and the schema of its records\x82\xd3\xe4\x93\x02?:\x01*\x1a:/api/v1/canvases/{canvas_id}/memory-namespaces/{namespace}\x12\xdf\x02\n" +
+	"\x1bUpdateCanvasRetentionPolicy\x127.Superplane.Canvases.UpdateCanvasRetentionPolicyRequest\x1a8.Superplane.Canvases.UpdateCanvasRetentionPolicyResponse\"\xcc\x01\x92A\x90\x01\n" +
+	"\x06Canvas\x12\x1eUpdate canvas retention policy\x1afOverrides the organization retention policy for a canvas, or inherits it again when no policy is given\x82\xd3\xe4\x93\x022:\x01*\x1a-/api/v1/canvases/{canvas_id}/retention-policy\x12\xd9\x02\n" +
+	"\x18GetCanvasRetentionReport\x124.Superplane.Canvases.GetCanvasRetentionReportRequest\x1a5.Superplane.Canvases.GetCanvasRetentionReportResponse\"\xcf\x01\x92A\x96\x01\n" +
+	"\x06Canvas\x12\x1bGet canvas retention report\x1aoReturns the retention policy applied to a canvas, and what it would delete right now, without deleting anything\x82\xd3\xe4\x93\x02/\x12-/api/v1/canvases/{canvas_id}/retention-report\x12\xa7\x02\n" +
+	"\x16ListCanvasRoleBindings\x122.Superplane.Canvases.ListCanvasRoleBindingsRequest\x1a3.Superplane.Canvases.ListCanvasRoleBindingsResponse\"\xa3\x01\x92An\n" +
+	"\x06Canvas\x12\x19List canvas role bindings\x1aIReturns the roles bound to users, groups and service accounts on a canvas\x82\xd3\xe4\x93\x02,\x12*/api/v1/canvases/{canvas_id}/role-bindings\x12\xad\x02\n" +
+	"\x10AssignCanvasRole\x12,.Superplane.Canvases.AssignCanvasRoleRequest\x1a-.Superplane.Canvases.AssignCanvasRoleResponse\"\xbb\x01\x92A\x82\x01\n" +
+	"\x06Canvas\x12\x12Assign canvas role\x1adBinds a canvas role to a user, group or service account, replacing the role previously bound to them\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/v1/canvases/{canvas_id}/role-bindings\x12\x86\x02\n" +
+	"\x10RemoveCanvasRole\x12,.Superplane.Canvases.RemoveCanvasRoleRequest\x1a-.Superplane.Canvases.RemoveCanvasRoleResponse\"\x94\x01\x92A_\n" +
+	"\x06Canvas\x12\x12Remove canvas role\x1aARemoves the canvas role bound to a user, group or service account\x82\xd3\xe4\x93\x02,**/api/v1/canvases/{canvas_id}/role-bindings\x12\xa4\x02\n" +
 	"\x13ListEventExecutions\x12/.Superplane.Canvases.ListEventExecutionsRequest\x1a0.Superplane.Canvases.ListEventExecutionsResponse\"\xa9\x01\x92Ae\n" +
 	"\vCanvasEvent\x12\x15List event executions\x1a?Returns a list of all node executions triggered by a root event\x82\xd3\xe4\x93\x02;\x129/api/v1/canvases/{canvas_id}/events/{event_id}/executions\x12\x9b\x02\n" +
 	"\rSendAiMessage\x12).Superplane.Canvases.SendAiMessageRequest\x1a*.Superplane.Canvases.SendAiMessageResponse\"\xb2\x01\x92A|\n" +
-	"\x06Canvas\x12\x1bGenerate AI canvas proposal\x1aUGenerates a structured, non-persistent canvas proposal from a natural language prompt\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/canvases/{canvas_id}/ai/messagesB\xc8\x01\x92A\x8c\x01\x12b\n" +
+	"\x06Canvas\x12\x1bGenerate AI canvas proposal\x1aUGenerates a structured, non-persistent canvas proposal from a natural language prompt\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/canvases/{canvas_id}/ai/messages\x12\xb6\x02\n" +
+	"\x12EvaluateExpression\x12..Superplane.Canvases.EvaluateExpressionRequest\x1a/.Superplane.Canvases.EvaluateExpressionResponse\"\xbe\x01\x92A{\n" +
+	"\x06Canvas\x12\x13Evaluate expression\x1a\\Resolves an expression or a configuration for a node, against an existing event or execution\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/canvases/{canvas_id}/nodes/{node_id}/evaluate\x12\x97\x02\n" +
+	"\x0fListDeadLetters\x12+.Superplane.Canvases.ListDeadLettersRequest\x1a,.Superplane.Canvases.ListDeadLettersResponse\"\xa8\x01\x92At\n" +
+	"\vCanvasEvent\x12\x11List dead letters\x1aRReturns events and queue items that could not be processed after too many attempts\x82\xd3\xe4\x93\x02+\x12)/api/v1/canvases/{canvas_id}/dead-letters\x12\xab\x02\n" +
+	"\x10ReplayDeadLetter\x12,.Superplane.Canvases.ReplayDeadLetterRequest\x1a-.Superplane.Canvases.ReplayDeadLetterResponse\"\xb9\x01\x92Av\n" +
+	"\vCanvasEvent\x12\x12Replay dead letter\x1aSMoves a dead-lettered event or queue item back to pending, so it is processed again\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/canvases/{canvas_id}/dead-letters/{id}/replay\x12\xbd\x02\n" +
+	"\x11ReplayCanvasEvent\x12-.Superplane.Canvases.ReplayCanvasEventRequest\x1a..Superplane.Canvases.ReplayCanvasEventResponse\"\xc8\x01\x92A\x84\x01\n" +
+	"\vCanvasEvent\x12\x13Replay canvas event\x1a`Re-routes a root event through the live canvas version, optionally starting from a specific node\x82\xd3\xe4\x93\x02::\x01*\"5/api/v1/canvases/{canvas_id}/events/{event_id}/replay\x12\xac\x02\n" +
+	"\x12ExportCanvasBundle\x12..Superplane.Canvases.ExportCanvasBundleRequest\x1a/.Superplane.Canvases.ExportCanvasBundleResponse\"\xb4\x01\x92A\x8c\x01\n" +
+	"\x06Canvas\x12\x14Export canvas bundle\x1alReturns a portable bundle with the canvas, the blueprints it uses, and the integrations and secrets it needs\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/canvases/{id}/bundle\x12\xab\x02\n" +
+	"\x12ImportCanvasBundle\x12..Superplane.Canvases.ImportCanvasBundleRequest\x1a/.Superplane.Canvases.ImportCanvasBundleResponse\"\xb3\x01\x92A\x8d\x01\n" +
+	"\x06Canvas\x12\x14Import canvas bundle\x1amCreates a canvas from a bundle, remapping integrations and reporting dependencies missing in the organization\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/canvases/importB\xc8\x01\x92A\x8c\x01\x12b\n" +
 	"\x17Superplane Canvases API\x12\x1bAPI for Superplane canvases\"%\n" +
 	"\vAPI Support\x1a\x16support@superplane.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZ6github.com/superplanehq/superplane/pkg/protos/canvasesb\x06proto3"
 
@@ -6225,290 +9820,436 @@ func file_canvases_proto_rawDescGZIP() []byte {
 	return file_canvases_proto_rawDescData
 }
 
-var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_canvases_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_canvases_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
 var file_canvases_proto_goTypes = []any{
-	(CanvasAutoLayout_Algorithm)(0),             // 0: Superplane.Canvases.CanvasAutoLayout.Algorithm
-	(CanvasAutoLayout_Scope)(0),                 // 1: Superplane.Canvases.CanvasAutoLayout.Scope
-	(ActOnCanvasChangeRequestRequest_Action)(0), // 2: Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
-	(CanvasChangeRequestApprover_Type)(0),       // 3: Superplane.Canvases.CanvasChangeRequestApprover.Type
-	(CanvasChangeRequestApproval_State)(0),      // 4: Superplane.Canvases.CanvasChangeRequestApproval.State
-	(CanvasChangeRequest_Status)(0),             // 5: Superplane.Canvases.CanvasChangeRequest.Status
-	(CanvasNodeExecution_State)(0),              // 6: Superplane.Canvases.CanvasNodeExecution.State
-	(CanvasNodeExecution_Result)(0),             // 7: Superplane.Canvases.CanvasNodeExecution.Result
-	(CanvasNodeExecution_ResultReason)(0),       // 8: Superplane.Canvases.CanvasNodeExecution.ResultReason
-	(*ListCanvasesRequest)(nil),                 // 9: Superplane.Canvases.ListCanvasesRequest
-	(*ListCanvasesResponse)(nil),                // 10: Superplane.Canvases.ListCanvasesResponse
-	(*DescribeCanvasRequest)(nil),               // 11: Superplane.Canvases.DescribeCanvasRequest
-	(*DescribeCanvasResponse)(nil),              // 12: Superplane.Canvases.DescribeCanvasResponse
-	(*UpdateCanvasRequest)(nil),                 // 13: Superplane.Canvases.UpdateCanvasRequest
-	(*UpdateCanvasResponse)(nil),                // 14: Superplane.Canvases.UpdateCanvasResponse
-	(*CreateCanvasRequest)(nil),                 // 15: Superplane.Canvases.CreateCanvasRequest
-	(*CreateCanvasResponse)(nil),                // 16: Superplane.Canvases.CreateCanvasResponse
-	(*CanvasAutoLayout)(nil),                    // 17: Superplane.Canvases.CanvasAutoLayout
-	(*CreateCanvasVersionRequest)(nil),          // 18: Superplane.Canvases.CreateCanvasVersionRequest
-	(*CreateCanvasVersionResponse)(nil),         // 19: Superplane.Canvases.CreateCanvasVersionResponse
-	(*ListCanvasVersionsRequest)(nil),           // 20: Superplane.Canvases.ListCanvasVersionsRequest
-	(*ListCanvasVersionsResponse)(nil),          // 21: Superplane.Canvases.ListCanvasVersionsResponse
-	(*DescribeCanvasVersionRequest)(nil),        // 22: Superplane.Canvases.DescribeCanvasVersionRequest
-	(*DescribeCanvasVersionResponse)(nil),       // 23: Superplane.Canvases.DescribeCanvasVersionResponse
-	(*UpdateCanvasVersionRequest)(nil),          // 24: Superplane.Canvases.UpdateCanvasVersionRequest
-	(*UpdateCanvasVersionResponse)(nil),         // 25: Superplane.Canvases.UpdateCanvasVersionResponse
-	(*CreateCanvasChangeRequestRequest)(nil),    // 26: Superplane.Canvases.CreateCanvasChangeRequestRequest
-	(*CreateCanvasChangeRequestResponse)(nil),   // 27: Superplane.Canvases.CreateCanvasChangeRequestResponse
-	(*ListCanvasChangeRequestsRequest)(nil),     // 28: Superplane.Canvases.ListCanvasChangeRequestsRequest
-	(*ListCanvasChangeRequestsResponse)(nil),    // 29: Superplane.Canvases.ListCanvasChangeRequestsResponse
-	(*DescribeCanvasChangeRequestRequest)(nil),  // 30: Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	(*DescribeCanvasChangeRequestResponse)(nil), // 31: Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	(*ActOnCanvasChangeRequestRequest)(nil),     // 32: Superplane.Canvases.ActOnCanvasChangeRequestRequest
-	(*ActOnCanvasChangeRequestResponse)(nil),    // 33: Superplane.Canvases.ActOnCanvasChangeRequestResponse
-	(*ResolveCanvasChangeRequestRequest)(nil),   // 34: Superplane.Canvases.ResolveCanvasChangeRequestRequest
-	(*ResolveCanvasChangeRequestResponse)(nil),  // 35: Superplane.Canvases.ResolveCanvasChangeRequestResponse
-	(*DeleteCanvasRequest)(nil),                 // 36: Superplane.Canvases.DeleteCanvasRequest
-	(*DeleteCanvasResponse)(nil),                // 37: Superplane.Canvases.DeleteCanvasResponse
-	(*UserRef)(nil),                             // 38: Superplane.Canvases.UserRef
-	(*Canvas)(nil),                              // 39: Superplane.Canvases.Canvas
-	(*CanvasVersion)(nil),                       // 40: Superplane.Canvases.CanvasVersion
-	(*CanvasChangeRequestDiff)(nil),             // 41: Superplane.Canvases.CanvasChangeRequestDiff
-	(*CanvasChangeRequestApprover)(nil),         // 42: Superplane.Canvases.CanvasChangeRequestApprover
-	(*CanvasChangeRequestApprovalConfig)(nil),   // 43: Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	(*CanvasChangeRequestApproval)(nil),         // 44: Superplane.Canvases.CanvasChangeRequestApproval
-	(*CanvasChangeRequest)(nil),                 // 45: Superplane.Canvases.CanvasChangeRequest
-	(*ListNodeEventsRequest)(nil),               // 46: Superplane.Canvases.ListNodeEventsRequest
-	(*ListNodeEventsResponse)(nil),              // 47: Superplane.Canvases.ListNodeEventsResponse
-	(*EmitNodeEventRequest)(nil),                // 48: Superplane.Canvases.EmitNodeEventRequest
-	(*EmitNodeEventResponse)(nil),               // 49: Superplane.Canvases.EmitNodeEventResponse
-	(*ListNodeQueueItemsRequest)(nil),           // 50: Superplane.Canvases.ListNodeQueueItemsRequest
-	(*ListNodeQueueItemsResponse)(nil),          // 51: Superplane.Canvases.ListNodeQueueItemsResponse
-	(*DeleteNodeQueueItemRequest)(nil),          // 52: Superplane.Canvases.DeleteNodeQueueItemRequest
-	(*DeleteNodeQueueItemResponse)(nil),         // 53: Superplane.Canvases.DeleteNodeQueueItemResponse
-	(*UpdateNodePauseRequest)(nil),              // 54: Superplane.Canvases.UpdateNodePauseRequest
-	(*UpdateNodePauseResponse)(nil),             // 55: Superplane.Canvases.UpdateNodePauseResponse
-	(*ListNodeExecutionsRequest)(nil),           // 56: Superplane.Canvases.ListNodeExecutionsRequest
-	(*ListNodeExecutionsResponse)(nil),          // 57: Superplane.Canvases.ListNodeExecutionsResponse
-	(*ListChildExecutionsRequest)(nil),          // 58: Superplane.Canvases.ListChildExecutionsRequest
-	(*ListChildExecutionsResponse)(nil),         // 59: Superplane.Canvases.ListChildExecutionsResponse
-	(*CanvasNodeExecution)(nil),                 // 60: Superplane.Canvases.CanvasNodeExecution
-	(*CanvasNodeQueueItem)(nil),                 // 61: Superplane.Canvases.CanvasNodeQueueItem
-	(*InvokeNodeExecutionActionRequest)(nil),    // 62: Superplane.Canvases.InvokeNodeExecutionActionRequest
-	(*InvokeNodeExecutionActionResponse)(nil),   // 63: Superplane.Canvases.InvokeNodeExecutionActionResponse
-	(*InvokeNodeTriggerActionRequest)(nil),      // 64: Superplane.Canvases.InvokeNodeTriggerActionRequest
-	(*InvokeNodeTriggerActionResponse)(nil),     // 65: Superplane.Canvases.InvokeNodeTriggerActionResponse
-	(*ListCanvasEventsRequest)(nil),             // 66: Superplane.Canvases.ListCanvasEventsRequest
-	(*ListCanvasEventsResponse)(nil),            // 67: Superplane.Canvases.ListCanvasEventsResponse
-	(*CanvasMemory)(nil),                        // 68: Superplane.Canvases.CanvasMemory
-	(*ListCanvasMemoriesRequest)(nil),           // 69: Superplane.Canvases.ListCanvasMemoriesRequest
-	(*ListCanvasMemoriesResponse)(nil),          // 70: Superplane.Canvases.ListCanvasMemoriesResponse
-	(*DeleteCanvasMemoryRequest)(nil),           // 71: Superplane.Canvases.DeleteCanvasMemoryRequest
-	(*DeleteCanvasMemoryResponse)(nil),          // 72: Superplane.Canvases.DeleteCanvasMemoryResponse
-	(*CanvasEvent)(nil),                         // 73: Superplane.Canvases.CanvasEvent
-	(*CanvasEventWithExecutions)(nil),           // 74: Superplane.Canvases.CanvasEventWithExecutions
-	(*ListEventExecutionsRequest)(nil),          // 75: Superplane.Canvases.ListEventExecutionsRequest
-	(*ListEventExecutionsResponse)(nil),         // 76: Superplane.Canvases.ListEventExecutionsResponse
-	(*CancelExecutionRequest)(nil),              // 77: Superplane.Canvases.CancelExecutionRequest
-	(*CancelExecutionResponse)(nil),             // 78: Superplane.Canvases.CancelExecutionResponse
-	(*ResolveExecutionErrorsRequest)(nil),       // 79: Superplane.Canvases.ResolveExecutionErrorsRequest
-	(*ResolveExecutionErrorsResponse)(nil),      // 80: Superplane.Canvases.ResolveExecutionErrorsResponse
-	(*CanvasAiNodeContext)(nil),                 // 81: Superplane.Canvases.CanvasAiNodeContext
-	(*CanvasAiBlockContext)(nil),                // 82: Superplane.Canvases.CanvasAiBlockContext
-	(*CanvasAiContext)(nil),                     // 83: Superplane.Canvases.CanvasAiContext
-	(*SendAiMessageRequest)(nil),                // 84: Superplane.Canvases.SendAiMessageRequest
-	(*SendAiMessageResponse)(nil),               // 85: Superplane.Canvases.SendAiMessageResponse
-	(*CanvasNodeEventMessage)(nil),              // 86: Superplane.Canvases.CanvasNodeEventMessage
-	(*CanvasNodeExecutionMessage)(nil),          // 87: Superplane.Canvases.CanvasNodeExecutionMessage
-	(*CanvasNodeQueueItemMessage)(nil),          // 88: Superplane.Canvases.CanvasNodeQueueItemMessage
-	(*CanvasMessage)(nil),                       // 89: Superplane.Canvases.CanvasMessage
-	(*CanvasVersionMessage)(nil),                // 90: Superplane.Canvases.CanvasVersionMessage
-	(*Canvas_Metadata)(nil),                     // 91: Superplane.Canvases.Canvas.Metadata
-	(*Canvas_Spec)(nil),                         // 92: Superplane.Canvases.Canvas.Spec
-	(*Canvas_Status)(nil),                       // 93: Superplane.Canvases.Canvas.Status
-	(*CanvasVersion_Metadata)(nil),              // 94: Superplane.Canvases.CanvasVersion.Metadata
-	(*CanvasChangeRequest_Metadata)(nil),        // 95: Superplane.Canvases.CanvasChangeRequest.Metadata
-	(*timestamp.Timestamp)(nil),                 // 96: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                      // 97: google.protobuf.Struct
-	(*components.Node)(nil),                     // 98: Superplane.Components.Node
-	(*_struct.Value)(nil),                       // 99: google.protobuf.Value
-	(*components.Edge)(nil),                     // 100: Superplane.Components.Edge
+	(CanvasRole)(0),                               // 0: Superplane.Canvases.CanvasRole
+	(CanvasAutoLayout_Algorithm)(0),               // 1: Superplane.Canvases.CanvasAutoLayout.Algorithm
+	(CanvasAutoLayout_Scope)(0),                   // 2: Superplane.Canvases.CanvasAutoLayout.Scope
+	(ActOnCanvasChangeRequestRequest_Action)(0),   // 3: Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
+	(CanvasChangeRequestApprover_Type)(0),         // 4: Superplane.Canvases.CanvasChangeRequestApprover.Type
+	(CanvasChangeRequestApproval_State)(0),        // 5: Superplane.Canvases.CanvasChangeRequestApproval.State
+	(CanvasChangeRequest_Status)(0),               // 6: Superplane.Canvases.CanvasChangeRequest.Status
+	(CanvasNodeExecution_State)(0),                // 7: Superplane.Canvases.CanvasNodeExecution.State
+	(CanvasNodeExecution_Result)(0),               // 8: Superplane.Canvases.CanvasNodeExecution.Result
+	(CanvasNodeExecution_ResultReason)(0),         // 9: Superplane.Canvases.CanvasNodeExecution.ResultReason
+	(CanvasMemorySchema_Field_Type)(0),            // 10: Superplane.Canvases.CanvasMemorySchema.Field.Type
+	(RetentionPolicy_Source)(0),                   // 11: Superplane.Canvases.RetentionPolicy.Source
+	(CanvasRoleBinding_SubjectType)(0),            // 12: Superplane.Canvases.CanvasRoleBinding.SubjectType
+	(DeadLetter_Type)(0),                          // 13: Superplane.Canvases.DeadLetter.Type
+	(CanvasNodeExecutionLog_Level)(0),             // 14: Superplane.Canvases.CanvasNodeExecutionLog.Level
+	(CanvasBundleDependency_Type)(0),              // 15: Superplane.Canvases.CanvasBundleDependency.Type
+	(*ListCanvasesRequest)(nil),                   // 16: Superplane.Canvases.ListCanvasesRequest
+	(*ListCanvasesResponse)(nil),                  // 17: Superplane.Canvases.ListCanvasesResponse
+	(*DescribeCanvasRequest)(nil),                 // 18: Superplane.Canvases.DescribeCanvasRequest
+	(*DescribeCanvasResponse)(nil),                // 19: Superplane.Canvases.DescribeCanvasResponse
+	(*UpdateCanvasRequest)(nil),                   // 20: Superplane.Canvases.UpdateCanvasRequest
+	(*UpdateCanvasResponse)(nil),                  // 21: Superplane.Canvases.UpdateCanvasResponse
+	(*CreateCanvasRequest)(nil),                   // 22: Superplane.Canvases.CreateCanvasRequest
+	(*CreateCanvasResponse)(nil),                  // 23: Superplane.Canvases.CreateCanvasResponse
+	(*CanvasAutoLayout)(nil),                      // 24: Superplane.Canvases.CanvasAutoLayout
+	(*CreateCanvasVersionRequest)(nil),            // 25: Superplane.Canvases.CreateCanvasVersionRequest
+	(*CreateCanvasVersionResponse)(nil),           // 26: Superplane.Canvases.CreateCanvasVersionResponse
+	(*ListCanvasVersionsRequest)(nil),             // 27: Superplane.Canvases.ListCanvasVersionsRequest
+	(*ListCanvasVersionsResponse)(nil),            // 28: Superplane.Canvases.ListCanvasVersionsResponse
+	(*DescribeCanvasVersionRequest)(nil),          // 29: Superplane.Canvases.DescribeCanvasVersionRequest
+	(*DescribeCanvasVersionResponse)(nil),         // 30: Superplane.Canvases.DescribeCanvasVersionResponse
+	(*UpdateCanvasVersionRequest)(nil),            // 31: Superplane.Canvases.UpdateCanvasVersionRequest
+	(*UpdateCanvasVersionResponse)(nil),           // 32: Superplane.Canvases.UpdateCanvasVersionResponse
+	(*CreateCanvasChangeRequestRequest)(nil),      // 33: Superplane.Canvases.CreateCanvasChangeRequestRequest
+	(*CreateCanvasChangeRequestResponse)(nil),     // 34: Superplane.Canvases.CreateCanvasChangeRequestResponse
+	(*ListCanvasChangeRequestsRequest)(nil),       // 35: Superplane.Canvases.ListCanvasChangeRequestsRequest
+	(*ListCanvasChangeRequestsResponse)(nil),      // 36: Superplane.Canvases.ListCanvasChangeRequestsResponse
+	(*DescribeCanvasChangeRequestRequest)(nil),    // 37: Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	(*DescribeCanvasChangeRequestResponse)(nil),   // 38: Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	(*ActOnCanvasChangeRequestRequest)(nil),       // 39: Superplane.Canvases.ActOnCanvasChangeRequestRequest
+	(*ActOnCanvasChangeRequestResponse)(nil),      // 40: Superplane.Canvases.ActOnCanvasChangeRequestResponse
+	(*ResolveCanvasChangeRequestRequest)(nil),     // 41: Superplane.Canvases.ResolveCanvasChangeRequestRequest
+	(*ResolveCanvasChangeRequestResponse)(nil),    // 42: Superplane.Canvases.ResolveCanvasChangeRequestResponse
+	(*DeleteCanvasRequest)(nil),                   // 43: Superplane.Canvases.DeleteCanvasRequest
+	(*DeleteCanvasResponse)(nil),                  // 44: Superplane.Canvases.DeleteCanvasResponse
+	(*UserRef)(nil),                               // 45: Superplane.Canvases.UserRef
+	(*Canvas)(nil),                                // 46: Superplane.Canvases.Canvas
+	(*CanvasVersion)(nil),                         // 47: Superplane.Canvases.CanvasVersion
+	(*CanvasVariable)(nil),                        // 48: Superplane.Canvases.CanvasVariable
+	(*CanvasEnvironment)(nil),                     // 49: Superplane.Canvases.CanvasEnvironment
+	(*CanvasChangeRequestDiff)(nil),               // 50: Superplane.Canvases.CanvasChangeRequestDiff
+	(*CanvasChangeRequestApprover)(nil),           // 51: Superplane.Canvases.CanvasChangeRequestApprover
+	(*CanvasChangeRequestApprovalConfig)(nil),     // 52: Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	(*CanvasChangeRequestApproval)(nil),           // 53: Superplane.Canvases.CanvasChangeRequestApproval
+	(*CanvasChangeRequest)(nil),                   // 54: Superplane.Canvases.CanvasChangeRequest
+	(*ListNodeEventsRequest)(nil),                 // 55: Superplane.Canvases.ListNodeEventsRequest
+	(*ListNodeEventsResponse)(nil),                // 56: Superplane.Canvases.ListNodeEventsResponse
+	(*EmitNodeEventRequest)(nil),                  // 57: Superplane.Canvases.EmitNodeEventRequest
+	(*EmitNodeEventResponse)(nil),                 // 58: Superplane.Canvases.EmitNodeEventResponse
+	(*ListNodeQueueItemsRequest)(nil),             // 59: Superplane.Canvases.ListNodeQueueItemsRequest
+	(*ListNodeQueueItemsResponse)(nil),            // 60: Superplane.Canvases.ListNodeQueueItemsResponse
+	(*DeleteNodeQueueItemRequest)(nil),            // 61: Superplane.Canvases.DeleteNodeQueueItemRequest
+	(*DeleteNodeQueueItemResponse)(nil),           // 62: Superplane.Canvases.DeleteNodeQueueItemResponse
+	(*UpdateNodePauseRequest)(nil),                // 63: Superplane.Canvases.UpdateNodePauseRequest
+	(*UpdateNodePauseResponse)(nil),               // 64: Superplane.Canvases.UpdateNodePauseResponse
+	(*ListNodeExecutionsRequest)(nil),             // 65: Superplane.Canvases.ListNodeExecutionsRequest
+	(*ListNodeExecutionsResponse)(nil),            // 66: Superplane.Canvases.ListNodeExecutionsResponse
+	(*ListChildExecutionsRequest)(nil),            // 67: Superplane.Canvases.ListChildExecutionsRequest
+	(*ListChildExecutionsResponse)(nil),           // 68: Superplane.Canvases.ListChildExecutionsResponse
+	(*CanvasNodeExecution)(nil),                   // 69: Superplane.Canvases.CanvasNodeExecution
+	(*CanvasNodeQueueItem)(nil),                   // 70: Superplane.Canvases.CanvasNodeQueueItem
+	(*InvokeNodeExecutionActionRequest)(nil),      // 71: Superplane.Canvases.InvokeNodeExecutionActionRequest
+	(*InvokeNodeExecutionActionResponse)(nil),     // 72: Superplane.Canvases.InvokeNodeExecutionActionResponse
+	(*InvokeNodeTriggerActionRequest)(nil),        // 73: Superplane.Canvases.InvokeNodeTriggerActionRequest
+	(*InvokeNodeTriggerActionResponse)(nil),       // 74: Superplane.Canvases.InvokeNodeTriggerActionResponse
+	(*ListCanvasEventsRequest)(nil),               // 75: Superplane.Canvases.ListCanvasEventsRequest
+	(*ListCanvasEventsResponse)(nil),              // 76: Superplane.Canvases.ListCanvasEventsResponse
+	(*CanvasMemory)(nil),                          // 77: Superplane.Canvases.CanvasMemory
+	(*ListCanvasMemoriesRequest)(nil),             // 78: Superplane.Canvases.ListCanvasMemoriesRequest
+	(*ListCanvasMemoriesResponse)(nil),            // 79: Superplane.Canvases.ListCanvasMemoriesResponse
+	(*DeleteCanvasMemoryRequest)(nil),             // 80: Superplane.Canvases.DeleteCanvasMemoryRequest
+	(*DeleteCanvasMemoryResponse)(nil),            // 81: Superplane.Canvases.DeleteCanvasMemoryResponse
+	(*CanvasMemoryNamespace)(nil),                 // 82: Superplane.Canvases.CanvasMemoryNamespace
+	(*CanvasMemorySchema)(nil),                    // 83: Superplane.Canvases.CanvasMemorySchema
+	(*ListCanvasMemoryNamespacesRequest)(nil),     // 84: Superplane.Canvases.ListCanvasMemoryNamespacesRequest
+	(*ListCanvasMemoryNamespacesResponse)(nil),    // 85: Superplane.Canvases.ListCanvasMemoryNamespacesResponse
+	(*UpdateCanvasMemoryNamespaceRequest)(nil),    // 86: Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest
+	(*UpdateCanvasMemoryNamespaceResponse)(nil),   // 87: Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse
+	(*RetentionPolicy)(nil),                       // 88: Superplane.Canvases.RetentionPolicy
+	(*UpdateCanvasRetentionPolicyRequest)(nil),    // 89: Superplane.Canvases.UpdateCanvasRetentionPolicyRequest
+	(*UpdateCanvasRetentionPolicyResponse)(nil),   // 90: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse
+	(*GetCanvasRetentionReportRequest)(nil),       // 91: Superplane.Canvases.GetCanvasRetentionReportRequest
+	(*GetCanvasRetentionReportResponse)(nil),      // 92: Superplane.Canvases.GetCanvasRetentionReportResponse
+	(*CanvasRoleBinding)(nil),                     // 93: Superplane.Canvases.CanvasRoleBinding
+	(*ListCanvasRoleBindingsRequest)(nil),         // 94: Superplane.Canvases.ListCanvasRoleBindingsRequest
+	(*ListCanvasRoleBindingsResponse)(nil),        // 95: Superplane.Canvases.ListCanvasRoleBindingsResponse
+	(*AssignCanvasRoleRequest)(nil),               // 96: Superplane.Canvases.AssignCanvasRoleRequest
+	(*AssignCanvasRoleResponse)(nil),              // 97: Superplane.Canvases.AssignCanvasRoleResponse
+	(*RemoveCanvasRoleRequest)(nil),               // 98: Superplane.Canvases.RemoveCanvasRoleRequest
+	(*RemoveCanvasRoleResponse)(nil),              // 99: Superplane.Canvases.RemoveCanvasRoleResponse
+	(*CanvasEvent)(nil),                           // 100: Superplane.Canvases.CanvasEvent
+	(*CanvasEventWithExecutions)(nil),             // 101: Superplane.Canvases.CanvasEventWithExecutions
+	(*ListEventExecutionsRequest)(nil),            // 102: Superplane.Canvases.ListEventExecutionsRequest
+	(*ListEventExecutionsResponse)(nil),           // 103: Superplane.Canvases.ListEventExecutionsResponse
+	(*DeadLetter)(nil),                            // 104: Superplane.Canvases.DeadLetter
+	(*ListDeadLettersRequest)(nil),                // 105: Superplane.Canvases.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),               // 106: Superplane.Canvases.ListDeadLettersResponse
+	(*ReplayDeadLetterRequest)(nil),               // 107: Superplane.Canvases.ReplayDeadLetterRequest
+	(*ReplayDeadLetterResponse)(nil),              // 108: Superplane.Canvases.ReplayDeadLetterResponse
+	(*ReplayCanvasEventRequest)(nil),              // 109: Superplane.Canvases.ReplayCanvasEventRequest
+	(*ReplayCanvasEventResponse)(nil),             // 110: Superplane.Canvases.ReplayCanvasEventResponse
+	(*CancelExecutionRequest)(nil),                // 111: Superplane.Canvases.CancelExecutionRequest
+	(*CancelExecutionResponse)(nil),               // 112: Superplane.Canvases.CancelExecutionResponse
+	(*RerunExecutionRequest)(nil),                 // 113: Superplane.Canvases.RerunExecutionRequest
+	(*RerunExecutionResponse)(nil),                // 114: Superplane.Canvases.RerunExecutionResponse
+	(*GetExecutionLogsRequest)(nil),               // 115: Superplane.Canvases.GetExecutionLogsRequest
+	(*GetExecutionLogsResponse)(nil),              // 116: Superplane.Canvases.GetExecutionLogsResponse
+	(*CanvasNodeExecutionLog)(nil),                // 117: Superplane.Canvases.CanvasNodeExecutionLog
+	(*ResolveExecutionErrorsRequest)(nil),         // 118: Superplane.Canvases.ResolveExecutionErrorsRequest
+	(*ResolveExecutionErrorsResponse)(nil),        // 119: Superplane.Canvases.ResolveExecutionErrorsResponse
+	(*CanvasAiNodeContext)(nil),                   // 120: Superplane.Canvases.CanvasAiNodeContext
+	(*CanvasAiBlockContext)(nil),                  // 121: Superplane.Canvases.CanvasAiBlockContext
+	(*CanvasAiContext)(nil),                       // 122: Superplane.Canvases.CanvasAiContext
+	(*SendAiMessageRequest)(nil),                  // 123: Superplane.Canvases.SendAiMessageRequest
+	(*SendAiMessageResponse)(nil),                 // 124: Superplane.Canvases.SendAiMessageResponse
+	(*EvaluateExpressionRequest)(nil),             // 125: Superplane.Canvases.EvaluateExpressionRequest
+	(*EvaluateExpressionResponse)(nil),            // 126: Superplane.Canvases.EvaluateExpressionResponse
+	(*CanvasBundle)(nil),                          // 127: Superplane.Canvases.CanvasBundle
+	(*CanvasBundleDependency)(nil),                // 128: Superplane.Canvases.CanvasBundleDependency
+	(*ExportCanvasBundleRequest)(nil),             // 129: Superplane.Canvases.ExportCanvasBundleRequest
+	(*ExportCanvasBundleResponse)(nil),            // 130: Superplane.Canvases.ExportCanvasBundleResponse
+	(*ImportCanvasBundleRequest)(nil),             // 131: Superplane.Canvases.ImportCanvasBundleRequest
+	(*ImportCanvasBundleResponse)(nil),            // 132: Superplane.Canvases.ImportCanvasBundleResponse
+	(*CanvasNodeEventMessage)(nil),                // 133: Superplane.Canvases.CanvasNodeEventMessage
+	(*CanvasNodeExecutionMessage)(nil),            // 134: Superplane.Canvases.CanvasNodeExecutionMessage
+	(*CanvasNodeExecutionLogMessage)(nil),         // 135: Superplane.Canvases.CanvasNodeExecutionLogMessage
+	(*CanvasNodeQueueItemMessage)(nil),            // 136: Superplane.Canvases.CanvasNodeQueueItemMessage
+	(*CanvasMessage)(nil),                         // 137: Superplane.Canvases.CanvasMessage
+	(*CanvasVersionMessage)(nil),                  // 138: Superplane.Canvases.CanvasVersionMessage
+	(*Canvas_Metadata)(nil),                       // 139: Superplane.Canvases.Canvas.Metadata
+	(*Canvas_Spec)(nil),                           // 140: Superplane.Canvases.Canvas.Spec
+	(*Canvas_Status)(nil),                         // 141: Superplane.Canvases.Canvas.Status
+	(*CanvasVersion_Metadata)(nil),                // 142: Superplane.Canvases.CanvasVersion.Metadata
+	(*CanvasVariable_SecretRef)(nil),              // 143: Superplane.Canvases.CanvasVariable.SecretRef
+	(*CanvasChangeRequest_Metadata)(nil),          // 144: Superplane.Canvases.CanvasChangeRequest.Metadata
+	(*CanvasMemorySchema_Field)(nil),              // 145: Superplane.Canvases.CanvasMemorySchema.Field
+	(*CanvasMemorySchema_LookupKey)(nil),          // 146: Superplane.Canvases.CanvasMemorySchema.LookupKey
+	(*GetCanvasRetentionReportResponse_Node)(nil), // 147: Superplane.Canvases.GetCanvasRetentionReportResponse.Node
+	(*CanvasBundle_Integration)(nil),              // 148: Superplane.Canvases.CanvasBundle.Integration
+	(*CanvasBundle_Secret)(nil),                   // 149: Superplane.Canvases.CanvasBundle.Secret
+	nil,                                           // 150: Superplane.Canvases.ImportCanvasBundleRequest.IntegrationIdsEntry
+	nil,                                           // 151: Superplane.Canvases.ImportCanvasBundleResponse.IntegrationIdsEntry
+	nil,                                           // 152: Superplane.Canvases.ImportCanvasBundleResponse.BlueprintIdsEntry
+	(*timestamp.Timestamp)(nil),                   // 153: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                        // 154: google.protobuf.Struct
+	(*components.Node)(nil),                       // 155: Superplane.Components.Node
+	(*_struct.Value)(nil),                         // 156: google.protobuf.Value
+	(*blueprints.Blueprint)(nil),                  // 157: Superplane.Blueprints.Blueprint
+	(*components.Edge)(nil),                       // 158: Superplane.Components.Edge
 }
 var file_canvases_proto_depIdxs = []int32{
-	39,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
-	39,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	43,  // 2: Superplane.Canvases.UpdateCanvasRequest.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	39,  // 3: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	39,  // 4: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	39,  // 5: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
-	0,   // 6: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
-	1,   // 7: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
-	40,  // 8: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	96,  // 9: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
-	40,  // 10: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
-	96,  // 11: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	40,  // 12: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	39,  // 13: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17,  // 14: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	40,  // 15: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	45,  // 16: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	96,  // 17: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
-	45,  // 18: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
-	96,  // 19: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	45,  // 20: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	2,   // 21: Superplane.Canvases.ActOnCanvasChangeRequestRequest.action:type_name -> Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
-	45,  // 22: Superplane.Canvases.ActOnCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	39,  // 23: Superplane.Canvases.ResolveCanvasChangeRequestRequest.canvas:type_name -> Superplane.Canvases.Canvas
-	17,  // 24: Superplane.Canvases.ResolveCanvasChangeRequestRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
-	40,  // 25: Superplane.Canvases.ResolveCanvasChangeRequestResponse.version:type_name -> Superplane.Canvases.CanvasVersion
-	45,  // 26: Superplane.Canvases.ResolveCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
-	91,  // 27: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
-	92,  // 28: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	93,  // 29: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
-	94,  // 30: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
-	92,  // 31: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
-	3,   // 32: Superplane.Canvases.CanvasChangeRequestApprover.type:type_name -> Superplane.Canvases.CanvasChangeRequestApprover.Type
-	42,  // 33: Superplane.Canvases.CanvasChangeRequestApprovalConfig.items:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
-	38,  // 34: Superplane.Canvases.CanvasChangeRequestApproval.actor:type_name -> Superplane.Canvases.UserRef
-	42,  // 35: Superplane.Canvases.CanvasChangeRequestApproval.approver:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
-	4,   // 36: Superplane.Canvases.CanvasChangeRequestApproval.state:type_name -> Superplane.Canvases.CanvasChangeRequestApproval.State
-	96,  // 37: Superplane.Canvases.CanvasChangeRequestApproval.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 38: Superplane.Canvases.CanvasChangeRequestApproval.invalidated_at:type_name -> google.protobuf.Timestamp
-	95,  // 39: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
-	40,  // 40: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
-	41,  // 41: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
-	44,  // 42: Superplane.Canvases.CanvasChangeRequest.approvals:type_name -> Superplane.Canvases.CanvasChangeRequestApproval
-	96,  // 43: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
-	73,  // 44: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
-	96,  // 45: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	97,  // 46: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
-	96,  // 47: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
-	61,  // 48: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	96,  // 49: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	98,  // 50: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
-	6,   // 51: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	7,   // 52: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	96,  // 53: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
-	60,  // 54: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	96,  // 55: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	60,  // 56: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	6,   // 57: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
-	7,   // 58: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
-	8,   // 59: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
-	97,  // 60: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
-	97,  // 61: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
-	96,  // 62: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 63: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
-	97,  // 64: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
-	97,  // 65: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
-	60,  // 66: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	73,  // 67: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	38,  // 68: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
-	97,  // 69: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
-	73,  // 70: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
-	96,  // 71: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
-	97,  // 72: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
-	97,  // 73: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
-	97,  // 74: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
-	96,  // 75: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
-	74,  // 76: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
-	96,  // 77: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
-	99,  // 78: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
-	68,  // 79: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
-	97,  // 80: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
-	96,  // 81: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
-	97,  // 82: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
-	96,  // 83: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
-	60,  // 84: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	60,  // 85: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	81,  // 86: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
-	82,  // 87: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
-	83,  // 88: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
-	97,  // 89: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
-	96,  // 90: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 91: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 92: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 93: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 94: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 95: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 96: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	38,  // 97: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
-	43,  // 98: Superplane.Canvases.Canvas.Metadata.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
-	98,  // 99: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
-	100, // 100: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
-	60,  // 101: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
-	61,  // 102: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
-	73,  // 103: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
-	38,  // 104: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	96,  // 105: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	96,  // 106: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 107: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	38,  // 108: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
-	5,   // 109: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
-	96,  // 110: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
-	96,  // 111: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	96,  // 112: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	9,   // 113: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
-	15,  // 114: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
-	11,  // 115: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
-	13,  // 116: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
-	18,  // 117: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
-	20,  // 118: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
-	22,  // 119: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
-	24,  // 120: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
-	26,  // 121: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
-	28,  // 122: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
-	30,  // 123: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
-	32,  // 124: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:input_type -> Superplane.Canvases.ActOnCanvasChangeRequestRequest
-	34,  // 125: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:input_type -> Superplane.Canvases.ResolveCanvasChangeRequestRequest
-	36,  // 126: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
-	50,  // 127: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
-	52,  // 128: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
-	54,  // 129: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
-	56,  // 130: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
-	46,  // 131: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
-	48,  // 132: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
-	62,  // 133: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
-	64,  // 134: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
-	58,  // 135: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
-	77,  // 136: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
-	79,  // 137: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
-	66,  // 138: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
-	69,  // 139: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
-	71,  // 140: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
-	75,  // 141: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
-	84,  // 142: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
-	10,  // 143: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
-	16,  // 144: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
-	12,  // 145: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
-	14,  // 146: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
-	19,  // 147: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
-	21,  // 148: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
-	23,  // 149: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
-	25,  // 150: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
-	27,  // 151: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
-	29,  // 152: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
-	31,  // 153: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
-	33,  // 154: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:output_type -> Superplane.Canvases.ActOnCanvasChangeRequestResponse
-	35,  // 155: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:output_type -> Superplane.Canvases.ResolveCanvasChangeRequestResponse
-	37,  // 156: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
-	51,  // 157: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
-	53,  // 158: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
-	55,  // 159: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
-	57,  // 160: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
-	47,  // 161: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
-	49,  // 162: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
-	63,  // 163: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
-	65,  // 164: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
-	59,  // 165: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
-	78,  // 166: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
-	80,  // 167: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
-	67,  // 168: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
-	70,  // 169: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
-	72,  // 170: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
-	76,  // 171: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
-	85,  // 172: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
-	143, // [143:173] is the sub-list for method output_type
-	113, // [113:143] is the sub-list for method input_type
-	113, // [113:113] is the sub-list for extension type_name
-	113, // [113:113] is the sub-list for extension extendee
-	0,   // [0:113] is the sub-list for field type_name
+	46,  // 0: Superplane.Canvases.ListCanvasesResponse.canvases:type_name -> Superplane.Canvases.Canvas
+	46,  // 1: Superplane.Canvases.DescribeCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	52,  // 2: Superplane.Canvases.UpdateCanvasRequest.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	46,  // 3: Superplane.Canvases.UpdateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	46,  // 4: Superplane.Canvases.CreateCanvasRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	46,  // 5: Superplane.Canvases.CreateCanvasResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	1,   // 6: Superplane.Canvases.CanvasAutoLayout.algorithm:type_name -> Superplane.Canvases.CanvasAutoLayout.Algorithm
+	2,   // 7: Superplane.Canvases.CanvasAutoLayout.scope:type_name -> Superplane.Canvases.CanvasAutoLayout.Scope
+	47,  // 8: Superplane.Canvases.CreateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	153, // 9: Superplane.Canvases.ListCanvasVersionsRequest.before:type_name -> google.protobuf.Timestamp
+	47,  // 10: Superplane.Canvases.ListCanvasVersionsResponse.versions:type_name -> Superplane.Canvases.CanvasVersion
+	153, // 11: Superplane.Canvases.ListCanvasVersionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	47,  // 12: Superplane.Canvases.DescribeCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	46,  // 13: Superplane.Canvases.UpdateCanvasVersionRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	24,  // 14: Superplane.Canvases.UpdateCanvasVersionRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	47,  // 15: Superplane.Canvases.UpdateCanvasVersionResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	54,  // 16: Superplane.Canvases.CreateCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	153, // 17: Superplane.Canvases.ListCanvasChangeRequestsRequest.before:type_name -> google.protobuf.Timestamp
+	54,  // 18: Superplane.Canvases.ListCanvasChangeRequestsResponse.change_requests:type_name -> Superplane.Canvases.CanvasChangeRequest
+	153, // 19: Superplane.Canvases.ListCanvasChangeRequestsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	54,  // 20: Superplane.Canvases.DescribeCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	3,   // 21: Superplane.Canvases.ActOnCanvasChangeRequestRequest.action:type_name -> Superplane.Canvases.ActOnCanvasChangeRequestRequest.Action
+	54,  // 22: Superplane.Canvases.ActOnCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	46,  // 23: Superplane.Canvases.ResolveCanvasChangeRequestRequest.canvas:type_name -> Superplane.Canvases.Canvas
+	24,  // 24: Superplane.Canvases.ResolveCanvasChangeRequestRequest.auto_layout:type_name -> Superplane.Canvases.CanvasAutoLayout
+	47,  // 25: Superplane.Canvases.ResolveCanvasChangeRequestResponse.version:type_name -> Superplane.Canvases.CanvasVersion
+	54,  // 26: Superplane.Canvases.ResolveCanvasChangeRequestResponse.change_request:type_name -> Superplane.Canvases.CanvasChangeRequest
+	139, // 27: Superplane.Canvases.Canvas.metadata:type_name -> Superplane.Canvases.Canvas.Metadata
+	140, // 28: Superplane.Canvases.Canvas.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	141, // 29: Superplane.Canvases.Canvas.status:type_name -> Superplane.Canvases.Canvas.Status
+	142, // 30: Superplane.Canvases.CanvasVersion.metadata:type_name -> Superplane.Canvases.CanvasVersion.Metadata
+	140, // 31: Superplane.Canvases.CanvasVersion.spec:type_name -> Superplane.Canvases.Canvas.Spec
+	143, // 32: Superplane.Canvases.CanvasVariable.secret:type_name -> Superplane.Canvases.CanvasVariable.SecretRef
+	48,  // 33: Superplane.Canvases.CanvasEnvironment.variables:type_name -> Superplane.Canvases.CanvasVariable
+	4,   // 34: Superplane.Canvases.CanvasChangeRequestApprover.type:type_name -> Superplane.Canvases.CanvasChangeRequestApprover.Type
+	51,  // 35: Superplane.Canvases.CanvasChangeRequestApprovalConfig.items:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
+	45,  // 36: Superplane.Canvases.CanvasChangeRequestApproval.actor:type_name -> Superplane.Canvases.UserRef
+	51,  // 37: Superplane.Canvases.CanvasChangeRequestApproval.approver:type_name -> Superplane.Canvases.CanvasChangeRequestApprover
+	5,   // 38: Superplane.Canvases.CanvasChangeRequestApproval.state:type_name -> Superplane.Canvases.CanvasChangeRequestApproval.State
+	153, // 39: Superplane.Canvases.CanvasChangeRequestApproval.created_at:type_name -> google.protobuf.Timestamp
+	153, // 40: Superplane.Canvases.CanvasChangeRequestApproval.invalidated_at:type_name -> google.protobuf.Timestamp
+	144, // 41: Superplane.Canvases.CanvasChangeRequest.metadata:type_name -> Superplane.Canvases.CanvasChangeRequest.Metadata
+	47,  // 42: Superplane.Canvases.CanvasChangeRequest.version:type_name -> Superplane.Canvases.CanvasVersion
+	50,  // 43: Superplane.Canvases.CanvasChangeRequest.diff:type_name -> Superplane.Canvases.CanvasChangeRequestDiff
+	53,  // 44: Superplane.Canvases.CanvasChangeRequest.approvals:type_name -> Superplane.Canvases.CanvasChangeRequestApproval
+	153, // 45: Superplane.Canvases.ListNodeEventsRequest.before:type_name -> google.protobuf.Timestamp
+	100, // 46: Superplane.Canvases.ListNodeEventsResponse.events:type_name -> Superplane.Canvases.CanvasEvent
+	153, // 47: Superplane.Canvases.ListNodeEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	154, // 48: Superplane.Canvases.EmitNodeEventRequest.data:type_name -> google.protobuf.Struct
+	153, // 49: Superplane.Canvases.ListNodeQueueItemsRequest.before:type_name -> google.protobuf.Timestamp
+	70,  // 50: Superplane.Canvases.ListNodeQueueItemsResponse.items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	153, // 51: Superplane.Canvases.ListNodeQueueItemsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	155, // 52: Superplane.Canvases.UpdateNodePauseResponse.node:type_name -> Superplane.Components.Node
+	7,   // 53: Superplane.Canvases.ListNodeExecutionsRequest.states:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	8,   // 54: Superplane.Canvases.ListNodeExecutionsRequest.results:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	153, // 55: Superplane.Canvases.ListNodeExecutionsRequest.before:type_name -> google.protobuf.Timestamp
+	69,  // 56: Superplane.Canvases.ListNodeExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	153, // 57: Superplane.Canvases.ListNodeExecutionsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	69,  // 58: Superplane.Canvases.ListChildExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	7,   // 59: Superplane.Canvases.CanvasNodeExecution.state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	8,   // 60: Superplane.Canvases.CanvasNodeExecution.result:type_name -> Superplane.Canvases.CanvasNodeExecution.Result
+	9,   // 61: Superplane.Canvases.CanvasNodeExecution.result_reason:type_name -> Superplane.Canvases.CanvasNodeExecution.ResultReason
+	154, // 62: Superplane.Canvases.CanvasNodeExecution.input:type_name -> google.protobuf.Struct
+	154, // 63: Superplane.Canvases.CanvasNodeExecution.outputs:type_name -> google.protobuf.Struct
+	153, // 64: Superplane.Canvases.CanvasNodeExecution.created_at:type_name -> google.protobuf.Timestamp
+	153, // 65: Superplane.Canvases.CanvasNodeExecution.updated_at:type_name -> google.protobuf.Timestamp
+	154, // 66: Superplane.Canvases.CanvasNodeExecution.metadata:type_name -> google.protobuf.Struct
+	154, // 67: Superplane.Canvases.CanvasNodeExecution.configuration:type_name -> google.protobuf.Struct
+	69,  // 68: Superplane.Canvases.CanvasNodeExecution.child_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	100, // 69: Superplane.Canvases.CanvasNodeExecution.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	45,  // 70: Superplane.Canvases.CanvasNodeExecution.cancelled_by:type_name -> Superplane.Canvases.UserRef
+	153, // 71: Superplane.Canvases.CanvasNodeExecution.run_at:type_name -> google.protobuf.Timestamp
+	154, // 72: Superplane.Canvases.CanvasNodeQueueItem.input:type_name -> google.protobuf.Struct
+	100, // 73: Superplane.Canvases.CanvasNodeQueueItem.root_event:type_name -> Superplane.Canvases.CanvasEvent
+	153, // 74: Superplane.Canvases.CanvasNodeQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	154, // 75: Superplane.Canvases.InvokeNodeExecutionActionRequest.parameters:type_name -> google.protobuf.Struct
+	154, // 76: Superplane.Canvases.InvokeNodeTriggerActionRequest.parameters:type_name -> google.protobuf.Struct
+	154, // 77: Superplane.Canvases.InvokeNodeTriggerActionResponse.result:type_name -> google.protobuf.Struct
+	153, // 78: Superplane.Canvases.ListCanvasEventsRequest.before:type_name -> google.protobuf.Timestamp
+	101, // 79: Superplane.Canvases.ListCanvasEventsResponse.events:type_name -> Superplane.Canvases.CanvasEventWithExecutions
+	153, // 80: Superplane.Canvases.ListCanvasEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	156, // 81: Superplane.Canvases.CanvasMemory.values:type_name -> google.protobuf.Value
+	153, // 82: Superplane.Canvases.CanvasMemory.expires_at:type_name -> google.protobuf.Timestamp
+	77,  // 83: Superplane.Canvases.ListCanvasMemoriesResponse.items:type_name -> Superplane.Canvases.CanvasMemory
+	153, // 84: Superplane.Canvases.CanvasMemoryNamespace.updated_at:type_name -> google.protobuf.Timestamp
+	83,  // 85: Superplane.Canvases.CanvasMemoryNamespace.schema:type_name -> Superplane.Canvases.CanvasMemorySchema
+	145, // 86: Superplane.Canvases.CanvasMemorySchema.fields:type_name -> Superplane.Canvases.CanvasMemorySchema.Field
+	146, // 87: Superplane.Canvases.CanvasMemorySchema.lookup_keys:type_name -> Superplane.Canvases.CanvasMemorySchema.LookupKey
+	82,  // 88: Superplane.Canvases.ListCanvasMemoryNamespacesResponse.namespaces:type_name -> Superplane.Canvases.CanvasMemoryNamespace
+	83,  // 89: Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest.schema:type_name -> Superplane.Canvases.CanvasMemorySchema
+	82,  // 90: Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse.namespace:type_name -> Superplane.Canvases.CanvasMemoryNamespace
+	88,  // 91: Superplane.Canvases.UpdateCanvasRetentionPolicyRequest.retention_policy:type_name -> Superplane.Canvases.RetentionPolicy
+	88,  // 92: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse.retention_policy:type_name -> Superplane.Canvases.RetentionPolicy
+	11,  // 93: Superplane.Canvases.UpdateCanvasRetentionPolicyResponse.source:type_name -> Superplane.Canvases.RetentionPolicy.Source
+	88,  // 94: Superplane.Canvases.GetCanvasRetentionReportResponse.retention_policy:type_name -> Superplane.Canvases.RetentionPolicy
+	11,  // 95: Superplane.Canvases.GetCanvasRetentionReportResponse.source:type_name -> Superplane.Canvases.RetentionPolicy.Source
+	147, // 96: Superplane.Canvases.GetCanvasRetentionReportResponse.nodes:type_name -> Superplane.Canvases.GetCanvasRetentionReportResponse.Node
+	12,  // 97: Superplane.Canvases.CanvasRoleBinding.subject_type:type_name -> Superplane.Canvases.CanvasRoleBinding.SubjectType
+	0,   // 98: Superplane.Canvases.CanvasRoleBinding.role:type_name -> Superplane.Canvases.CanvasRole
+	93,  // 99: Superplane.Canvases.ListCanvasRoleBindingsResponse.role_bindings:type_name -> Superplane.Canvases.CanvasRoleBinding
+	12,  // 100: Superplane.Canvases.AssignCanvasRoleRequest.subject_type:type_name -> Superplane.Canvases.CanvasRoleBinding.SubjectType
+	0,   // 101: Superplane.Canvases.AssignCanvasRoleRequest.role:type_name -> Superplane.Canvases.CanvasRole
+	93,  // 102: Superplane.Canvases.AssignCanvasRoleResponse.role_binding:type_name -> Superplane.Canvases.CanvasRoleBinding
+	12,  // 103: Superplane.Canvases.RemoveCanvasRoleRequest.subject_type:type_name -> Superplane.Canvases.CanvasRoleBinding.SubjectType
+	154, // 104: Superplane.Canvases.CanvasEvent.data:type_name -> google.protobuf.Struct
+	153, // 105: Superplane.Canvases.CanvasEvent.created_at:type_name -> google.protobuf.Timestamp
+	154, // 106: Superplane.Canvases.CanvasEventWithExecutions.data:type_name -> google.protobuf.Struct
+	153, // 107: Superplane.Canvases.CanvasEventWithExecutions.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 108: Superplane.Canvases.CanvasEventWithExecutions.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	69,  // 109: Superplane.Canvases.ListEventExecutionsResponse.executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	13,  // 110: Superplane.Canvases.DeadLetter.type:type_name -> Superplane.Canvases.DeadLetter.Type
+	100, // 111: Superplane.Canvases.DeadLetter.event:type_name -> Superplane.Canvases.CanvasEvent
+	153, // 112: Superplane.Canvases.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	153, // 113: Superplane.Canvases.ListDeadLettersRequest.before:type_name -> google.protobuf.Timestamp
+	104, // 114: Superplane.Canvases.ListDeadLettersResponse.dead_letters:type_name -> Superplane.Canvases.DeadLetter
+	153, // 115: Superplane.Canvases.ListDeadLettersResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	100, // 116: Superplane.Canvases.ReplayCanvasEventResponse.event:type_name -> Superplane.Canvases.CanvasEvent
+	70,  // 117: Superplane.Canvases.RerunExecutionResponse.queue_item:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	153, // 118: Superplane.Canvases.GetExecutionLogsRequest.after:type_name -> google.protobuf.Timestamp
+	117, // 119: Superplane.Canvases.GetExecutionLogsResponse.logs:type_name -> Superplane.Canvases.CanvasNodeExecutionLog
+	153, // 120: Superplane.Canvases.GetExecutionLogsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	7,   // 121: Superplane.Canvases.GetExecutionLogsResponse.execution_state:type_name -> Superplane.Canvases.CanvasNodeExecution.State
+	14,  // 122: Superplane.Canvases.CanvasNodeExecutionLog.level:type_name -> Superplane.Canvases.CanvasNodeExecutionLog.Level
+	154, // 123: Superplane.Canvases.CanvasNodeExecutionLog.fields:type_name -> google.protobuf.Struct
+	153, // 124: Superplane.Canvases.CanvasNodeExecutionLog.created_at:type_name -> google.protobuf.Timestamp
+	120, // 125: Superplane.Canvases.CanvasAiContext.nodes:type_name -> Superplane.Canvases.CanvasAiNodeContext
+	121, // 126: Superplane.Canvases.CanvasAiContext.available_blocks:type_name -> Superplane.Canvases.CanvasAiBlockContext
+	122, // 127: Superplane.Canvases.SendAiMessageRequest.canvas_context:type_name -> Superplane.Canvases.CanvasAiContext
+	154, // 128: Superplane.Canvases.SendAiMessageResponse.operations:type_name -> google.protobuf.Struct
+	154, // 129: Superplane.Canvases.EvaluateExpressionRequest.configuration:type_name -> google.protobuf.Struct
+	156, // 130: Superplane.Canvases.EvaluateExpressionResponse.value:type_name -> google.protobuf.Value
+	154, // 131: Superplane.Canvases.EvaluateExpressionResponse.configuration:type_name -> google.protobuf.Struct
+	46,  // 132: Superplane.Canvases.CanvasBundle.canvas:type_name -> Superplane.Canvases.Canvas
+	157, // 133: Superplane.Canvases.CanvasBundle.blueprints:type_name -> Superplane.Blueprints.Blueprint
+	148, // 134: Superplane.Canvases.CanvasBundle.integrations:type_name -> Superplane.Canvases.CanvasBundle.Integration
+	149, // 135: Superplane.Canvases.CanvasBundle.secrets:type_name -> Superplane.Canvases.CanvasBundle.Secret
+	15,  // 136: Superplane.Canvases.CanvasBundleDependency.type:type_name -> Superplane.Canvases.CanvasBundleDependency.Type
+	127, // 137: Superplane.Canvases.ExportCanvasBundleResponse.bundle:type_name -> Superplane.Canvases.CanvasBundle
+	127, // 138: Superplane.Canvases.ImportCanvasBundleRequest.bundle:type_name -> Superplane.Canvases.CanvasBundle
+	150, // 139: Superplane.Canvases.ImportCanvasBundleRequest.integration_ids:type_name -> Superplane.Canvases.ImportCanvasBundleRequest.IntegrationIdsEntry
+	46,  // 140: Superplane.Canvases.ImportCanvasBundleResponse.canvas:type_name -> Superplane.Canvases.Canvas
+	128, // 141: Superplane.Canvases.ImportCanvasBundleResponse.unresolved:type_name -> Superplane.Canvases.CanvasBundleDependency
+	151, // 142: Superplane.Canvases.ImportCanvasBundleResponse.integration_ids:type_name -> Superplane.Canvases.ImportCanvasBundleResponse.IntegrationIdsEntry
+	152, // 143: Superplane.Canvases.ImportCanvasBundleResponse.blueprint_ids:type_name -> Superplane.Canvases.ImportCanvasBundleResponse.BlueprintIdsEntry
+	153, // 144: Superplane.Canvases.CanvasNodeEventMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 145: Superplane.Canvases.CanvasNodeExecutionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 146: Superplane.Canvases.CanvasNodeExecutionLogMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 147: Superplane.Canvases.CanvasNodeQueueItemMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 148: Superplane.Canvases.CanvasMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 149: Superplane.Canvases.CanvasVersionMessage.timestamp:type_name -> google.protobuf.Timestamp
+	153, // 150: Superplane.Canvases.Canvas.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	153, // 151: Superplane.Canvases.Canvas.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	45,  // 152: Superplane.Canvases.Canvas.Metadata.created_by:type_name -> Superplane.Canvases.UserRef
+	52,  // 153: Superplane.Canvases.Canvas.Metadata.change_request_approval_config:type_name -> Superplane.Canvases.CanvasChangeRequestApprovalConfig
+	155, // 154: Superplane.Canvases.Canvas.Spec.nodes:type_name -> Superplane.Components.Node
+	158, // 155: Superplane.Canvases.Canvas.Spec.edges:type_name -> Superplane.Components.Edge
+	48,  // 156: Superplane.Canvases.Canvas.Spec.variables:type_name -> Superplane.Canvases.CanvasVariable
+	49,  // 157: Superplane.Canvases.Canvas.Spec.environments:type_name -> Superplane.Canvases.CanvasEnvironment
+	69,  // 158: Superplane.Canvases.Canvas.Status.last_executions:type_name -> Superplane.Canvases.CanvasNodeExecution
+	70,  // 159: Superplane.Canvases.Canvas.Status.next_queue_items:type_name -> Superplane.Canvases.CanvasNodeQueueItem
+	100, // 160: Superplane.Canvases.Canvas.Status.last_events:type_name -> Superplane.Canvases.CanvasEvent
+	45,  // 161: Superplane.Canvases.CanvasVersion.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	153, // 162: Superplane.Canvases.CanvasVersion.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	153, // 163: Superplane.Canvases.CanvasVersion.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	153, // 164: Superplane.Canvases.CanvasVersion.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	45,  // 165: Superplane.Canvases.CanvasChangeRequest.Metadata.owner:type_name -> Superplane.Canvases.UserRef
+	6,   // 166: Superplane.Canvases.CanvasChangeRequest.Metadata.status:type_name -> Superplane.Canvases.CanvasChangeRequest.Status
+	153, // 167: Superplane.Canvases.CanvasChangeRequest.Metadata.published_at:type_name -> google.protobuf.Timestamp
+	153, // 168: Superplane.Canvases.CanvasChangeRequest.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	153, // 169: Superplane.Canvases.CanvasChangeRequest.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 170: Superplane.Canvases.CanvasMemorySchema.Field.type:type_name -> Superplane.Canvases.CanvasMemorySchema.Field.Type
+	16,  // 171: Superplane.Canvases.Canvases.ListCanvases:input_type -> Superplane.Canvases.ListCanvasesRequest
+	22,  // 172: Superplane.Canvases.Canvases.CreateCanvas:input_type -> Superplane.Canvases.CreateCanvasRequest
+	18,  // 173: Superplane.Canvases.Canvases.DescribeCanvas:input_type -> Superplane.Canvases.DescribeCanvasRequest
+	20,  // 174: Superplane.Canvases.Canvases.UpdateCanvas:input_type -> Superplane.Canvases.UpdateCanvasRequest
+	25,  // 175: Superplane.Canvases.Canvases.CreateCanvasVersion:input_type -> Superplane.Canvases.CreateCanvasVersionRequest
+	27,  // 176: Superplane.Canvases.Canvases.ListCanvasVersions:input_type -> Superplane.Canvases.ListCanvasVersionsRequest
+	29,  // 177: Superplane.Canvases.Canvases.DescribeCanvasVersion:input_type -> Superplane.Canvases.DescribeCanvasVersionRequest
+	31,  // 178: Superplane.Canvases.Canvases.UpdateCanvasVersion:input_type -> Superplane.Canvases.UpdateCanvasVersionRequest
+	33,  // 179: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:input_type -> Superplane.Canvases.CreateCanvasChangeRequestRequest
+	35,  // 180: Superplane.Canvases.Canvases.ListCanvasChangeRequests:input_type -> Superplane.Canvases.ListCanvasChangeRequestsRequest
+	37,  // 181: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:input_type -> Superplane.Canvases.DescribeCanvasChangeRequestRequest
+	39,  // 182: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:input_type -> Superplane.Canvases.ActOnCanvasChangeRequestRequest
+	41,  // 183: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:input_type -> Superplane.Canvases.ResolveCanvasChangeRequestRequest
+	43,  // 184: Superplane.Canvases.Canvases.DeleteCanvas:input_type -> Superplane.Canvases.DeleteCanvasRequest
+	59,  // 185: Superplane.Canvases.Canvases.ListNodeQueueItems:input_type -> Superplane.Canvases.ListNodeQueueItemsRequest
+	61,  // 186: Superplane.Canvases.Canvases.DeleteNodeQueueItem:input_type -> Superplane.Canvases.DeleteNodeQueueItemRequest
+	63,  // 187: Superplane.Canvases.Canvases.UpdateNodePause:input_type -> Superplane.Canvases.UpdateNodePauseRequest
+	65,  // 188: Superplane.Canvases.Canvases.ListNodeExecutions:input_type -> Superplane.Canvases.ListNodeExecutionsRequest
+	55,  // 189: Superplane.Canvases.Canvases.ListNodeEvents:input_type -> Superplane.Canvases.ListNodeEventsRequest
+	57,  // 190: Superplane.Canvases.Canvases.EmitNodeEvent:input_type -> Superplane.Canvases.EmitNodeEventRequest
+	71,  // 191: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:input_type -> Superplane.Canvases.InvokeNodeExecutionActionRequest
+	73,  // 192: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:input_type -> Superplane.Canvases.InvokeNodeTriggerActionRequest
+	67,  // 193: Superplane.Canvases.Canvases.ListChildExecutions:input_type -> Superplane.Canvases.ListChildExecutionsRequest
+	111, // 194: Superplane.Canvases.Canvases.CancelExecution:input_type -> Superplane.Canvases.CancelExecutionRequest
+	113, // 195: Superplane.Canvases.Canvases.RerunExecution:input_type -> Superplane.Canvases.RerunExecutionRequest
+	115, // 196: Superplane.Canvases.Canvases.GetExecutionLogs:input_type -> Superplane.Canvases.GetExecutionLogsRequest
+	118, // 197: Superplane.Canvases.Canvases.ResolveExecutionErrors:input_type -> Superplane.Canvases.ResolveExecutionErrorsRequest
+	75,  // 198: Superplane.Canvases.Canvases.ListCanvasEvents:input_type -> Superplane.Canvases.ListCanvasEventsRequest
+	78,  // 199: Superplane.Canvases.Canvases.ListCanvasMemories:input_type -> Superplane.Canvases.ListCanvasMemoriesRequest
+	80,  // 200: Superplane.Canvases.Canvases.DeleteCanvasMemory:input_type -> Superplane.Canvases.DeleteCanvasMemoryRequest
+	84,  // 201: Superplane.Canvases.Canvases.ListCanvasMemoryNamespaces:input_type -> Superplane.Canvases.ListCanvasMemoryNamespacesRequest
+	86,  // 202: Superplane.Canvases.Canvases.UpdateCanvasMemoryNamespace:input_type -> Superplane.Canvases.UpdateCanvasMemoryNamespaceRequest
+	89,  // 203: Superplane.Canvases.Canvases.UpdateCanvasRetentionPolicy:input_type -> Superplane.Canvases.UpdateCanvasRetentionPolicyRequest
+	91,  // 204: Superplane.Canvases.Canvases.GetCanvasRetentionReport:input_type -> Superplane.Canvases.GetCanvasRetentionReportRequest
+	94,  // 205: Superplane.Canvases.Canvases.ListCanvasRoleBindings:input_type -> Superplane.Canvases.ListCanvasRoleBindingsRequest
+	96,  // 206: Superplane.Canvases.Canvases.AssignCanvasRole:input_type -> Superplane.Canvases.AssignCanvasRoleRequest
+	98,  // 207: Superplane.Canvases.Canvases.RemoveCanvasRole:input_type -> Superplane.Canvases.RemoveCanvasRoleRequest
+	102, // 208: Superplane.Canvases.Canvases.ListEventExecutions:input_type -> Superplane.Canvases.ListEventExecutionsRequest
+	123, // 209: Superplane.Canvases.Canvases.SendAiMessage:input_type -> Superplane.Canvases.SendAiMessageRequest
+	125, // 210: Superplane.Canvases.Canvases.EvaluateExpression:input_type -> Superplane.Canvases.EvaluateExpressionRequest
+	105, // 211: Superplane.Canvases.Canvases.ListDeadLetters:input_type -> Superplane.Canvases.ListDeadLettersRequest
+	107, // 212: Superplane.Canvases.Canvases.ReplayDeadLetter:input_type -> Superplane.Canvases.ReplayDeadLetterRequest
+	109, // 213: Superplane.Canvases.Canvases.ReplayCanvasEvent:input_type -> Superplane.Canvases.ReplayCanvasEventRequest
+	129, // 214: Superplane.Canvases.Canvases.ExportCanvasBundle:input_type -> Superplane.Canvases.ExportCanvasBundleRequest
+	131, // 215: Superplane.Canvases.Canvases.ImportCanvasBundle:input_type -> Superplane.Canvases.ImportCanvasBundleRequest
+	17,  // 216: Superplane.Canvases.Canvases.ListCanvases:output_type -> Superplane.Canvases.ListCanvasesResponse
+	23,  // 217: Superplane.Canvases.Canvases.CreateCanvas:output_type -> Superplane.Canvases.CreateCanvasResponse
+	19,  // 218: Superplane.Canvases.Canvases.DescribeCanvas:output_type -> Superplane.Canvases.DescribeCanvasResponse
+	21,  // 219: Superplane.Canvases.Canvases.UpdateCanvas:output_type -> Superplane.Canvases.UpdateCanvasResponse
+	26,  // 220: Superplane.Canvases.Canvases.CreateCanvasVersion:output_type -> Superplane.Canvases.CreateCanvasVersionResponse
+	28,  // 221: Superplane.Canvases.Canvases.ListCanvasVersions:output_type -> Superplane.Canvases.ListCanvasVersionsResponse
+	30,  // 222: Superplane.Canvases.Canvases.DescribeCanvasVersion:output_type -> Superplane.Canvases.DescribeCanvasVersionResponse
+	32,  // 223: Superplane.Canvases.Canvases.UpdateCanvasVersion:output_type -> Superplane.Canvases.UpdateCanvasVersionResponse
+	34,  // 224: Superplane.Canvases.Canvases.CreateCanvasChangeRequest:output_type -> Superplane.Canvases.CreateCanvasChangeRequestResponse
+	36,  // 225: Superplane.Canvases.Canvases.ListCanvasChangeRequests:output_type -> Superplane.Canvases.ListCanvasChangeRequestsResponse
+	38,  // 226: Superplane.Canvases.Canvases.DescribeCanvasChangeRequest:output_type -> Superplane.Canvases.DescribeCanvasChangeRequestResponse
+	40,  // 227: Superplane.Canvases.Canvases.ActOnCanvasChangeRequest:output_type -> Superplane.Canvases.ActOnCanvasChangeRequestResponse
+	42,  // 228: Superplane.Canvases.Canvases.ResolveCanvasChangeRequest:output_type -> Superplane.Canvases.ResolveCanvasChangeRequestResponse
+	44,  // 229: Superplane.Canvases.Canvases.DeleteCanvas:output_type -> Superplane.Canvases.DeleteCanvasResponse
+	60,  // 230: Superplane.Canvases.Canvases.ListNodeQueueItems:output_type -> Superplane.Canvases.ListNodeQueueItemsResponse
+	62,  // 231: Superplane.Canvases.Canvases.DeleteNodeQueueItem:output_type -> Superplane.Canvases.DeleteNodeQueueItemResponse
+	64,  // 232: Superplane.Canvases.Canvases.UpdateNodePause:output_type -> Superplane.Canvases.UpdateNodePauseResponse
+	66,  // 233: Superplane.Canvases.Canvases.ListNodeExecutions:output_type -> Superplane.Canvases.ListNodeExecutionsResponse
+	56,  // 234: Superplane.Canvases.Canvases.ListNodeEvents:output_type -> Superplane.Canvases.ListNodeEventsResponse
+	58,  // 235: Superplane.Canvases.Canvases.EmitNodeEvent:output_type -> Superplane.Canvases.EmitNodeEventResponse
+	72,  // 236: Superplane.Canvases.Canvases.InvokeNodeExecutionAction:output_type -> Superplane.Canvases.InvokeNodeExecutionActionResponse
+	74,  // 237: Superplane.Canvases.Canvases.InvokeNodeTriggerAction:output_type -> Superplane.Canvases.InvokeNodeTriggerActionResponse
+	68,  // 238: Superplane.Canvases.Canvases.ListChildExecutions:output_type -> Superplane.Canvases.ListChildExecutionsResponse
+	112, // 239: Superplane.Canvases.Canvases.CancelExecution:output_type -> Superplane.Canvases.CancelExecutionResponse
+	114, // 240: Superplane.Canvases.Canvases.RerunExecution:output_type -> Superplane.Canvases.RerunExecutionResponse
+	116, // 241: Superplane.Canvases.Canvases.GetExecutionLogs:output_type -> Superplane.Canvases.GetExecutionLogsResponse
+	119, // 242: Superplane.Canvases.Canvases.ResolveExecutionErrors:output_type -> Superplane.Canvases.ResolveExecutionErrorsResponse
+	76,  // 243: Superplane.Canvases.Canvases.ListCanvasEvents:output_type -> Superplane.Canvases.ListCanvasEventsResponse
+	79,  // 244: Superplane.Canvases.Canvases.ListCanvasMemories:output_type -> Superplane.Canvases.ListCanvasMemoriesResponse
+	81,  // 245: Superplane.Canvases.Canvases.DeleteCanvasMemory:output_type -> Superplane.Canvases.DeleteCanvasMemoryResponse
+	85,  // 246: Superplane.Canvases.Canvases.ListCanvasMemoryNamespaces:output_type -> Superplane.Canvases.ListCanvasMemoryNamespacesResponse
+	87,  // 247: Superplane.Canvases.Canvases.UpdateCanvasMemoryNamespace:output_type -> Superplane.Canvases.UpdateCanvasMemoryNamespaceResponse
+	90,  // 248: Superplane.Canvases.Canvases.UpdateCanvasRetentionPolicy:output_type -> Superplane.Canvases.UpdateCanvasRetentionPolicyResponse
+	92,  // 249: Superplane.Canvases.Canvases.GetCanvasRetentionReport:output_type -> Superplane.Canvases.GetCanvasRetentionReportResponse
+	95,  // 250: Superplane.Canvases.Canvases.ListCanvasRoleBindings:output_type -> Superplane.Canvases.ListCanvasRoleBindingsResponse
+	97,  // 251: Superplane.Canvases.Canvases.AssignCanvasRole:output_type -> Superplane.Canvases.AssignCanvasRoleResponse
+	99,  // 252: Superplane.Canvases.Canvases.RemoveCanvasRole:output_type -> Superplane.Canvases.RemoveCanvasRoleResponse
+	103, // 253: Superplane.Canvases.Canvases.ListEventExecutions:output_type -> Superplane.Canvases.ListEventExecutionsResponse
+	124, // 254: Superplane.Canvases.Canvases.SendAiMessage:output_type -> Superplane.Canvases.SendAiMessageResponse
+	126, // 255: Superplane.Canvases.Canvases.EvaluateExpression:output_type -> Superplane.Canvases.EvaluateExpressionResponse
+	106, // 256: Superplane.Canvases.Canvases.ListDeadLetters:output_type -> Superplane.Canvases.ListDeadLettersResponse
+	108, // 257: Superplane.Canvases.Canvases.ReplayDeadLetter:output_type -> Superplane.Canvases.ReplayDeadLetterResponse
+	110, // 258: Superplane.Canvases.Canvases.ReplayCanvasEvent:output_type -> Superplane.Canvases.ReplayCanvasEventResponse
+	130, // 259: Superplane.Canvases.Canvases.ExportCanvasBundle:output_type -> Superplane.Canvases.ExportCanvasBundleResponse
+	132, // 260: Superplane.Canvases.Canvases.ImportCanvasBundle:output_type -> Superplane.Canvases.ImportCanvasBundleResponse
+	216, // [216:261] is the sub-list for method output_type
+	171, // [171:216] is the sub-list for method input_type
+	171, // [171:171] is the sub-list for extension type_name
+	171, // [171:171] is the sub-list for extension extendee
+	0,   // [0:171] is the sub-list for field type_name
 }
 
 func init() { file_canvases_proto_init() }
@@ -6522,8 +10263,8 @@ func file_canvases_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_canvases_proto_rawDesc), len(file_canvases_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   87,
+			NumEnums:      16,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -43,12 +43,27 @@ const (
 	Canvases_InvokeNodeTriggerAction_FullMethodName     = "/Superplane.Canvases.Canvases/InvokeNodeTriggerAction"
 	Canvases_ListChildExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListChildExecutions"
 	Canvases_CancelExecution_FullMethodName             = "/Superplane.Canvases.Canvases/CancelExecution"
+	Canvases_RerunExecution_FullMethodName              = "/Superplane.Canvases.Canvases/RerunExecution"
+	Canvases_GetExecutionLogs_FullMethodName            = "/Superplane.Canvases.Canvases/GetExecutionLogs"
 	Canvases_ResolveExecutionErrors_FullMethodName      = "/Superplane.Canvases.Canvases/ResolveExecutionErrors"
 	Canvases_ListCanvasEvents_FullMethodName            = "/Superplane.Canvases.Canvases/ListCanvasEvents"
 	Canvases_ListCanvasMemories_FullMethodName          = "/Superplane.Canvases.Canvases/ListCanvasMemories"
 	Canvases_DeleteCanvasMemory_FullMethodName          = "/Superplane.Canvases.Canvases/DeleteCanvasMemory"
+	Canvases_ListCanvasMemoryNamespaces_FullMethodName  = "/Superplane.Canvases.Canvases/ListCanvasMemoryNamespaces"
+	Canvases_UpdateCanvasMemoryNamespace_FullMethodName = "/Superplane.Canvases.Canvases/UpdateCanvasMemoryNamespace"
+	Canvases_UpdateCanvasRetentionPolicy_FullMethodName = "/Superplane.Canvases.Canvases/UpdateCanvasRetentionPolicy"
+	Canvases_GetCanvasRetentionReport_FullMethodName    = "/Superplane.Canvases.Canvases/GetCanvasRetentionReport"
+	Canvases_ListCanvasRoleBindings_FullMethodName      = "/Superplane.Canvases.Canvases/ListCanvasRoleBindings"
+	Canvases_AssignCanvasRole_FullMethodName            = "/Superplane.Canvases.Canvases/AssignCanvasRole"
+	Canvases_RemoveCanvasRole_FullMethodName            = "/Superplane.Canvases.Canvases/RemoveCanvasRole"
 	Canvases_ListEventExecutions_FullMethodName         = "/Superplane.Canvases.Canvases/ListEventExecutions"
 	Canvases_SendAiMessage_FullMethodName               = "/Superplane.Canvases.Canvases/SendAiMessage"
+	Canvases_EvaluateExpression_FullMethodName          = "/Superplane.Canvases.Canvases/EvaluateExpression"
+	Canvases_ListDeadLetters_FullMethodName             = "/Superplane.Canvases.Canvases/ListDeadLetters"
+	Canvases_ReplayDeadLetter_FullMethodName            = "/Superplane.Canvases.Canvases/ReplayDeadLetter"
+	Canvases_ReplayCanvasEvent_FullMethodName           = "/Superplane.Canvases.Canvases/ReplayCanvasEvent"
+	Canvases_ExportCanvasBundle_FullMethodName          = "/Superplane.Canvases.Canvases/ExportCanvasBundle"
+	Canvases_ImportCanvasBundle_FullMethodName          = "/Superplane.Canvases.Canvases/ImportCanvasBundle"
 )
 
 // CanvasesClient is the client API for Canvases service.
@@ -79,12 +94,27 @@ type CanvasesClient interface {
 	InvokeNodeTriggerAction(ctx context.Context, in *InvokeNodeTriggerActionRequest, opts ...grpc.CallOption) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(ctx context.Context, in *ListChildExecutionsRequest, opts ...grpc.CallOption) (*ListChildExecutionsResponse, error)
 	CancelExecution(ctx context.Context, in *CancelExecutionRequest, opts ...grpc.CallOption) (*CancelExecutionResponse, error)
+	RerunExecution(ctx context.Context, in *RerunExecutionRequest, opts ...grpc.CallOption) (*RerunExecutionResponse, error)
+	GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error)
 	ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(ctx context.Context, in *ListCanvasEventsRequest, opts ...grpc.CallOption) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(ctx context.Context, in *ListCanvasMemoriesRequest, opts ...grpc.CallOption) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(ctx context.Context, in *DeleteCanvasMemoryRequest, opts ...grpc.CallOption) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasMemoryNamespaces(ctx context.Context, in *ListCanvasMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListCanvasMemoryNamespacesResponse, error)
+	UpdateCanvasMemoryNamespace(ctx context.Context, in *UpdateCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateCanvasMemoryNamespaceResponse, error)
+	UpdateCanvasRetentionPolicy(ctx context.Context, in *UpdateCanvasRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateCanvasRetentionPolicyResponse, error)
+	GetCanvasRetentionReport(ctx context.Context, in *GetCanvasRetentionReportRequest, opts ...grpc.CallOption) (*GetCanvasRetentionReportResponse, error)
+	ListCanvasRoleBindings(ctx context.Context, in *ListCanvasRoleBindingsRequest, opts ...grpc.CallOption) (*ListCanvasRoleBindingsResponse, error)
+	AssignCanvasRole(ctx context.Context, in *AssignCanvasRoleRequest, opts ...grpc.CallOption) (*AssignCanvasRoleResponse, error)
+	RemoveCanvasRole(ctx context.Context, in *RemoveCanvasRoleRequest, opts ...grpc.CallOption) (*RemoveCanvasRoleResponse, error)
 	ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error)
 	SendAiMessage(ctx context.Context, in *SendAiMessageRequest, opts ...grpc.CallOption) (*SendAiMessageResponse, error)
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error)
+	ReplayCanvasEvent(ctx context.Context, in *ReplayCanvasEventRequest, opts ...grpc.CallOption) (*ReplayCanvasEventResponse, error)
+	ExportCanvasBundle(ctx context.Context, in *ExportCanvasBundleRequest, opts ...grpc.CallOption) (*ExportCanvasBundleResponse, error)
+	ImportCanvasBundle(ctx context.Context, in *ImportCanvasBundleRequest, opts ...grpc.CallOption) (*ImportCanvasBundleResponse, error)
 }
 
 type canvasesClient struct {
@@ -335,6 +365,26 @@ func (c *canvasesClient) CancelExecution(ctx context.Context, in *CancelExecutio
 	return out, nil
 }
 
+func (c *canvasesClient) RerunExecution(ctx context.Context, in *RerunExecutionRequest, opts ...grpc.CallOption) (*RerunExecutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RerunExecutionResponse)
+	err := c.cc.Invoke(ctx, Canvases_RerunExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetExecutionLogs(ctx context.Context, in *GetExecutionLogsRequest, opts ...grpc.CallOption) (*GetExecutionLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetExecutionLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ResolveExecutionErrors(ctx context.Context, in *ResolveExecutionErrorsRequest, opts ...grpc.CallOption) (*ResolveExecutionErrorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResolveExecutionErrorsResponse)
@@ -375,6 +425,76 @@ func (c *canvasesClient) DeleteCanvasMemory(ctx context.Context, in *DeleteCanva
 	return out, nil
 }
 
+func (c *canvasesClient) ListCanvasMemoryNamespaces(ctx context.Context, in *ListCanvasMemoryNamespacesRequest, opts ...grpc.CallOption) (*ListCanvasMemoryNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasMemoryNamespacesResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasMemoryNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasMemoryNamespace(ctx context.Context, in *UpdateCanvasMemoryNamespaceRequest, opts ...grpc.CallOption) (*UpdateCanvasMemoryNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasMemoryNamespaceResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasMemoryNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) UpdateCanvasRetentionPolicy(ctx context.Context, in *UpdateCanvasRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateCanvasRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCanvasRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Canvases_UpdateCanvasRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) GetCanvasRetentionReport(ctx context.Context, in *GetCanvasRetentionReportRequest, opts ...grpc.CallOption) (*GetCanvasRetentionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCanvasRetentionReportResponse)
+	err := c.cc.Invoke(ctx, Canvases_GetCanvasRetentionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListCanvasRoleBindings(ctx context.Context, in *ListCanvasRoleBindingsRequest, opts ...grpc.CallOption) (*ListCanvasRoleBindingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCanvasRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListCanvasRoleBindings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) AssignCanvasRole(ctx context.Context, in *AssignCanvasRoleRequest, opts ...grpc.CallOption) (*AssignCanvasRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignCanvasRoleResponse)
+	err := c.cc.Invoke(ctx, Canvases_AssignCanvasRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) RemoveCanvasRole(ctx context.Context, in *RemoveCanvasRoleRequest, opts ...grpc.CallOption) (*RemoveCanvasRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCanvasRoleResponse)
+	err := c.cc.Invoke(ctx, Canvases_RemoveCanvasRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *canvasesClient) ListEventExecutions(ctx context.Context, in *ListEventExecutionsRequest, opts ...grpc.CallOption) (*ListEventExecutionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListEventExecutionsResponse)
@@ -395,6 +515,66 @@ func (c *canvasesClient) SendAiMessage(ctx context.Context, in *SendAiMessageReq
 	return out, nil
 }
 
+func (c *canvasesClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, Canvases_EvaluateExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Canvases_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLetterResponse)
+	err := c.cc.Invoke(ctx, Canvases_ReplayDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ReplayCanvasEvent(ctx context.Context, in *ReplayCanvasEventRequest, opts ...grpc.CallOption) (*ReplayCanvasEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayCanvasEventResponse)
+	err := c.cc.Invoke(ctx, Canvases_ReplayCanvasEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ExportCanvasBundle(ctx context.Context, in *ExportCanvasBundleRequest, opts ...grpc.CallOption) (*ExportCanvasBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCanvasBundleResponse)
+	err := c.cc.Invoke(ctx, Canvases_ExportCanvasBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *canvasesClient) ImportCanvasBundle(ctx context.Context, in *ImportCanvasBundleRequest, opts ...grpc.CallOption) (*ImportCanvasBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCanvasBundleResponse)
+	err := c.cc.Invoke(ctx, Canvases_ImportCanvasBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CanvasesServer is the server API for Canvases service.
 // All implementations should embed UnimplementedCanvasesServer
 // for forward compatibility.
@@ -423,12 +603,27 @@ type CanvasesServer interface {
 	InvokeNodeTriggerAction(context.Context, *InvokeNodeTriggerActionRequest) (*InvokeNodeTriggerActionResponse, error)
 	ListChildExecutions(context.Context, *ListChildExecutionsRequest) (*ListChildExecutionsResponse, error)
 	CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error)
+	RerunExecution(context.Context, *RerunExecutionRequest) (*RerunExecutionResponse, error)
+	GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error)
 	ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error)
 	ListCanvasEvents(context.Context, *ListCanvasEventsRequest) (*ListCanvasEventsResponse, error)
 	ListCanvasMemories(context.Context, *ListCanvasMemoriesRequest) (*ListCanvasMemoriesResponse, error)
 	DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error)
+	ListCanvasMemoryNamespaces(context.Context, *ListCanvasMemoryNamespacesRequest) (*ListCanvasMemoryNamespacesResponse, error)
+	UpdateCanvasMemoryNamespace(context.Context, *UpdateCanvasMemoryNamespaceRequest) (*UpdateCanvasMemoryNamespaceResponse, error)
+	UpdateCanvasRetentionPolicy(context.Context, *UpdateCanvasRetentionPolicyRequest) (*UpdateCanvasRetentionPolicyResponse, error)
+	GetCanvasRetentionReport(context.Context, *GetCanvasRetentionReportRequest) (*GetCanvasRetentionReportResponse, error)
+	ListCanvasRoleBindings(context.Context, *ListCanvasRoleBindingsRequest) (*ListCanvasRoleBindingsResponse, error)
+	AssignCanvasRole(context.Context, *AssignCanvasRoleRequest) (*AssignCanvasRoleResponse, error)
+	RemoveCanvasRole(context.Context, *RemoveCanvasRoleRequest) (*RemoveCanvasRoleResponse, error)
 	ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error)
 	SendAiMessage(context.Context, *SendAiMessageRequest) (*SendAiMessageResponse, error)
+	EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error)
+	ReplayCanvasEvent(context.Context, *ReplayCanvasEventRequest) (*ReplayCanvasEventResponse, error)
+	ExportCanvasBundle(context.Context, *ExportCanvasBundleRequest) (*ExportCanvasBundleResponse, error)
+	ImportCanvasBundle(context.Context, *ImportCanvasBundleRequest) (*ImportCanvasBundleResponse, error)
 }
 
 // UnimplementedCanvasesServer should be embedded to have
@@ -510,6 +705,12 @@ func (UnimplementedCanvasesServer) ListChildExecutions(context.Context, *ListChi
 func (UnimplementedCanvasesServer) CancelExecution(context.Context, *CancelExecutionRequest) (*CancelExecutionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelExecution not implemented")
 }
+func (UnimplementedCanvasesServer) RerunExecution(context.Context, *RerunExecutionRequest) (*RerunExecutionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RerunExecution not implemented")
+}
+func (UnimplementedCanvasesServer) GetExecutionLogs(context.Context, *GetExecutionLogsRequest) (*GetExecutionLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExecutionLogs not implemented")
+}
 func (UnimplementedCanvasesServer) ResolveExecutionErrors(context.Context, *ResolveExecutionErrorsRequest) (*ResolveExecutionErrorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveExecutionErrors not implemented")
 }
@@ -522,12 +723,51 @@ func (UnimplementedCanvasesServer) ListCanvasMemories(context.Context, *ListCanv
 func (UnimplementedCanvasesServer) DeleteCanvasMemory(context.Context, *DeleteCanvasMemoryRequest) (*DeleteCanvasMemoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCanvasMemory not implemented")
 }
+func (UnimplementedCanvasesServer) ListCanvasMemoryNamespaces(context.Context, *ListCanvasMemoryNamespacesRequest) (*ListCanvasMemoryNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasMemoryNamespaces not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasMemoryNamespace(context.Context, *UpdateCanvasMemoryNamespaceRequest) (*UpdateCanvasMemoryNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasMemoryNamespace not implemented")
+}
+func (UnimplementedCanvasesServer) UpdateCanvasRetentionPolicy(context.Context, *UpdateCanvasRetentionPolicyRequest) (*UpdateCanvasRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCanvasRetentionPolicy not implemented")
+}
+func (UnimplementedCanvasesServer) GetCanvasRetentionReport(context.Context, *GetCanvasRetentionReportRequest) (*GetCanvasRetentionReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCanvasRetentionReport not implemented")
+}
+func (UnimplementedCanvasesServer) ListCanvasRoleBindings(context.Context, *ListCanvasRoleBindingsRequest) (*ListCanvasRoleBindingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCanvasRoleBindings not implemented")
+}
+func (UnimplementedCanvasesServer) AssignCanvasRole(context.Context, *AssignCanvasRoleRequest) (*AssignCanvasRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignCanvasRole not implemented")
+}
+func (UnimplementedCanvasesServer) RemoveCanvasRole(context.Context, *RemoveCanvasRoleRequest) (*RemoveCanvasRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCanvasRole not implemented")
+}
 func (UnimplementedCanvasesServer) ListEventExecutions(context.Context, *ListEventExecutionsRequest) (*ListEventExecutionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEventExecutions not implemented")
 }
 func (UnimplementedCanvasesServer) SendAiMessage(context.Context, *SendAiMessageRequest) (*SendAiMessageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendAiMessage not implemented")
 }
+func (UnimplementedCanvasesServer) EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateExpression not implemented")
+}
+func (UnimplementedCanvasesServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedCanvasesServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
+func (UnimplementedCanvasesServer) ReplayCanvasEvent(context.Context, *ReplayCanvasEventRequest) (*ReplayCanvasEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayCanvasEvent not implemented")
+}
+func (UnimplementedCanvasesServer) ExportCanvasBundle(context.Context, *ExportCanvasBundleRequest) (*ExportCanvasBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportCanvasBundle not implemented")
+}
+func (UnimplementedCanvasesServer) ImportCanvasBundle(context.Context, *ImportCanvasBundleRequest) (*ImportCanvasBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportCanvasBundle not implemented")
+}
 func (UnimplementedCanvasesServer) testEmbeddedByValue() {}
 
 // UnsafeCanvasesServer may be embedded to opt out of forward compatibility for this service.
@@ -980,6 +1220,42 @@ func _Canvases_CancelExecution_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_RerunExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RerunExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RerunExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RerunExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RerunExecution(ctx, req.(*RerunExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetExecutionLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetExecutionLogs(ctx, req.(*GetExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ResolveExecutionErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveExecutionErrorsRequest)
 	if err := dec(in); err != nil {
@@ -1052,6 +1328,132 @@ func _Canvases_DeleteCanvasMemory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_ListCanvasMemoryNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasMemoryNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasMemoryNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasMemoryNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasMemoryNamespaces(ctx, req.(*ListCanvasMemoryNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasMemoryNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasMemoryNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasMemoryNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasMemoryNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasMemoryNamespace(ctx, req.(*UpdateCanvasMemoryNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_UpdateCanvasRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCanvasRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).UpdateCanvasRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_UpdateCanvasRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).UpdateCanvasRetentionPolicy(ctx, req.(*UpdateCanvasRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_GetCanvasRetentionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCanvasRetentionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).GetCanvasRetentionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_GetCanvasRetentionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).GetCanvasRetentionReport(ctx, req.(*GetCanvasRetentionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListCanvasRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCanvasRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListCanvasRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListCanvasRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListCanvasRoleBindings(ctx, req.(*ListCanvasRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_AssignCanvasRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCanvasRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).AssignCanvasRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_AssignCanvasRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).AssignCanvasRole(ctx, req.(*AssignCanvasRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_RemoveCanvasRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCanvasRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).RemoveCanvasRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_RemoveCanvasRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).RemoveCanvasRole(ctx, req.(*RemoveCanvasRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Canvases_ListEventExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEventExecutionsRequest)
 	if err := dec(in); err != nil {
@@ -1088,6 +1490,114 @@ func _Canvases_SendAiMessage_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Canvases_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_EvaluateExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).EvaluateExpression(ctx, req.(*EvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ReplayDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ReplayCanvasEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayCanvasEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ReplayCanvasEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ReplayCanvasEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ReplayCanvasEvent(ctx, req.(*ReplayCanvasEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ExportCanvasBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCanvasBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ExportCanvasBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ExportCanvasBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ExportCanvasBundle(ctx, req.(*ExportCanvasBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Canvases_ImportCanvasBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCanvasBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CanvasesServer).ImportCanvasBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Canvases_ImportCanvasBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CanvasesServer).ImportCanvasBundle(ctx, req.(*ImportCanvasBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Canvases_ServiceDesc is the grpc.ServiceDesc for Canvases service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1191,6 +1701,14 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelExecution",
 			Handler:    _Canvases_CancelExecution_Handler,
 		},
+		{
+			MethodName: "RerunExecution",
+			Handler:    _Canvases_RerunExecution_Handler,
+		},
+		{
+			MethodName: "GetExecutionLogs",
+			Handler:    _Canvases_GetExecutionLogs_Handler,
+		},
 		{
 			MethodName: "ResolveExecutionErrors",
 			Handler:    _Canvases_ResolveExecutionErrors_Handler,
@@ -1207,6 +1725,34 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCanvasMemory",
 			Handler:    _Canvases_DeleteCanvasMemory_Handler,
 		},
+		{
+			MethodName: "ListCanvasMemoryNamespaces",
+			Handler:    _Canvases_ListCanvasMemoryNamespaces_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasMemoryNamespace",
+			Handler:    _Canvases_UpdateCanvasMemoryNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateCanvasRetentionPolicy",
+			Handler:    _Canvases_UpdateCanvasRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetCanvasRetentionReport",
+			Handler:    _Canvases_GetCanvasRetentionReport_Handler,
+		},
+		{
+			MethodName: "ListCanvasRoleBindings",
+			Handler:    _Canvases_ListCanvasRoleBindings_Handler,
+		},
+		{
+			MethodName: "AssignCanvasRole",
+			Handler:    _Canvases_AssignCanvasRole_Handler,
+		},
+		{
+			MethodName: "RemoveCanvasRole",
+			Handler:    _Canvases_RemoveCanvasRole_Handler,
+		},
 		{
 			MethodName: "ListEventExecutions",
 			Handler:    _Canvases_ListEventExecutions_Handler,
@@ -1215,6 +1761,30 @@ var Canvases_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendAiMessage",
 			Handler:    _Canvases_SendAiMessage_Handler,
 		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _Canvases_EvaluateExpression_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _Canvases_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _Canvases_ReplayDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReplayCanvasEvent",
+			Handler:    _Canvases_ReplayCanvasEvent_Handler,
+		},
+		{
+			MethodName: "ExportCanvasBundle",
+			Handler:    _Canvases_ExportCanvasBundle_Handler,
+		},
+		{
+			MethodName: "ImportCanvasBundle",
+			Handler:    _Canvases_ImportCanvasBundle_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "canvases.proto",
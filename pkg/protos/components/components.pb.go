@@ -78,6 +78,101 @@ func (Node_Type) EnumDescriptor() ([]byte, []int) {
 	return file_components_proto_rawDescGZIP(), []int{9, 0}
 }
 
+type Node_QueuePolicy int32
+
+const (
+	Node_QUEUE_POLICY_FIFO             Node_QueuePolicy = 0
+	Node_QUEUE_POLICY_LATEST_WINS      Node_QueuePolicy = 1
+	Node_QUEUE_POLICY_REJECT_WHEN_BUSY Node_QueuePolicy = 2
+)
+
+// Enum value maps for Node_QueuePolicy.
+var (
+	Node_QueuePolicy_name = map[int32]string{
+		0: "QUEUE_POLICY_FIFO",
+		1: "QUEUE_POLICY_LATEST_WINS",
+		2: "QUEUE_POLICY_REJECT_WHEN_BUSY",
+	}
+	Node_QueuePolicy_value = map[string]int32{
+		"QUEUE_POLICY_FIFO":             0,
+		"QUEUE_POLICY_LATEST_WINS":      1,
+		"QUEUE_POLICY_REJECT_WHEN_BUSY": 2,
+	}
+)
+
+func (x Node_QueuePolicy) Enum() *Node_QueuePolicy {
+	p := new(Node_QueuePolicy)
+	*p = x
+	return p
+}
+
+func (x Node_QueuePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Node_QueuePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_components_proto_enumTypes[1].Descriptor()
+}
+
+func (Node_QueuePolicy) Type() protoreflect.EnumType {
+	return &file_components_proto_enumTypes[1]
+}
+
+func (x Node_QueuePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Node_QueuePolicy.Descriptor instead.
+func (Node_QueuePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{9, 1}
+}
+
+type RetryPolicy_Backoff int32
+
+const (
+	RetryPolicy_BACKOFF_FIXED       RetryPolicy_Backoff = 0
+	RetryPolicy_BACKOFF_EXPONENTIAL RetryPolicy_Backoff = 1
+)
+
+// Enum value maps for RetryPolicy_Backoff.
+var (
+	RetryPolicy_Backoff_name = map[int32]string{
+		0: "BACKOFF_FIXED",
+		1: "BACKOFF_EXPONENTIAL",
+	}
+	RetryPolicy_Backoff_value = map[string]int32{
+		"BACKOFF_FIXED":       0,
+		"BACKOFF_EXPONENTIAL": 1,
+	}
+)
+
+func (x RetryPolicy_Backoff) Enum() *RetryPolicy_Backoff {
+	p := new(RetryPolicy_Backoff)
+	*p = x
+	return p
+}
+
+func (x RetryPolicy_Backoff) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryPolicy_Backoff) Descriptor() protoreflect.EnumDescriptor {
+	return file_components_proto_enumTypes[2].Descriptor()
+}
+
+func (RetryPolicy_Backoff) Type() protoreflect.EnumType {
+	return &file_components_proto_enumTypes[2]
+}
+
+func (x RetryPolicy_Backoff) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryPolicy_Backoff.Descriptor instead.
+func (RetryPolicy_Backoff) EnumDescriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type ListComponentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -571,6 +666,10 @@ type Node struct {
 	ErrorMessage   string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	WarningMessage string                 `protobuf:"bytes,14,opt,name=warning_message,json=warningMessage,proto3" json:"warning_message,omitempty"`
 	Paused         bool                   `protobuf:"varint,15,opt,name=paused,proto3" json:"paused,omitempty"`
+	RetryPolicy    *RetryPolicy           `protobuf:"bytes,16,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,17,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxConcurrency int32                  `protobuf:"varint,18,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	QueuePolicy    Node_QueuePolicy       `protobuf:"varint,19,opt,name=queue_policy,json=queuePolicy,proto3,enum=Superplane.Components.Node_QueuePolicy" json:"queue_policy,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -710,6 +809,110 @@ func (x *Node) GetPaused() bool {
 	return false
 }
 
+func (x *Node) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Node) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Node) GetMaxConcurrency() int32 {
+	if x != nil {
+		return x.MaxConcurrency
+	}
+	return 0
+}
+
+func (x *Node) GetQueuePolicy() Node_QueuePolicy {
+	if x != nil {
+		return x.QueuePolicy
+	}
+	return Node_QUEUE_POLICY_FIFO
+}
+
+type RetryPolicy struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts     int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff         RetryPolicy_Backoff    `protobuf:"varint,2,opt,name=backoff,proto3,enum=Superplane.Components.RetryPolicy_Backoff" json:"backoff,omitempty"`
+	DelaySeconds    int32                  `protobuf:"varint,3,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	MaxDelaySeconds int32                  `protobuf:"varint,4,opt,name=max_delay_seconds,json=maxDelaySeconds,proto3" json:"max_delay_seconds,omitempty"`
+	RetryOn         []string               `protobuf:"bytes,5,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_components_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_components_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_components_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() RetryPolicy_Backoff {
+	if x != nil {
+		return x.Backoff
+	}
+	return RetryPolicy_BACKOFF_FIXED
+}
+
+func (x *RetryPolicy) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxDelaySeconds() int32 {
+	if x != nil {
+		return x.MaxDelaySeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
 type Position struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -720,7 +923,7 @@ type Position struct {
 
 func (x *Position) Reset() {
 	*x = Position{}
-	mi := &file_components_proto_msgTypes[10]
+	mi := &file_components_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -732,7 +935,7 @@ func (x *Position) String() string {
 func (*Position) ProtoMessage() {}
 
 func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[10]
+	mi := &file_components_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,7 +948,7 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Position.ProtoReflect.Descriptor instead.
 func (*Position) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{10}
+	return file_components_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Position) GetX() int32 {
@@ -773,7 +976,7 @@ type Edge struct {
 
 func (x *Edge) Reset() {
 	*x = Edge{}
-	mi := &file_components_proto_msgTypes[11]
+	mi := &file_components_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -785,7 +988,7 @@ func (x *Edge) String() string {
 func (*Edge) ProtoMessage() {}
 
 func (x *Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[11]
+	mi := &file_components_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +1001,7 @@ func (x *Edge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Edge.ProtoReflect.Descriptor instead.
 func (*Edge) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{11}
+	return file_components_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Edge) GetSourceId() string {
@@ -832,7 +1035,7 @@ type IntegrationRef struct {
 
 func (x *IntegrationRef) Reset() {
 	*x = IntegrationRef{}
-	mi := &file_components_proto_msgTypes[12]
+	mi := &file_components_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1047,7 @@ func (x *IntegrationRef) String() string {
 func (*IntegrationRef) ProtoMessage() {}
 
 func (x *IntegrationRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[12]
+	mi := &file_components_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1060,7 @@ func (x *IntegrationRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationRef.ProtoReflect.Descriptor instead.
 func (*IntegrationRef) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{12}
+	return file_components_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *IntegrationRef) GetId() string {
@@ -892,7 +1095,7 @@ type NotificationEmailRequested struct {
 
 func (x *NotificationEmailRequested) Reset() {
 	*x = NotificationEmailRequested{}
-	mi := &file_components_proto_msgTypes[13]
+	mi := &file_components_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -904,7 +1107,7 @@ func (x *NotificationEmailRequested) String() string {
 func (*NotificationEmailRequested) ProtoMessage() {}
 
 func (x *NotificationEmailRequested) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[13]
+	mi := &file_components_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -917,7 +1120,7 @@ func (x *NotificationEmailRequested) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationEmailRequested.ProtoReflect.Descriptor instead.
 func (*NotificationEmailRequested) Descriptor() ([]byte, []int) {
-	return file_components_proto_rawDescGZIP(), []int{13}
+	return file_components_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NotificationEmailRequested) GetOrganizationId() string {
@@ -992,7 +1195,7 @@ type Node_ComponentRef struct {
 
 func (x *Node_ComponentRef) Reset() {
 	*x = Node_ComponentRef{}
-	mi := &file_components_proto_msgTypes[14]
+	mi := &file_components_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1207,7 @@ func (x *Node_ComponentRef) String() string {
 func (*Node_ComponentRef) ProtoMessage() {}
 
 func (x *Node_ComponentRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[14]
+	mi := &file_components_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1239,7 @@ type Node_TriggerRef struct {
 
 func (x *Node_TriggerRef) Reset() {
 	*x = Node_TriggerRef{}
-	mi := &file_components_proto_msgTypes[15]
+	mi := &file_components_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1048,7 +1251,7 @@ func (x *Node_TriggerRef) String() string {
 func (*Node_TriggerRef) ProtoMessage() {}
 
 func (x *Node_TriggerRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[15]
+	mi := &file_components_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,7 +1283,7 @@ type Node_WidgetRef struct {
 
 func (x *Node_WidgetRef) Reset() {
 	*x = Node_WidgetRef{}
-	mi := &file_components_proto_msgTypes[16]
+	mi := &file_components_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1092,7 +1295,7 @@ func (x *Node_WidgetRef) String() string {
 func (*Node_WidgetRef) ProtoMessage() {}
 
 func (x *Node_WidgetRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[16]
+	mi := &file_components_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,15 +1319,21 @@ func (x *Node_WidgetRef) GetName() string {
 }
 
 type Node_BlueprintRef struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The published version of the blueprint the node uses.
+	// Zero pins the node to the latest version when the canvas is saved.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The latest published version of the blueprint, set in responses.
+	// An upgrade is available when it is greater than version.
+	LatestVersion int32 `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Node_BlueprintRef) Reset() {
 	*x = Node_BlueprintRef{}
-	mi := &file_components_proto_msgTypes[17]
+	mi := &file_components_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1136,7 +1345,7 @@ func (x *Node_BlueprintRef) String() string {
 func (*Node_BlueprintRef) ProtoMessage() {}
 
 func (x *Node_BlueprintRef) ProtoReflect() protoreflect.Message {
-	mi := &file_components_proto_msgTypes[17]
+	mi := &file_components_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,6 +1368,20 @@ func (x *Node_BlueprintRef) GetId() string {
 	return ""
 }
 
+func (x *Node_BlueprintRef) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Node_BlueprintRef) GetLatestVersion() int32 {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return 0
+}
+
 var File_components_proto protoreflect.FileDescriptor
 
 const file_components_proto_rawDesc = "" +
@@ -1195,7 +1418,8 @@ const file_components_proto_rawDesc = "" +
 	"parameters\x18\x03 \x03(\v2\x1f.Superplane.Configuration.FieldR\n" +
 	"parameters\"`\n" +
 	"\x1cListComponentActionsResponse\x12@\n" +
-	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xce\a\n" +
+	"\aactions\x18\x01 \x03(\v2&.Superplane.Components.ComponentActionR\aactions\"\xdb\n" +
+	"\n" +
 	"\x04Node\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
@@ -1212,21 +1436,40 @@ const file_components_proto_rawDesc = "" +
 	"\vintegration\x18\f \x01(\v2%.Superplane.Components.IntegrationRefR\vintegration\x12#\n" +
 	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x12'\n" +
 	"\x0fwarning_message\x18\x0e \x01(\tR\x0ewarningMessage\x12\x16\n" +
-	"\x06paused\x18\x0f \x01(\bR\x06paused\x1a\"\n" +
+	"\x06paused\x18\x0f \x01(\bR\x06paused\x12E\n" +
+	"\fretry_policy\x18\x10 \x01(\v2\".Superplane.Components.RetryPolicyR\vretryPolicy\x12'\n" +
+	"\x0ftimeout_seconds\x18\x11 \x01(\x05R\x0etimeoutSeconds\x12'\n" +
+	"\x0fmax_concurrency\x18\x12 \x01(\x05R\x0emaxConcurrency\x12J\n" +
+	"\fqueue_policy\x18\x13 \x01(\x0e2'.Superplane.Components.Node.QueuePolicyR\vqueuePolicy\x1a\"\n" +
 	"\fComponentRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a \n" +
 	"\n" +
 	"TriggerRef\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x1a\x1f\n" +
 	"\tWidgetRef\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x1a\x1e\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x1a_\n" +
 	"\fBlueprintRef\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12%\n" +
+	"\x0elatest_version\x18\x03 \x01(\x05R\rlatestVersion\"Q\n" +
 	"\x04Type\x12\x12\n" +
 	"\x0eTYPE_COMPONENT\x10\x00\x12\x12\n" +
 	"\x0eTYPE_BLUEPRINT\x10\x01\x12\x10\n" +
 	"\fTYPE_TRIGGER\x10\x02\x12\x0f\n" +
-	"\vTYPE_WIDGET\x10\x03\"&\n" +
+	"\vTYPE_WIDGET\x10\x03\"e\n" +
+	"\vQueuePolicy\x12\x15\n" +
+	"\x11QUEUE_POLICY_FIFO\x10\x00\x12\x1c\n" +
+	"\x18QUEUE_POLICY_LATEST_WINS\x10\x01\x12!\n" +
+	"\x1dQUEUE_POLICY_REJECT_WHEN_BUSY\x10\x02\"\x99\x02\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12D\n" +
+	"\abackoff\x18\x02 \x01(\x0e2*.Superplane.Components.RetryPolicy.BackoffR\abackoff\x12#\n" +
+	"\rdelay_seconds\x18\x03 \x01(\x05R\fdelaySeconds\x12*\n" +
+	"\x11max_delay_seconds\x18\x04 \x01(\x05R\x0fmaxDelaySeconds\x12\x19\n" +
+	"\bretry_on\x18\x05 \x03(\tR\aretryOn\"5\n" +
+	"\aBackoff\x12\x11\n" +
+	"\rBACKOFF_FIXED\x10\x00\x12\x17\n" +
+	"\x13BACKOFF_EXPONENTIAL\x10\x01\"&\n" +
 	"\bPosition\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x05R\x01y\"Z\n" +
@@ -1270,61 +1513,67 @@ func file_components_proto_rawDescGZIP() []byte {
 	return file_components_proto_rawDescData
 }
 
-var file_components_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_components_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_components_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_components_proto_goTypes = []any{
 	(Node_Type)(0),                       // 0: Superplane.Components.Node.Type
-	(*ListComponentsRequest)(nil),        // 1: Superplane.Components.ListComponentsRequest
-	(*ListComponentsResponse)(nil),       // 2: Superplane.Components.ListComponentsResponse
-	(*DescribeComponentRequest)(nil),     // 3: Superplane.Components.DescribeComponentRequest
-	(*DescribeComponentResponse)(nil),    // 4: Superplane.Components.DescribeComponentResponse
-	(*Component)(nil),                    // 5: Superplane.Components.Component
-	(*OutputChannel)(nil),                // 6: Superplane.Components.OutputChannel
-	(*ListComponentActionsRequest)(nil),  // 7: Superplane.Components.ListComponentActionsRequest
-	(*ComponentAction)(nil),              // 8: Superplane.Components.ComponentAction
-	(*ListComponentActionsResponse)(nil), // 9: Superplane.Components.ListComponentActionsResponse
-	(*Node)(nil),                         // 10: Superplane.Components.Node
-	(*Position)(nil),                     // 11: Superplane.Components.Position
-	(*Edge)(nil),                         // 12: Superplane.Components.Edge
-	(*IntegrationRef)(nil),               // 13: Superplane.Components.IntegrationRef
-	(*NotificationEmailRequested)(nil),   // 14: Superplane.Components.NotificationEmailRequested
-	(*Node_ComponentRef)(nil),            // 15: Superplane.Components.Node.ComponentRef
-	(*Node_TriggerRef)(nil),              // 16: Superplane.Components.Node.TriggerRef
-	(*Node_WidgetRef)(nil),               // 17: Superplane.Components.Node.WidgetRef
-	(*Node_BlueprintRef)(nil),            // 18: Superplane.Components.Node.BlueprintRef
-	(*configuration.Field)(nil),          // 19: Superplane.Configuration.Field
-	(*_struct.Struct)(nil),               // 20: google.protobuf.Struct
-	(*timestamp.Timestamp)(nil),          // 21: google.protobuf.Timestamp
+	(Node_QueuePolicy)(0),                // 1: Superplane.Components.Node.QueuePolicy
+	(RetryPolicy_Backoff)(0),             // 2: Superplane.Components.RetryPolicy.Backoff
+	(*ListComponentsRequest)(nil),        // 3: Superplane.Components.ListComponentsRequest
+	(*ListComponentsResponse)(nil),       // 4: Superplane.Components.ListComponentsResponse
+	(*DescribeComponentRequest)(nil),     // 5: Superplane.Components.DescribeComponentRequest
+	(*DescribeComponentResponse)(nil),    // 6: Superplane.Components.DescribeComponentResponse
+	(*Component)(nil),                    // 7: Superplane.Components.Component
+	(*OutputChannel)(nil),                // 8: Superplane.Components.OutputChannel
+	(*ListComponentActionsRequest)(nil),  // 9: Superplane.Components.ListComponentActionsRequest
+	(*ComponentAction)(nil),              // 10: Superplane.Components.ComponentAction
+	(*ListComponentActionsResponse)(nil), // 11: Superplane.Components.ListComponentActionsResponse
+	(*Node)(nil),                         // 12: Superplane.Components.Node
+	(*RetryPolicy)(nil),                  // 13: Superplane.Components.RetryPolicy
+	(*Position)(nil),                     // 14: Superplane.Components.Position
+	(*Edge)(nil),                         // 15: Superplane.Components.Edge
+	(*IntegrationRef)(nil),               // 16: Superplane.Components.IntegrationRef
+	(*NotificationEmailRequested)(nil),   // 17: Superplane.Components.NotificationEmailRequested
+	(*Node_ComponentRef)(nil),            // 18: Superplane.Components.Node.ComponentRef
+	(*Node_TriggerRef)(nil),              // 19: Superplane.Components.Node.TriggerRef
+	(*Node_WidgetRef)(nil),               // 20: Superplane.Components.Node.WidgetRef
+	(*Node_BlueprintRef)(nil),            // 21: Superplane.Components.Node.BlueprintRef
+	(*configuration.Field)(nil),          // 22: Superplane.Configuration.Field
+	(*_struct.Struct)(nil),               // 23: google.protobuf.Struct
+	(*timestamp.Timestamp)(nil),          // 24: google.protobuf.Timestamp
 }
 var file_components_proto_depIdxs = []int32{
-	5,  // 0: Superplane.Components.ListComponentsResponse.components:type_name -> Superplane.Components.Component
-	5,  // 1: Superplane.Components.DescribeComponentResponse.component:type_name -> Superplane.Components.Component
-	19, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
-	6,  // 3: Superplane.Components.Component.output_channels:type_name -> Superplane.Components.OutputChannel
-	20, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
-	19, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
-	8,  // 6: Superplane.Components.ListComponentActionsResponse.actions:type_name -> Superplane.Components.ComponentAction
+	7,  // 0: Superplane.Components.ListComponentsResponse.components:type_name -> Superplane.Components.Component
+	7,  // 1: Superplane.Components.DescribeComponentResponse.component:type_name -> Superplane.Components.Component
+	22, // 2: Superplane.Components.Component.configuration:type_name -> Superplane.Configuration.Field
+	8,  // 3: Superplane.Components.Component.output_channels:type_name -> Superplane.Components.OutputChannel
+	23, // 4: Superplane.Components.Component.example_output:type_name -> google.protobuf.Struct
+	22, // 5: Superplane.Components.ComponentAction.parameters:type_name -> Superplane.Configuration.Field
+	10, // 6: Superplane.Components.ListComponentActionsResponse.actions:type_name -> Superplane.Components.ComponentAction
 	0,  // 7: Superplane.Components.Node.type:type_name -> Superplane.Components.Node.Type
-	20, // 8: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
-	20, // 9: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
-	11, // 10: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
-	15, // 11: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
-	18, // 12: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
-	16, // 13: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
-	17, // 14: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
-	13, // 15: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
-	21, // 16: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 17: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
-	3,  // 18: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
-	7,  // 19: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
-	2,  // 20: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
-	4,  // 21: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
-	9,  // 22: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
-	20, // [20:23] is the sub-list for method output_type
-	17, // [17:20] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	23, // 8: Superplane.Components.Node.configuration:type_name -> google.protobuf.Struct
+	23, // 9: Superplane.Components.Node.metadata:type_name -> google.protobuf.Struct
+	14, // 10: Superplane.Components.Node.position:type_name -> Superplane.Components.Position
+	18, // 11: Superplane.Components.Node.component:type_name -> Superplane.Components.Node.ComponentRef
+	21, // 12: Superplane.Components.Node.blueprint:type_name -> Superplane.Components.Node.BlueprintRef
+	19, // 13: Superplane.Components.Node.trigger:type_name -> Superplane.Components.Node.TriggerRef
+	20, // 14: Superplane.Components.Node.widget:type_name -> Superplane.Components.Node.WidgetRef
+	16, // 15: Superplane.Components.Node.integration:type_name -> Superplane.Components.IntegrationRef
+	13, // 16: Superplane.Components.Node.retry_policy:type_name -> Superplane.Components.RetryPolicy
+	1,  // 17: Superplane.Components.Node.queue_policy:type_name -> Superplane.Components.Node.QueuePolicy
+	2,  // 18: Superplane.Components.RetryPolicy.backoff:type_name -> Superplane.Components.RetryPolicy.Backoff
+	24, // 19: Superplane.Components.NotificationEmailRequested.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 20: Superplane.Components.Components.ListComponents:input_type -> Superplane.Components.ListComponentsRequest
+	5,  // 21: Superplane.Components.Components.DescribeComponent:input_type -> Superplane.Components.DescribeComponentRequest
+	9,  // 22: Superplane.Components.Components.ListComponentActions:input_type -> Superplane.Components.ListComponentActionsRequest
+	4,  // 23: Superplane.Components.Components.ListComponents:output_type -> Superplane.Components.ListComponentsResponse
+	6,  // 24: Superplane.Components.Components.DescribeComponent:output_type -> Superplane.Components.DescribeComponentResponse
+	11, // 25: Superplane.Components.Components.ListComponentActions:output_type -> Superplane.Components.ListComponentActionsResponse
+	23, // [23:26] is the sub-list for method output_type
+	20, // [20:23] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_components_proto_init() }
@@ -1337,8 +1586,8 @@ func file_components_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_components_proto_rawDesc), len(file_components_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
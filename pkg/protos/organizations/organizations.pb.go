@@ -1573,6 +1573,1496 @@ func (x *DeleteAgentOpenAIKeyResponse) GetAgentSettings() *AgentSettings {
 	return nil
 }
 
+type RetentionPolicy struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxAgeDays           int32                  `protobuf:"varint,1,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	MaxExecutionsPerNode int32                  `protobuf:"varint,2,opt,name=max_executions_per_node,json=maxExecutionsPerNode,proto3" json:"max_executions_per_node,omitempty"`
+	FailedMaxAgeDays     int32                  `protobuf:"varint,3,opt,name=failed_max_age_days,json=failedMaxAgeDays,proto3" json:"failed_max_age_days,omitempty"`
+	UpdatedAt            *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy            string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_organizations_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RetentionPolicy) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMaxExecutionsPerNode() int32 {
+	if x != nil {
+		return x.MaxExecutionsPerNode
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetFailedMaxAgeDays() int32 {
+	if x != nil {
+		return x.FailedMaxAgeDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRetentionPolicyRequest) Reset() {
+	*x = GetRetentionPolicyRequest{}
+	mi := &file_organizations_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *GetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetRetentionPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRetentionPolicyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,1,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRetentionPolicyResponse) Reset() {
+	*x = GetRetentionPolicyResponse{}
+	mi := &file_organizations_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *GetRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRetentionPolicyResponse) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+type UpdateRetentionPolicyRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaxAgeDays           int32                  `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	MaxExecutionsPerNode int32                  `protobuf:"varint,3,opt,name=max_executions_per_node,json=maxExecutionsPerNode,proto3" json:"max_executions_per_node,omitempty"`
+	FailedMaxAgeDays     int32                  `protobuf:"varint,4,opt,name=failed_max_age_days,json=failedMaxAgeDays,proto3" json:"failed_max_age_days,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateRetentionPolicyRequest) Reset() {
+	*x = UpdateRetentionPolicyRequest{}
+	mi := &file_organizations_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateRetentionPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRetentionPolicyRequest) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *UpdateRetentionPolicyRequest) GetMaxExecutionsPerNode() int32 {
+	if x != nil {
+		return x.MaxExecutionsPerNode
+	}
+	return 0
+}
+
+func (x *UpdateRetentionPolicyRequest) GetFailedMaxAgeDays() int32 {
+	if x != nil {
+		return x.FailedMaxAgeDays
+	}
+	return 0
+}
+
+type UpdateRetentionPolicyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,1,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateRetentionPolicyResponse) Reset() {
+	*x = UpdateRetentionPolicyResponse{}
+	mi := &file_organizations_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateRetentionPolicyResponse) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName     string                 `protobuf:"bytes,3,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	ActorEmail    string                 `protobuf:"bytes,4,opt,name=actor_email,json=actorEmail,proto3" json:"actor_email,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,6,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Action        string                 `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+	Method        string                 `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Before        *_struct.Struct        `protobuf:"bytes,11,opt,name=before,proto3" json:"before,omitempty"`
+	After         *_struct.Struct        `protobuf:"bytes,12,opt,name=after,proto3" json:"after,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_organizations_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorEmail() string {
+	if x != nil {
+		return x.ActorEmail
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() *_struct.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *_struct.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ResourceType  string                 `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Since         *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Before        *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	Limit         uint32                 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_organizations_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListAuditEventsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamp.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	HasNextPage   bool                   `protobuf:"varint,2,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	LastTimestamp *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_organizations_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *ListAuditEventsResponse) GetLastTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type EventSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Either webhook or syslog.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// HTTP endpoint for webhook sinks, or udp://, tcp:// or tls:// address for syslog sinks.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Any of canvas_events, executions and audit_events.
+	Categories      []string             `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	Enabled         bool                 `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	HasSecret       bool                 `protobuf:"varint,5,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"`
+	Backlog         *EventSink_Backlog   `protobuf:"bytes,6,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	LastDeliveredAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=last_delivered_at,json=lastDeliveredAt,proto3" json:"last_delivered_at,omitempty"`
+	LastError       string               `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt     *timestamp.Timestamp `protobuf:"bytes,9,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`
+	UpdatedAt       *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       string               `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EventSink) Reset() {
+	*x = EventSink{}
+	mi := &file_organizations_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSink) ProtoMessage() {}
+
+func (x *EventSink) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSink.ProtoReflect.Descriptor instead.
+func (*EventSink) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EventSink) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *EventSink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EventSink) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *EventSink) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EventSink) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *EventSink) GetBacklog() *EventSink_Backlog {
+	if x != nil {
+		return x.Backlog
+	}
+	return nil
+}
+
+func (x *EventSink) GetLastDeliveredAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastDeliveredAt
+	}
+	return nil
+}
+
+func (x *EventSink) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *EventSink) GetLastErrorAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return nil
+}
+
+func (x *EventSink) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EventSink) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetEventSinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventSinkRequest) Reset() {
+	*x = GetEventSinkRequest{}
+	mi := &file_organizations_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventSinkRequest) ProtoMessage() {}
+
+func (x *GetEventSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventSinkRequest.ProtoReflect.Descriptor instead.
+func (*GetEventSinkRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetEventSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetEventSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventSink     *EventSink             `protobuf:"bytes,1,opt,name=event_sink,json=eventSink,proto3" json:"event_sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventSinkResponse) Reset() {
+	*x = GetEventSinkResponse{}
+	mi := &file_organizations_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventSinkResponse) ProtoMessage() {}
+
+func (x *GetEventSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventSinkResponse.ProtoReflect.Descriptor instead.
+func (*GetEventSinkResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetEventSinkResponse) GetEventSink() *EventSink {
+	if x != nil {
+		return x.EventSink
+	}
+	return nil
+}
+
+type UpdateEventSinkRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Url        string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Categories []string               `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Enabled    bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Key used to sign the events. Required when the sink is created,
+	// and kept as it is when left empty on updates.
+	Secret        string `protobuf:"bytes,6,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventSinkRequest) Reset() {
+	*x = UpdateEventSinkRequest{}
+	mi := &file_organizations_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventSinkRequest) ProtoMessage() {}
+
+func (x *UpdateEventSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventSinkRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventSinkRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateEventSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventSinkRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateEventSinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateEventSinkRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *UpdateEventSinkRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateEventSinkRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type UpdateEventSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventSink     *EventSink             `protobuf:"bytes,1,opt,name=event_sink,json=eventSink,proto3" json:"event_sink,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventSinkResponse) Reset() {
+	*x = UpdateEventSinkResponse{}
+	mi := &file_organizations_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventSinkResponse) ProtoMessage() {}
+
+func (x *UpdateEventSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventSinkResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventSinkResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateEventSinkResponse) GetEventSink() *EventSink {
+	if x != nil {
+		return x.EventSink
+	}
+	return nil
+}
+
+type DeleteEventSinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventSinkRequest) Reset() {
+	*x = DeleteEventSinkRequest{}
+	mi := &file_organizations_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventSinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventSinkRequest) ProtoMessage() {}
+
+func (x *DeleteEventSinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventSinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventSinkRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteEventSinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEventSinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventSinkResponse) Reset() {
+	*x = DeleteEventSinkResponse{}
+	mi := &file_organizations_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventSinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventSinkResponse) ProtoMessage() {}
+
+func (x *DeleteEventSinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventSinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventSinkResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{45}
+}
+
+type RetryEventSinkDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEventSinkDeliveriesRequest) Reset() {
+	*x = RetryEventSinkDeliveriesRequest{}
+	mi := &file_organizations_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEventSinkDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEventSinkDeliveriesRequest) ProtoMessage() {}
+
+func (x *RetryEventSinkDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEventSinkDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*RetryEventSinkDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RetryEventSinkDeliveriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryEventSinkDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requeued      int64                  `protobuf:"varint,1,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryEventSinkDeliveriesResponse) Reset() {
+	*x = RetryEventSinkDeliveriesResponse{}
+	mi := &file_organizations_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryEventSinkDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryEventSinkDeliveriesResponse) ProtoMessage() {}
+
+func (x *RetryEventSinkDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryEventSinkDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*RetryEventSinkDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RetryEventSinkDeliveriesResponse) GetRequeued() int64 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+type SAMLSettings struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// When enforced, members can only use the organization after signing in with the identity provider.
+	Enforced       bool   `protobuf:"varint,2,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	IdpMetadataXml string `protobuf:"bytes,3,opt,name=idp_metadata_xml,json=idpMetadataXml,proto3" json:"idp_metadata_xml,omitempty"`
+	IdpEntityId    string `protobuf:"bytes,4,opt,name=idp_entity_id,json=idpEntityId,proto3" json:"idp_entity_id,omitempty"`
+	IdpSsoUrl      string `protobuf:"bytes,5,opt,name=idp_sso_url,json=idpSsoUrl,proto3" json:"idp_sso_url,omitempty"`
+	// Attribute holding the email address. The NameID is used when empty.
+	EmailAttribute string `protobuf:"bytes,6,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	NameAttribute  string `protobuf:"bytes,7,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`
+	// Attribute holding the IdP groups of the person, used by the group mappings.
+	GroupsAttribute string                       `protobuf:"bytes,8,opt,name=groups_attribute,json=groupsAttribute,proto3" json:"groups_attribute,omitempty"`
+	GroupMappings   []*SAMLSettings_GroupMapping `protobuf:"bytes,9,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
+	SpEntityId      string                       `protobuf:"bytes,10,opt,name=sp_entity_id,json=spEntityId,proto3" json:"sp_entity_id,omitempty"`
+	SpAcsUrl        string                       `protobuf:"bytes,11,opt,name=sp_acs_url,json=spAcsUrl,proto3" json:"sp_acs_url,omitempty"`
+	SpMetadataUrl   string                       `protobuf:"bytes,12,opt,name=sp_metadata_url,json=spMetadataUrl,proto3" json:"sp_metadata_url,omitempty"`
+	LoginUrl        string                       `protobuf:"bytes,13,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	UpdatedAt       *timestamp.Timestamp         `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       string                       `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SAMLSettings) Reset() {
+	*x = SAMLSettings{}
+	mi := &file_organizations_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLSettings) ProtoMessage() {}
+
+func (x *SAMLSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLSettings.ProtoReflect.Descriptor instead.
+func (*SAMLSettings) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SAMLSettings) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SAMLSettings) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+func (x *SAMLSettings) GetIdpMetadataXml() string {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetIdpEntityId() string {
+	if x != nil {
+		return x.IdpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetIdpSsoUrl() string {
+	if x != nil {
+		return x.IdpSsoUrl
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetGroupsAttribute() string {
+	if x != nil {
+		return x.GroupsAttribute
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetGroupMappings() []*SAMLSettings_GroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
+
+func (x *SAMLSettings) GetSpEntityId() string {
+	if x != nil {
+		return x.SpEntityId
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetSpAcsUrl() string {
+	if x != nil {
+		return x.SpAcsUrl
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetSpMetadataUrl() string {
+	if x != nil {
+		return x.SpMetadataUrl
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+func (x *SAMLSettings) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SAMLSettings) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type GetSAMLSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSAMLSettingsRequest) Reset() {
+	*x = GetSAMLSettingsRequest{}
+	mi := &file_organizations_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSAMLSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSAMLSettingsRequest) ProtoMessage() {}
+
+func (x *GetSAMLSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSAMLSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSAMLSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetSAMLSettingsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSAMLSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *SAMLSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSAMLSettingsResponse) Reset() {
+	*x = GetSAMLSettingsResponse{}
+	mi := &file_organizations_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSAMLSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSAMLSettingsResponse) ProtoMessage() {}
+
+func (x *GetSAMLSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSAMLSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSAMLSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetSAMLSettingsResponse) GetSettings() *SAMLSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSAMLSettingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled  bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enforced bool                   `protobuf:"varint,3,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	// Metadata document of the identity provider.
+	// Required when SAML is configured, and kept as it is when left empty on updates.
+	IdpMetadataXml  string                       `protobuf:"bytes,4,opt,name=idp_metadata_xml,json=idpMetadataXml,proto3" json:"idp_metadata_xml,omitempty"`
+	EmailAttribute  string                       `protobuf:"bytes,5,opt,name=email_attribute,json=emailAttribute,proto3" json:"email_attribute,omitempty"`
+	NameAttribute   string                       `protobuf:"bytes,6,opt,name=name_attribute,json=nameAttribute,proto3" json:"name_attribute,omitempty"`
+	GroupsAttribute string                       `protobuf:"bytes,7,opt,name=groups_attribute,json=groupsAttribute,proto3" json:"groups_attribute,omitempty"`
+	GroupMappings   []*SAMLSettings_GroupMapping `protobuf:"bytes,8,rep,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSAMLSettingsRequest) Reset() {
+	*x = UpdateSAMLSettingsRequest{}
+	mi := &file_organizations_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSAMLSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSAMLSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSAMLSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSAMLSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSAMLSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateSAMLSettingsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSAMLSettingsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateSAMLSettingsRequest) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+func (x *UpdateSAMLSettingsRequest) GetIdpMetadataXml() string {
+	if x != nil {
+		return x.IdpMetadataXml
+	}
+	return ""
+}
+
+func (x *UpdateSAMLSettingsRequest) GetEmailAttribute() string {
+	if x != nil {
+		return x.EmailAttribute
+	}
+	return ""
+}
+
+func (x *UpdateSAMLSettingsRequest) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *UpdateSAMLSettingsRequest) GetGroupsAttribute() string {
+	if x != nil {
+		return x.GroupsAttribute
+	}
+	return ""
+}
+
+func (x *UpdateSAMLSettingsRequest) GetGroupMappings() []*SAMLSettings_GroupMapping {
+	if x != nil {
+		return x.GroupMappings
+	}
+	return nil
+}
+
+type UpdateSAMLSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *SAMLSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSAMLSettingsResponse) Reset() {
+	*x = UpdateSAMLSettingsResponse{}
+	mi := &file_organizations_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSAMLSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSAMLSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateSAMLSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSAMLSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSAMLSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateSAMLSettingsResponse) GetSettings() *SAMLSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 type RemoveUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1583,7 +3073,7 @@ type RemoveUserRequest struct {
 
 func (x *RemoveUserRequest) Reset() {
 	*x = RemoveUserRequest{}
-	mi := &file_organizations_proto_msgTypes[31]
+	mi := &file_organizations_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +3085,7 @@ func (x *RemoveUserRequest) String() string {
 func (*RemoveUserRequest) ProtoMessage() {}
 
 func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[31]
+	mi := &file_organizations_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +3098,7 @@ func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
 func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{31}
+	return file_organizations_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *RemoveUserRequest) GetId() string {
@@ -1633,7 +3123,7 @@ type RemoveUserResponse struct {
 
 func (x *RemoveUserResponse) Reset() {
 	*x = RemoveUserResponse{}
-	mi := &file_organizations_proto_msgTypes[32]
+	mi := &file_organizations_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1645,7 +3135,7 @@ func (x *RemoveUserResponse) String() string {
 func (*RemoveUserResponse) ProtoMessage() {}
 
 func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[32]
+	mi := &file_organizations_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,7 +3148,7 @@ func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
 func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{32}
+	return file_organizations_proto_rawDescGZIP(), []int{54}
 }
 
 type ListIntegrationsRequest struct {
@@ -1670,7 +3160,7 @@ type ListIntegrationsRequest struct {
 
 func (x *ListIntegrationsRequest) Reset() {
 	*x = ListIntegrationsRequest{}
-	mi := &file_organizations_proto_msgTypes[33]
+	mi := &file_organizations_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1682,7 +3172,7 @@ func (x *ListIntegrationsRequest) String() string {
 func (*ListIntegrationsRequest) ProtoMessage() {}
 
 func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[33]
+	mi := &file_organizations_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +3185,7 @@ func (x *ListIntegrationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationsRequest.ProtoReflect.Descriptor instead.
 func (*ListIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{33}
+	return file_organizations_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ListIntegrationsRequest) GetId() string {
@@ -1714,7 +3204,7 @@ type ListIntegrationsResponse struct {
 
 func (x *ListIntegrationsResponse) Reset() {
 	*x = ListIntegrationsResponse{}
-	mi := &file_organizations_proto_msgTypes[34]
+	mi := &file_organizations_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1726,7 +3216,7 @@ func (x *ListIntegrationsResponse) String() string {
 func (*ListIntegrationsResponse) ProtoMessage() {}
 
 func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[34]
+	mi := &file_organizations_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +3229,7 @@ func (x *ListIntegrationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationsResponse.ProtoReflect.Descriptor instead.
 func (*ListIntegrationsResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{34}
+	return file_organizations_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ListIntegrationsResponse) GetIntegrations() []*Integration {
@@ -1761,7 +3251,7 @@ type CreateIntegrationRequest struct {
 
 func (x *CreateIntegrationRequest) Reset() {
 	*x = CreateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[35]
+	mi := &file_organizations_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +3263,7 @@ func (x *CreateIntegrationRequest) String() string {
 func (*CreateIntegrationRequest) ProtoMessage() {}
 
 func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[35]
+	mi := &file_organizations_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +3276,7 @@ func (x *CreateIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*CreateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{35}
+	return file_organizations_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *CreateIntegrationRequest) GetId() string {
@@ -1826,7 +3316,7 @@ type CreateIntegrationResponse struct {
 
 func (x *CreateIntegrationResponse) Reset() {
 	*x = CreateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[36]
+	mi := &file_organizations_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +3328,7 @@ func (x *CreateIntegrationResponse) String() string {
 func (*CreateIntegrationResponse) ProtoMessage() {}
 
 func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[36]
+	mi := &file_organizations_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +3341,7 @@ func (x *CreateIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*CreateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{36}
+	return file_organizations_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *CreateIntegrationResponse) GetIntegration() *Integration {
@@ -1871,7 +3361,7 @@ type DescribeIntegrationRequest struct {
 
 func (x *DescribeIntegrationRequest) Reset() {
 	*x = DescribeIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[37]
+	mi := &file_organizations_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1883,7 +3373,7 @@ func (x *DescribeIntegrationRequest) String() string {
 func (*DescribeIntegrationRequest) ProtoMessage() {}
 
 func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[37]
+	mi := &file_organizations_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,7 +3386,7 @@ func (x *DescribeIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DescribeIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{37}
+	return file_organizations_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *DescribeIntegrationRequest) GetId() string {
@@ -1922,7 +3412,7 @@ type DescribeIntegrationResponse struct {
 
 func (x *DescribeIntegrationResponse) Reset() {
 	*x = DescribeIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[38]
+	mi := &file_organizations_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +3424,7 @@ func (x *DescribeIntegrationResponse) String() string {
 func (*DescribeIntegrationResponse) ProtoMessage() {}
 
 func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[38]
+	mi := &file_organizations_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +3437,7 @@ func (x *DescribeIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*DescribeIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{38}
+	return file_organizations_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *DescribeIntegrationResponse) GetIntegration() *Integration {
@@ -1968,7 +3458,7 @@ type ListIntegrationResourcesRequest struct {
 
 func (x *ListIntegrationResourcesRequest) Reset() {
 	*x = ListIntegrationResourcesRequest{}
-	mi := &file_organizations_proto_msgTypes[39]
+	mi := &file_organizations_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1980,7 +3470,7 @@ func (x *ListIntegrationResourcesRequest) String() string {
 func (*ListIntegrationResourcesRequest) ProtoMessage() {}
 
 func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[39]
+	mi := &file_organizations_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +3483,7 @@ func (x *ListIntegrationResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListIntegrationResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{39}
+	return file_organizations_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListIntegrationResourcesRequest) GetId() string {
@@ -2026,7 +3516,7 @@ type ListIntegrationResourcesResponse struct {
 
 func (x *ListIntegrationResourcesResponse) Reset() {
 	*x = ListIntegrationResourcesResponse{}
-	mi := &file_organizations_proto_msgTypes[40]
+	mi := &file_organizations_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2038,7 +3528,7 @@ func (x *ListIntegrationResourcesResponse) String() string {
 func (*ListIntegrationResourcesResponse) ProtoMessage() {}
 
 func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[40]
+	mi := &file_organizations_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +3541,7 @@ func (x *ListIntegrationResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListIntegrationResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListIntegrationResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{40}
+	return file_organizations_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListIntegrationResourcesResponse) GetResources() []*IntegrationResourceRef {
@@ -2072,7 +3562,7 @@ type IntegrationResourceRef struct {
 
 func (x *IntegrationResourceRef) Reset() {
 	*x = IntegrationResourceRef{}
-	mi := &file_organizations_proto_msgTypes[41]
+	mi := &file_organizations_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2084,7 +3574,7 @@ func (x *IntegrationResourceRef) String() string {
 func (*IntegrationResourceRef) ProtoMessage() {}
 
 func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[41]
+	mi := &file_organizations_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,7 +3587,7 @@ func (x *IntegrationResourceRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationResourceRef.ProtoReflect.Descriptor instead.
 func (*IntegrationResourceRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{41}
+	return file_organizations_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *IntegrationResourceRef) GetType() string {
@@ -2133,7 +3623,7 @@ type UpdateIntegrationRequest struct {
 
 func (x *UpdateIntegrationRequest) Reset() {
 	*x = UpdateIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[42]
+	mi := &file_organizations_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2145,7 +3635,7 @@ func (x *UpdateIntegrationRequest) String() string {
 func (*UpdateIntegrationRequest) ProtoMessage() {}
 
 func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[42]
+	mi := &file_organizations_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2158,7 +3648,7 @@ func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{42}
+	return file_organizations_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UpdateIntegrationRequest) GetId() string {
@@ -2198,7 +3688,7 @@ type UpdateIntegrationResponse struct {
 
 func (x *UpdateIntegrationResponse) Reset() {
 	*x = UpdateIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[43]
+	mi := &file_organizations_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2210,7 +3700,7 @@ func (x *UpdateIntegrationResponse) String() string {
 func (*UpdateIntegrationResponse) ProtoMessage() {}
 
 func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[43]
+	mi := &file_organizations_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,7 +3713,7 @@ func (x *UpdateIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*UpdateIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{43}
+	return file_organizations_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UpdateIntegrationResponse) GetIntegration() *Integration {
@@ -2243,7 +3733,7 @@ type DeleteIntegrationRequest struct {
 
 func (x *DeleteIntegrationRequest) Reset() {
 	*x = DeleteIntegrationRequest{}
-	mi := &file_organizations_proto_msgTypes[44]
+	mi := &file_organizations_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +3745,7 @@ func (x *DeleteIntegrationRequest) String() string {
 func (*DeleteIntegrationRequest) ProtoMessage() {}
 
 func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[44]
+	mi := &file_organizations_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +3758,7 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{44}
+	return file_organizations_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DeleteIntegrationRequest) GetId() string {
@@ -2293,7 +3783,7 @@ type DeleteIntegrationResponse struct {
 
 func (x *DeleteIntegrationResponse) Reset() {
 	*x = DeleteIntegrationResponse{}
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2305,7 +3795,7 @@ func (x *DeleteIntegrationResponse) String() string {
 func (*DeleteIntegrationResponse) ProtoMessage() {}
 
 func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[45]
+	mi := &file_organizations_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +3808,7 @@ func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{45}
+	return file_organizations_proto_rawDescGZIP(), []int{67}
 }
 
 type Integration struct {
@@ -2332,7 +3822,7 @@ type Integration struct {
 
 func (x *Integration) Reset() {
 	*x = Integration{}
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2344,7 +3834,7 @@ func (x *Integration) String() string {
 func (*Integration) ProtoMessage() {}
 
 func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[46]
+	mi := &file_organizations_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +3847,7 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration.ProtoReflect.Descriptor instead.
 func (*Integration) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46}
+	return file_organizations_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *Integration) GetMetadata() *Integration_Metadata {
@@ -2393,7 +3883,7 @@ type BrowserAction struct {
 
 func (x *BrowserAction) Reset() {
 	*x = BrowserAction{}
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2405,7 +3895,7 @@ func (x *BrowserAction) String() string {
 func (*BrowserAction) ProtoMessage() {}
 
 func (x *BrowserAction) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[47]
+	mi := &file_organizations_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +3908,7 @@ func (x *BrowserAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BrowserAction.ProtoReflect.Descriptor instead.
 func (*BrowserAction) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{47}
+	return file_organizations_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *BrowserAction) GetUrl() string {
@@ -2460,7 +3950,7 @@ type OrganizationCreated struct {
 
 func (x *OrganizationCreated) Reset() {
 	*x = OrganizationCreated{}
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2472,7 +3962,7 @@ func (x *OrganizationCreated) String() string {
 func (*OrganizationCreated) ProtoMessage() {}
 
 func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[48]
+	mi := &file_organizations_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,7 +3975,7 @@ func (x *OrganizationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationCreated.ProtoReflect.Descriptor instead.
 func (*OrganizationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{48}
+	return file_organizations_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *OrganizationCreated) GetOrganizationId() string {
@@ -2512,7 +4002,7 @@ type OrganizationUpdated struct {
 
 func (x *OrganizationUpdated) Reset() {
 	*x = OrganizationUpdated{}
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +4014,7 @@ func (x *OrganizationUpdated) String() string {
 func (*OrganizationUpdated) ProtoMessage() {}
 
 func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[49]
+	mi := &file_organizations_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +4027,7 @@ func (x *OrganizationUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationUpdated.ProtoReflect.Descriptor instead.
 func (*OrganizationUpdated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{49}
+	return file_organizations_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *OrganizationUpdated) GetOrganizationId() string {
@@ -2564,7 +4054,7 @@ type OrganizationDeleted struct {
 
 func (x *OrganizationDeleted) Reset() {
 	*x = OrganizationDeleted{}
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2576,7 +4066,7 @@ func (x *OrganizationDeleted) String() string {
 func (*OrganizationDeleted) ProtoMessage() {}
 
 func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[50]
+	mi := &file_organizations_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,7 +4079,7 @@ func (x *OrganizationDeleted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationDeleted.ProtoReflect.Descriptor instead.
 func (*OrganizationDeleted) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{50}
+	return file_organizations_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *OrganizationDeleted) GetOrganizationId() string {
@@ -2616,7 +4106,7 @@ type InvitationCreated struct {
 
 func (x *InvitationCreated) Reset() {
 	*x = InvitationCreated{}
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2628,7 +4118,7 @@ func (x *InvitationCreated) String() string {
 func (*InvitationCreated) ProtoMessage() {}
 
 func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[51]
+	mi := &file_organizations_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,7 +4131,7 @@ func (x *InvitationCreated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvitationCreated.ProtoReflect.Descriptor instead.
 func (*InvitationCreated) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{51}
+	return file_organizations_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *InvitationCreated) GetInvitationId() string {
@@ -2658,6 +4148,66 @@ func (x *InvitationCreated) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+type AuditEventCreated struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AuditEventId   string                 `protobuf:"bytes,1,opt,name=audit_event_id,json=auditEventId,proto3" json:"audit_event_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuditEventCreated) Reset() {
+	*x = AuditEventCreated{}
+	mi := &file_organizations_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventCreated) ProtoMessage() {}
+
+func (x *AuditEventCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventCreated.ProtoReflect.Descriptor instead.
+func (*AuditEventCreated) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AuditEventCreated) GetAuditEventId() string {
+	if x != nil {
+		return x.AuditEventId
+	}
+	return ""
+}
+
+func (x *AuditEventCreated) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuditEventCreated) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 type Organization_Metadata struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2672,7 +4222,7 @@ type Organization_Metadata struct {
 
 func (x *Organization_Metadata) Reset() {
 	*x = Organization_Metadata{}
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2684,7 +4234,7 @@ func (x *Organization_Metadata) String() string {
 func (*Organization_Metadata) ProtoMessage() {}
 
 func (x *Organization_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[52]
+	mi := &file_organizations_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,6 +4292,120 @@ func (x *Organization_Metadata) GetCanvasVersioningEnabled() bool {
 	return false
 }
 
+type EventSink_Backlog struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Pending         int64                  `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Failed          int64                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	OldestPendingAt *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=oldest_pending_at,json=oldestPendingAt,proto3" json:"oldest_pending_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EventSink_Backlog) Reset() {
+	*x = EventSink_Backlog{}
+	mi := &file_organizations_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSink_Backlog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSink_Backlog) ProtoMessage() {}
+
+func (x *EventSink_Backlog) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSink_Backlog.ProtoReflect.Descriptor instead.
+func (*EventSink_Backlog) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *EventSink_Backlog) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *EventSink_Backlog) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *EventSink_Backlog) GetOldestPendingAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.OldestPendingAt
+	}
+	return nil
+}
+
+type SAMLSettings_GroupMapping struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Group name sent by the identity provider.
+	IdpGroup string `protobuf:"bytes,1,opt,name=idp_group,json=idpGroup,proto3" json:"idp_group,omitempty"`
+	// Organization group the members of the IdP group are added to.
+	Group         string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SAMLSettings_GroupMapping) Reset() {
+	*x = SAMLSettings_GroupMapping{}
+	mi := &file_organizations_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SAMLSettings_GroupMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SAMLSettings_GroupMapping) ProtoMessage() {}
+
+func (x *SAMLSettings_GroupMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_organizations_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SAMLSettings_GroupMapping.ProtoReflect.Descriptor instead.
+func (*SAMLSettings_GroupMapping) Descriptor() ([]byte, []int) {
+	return file_organizations_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *SAMLSettings_GroupMapping) GetIdpGroup() string {
+	if x != nil {
+		return x.IdpGroup
+	}
+	return ""
+}
+
+func (x *SAMLSettings_GroupMapping) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
 type Integration_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2754,7 +4418,7 @@ type Integration_Metadata struct {
 
 func (x *Integration_Metadata) Reset() {
 	*x = Integration_Metadata{}
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2766,7 +4430,7 @@ func (x *Integration_Metadata) String() string {
 func (*Integration_Metadata) ProtoMessage() {}
 
 func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[54]
+	mi := &file_organizations_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,7 +4443,7 @@ func (x *Integration_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Metadata.ProtoReflect.Descriptor instead.
 func (*Integration_Metadata) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 0}
+	return file_organizations_proto_rawDescGZIP(), []int{68, 0}
 }
 
 func (x *Integration_Metadata) GetId() string {
@@ -2820,7 +4484,7 @@ type Integration_Spec struct {
 
 func (x *Integration_Spec) Reset() {
 	*x = Integration_Spec{}
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2832,7 +4496,7 @@ func (x *Integration_Spec) String() string {
 func (*Integration_Spec) ProtoMessage() {}
 
 func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[55]
+	mi := &file_organizations_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,7 +4509,7 @@ func (x *Integration_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Spec.ProtoReflect.Descriptor instead.
 func (*Integration_Spec) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 1}
+	return file_organizations_proto_rawDescGZIP(), []int{68, 1}
 }
 
 func (x *Integration_Spec) GetIntegrationName() string {
@@ -2875,7 +4539,7 @@ type Integration_Status struct {
 
 func (x *Integration_Status) Reset() {
 	*x = Integration_Status{}
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2887,7 +4551,7 @@ func (x *Integration_Status) String() string {
 func (*Integration_Status) ProtoMessage() {}
 
 func (x *Integration_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[56]
+	mi := &file_organizations_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,7 +4564,7 @@ func (x *Integration_Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_Status.ProtoReflect.Descriptor instead.
 func (*Integration_Status) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 2}
+	return file_organizations_proto_rawDescGZIP(), []int{68, 2}
 }
 
 func (x *Integration_Status) GetState() string {
@@ -2950,7 +4614,7 @@ type Integration_NodeRef struct {
 
 func (x *Integration_NodeRef) Reset() {
 	*x = Integration_NodeRef{}
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2962,7 +4626,7 @@ func (x *Integration_NodeRef) String() string {
 func (*Integration_NodeRef) ProtoMessage() {}
 
 func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_organizations_proto_msgTypes[57]
+	mi := &file_organizations_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,7 +4639,7 @@ func (x *Integration_NodeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration_NodeRef.ProtoReflect.Descriptor instead.
 func (*Integration_NodeRef) Descriptor() ([]byte, []int) {
-	return file_organizations_proto_rawDescGZIP(), []int{46, 3}
+	return file_organizations_proto_rawDescGZIP(), []int{68, 3}
 }
 
 func (x *Integration_NodeRef) GetCanvasId() string {
@@ -3120,7 +4784,152 @@ const file_organizations_proto_rawDesc = "" +
 	"\x1bDeleteAgentOpenAIKeyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
 	"\x1cDeleteAgentOpenAIKeyResponse\x12N\n" +
-	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"<\n" +
+	"\x0eagent_settings\x18\x01 \x01(\v2'.Superplane.Organizations.AgentSettingsR\ragentSettings\"\xf3\x01\n" +
+	"\x0fRetentionPolicy\x12 \n" +
+	"\fmax_age_days\x18\x01 \x01(\x05R\n" +
+	"maxAgeDays\x125\n" +
+	"\x17max_executions_per_node\x18\x02 \x01(\x05R\x14maxExecutionsPerNode\x12-\n" +
+	"\x13failed_max_age_days\x18\x03 \x01(\x05R\x10failedMaxAgeDays\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\"+\n" +
+	"\x19GetRetentionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"r\n" +
+	"\x1aGetRetentionPolicyResponse\x12T\n" +
+	"\x10retention_policy\x18\x01 \x01(\v2).Superplane.Organizations.RetentionPolicyR\x0fretentionPolicy\"\xb6\x01\n" +
+	"\x1cUpdateRetentionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\fmax_age_days\x18\x02 \x01(\x05R\n" +
+	"maxAgeDays\x125\n" +
+	"\x17max_executions_per_node\x18\x03 \x01(\x05R\x14maxExecutionsPerNode\x12-\n" +
+	"\x13failed_max_age_days\x18\x04 \x01(\x05R\x10failedMaxAgeDays\"u\n" +
+	"\x1dUpdateRetentionPolicyResponse\x12T\n" +
+	"\x10retention_policy\x18\x01 \x01(\v2).Superplane.Organizations.RetentionPolicyR\x0fretentionPolicy\"\xc3\x04\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x03 \x01(\tR\tactorName\x12\x1f\n" +
+	"\vactor_email\x18\x04 \x01(\tR\n" +
+	"actorEmail\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x06 \x01(\tR\n" +
+	"resourceId\x12\x16\n" +
+	"\x06action\x18\a \x01(\tR\x06action\x12\x16\n" +
+	"\x06method\x18\b \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12/\n" +
+	"\x06before\x18\v \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\f \x01(\v2\x17.google.protobuf.StructR\x05after\x12N\n" +
+	"\bmetadata\x18\r \x03(\v22.Superplane.Organizations.AuditEvent.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x02\n" +
+	"\x16ListAuditEventsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12\x1f\n" +
+	"\vresource_id\x18\x04 \x01(\tR\n" +
+	"resourceId\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x120\n" +
+	"\x05since\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x122\n" +
+	"\x06before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x14\n" +
+	"\x05limit\x18\b \x01(\rR\x05limit\"\xbe\x01\n" +
+	"\x17ListAuditEventsResponse\x12<\n" +
+	"\x06events\x18\x01 \x03(\v2$.Superplane.Organizations.AuditEventR\x06events\x12\"\n" +
+	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\x12A\n" +
+	"\x0elast_timestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"\xd8\x04\n" +
+	"\tEventSink\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"has_secret\x18\x05 \x01(\bR\thasSecret\x12E\n" +
+	"\abacklog\x18\x06 \x01(\v2+.Superplane.Organizations.EventSink.BacklogR\abacklog\x12F\n" +
+	"\x11last_delivered_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0flastDeliveredAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12>\n" +
+	"\rlast_error_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vlastErrorAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x1a\x83\x01\n" +
+	"\aBacklog\x12\x18\n" +
+	"\apending\x18\x01 \x01(\x03R\apending\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x03R\x06failed\x12F\n" +
+	"\x11oldest_pending_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0foldestPendingAt\"%\n" +
+	"\x13GetEventSinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x14GetEventSinkResponse\x12B\n" +
+	"\n" +
+	"event_sink\x18\x01 \x01(\v2#.Superplane.Organizations.EventSinkR\teventSink\"\xa0\x01\n" +
+	"\x16UpdateEventSinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\tR\n" +
+	"categories\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06secret\x18\x06 \x01(\tR\x06secret\"]\n" +
+	"\x17UpdateEventSinkResponse\x12B\n" +
+	"\n" +
+	"event_sink\x18\x01 \x01(\v2#.Superplane.Organizations.EventSinkR\teventSink\"(\n" +
+	"\x16DeleteEventSinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteEventSinkResponse\"1\n" +
+	"\x1fRetryEventSinkDeliveriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	" RetryEventSinkDeliveriesResponse\x12\x1a\n" +
+	"\brequeued\x18\x01 \x01(\x03R\brequeued\"\xab\x05\n" +
+	"\fSAMLSettings\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\benforced\x18\x02 \x01(\bR\benforced\x12(\n" +
+	"\x10idp_metadata_xml\x18\x03 \x01(\tR\x0eidpMetadataXml\x12\"\n" +
+	"\ridp_entity_id\x18\x04 \x01(\tR\vidpEntityId\x12\x1e\n" +
+	"\vidp_sso_url\x18\x05 \x01(\tR\tidpSsoUrl\x12'\n" +
+	"\x0femail_attribute\x18\x06 \x01(\tR\x0eemailAttribute\x12%\n" +
+	"\x0ename_attribute\x18\a \x01(\tR\rnameAttribute\x12)\n" +
+	"\x10groups_attribute\x18\b \x01(\tR\x0fgroupsAttribute\x12Z\n" +
+	"\x0egroup_mappings\x18\t \x03(\v23.Superplane.Organizations.SAMLSettings.GroupMappingR\rgroupMappings\x12 \n" +
+	"\fsp_entity_id\x18\n" +
+	" \x01(\tR\n" +
+	"spEntityId\x12\x1c\n" +
+	"\n" +
+	"sp_acs_url\x18\v \x01(\tR\bspAcsUrl\x12&\n" +
+	"\x0fsp_metadata_url\x18\f \x01(\tR\rspMetadataUrl\x12\x1b\n" +
+	"\tlogin_url\x18\r \x01(\tR\bloginUrl\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\tR\tupdatedBy\x1aA\n" +
+	"\fGroupMapping\x12\x1b\n" +
+	"\tidp_group\x18\x01 \x01(\tR\bidpGroup\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\"(\n" +
+	"\x16GetSAMLSettingsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x17GetSAMLSettingsResponse\x12B\n" +
+	"\bsettings\x18\x01 \x01(\v2&.Superplane.Organizations.SAMLSettingsR\bsettings\"\xe2\x02\n" +
+	"\x19UpdateSAMLSettingsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1a\n" +
+	"\benforced\x18\x03 \x01(\bR\benforced\x12(\n" +
+	"\x10idp_metadata_xml\x18\x04 \x01(\tR\x0eidpMetadataXml\x12'\n" +
+	"\x0femail_attribute\x18\x05 \x01(\tR\x0eemailAttribute\x12%\n" +
+	"\x0ename_attribute\x18\x06 \x01(\tR\rnameAttribute\x12)\n" +
+	"\x10groups_attribute\x18\a \x01(\tR\x0fgroupsAttribute\x12Z\n" +
+	"\x0egroup_mappings\x18\b \x03(\v23.Superplane.Organizations.SAMLSettings.GroupMappingR\rgroupMappings\"`\n" +
+	"\x1aUpdateSAMLSettingsResponse\x12B\n" +
+	"\bsettings\x18\x01 \x01(\v2&.Superplane.Organizations.SAMLSettingsR\bsettings\"<\n" +
 	"\x11RemoveUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
@@ -3213,7 +5022,11 @@ const file_organizations_proto_rawDesc = "" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"r\n" +
 	"\x11InvitationCreated\x12#\n" +
 	"\rinvitation_id\x18\x01 \x01(\tR\finvitationId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x96/\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x9c\x01\n" +
+	"\x11AuditEventCreated\x12$\n" +
+	"\x0eaudit_event_id\x18\x01 \x01(\tR\fauditEventId\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\xa9E\n" +
 	"\rOrganizations\x12\xa7\x02\n" +
 	"\x14DescribeOrganization\x125.Superplane.Organizations.DescribeOrganizationRequest\x1a6.Superplane.Organizations.DescribeOrganizationResponse\"\x9f\x01\x92Az\n" +
 	"\fOrganization\x12\x18Get organization details\x1aPReturns the details of a specific organization (can be referenced by ID or name)\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/organizations/{id}\x12\x96\x02\n" +
@@ -3243,7 +5056,25 @@ const file_organizations_proto_rawDesc = "" +
 	"\x11SetAgentOpenAIKey\x122.Superplane.Organizations.SetAgentOpenAIKeyRequest\x1a3.Superplane.Organizations.SetAgentOpenAIKeyResponse\"\xbe\x01\x92A|\n" +
 	"\fOrganization\x127Create or update organization OpenAI key for Agent Mode\x1a3Sets the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x029:\x01*\x1a4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xbc\x02\n" +
 	"\x14DeleteAgentOpenAIKey\x125.Superplane.Organizations.DeleteAgentOpenAIKeyRequest\x1a6.Superplane.Organizations.DeleteAgentOpenAIKeyResponse\"\xb4\x01\x92Au\n" +
-	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xea\x01\n" +
+	"\fOrganization\x12-Delete organization OpenAI key for Agent Mode\x1a6Deletes the OpenAI key used by organization Agent Mode\x82\xd3\xe4\x93\x026*4/api/v1/organizations/{id}/agent-settings/openai-key\x12\xc4\x02\n" +
+	"\x12GetRetentionPolicy\x123.Superplane.Organizations.GetRetentionPolicyRequest\x1a4.Superplane.Organizations.GetRetentionPolicyResponse\"\xc2\x01\x92A\x8b\x01\n" +
+	"\fOrganization\x12!Get organization retention policy\x1aXReturns the retention policy applied to every canvas in the organization without its own\x82\xd3\xe4\x93\x02-\x12+/api/v1/organizations/{id}/retention-policy\x12\xd3\x02\n" +
+	"\x15UpdateRetentionPolicy\x126.Superplane.Organizations.UpdateRetentionPolicyRequest\x1a7.Superplane.Organizations.UpdateRetentionPolicyResponse\"\xc8\x01\x92A\x8e\x01\n" +
+	"\fOrganization\x12$Update organization retention policy\x1aXUpdates the retention policy applied to every canvas in the organization without its own\x82\xd3\xe4\x93\x020:\x01*2+/api/v1/organizations/{id}/retention-policy\x12\x8d\x02\n" +
+	"\x0fListAuditEvents\x120.Superplane.Organizations.ListAuditEventsRequest\x1a1.Superplane.Organizations.ListAuditEventsResponse\"\x94\x01\x92Ab\n" +
+	"\fOrganization\x12\x11List audit events\x1a?Returns the changes made in the organization, most recent first\x82\xd3\xe4\x93\x02)\x12'/api/v1/organizations/{id}/audit-events\x12\xa1\x02\n" +
+	"\fGetEventSink\x12-.Superplane.Organizations.GetEventSinkRequest\x1a..Superplane.Organizations.GetEventSinkResponse\"\xb1\x01\x92A\x80\x01\n" +
+	"\fOrganization\x12\x1bGet organization event sink\x1aSReturns the sink the organization activity is streamed to, and its delivery backlog\x82\xd3\xe4\x93\x02'\x12%/api/v1/organizations/{id}/event-sink\x12\xaa\x02\n" +
+	"\x0fUpdateEventSink\x120.Superplane.Organizations.UpdateEventSinkRequest\x1a1.Superplane.Organizations.UpdateEventSinkResponse\"\xb1\x01\x92A~\n" +
+	"\fOrganization\x12\x1eUpdate organization event sink\x1aNConfigures the webhook or syslog sink the organization activity is streamed to\x82\xd3\xe4\x93\x02*:\x01*2%/api/v1/organizations/{id}/event-sink\x12\xab\x02\n" +
+	"\x0fDeleteEventSink\x120.Superplane.Organizations.DeleteEventSinkRequest\x1a1.Superplane.Organizations.DeleteEventSinkResponse\"\xb2\x01\x92A\x81\x01\n" +
+	"\fOrganization\x12\x1eDelete organization event sink\x1aQStops streaming the organization activity, and drops the events not delivered yet\x82\xd3\xe4\x93\x02'*%/api/v1/organizations/{id}/event-sink\x12\xd0\x02\n" +
+	"\x18RetryEventSinkDeliveries\x129.Superplane.Organizations.RetryEventSinkDeliveriesRequest\x1a:.Superplane.Organizations.RetryEventSinkDeliveriesResponse\"\xbc\x01\x92A\x82\x01\n" +
+	"\fOrganization\x12\"Retry failed event sink deliveries\x1aNPuts the events which could not be delivered to the sink back into its backlog\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/organizations/{id}/event-sink/retry\x12\xc3\x02\n" +
+	"\x0fGetSAMLSettings\x120.Superplane.Organizations.GetSAMLSettingsRequest\x1a1.Superplane.Organizations.GetSAMLSettingsResponse\"\xca\x01\x92A\x9f\x01\n" +
+	"\fOrganization\x12\x1eGet organization SAML settings\x1aoReturns the SAML single sign-on settings, and the service provider details to register in the identity provider\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/organizations/{id}/saml\x12\xcb\x02\n" +
+	"\x12UpdateSAMLSettings\x123.Superplane.Organizations.UpdateSAMLSettingsRequest\x1a4.Superplane.Organizations.UpdateSAMLSettingsResponse\"\xc9\x01\x92A\x9b\x01\n" +
+	"\fOrganization\x12!Update organization SAML settings\x1ahConfigures SAML single sign-on with the identity provider metadata, attribute mapping and group mappings\x82\xd3\xe4\x93\x02$:\x01*2\x1f/api/v1/organizations/{id}/saml\x12\xea\x01\n" +
 	"\x10AcceptInviteLink\x12$.Superplane.Organizations.InviteLink\x1a\x17.google.protobuf.Struct\"\x96\x01\x92Ah\n" +
 	"\fOrganization\x12\x15Accept an invite link\x1aAAccepts an organization invite link for the authenticated account\x82\xd3\xe4\x93\x02%\"#/api/v1/invite-links/{token}/accept\x12\x95\x02\n" +
 	"\x10ListIntegrations\x121.Superplane.Organizations.ListIntegrationsRequest\x1a2.Superplane.Organizations.ListIntegrationsResponse\"\x99\x01\x92Ag\n" +
@@ -3273,7 +5104,7 @@ func file_organizations_proto_rawDescGZIP() []byte {
 	return file_organizations_proto_rawDescData
 }
 
-var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_organizations_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_organizations_proto_goTypes = []any{
 	(*Organization)(nil),                     // 0: Superplane.Organizations.Organization
 	(*DescribeOrganizationRequest)(nil),      // 1: Superplane.Organizations.DescribeOrganizationRequest
@@ -3306,47 +5137,73 @@ var file_organizations_proto_goTypes = []any{
 	(*SetAgentOpenAIKeyResponse)(nil),        // 28: Superplane.Organizations.SetAgentOpenAIKeyResponse
 	(*DeleteAgentOpenAIKeyRequest)(nil),      // 29: Superplane.Organizations.DeleteAgentOpenAIKeyRequest
 	(*DeleteAgentOpenAIKeyResponse)(nil),     // 30: Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	(*RemoveUserRequest)(nil),                // 31: Superplane.Organizations.RemoveUserRequest
-	(*RemoveUserResponse)(nil),               // 32: Superplane.Organizations.RemoveUserResponse
-	(*ListIntegrationsRequest)(nil),          // 33: Superplane.Organizations.ListIntegrationsRequest
-	(*ListIntegrationsResponse)(nil),         // 34: Superplane.Organizations.ListIntegrationsResponse
-	(*CreateIntegrationRequest)(nil),         // 35: Superplane.Organizations.CreateIntegrationRequest
-	(*CreateIntegrationResponse)(nil),        // 36: Superplane.Organizations.CreateIntegrationResponse
-	(*DescribeIntegrationRequest)(nil),       // 37: Superplane.Organizations.DescribeIntegrationRequest
-	(*DescribeIntegrationResponse)(nil),      // 38: Superplane.Organizations.DescribeIntegrationResponse
-	(*ListIntegrationResourcesRequest)(nil),  // 39: Superplane.Organizations.ListIntegrationResourcesRequest
-	(*ListIntegrationResourcesResponse)(nil), // 40: Superplane.Organizations.ListIntegrationResourcesResponse
-	(*IntegrationResourceRef)(nil),           // 41: Superplane.Organizations.IntegrationResourceRef
-	(*UpdateIntegrationRequest)(nil),         // 42: Superplane.Organizations.UpdateIntegrationRequest
-	(*UpdateIntegrationResponse)(nil),        // 43: Superplane.Organizations.UpdateIntegrationResponse
-	(*DeleteIntegrationRequest)(nil),         // 44: Superplane.Organizations.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 45: Superplane.Organizations.DeleteIntegrationResponse
-	(*Integration)(nil),                      // 46: Superplane.Organizations.Integration
-	(*BrowserAction)(nil),                    // 47: Superplane.Organizations.BrowserAction
-	(*OrganizationCreated)(nil),              // 48: Superplane.Organizations.OrganizationCreated
-	(*OrganizationUpdated)(nil),              // 49: Superplane.Organizations.OrganizationUpdated
-	(*OrganizationDeleted)(nil),              // 50: Superplane.Organizations.OrganizationDeleted
-	(*InvitationCreated)(nil),                // 51: Superplane.Organizations.InvitationCreated
-	(*Organization_Metadata)(nil),            // 52: Superplane.Organizations.Organization.Metadata
-	nil,                                      // 53: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	(*Integration_Metadata)(nil),             // 54: Superplane.Organizations.Integration.Metadata
-	(*Integration_Spec)(nil),                 // 55: Superplane.Organizations.Integration.Spec
-	(*Integration_Status)(nil),               // 56: Superplane.Organizations.Integration.Status
-	(*Integration_NodeRef)(nil),              // 57: Superplane.Organizations.Integration.NodeRef
-	nil,                                      // 58: Superplane.Organizations.BrowserAction.FormFieldsEntry
-	(*timestamp.Timestamp)(nil),              // 59: google.protobuf.Timestamp
-	(*_struct.Struct)(nil),                   // 60: google.protobuf.Struct
+	(*RetentionPolicy)(nil),                  // 31: Superplane.Organizations.RetentionPolicy
+	(*GetRetentionPolicyRequest)(nil),        // 32: Superplane.Organizations.GetRetentionPolicyRequest
+	(*GetRetentionPolicyResponse)(nil),       // 33: Superplane.Organizations.GetRetentionPolicyResponse
+	(*UpdateRetentionPolicyRequest)(nil),     // 34: Superplane.Organizations.UpdateRetentionPolicyRequest
+	(*UpdateRetentionPolicyResponse)(nil),    // 35: Superplane.Organizations.UpdateRetentionPolicyResponse
+	(*AuditEvent)(nil),                       // 36: Superplane.Organizations.AuditEvent
+	(*ListAuditEventsRequest)(nil),           // 37: Superplane.Organizations.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),          // 38: Superplane.Organizations.ListAuditEventsResponse
+	(*EventSink)(nil),                        // 39: Superplane.Organizations.EventSink
+	(*GetEventSinkRequest)(nil),              // 40: Superplane.Organizations.GetEventSinkRequest
+	(*GetEventSinkResponse)(nil),             // 41: Superplane.Organizations.GetEventSinkResponse
+	(*UpdateEventSinkRequest)(nil),           // 42: Superplane.Organizations.UpdateEventSinkRequest
+	(*UpdateEventSinkResponse)(nil),          // 43: Superplane.Organizations.UpdateEventSinkResponse
+	(*DeleteEventSinkRequest)(nil),           // 44: Superplane.Organizations.DeleteEventSinkRequest
+	(*DeleteEventSinkResponse)(nil),          // 45: Superplane.Organizations.DeleteEventSinkResponse
+	(*RetryEventSinkDeliveriesRequest)(nil),  // 46: Superplane.Organizations.RetryEventSinkDeliveriesRequest
+	(*RetryEventSinkDeliveriesResponse)(nil), // 47: Superplane.Organizations.RetryEventSinkDeliveriesResponse
+	(*SAMLSettings)(nil),                     // 48: Superplane.Organizations.SAMLSettings
+	(*GetSAMLSettingsRequest)(nil),           // 49: Superplane.Organizations.GetSAMLSettingsRequest
+	(*GetSAMLSettingsResponse)(nil),          // 50: Superplane.Organizations.GetSAMLSettingsResponse
+	(*UpdateSAMLSettingsRequest)(nil),        // 51: Superplane.Organizations.UpdateSAMLSettingsRequest
+	(*UpdateSAMLSettingsResponse)(nil),       // 52: Superplane.Organizations.UpdateSAMLSettingsResponse
+	(*RemoveUserRequest)(nil),                // 53: Superplane.Organizations.RemoveUserRequest
+	(*RemoveUserResponse)(nil),               // 54: Superplane.Organizations.RemoveUserResponse
+	(*ListIntegrationsRequest)(nil),          // 55: Superplane.Organizations.ListIntegrationsRequest
+	(*ListIntegrationsResponse)(nil),         // 56: Superplane.Organizations.ListIntegrationsResponse
+	(*CreateIntegrationRequest)(nil),         // 57: Superplane.Organizations.CreateIntegrationRequest
+	(*CreateIntegrationResponse)(nil),        // 58: Superplane.Organizations.CreateIntegrationResponse
+	(*DescribeIntegrationRequest)(nil),       // 59: Superplane.Organizations.DescribeIntegrationRequest
+	(*DescribeIntegrationResponse)(nil),      // 60: Superplane.Organizations.DescribeIntegrationResponse
+	(*ListIntegrationResourcesRequest)(nil),  // 61: Superplane.Organizations.ListIntegrationResourcesRequest
+	(*ListIntegrationResourcesResponse)(nil), // 62: Superplane.Organizations.ListIntegrationResourcesResponse
+	(*IntegrationResourceRef)(nil),           // 63: Superplane.Organizations.IntegrationResourceRef
+	(*UpdateIntegrationRequest)(nil),         // 64: Superplane.Organizations.UpdateIntegrationRequest
+	(*UpdateIntegrationResponse)(nil),        // 65: Superplane.Organizations.UpdateIntegrationResponse
+	(*DeleteIntegrationRequest)(nil),         // 66: Superplane.Organizations.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),        // 67: Superplane.Organizations.DeleteIntegrationResponse
+	(*Integration)(nil),                      // 68: Superplane.Organizations.Integration
+	(*BrowserAction)(nil),                    // 69: Superplane.Organizations.BrowserAction
+	(*OrganizationCreated)(nil),              // 70: Superplane.Organizations.OrganizationCreated
+	(*OrganizationUpdated)(nil),              // 71: Superplane.Organizations.OrganizationUpdated
+	(*OrganizationDeleted)(nil),              // 72: Superplane.Organizations.OrganizationDeleted
+	(*InvitationCreated)(nil),                // 73: Superplane.Organizations.InvitationCreated
+	(*AuditEventCreated)(nil),                // 74: Superplane.Organizations.AuditEventCreated
+	(*Organization_Metadata)(nil),            // 75: Superplane.Organizations.Organization.Metadata
+	nil,                                      // 76: Superplane.Organizations.AuditEvent.MetadataEntry
+	(*EventSink_Backlog)(nil),                // 77: Superplane.Organizations.EventSink.Backlog
+	(*SAMLSettings_GroupMapping)(nil),        // 78: Superplane.Organizations.SAMLSettings.GroupMapping
+	nil,                                      // 79: Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	(*Integration_Metadata)(nil),             // 80: Superplane.Organizations.Integration.Metadata
+	(*Integration_Spec)(nil),                 // 81: Superplane.Organizations.Integration.Spec
+	(*Integration_Status)(nil),               // 82: Superplane.Organizations.Integration.Status
+	(*Integration_NodeRef)(nil),              // 83: Superplane.Organizations.Integration.NodeRef
+	nil,                                      // 84: Superplane.Organizations.BrowserAction.FormFieldsEntry
+	(*timestamp.Timestamp)(nil),              // 85: google.protobuf.Timestamp
+	(*_struct.Struct)(nil),                   // 86: google.protobuf.Struct
 }
 var file_organizations_proto_depIdxs = []int32{
-	52, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
+	75, // 0: Superplane.Organizations.Organization.metadata:type_name -> Superplane.Organizations.Organization.Metadata
 	0,  // 1: Superplane.Organizations.DescribeOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
 	0,  // 2: Superplane.Organizations.UpdateOrganizationRequest.organization:type_name -> Superplane.Organizations.Organization
 	0,  // 3: Superplane.Organizations.UpdateOrganizationResponse.organization:type_name -> Superplane.Organizations.Organization
-	59, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	59, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
-	59, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
-	59, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
+	85, // 4: Superplane.Organizations.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	85, // 5: Superplane.Organizations.InviteLink.created_at:type_name -> google.protobuf.Timestamp
+	85, // 6: Superplane.Organizations.InviteLink.updated_at:type_name -> google.protobuf.Timestamp
+	85, // 7: Superplane.Organizations.AgentOpenAIKey.validated_at:type_name -> google.protobuf.Timestamp
+	85, // 8: Superplane.Organizations.AgentOpenAIKey.updated_at:type_name -> google.protobuf.Timestamp
 	9,  // 9: Superplane.Organizations.AgentSettings.openai_key:type_name -> Superplane.Organizations.AgentOpenAIKey
 	7,  // 10: Superplane.Organizations.CreateInvitationResponse.invitation:type_name -> Superplane.Organizations.Invitation
 	7,  // 11: Superplane.Organizations.ListInvitationsResponse.invitations:type_name -> Superplane.Organizations.Invitation
@@ -3357,77 +5214,119 @@ var file_organizations_proto_depIdxs = []int32{
 	10, // 16: Superplane.Organizations.UpdateAgentSettingsResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
 	10, // 17: Superplane.Organizations.SetAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
 	10, // 18: Superplane.Organizations.DeleteAgentOpenAIKeyResponse.agent_settings:type_name -> Superplane.Organizations.AgentSettings
-	46, // 19: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
-	60, // 20: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 21: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	46, // 22: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	53, // 23: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
-	41, // 24: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
-	60, // 25: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
-	46, // 26: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
-	54, // 27: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
-	55, // 28: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
-	56, // 29: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
-	58, // 30: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
-	59, // 31: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 32: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 33: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 34: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
-	59, // 35: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 36: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	59, // 37: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	59, // 38: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
-	60, // 39: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
-	60, // 40: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
-	47, // 41: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
-	57, // 42: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
-	1,  // 43: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
-	3,  // 44: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
-	5,  // 45: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
-	31, // 46: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
-	11, // 47: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
-	13, // 48: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
-	15, // 49: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
-	17, // 50: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
-	19, // 51: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
-	21, // 52: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
-	23, // 53: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
-	25, // 54: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
-	27, // 55: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
-	29, // 56: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
-	8,  // 57: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
-	33, // 58: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
-	37, // 59: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
-	39, // 60: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
-	35, // 61: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
-	42, // 62: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
-	44, // 63: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
-	2,  // 64: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
-	4,  // 65: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
-	6,  // 66: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
-	32, // 67: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
-	12, // 68: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
-	14, // 69: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
-	16, // 70: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
-	18, // 71: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
-	20, // 72: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
-	22, // 73: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
-	24, // 74: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
-	26, // 75: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
-	28, // 76: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
-	30, // 77: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
-	60, // 78: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
-	34, // 79: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
-	38, // 80: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
-	40, // 81: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
-	36, // 82: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
-	43, // 83: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
-	45, // 84: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
-	64, // [64:85] is the sub-list for method output_type
-	43, // [43:64] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	85, // 19: Superplane.Organizations.RetentionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 20: Superplane.Organizations.GetRetentionPolicyResponse.retention_policy:type_name -> Superplane.Organizations.RetentionPolicy
+	31, // 21: Superplane.Organizations.UpdateRetentionPolicyResponse.retention_policy:type_name -> Superplane.Organizations.RetentionPolicy
+	86, // 22: Superplane.Organizations.AuditEvent.before:type_name -> google.protobuf.Struct
+	86, // 23: Superplane.Organizations.AuditEvent.after:type_name -> google.protobuf.Struct
+	76, // 24: Superplane.Organizations.AuditEvent.metadata:type_name -> Superplane.Organizations.AuditEvent.MetadataEntry
+	85, // 25: Superplane.Organizations.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	85, // 26: Superplane.Organizations.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	85, // 27: Superplane.Organizations.ListAuditEventsRequest.before:type_name -> google.protobuf.Timestamp
+	36, // 28: Superplane.Organizations.ListAuditEventsResponse.events:type_name -> Superplane.Organizations.AuditEvent
+	85, // 29: Superplane.Organizations.ListAuditEventsResponse.last_timestamp:type_name -> google.protobuf.Timestamp
+	77, // 30: Superplane.Organizations.EventSink.backlog:type_name -> Superplane.Organizations.EventSink.Backlog
+	85, // 31: Superplane.Organizations.EventSink.last_delivered_at:type_name -> google.protobuf.Timestamp
+	85, // 32: Superplane.Organizations.EventSink.last_error_at:type_name -> google.protobuf.Timestamp
+	85, // 33: Superplane.Organizations.EventSink.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 34: Superplane.Organizations.GetEventSinkResponse.event_sink:type_name -> Superplane.Organizations.EventSink
+	39, // 35: Superplane.Organizations.UpdateEventSinkResponse.event_sink:type_name -> Superplane.Organizations.EventSink
+	78, // 36: Superplane.Organizations.SAMLSettings.group_mappings:type_name -> Superplane.Organizations.SAMLSettings.GroupMapping
+	85, // 37: Superplane.Organizations.SAMLSettings.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 38: Superplane.Organizations.GetSAMLSettingsResponse.settings:type_name -> Superplane.Organizations.SAMLSettings
+	78, // 39: Superplane.Organizations.UpdateSAMLSettingsRequest.group_mappings:type_name -> Superplane.Organizations.SAMLSettings.GroupMapping
+	48, // 40: Superplane.Organizations.UpdateSAMLSettingsResponse.settings:type_name -> Superplane.Organizations.SAMLSettings
+	68, // 41: Superplane.Organizations.ListIntegrationsResponse.integrations:type_name -> Superplane.Organizations.Integration
+	86, // 42: Superplane.Organizations.CreateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	68, // 43: Superplane.Organizations.CreateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	68, // 44: Superplane.Organizations.DescribeIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	79, // 45: Superplane.Organizations.ListIntegrationResourcesRequest.parameters:type_name -> Superplane.Organizations.ListIntegrationResourcesRequest.ParametersEntry
+	63, // 46: Superplane.Organizations.ListIntegrationResourcesResponse.resources:type_name -> Superplane.Organizations.IntegrationResourceRef
+	86, // 47: Superplane.Organizations.UpdateIntegrationRequest.configuration:type_name -> google.protobuf.Struct
+	68, // 48: Superplane.Organizations.UpdateIntegrationResponse.integration:type_name -> Superplane.Organizations.Integration
+	80, // 49: Superplane.Organizations.Integration.metadata:type_name -> Superplane.Organizations.Integration.Metadata
+	81, // 50: Superplane.Organizations.Integration.spec:type_name -> Superplane.Organizations.Integration.Spec
+	82, // 51: Superplane.Organizations.Integration.status:type_name -> Superplane.Organizations.Integration.Status
+	84, // 52: Superplane.Organizations.BrowserAction.form_fields:type_name -> Superplane.Organizations.BrowserAction.FormFieldsEntry
+	85, // 53: Superplane.Organizations.OrganizationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	85, // 54: Superplane.Organizations.OrganizationUpdated.timestamp:type_name -> google.protobuf.Timestamp
+	85, // 55: Superplane.Organizations.OrganizationDeleted.timestamp:type_name -> google.protobuf.Timestamp
+	85, // 56: Superplane.Organizations.InvitationCreated.timestamp:type_name -> google.protobuf.Timestamp
+	85, // 57: Superplane.Organizations.AuditEventCreated.timestamp:type_name -> google.protobuf.Timestamp
+	85, // 58: Superplane.Organizations.Organization.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	85, // 59: Superplane.Organizations.Organization.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	85, // 60: Superplane.Organizations.EventSink.Backlog.oldest_pending_at:type_name -> google.protobuf.Timestamp
+	85, // 61: Superplane.Organizations.Integration.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	85, // 62: Superplane.Organizations.Integration.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	86, // 63: Superplane.Organizations.Integration.Spec.configuration:type_name -> google.protobuf.Struct
+	86, // 64: Superplane.Organizations.Integration.Status.metadata:type_name -> google.protobuf.Struct
+	69, // 65: Superplane.Organizations.Integration.Status.browser_action:type_name -> Superplane.Organizations.BrowserAction
+	83, // 66: Superplane.Organizations.Integration.Status.used_in:type_name -> Superplane.Organizations.Integration.NodeRef
+	1,  // 67: Superplane.Organizations.Organizations.DescribeOrganization:input_type -> Superplane.Organizations.DescribeOrganizationRequest
+	3,  // 68: Superplane.Organizations.Organizations.UpdateOrganization:input_type -> Superplane.Organizations.UpdateOrganizationRequest
+	5,  // 69: Superplane.Organizations.Organizations.DeleteOrganization:input_type -> Superplane.Organizations.DeleteOrganizationRequest
+	53, // 70: Superplane.Organizations.Organizations.RemoveUser:input_type -> Superplane.Organizations.RemoveUserRequest
+	11, // 71: Superplane.Organizations.Organizations.CreateInvitation:input_type -> Superplane.Organizations.CreateInvitationRequest
+	13, // 72: Superplane.Organizations.Organizations.ListInvitations:input_type -> Superplane.Organizations.ListInvitationsRequest
+	15, // 73: Superplane.Organizations.Organizations.RemoveInvitation:input_type -> Superplane.Organizations.RemoveInvitationRequest
+	17, // 74: Superplane.Organizations.Organizations.GetInviteLink:input_type -> Superplane.Organizations.GetInviteLinkRequest
+	19, // 75: Superplane.Organizations.Organizations.UpdateInviteLink:input_type -> Superplane.Organizations.UpdateInviteLinkRequest
+	21, // 76: Superplane.Organizations.Organizations.ResetInviteLink:input_type -> Superplane.Organizations.ResetInviteLinkRequest
+	23, // 77: Superplane.Organizations.Organizations.GetAgentSettings:input_type -> Superplane.Organizations.GetAgentSettingsRequest
+	25, // 78: Superplane.Organizations.Organizations.UpdateAgentSettings:input_type -> Superplane.Organizations.UpdateAgentSettingsRequest
+	27, // 79: Superplane.Organizations.Organizations.SetAgentOpenAIKey:input_type -> Superplane.Organizations.SetAgentOpenAIKeyRequest
+	29, // 80: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:input_type -> Superplane.Organizations.DeleteAgentOpenAIKeyRequest
+	32, // 81: Superplane.Organizations.Organizations.GetRetentionPolicy:input_type -> Superplane.Organizations.GetRetentionPolicyRequest
+	34, // 82: Superplane.Organizations.Organizations.UpdateRetentionPolicy:input_type -> Superplane.Organizations.UpdateRetentionPolicyRequest
+	37, // 83: Superplane.Organizations.Organizations.ListAuditEvents:input_type -> Superplane.Organizations.ListAuditEventsRequest
+	40, // 84: Superplane.Organizations.Organizations.GetEventSink:input_type -> Superplane.Organizations.GetEventSinkRequest
+	42, // 85: Superplane.Organizations.Organizations.UpdateEventSink:input_type -> Superplane.Organizations.UpdateEventSinkRequest
+	44, // 86: Superplane.Organizations.Organizations.DeleteEventSink:input_type -> Superplane.Organizations.DeleteEventSinkRequest
+	46, // 87: Superplane.Organizations.Organizations.RetryEventSinkDeliveries:input_type -> Superplane.Organizations.RetryEventSinkDeliveriesRequest
+	49, // 88: Superplane.Organizations.Organizations.GetSAMLSettings:input_type -> Superplane.Organizations.GetSAMLSettingsRequest
+	51, // 89: Superplane.Organizations.Organizations.UpdateSAMLSettings:input_type -> Superplane.Organizations.UpdateSAMLSettingsRequest
+	8,  // 90: Superplane.Organizations.Organizations.AcceptInviteLink:input_type -> Superplane.Organizations.InviteLink
+	55, // 91: Superplane.Organizations.Organizations.ListIntegrations:input_type -> Superplane.Organizations.ListIntegrationsRequest
+	59, // 92: Superplane.Organizations.Organizations.DescribeIntegration:input_type -> Superplane.Organizations.DescribeIntegrationRequest
+	61, // 93: Superplane.Organizations.Organizations.ListIntegrationResources:input_type -> Superplane.Organizations.ListIntegrationResourcesRequest
+	57, // 94: Superplane.Organizations.Organizations.CreateIntegration:input_type -> Superplane.Organizations.CreateIntegrationRequest
+	64, // 95: Superplane.Organizations.Organizations.UpdateIntegration:input_type -> Superplane.Organizations.UpdateIntegrationRequest
+	66, // 96: Superplane.Organizations.Organizations.DeleteIntegration:input_type -> Superplane.Organizations.DeleteIntegrationRequest
+	2,  // 97: Superplane.Organizations.Organizations.DescribeOrganization:output_type -> Superplane.Organizations.DescribeOrganizationResponse
+	4,  // 98: Superplane.Organizations.Organizations.UpdateOrganization:output_type -> Superplane.Organizations.UpdateOrganizationResponse
+	6,  // 99: Superplane.Organizations.Organizations.DeleteOrganization:output_type -> Superplane.Organizations.DeleteOrganizationResponse
+	54, // 100: Superplane.Organizations.Organizations.RemoveUser:output_type -> Superplane.Organizations.RemoveUserResponse
+	12, // 101: Superplane.Organizations.Organizations.CreateInvitation:output_type -> Superplane.Organizations.CreateInvitationResponse
+	14, // 102: Superplane.Organizations.Organizations.ListInvitations:output_type -> Superplane.Organizations.ListInvitationsResponse
+	16, // 103: Superplane.Organizations.Organizations.RemoveInvitation:output_type -> Superplane.Organizations.RemoveInvitationResponse
+	18, // 104: Superplane.Organizations.Organizations.GetInviteLink:output_type -> Superplane.Organizations.GetInviteLinkResponse
+	20, // 105: Superplane.Organizations.Organizations.UpdateInviteLink:output_type -> Superplane.Organizations.UpdateInviteLinkResponse
+	22, // 106: Superplane.Organizations.Organizations.ResetInviteLink:output_type -> Superplane.Organizations.ResetInviteLinkResponse
+	24, // 107: Superplane.Organizations.Organizations.GetAgentSettings:output_type -> Superplane.Organizations.GetAgentSettingsResponse
+	26, // 108: Superplane.Organizations.Organizations.UpdateAgentSettings:output_type -> Superplane.Organizations.UpdateAgentSettingsResponse
+	28, // 109: Superplane.Organizations.Organizations.SetAgentOpenAIKey:output_type -> Superplane.Organizations.SetAgentOpenAIKeyResponse
+	30, // 110: Superplane.Organizations.Organizations.DeleteAgentOpenAIKey:output_type -> Superplane.Organizations.DeleteAgentOpenAIKeyResponse
+	33, // 111: Superplane.Organizations.Organizations.GetRetentionPolicy:output_type -> Superplane.Organizations.GetRetentionPolicyResponse
+	35, // 112: Superplane.Organizations.Organizations.UpdateRetentionPolicy:output_type -> Superplane.Organizations.UpdateRetentionPolicyResponse
+	38, // 113: Superplane.Organizations.Organizations.ListAuditEvents:output_type -> Superplane.Organizations.ListAuditEventsResponse
+	41, // 114: Superplane.Organizations.Organizations.GetEventSink:output_type -> Superplane.Organizations.GetEventSinkResponse
+	43, // 115: Superplane.Organizations.Organizations.UpdateEventSink:output_type -> Superplane.Organizations.UpdateEventSinkResponse
+	45, // 116: Superplane.Organizations.Organizations.DeleteEventSink:output_type -> Superplane.Organizations.DeleteEventSinkResponse
+	47, // 117: Superplane.Organizations.Organizations.RetryEventSinkDeliveries:output_type -> Superplane.Organizations.RetryEventSinkDeliveriesResponse
+	50, // 118: Superplane.Organizations.Organizations.GetSAMLSettings:output_type -> Superplane.Organizations.GetSAMLSettingsResponse
+	52, // 119: Superplane.Organizations.Organizations.UpdateSAMLSettings:output_type -> Superplane.Organizations.UpdateSAMLSettingsResponse
+	86, // 120: Superplane.Organizations.Organizations.AcceptInviteLink:output_type -> google.protobuf.Struct
+	56, // 121: Superplane.Organizations.Organizations.ListIntegrations:output_type -> Superplane.Organizations.ListIntegrationsResponse
+	60, // 122: Superplane.Organizations.Organizations.DescribeIntegration:output_type -> Superplane.Organizations.DescribeIntegrationResponse
+	62, // 123: Superplane.Organizations.Organizations.ListIntegrationResources:output_type -> Superplane.Organizations.ListIntegrationResourcesResponse
+	58, // 124: Superplane.Organizations.Organizations.CreateIntegration:output_type -> Superplane.Organizations.CreateIntegrationResponse
+	65, // 125: Superplane.Organizations.Organizations.UpdateIntegration:output_type -> Superplane.Organizations.UpdateIntegrationResponse
+	67, // 126: Superplane.Organizations.Organizations.DeleteIntegration:output_type -> Superplane.Organizations.DeleteIntegrationResponse
+	97, // [97:127] is the sub-list for method output_type
+	67, // [67:97] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_organizations_proto_init() }
@@ -3435,14 +5334,14 @@ func file_organizations_proto_init() {
 	if File_organizations_proto != nil {
 		return
 	}
-	file_organizations_proto_msgTypes[52].OneofWrappers = []any{}
+	file_organizations_proto_msgTypes[75].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organizations_proto_rawDesc), len(file_organizations_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,6 +34,15 @@ const (
 	Organizations_UpdateAgentSettings_FullMethodName      = "/Superplane.Organizations.Organizations/UpdateAgentSettings"
 	Organizations_SetAgentOpenAIKey_FullMethodName        = "/Superplane.Organizations.Organizations/SetAgentOpenAIKey"
 	Organizations_DeleteAgentOpenAIKey_FullMethodName     = "/Superplane.Organizations.Organizations/DeleteAgentOpenAIKey"
+	Organizations_GetRetentionPolicy_FullMethodName       = "/Superplane.Organizations.Organizations/GetRetentionPolicy"
+	Organizations_UpdateRetentionPolicy_FullMethodName    = "/Superplane.Organizations.Organizations/UpdateRetentionPolicy"
+	Organizations_ListAuditEvents_FullMethodName          = "/Superplane.Organizations.Organizations/ListAuditEvents"
+	Organizations_GetEventSink_FullMethodName             = "/Superplane.Organizations.Organizations/GetEventSink"
+	Organizations_UpdateEventSink_FullMethodName          = "/Superplane.Organizations.Organizations/UpdateEventSink"
+	Organizations_DeleteEventSink_FullMethodName          = "/Superplane.Organizations.Organizations/DeleteEventSink"
+	Organizations_RetryEventSinkDeliveries_FullMethodName = "/Superplane.Organizations.Organizations/RetryEventSinkDeliveries"
+	Organizations_GetSAMLSettings_FullMethodName          = "/Superplane.Organizations.Organizations/GetSAMLSettings"
+	Organizations_UpdateSAMLSettings_FullMethodName       = "/Superplane.Organizations.Organizations/UpdateSAMLSettings"
 	Organizations_AcceptInviteLink_FullMethodName         = "/Superplane.Organizations.Organizations/AcceptInviteLink"
 	Organizations_ListIntegrations_FullMethodName         = "/Superplane.Organizations.Organizations/ListIntegrations"
 	Organizations_DescribeIntegration_FullMethodName      = "/Superplane.Organizations.Organizations/DescribeIntegration"
@@ -61,6 +70,15 @@ type OrganizationsClient interface {
 	UpdateAgentSettings(ctx context.Context, in *UpdateAgentSettingsRequest, opts ...grpc.CallOption) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(ctx context.Context, in *SetAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(ctx context.Context, in *DeleteAgentOpenAIKeyRequest, opts ...grpc.CallOption) (*DeleteAgentOpenAIKeyResponse, error)
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error)
+	UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	GetEventSink(ctx context.Context, in *GetEventSinkRequest, opts ...grpc.CallOption) (*GetEventSinkResponse, error)
+	UpdateEventSink(ctx context.Context, in *UpdateEventSinkRequest, opts ...grpc.CallOption) (*UpdateEventSinkResponse, error)
+	DeleteEventSink(ctx context.Context, in *DeleteEventSinkRequest, opts ...grpc.CallOption) (*DeleteEventSinkResponse, error)
+	RetryEventSinkDeliveries(ctx context.Context, in *RetryEventSinkDeliveriesRequest, opts ...grpc.CallOption) (*RetryEventSinkDeliveriesResponse, error)
+	GetSAMLSettings(ctx context.Context, in *GetSAMLSettingsRequest, opts ...grpc.CallOption) (*GetSAMLSettingsResponse, error)
+	UpdateSAMLSettings(ctx context.Context, in *UpdateSAMLSettingsRequest, opts ...grpc.CallOption) (*UpdateSAMLSettingsResponse, error)
 	AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error)
 	ListIntegrations(ctx context.Context, in *ListIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationsResponse, error)
 	DescribeIntegration(ctx context.Context, in *DescribeIntegrationRequest, opts ...grpc.CallOption) (*DescribeIntegrationResponse, error)
@@ -218,6 +236,96 @@ func (c *organizationsClient) DeleteAgentOpenAIKey(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *organizationsClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Organizations_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetEventSink(ctx context.Context, in *GetEventSinkRequest, opts ...grpc.CallOption) (*GetEventSinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventSinkResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetEventSink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateEventSink(ctx context.Context, in *UpdateEventSinkRequest, opts ...grpc.CallOption) (*UpdateEventSinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEventSinkResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateEventSink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) DeleteEventSink(ctx context.Context, in *DeleteEventSinkRequest, opts ...grpc.CallOption) (*DeleteEventSinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEventSinkResponse)
+	err := c.cc.Invoke(ctx, Organizations_DeleteEventSink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) RetryEventSinkDeliveries(ctx context.Context, in *RetryEventSinkDeliveriesRequest, opts ...grpc.CallOption) (*RetryEventSinkDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryEventSinkDeliveriesResponse)
+	err := c.cc.Invoke(ctx, Organizations_RetryEventSinkDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) GetSAMLSettings(ctx context.Context, in *GetSAMLSettingsRequest, opts ...grpc.CallOption) (*GetSAMLSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSAMLSettingsResponse)
+	err := c.cc.Invoke(ctx, Organizations_GetSAMLSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationsClient) UpdateSAMLSettings(ctx context.Context, in *UpdateSAMLSettingsRequest, opts ...grpc.CallOption) (*UpdateSAMLSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSAMLSettingsResponse)
+	err := c.cc.Invoke(ctx, Organizations_UpdateSAMLSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *organizationsClient) AcceptInviteLink(ctx context.Context, in *InviteLink, opts ...grpc.CallOption) (*_struct.Struct, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(_struct.Struct)
@@ -306,6 +414,15 @@ type OrganizationsServer interface {
 	UpdateAgentSettings(context.Context, *UpdateAgentSettingsRequest) (*UpdateAgentSettingsResponse, error)
 	SetAgentOpenAIKey(context.Context, *SetAgentOpenAIKeyRequest) (*SetAgentOpenAIKeyResponse, error)
 	DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error)
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error)
+	UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	GetEventSink(context.Context, *GetEventSinkRequest) (*GetEventSinkResponse, error)
+	UpdateEventSink(context.Context, *UpdateEventSinkRequest) (*UpdateEventSinkResponse, error)
+	DeleteEventSink(context.Context, *DeleteEventSinkRequest) (*DeleteEventSinkResponse, error)
+	RetryEventSinkDeliveries(context.Context, *RetryEventSinkDeliveriesRequest) (*RetryEventSinkDeliveriesResponse, error)
+	GetSAMLSettings(context.Context, *GetSAMLSettingsRequest) (*GetSAMLSettingsResponse, error)
+	UpdateSAMLSettings(context.Context, *UpdateSAMLSettingsRequest) (*UpdateSAMLSettingsResponse, error)
 	AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error)
 	ListIntegrations(context.Context, *ListIntegrationsRequest) (*ListIntegrationsResponse, error)
 	DescribeIntegration(context.Context, *DescribeIntegrationRequest) (*DescribeIntegrationResponse, error)
@@ -364,6 +481,33 @@ func (UnimplementedOrganizationsServer) SetAgentOpenAIKey(context.Context, *SetA
 func (UnimplementedOrganizationsServer) DeleteAgentOpenAIKey(context.Context, *DeleteAgentOpenAIKeyRequest) (*DeleteAgentOpenAIKeyResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteAgentOpenAIKey not implemented")
 }
+func (UnimplementedOrganizationsServer) GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRetentionPolicy not implemented")
+}
+func (UnimplementedOrganizationsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedOrganizationsServer) GetEventSink(context.Context, *GetEventSinkRequest) (*GetEventSinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventSink not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateEventSink(context.Context, *UpdateEventSinkRequest) (*UpdateEventSinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEventSink not implemented")
+}
+func (UnimplementedOrganizationsServer) DeleteEventSink(context.Context, *DeleteEventSinkRequest) (*DeleteEventSinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEventSink not implemented")
+}
+func (UnimplementedOrganizationsServer) RetryEventSinkDeliveries(context.Context, *RetryEventSinkDeliveriesRequest) (*RetryEventSinkDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryEventSinkDeliveries not implemented")
+}
+func (UnimplementedOrganizationsServer) GetSAMLSettings(context.Context, *GetSAMLSettingsRequest) (*GetSAMLSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSAMLSettings not implemented")
+}
+func (UnimplementedOrganizationsServer) UpdateSAMLSettings(context.Context, *UpdateSAMLSettingsRequest) (*UpdateSAMLSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSAMLSettings not implemented")
+}
 func (UnimplementedOrganizationsServer) AcceptInviteLink(context.Context, *InviteLink) (*_struct.Struct, error) {
 	return nil, status.Error(codes.Unimplemented, "method AcceptInviteLink not implemented")
 }
@@ -657,6 +801,168 @@ func _Organizations_DeleteAgentOpenAIKey_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organizations_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateRetentionPolicy(ctx, req.(*UpdateRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetEventSink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventSinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetEventSink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetEventSink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetEventSink(ctx, req.(*GetEventSinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateEventSink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventSinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateEventSink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateEventSink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateEventSink(ctx, req.(*UpdateEventSinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_DeleteEventSink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventSinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).DeleteEventSink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_DeleteEventSink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).DeleteEventSink(ctx, req.(*DeleteEventSinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_RetryEventSinkDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryEventSinkDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).RetryEventSinkDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_RetryEventSinkDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).RetryEventSinkDeliveries(ctx, req.(*RetryEventSinkDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_GetSAMLSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSAMLSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).GetSAMLSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_GetSAMLSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).GetSAMLSettings(ctx, req.(*GetSAMLSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organizations_UpdateSAMLSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSAMLSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationsServer).UpdateSAMLSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Organizations_UpdateSAMLSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationsServer).UpdateSAMLSettings(ctx, req.(*UpdateSAMLSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Organizations_AcceptInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteLink)
 	if err := dec(in); err != nil {
@@ -846,6 +1152,42 @@ var Organizations_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAgentOpenAIKey",
 			Handler:    _Organizations_DeleteAgentOpenAIKey_Handler,
 		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _Organizations_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateRetentionPolicy",
+			Handler:    _Organizations_UpdateRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Organizations_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetEventSink",
+			Handler:    _Organizations_GetEventSink_Handler,
+		},
+		{
+			MethodName: "UpdateEventSink",
+			Handler:    _Organizations_UpdateEventSink_Handler,
+		},
+		{
+			MethodName: "DeleteEventSink",
+			Handler:    _Organizations_DeleteEventSink_Handler,
+		},
+		{
+			MethodName: "RetryEventSinkDeliveries",
+			Handler:    _Organizations_RetryEventSinkDeliveries_Handler,
+		},
+		{
+			MethodName: "GetSAMLSettings",
+			Handler:    _Organizations_GetSAMLSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSAMLSettings",
+			Handler:    _Organizations_UpdateSAMLSettings_Handler,
+		},
 		{
 			MethodName: "AcceptInviteLink",
 			Handler:    _Organizations_AcceptInviteLink_Handler,
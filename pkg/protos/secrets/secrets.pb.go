@@ -30,6 +30,7 @@ type Secret_Provider int32
 const (
 	Secret_PROVIDER_UNKNOWN Secret_Provider = 0
 	Secret_PROVIDER_LOCAL   Secret_Provider = 1
+	Secret_PROVIDER_VAULT   Secret_Provider = 2
 )
 
 // Enum value maps for Secret_Provider.
@@ -37,10 +38,12 @@ var (
 	Secret_Provider_name = map[int32]string{
 		0: "PROVIDER_UNKNOWN",
 		1: "PROVIDER_LOCAL",
+		2: "PROVIDER_VAULT",
 	}
 	Secret_Provider_value = map[string]int32{
 		"PROVIDER_UNKNOWN": 0,
 		"PROVIDER_LOCAL":   1,
+		"PROVIDER_VAULT":   2,
 	}
 )
 
@@ -71,6 +74,55 @@ func (Secret_Provider) EnumDescriptor() ([]byte, []int) {
 	return file_secrets_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type Secret_Vault_AuthMethod int32
+
+const (
+	Secret_Vault_AUTH_METHOD_UNSPECIFIED Secret_Vault_AuthMethod = 0
+	Secret_Vault_AUTH_METHOD_TOKEN       Secret_Vault_AuthMethod = 1
+	Secret_Vault_AUTH_METHOD_APPROLE     Secret_Vault_AuthMethod = 2
+)
+
+// Enum value maps for Secret_Vault_AuthMethod.
+var (
+	Secret_Vault_AuthMethod_name = map[int32]string{
+		0: "AUTH_METHOD_UNSPECIFIED",
+		1: "AUTH_METHOD_TOKEN",
+		2: "AUTH_METHOD_APPROLE",
+	}
+	Secret_Vault_AuthMethod_value = map[string]int32{
+		"AUTH_METHOD_UNSPECIFIED": 0,
+		"AUTH_METHOD_TOKEN":       1,
+		"AUTH_METHOD_APPROLE":     2,
+	}
+)
+
+func (x Secret_Vault_AuthMethod) Enum() *Secret_Vault_AuthMethod {
+	p := new(Secret_Vault_AuthMethod)
+	*p = x
+	return p
+}
+
+func (x Secret_Vault_AuthMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Secret_Vault_AuthMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_secrets_proto_enumTypes[1].Descriptor()
+}
+
+func (Secret_Vault_AuthMethod) Type() protoreflect.EnumType {
+	return &file_secrets_proto_enumTypes[1]
+}
+
+func (x Secret_Vault_AuthMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Secret_Vault_AuthMethod.Descriptor instead.
+func (Secret_Vault_AuthMethod) EnumDescriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 type Secret struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metadata      *Secret_Metadata       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -1024,6 +1076,133 @@ func (x *Secret_Local) GetData() map[string]string {
 	return nil
 }
 
+// Vault secrets are read from a KV v2 secrets engine in HashiCorp Vault.
+// The values are never stored by SuperPlane.
+type Secret_Vault struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Address   string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//
+	// Mount path of the KV v2 secrets engine. Defaults to "secret".
+	//
+	Mount      string                  `protobuf:"bytes,3,opt,name=mount,proto3" json:"mount,omitempty"`
+	Path       string                  `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	AuthMethod Secret_Vault_AuthMethod `protobuf:"varint,5,opt,name=auth_method,json=authMethod,proto3,enum=Superplane.Secrets.Secret_Vault_AuthMethod" json:"auth_method,omitempty"`
+	//
+	// Credentials are write-only, and are not returned when describing the secret.
+	//
+	Token        string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	ApproleMount string `protobuf:"bytes,7,opt,name=approle_mount,json=approleMount,proto3" json:"approle_mount,omitempty"`
+	RoleId       string `protobuf:"bytes,8,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	SecretId     string `protobuf:"bytes,9,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	//
+	// Keys of the secret in Vault. Only set in responses.
+	//
+	Keys          []string `protobuf:"bytes,10,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret_Vault) Reset() {
+	*x = Secret_Vault{}
+	mi := &file_secrets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret_Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret_Vault) ProtoMessage() {}
+
+func (x *Secret_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_secrets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret_Vault.ProtoReflect.Descriptor instead.
+func (*Secret_Vault) Descriptor() ([]byte, []int) {
+	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Secret_Vault) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetMount() string {
+	if x != nil {
+		return x.Mount
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetAuthMethod() Secret_Vault_AuthMethod {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return Secret_Vault_AUTH_METHOD_UNSPECIFIED
+}
+
+func (x *Secret_Vault) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetApproleMount() string {
+	if x != nil {
+		return x.ApproleMount
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetSecretId() string {
+	if x != nil {
+		return x.SecretId
+	}
+	return ""
+}
+
+func (x *Secret_Vault) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type Secret_Metadata struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1037,7 +1216,7 @@ type Secret_Metadata struct {
 
 func (x *Secret_Metadata) Reset() {
 	*x = Secret_Metadata{}
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1228,7 @@ func (x *Secret_Metadata) String() string {
 func (*Secret_Metadata) ProtoMessage() {}
 
 func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[18]
+	mi := &file_secrets_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1241,7 @@ func (x *Secret_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Metadata.ProtoReflect.Descriptor instead.
 func (*Secret_Metadata) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 1}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *Secret_Metadata) GetId() string {
@@ -1104,13 +1283,14 @@ type Secret_Spec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Provider      Secret_Provider        `protobuf:"varint,1,opt,name=provider,proto3,enum=Superplane.Secrets.Secret_Provider" json:"provider,omitempty"`
 	Local         *Secret_Local          `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	Vault         *Secret_Vault          `protobuf:"bytes,3,opt,name=vault,proto3" json:"vault,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Secret_Spec) Reset() {
 	*x = Secret_Spec{}
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1302,7 @@ func (x *Secret_Spec) String() string {
 func (*Secret_Spec) ProtoMessage() {}
 
 func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_secrets_proto_msgTypes[19]
+	mi := &file_secrets_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1315,7 @@ func (x *Secret_Spec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Secret_Spec.ProtoReflect.Descriptor instead.
 func (*Secret_Spec) Descriptor() ([]byte, []int) {
-	return file_secrets_proto_rawDescGZIP(), []int{0, 2}
+	return file_secrets_proto_rawDescGZIP(), []int{0, 3}
 }
 
 func (x *Secret_Spec) GetProvider() Secret_Provider {
@@ -1152,11 +1332,18 @@ func (x *Secret_Spec) GetLocal() *Secret_Local {
 	return nil
 }
 
+func (x *Secret_Spec) GetVault() *Secret_Vault {
+	if x != nil {
+		return x.Vault
+	}
+	return nil
+}
+
 var File_secrets_proto protoreflect.FileDescriptor
 
 const file_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x88\x05\n" +
+	"\rsecrets.proto\x12\x12Superplane.Secrets\x1a\x13authorization.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xef\b\n" +
 	"\x06Secret\x12?\n" +
 	"\bmetadata\x18\x01 \x01(\v2#.Superplane.Secrets.Secret.MetadataR\bmetadata\x123\n" +
 	"\x04spec\x18\x02 \x01(\v2\x1f.Superplane.Secrets.Secret.SpecR\x04spec\x1a\x80\x01\n" +
@@ -1164,7 +1351,25 @@ const file_secrets_proto_rawDesc = "" +
 	"\x04data\x18\x01 \x03(\v2*.Superplane.Secrets.Secret.Local.DataEntryR\x04data\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xcd\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x97\x03\n" +
+	"\x05Vault\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05mount\x18\x03 \x01(\tR\x05mount\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12L\n" +
+	"\vauth_method\x18\x05 \x01(\x0e2+.Superplane.Secrets.Secret.Vault.AuthMethodR\n" +
+	"authMethod\x12\x14\n" +
+	"\x05token\x18\x06 \x01(\tR\x05token\x12#\n" +
+	"\rapprole_mount\x18\a \x01(\tR\fapproleMount\x12\x17\n" +
+	"\arole_id\x18\b \x01(\tR\x06roleId\x12\x1b\n" +
+	"\tsecret_id\x18\t \x01(\tR\bsecretId\x12\x12\n" +
+	"\x04keys\x18\n" +
+	" \x03(\tR\x04keys\"Y\n" +
+	"\n" +
+	"AuthMethod\x12\x1b\n" +
+	"\x17AUTH_METHOD_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11AUTH_METHOD_TOKEN\x10\x01\x12\x17\n" +
+	"\x13AUTH_METHOD_APPROLE\x10\x02\x1a\xcd\x01\n" +
 	"\bMetadata\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12E\n" +
@@ -1172,13 +1377,15 @@ const file_secrets_proto_rawDesc = "" +
 	"domainType\x12\x1b\n" +
 	"\tdomain_id\x18\x04 \x01(\tR\bdomainId\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\x7f\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a\xb7\x01\n" +
 	"\x04Spec\x12?\n" +
 	"\bprovider\x18\x01 \x01(\x0e2#.Superplane.Secrets.Secret.ProviderR\bprovider\x126\n" +
-	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\"4\n" +
+	"\x05local\x18\x02 \x01(\v2 .Superplane.Secrets.Secret.LocalR\x05local\x126\n" +
+	"\x05vault\x18\x03 \x01(\v2 .Superplane.Secrets.Secret.VaultR\x05vault\"H\n" +
 	"\bProvider\x12\x14\n" +
 	"\x10PROVIDER_UNKNOWN\x10\x00\x12\x12\n" +
-	"\x0ePROVIDER_LOCAL\x10\x01\"\xad\x01\n" +
+	"\x0ePROVIDER_LOCAL\x10\x01\x12\x12\n" +
+	"\x0ePROVIDER_VAULT\x10\x02\"\xad\x01\n" +
 	"\x13CreateSecretRequest\x122\n" +
 	"\x06secret\x18\x01 \x01(\v2\x1a.Superplane.Secrets.SecretR\x06secret\x12E\n" +
 	"\vdomain_type\x18\x02 \x01(\x0e2$.Superplane.Authorization.DomainTypeR\n" +
@@ -1276,80 +1483,84 @@ func file_secrets_proto_rawDescGZIP() []byte {
 	return file_secrets_proto_rawDescData
 }
 
-var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_secrets_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_secrets_proto_goTypes = []any{
 	(Secret_Provider)(0),             // 0: Superplane.Secrets.Secret.Provider
-	(*Secret)(nil),                   // 1: Superplane.Secrets.Secret
-	(*CreateSecretRequest)(nil),      // 2: Superplane.Secrets.CreateSecretRequest
-	(*CreateSecretResponse)(nil),     // 3: Superplane.Secrets.CreateSecretResponse
-	(*UpdateSecretRequest)(nil),      // 4: Superplane.Secrets.UpdateSecretRequest
-	(*UpdateSecretResponse)(nil),     // 5: Superplane.Secrets.UpdateSecretResponse
-	(*DescribeSecretRequest)(nil),    // 6: Superplane.Secrets.DescribeSecretRequest
-	(*DescribeSecretResponse)(nil),   // 7: Superplane.Secrets.DescribeSecretResponse
-	(*ListSecretsRequest)(nil),       // 8: Superplane.Secrets.ListSecretsRequest
-	(*ListSecretsResponse)(nil),      // 9: Superplane.Secrets.ListSecretsResponse
-	(*DeleteSecretRequest)(nil),      // 10: Superplane.Secrets.DeleteSecretRequest
-	(*DeleteSecretResponse)(nil),     // 11: Superplane.Secrets.DeleteSecretResponse
-	(*SetSecretKeyRequest)(nil),      // 12: Superplane.Secrets.SetSecretKeyRequest
-	(*SetSecretKeyResponse)(nil),     // 13: Superplane.Secrets.SetSecretKeyResponse
-	(*DeleteSecretKeyRequest)(nil),   // 14: Superplane.Secrets.DeleteSecretKeyRequest
-	(*DeleteSecretKeyResponse)(nil),  // 15: Superplane.Secrets.DeleteSecretKeyResponse
-	(*UpdateSecretNameRequest)(nil),  // 16: Superplane.Secrets.UpdateSecretNameRequest
-	(*UpdateSecretNameResponse)(nil), // 17: Superplane.Secrets.UpdateSecretNameResponse
-	(*Secret_Local)(nil),             // 18: Superplane.Secrets.Secret.Local
-	(*Secret_Metadata)(nil),          // 19: Superplane.Secrets.Secret.Metadata
-	(*Secret_Spec)(nil),              // 20: Superplane.Secrets.Secret.Spec
-	nil,                              // 21: Superplane.Secrets.Secret.Local.DataEntry
-	(authorization.DomainType)(0),    // 22: Superplane.Authorization.DomainType
-	(*timestamp.Timestamp)(nil),      // 23: google.protobuf.Timestamp
+	(Secret_Vault_AuthMethod)(0),     // 1: Superplane.Secrets.Secret.Vault.AuthMethod
+	(*Secret)(nil),                   // 2: Superplane.Secrets.Secret
+	(*CreateSecretRequest)(nil),      // 3: Superplane.Secrets.CreateSecretRequest
+	(*CreateSecretResponse)(nil),     // 4: Superplane.Secrets.CreateSecretResponse
+	(*UpdateSecretRequest)(nil),      // 5: Superplane.Secrets.UpdateSecretRequest
+	(*UpdateSecretResponse)(nil),     // 6: Superplane.Secrets.UpdateSecretResponse
+	(*DescribeSecretRequest)(nil),    // 7: Superplane.Secrets.DescribeSecretRequest
+	(*DescribeSecretResponse)(nil),   // 8: Superplane.Secrets.DescribeSecretResponse
+	(*ListSecretsRequest)(nil),       // 9: Superplane.Secrets.ListSecretsRequest
+	(*ListSecretsResponse)(nil),      // 10: Superplane.Secrets.ListSecretsResponse
+	(*DeleteSecretRequest)(nil),      // 11: Superplane.Secrets.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),     // 12: Superplane.Secrets.DeleteSecretResponse
+	(*SetSecretKeyRequest)(nil),      // 13: Superplane.Secrets.SetSecretKeyRequest
+	(*SetSecretKeyResponse)(nil),     // 14: Superplane.Secrets.SetSecretKeyResponse
+	(*DeleteSecretKeyRequest)(nil),   // 15: Superplane.Secrets.DeleteSecretKeyRequest
+	(*DeleteSecretKeyResponse)(nil),  // 16: Superplane.Secrets.DeleteSecretKeyResponse
+	(*UpdateSecretNameRequest)(nil),  // 17: Superplane.Secrets.UpdateSecretNameRequest
+	(*UpdateSecretNameResponse)(nil), // 18: Superplane.Secrets.UpdateSecretNameResponse
+	(*Secret_Local)(nil),             // 19: Superplane.Secrets.Secret.Local
+	(*Secret_Vault)(nil),             // 20: Superplane.Secrets.Secret.Vault
+	(*Secret_Metadata)(nil),          // 21: Superplane.Secrets.Secret.Metadata
+	(*Secret_Spec)(nil),              // 22: Superplane.Secrets.Secret.Spec
+	nil,                              // 23: Superplane.Secrets.Secret.Local.DataEntry
+	(authorization.DomainType)(0),    // 24: Superplane.Authorization.DomainType
+	(*timestamp.Timestamp)(nil),      // 25: google.protobuf.Timestamp
 }
 var file_secrets_proto_depIdxs = []int32{
-	19, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
-	20, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
-	1,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	1,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
-	22, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 11: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
-	22, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	22, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 14: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 16: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
-	22, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
-	1,  // 18: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
-	21, // 19: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
-	22, // 20: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
-	23, // 21: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 22: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
-	18, // 23: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
-	2,  // 24: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
-	6,  // 25: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
-	8,  // 26: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
-	4,  // 27: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
-	10, // 28: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
-	12, // 29: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
-	14, // 30: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
-	16, // 31: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
-	3,  // 32: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
-	7,  // 33: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
-	9,  // 34: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
-	5,  // 35: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
-	11, // 36: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
-	13, // 37: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
-	15, // 38: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
-	17, // 39: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
-	32, // [32:40] is the sub-list for method output_type
-	24, // [24:32] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	21, // 0: Superplane.Secrets.Secret.metadata:type_name -> Superplane.Secrets.Secret.Metadata
+	22, // 1: Superplane.Secrets.Secret.spec:type_name -> Superplane.Secrets.Secret.Spec
+	2,  // 2: Superplane.Secrets.CreateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
+	24, // 3: Superplane.Secrets.CreateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 4: Superplane.Secrets.CreateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	2,  // 5: Superplane.Secrets.UpdateSecretRequest.secret:type_name -> Superplane.Secrets.Secret
+	24, // 6: Superplane.Secrets.UpdateSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 7: Superplane.Secrets.UpdateSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	24, // 8: Superplane.Secrets.DescribeSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 9: Superplane.Secrets.DescribeSecretResponse.secret:type_name -> Superplane.Secrets.Secret
+	24, // 10: Superplane.Secrets.ListSecretsRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 11: Superplane.Secrets.ListSecretsResponse.secrets:type_name -> Superplane.Secrets.Secret
+	24, // 12: Superplane.Secrets.DeleteSecretRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	24, // 13: Superplane.Secrets.SetSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 14: Superplane.Secrets.SetSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	24, // 15: Superplane.Secrets.DeleteSecretKeyRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 16: Superplane.Secrets.DeleteSecretKeyResponse.secret:type_name -> Superplane.Secrets.Secret
+	24, // 17: Superplane.Secrets.UpdateSecretNameRequest.domain_type:type_name -> Superplane.Authorization.DomainType
+	2,  // 18: Superplane.Secrets.UpdateSecretNameResponse.secret:type_name -> Superplane.Secrets.Secret
+	23, // 19: Superplane.Secrets.Secret.Local.data:type_name -> Superplane.Secrets.Secret.Local.DataEntry
+	1,  // 20: Superplane.Secrets.Secret.Vault.auth_method:type_name -> Superplane.Secrets.Secret.Vault.AuthMethod
+	24, // 21: Superplane.Secrets.Secret.Metadata.domain_type:type_name -> Superplane.Authorization.DomainType
+	25, // 22: Superplane.Secrets.Secret.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 23: Superplane.Secrets.Secret.Spec.provider:type_name -> Superplane.Secrets.Secret.Provider
+	19, // 24: Superplane.Secrets.Secret.Spec.local:type_name -> Superplane.Secrets.Secret.Local
+	20, // 25: Superplane.Secrets.Secret.Spec.vault:type_name -> Superplane.Secrets.Secret.Vault
+	3,  // 26: Superplane.Secrets.Secrets.CreateSecret:input_type -> Superplane.Secrets.CreateSecretRequest
+	7,  // 27: Superplane.Secrets.Secrets.DescribeSecret:input_type -> Superplane.Secrets.DescribeSecretRequest
+	9,  // 28: Superplane.Secrets.Secrets.ListSecrets:input_type -> Superplane.Secrets.ListSecretsRequest
+	5,  // 29: Superplane.Secrets.Secrets.UpdateSecret:input_type -> Superplane.Secrets.UpdateSecretRequest
+	11, // 30: Superplane.Secrets.Secrets.DeleteSecret:input_type -> Superplane.Secrets.DeleteSecretRequest
+	13, // 31: Superplane.Secrets.Secrets.SetSecretKey:input_type -> Superplane.Secrets.SetSecretKeyRequest
+	15, // 32: Superplane.Secrets.Secrets.DeleteSecretKey:input_type -> Superplane.Secrets.DeleteSecretKeyRequest
+	17, // 33: Superplane.Secrets.Secrets.UpdateSecretName:input_type -> Superplane.Secrets.UpdateSecretNameRequest
+	4,  // 34: Superplane.Secrets.Secrets.CreateSecret:output_type -> Superplane.Secrets.CreateSecretResponse
+	8,  // 35: Superplane.Secrets.Secrets.DescribeSecret:output_type -> Superplane.Secrets.DescribeSecretResponse
+	10, // 36: Superplane.Secrets.Secrets.ListSecrets:output_type -> Superplane.Secrets.ListSecretsResponse
+	6,  // 37: Superplane.Secrets.Secrets.UpdateSecret:output_type -> Superplane.Secrets.UpdateSecretResponse
+	12, // 38: Superplane.Secrets.Secrets.DeleteSecret:output_type -> Superplane.Secrets.DeleteSecretResponse
+	14, // 39: Superplane.Secrets.Secrets.SetSecretKey:output_type -> Superplane.Secrets.SetSecretKeyResponse
+	16, // 40: Superplane.Secrets.Secrets.DeleteSecretKey:output_type -> Superplane.Secrets.DeleteSecretKeyResponse
+	18, // 41: Superplane.Secrets.Secrets.UpdateSecretName:output_type -> Superplane.Secrets.UpdateSecretNameResponse
+	34, // [34:42] is the sub-list for method output_type
+	26, // [26:34] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_secrets_proto_init() }
@@ -1362,8 +1573,8 @@ func file_secrets_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_secrets_proto_rawDesc), len(file_secrets_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
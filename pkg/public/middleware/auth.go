@@ -21,9 +21,11 @@ import (
 type contextKey string
 
 const AccountContextKey contextKey = "account"
+const SessionContextKey contextKey = "session"
 const UserContextKey contextKey = "user"
 const OrganizationNotFoundError string = "organization_not_found_error"
 const AccountNotFoundError string = "account_not_found_error"
+const SSORequiredError string = "sso_required_error"
 
 var ownerSetupEnabled = os.Getenv("OWNER_SETUP_ENABLED") == "yes"
 
@@ -113,7 +115,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			accountID, err := getAccountFromCookie(r, jwtSigner)
+			session, err := getSessionFromCookie(r, jwtSigner)
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -125,7 +127,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 				return
 			}
 
-			account, err := models.FindAccountByID(accountID)
+			account, err := models.FindAccountByID(session.AccountID)
 			if err != nil {
 				if isAccountAPIPath(r.URL.Path) {
 					http.Error(w, "Unauthorized", http.StatusUnauthorized)
@@ -138,6 +140,7 @@ func AccountAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 			}
 
 			ctx := context.WithValue(r.Context(), AccountContextKey, account)
+			ctx = context.WithValue(ctx, SessionContextKey, session)
 			r = r.WithContext(ctx)
 			next.ServeHTTP(w, r)
 		})
@@ -176,6 +179,16 @@ func OrganizationAuthMiddleware(jwtSigner *jwt.Signer) mux.MiddlewareFunc {
 					return
 				}
 
+				//
+				// The UI sends the person to the login URL
+				// of the identity provider of the organization.
+				//
+				if err.Error() == SSORequiredError {
+					w.Header().Set(authentication.SSOLoginURLHeader, authentication.SAMLLoginPath(findOrganizationID(r)))
+					http.Error(w, "Unauthorized", http.StatusUnauthorized)
+					return
+				}
+
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
 			}
@@ -203,7 +216,7 @@ func authenticateUserByToken(r *http.Request) (*models.User, error) {
 }
 
 func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.User, error) {
-	accountID, err := getAccountFromCookie(r, jwtSigner)
+	session, err := getSessionFromCookie(r, jwtSigner)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
@@ -213,7 +226,7 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
-	account, err := models.FindAccountByID(accountID)
+	account, err := models.FindAccountByID(session.AccountID)
 	if err != nil {
 		return nil, errors.New(AccountNotFoundError)
 	}
@@ -223,6 +236,26 @@ func authenticateUserByCookie(jwtSigner *jwt.Signer, r *http.Request) (*models.U
 		return nil, errors.New(OrganizationNotFoundError)
 	}
 
+	//
+	// Sessions started with the identity provider of another organization
+	// cannot be used here, and organizations enforcing single sign-on
+	// require a sign-in with their own identity provider.
+	//
+	if !session.CanAccess(organizationID) {
+		return nil, errors.New(OrganizationNotFoundError)
+	}
+
+	if !session.SignedInWithSSO(organizationID) {
+		enforced, err := models.IsSAMLEnforced(user.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		if enforced {
+			return nil, errors.New(SSORequiredError)
+		}
+	}
+
 	return user, nil
 }
 
@@ -245,6 +278,11 @@ func GetAccountFromContext(ctx context.Context) (*models.Account, bool) {
 	return account, ok
 }
 
+func GetSessionFromContext(ctx context.Context) (*authentication.Session, bool) {
+	session, ok := ctx.Value(SessionContextKey).(*authentication.Session)
+	return session, ok
+}
+
 func isOwnerSetupAllowedPath(path string) bool {
 	if path == "/setup" || strings.HasPrefix(path, "/assets") {
 		return true
@@ -274,28 +312,18 @@ func isAccountAPIPath(path string) bool {
 	}
 }
 
-func getAccountFromCookie(r *http.Request, jwtSigner *jwt.Signer) (string, error) {
+func getSessionFromCookie(r *http.Request, jwtSigner *jwt.Signer) (*authentication.Session, error) {
 	cookie, err := r.Cookie("account_token")
 	if err != nil {
-		return "", fmt.Errorf("account token cookie not found")
+		return nil, fmt.Errorf("account token cookie not found")
 	}
 
-	claims, err := jwtSigner.ValidateAndGetClaims(cookie.Value)
+	session, err := authentication.ParseSession(jwtSigner, cookie.Value)
 	if err != nil {
-		return "", fmt.Errorf("invalid account token: %v", err)
-	}
-
-	accountClaim, exists := claims["sub"]
-	if !exists {
-		return "", fmt.Errorf("account ID missing from token")
-	}
-
-	accountID, ok := accountClaim.(string)
-	if !ok {
-		return "", fmt.Errorf("invalid account ID in token")
+		return nil, fmt.Errorf("invalid account token: %v", err)
 	}
 
-	return accountID, nil
+	return session, nil
 }
 
 func GetUserFromContext(ctx context.Context) (*models.User, bool) {
@@ -9,7 +9,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/authentication"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/jwt"
+	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 )
 
@@ -66,3 +69,65 @@ func TestOrganizationAuthMiddleware_CookieAuthErrors(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, res.Code)
 	})
 }
+
+func TestOrganizationAuthMiddleware_SAML(t *testing.T) {
+	r := support.Setup(t)
+	signer := jwt.NewSigner("test-secret")
+	orgID := r.Organization.ID.String()
+
+	handler := OrganizationAuthMiddleware(signer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(t *testing.T, claims map[string]any) *httptest.ResponseRecorder {
+		token, err := signer.GenerateWithClaims(r.Account.ID.String(), time.Hour, claims)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users", nil)
+		req.AddCookie(&http.Cookie{Name: "account_token", Value: token})
+		req.Header.Set("x-organization-id", orgID)
+
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+
+	t.Run("single sign-on session for another organization returns not found", func(t *testing.T) {
+		res := request(t, map[string]any{
+			authentication.SSOOrganizationsClaim: []string{uuid.NewString()},
+			authentication.SSOOnlyClaim:          true,
+		})
+
+		assert.Equal(t, http.StatusNotFound, res.Code)
+	})
+
+	t.Run("sign-in flow tokens are not sessions", func(t *testing.T) {
+		res := request(t, map[string]any{"purpose": "saml_login"})
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	require.NoError(t, database.Conn().Create(&models.OrganizationSAMLSettings{
+		OrganizationID: r.Organization.ID,
+		Enabled:        true,
+		Enforced:       true,
+		IdpMetadata:    "<md:EntityDescriptor/>",
+		IdpEntityID:    "https://idp.example.com",
+		IdpSSOURL:      "https://idp.example.com/sso",
+	}).Error)
+
+	t.Run("enforced single sign-on without SAML sign-in returns unauthorized with login URL", func(t *testing.T) {
+		res := request(t, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+		assert.Equal(t, "/auth/saml/"+orgID, res.Header().Get(authentication.SSOLoginURLHeader))
+	})
+
+	t.Run("enforced single sign-on with SAML sign-in reaches next handler", func(t *testing.T) {
+		res := request(t, map[string]any{
+			authentication.SSOOrganizationsClaim: []string{orgID},
+			authentication.SSOOnlyClaim:          true,
+		})
+
+		assert.Equal(t, http.StatusNoContent, res.Code)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/jwt"
 	"github.com/superplanehq/superplane/pkg/logging"
 	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/scim"
 	"github.com/superplanehq/superplane/pkg/workers/contexts"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	nooptrace "go.opentelemetry.io/otel/trace/noop"
@@ -79,6 +81,7 @@ type Server struct {
 	WebhooksBaseURL       string
 	wsHub                 *ws.Hub
 	authHandler           *authentication.Handler
+	scimHandler           *scim.Handler
 	isDev                 bool
 }
 
@@ -107,6 +110,7 @@ func NewServer(
 	authHandler := authentication.NewHandler(jwtSigner, encryptor, authorizationService, appEnv, templateDir, blockSignup, passwordLoginEnabled)
 	providers := getOAuthProviders()
 	authHandler.InitializeProviders(providers)
+	authHandler.InitializeSAML(baseURL)
 
 	server := &Server{
 		BaseURL:               baseURL,
@@ -114,6 +118,7 @@ func NewServer(
 		BasePath:              basePath,
 		wsHub:                 ws.NewHub(),
 		authHandler:           authHandler,
+		scimHandler:           scim.NewHandler(authorizationService),
 		isDev:                 appEnv == "development",
 		timeoutHandlerTimeout: 15 * time.Second,
 		encryptor:             encryptor,
@@ -306,6 +311,11 @@ func (s *Server) grpcGatewayAccountHandler(grpcGatewayMux *runtime.ServeMux) htt
 			return
 		}
 
+		if session, ok := middleware.GetSessionFromContext(r.Context()); ok && session.SSOOnly {
+			http.Error(w, "Not available with single sign-on sessions", http.StatusForbidden)
+			return
+		}
+
 		r2 := new(http.Request)
 		*r2 = *r
 		r2.URL = new(url.URL)
@@ -386,6 +396,9 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	// Register authentication routes (no auth required)
 	s.authHandler.RegisterRoutes(r)
 
+	// SCIM provisioning endpoints (authenticated with service account tokens)
+	s.scimHandler.RegisterRoutes(r)
+
 	//
 	// Public routes (no authentication required)
 	//
@@ -548,6 +561,11 @@ func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if session, ok := middleware.GetSessionFromContext(r.Context()); ok && session.SSOOnly {
+		http.Error(w, "Not available with single sign-on sessions", http.StatusForbidden)
+		return
+	}
+
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
@@ -678,6 +696,15 @@ func (s *Server) listAccountOrganizations(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	//
+	// Single sign-on sessions only see the organizations they signed in to.
+	//
+	if session, ok := middleware.GetSessionFromContext(r.Context()); ok {
+		organizations = slices.DeleteFunc(organizations, func(organization models.Organization) bool {
+			return !session.CanAccess(organization.ID.String())
+		})
+	}
+
 	orgIDs := make([]string, 0, len(organizations))
 	for _, organization := range organizations {
 		orgIDs = append(orgIDs, organization.ID.String())
@@ -908,6 +935,7 @@ func (s *Server) executeComponentNode(ctx context.Context, body []byte, headers
 				Logger:         logging.ForExecution(execution, nil),
 				Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 				CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
+				Logs:           contexts.NewExecutionLogContext(execution),
 			}, nil
 		},
 	})
@@ -141,6 +141,21 @@ func (c *HTTPContext) do(request *http.Request) (*http.Response, error) {
 	return resp, nil
 }
 
+// DialContext opens a connection with the same checks as HTTP requests,
+// for sending data to user-provided addresses over other protocols.
+func (c *HTTPContext) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	if err := c.ValidateHost(host); err != nil {
+		return nil, err
+	}
+
+	return c.dialer.DialContext(ctx, network, address)
+}
+
 type LimitedReadCloser struct {
 	reader          io.ReadCloser
 	remaining       int64
@@ -181,7 +196,12 @@ func (c *HTTPContext) validateURL(URL *url.URL) error {
 		return fmt.Errorf("only http and https schemes are allowed")
 	}
 
-	host := URL.Hostname()
+	return c.ValidateHost(URL.Hostname())
+}
+
+// ValidateHost checks a host against the blocked hostnames,
+// and against the private IP ranges when it is an IP address.
+func (c *HTTPContext) ValidateHost(host string) error {
 	if host == "" {
 		return fmt.Errorf("URL must have a host")
 	}
@@ -0,0 +1,36 @@
+package scim
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var filterRegex = regexp.MustCompile(`^\s*([A-Za-z][\w.:]*)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// Filter is an equality filter on one attribute, e.g. userName eq "jane@example.com".
+// Identity providers only use this form to look up the resources they provisioned,
+// so the other operators of RFC 7644 are not supported.
+type Filter struct {
+	Attribute string
+	Value     string
+}
+
+// ParseFilter parses a filter expression. Attribute names are case-insensitive,
+// so they are returned in lower case. An empty expression returns nil.
+func ParseFilter(expression string) (*Filter, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	matches := filterRegex.FindStringSubmatch(expression)
+	if matches == nil {
+		return nil, fmt.Errorf("unsupported filter %q: only 'attribute eq \"value\"' is supported", expression)
+	}
+
+	value := strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(matches[2])
+	return &Filter{
+		Attribute: strings.ToLower(matches[1]),
+		Value:     value,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ParseFilter(t *testing.T) {
+	t.Run("empty filter", func(t *testing.T) {
+		filter, err := ParseFilter("  ")
+		require.NoError(t, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("equality filter", func(t *testing.T) {
+		filter, err := ParseFilter(`userName eq "jane@example.com"`)
+		require.NoError(t, err)
+		assert.Equal(t, "username", filter.Attribute)
+		assert.Equal(t, "jane@example.com", filter.Value)
+	})
+
+	t.Run("operator is case-insensitive and quotes can be escaped", func(t *testing.T) {
+		filter, err := ParseFilter(`displayName EQ "The \"core\" team"`)
+		require.NoError(t, err)
+		assert.Equal(t, "displayname", filter.Attribute)
+		assert.Equal(t, `The "core" team`, filter.Value)
+	})
+
+	t.Run("other operators are not supported", func(t *testing.T) {
+		_, err := ParseFilter(`userName sw "jane"`)
+		require.Error(t, err)
+
+		_, err = ParseFilter(`userName eq "a" and displayName eq "b"`)
+		require.Error(t, err)
+	})
+}
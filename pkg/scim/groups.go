@@ -0,0 +1,490 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const ResourceGroups = "groups"
+
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// GroupName returns the name of the group created for a display name,
+// following the same rules as groups created from the UI.
+func GroupName(displayName string) string {
+	return strings.ToLower(strings.Join(strings.Fields(displayName), "_"))
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "read")
+	if !ok {
+		return
+	}
+
+	filter, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	if filter != nil && filter.Attribute != "displayname" {
+		writeError(w, http.StatusBadRequest, "invalidFilter", "groups can only be filtered by displayName")
+		return
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	names, err := h.authService.GetGroups(orgID, models.DomainTypeOrganization)
+	if err != nil {
+		log.Errorf("Error listing groups for organization %s: %v", orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error listing groups")
+		return
+	}
+
+	sort.Strings(names)
+
+	resources := []any{}
+	for _, name := range names {
+		group, err := h.serializeGroup(orgID, name)
+		if err != nil {
+			log.Errorf("Error describing group %s in organization %s: %v", name, orgID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error listing groups")
+			return
+		}
+
+		if filter != nil && !strings.EqualFold(group.DisplayName, filter.Value) {
+			continue
+		}
+
+		resources = append(resources, group)
+	}
+
+	writeJSON(w, http.StatusOK, page(r, resources))
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "read")
+	if !ok {
+		return
+	}
+
+	group, ok := h.findGroup(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "create")
+	if !ok {
+		return
+	}
+
+	var req Group
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	displayName := strings.TrimSpace(req.DisplayName)
+	name := GroupName(displayName)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "displayName is required")
+		return
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	if _, err := h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, name); err == nil {
+		writeError(w, http.StatusConflict, "uniqueness", "group already exists")
+		return
+	}
+
+	memberIDs, err := h.validateMembers(orgID, req.Members)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	//
+	// Provisioned groups grant the viewer role.
+	// Admins can give them other roles in the organization settings.
+	//
+	err = h.authService.CreateGroup(orgID, models.DomainTypeOrganization, name, models.RoleOrgViewer, displayName, "")
+	if err != nil {
+		log.Errorf("Error creating group %s in organization %s: %v", name, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error creating group")
+		return
+	}
+
+	if err := h.setMembers(orgID, name, memberIDs); err != nil {
+		log.Errorf("Error adding members to group %s in organization %s: %v", name, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error creating group")
+		return
+	}
+
+	group, ok := h.findGroup(w, serviceAccount, name)
+	if !ok {
+		return
+	}
+
+	h.recordAudit(r, serviceAccount, ResourceGroups, name, "create", nil, group)
+	writeJSON(w, http.StatusCreated, group)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "update")
+	if !ok {
+		return
+	}
+
+	group, ok := h.findGroup(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	var req Group
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	memberIDs, err := h.validateMembers(orgID, req.Members)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	h.updateGroup(w, r, serviceAccount, group, strings.TrimSpace(req.DisplayName), memberIDs)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "update")
+	if !ok {
+		return
+	}
+
+	group, ok := h.findGroup(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	var req PatchRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	memberIDs := []string{}
+	for _, member := range group.Members {
+		memberIDs = append(memberIDs, member.Value)
+	}
+
+	displayName, memberIDs, err := applyGroupPatch(group.DisplayName, memberIDs, req.Operations)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	members := []Member{}
+	for _, id := range memberIDs {
+		members = append(members, Member{Value: id})
+	}
+
+	memberIDs, err = h.validateMembers(orgID, members)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	h.updateGroup(w, r, serviceAccount, group, displayName, memberIDs)
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceGroups, "delete")
+	if !ok {
+		return
+	}
+
+	group, ok := h.findGroup(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	if err := h.authService.DeleteGroup(orgID, models.DomainTypeOrganization, group.ID); err != nil {
+		log.Errorf("Error deleting group %s in organization %s: %v", group.ID, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error deleting group")
+		return
+	}
+
+	h.recordAudit(r, serviceAccount, ResourceGroups, group.ID, "delete", group, nil)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateGroup applies the changes of PUT and PATCH requests.
+// The name of the group never changes, so the role bindings are kept,
+// and only its display name and members are updated.
+func (h *Handler) updateGroup(w http.ResponseWriter, r *http.Request, serviceAccount *models.User, group *Group, displayName string, memberIDs []string) {
+	orgID := serviceAccount.OrganizationID.String()
+
+	if displayName != "" && displayName != group.DisplayName {
+		role, err := h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, group.ID)
+		if err != nil {
+			writeError(w, http.StatusNotFound, "", "group not found")
+			return
+		}
+
+		err = h.authService.UpdateGroup(orgID, models.DomainTypeOrganization, group.ID, role, displayName, "")
+		if err != nil {
+			log.Errorf("Error updating group %s in organization %s: %v", group.ID, orgID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error updating group")
+			return
+		}
+	}
+
+	if err := h.setMembers(orgID, group.ID, memberIDs); err != nil {
+		log.Errorf("Error updating members of group %s in organization %s: %v", group.ID, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error updating group")
+		return
+	}
+
+	updated, ok := h.findGroup(w, serviceAccount, group.ID)
+	if !ok {
+		return
+	}
+
+	h.recordAudit(r, serviceAccount, ResourceGroups, group.ID, "update", group, updated)
+	writeJSON(w, http.StatusOK, updated)
+}
+
+// setMembers adds and removes users, so the group has exactly the given members.
+func (h *Handler) setMembers(orgID, name string, memberIDs []string) error {
+	current, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range memberIDs {
+		if slices.Contains(current, id) {
+			continue
+		}
+
+		if err := h.authService.AddUserToGroup(orgID, models.DomainTypeOrganization, id, name); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range current {
+		if slices.Contains(memberIDs, id) {
+			continue
+		}
+
+		if err := h.authService.RemoveUserFromGroup(orgID, models.DomainTypeOrganization, id, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMembers checks all members are active people in the organization,
+// and returns their IDs without duplicates.
+func (h *Handler) validateMembers(orgID string, members []Member) ([]string, error) {
+	ids := []string{}
+	for _, member := range members {
+		if slices.Contains(ids, member.Value) {
+			continue
+		}
+
+		user, err := models.FindActiveUserByID(orgID, member.Value)
+		if err != nil || user.IsServiceAccount() {
+			return nil, fmt.Errorf("user %s not found", member.Value)
+		}
+
+		ids = append(ids, member.Value)
+	}
+
+	return ids, nil
+}
+
+func (h *Handler) findGroup(w http.ResponseWriter, serviceAccount *models.User, name string) (*Group, bool) {
+	orgID := serviceAccount.OrganizationID.String()
+	if _, err := h.authService.GetGroupRole(orgID, models.DomainTypeOrganization, name); err != nil {
+		writeError(w, http.StatusNotFound, "", "group not found")
+		return nil, false
+	}
+
+	group, err := h.serializeGroup(orgID, name)
+	if err != nil {
+		log.Errorf("Error describing group %s in organization %s: %v", name, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error finding group")
+		return nil, false
+	}
+
+	return group, true
+}
+
+func (h *Handler) serializeGroup(orgID, name string) (*Group, error) {
+	group := &Group{
+		Schemas:     []string{SchemaGroup},
+		ID:          name,
+		DisplayName: name,
+		Members:     []Member{},
+		Meta:        &Meta{ResourceType: "Group"},
+	}
+
+	metadata, err := models.FindGroupMetadata(name, models.DomainTypeOrganization, orgID)
+	if err == nil {
+		if metadata.DisplayName != "" {
+			group.DisplayName = metadata.DisplayName
+		}
+
+		group.Meta.Created = metadata.CreatedAt.UTC().Format(time.RFC3339)
+		group.Meta.LastModified = metadata.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	userIDs, err := h.authService.GetGroupUsers(orgID, models.DomainTypeOrganization, name)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := models.ListActiveUsersByID(orgID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		group.Members = append(group.Members, Member{Value: user.ID.String(), Display: user.Name})
+	}
+
+	return group, nil
+}
+
+// applyGroupPatch applies PATCH operations to the display name and members of a group.
+// Members are removed with a "members" path and a list of members as value,
+// or with a path filtering the member, as in members[value eq "<id>"].
+func applyGroupPatch(displayName string, memberIDs []string, operations []PatchOperation) (string, []string, error) {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		path := strings.ToLower(operation.Path)
+
+		switch {
+		case path == "":
+			if op == "remove" {
+				return "", nil, errors.New("remove operations require a path")
+			}
+
+			var value struct {
+				DisplayName string   `json:"displayName"`
+				Members     []Member `json:"members"`
+			}
+
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return "", nil, errors.New("operations without a path must have an object value")
+			}
+
+			if value.DisplayName != "" {
+				displayName = value.DisplayName
+			}
+
+			if value.Members != nil {
+				memberIDs = applyMembersOperation(op, memberIDs, value.Members)
+			}
+
+		case path == "displayname":
+			if op == "remove" {
+				return "", nil, errors.New("displayName cannot be removed")
+			}
+
+			if err := json.Unmarshal(operation.Value, &displayName); err != nil {
+				return "", nil, errors.New("displayName must be a string")
+			}
+
+		case path == "members":
+			members := []Member{}
+			if len(operation.Value) > 0 {
+				if err := json.Unmarshal(operation.Value, &members); err != nil {
+					return "", nil, errors.New("members must be a list")
+				}
+			}
+
+			//
+			// Removing the members attribute without a value removes everyone.
+			//
+			if op == "remove" && len(members) == 0 {
+				memberIDs = []string{}
+				continue
+			}
+
+			memberIDs = applyMembersOperation(op, memberIDs, members)
+
+		case strings.HasPrefix(path, "members[") && strings.HasSuffix(path, "]"):
+			if op != "remove" {
+				return "", nil, fmt.Errorf("unsupported %s operation on %s", op, operation.Path)
+			}
+
+			filter, err := ParseFilter(operation.Path[len("members[") : len(operation.Path)-1])
+			if err != nil || filter.Attribute != "value" {
+				return "", nil, fmt.Errorf("unsupported path %s", operation.Path)
+			}
+
+			memberIDs = applyMembersOperation(op, memberIDs, []Member{{Value: filter.Value}})
+
+		//
+		// Identity providers also send attributes which are not stored, like externalId.
+		//
+		default:
+			continue
+		}
+	}
+
+	return displayName, memberIDs, nil
+}
+
+func applyMembersOperation(op string, memberIDs []string, members []Member) []string {
+	switch op {
+	case "replace":
+		result := []string{}
+		for _, member := range members {
+			result = append(result, member.Value)
+		}
+
+		return result
+
+	case "remove":
+		return slices.DeleteFunc(slices.Clone(memberIDs), func(id string) bool {
+			return slices.ContainsFunc(members, func(member Member) bool { return member.Value == id })
+		})
+
+	default:
+		result := slices.Clone(memberIDs)
+		for _, member := range members {
+			if !slices.Contains(result, member.Value) {
+				result = append(result, member.Value)
+			}
+		}
+
+		return result
+	}
+}
@@ -0,0 +1,78 @@
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__GroupName(t *testing.T) {
+	assert.Equal(t, "platform_engineering", GroupName("  Platform   Engineering "))
+	assert.Equal(t, "", GroupName(" "))
+}
+
+func Test__ApplyGroupPatch(t *testing.T) {
+	value := func(v any) json.RawMessage {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("members are added and removed", func(t *testing.T) {
+		displayName, members, err := applyGroupPatch("Engineering", []string{"a", "b"}, []PatchOperation{
+			{Op: "add", Path: "members", Value: value([]Member{{Value: "c"}, {Value: "a"}})},
+			{Op: "remove", Path: `members[value eq "b"]`},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Engineering", displayName)
+		assert.Equal(t, []string{"a", "c"}, members)
+	})
+
+	t.Run("members are replaced", func(t *testing.T) {
+		_, members, err := applyGroupPatch("Engineering", []string{"a", "b"}, []PatchOperation{
+			{Op: "Replace", Path: "members", Value: value([]Member{{Value: "c"}})},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c"}, members)
+	})
+
+	t.Run("removing members without value removes everyone", func(t *testing.T) {
+		_, members, err := applyGroupPatch("Engineering", []string{"a", "b"}, []PatchOperation{
+			{Op: "remove", Path: "members"},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, members)
+	})
+
+	t.Run("display name is replaced", func(t *testing.T) {
+		displayName, _, err := applyGroupPatch("Engineering", []string{}, []PatchOperation{
+			{Op: "replace", Value: value(map[string]any{"displayName": "Platform"})},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Platform", displayName)
+	})
+
+	t.Run("attributes which are not stored are ignored", func(t *testing.T) {
+		displayName, members, err := applyGroupPatch("Engineering", []string{"a"}, []PatchOperation{
+			{Op: "replace", Path: "externalId", Value: value("123")},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Engineering", displayName)
+		assert.Equal(t, []string{"a"}, members)
+	})
+
+	t.Run("member filters only support removal", func(t *testing.T) {
+		_, _, err := applyGroupPatch("Engineering", []string{"a"}, []PatchOperation{
+			{Op: "add", Path: `members[value eq "b"]`},
+		})
+
+		require.ErrorContains(t, err, "unsupported add operation")
+	})
+}
@@ -0,0 +1,257 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/audit"
+	"github.com/superplanehq/superplane/pkg/authorization"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	BasePath    = "/scim/v2"
+	ContentType = "application/scim+json"
+
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+type contextKey string
+
+const serviceAccountContextKey contextKey = "scim-service-account"
+
+// Handler serves the SCIM 2.0 endpoints identity providers use
+// to provision the users and groups of an organization.
+// Requests are authenticated with the token of a service account,
+// and scoped to the organization of that service account.
+type Handler struct {
+	authService authorization.Authorization
+}
+
+func NewHandler(authService authorization.Authorization) *Handler {
+	return &Handler{authService: authService}
+}
+
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	r := router.PathPrefix(BasePath).Subrouter()
+	r.Use(h.authenticate)
+
+	r.HandleFunc("/ServiceProviderConfig", h.getServiceProviderConfig).Methods(http.MethodGet)
+
+	r.HandleFunc("/Users", h.listUsers).Methods(http.MethodGet)
+	r.HandleFunc("/Users", h.createUser).Methods(http.MethodPost)
+	r.HandleFunc("/Users/{id}", h.getUser).Methods(http.MethodGet)
+	r.HandleFunc("/Users/{id}", h.replaceUser).Methods(http.MethodPut)
+	r.HandleFunc("/Users/{id}", h.patchUser).Methods(http.MethodPatch)
+	r.HandleFunc("/Users/{id}", h.deleteUser).Methods(http.MethodDelete)
+
+	r.HandleFunc("/Groups", h.listGroups).Methods(http.MethodGet)
+	r.HandleFunc("/Groups", h.createGroup).Methods(http.MethodPost)
+	r.HandleFunc("/Groups/{id}", h.getGroup).Methods(http.MethodGet)
+	r.HandleFunc("/Groups/{id}", h.replaceGroup).Methods(http.MethodPut)
+	r.HandleFunc("/Groups/{id}", h.patchGroup).Methods(http.MethodPatch)
+	r.HandleFunc("/Groups/{id}", h.deleteGroup).Methods(http.MethodDelete)
+}
+
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			writeError(w, http.StatusUnauthorized, "", "Unauthorized")
+			return
+		}
+
+		//
+		// Only service accounts can provision users,
+		// since the tokens of people are tied to their own access.
+		//
+		user, err := models.FindActiveUserByTokenHash(crypto.HashToken(token))
+		if err != nil || !user.IsServiceAccount() {
+			writeError(w, http.StatusUnauthorized, "", "Unauthorized")
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), serviceAccountContextKey, user)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// authorize checks the service account has the organization permission,
+// and writes the error response when it does not.
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, resource, action string) (*models.User, bool) {
+	serviceAccount := serviceAccountFromContext(r.Context())
+	allowed, err := h.authService.CheckOrganizationPermission(
+		serviceAccount.ID.String(),
+		serviceAccount.OrganizationID.String(),
+		resource,
+		action,
+	)
+
+	if err != nil {
+		log.Errorf("Error checking %s.%s permission for service account %s: %v", resource, action, serviceAccount.ID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error checking permissions")
+		return nil, false
+	}
+
+	if !allowed {
+		writeError(w, http.StatusForbidden, "", "Forbidden")
+		return nil, false
+	}
+
+	return serviceAccount, true
+}
+
+func serviceAccountFromContext(ctx context.Context) *models.User {
+	user, _ := ctx.Value(serviceAccountContextKey).(*models.User)
+	return user
+}
+
+func (h *Handler) recordAudit(r *http.Request, serviceAccount *models.User, resourceType, resourceID, action string, before, after any) {
+	audit.Record(r.Context(), audit.Event{
+		OrganizationID: serviceAccount.OrganizationID,
+		ActorID:        serviceAccount.ID.String(),
+		ResourceType:   resourceType,
+		ResourceID:     resourceID,
+		Action:         action,
+		Method:         r.Method + " " + r.URL.Path,
+		Before:         toMap(before),
+		After:          toMap(after),
+	})
+}
+
+func toMap(resource any) map[string]any {
+	if resource == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil
+	}
+
+	return result
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// page returns the resources requested with the startIndex and count
+// query parameters, which are 1-based as defined by RFC 7644.
+func page(r *http.Request, resources []any) ListResponse {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = DefaultPageSize
+	}
+
+	if count > MaxPageSize {
+		count = MaxPageSize
+	}
+
+	start := min(startIndex-1, len(resources))
+	end := min(start+count, len(resources))
+
+	return ListResponse{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: end - start,
+		Resources:    append([]any{}, resources[start:end]...),
+	}
+}
+
+func (h *Handler) getServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":        []string{SchemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": MaxPageSize},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Service account token",
+				"description": "Authentication with the API token of a service account",
+				"primary":     true,
+			},
+		},
+		"meta": Meta{ResourceType: "ServiceProviderConfig"},
+	})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Errorf("Error writing SCIM response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, scimType, detail string) {
+	writeJSON(w, statusCode, ErrorResponse{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(statusCode),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+// writeStatusError writes the error returned by one of the gRPC actions.
+func writeStatusError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	writeError(w, runtime.HTTPStatusFromCode(s.Code()), "", s.Message())
+}
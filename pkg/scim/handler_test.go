@@ -0,0 +1,238 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/gorm"
+)
+
+func Test__SCIM(t *testing.T) {
+	r := support.Setup(t)
+	router := mux.NewRouter()
+	NewHandler(r.AuthService).RegisterRoutes(router)
+
+	adminToken := createServiceAccount(t, r, models.RoleOrgAdmin)
+	viewerToken := createServiceAccount(t, r, models.RoleOrgViewer)
+
+	send := func(method, path, token string, body any) *httptest.ResponseRecorder {
+		var payload []byte
+		if body != nil {
+			var err error
+			payload, err = json.Marshal(body)
+			require.NoError(t, err)
+		}
+
+		req := httptest.NewRequest(method, path, bytes.NewReader(payload))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, req)
+		return response
+	}
+
+	decode := func(response *httptest.ResponseRecorder, v any) {
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), v))
+	}
+
+	t.Run("requests need a service account token", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, send(http.MethodGet, "/scim/v2/Users", "", nil).Code)
+		assert.Equal(t, http.StatusUnauthorized, send(http.MethodGet, "/scim/v2/Users", "not-a-token", nil).Code)
+	})
+
+	t.Run("service account needs permissions", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send(http.MethodGet, "/scim/v2/Users", viewerToken, nil).Code)
+
+		response := send(http.MethodPost, "/scim/v2/Users", viewerToken, map[string]any{"userName": "jane@example.com"})
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	var userID string
+
+	t.Run("user is provisioned", func(t *testing.T) {
+		response := send(http.MethodPost, "/scim/v2/Users", adminToken, map[string]any{
+			"schemas":  []string{SchemaUser},
+			"userName": "Jane@example.com",
+			"name":     map[string]string{"givenName": "Jane", "familyName": "Doe"},
+			"active":   true,
+		})
+
+		require.Equal(t, http.StatusCreated, response.Code)
+		assert.Equal(t, ContentType, response.Header().Get("Content-Type"))
+
+		user := User{}
+		decode(response, &user)
+		assert.Equal(t, "jane@example.com", user.UserName)
+		assert.Equal(t, "Jane Doe", user.DisplayName)
+		require.NotNil(t, user.Active)
+		assert.True(t, *user.Active)
+		userID = user.ID
+
+		roles, err := r.AuthService.GetUserRolesForOrg(userID, r.Organization.ID.String())
+		require.NoError(t, err)
+		require.NotEmpty(t, roles)
+		assert.Equal(t, models.RoleOrgViewer, roles[0].Name)
+
+		response = send(http.MethodPost, "/scim/v2/Users", adminToken, map[string]any{"userName": "jane@example.com"})
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+
+	t.Run("provisioning follows the invitation flow", func(t *testing.T) {
+		//
+		// People with an account are added with it,
+		// and an accepted invitation records it.
+		//
+		account, err := models.CreateAccount("John", "john@example.com")
+		require.NoError(t, err)
+
+		response := send(http.MethodPost, "/scim/v2/Users", adminToken, map[string]any{"userName": "john@example.com"})
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		user := User{}
+		decode(response, &user)
+		provisioned, err := models.FindActiveUserByID(r.Organization.ID.String(), user.ID)
+		require.NoError(t, err)
+		require.NotNil(t, provisioned.AccountID)
+		assert.Equal(t, account.ID, *provisioned.AccountID)
+
+		invitations, err := models.ListInvitationsInState(r.Organization.ID.String(), models.InvitationStateAccepted)
+		require.NoError(t, err)
+		require.Len(t, invitations, 2)
+		assert.Equal(t, "john@example.com", invitations[0].Email)
+		assert.NotEqual(t, r.User, invitations[0].InvitedBy)
+
+		//
+		// Pending invitations are accepted,
+		// so they are not accepted again when the person signs in.
+		//
+		_, err = models.CreateInvitation(r.Organization.ID, r.User, "mary@example.com", models.InvitationStatePending)
+		require.NoError(t, err)
+
+		response = send(http.MethodPost, "/scim/v2/Users", adminToken, map[string]any{"userName": "mary@example.com"})
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		_, err = models.FindPendingInvitation("mary@example.com", r.Organization.ID.String())
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("users are filtered by userName", func(t *testing.T) {
+		response := send(http.MethodGet, `/scim/v2/Users?filter=userName+eq+%22jane%40example.com%22`, adminToken, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		list := ListResponse{}
+		decode(response, &list)
+		assert.Equal(t, 1, list.TotalResults)
+
+		response = send(http.MethodGet, `/scim/v2/Users?filter=title+sw+%22x%22`, adminToken, nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	var groupID string
+
+	t.Run("group is provisioned with members", func(t *testing.T) {
+		response := send(http.MethodPost, "/scim/v2/Groups", adminToken, map[string]any{
+			"schemas":     []string{SchemaGroup},
+			"displayName": "Platform Engineering",
+			"members":     []map[string]string{{"value": userID}},
+		})
+
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		group := Group{}
+		decode(response, &group)
+		assert.Equal(t, "platform_engineering", group.ID)
+		assert.Equal(t, "Platform Engineering", group.DisplayName)
+		require.Len(t, group.Members, 1)
+		assert.Equal(t, userID, group.Members[0].Value)
+		groupID = group.ID
+
+		role, err := r.AuthService.GetGroupRole(r.Organization.ID.String(), models.DomainTypeOrganization, groupID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RoleOrgViewer, role)
+	})
+
+	t.Run("group members are patched", func(t *testing.T) {
+		response := send(http.MethodPatch, "/scim/v2/Groups/"+groupID, adminToken, map[string]any{
+			"schemas": []string{SchemaPatchOp},
+			"Operations": []map[string]any{
+				{"op": "remove", "path": `members[value eq "` + userID + `"]`},
+				{"op": "replace", "path": "displayName", "value": "Platform"},
+			},
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		group := Group{}
+		decode(response, &group)
+		assert.Equal(t, "Platform", group.DisplayName)
+		assert.Empty(t, group.Members)
+
+		response = send(http.MethodPatch, "/scim/v2/Groups/"+groupID, adminToken, map[string]any{
+			"Operations": []map[string]any{
+				{"op": "add", "path": "members", "value": []map[string]string{{"value": "not-a-user"}}},
+			},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("user is deactivated and reactivated", func(t *testing.T) {
+		response := send(http.MethodPatch, "/scim/v2/Users/"+userID, adminToken, map[string]any{
+			"schemas":    []string{SchemaPatchOp},
+			"Operations": []map[string]any{{"op": "Replace", "value": map[string]any{"active": "False"}}},
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+
+		user := User{}
+		decode(response, &user)
+		require.NotNil(t, user.Active)
+		assert.False(t, *user.Active)
+
+		_, err := models.FindActiveUserByID(r.Organization.ID.String(), userID)
+		require.Error(t, err)
+
+		response = send(http.MethodPut, "/scim/v2/Users/"+userID, adminToken, map[string]any{
+			"userName":    "jane@example.com",
+			"displayName": "Jane Smith",
+			"active":      true,
+		})
+
+		require.Equal(t, http.StatusOK, response.Code)
+		decode(response, &user)
+		assert.True(t, *user.Active)
+		assert.Equal(t, "Jane Smith", user.DisplayName)
+	})
+
+	t.Run("user and group are deleted", func(t *testing.T) {
+		assert.Equal(t, http.StatusNoContent, send(http.MethodDelete, "/scim/v2/Groups/"+groupID, adminToken, nil).Code)
+		assert.Equal(t, http.StatusNotFound, send(http.MethodGet, "/scim/v2/Groups/"+groupID, adminToken, nil).Code)
+
+		assert.Equal(t, http.StatusNoContent, send(http.MethodDelete, "/scim/v2/Users/"+userID, adminToken, nil).Code)
+		_, err := models.FindActiveUserByID(r.Organization.ID.String(), userID)
+		require.Error(t, err)
+	})
+}
+
+func createServiceAccount(t *testing.T, r *support.ResourceRegistry, role string) string {
+	token, err := crypto.Base64String(64)
+	require.NoError(t, err)
+
+	serviceAccount, err := models.CreateServiceAccount(database.Conn(), r.Organization.ID, support.RandomName("scim"), nil, r.User)
+	require.NoError(t, err)
+	require.NoError(t, serviceAccount.UpdateTokenHash(crypto.HashToken(token)))
+	require.NoError(t, r.AuthService.AssignRole(serviceAccount.ID.String(), role, r.Organization.ID.String(), models.DomainTypeOrganization))
+	return token
+}
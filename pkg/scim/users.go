@@ -0,0 +1,539 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/organizations"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/utils"
+	"gorm.io/gorm"
+)
+
+const ResourceMembers = "members"
+
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// PatchRequest is the body of the PATCH requests, for users and groups.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// email returns the address the user signs in with,
+// which is the userName, or the primary email when userName is not an address.
+func (u *User) email() string {
+	if strings.Contains(u.UserName, "@") {
+		return utils.NormalizeEmail(u.UserName)
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return utils.NormalizeEmail(email.Value)
+		}
+	}
+
+	if len(u.Emails) > 0 {
+		return utils.NormalizeEmail(u.Emails[0].Value)
+	}
+
+	return ""
+}
+
+func (u *User) fullName() string {
+	if u.DisplayName != "" {
+		return strings.TrimSpace(u.DisplayName)
+	}
+
+	if u.Name == nil {
+		return ""
+	}
+
+	if u.Name.Formatted != "" {
+		return strings.TrimSpace(u.Name.Formatted)
+	}
+
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+func serializeUser(user *models.User) *User {
+	active := !user.DeletedAt.Valid
+	email := user.GetEmail()
+
+	return &User{
+		Schemas:     []string{SchemaUser},
+		ID:          user.ID.String(),
+		UserName:    email,
+		Name:        &Name{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []Email{{Value: email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt.UTC().Format(time.RFC3339),
+			LastModified: user.UpdatedAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "read")
+	if !ok {
+		return
+	}
+
+	filter, err := ParseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+		return
+	}
+
+	if filter != nil && filter.Attribute != "username" && filter.Attribute != "emails.value" && filter.Attribute != "emails" {
+		writeError(w, http.StatusBadRequest, "invalidFilter", "users can only be filtered by userName or emails")
+		return
+	}
+
+	users, err := models.FindHumanUsersByOrganization(serviceAccount.OrganizationID.String())
+	if err != nil {
+		log.Errorf("Error listing users for organization %s: %v", serviceAccount.OrganizationID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error listing users")
+		return
+	}
+
+	resources := []any{}
+	for _, user := range users {
+		if filter != nil && user.GetEmail() != utils.NormalizeEmail(filter.Value) {
+			continue
+		}
+
+		resources = append(resources, serializeUser(&user))
+	}
+
+	writeJSON(w, http.StatusOK, page(r, resources))
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "read")
+	if !ok {
+		return
+	}
+
+	user, ok := h.findUser(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, serializeUser(user))
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "create")
+	if !ok {
+		return
+	}
+
+	var req User
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	email := req.email()
+	if email == "" {
+		writeError(w, http.StatusBadRequest, "invalidValue", "userName must be an email address")
+		return
+	}
+
+	name := req.fullName()
+	if name == "" {
+		name = email
+	}
+
+	orgID := serviceAccount.OrganizationID.String()
+	existing, err := models.FindMaybeDeletedUserByEmail(orgID, email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("Error finding user %s in organization %s: %v", email, orgID, err)
+		writeError(w, http.StatusInternalServerError, "", "Error creating user")
+		return
+	}
+
+	var user *models.User
+	switch {
+	case err == nil && !existing.DeletedAt.Valid:
+		writeError(w, http.StatusConflict, "uniqueness", "user already exists")
+		return
+
+	//
+	// People removed from the organization are restored,
+	// so they keep their identity when they are provisioned again.
+	//
+	case err == nil:
+		user = existing
+		if err := h.reactivateUser(user); err != nil {
+			log.Errorf("Error restoring user %s in organization %s: %v", user.ID, orgID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error creating user")
+			return
+		}
+
+	default:
+		user, err = h.provisionUser(serviceAccount, email, name)
+		if err != nil {
+			log.Errorf("Error creating user %s in organization %s: %v", email, orgID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error creating user")
+			return
+		}
+	}
+
+	if user.Name != name {
+		if err := user.UpdateName(name); err != nil {
+			log.Errorf("Error updating name of user %s: %v", user.ID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error creating user")
+			return
+		}
+	}
+
+	if req.Active != nil && !*req.Active {
+		if err := h.deactivateUser(r, serviceAccount, user); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+	}
+
+	response := serializeUser(user)
+	h.recordAudit(r, serviceAccount, ResourceMembers, user.ID.String(), "create", nil, response)
+	writeJSON(w, http.StatusCreated, response)
+}
+
+// provisionUser adds a person to the organization with the viewer role.
+//
+// It follows the invitation flow for people who already have an account:
+// they are added right away, without accepting anything, and an accepted
+// invitation from the service account records who added them.
+// The identity provider manages who belongs to the organization,
+// so people without an account get one, and sign in through the provider.
+// Pending invitations for the same email are accepted,
+// so they are not accepted again when the person signs in.
+func (h *Handler) provisionUser(serviceAccount *models.User, email, name string) (*models.User, error) {
+	var user *models.User
+	orgID := serviceAccount.OrganizationID
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		account, err := models.FindAccountByEmailInTransaction(tx, email)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			account, err = models.CreateAccountInTransaction(tx, name, email)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := acceptInvitationInTransaction(tx, serviceAccount, email); err != nil {
+			return err
+		}
+
+		user, err = models.CreateUserInTransaction(tx, orgID, account.ID, email, name)
+		if err != nil {
+			return err
+		}
+
+		return h.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, orgID.String(), models.DomainTypeOrganization)
+	})
+
+	return user, err
+}
+
+func acceptInvitationInTransaction(tx *gorm.DB, serviceAccount *models.User, email string) error {
+	orgID := serviceAccount.OrganizationID
+	invitation, err := models.FindPendingInvitationInTransaction(tx, email, orgID.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		_, err = models.CreateInvitationInTransaction(tx, orgID, serviceAccount.ID, email, models.InvitationStateAccepted)
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	invitation.State = models.InvitationStateAccepted
+	invitation.UpdatedAt = time.Now()
+	return tx.Save(invitation).Error
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "update")
+	if !ok {
+		return
+	}
+
+	user, ok := h.findUser(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	var req User
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if email := req.email(); email != "" && email != user.GetEmail() {
+		writeError(w, http.StatusBadRequest, "mutability", "userName cannot be changed")
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	h.updateUser(w, r, serviceAccount, user, req.fullName(), &active)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "update")
+	if !ok {
+		return
+	}
+
+	user, ok := h.findUser(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	var req PatchRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	patch, err := parseUserPatch(req.Operations)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	h.updateUser(w, r, serviceAccount, user, patch.fullName(), patch.Active)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	serviceAccount, ok := h.authorize(w, r, ResourceMembers, "delete")
+	if !ok {
+		return
+	}
+
+	user, ok := h.findUser(w, serviceAccount, mux.Vars(r)["id"])
+	if !ok {
+		return
+	}
+
+	before := serializeUser(user)
+	if !user.DeletedAt.Valid {
+		if err := h.deactivateUser(r, serviceAccount, user); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+	}
+
+	h.recordAudit(r, serviceAccount, ResourceMembers, user.ID.String(), "delete", before, nil)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// updateUser applies the changes of PUT and PATCH requests.
+// Deactivated users are removed from the organization,
+// and restored when they are activated again.
+func (h *Handler) updateUser(w http.ResponseWriter, r *http.Request, serviceAccount *models.User, user *models.User, name string, active *bool) {
+	before := serializeUser(user)
+
+	if active != nil && *active && user.DeletedAt.Valid {
+		if err := h.reactivateUser(user); err != nil {
+			log.Errorf("Error restoring user %s: %v", user.ID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error updating user")
+			return
+		}
+	}
+
+	if name != "" && name != user.Name && !user.DeletedAt.Valid {
+		if err := user.UpdateName(name); err != nil {
+			log.Errorf("Error updating name of user %s: %v", user.ID, err)
+			writeError(w, http.StatusInternalServerError, "", "Error updating user")
+			return
+		}
+	}
+
+	if active != nil && !*active && !user.DeletedAt.Valid {
+		if err := h.deactivateUser(r, serviceAccount, user); err != nil {
+			writeStatusError(w, err)
+			return
+		}
+	}
+
+	response := serializeUser(user)
+	h.recordAudit(r, serviceAccount, ResourceMembers, user.ID.String(), "update", before, response)
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *Handler) reactivateUser(user *models.User) error {
+	if err := user.Restore(); err != nil {
+		return err
+	}
+
+	user.DeletedAt = gorm.DeletedAt{}
+	return h.authService.AssignRole(user.ID.String(), models.RoleOrgViewer, user.OrganizationID.String(), models.DomainTypeOrganization)
+}
+
+// deactivateUser removes the user from the organization,
+// with the same checks as removing them from the members page.
+func (h *Handler) deactivateUser(r *http.Request, serviceAccount *models.User, user *models.User) error {
+	_, err := organizations.RemoveUser(r.Context(), h.authService, serviceAccount.OrganizationID.String(), user.ID.String())
+	if err != nil {
+		return err
+	}
+
+	deleted, err := models.FindMaybeDeletedUserByID(user.OrganizationID.String(), user.ID.String())
+	if err != nil {
+		return err
+	}
+
+	*user = *deleted
+	return nil
+}
+
+// findUser finds a person in the organization of the service account,
+// including the ones who were deactivated.
+func (h *Handler) findUser(w http.ResponseWriter, serviceAccount *models.User, id string) (*models.User, bool) {
+	user, err := models.FindMaybeDeletedUserByID(serviceAccount.OrganizationID.String(), id)
+	if err != nil || user.IsServiceAccount() {
+		writeError(w, http.StatusNotFound, "", "user not found")
+		return nil, false
+	}
+
+	return user, true
+}
+
+type userPatch struct {
+	Active      *bool
+	DisplayName string
+	GivenName   string
+	FamilyName  string
+}
+
+func (p *userPatch) fullName() string {
+	if p.DisplayName != "" {
+		return p.DisplayName
+	}
+
+	return strings.TrimSpace(p.GivenName + " " + p.FamilyName)
+}
+
+// parseUserPatch reads the changes to the active flag and to the name of a user.
+// Identity providers also send attributes which are not stored, which are ignored.
+func parseUserPatch(operations []PatchOperation) (*userPatch, error) {
+	patch := &userPatch{}
+
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" {
+			continue
+		}
+
+		values := map[string]any{}
+		if operation.Path == "" {
+			if err := json.Unmarshal(operation.Value, &values); err != nil {
+				return nil, errors.New("operations without a path must have an object value")
+			}
+		} else {
+			var value any
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return nil, errors.New("invalid operation value")
+			}
+
+			values[operation.Path] = value
+		}
+
+		for path, value := range values {
+			if err := patch.apply(strings.ToLower(path), value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return patch, nil
+}
+
+func (p *userPatch) apply(path string, value any) error {
+	switch path {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+
+		p.Active = &active
+
+	case "displayname", "name.formatted":
+		p.DisplayName, _ = value.(string)
+
+	case "name.givenname":
+		p.GivenName, _ = value.(string)
+
+	case "name.familyname":
+		p.FamilyName, _ = value.(string)
+
+	case "name":
+		name, _ := value.(map[string]any)
+		for attribute, v := range name {
+			if err := p.apply("name."+strings.ToLower(attribute), v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseBool accepts booleans, and the "True" and "False" strings some identity providers send.
+func parseBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(v) {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+
+	return false, errors.New("active must be a boolean")
+}
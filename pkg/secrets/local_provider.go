@@ -31,6 +31,10 @@ func (p *LocalProvider) Load(ctx context.Context) (map[string]string, error) {
 		return nil, fmt.Errorf("error decrypting secret %s: %v", name, err)
 	}
 
+	if len(decrypted) == 0 {
+		return map[string]string{}, nil
+	}
+
 	var values map[string]string
 	err = json.Unmarshal(decrypted, &values)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 
 const (
 	ProviderLocal = "local"
+	ProviderVault = "vault"
 )
 
 type Provider interface {
@@ -33,6 +34,8 @@ func NewProvider(tx *gorm.DB, encryptor crypto.Encryptor, name, domainType strin
 	switch secret.Provider {
 	case ProviderLocal:
 		return NewLocalProvider(tx, encryptor, secret), nil
+	case ProviderVault:
+		return NewVaultProvider(encryptor, secret), nil
 	default:
 		return nil, fmt.Errorf("provider not supported: %s", secret.Provider)
 	}
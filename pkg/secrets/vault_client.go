@@ -0,0 +1,358 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superplanehq/superplane/pkg/core"
+)
+
+const (
+	DefaultVaultCacheTTL   = 5 * time.Minute
+	DefaultVaultTimeout    = 10 * time.Second
+	vaultTokenRenewFactor  = 0.8
+	vaultMaxResponseLength = 1024 * 1024
+)
+
+// DefaultVaultClient is shared by all the Vault providers,
+// so the tokens and values cached are reused between executions.
+// Its requests go through the HTTP context given to UseHTTPContext,
+// which blocks requests to internal hosts, since Vault addresses come from users.
+var DefaultVaultClient = NewVaultClient(nil, DefaultVaultCacheTTL)
+
+// VaultClient reads KV v2 secrets from Vault.
+// Values are cached for the cache TTL, or for their lease duration if shorter.
+// AppRole tokens are cached until most of their lease is used,
+// and a new one is requested if Vault rejects the cached one.
+type VaultClient struct {
+	http     core.HTTPContext
+	cacheTTL time.Duration
+	nowFunc  func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]vaultCacheEntry[string]
+	values map[string]vaultCacheEntry[map[string]string]
+}
+
+type vaultCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+func (e vaultCacheEntry[T]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+type VaultError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *VaultError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("vault responded with status %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("vault responded with status %d: %s", e.StatusCode, strings.Join(e.Errors, ", "))
+}
+
+func NewVaultClient(httpClient core.HTTPContext, cacheTTL time.Duration) *VaultClient {
+	return &VaultClient{
+		http:     httpClient,
+		cacheTTL: cacheTTL,
+		nowFunc:  time.Now,
+		tokens:   map[string]vaultCacheEntry[string]{},
+		values:   map[string]vaultCacheEntry[map[string]string]{},
+	}
+}
+
+func (c *VaultClient) UseHTTPContext(httpCtx core.HTTPContext) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.http = httpCtx
+}
+
+func (c *VaultClient) Read(ctx context.Context, config *VaultConfig) (map[string]string, error) {
+	key := vaultValuesCacheKey(config)
+	if values, ok := c.cachedValues(key); ok {
+		return values, nil
+	}
+
+	values, lease, err := c.readWithToken(ctx, config)
+
+	//
+	// AppRole tokens can be revoked before their lease ends,
+	// so we log in again once before giving up.
+	//
+	if isVaultPermissionDenied(err) && config.Auth.Method == VaultAuthMethodAppRole {
+		c.forgetToken(vaultTokenCacheKey(config))
+		values, lease, err = c.readWithToken(ctx, config)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := c.cacheTTL
+	if lease > 0 && lease < ttl {
+		ttl = lease
+	}
+
+	c.mu.Lock()
+	c.values[key] = vaultCacheEntry[map[string]string]{value: values, expiresAt: c.nowFunc().Add(ttl)}
+	c.mu.Unlock()
+
+	return copyValues(values), nil
+}
+
+// Forget removes the cached values for a secret,
+// so the next read goes to Vault.
+func (c *VaultClient) Forget(config *VaultConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, vaultValuesCacheKey(config))
+}
+
+func (c *VaultClient) cachedValues(key string) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.values[key]
+	if !ok {
+		return nil, false
+	}
+
+	if entry.expired(c.nowFunc()) {
+		delete(c.values, key)
+		return nil, false
+	}
+
+	return copyValues(entry.value), true
+}
+
+func (c *VaultClient) readWithToken(ctx context.Context, config *VaultConfig) (map[string]string, time.Duration, error) {
+	token, err := c.token(ctx, config)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var response struct {
+		LeaseDuration int `json:"lease_duration"`
+		Data          struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/data/%s", config.Address, config.Mount, config.Path)
+	err = c.do(ctx, http.MethodGet, url, token, config.Namespace, nil, &response)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if response.Data.Data == nil {
+		return nil, 0, fmt.Errorf("no data found at %s/%s", config.Mount, config.Path)
+	}
+
+	values := make(map[string]string, len(response.Data.Data))
+	for k, v := range response.Data.Data {
+		if s, ok := v.(string); ok {
+			values[k] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error encoding key %s: %v", k, err)
+		}
+
+		values[k] = string(encoded)
+	}
+
+	return values, time.Duration(response.LeaseDuration) * time.Second, nil
+}
+
+func (c *VaultClient) token(ctx context.Context, config *VaultConfig) (string, error) {
+	if config.Auth.Method == VaultAuthMethodToken {
+		return config.Auth.Token, nil
+	}
+
+	if config.Auth.Method != VaultAuthMethodAppRole {
+		return "", fmt.Errorf("vault auth method not supported: %s", config.Auth.Method)
+	}
+
+	key := vaultTokenCacheKey(config)
+
+	c.mu.Lock()
+	entry, ok := c.tokens[key]
+	c.mu.Unlock()
+
+	if ok && !entry.expired(c.nowFunc()) {
+		return entry.value, nil
+	}
+
+	var response struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+
+	body := map[string]string{
+		"role_id":   config.Auth.RoleID,
+		"secret_id": config.Auth.SecretID,
+	}
+
+	url := fmt.Sprintf("%s/v1/auth/%s/login", config.Address, config.Auth.Mount)
+	err := c.do(ctx, http.MethodPost, url, "", config.Namespace, body, &response)
+	if err != nil {
+		return "", fmt.Errorf("error logging in with approle: %w", err)
+	}
+
+	if response.Auth.ClientToken == "" {
+		return "", fmt.Errorf("error logging in with approle: no token returned")
+	}
+
+	//
+	// Tokens without a lease do not expire.
+	// The others are replaced before their lease ends.
+	//
+	entry = vaultCacheEntry[string]{value: response.Auth.ClientToken}
+	if response.Auth.LeaseDuration > 0 {
+		lease := time.Duration(float64(response.Auth.LeaseDuration)*vaultTokenRenewFactor) * time.Second
+		entry.expiresAt = c.nowFunc().Add(lease)
+	}
+
+	c.mu.Lock()
+	c.tokens[key] = entry
+	c.mu.Unlock()
+
+	return entry.value, nil
+}
+
+func (c *VaultClient) forgetToken(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, key)
+}
+
+func (c *VaultClient) do(ctx context.Context, method, url, token, namespace string, body any, out any) error {
+	c.mu.Lock()
+	httpCtx := c.http
+	c.mu.Unlock()
+
+	if httpCtx == nil {
+		return fmt.Errorf("vault client has no HTTP context")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultVaultTimeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	if namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	res, err := httpCtx.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, vaultMaxResponseLength))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		vaultErr := &VaultError{StatusCode: res.StatusCode}
+		var errResponse struct {
+			Errors []string `json:"errors"`
+		}
+
+		if json.Unmarshal(data, &errResponse) == nil {
+			vaultErr.Errors = errResponse.Errors
+		}
+
+		return vaultErr
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+func isVaultPermissionDenied(err error) bool {
+	vaultErr, ok := err.(*VaultError)
+	return ok && vaultErr.StatusCode == http.StatusForbidden
+}
+
+// The credentials are part of the cache keys,
+// so secrets using different credentials never share cached values.
+func vaultValuesCacheKey(config *VaultConfig) string {
+	return hashVaultCacheKey(
+		config.Address,
+		config.Namespace,
+		config.Mount,
+		config.Path,
+		config.Auth.Method,
+		config.Auth.Token,
+		config.Auth.Mount,
+		config.Auth.RoleID,
+		config.Auth.SecretID,
+	)
+}
+
+func vaultTokenCacheKey(config *VaultConfig) string {
+	return hashVaultCacheKey(
+		config.Address,
+		config.Namespace,
+		config.Auth.Mount,
+		config.Auth.RoleID,
+		config.Auth.SecretID,
+	)
+}
+
+func hashVaultCacheKey(parts ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+func copyValues(values map[string]string) map[string]string {
+	c := make(map[string]string, len(values))
+	for k, v := range values {
+		c[k] = v
+	}
+
+	return c
+}
@@ -0,0 +1,140 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+const (
+	VaultAuthMethodToken   = "token"
+	VaultAuthMethodAppRole = "approle"
+
+	DefaultVaultKVMount      = "secret"
+	DefaultVaultAppRoleMount = "approle"
+)
+
+// VaultConfig is what is stored for secrets using the Vault provider.
+// Only the location of the secret and the credentials to read it are stored,
+// the values are always read from Vault.
+type VaultConfig struct {
+	Address   string    `json:"address"`
+	Namespace string    `json:"namespace,omitempty"`
+	Mount     string    `json:"mount"`
+	Path      string    `json:"path"`
+	Auth      VaultAuth `json:"auth"`
+}
+
+type VaultAuth struct {
+	Method string `json:"method"`
+
+	//
+	// Used by the token auth method.
+	//
+	Token string `json:"token,omitempty"`
+
+	//
+	// Used by the AppRole auth method.
+	//
+	Mount    string `json:"mount,omitempty"`
+	RoleID   string `json:"roleId,omitempty"`
+	SecretID string `json:"secretId,omitempty"`
+}
+
+func (c *VaultConfig) Validate() error {
+	if c.Address == "" {
+		return fmt.Errorf("vault address is required")
+	}
+
+	address, err := url.Parse(c.Address)
+	if err != nil || (address.Scheme != "http" && address.Scheme != "https") || address.Host == "" {
+		return fmt.Errorf("invalid vault address: %s", c.Address)
+	}
+
+	if strings.Trim(c.Path, "/") == "" {
+		return fmt.Errorf("vault path is required")
+	}
+
+	switch c.Auth.Method {
+	case VaultAuthMethodToken:
+		if c.Auth.Token == "" {
+			return fmt.Errorf("vault token is required")
+		}
+
+	case VaultAuthMethodAppRole:
+		if c.Auth.RoleID == "" || c.Auth.SecretID == "" {
+			return fmt.Errorf("vault role ID and secret ID are required")
+		}
+
+	default:
+		return fmt.Errorf("vault auth method not supported: %s", c.Auth.Method)
+	}
+
+	return nil
+}
+
+// WithDefaults fills in the mounts Vault uses by default.
+func (c VaultConfig) WithDefaults() VaultConfig {
+	c.Address = strings.TrimRight(c.Address, "/")
+	c.Path = strings.Trim(c.Path, "/")
+
+	if c.Mount == "" {
+		c.Mount = DefaultVaultKVMount
+	}
+
+	c.Mount = strings.Trim(c.Mount, "/")
+	if c.Auth.Method == VaultAuthMethodAppRole && c.Auth.Mount == "" {
+		c.Auth.Mount = DefaultVaultAppRoleMount
+	}
+
+	return c
+}
+
+func DecryptVaultConfig(ctx context.Context, encryptor crypto.Encryptor, record *models.Secret) (*VaultConfig, error) {
+	decrypted, err := encryptor.Decrypt(ctx, record.Data, []byte(record.Name))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting secret %s: %v", record.Name, err)
+	}
+
+	var config VaultConfig
+	err = json.Unmarshal(decrypted, &config)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling secret %s: %v", record.Name, err)
+	}
+
+	config = config.WithDefaults()
+	return &config, nil
+}
+
+type VaultProvider struct {
+	encryptor crypto.Encryptor
+	record    *models.Secret
+	client    *VaultClient
+}
+
+func NewVaultProvider(encryptor crypto.Encryptor, record *models.Secret) *VaultProvider {
+	return &VaultProvider{
+		encryptor: encryptor,
+		record:    record,
+		client:    DefaultVaultClient,
+	}
+}
+
+func (p *VaultProvider) Load(ctx context.Context) (map[string]string, error) {
+	config, err := DecryptVaultConfig(ctx, p.encryptor, p.record)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := p.client.Read(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("error reading secret %s from vault: %v", p.record.Name, err)
+	}
+
+	return values, nil
+}
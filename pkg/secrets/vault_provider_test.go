@@ -0,0 +1,251 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+)
+
+// fakeVault mimics the parts of the Vault API used by the provider:
+// AppRole logins and KV v2 reads.
+type fakeVault struct {
+	server    *httptest.Server
+	logins    atomic.Int32
+	reads     atomic.Int32
+	tokens    map[string]bool
+	tokenTTL  int
+	namespace string
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	v := &fakeVault{tokens: map[string]bool{"root": true}, tokenTTL: 3600}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/approle/login", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != "role" || body["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid role or secret ID"]}`))
+			return
+		}
+
+		v.logins.Add(1)
+		token := "approle-token"
+		v.tokens[token] = true
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"auth": map[string]any{"client_token": token, "lease_duration": v.tokenTTL},
+		})
+	})
+
+	mux.HandleFunc("/v1/secret/data/apps/api", func(w http.ResponseWriter, r *http.Request) {
+		if !v.tokens[r.Header.Get("X-Vault-Token")] || r.Header.Get("X-Vault-Namespace") != v.namespace {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		v.reads.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"lease_duration": 0,
+			"data": map[string]any{
+				"data":     map[string]any{"password": "hunter2", "port": 5432},
+				"metadata": map[string]any{"version": 1},
+			},
+		})
+	})
+
+	v.server = httptest.NewServer(mux)
+	t.Cleanup(v.server.Close)
+	return v
+}
+
+func newVaultSecret(t *testing.T, config VaultConfig) *models.Secret {
+	data, err := json.Marshal(config)
+	require.NoError(t, err)
+	return &models.Secret{Name: "vault-secret", Provider: ProviderVault, Data: data}
+}
+
+func Test__VaultProvider(t *testing.T) {
+	encryptor := &crypto.NoOpEncryptor{}
+
+	t.Run("reads values with a token", func(t *testing.T) {
+		vault := newFakeVault(t)
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodToken, Token: "root"},
+		}))
+
+		provider.client = NewVaultClient(vault.server.Client(), time.Minute)
+		values, err := provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"password": "hunter2", "port": "5432"}, values)
+	})
+
+	t.Run("reads values with approle and caches them", func(t *testing.T) {
+		vault := newFakeVault(t)
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "/apps/api/",
+			Auth:    VaultAuth{Method: VaultAuthMethodAppRole, RoleID: "role", SecretID: "secret"},
+		}))
+
+		provider.client = NewVaultClient(vault.server.Client(), time.Minute)
+		for i := 0; i < 3; i++ {
+			values, err := provider.Load(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "hunter2", values["password"])
+		}
+
+		assert.Equal(t, int32(1), vault.logins.Load())
+		assert.Equal(t, int32(1), vault.reads.Load())
+	})
+
+	t.Run("expired values and tokens are requested again", func(t *testing.T) {
+		vault := newFakeVault(t)
+		vault.tokenTTL = 60
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodAppRole, RoleID: "role", SecretID: "secret"},
+		}))
+
+		now := time.Now()
+		provider.client = NewVaultClient(vault.server.Client(), 30*time.Second)
+		provider.client.nowFunc = func() time.Time { return now }
+
+		_, err := provider.Load(context.Background())
+		require.NoError(t, err)
+
+		now = now.Add(40 * time.Second)
+		_, err = provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), vault.logins.Load())
+		assert.Equal(t, int32(2), vault.reads.Load())
+
+		now = now.Add(40 * time.Second)
+		_, err = provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), vault.logins.Load())
+		assert.Equal(t, int32(3), vault.reads.Load())
+	})
+
+	t.Run("revoked approle token is replaced", func(t *testing.T) {
+		vault := newFakeVault(t)
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodAppRole, RoleID: "role", SecretID: "secret"},
+		}))
+
+		provider.client = NewVaultClient(vault.server.Client(), 0)
+		_, err := provider.Load(context.Background())
+		require.NoError(t, err)
+
+		delete(vault.tokens, "approle-token")
+		_, err = provider.Load(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), vault.logins.Load())
+	})
+
+	t.Run("namespace is sent to vault", func(t *testing.T) {
+		vault := newFakeVault(t)
+		vault.namespace = "team-a"
+		config := VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodToken, Token: "root"},
+		}
+
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, config))
+		provider.client = NewVaultClient(vault.server.Client(), time.Minute)
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "permission denied")
+
+		config.Namespace = "team-a"
+		provider = NewVaultProvider(encryptor, newVaultSecret(t, config))
+		provider.client = NewVaultClient(vault.server.Client(), time.Minute)
+		_, err = provider.Load(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		vault := newFakeVault(t)
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodAppRole, RoleID: "role", SecretID: "wrong"},
+		}))
+
+		provider.client = NewVaultClient(vault.server.Client(), time.Minute)
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "invalid role or secret ID")
+	})
+
+	t.Run("requests to blocked addresses are rejected", func(t *testing.T) {
+		vault := newFakeVault(t)
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: vault.server.URL,
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodToken, Token: "root"},
+		}))
+
+		httpCtx, err := registry.NewHTTPContext(registry.HTTPOptions{PrivateIPRanges: []string{"127.0.0.0/8"}})
+		require.NoError(t, err)
+
+		provider.client = NewVaultClient(httpCtx, time.Minute)
+		_, err = provider.Load(context.Background())
+		require.ErrorContains(t, err, "access to private IP address 127.0.0.1 is not allowed")
+		assert.Equal(t, int32(0), vault.reads.Load())
+	})
+
+	t.Run("client without HTTP context -> error", func(t *testing.T) {
+		provider := NewVaultProvider(encryptor, newVaultSecret(t, VaultConfig{
+			Address: "https://vault.example.com",
+			Path:    "apps/api",
+			Auth:    VaultAuth{Method: VaultAuthMethodToken, Token: "root"},
+		}))
+
+		provider.client = NewVaultClient(nil, time.Minute)
+		_, err := provider.Load(context.Background())
+		require.ErrorContains(t, err, "vault client has no HTTP context")
+	})
+}
+
+func Test__VaultConfig_Validate(t *testing.T) {
+	config := VaultConfig{
+		Address: "https://vault.example.com",
+		Path:    "apps/api",
+		Auth:    VaultAuth{Method: VaultAuthMethodToken, Token: "root"},
+	}
+
+	require.NoError(t, config.Validate())
+	assert.Equal(t, DefaultVaultKVMount, config.WithDefaults().Mount)
+
+	invalid := config
+	invalid.Address = "vault.example.com"
+	require.ErrorContains(t, invalid.Validate(), "invalid vault address")
+
+	invalid = config
+	invalid.Path = "/"
+	require.ErrorContains(t, invalid.Validate(), "vault path is required")
+
+	invalid = config
+	invalid.Auth = VaultAuth{Method: VaultAuthMethodAppRole, RoleID: "role"}
+	require.ErrorContains(t, invalid.Validate(), "role ID and secret ID are required")
+
+	invalid = config
+	invalid.Auth = VaultAuth{Method: "kubernetes"}
+	require.ErrorContains(t, invalid.Validate(), "auth method not supported")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/superplanehq/superplane/pkg/oidc"
 	"github.com/superplanehq/superplane/pkg/public"
 	registry "github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"github.com/superplanehq/superplane/pkg/services"
 	"github.com/superplanehq/superplane/pkg/telemetry"
 	"github.com/superplanehq/superplane/pkg/templates"
@@ -25,14 +26,17 @@ import (
 	// Import integrations, components and triggers to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/addmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
+	_ "github.com/superplanehq/superplane/pkg/components/canvasrun"
 	_ "github.com/superplanehq/superplane/pkg/components/deletememory"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/foreach"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
 	_ "github.com/superplanehq/superplane/pkg/components/readmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/switch"
 	_ "github.com/superplanehq/superplane/pkg/components/timegate"
 	_ "github.com/superplanehq/superplane/pkg/components/updatememory"
 	_ "github.com/superplanehq/superplane/pkg/components/upsertmemory"
@@ -163,6 +167,83 @@ func startWorkers(encryptor crypto.Encryptor, registry *registry.Registry, oidcP
 		w := workers.NewCanvasCleanupWorker()
 		go w.Start(context.Background())
 	}
+
+	if os.Getenv("START_RETENTION_WORKER") == "yes" {
+		log.Println("Starting Retention Worker")
+
+		w := workers.NewRetentionWorker()
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_CANVAS_MEMORY_CLEANUP_WORKER") == "yes" {
+		log.Println("Starting Canvas Memory Cleanup Worker")
+
+		w := workers.NewCanvasMemoryCleanupWorker()
+		go w.Start(context.Background())
+	}
+
+	if os.Getenv("START_ENCRYPTION_KEY_ROTATION_WORKER") == "yes" {
+		rotatable, ok := encryptor.(crypto.RotatableEncryptor)
+		if ok {
+			log.Println("Starting Encryption Key Rotation Worker")
+
+			w := workers.NewEncryptionKeyRotationWorker(rotatable)
+			go w.Start(context.Background())
+		} else {
+			log.Warn("Encryption Key Rotation Worker not started - envelope encryption is not enabled (ENCRYPTION_MASTER_KEYS)")
+		}
+	}
+
+	if os.Getenv("START_EVENT_SINK_WORKER") == "yes" {
+		log.Println("Starting Event Sink Worker")
+
+		consumer := workers.NewEventSinkConsumer(rabbitMQURL)
+		consumer.Start()
+
+		w := workers.NewEventSinkWorker(encryptor, registry.HTTPContext())
+		go w.Start(context.Background())
+	}
+}
+
+// newEncryptor uses envelope encryption when master keys are configured.
+// ENCRYPTION_KEY is still used to decrypt the values
+// encrypted before envelope encryption was enabled.
+func newEncryptor(encryptionKey string) crypto.Encryptor {
+	legacy := crypto.NewAESGCMEncryptor([]byte(encryptionKey))
+	masterKeys := os.Getenv("ENCRYPTION_MASTER_KEYS")
+	if masterKeys == "" {
+		return legacy
+	}
+
+	kms := os.Getenv("ENCRYPTION_KMS")
+	if kms == "" {
+		kms = crypto.KeyManagerLocal
+	}
+
+	if kms != crypto.KeyManagerLocal {
+		panic(fmt.Sprintf("ENCRYPTION_KMS %s is not supported", kms))
+	}
+
+	keys, err := crypto.ParseMasterKeys(masterKeys)
+	if err != nil {
+		panic(fmt.Sprintf("invalid ENCRYPTION_MASTER_KEYS: %v", err))
+	}
+
+	keyManager, err := crypto.NewLocalKeyManager(os.Getenv("ENCRYPTION_MASTER_KEY_ID"), keys)
+	if err != nil {
+		panic(fmt.Sprintf("invalid ENCRYPTION_MASTER_KEY_ID: %v", err))
+	}
+
+	//
+	// Removing a master key which is still used would make
+	// the values encrypted with it impossible to decrypt.
+	//
+	if err := workers.CheckMasterKeysInUse(keyManager.KeyIDs()); err != nil {
+		panic(fmt.Sprintf("invalid ENCRYPTION_MASTER_KEYS: %v", err))
+	}
+
+	log.Infof("Using envelope encryption with master key %s", keyManager.CurrentKeyID())
+	return crypto.NewEnvelopeEncryptor(keyManager, legacy)
 }
 
 func startEmailConsumers(rabbitMQURL string, encryptor crypto.Encryptor, baseURL string, authService authorization.Authorization) {
@@ -338,7 +419,7 @@ func Start() {
 		log.Warn("NO_ENCRYPTION is set to yes, using NoOpEncryptor")
 		encryptorInstance = crypto.NewNoOpEncryptor()
 	} else {
-		encryptorInstance = crypto.NewAESGCMEncryptor([]byte(encryptionKey))
+		encryptorInstance = newEncryptor(encryptionKey)
 	}
 
 	authService, err := authorization.NewAuthService()
@@ -384,6 +465,7 @@ func Start() {
 	}
 
 	templates.Setup(registry)
+	secrets.DefaultVaultClient.UseHTTPContext(registry.HTTPContext())
 
 	if os.Getenv("START_PUBLIC_API") == "yes" {
 		go startPublicAPI(baseURL, basePath, encryptorInstance, registry, jwtSigner, oidcProvider, authService)
@@ -32,6 +32,15 @@ var (
 	workflowCleanupWorkerTickHistogram          metric.Float64Histogram
 	workflowCleanupWorkerCanvasesCountHistogram metric.Int64Histogram
 
+	retentionWorkerTickHistogram              metric.Float64Histogram
+	retentionWorkerDeletedExecutionsHistogram metric.Int64Histogram
+	retentionWorkerDeletedEventsHistogram     metric.Int64Histogram
+	retentionWorkerDeletedQueueItemsHistogram metric.Int64Histogram
+
+	eventSinkWorkerTickHistogram            metric.Float64Histogram
+	eventSinkWorkerDeliveriesCountHistogram metric.Int64Histogram
+	eventSinkWorkerFailedCountHistogram     metric.Int64Histogram
+
 	dbLocksCountHistogram       metric.Int64Histogram
 	dbLongQueriesCountHistogram metric.Int64Histogram
 )
@@ -141,6 +150,69 @@ func InitMetrics(ctx context.Context) error {
 		return err
 	}
 
+	retentionWorkerTickHistogram, err = meter.Float64Histogram(
+		"retention_worker.tick.duration.seconds",
+		metric.WithDescription("Duration of each RetentionWorker tick"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	retentionWorkerDeletedExecutionsHistogram, err = meter.Int64Histogram(
+		"retention_worker.tick.executions.deleted",
+		metric.WithDescription("Number of node executions deleted by the retention policies each tick"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	retentionWorkerDeletedEventsHistogram, err = meter.Int64Histogram(
+		"retention_worker.tick.events.deleted",
+		metric.WithDescription("Number of events deleted by the retention policies each tick"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	retentionWorkerDeletedQueueItemsHistogram, err = meter.Int64Histogram(
+		"retention_worker.tick.queue_items.deleted",
+		metric.WithDescription("Number of queue items deleted by the retention policies each tick"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	eventSinkWorkerTickHistogram, err = meter.Float64Histogram(
+		"event_sink_worker.tick.duration.seconds",
+		metric.WithDescription("Duration of each EventSinkWorker tick"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+
+	eventSinkWorkerDeliveriesCountHistogram, err = meter.Int64Histogram(
+		"event_sink_worker.tick.deliveries.count",
+		metric.WithDescription("Number of event sink deliveries due for an attempt each tick"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
+	eventSinkWorkerFailedCountHistogram, err = meter.Int64Histogram(
+		"event_sink_worker.tick.deliveries.failed",
+		metric.WithDescription("Number of event sink delivery attempts which failed each tick"),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return err
+	}
+
 	dbLocksCountHistogram, err = meter.Int64Histogram(
 		"db.locks.count",
 		metric.WithDescription("Number of database locks"),
@@ -260,6 +332,62 @@ func RecordWorkflowCleanupWorkerCanvasesCount(ctx context.Context, count int) {
 	workflowCleanupWorkerCanvasesCountHistogram.Record(ctx, int64(count))
 }
 
+func RecordRetentionWorkerTickDuration(ctx context.Context, d time.Duration) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	retentionWorkerTickHistogram.Record(ctx, d.Seconds())
+}
+
+func RecordRetentionWorkerDeletedExecutions(ctx context.Context, count int) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	retentionWorkerDeletedExecutionsHistogram.Record(ctx, int64(count))
+}
+
+func RecordRetentionWorkerDeletedEvents(ctx context.Context, count int) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	retentionWorkerDeletedEventsHistogram.Record(ctx, int64(count))
+}
+
+func RecordRetentionWorkerDeletedQueueItems(ctx context.Context, count int) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	retentionWorkerDeletedQueueItemsHistogram.Record(ctx, int64(count))
+}
+
+func RecordEventSinkWorkerTickDuration(ctx context.Context, d time.Duration) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	eventSinkWorkerTickHistogram.Record(ctx, d.Seconds())
+}
+
+func RecordEventSinkWorkerDeliveriesCount(ctx context.Context, count int) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	eventSinkWorkerDeliveriesCountHistogram.Record(ctx, int64(count))
+}
+
+func RecordEventSinkWorkerFailedCount(ctx context.Context, count int) {
+	if !metricsReady.Load() {
+		return
+	}
+
+	eventSinkWorkerFailedCountHistogram.Record(ctx, int64(count))
+}
+
 func RecordDBLocksCount(ctx context.Context, count int64) {
 	if !metricsReady.Load() {
 		return
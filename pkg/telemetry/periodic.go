@@ -89,6 +89,7 @@ func countStuckQueueNodes() (int64, error) {
 				FROM workflow_node_queue_items q
 				WHERE q.workflow_id = n.workflow_id
 				  AND q.node_id = n.node_id
+				  AND q.state = 'pending'
 			)
 			AND NOT EXISTS (
 				SELECT 1
@@ -149,6 +149,11 @@ func createTemplateCanvas(tx *gorm.DB, registry *registry.Registry, template *pb
 		return err
 	}
 
+	variables, err := canvases.ParseCanvasVariables(template.Spec)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now()
 	liveVersionID := uuid.New()
 	canvas := models.Canvas{
@@ -202,6 +207,7 @@ func createTemplateCanvas(tx *gorm.DB, registry *registry.Registry, template *pb
 		CreatedAt:   &now,
 		UpdatedAt:   &now,
 	}
+	version.SetVariableSet(variables)
 
 	return tx.Create(&version).Error
 }
@@ -138,6 +138,10 @@ func (w *CanvasCleanupWorker) processCanvas(tx *gorm.DB, canvas models.Canvas) e
 	}
 
 	w.logger.Infof("Processed %d nodes from canvas %s (deleted %d resources, %d nodes remaining)", nodesProcessed, canvas.ID, totalResourcesDeleted, remainingNodesCount)
+	if err := models.DropCanvasMemoryLookupIndexesInTransaction(tx, canvas.ID); err != nil {
+		return fmt.Errorf("failed to drop canvas memory lookup indexes: %w", err)
+	}
+
 	if err := tx.Unscoped().Delete(&canvas).Error; err != nil {
 		return fmt.Errorf("failed to delete canvas: %w", err)
 	}
@@ -153,6 +157,7 @@ func (w *CanvasCleanupWorker) deleteNodeResourcesBatched(tx *gorm.DB, workflowID
 	}{
 		{&models.CanvasNodeRequest{}, "canvas_node_requests"},
 		{&models.CanvasNodeExecutionKV{}, "canvas_node_execution_kvs"},
+		{&models.CanvasNodeExecutionLog{}, "canvas_node_execution_logs"},
 		{&models.CanvasNodeExecution{}, "canvas_node_executions"},
 		{&models.CanvasNodeQueueItem{}, "canvas_node_queue_items"},
 		{&models.CanvasEvent{}, "canvas_events"},
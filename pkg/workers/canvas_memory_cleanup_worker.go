@@ -0,0 +1,55 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// CanvasMemoryCleanupWorker deletes canvas memory records which expired.
+// Expired records are already ignored by every read,
+// so this only keeps the table from growing forever.
+type CanvasMemoryCleanupWorker struct {
+	logger            *log.Entry
+	maxRecordsPerTick int
+	nowFunc           func() time.Time
+}
+
+func NewCanvasMemoryCleanupWorker() *CanvasMemoryCleanupWorker {
+	return &CanvasMemoryCleanupWorker{
+		logger:            log.WithFields(log.Fields{"worker": "CanvasMemoryCleanupWorker"}),
+		maxRecordsPerTick: 1000,
+		nowFunc:           time.Now,
+	}
+}
+
+func (w *CanvasMemoryCleanupWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.Tick(); err != nil {
+				w.logger.Errorf("Error deleting expired canvas memories: %v", err)
+			}
+		}
+	}
+}
+
+func (w *CanvasMemoryCleanupWorker) Tick() (int, error) {
+	deleted, err := models.DeleteExpiredCanvasMemories(w.nowFunc(), w.maxRecordsPerTick)
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		w.logger.Infof("Deleted %d expired canvas memories", deleted)
+	}
+
+	return deleted, nil
+}
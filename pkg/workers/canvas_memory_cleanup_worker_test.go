@@ -0,0 +1,77 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/workers/contexts"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__CanvasMemoryCleanupWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	memory := contexts.NewCanvasMemoryContext(database.Conn(), canvas.ID)
+
+	findRecord := func(t *testing.T, namespace string) models.CanvasMemory {
+		var record models.CanvasMemory
+		require.NoError(t, database.Conn().Where("canvas_id = ? AND namespace = ?", canvas.ID, namespace).First(&record).Error)
+		return record
+	}
+
+	t.Run("records without TTL do not expire", func(t *testing.T) {
+		require.NoError(t, memory.Add("permanent", map[string]any{"id": "1"}))
+		assert.Nil(t, findRecord(t, "permanent").ExpiresAt)
+	})
+
+	t.Run("namespace default TTL is used when no TTL is given", func(t *testing.T) {
+		require.NoError(t, models.UpsertCanvasMemoryNamespaceInTransaction(database.Conn(), &models.CanvasMemoryNamespace{
+			CanvasID:          canvas.ID,
+			Namespace:         "deploys",
+			DefaultTTLSeconds: 3600,
+		}))
+
+		require.NoError(t, memory.Add("deploys", map[string]any{"id": "1"}))
+		record := findRecord(t, "deploys")
+		require.NotNil(t, record.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *record.ExpiresAt, time.Minute)
+	})
+
+	t.Run("TTL given on write overrides the namespace default", func(t *testing.T) {
+		require.NoError(t, memory.AddWithTTL("incidents", map[string]any{"id": "1"}, 10*time.Minute))
+		record := findRecord(t, "incidents")
+		require.NotNil(t, record.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), *record.ExpiresAt, time.Minute)
+
+		_, err := memory.UpdateWithTTL("incidents", map[string]any{"id": "1"}, map[string]any{"state": "open"}, 2*time.Hour)
+		require.NoError(t, err)
+		record = findRecord(t, "incidents")
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), *record.ExpiresAt, time.Minute)
+	})
+
+	t.Run("expired records are deleted", func(t *testing.T) {
+		require.NoError(t, memory.AddWithTTL("expiring", map[string]any{"id": "1"}, time.Minute))
+		require.NoError(t, memory.Add("expiring", map[string]any{"id": "2"}))
+
+		worker := NewCanvasMemoryCleanupWorker()
+		deleted, err := worker.Tick()
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		worker.nowFunc = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		deleted, err = worker.Tick()
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		records, err := models.ListCanvasMemoriesByNamespace(canvas.ID, "expiring")
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, map[string]any{"id": "2"}, records[0].Values.Data())
+	})
+}
@@ -1,8 +1,10 @@
 package contexts
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/models"
@@ -18,13 +20,80 @@ func NewCanvasMemoryContext(tx *gorm.DB, canvasID uuid.UUID) *CanvasMemoryContex
 	return &CanvasMemoryContext{tx: tx, canvasID: canvasID}
 }
 
+// Add adds a record which expires after
+// the default TTL of its namespace, if there is one.
 func (c *CanvasMemoryContext) Add(namespace string, values any) error {
+	return c.AddWithTTL(namespace, values, 0)
+}
+
+// AddWithTTL adds a record which expires after ttl.
+// A zero ttl uses the default TTL of the namespace.
+// If the namespace declares a schema, the record is validated against it,
+// and if the schema has a unique key, the record with the same key is replaced.
+func (c *CanvasMemoryContext) AddWithTTL(namespace string, values any, ttl time.Duration) error {
 	namespace = strings.TrimSpace(namespace)
 	if namespace == "" {
 		return fmt.Errorf("namespace is required")
 	}
 
-	return models.AddCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, values)
+	settings, err := c.findNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	ttl, err = resolveTTL(settings, ttl)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt *time.Time
+	if ttl > 0 {
+		t := time.Now().Add(ttl)
+		expiresAt = &t
+	}
+
+	if schema := settings.MemorySchema(); schema != nil {
+		if err := schema.ValidateRecord(values); err != nil {
+			return fmt.Errorf("invalid record for namespace %s: %w", namespace, err)
+		}
+
+		if uniqueKey := schema.UniqueKeyValues(values); uniqueKey != nil {
+			return models.UpsertCanvasMemoryByUniqueKeyInTransaction(c.tx, c.canvasID, namespace, values, uniqueKey, expiresAt)
+		}
+	}
+
+	if expiresAt == nil {
+		return models.AddCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, values)
+	}
+
+	return models.AddExpiringCanvasMemoryInTransaction(c.tx, c.canvasID, namespace, values, *expiresAt)
+}
+
+// findNamespace returns the settings of the namespace,
+// or empty settings if the namespace has none.
+func (c *CanvasMemoryContext) findNamespace(namespace string) (*models.CanvasMemoryNamespace, error) {
+	settings, err := models.FindCanvasMemoryNamespaceInTransaction(c.tx, c.canvasID, namespace)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.CanvasMemoryNamespace{CanvasID: c.canvasID, Namespace: namespace}, nil
+		}
+
+		return nil, fmt.Errorf("failed to find namespace settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func resolveTTL(settings *models.CanvasMemoryNamespace, ttl time.Duration) (time.Duration, error) {
+	if err := models.ValidateCanvasMemoryTTL(ttl); err != nil {
+		return 0, err
+	}
+
+	if ttl > 0 {
+		return ttl, nil
+	}
+
+	return settings.DefaultTTL(), nil
 }
 
 func (c *CanvasMemoryContext) Find(namespace string, matches map[string]any) ([]any, error) {
@@ -83,20 +152,66 @@ func (c *CanvasMemoryContext) Delete(namespace string, matches map[string]any) (
 }
 
 func (c *CanvasMemoryContext) Update(namespace string, matches map[string]any, values map[string]any) ([]any, error) {
+	return c.update(namespace, matches, values, 0, false)
+}
+
+// UpdateWithTTL updates the matching records, and renews their expiry.
+// A zero ttl uses the default TTL of the namespace, and if there
+// is none, the expiry of the records is left unchanged.
+func (c *CanvasMemoryContext) UpdateWithTTL(namespace string, matches map[string]any, values map[string]any, ttl time.Duration) ([]any, error) {
+	return c.update(namespace, matches, values, ttl, true)
+}
+
+func (c *CanvasMemoryContext) update(namespace string, matches map[string]any, values map[string]any, ttl time.Duration, renew bool) ([]any, error) {
 	namespace = strings.TrimSpace(namespace)
 	if namespace == "" {
 		return nil, fmt.Errorf("namespace is required")
 	}
 
-	records, err := models.UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches, values)
+	settings, err := c.findNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if schema := settings.MemorySchema(); schema != nil {
+		if err := schema.ValidateUpdate(values); err != nil {
+			return nil, fmt.Errorf("invalid update for namespace %s: %w", namespace, err)
+		}
+	}
+
+	if renew {
+		ttl, err = resolveTTL(settings, ttl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var records []models.CanvasMemory
+	if ttl > 0 {
+		records, err = models.UpdateExpiringCanvasMemoriesByNamespaceAndMatchesInTransaction(
+			c.tx,
+			c.canvasID,
+			namespace,
+			matches,
+			values,
+			time.Now().Add(ttl),
+		)
+	} else {
+		records, err = models.UpdateCanvasMemoriesByNamespaceAndMatchesInTransaction(c.tx, c.canvasID, namespace, matches, values)
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
-	updatedValues := make([]any, 0, len(records))
+	return recordValues(records), nil
+}
+
+func recordValues(records []models.CanvasMemory) []any {
+	values := make([]any, 0, len(records))
 	for _, record := range records {
-		updatedValues = append(updatedValues, record.Values.Data())
+		values = append(values, record.Values.Data())
 	}
 
-	return updatedValues, nil
+	return values
 }
@@ -0,0 +1,110 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasMemoryContext_Schema(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+	memory := NewCanvasMemoryContext(database.Conn(), canvas.ID)
+
+	schema := models.CanvasMemorySchema{
+		Fields: []models.CanvasMemoryField{
+			{Name: "environment", Type: models.CanvasMemoryFieldTypeString, Required: true},
+			{Name: "version", Type: models.CanvasMemoryFieldTypeString},
+		},
+		UniqueKey:  []string{"environment"},
+		LookupKeys: [][]string{{"version"}},
+	}
+
+	setSchema := func(t *testing.T, schema *models.CanvasMemorySchema) {
+		tx := database.Conn()
+		var previous *models.CanvasMemorySchema
+		existing, err := models.FindCanvasMemoryNamespaceInTransaction(tx, canvas.ID, "deploys")
+		if err == nil {
+			previous = existing.MemorySchema()
+		}
+
+		record := &models.CanvasMemoryNamespace{CanvasID: canvas.ID, Namespace: "deploys"}
+		if schema != nil {
+			s := datatypes.NewJSONType(*schema)
+			record.Schema = &s
+		}
+
+		require.NoError(t, models.UpsertCanvasMemoryNamespaceInTransaction(tx, record))
+		require.NoError(t, models.UpdateCanvasMemorySchemaInTransaction(tx, canvas.ID, "deploys", previous, schema))
+	}
+
+	lookupIndexes := func(t *testing.T) int64 {
+		var count int64
+		require.NoError(t, database.Conn().
+			Table("pg_indexes").
+			Where("tablename = ? AND indexname LIKE ?", "canvas_memories", "idx_canvas_memories_lookup_%").
+			Count(&count).Error)
+		return count
+	}
+
+	t.Run("records written before the schema get their unique key", func(t *testing.T) {
+		require.NoError(t, memory.Add("deploys", map[string]any{"environment": "staging", "version": "v1"}))
+		setSchema(t, &schema)
+
+		assert.Equal(t, int64(1), lookupIndexes(t))
+
+		require.NoError(t, memory.Add("deploys", map[string]any{"environment": "staging", "version": "v2"}))
+		records, err := models.ListCanvasMemoriesByNamespace(canvas.ID, "deploys")
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, map[string]any{"environment": "staging", "version": "v2"}, records[0].Values.Data())
+	})
+
+	t.Run("records with the same unique key replace each other", func(t *testing.T) {
+		require.NoError(t, memory.Add("deploys", map[string]any{"environment": "production", "version": "v1"}))
+		require.NoError(t, memory.Add("deploys", map[string]any{"environment": "production", "version": "v2"}))
+
+		values, err := memory.Find("deploys", map[string]any{"environment": "production"})
+		require.NoError(t, err)
+		assert.Equal(t, []any{map[string]any{"environment": "production", "version": "v2"}}, values)
+
+		value, err := memory.FindFirst("deploys", map[string]any{"version": "v2"})
+		require.NoError(t, err)
+		assert.NotNil(t, value)
+	})
+
+	t.Run("records which do not match the schema are rejected", func(t *testing.T) {
+		require.ErrorContains(t, memory.Add("deploys", map[string]any{"version": "v1"}), "field environment is required")
+		require.ErrorContains(t, memory.Add("deploys", map[string]any{"environment": "qa", "owner": "alex"}), "field owner is not declared")
+		require.ErrorContains(t, memory.Add("deploys", map[string]any{"environment": "qa", "version": 1}), "field version must be a string")
+
+		_, err := memory.Update("deploys", map[string]any{"environment": "production"}, map[string]any{"environment": "qa"})
+		require.ErrorContains(t, err, "part of the unique key")
+
+		values, err := memory.Update("deploys", map[string]any{"environment": "production"}, map[string]any{"version": "v3"})
+		require.NoError(t, err)
+		assert.Len(t, values, 1)
+	})
+
+	t.Run("removing the schema drops the lookup indexes", func(t *testing.T) {
+		setSchema(t, nil)
+		assert.Zero(t, lookupIndexes(t))
+
+		require.NoError(t, memory.Add("deploys", map[string]any{"environment": "production", "owner": "alex"}))
+		values, err := memory.Find("deploys", map[string]any{"environment": "production"})
+		require.NoError(t, err)
+		assert.Len(t, values, 2)
+	})
+
+	t.Run("duplicate records prevent adding a unique key", func(t *testing.T) {
+		err := models.UpdateCanvasMemorySchemaInTransaction(database.Conn(), canvas.ID, "deploys", nil, &schema)
+		require.ErrorIs(t, err, models.ErrCanvasMemoryDuplicateUniqueKey)
+	})
+}
@@ -0,0 +1,258 @@
+package contexts
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const startTriggerName = "start"
+
+type CanvasRunContext struct {
+	tx             *gorm.DB
+	organizationID uuid.UUID
+	canvasID       uuid.UUID
+	onNewEvents    func([]models.CanvasEvent)
+}
+
+func NewCanvasRunContext(tx *gorm.DB, organizationID, canvasID uuid.UUID, onNewEvents func([]models.CanvasEvent)) *CanvasRunContext {
+	return &CanvasRunContext{
+		tx:             tx,
+		organizationID: organizationID,
+		canvasID:       canvasID,
+		onNewEvents:    onNewEvents,
+	}
+}
+
+func (c *CanvasRunContext) Start(canvasID string, payload any) (string, error) {
+	canvas, err := c.findCanvas(canvasID)
+	if err != nil {
+		return "", err
+	}
+
+	if canvas.ID == c.canvasID {
+		return "", fmt.Errorf("canvas cannot run itself")
+	}
+
+	nodes, _, err := models.FindLiveCanvasSpecInTransaction(c.tx, canvas.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find live version of canvas %s: %w", canvas.Name, err)
+	}
+
+	startNodes := []models.Node{}
+	for _, node := range nodes {
+		if node.Type == models.NodeTypeTrigger && node.Ref.Trigger != nil && node.Ref.Trigger.Name == startTriggerName {
+			startNodes = append(startNodes, node)
+		}
+	}
+
+	if len(startNodes) == 0 {
+		return "", fmt.Errorf("canvas %s has no start trigger", canvas.Name)
+	}
+
+	if len(startNodes) > 1 {
+		return "", fmt.Errorf("canvas %s has more than one start trigger", canvas.Name)
+	}
+
+	now := time.Now()
+	event := models.CanvasEvent{
+		WorkflowID: canvas.ID,
+		NodeID:     startNodes[0].ID,
+		Channel:    core.DefaultOutputChannel.Name,
+		Data:       datatypes.NewJSONType(payload),
+		State:      models.CanvasEventStatePending,
+		CreatedAt:  &now,
+	}
+
+	if err := c.tx.Create(&event).Error; err != nil {
+		return "", err
+	}
+
+	if c.onNewEvents != nil {
+		c.onNewEvents([]models.CanvasEvent{event})
+	}
+
+	return event.ID.String(), nil
+}
+
+func (c *CanvasRunContext) Get(canvasID, runID string) (*core.CanvasRun, error) {
+	canvas, err := c.findCanvas(canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	rootEventID, err := uuid.Parse(runID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run ID: %s", runID)
+	}
+
+	rootEvent, err := models.FindCanvasEventForCanvasInTransaction(c.tx, canvas.ID, rootEventID)
+	if err != nil {
+		return nil, fmt.Errorf("run %s not found: %w", runID, err)
+	}
+
+	run := &core.CanvasRun{ID: runID, State: core.CanvasRunStateRunning}
+	switch rootEvent.State {
+	case models.CanvasEventStatePending:
+		return run, nil
+	case models.CanvasEventStateDeadLetter:
+		return c.failed(run, "run could not be started: %s", valueOrEmpty(rootEvent.FailureReason)), nil
+	}
+
+	//
+	// The run is still going while there are items waiting in node queues,
+	// executions which are not finished, or output events not yet routed.
+	//
+	pending, err := models.CountQueueItemsForRootEventInTransaction(c.tx, canvas.ID, rootEventID, models.CanvasNodeQueueItemStatePending)
+	if err != nil {
+		return nil, err
+	}
+
+	if pending > 0 {
+		return run, nil
+	}
+
+	executions, err := models.ListRootEventExecutionsInTransaction(c.tx, canvas.ID, rootEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	executionIDs := make([]uuid.UUID, 0, len(executions))
+	for _, execution := range executions {
+		if execution.State != models.CanvasNodeExecutionStateFinished {
+			return run, nil
+		}
+
+		executionIDs = append(executionIDs, execution.ID)
+	}
+
+	events, err := models.ListCanvasEventsForExecutionsInTransaction(c.tx, executionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		switch event.State {
+		case models.CanvasEventStatePending:
+			return run, nil
+		case models.CanvasEventStateDeadLetter:
+			return c.failed(run, "event from node %s could not be routed: %s", event.NodeID, valueOrEmpty(event.FailureReason)), nil
+		}
+	}
+
+	deadLetters, err := models.CountQueueItemsForRootEventInTransaction(c.tx, canvas.ID, rootEventID, models.CanvasNodeQueueItemStateDeadLetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadLetters > 0 {
+		return c.failed(run, "%d queue items could not be processed", deadLetters), nil
+	}
+
+	//
+	// Failed executions only fail the run if they were not retried.
+	//
+	retried := map[uuid.UUID]bool{}
+	for _, execution := range executions {
+		if execution.RetryOfExecutionID != nil {
+			retried[*execution.RetryOfExecutionID] = true
+		}
+	}
+
+	for _, execution := range executions {
+		if execution.Result != models.CanvasNodeExecutionResultPassed && !retried[execution.ID] {
+			return c.failed(run, "node %s %s: %s", execution.NodeID, execution.Result, execution.ResultMessage), nil
+		}
+	}
+
+	outputs, err := c.terminalNodeOutputs(canvas.ID, executions, events)
+	if err != nil {
+		return nil, err
+	}
+
+	run.State = core.CanvasRunStatePassed
+	run.Outputs = outputs
+	return run, nil
+}
+
+// terminalNodeOutputs returns the payloads emitted by the last execution
+// of each node in the canvas which has no outgoing edges.
+func (c *CanvasRunContext) terminalNodeOutputs(canvasID uuid.UUID, executions []models.CanvasNodeExecution, events []models.CanvasEvent) (map[string][]any, error) {
+	nodes, edges, err := models.FindLiveCanvasSpecInTransaction(c.tx, canvasID)
+	if err != nil {
+		return nil, err
+	}
+
+	terminalNodes := []string{}
+	for _, node := range nodes {
+		if node.Type == models.NodeTypeTrigger {
+			continue
+		}
+
+		hasOutgoingEdges := slices.ContainsFunc(edges, func(edge models.Edge) bool {
+			return edge.SourceID == node.ID
+		})
+
+		if !hasOutgoingEdges {
+			terminalNodes = append(terminalNodes, node.ID)
+		}
+	}
+
+	lastExecutions := map[string]uuid.UUID{}
+	for _, execution := range executions {
+		if slices.Contains(terminalNodes, execution.NodeID) {
+			lastExecutions[execution.NodeID] = execution.ID
+		}
+	}
+
+	outputs := map[string][]any{}
+	for nodeID, executionID := range lastExecutions {
+		outputs[nodeID] = []any{}
+		for _, event := range events {
+			if event.ExecutionID != nil && *event.ExecutionID == executionID {
+				outputs[nodeID] = append(outputs[nodeID], event.Data.Data())
+			}
+		}
+	}
+
+	return outputs, nil
+}
+
+func (c *CanvasRunContext) failed(run *core.CanvasRun, format string, args ...any) *core.CanvasRun {
+	run.State = core.CanvasRunStateFailed
+	run.Message = fmt.Sprintf(format, args...)
+	return run
+}
+
+func (c *CanvasRunContext) findCanvas(canvasID string) (*models.Canvas, error) {
+	id, err := uuid.Parse(canvasID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid canvas ID: %s", canvasID)
+	}
+
+	canvas, err := models.FindCanvasInTransaction(c.tx, c.organizationID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("canvas %s not found", canvasID)
+		}
+
+		return nil, err
+	}
+
+	return canvas, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
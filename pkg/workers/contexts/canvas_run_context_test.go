@@ -0,0 +1,124 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__CanvasRunContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	componentNodeID := "component-1"
+	target, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNodeID,
+				Name:   componentNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNodeID, TargetID: componentNodeID, Channel: "default"},
+		},
+	)
+
+	caller, _ := support.CreateCanvas(t, r.Organization.ID, r.User, []models.CanvasNode{}, []models.Edge{})
+
+	t.Run("canvas cannot run itself", func(t *testing.T) {
+		ctx := NewCanvasRunContext(database.Conn(), r.Organization.ID, target.ID, nil)
+		_, err := ctx.Start(target.ID.String(), map[string]any{})
+		require.ErrorContains(t, err, "canvas cannot run itself")
+	})
+
+	t.Run("canvas from another organization is not found", func(t *testing.T) {
+		ctx := NewCanvasRunContext(database.Conn(), uuid.New(), caller.ID, nil)
+		_, err := ctx.Start(target.ID.String(), map[string]any{})
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("canvas without start trigger cannot be run", func(t *testing.T) {
+		ctx := NewCanvasRunContext(database.Conn(), r.Organization.ID, target.ID, nil)
+		_, err := ctx.Start(caller.ID.String(), map[string]any{})
+		require.ErrorContains(t, err, "has no start trigger")
+	})
+
+	t.Run("run is started and followed until it passes", func(t *testing.T) {
+		newEvents := []models.CanvasEvent{}
+		ctx := NewCanvasRunContext(database.Conn(), r.Organization.ID, caller.ID, func(events []models.CanvasEvent) {
+			newEvents = append(newEvents, events...)
+		})
+
+		runID, err := ctx.Start(target.ID.String(), map[string]any{"version": "v1"})
+		require.NoError(t, err)
+		require.Len(t, newEvents, 1)
+		assert.Equal(t, triggerNodeID, newEvents[0].NodeID)
+		assert.Equal(t, runID, newEvents[0].ID.String())
+
+		//
+		// Root event not routed yet.
+		//
+		run, err := ctx.Get(target.ID.String(), runID)
+		require.NoError(t, err)
+		assert.Equal(t, core.CanvasRunStateRunning, run.State)
+
+		//
+		// Root event routed, execution still running.
+		//
+		rootEvent := newEvents[0]
+		require.NoError(t, database.Conn().Model(&rootEvent).Update("state", models.CanvasEventStateRouted).Error)
+		execution := support.CreateCanvasNodeExecution(t, target.ID, componentNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		run, err = ctx.Get(target.ID.String(), runID)
+		require.NoError(t, err)
+		assert.Equal(t, core.CanvasRunStateRunning, run.State)
+
+		//
+		// Execution passed, output event routed.
+		//
+		events, err := execution.Pass(map[string][]any{"default": {map[string]any{"deployed": true}}})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.NoError(t, database.Conn().Model(&events[0]).Update("state", models.CanvasEventStateRouted).Error)
+
+		run, err = ctx.Get(target.ID.String(), runID)
+		require.NoError(t, err)
+		assert.Equal(t, core.CanvasRunStatePassed, run.State)
+		require.Len(t, run.Outputs[componentNodeID], 1)
+	})
+
+	t.Run("failed execution fails the run", func(t *testing.T) {
+		ctx := NewCanvasRunContext(database.Conn(), r.Organization.ID, caller.ID, nil)
+		runID, err := ctx.Start(target.ID.String(), map[string]any{})
+		require.NoError(t, err)
+
+		rootEventID := uuid.MustParse(runID)
+		require.NoError(t, database.Conn().Model(&models.CanvasEvent{}).Where("id = ?", rootEventID).Update("state", models.CanvasEventStateRouted).Error)
+		execution := support.CreateCanvasNodeExecution(t, target.ID, componentNodeID, rootEventID, rootEventID, nil)
+		require.NoError(t, execution.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		run, err := ctx.Get(target.ID.String(), runID)
+		require.NoError(t, err)
+		assert.Equal(t, core.CanvasRunStateFailed, run.State)
+		assert.Contains(t, run.Message, "boom")
+	})
+}
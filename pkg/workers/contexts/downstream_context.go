@@ -0,0 +1,155 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/models"
+	"gorm.io/gorm"
+)
+
+type DownstreamContext struct {
+	tx         *gorm.DB
+	workflowID uuid.UUID
+}
+
+func NewDownstreamContext(tx *gorm.DB, workflowID uuid.UUID) *DownstreamContext {
+	return &DownstreamContext{tx: tx, workflowID: workflowID}
+}
+
+// Get follows the canvas graph from the given event, one hop at a time:
+// the event starts executions, which emit events, which start more executions.
+// Nothing is finished while there is still something to run in any hop.
+func (c *DownstreamContext) Get(eventID string) (*core.DownstreamResult, error) {
+	id, err := uuid.Parse(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid event ID: %s", eventID)
+	}
+
+	result := &core.DownstreamResult{State: core.DownstreamStateRunning}
+	failures := []string{}
+	outputs := map[string][]any{}
+	eventIDs := []uuid.UUID{id}
+
+	for len(eventIDs) > 0 {
+		pending, err := models.CountQueueItemsForEventsInTransaction(c.tx, c.workflowID, eventIDs, models.CanvasNodeQueueItemStatePending)
+		if err != nil {
+			return nil, err
+		}
+
+		if pending > 0 {
+			return result, nil
+		}
+
+		deadLetters, err := models.CountQueueItemsForEventsInTransaction(c.tx, c.workflowID, eventIDs, models.CanvasNodeQueueItemStateDeadLetter)
+		if err != nil {
+			return nil, err
+		}
+
+		if deadLetters > 0 {
+			failures = append(failures, fmt.Sprintf("%d queue items could not be processed", deadLetters))
+		}
+
+		executions, err := models.ListExecutionsForEventsInTransaction(c.tx, c.workflowID, eventIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		retried := map[uuid.UUID]bool{}
+		executionIDs := make([]uuid.UUID, 0, len(executions))
+		for _, execution := range executions {
+			if execution.State != models.CanvasNodeExecutionStateFinished {
+				return result, nil
+			}
+
+			if execution.RetryOfExecutionID != nil {
+				retried[*execution.RetryOfExecutionID] = true
+			}
+
+			executionIDs = append(executionIDs, execution.ID)
+		}
+
+		//
+		// Failed executions only fail the result if they were not retried.
+		//
+		for _, execution := range executions {
+			if execution.Result != models.CanvasNodeExecutionResultPassed && !retried[execution.ID] {
+				failures = append(failures, fmt.Sprintf("node %s %s: %s", execution.NodeID, execution.Result, execution.ResultMessage))
+			}
+		}
+
+		if len(executionIDs) == 0 {
+			break
+		}
+
+		events, err := models.ListCanvasEventsForExecutionsInTransaction(c.tx, executionIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		next, err := c.nextHop(events, outputs, &failures)
+		if err != nil {
+			return nil, err
+		}
+
+		if next == nil {
+			return result, nil
+		}
+
+		eventIDs = next
+	}
+
+	if len(failures) > 0 {
+		result.State = core.DownstreamStateFailed
+		result.Message = failures[0]
+		return result, nil
+	}
+
+	result.State = core.DownstreamStatePassed
+	result.Outputs = outputs
+	return result, nil
+}
+
+// nextHop returns the IDs of the events which were routed to other nodes.
+// Events that were not routed to any node are collected as outputs.
+// A nil slice is returned if some of the events are not routed yet.
+func (c *DownstreamContext) nextHop(events []models.CanvasEvent, outputs map[string][]any, failures *[]string) ([]uuid.UUID, error) {
+	eventIDs := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		switch event.State {
+		case models.CanvasEventStatePending:
+			return nil, nil
+		case models.CanvasEventStateDeadLetter:
+			*failures = append(*failures, fmt.Sprintf("event from node %s could not be routed: %s", event.NodeID, valueOrEmpty(event.FailureReason)))
+			continue
+		}
+
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	if len(eventIDs) == 0 {
+		return eventIDs, nil
+	}
+
+	routed, err := models.ListRoutedEventIDsInTransaction(c.tx, c.workflowID, eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	next := make([]uuid.UUID, 0, len(eventIDs))
+	for _, event := range events {
+		if event.State != models.CanvasEventStateRouted {
+			continue
+		}
+
+		if routed[event.ID] {
+			next = append(next, event.ID)
+			continue
+		}
+
+		outputs[event.NodeID] = append(outputs[event.NodeID], event.Data.Data())
+	}
+
+	return next, nil
+}
@@ -0,0 +1,124 @@
+package contexts
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/core"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__DownstreamContext(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	triggerNodeID := "trigger-1"
+	loopNodeID := "loop-1"
+	deployNodeID := "deploy-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNodeID,
+				Name:   triggerNodeID,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: loopNodeID,
+				Name:   loopNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+			{
+				NodeID: deployNodeID,
+				Name:   deployNodeID,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNodeID, TargetID: loopNodeID, Channel: "default"},
+			{SourceID: loopNodeID, TargetID: deployNodeID, Channel: "item"},
+		},
+	)
+
+	routed := func(t *testing.T, event *models.CanvasEvent) {
+		require.NoError(t, database.Conn().Model(event).Update("state", models.CanvasEventStateRouted).Error)
+	}
+
+	t.Run("item is followed until everything started from it finishes", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNodeID, "default", nil)
+		loop := support.CreateCanvasNodeExecution(t, canvas.ID, loopNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		events, err := NewExecutionStateContext(database.Conn(), loop, nil).EmitAndContinue("item", "forEach.item", []any{map[string]any{"index": 0}})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		ctx := NewDownstreamContext(database.Conn(), canvas.ID)
+
+		//
+		// Item event not routed yet.
+		//
+		result, err := ctx.Get(events[0])
+		require.NoError(t, err)
+		assert.Equal(t, core.DownstreamStateRunning, result.State)
+
+		//
+		// Item event routed, deploy execution running.
+		//
+		itemEvent, err := models.FindCanvasEventForCanvasInTransaction(database.Conn(), canvas.ID, uuid.MustParse(events[0]))
+		require.NoError(t, err)
+		routed(t, itemEvent)
+		deploy := support.CreateCanvasNodeExecution(t, canvas.ID, deployNodeID, rootEvent.ID, itemEvent.ID, nil)
+
+		result, err = ctx.Get(events[0])
+		require.NoError(t, err)
+		assert.Equal(t, core.DownstreamStateRunning, result.State)
+
+		//
+		// Deploy execution passed, its output leads nowhere.
+		//
+		outputs, err := deploy.Pass(map[string][]any{"default": {map[string]any{"deployed": true}}})
+		require.NoError(t, err)
+		routed(t, &outputs[0])
+
+		result, err = ctx.Get(events[0])
+		require.NoError(t, err)
+		assert.Equal(t, core.DownstreamStatePassed, result.State)
+		require.Len(t, result.Outputs[deployNodeID], 1)
+
+		//
+		// The loop execution itself is not finished.
+		//
+		loop, err = models.FindNodeExecution(canvas.ID, loop.ID)
+		require.NoError(t, err)
+		assert.NotEqual(t, models.CanvasNodeExecutionStateFinished, loop.State)
+	})
+
+	t.Run("failed execution fails the item", func(t *testing.T) {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNodeID, "default", nil)
+		loop := support.CreateCanvasNodeExecution(t, canvas.ID, loopNodeID, rootEvent.ID, rootEvent.ID, nil)
+
+		events, err := NewExecutionStateContext(database.Conn(), loop, nil).EmitAndContinue("item", "forEach.item", []any{map[string]any{"index": 0}})
+		require.NoError(t, err)
+
+		itemEvent, err := models.FindCanvasEventForCanvasInTransaction(database.Conn(), canvas.ID, uuid.MustParse(events[0]))
+		require.NoError(t, err)
+		routed(t, itemEvent)
+		deploy := support.CreateCanvasNodeExecution(t, canvas.ID, deployNodeID, rootEvent.ID, itemEvent.ID, nil)
+		require.NoError(t, deploy.Fail(models.CanvasNodeExecutionResultReasonError, "boom"))
+
+		result, err := NewDownstreamContext(database.Conn(), canvas.ID).Get(events[0])
+		require.NoError(t, err)
+		assert.Equal(t, core.DownstreamStateFailed, result.State)
+		assert.Contains(t, result.Message, "boom")
+	})
+}
@@ -0,0 +1,62 @@
+package contexts
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+type ExecutionLogContext struct {
+	execution *models.CanvasNodeExecution
+	logger    *log.Entry
+}
+
+func NewExecutionLogContext(execution *models.CanvasNodeExecution) *ExecutionLogContext {
+	return &ExecutionLogContext{
+		execution: execution,
+		logger: log.WithFields(log.Fields{
+			"canvas_id":    execution.WorkflowID,
+			"node_id":      execution.NodeID,
+			"execution_id": execution.ID,
+		}),
+	}
+}
+
+func (c *ExecutionLogContext) Debug(message string, fields map[string]any) {
+	c.write(models.CanvasNodeExecutionLogLevelDebug, message, fields)
+}
+
+func (c *ExecutionLogContext) Info(message string, fields map[string]any) {
+	c.write(models.CanvasNodeExecutionLogLevelInfo, message, fields)
+}
+
+func (c *ExecutionLogContext) Warn(message string, fields map[string]any) {
+	c.write(models.CanvasNodeExecutionLogLevelWarn, message, fields)
+}
+
+func (c *ExecutionLogContext) Error(message string, fields map[string]any) {
+	c.write(models.CanvasNodeExecutionLogLevelError, message, fields)
+}
+
+// write does not use the transaction the execution is processed in.
+// The execution row is locked for the duration of that transaction,
+// and log lines should be visible to users while the execution is still running.
+func (c *ExecutionLogContext) write(level, message string, fields map[string]any) {
+	record, err := models.CreateNodeExecutionLogInTransaction(database.Conn(), c.execution, level, message, fields)
+	if err != nil {
+		c.logger.Errorf("error writing execution log: %v", err)
+		return
+	}
+
+	err = messages.NewCanvasExecutionLogMessage(
+		c.execution.WorkflowID.String(),
+		c.execution.ID.String(),
+		c.execution.NodeID,
+		record.ID.String(),
+	).Publish()
+
+	if err != nil {
+		c.logger.Errorf("error publishing execution log message: %v", err)
+	}
+}
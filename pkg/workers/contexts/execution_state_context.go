@@ -47,6 +47,47 @@ func (s *ExecutionStateContext) Pass() error {
 }
 
 func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any) error {
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return err
+	}
+
+	newEvents, err := s.execution.PassInTransaction(s.tx, outputs)
+	if err != nil {
+		return err
+	}
+
+	if s.onNewEvents != nil {
+		s.onNewEvents(newEvents)
+	}
+
+	return nil
+}
+
+func (s *ExecutionStateContext) EmitAndContinue(channel, payloadType string, payloads []any) ([]string, error) {
+	outputs, err := s.buildOutputs(channel, payloadType, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvents, err := s.execution.EmitInTransaction(s.tx, outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.onNewEvents != nil {
+		s.onNewEvents(newEvents)
+	}
+
+	eventIDs := make([]string, 0, len(newEvents))
+	for _, event := range newEvents {
+		eventIDs = append(eventIDs, event.ID.String())
+	}
+
+	return eventIDs, nil
+}
+
+func (s *ExecutionStateContext) buildOutputs(channel, payloadType string, payloads []any) (map[string][]any, error) {
 	outputs := map[string][]any{
 		channel: {},
 	}
@@ -60,26 +101,17 @@ func (s *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 
 		data, err := json.Marshal(event)
 		if err != nil {
-			return fmt.Errorf("failed to marshal payload: %w", err)
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
 		}
 
 		if len(data) > s.maxPayloadSize {
-			return fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
+			return nil, fmt.Errorf("event payload too large: %d bytes (max %d)", len(data), s.maxPayloadSize)
 		}
 
 		outputs[channel] = append(outputs[channel], json.RawMessage(data))
 	}
 
-	newEvents, err := s.execution.PassInTransaction(s.tx, outputs)
-	if err != nil {
-		return err
-	}
-
-	if s.onNewEvents != nil {
-		s.onNewEvents(newEvents)
-	}
-
-	return nil
+	return outputs, nil
 }
 
 func (s *ExecutionStateContext) Fail(reason, message string) error {
@@ -3,22 +3,25 @@ package contexts
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/expr-lang/expr"
 	"github.com/expr-lang/expr/ast"
 	"github.com/expr-lang/expr/parser"
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/configuration"
+	"github.com/superplanehq/superplane/pkg/expressions"
 	"github.com/superplanehq/superplane/pkg/models"
 	"gorm.io/gorm"
 )
 
 var expressionRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
-var previousDepthRegex = regexp.MustCompile(`\bprevious\s*\(([^)]*)\)`)
+var singleExpressionRegex = regexp.MustCompile(`^\s*\{\{(.*?)\}\}\s*$`)
+
+const maskedSecretValue = "********"
 
 type NodeConfigurationBuilder struct {
 	tx                  *gorm.DB
@@ -29,6 +32,9 @@ type NodeConfigurationBuilder struct {
 	input               any
 	parentBlueprintNode *models.CanvasNode
 	configurationFields []configuration.Field
+	maskSecrets         bool
+	secretRefs          bool
+	variables           map[string]models.CanvasVariable
 }
 
 func NewNodeConfigurationBuilder(tx *gorm.DB, workflowID uuid.UUID) *NodeConfigurationBuilder {
@@ -68,6 +74,13 @@ func (b *NodeConfigurationBuilder) WithConfigurationFields(fields []configuratio
 	return b
 }
 
+// WithMaskedSecrets resolves secret-backed variables to a placeholder,
+// for previews that must not expose the secret values.
+func (b *NodeConfigurationBuilder) WithMaskedSecrets() *NodeConfigurationBuilder {
+	b.maskSecrets = true
+	return b
+}
+
 func (b *NodeConfigurationBuilder) Build(configuration map[string]any) (map[string]any, error) {
 	if len(b.configurationFields) > 0 {
 		return b.resolveWithSchema(configuration, b.configurationFields)
@@ -128,6 +141,12 @@ func (b *NodeConfigurationBuilder) resolveFieldValue(value any, field configurat
 		return value, nil
 	}
 
+	if field.Type == configuration.FieldTypeSecretKey {
+		if expression, ok := value.(string); ok {
+			return b.resolveSecretKeyExpression(expression)
+		}
+	}
+
 	if field.TypeOptions != nil {
 		if field.TypeOptions.Object != nil && len(field.TypeOptions.Object.Schema) > 0 {
 			if obj, ok := asAnyMap(value); ok {
@@ -145,6 +164,21 @@ func (b *NodeConfigurationBuilder) resolveFieldValue(value any, field configurat
 	return b.resolveValue(value)
 }
 
+// resolveSecretKeyExpression resolves an expression given to a secret key field.
+// Secret-backed variables resolve to a reference to their secret key there,
+// so the value is only read when the node runs, and never stored with the configuration.
+func (b *NodeConfigurationBuilder) resolveSecretKeyExpression(expression string) (any, error) {
+	matches := singleExpressionRegex.FindStringSubmatch(expression)
+	if len(matches) != 2 {
+		return b.ResolveExpression(expression)
+	}
+
+	b.secretRefs = true
+	defer func() { b.secretRefs = false }()
+
+	return b.resolveExpression(matches[1])
+}
+
 func (b *NodeConfigurationBuilder) resolveListItems(list []any, itemDef *configuration.ListItemDefinition) ([]any, error) {
 	result := make([]any, len(list))
 	for i, item := range list {
@@ -244,6 +278,12 @@ func (b *NodeConfigurationBuilder) ResolveExpression(expression string) (any, er
 	return result, nil
 }
 
+// Evaluate runs an expression without the {{ }} delimiters,
+// returning its value as is instead of formatting it into a string.
+func (b *NodeConfigurationBuilder) Evaluate(expression string) (any, error) {
+	return b.resolveExpression(expression)
+}
+
 func (b *NodeConfigurationBuilder) BuildMessageChainForExpression(expression string) (map[string]any, error) {
 	referencedNodes, err := parseReferencedNodes(expression)
 	if err != nil {
@@ -269,10 +309,10 @@ func (b *NodeConfigurationBuilder) BuildExpressionEnv(expression string) (map[st
 		if err != nil {
 			return nil, err
 		}
-		env["__root"] = rootPayload
+		env[expressions.RootKey] = rootPayload
 	}
 
-	depths, err := parsePreviousDepths(expression)
+	depths, err := expressions.PreviousDepths(expression)
 	if err != nil {
 		return nil, err
 	}
@@ -285,7 +325,29 @@ func (b *NodeConfigurationBuilder) BuildExpressionEnv(expression string) (map[st
 			}
 			previousByDepth[strconv.Itoa(depth)] = payload
 		}
-		env["__previousByDepth"] = previousByDepth
+		env[expressions.PreviousByDepthKey] = previousByDepth
+	}
+
+	variableNames := expressions.VariableNames(expression)
+	if len(variableNames) > 0 {
+		variables, err := b.loadVariables()
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]any, len(variableNames))
+		for _, name := range variableNames {
+			if _, ok := variables[name]; !ok {
+				continue
+			}
+
+			value, err := b.resolveVariable(name)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = value
+		}
+		env[expressions.VariablesKey] = values
 	}
 
 	return env, nil
@@ -311,36 +373,13 @@ func (b *NodeConfigurationBuilder) resolveExpression(expression string) (any, er
 		env["config"] = b.parentBlueprintNode.Configuration.Data()
 	}
 
-	exprOptions := []expr.Option{
-		expr.Env(env),
-		expr.AsAny(),
-		expr.WithContext("ctx"),
-		expr.Timezone(time.UTC.String()),
-		expr.Function("root", func(params ...any) (any, error) {
-			if len(params) != 0 {
-				return nil, fmt.Errorf("root() takes no arguments")
-			}
-
-			return b.resolveRootPayload()
-		}),
-		expr.Function("previous", func(params ...any) (any, error) {
-			depth := 1
-			if len(params) > 1 {
-				return nil, fmt.Errorf("previous() accepts zero or one argument")
-			}
-			if len(params) == 1 {
-				parsedDepth, err := parseDepth(params[0])
-				if err != nil {
-					return nil, err
-				}
-				depth = parsedDepth
-			}
-
-			return b.resolvePreviousPayload(depth)
-		}),
+	resolvers := &expressions.Resolvers{
+		Root:     b.resolveRootPayload,
+		Previous: b.resolvePreviousPayload,
+		Variable: b.resolveVariable,
 	}
 
-	vm, err := expr.Compile(expression, exprOptions...)
+	vm, err := expressions.Compile(expression, env, resolvers, expr.AsAny())
 	if err != nil {
 		return "", err
 	}
@@ -520,6 +559,58 @@ func (b *NodeConfigurationBuilder) resolveRootPayload() (any, error) {
 	return rootEvent.Data.Data(), nil
 }
 
+// loadVariables reads the variables of the live canvas version once,
+// with the values of the environment the canvas uses.
+func (b *NodeConfigurationBuilder) loadVariables() (map[string]models.CanvasVariable, error) {
+	if b.variables != nil {
+		return b.variables, nil
+	}
+
+	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(b.tx, b.workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding canvas: %w", err)
+	}
+
+	version, err := models.FindLiveCanvasVersionByCanvasInTransaction(b.tx, canvas)
+	if err != nil {
+		return nil, fmt.Errorf("error finding live canvas version: %w", err)
+	}
+
+	variables, err := version.VariableSet().ForEnvironment(canvas.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	b.variables = variables
+	return variables, nil
+}
+
+func (b *NodeConfigurationBuilder) resolveVariable(name string) (any, error) {
+	variables, err := b.loadVariables()
+	if err != nil {
+		return nil, err
+	}
+
+	variable, ok := variables[name]
+	if !ok {
+		return nil, fmt.Errorf("variable %s is not defined", name)
+	}
+
+	if !variable.IsSecret() {
+		return variable.Value, nil
+	}
+
+	if b.secretRefs {
+		return map[string]any{"secret": variable.Secret.Name, "key": variable.Secret.Key}, nil
+	}
+
+	if b.maskSecrets {
+		return maskedSecretValue, nil
+	}
+
+	return nil, fmt.Errorf("variable %s is backed by a secret, and can only be used in secret key fields", name)
+}
+
 func populateFromInputOrRoot(messageChain map[string]any, inputMap map[string]any, rootEvent *models.CanvasEvent, refToNodeID map[string]string) map[string]string {
 	chainRefs := make(map[string]string, len(refToNodeID))
 	for nodeRef, nodeID := range refToNodeID {
@@ -604,64 +695,6 @@ func latestEventByExecution(events []models.CanvasEvent, executionIDs []uuid.UUI
 	return latestByExecution
 }
 
-func parsePreviousDepths(expression string) ([]int, error) {
-	matches := previousDepthRegex.FindAllStringSubmatch(expression, -1)
-	if len(matches) == 0 {
-		return nil, nil
-	}
-
-	seen := map[int]struct{}{}
-	for _, match := range matches {
-		raw := strings.TrimSpace(match[1])
-		if raw == "" {
-			seen[1] = struct{}{}
-			continue
-		}
-
-		parsed, err := strconv.Atoi(raw)
-		if err != nil {
-			return nil, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return nil, fmt.Errorf("depth must be >= 1")
-		}
-		seen[parsed] = struct{}{}
-	}
-
-	depths := make([]int, 0, len(seen))
-	for depth := range seen {
-		depths = append(depths, depth)
-	}
-	sort.Ints(depths)
-	return depths, nil
-}
-
-func parseDepth(param any) (int, error) {
-	switch value := param.(type) {
-	case int:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return value, nil
-	case int64:
-		if value < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return int(value), nil
-	case float64:
-		parsed := int(value)
-		if value != float64(parsed) {
-			return 0, fmt.Errorf("depth must be an integer")
-		}
-		if parsed < 1 {
-			return 0, fmt.Errorf("depth must be >= 1")
-		}
-		return parsed, nil
-	default:
-		return 0, fmt.Errorf("depth must be an integer")
-	}
-}
-
 func (b *NodeConfigurationBuilder) resolvePreviousPayload(depth int) (any, error) {
 	if depth < 1 {
 		return nil, fmt.Errorf("depth must be >= 1")
@@ -1010,6 +1043,31 @@ func (b *NodeConfigurationBuilder) listUpstreamNodeIDs() ([]string, error) {
 	return ids, nil
 }
 
+// ReferencedNodes returns the nodes read from the message chain by an expression,
+// or by the {{ }} placeholders of a template.
+func ReferencedNodes(expression string) ([]string, error) {
+	placeholders := expressionRegex.FindAllStringSubmatch(expression, -1)
+	if len(placeholders) == 0 {
+		return parseReferencedNodes(expression)
+	}
+
+	nodes := []string{}
+	for _, placeholder := range placeholders {
+		referenced, err := parseReferencedNodes(placeholder[1])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range referenced {
+			if !slices.Contains(nodes, node) {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
 func parseReferencedNodes(expression string) ([]string, error) {
 	tree, err := parser.Parse(expression)
 	if err != nil {
@@ -1,12 +1,15 @@
 package contexts
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/configuration"
 	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/expressions"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 	"gorm.io/datatypes"
@@ -1312,3 +1315,148 @@ func Test_NodeConfigurationBuilder_MemoryFindFirst(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, missing)
 }
+
+func Test_NodeConfigurationBuilder_MemoryIgnoresExpiredEntries(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: "node-1", Name: "node-1", Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{},
+	)
+
+	tx := database.Conn()
+	err := models.AddExpiringCanvasMemoryInTransaction(tx, canvas.ID, "machines", map[string]any{"sandbox_id": "12121", "state": "expired"}, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	err = models.AddExpiringCanvasMemoryInTransaction(tx, canvas.ID, "machines", map[string]any{"sandbox_id": "12121", "state": "live"}, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	builder := NewNodeConfigurationBuilder(tx, canvas.ID).WithInput(map[string]any{})
+
+	result, err := builder.resolveExpression(`memory.find("machines", {"sandbox_id": "12121"})`)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"sandbox_id": "12121", "state": "live"}}, result)
+
+	missing, err := builder.resolveExpression(`memory.findFirst("machines", {"state": "expired"})`)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func Test_NodeConfigurationBuilder_Variables(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID:        "node-1",
+				Name:          "node-1",
+				Type:          models.NodeTypeComponent,
+				Configuration: datatypes.NewJSONType(map[string]any{"token": `{{ env("TOKEN") }}`}),
+			},
+		},
+		[]models.Edge{},
+	)
+
+	secret, err := support.CreateSecret(t, r, map[string]string{"token": "s3cr3t"})
+	require.NoError(t, err)
+
+	version, err := models.FindLiveCanvasVersionByCanvasInTransaction(database.Conn(), canvas)
+	require.NoError(t, err)
+	version.SetVariableSet(models.CanvasVariableSet{
+		Variables: []models.CanvasVariable{
+			{Name: "CHANNEL", Value: "#staging"},
+			{Name: "TOKEN", Secret: &models.CanvasVariableSecret{Name: secret.Name, Key: "token"}},
+		},
+		Environments: []models.CanvasEnvironment{
+			{Name: "production", Variables: []models.CanvasVariable{{Name: "CHANNEL", Value: "#deploys"}}},
+		},
+	})
+	require.NoError(t, database.Conn().Save(version).Error)
+
+	newBuilder := func() *NodeConfigurationBuilder {
+		return NewNodeConfigurationBuilder(database.Conn(), canvas.ID).
+			WithNodeID("node-1").
+			WithInput(map[string]any{})
+	}
+
+	t.Run("plain variable uses the spec value", func(t *testing.T) {
+		result, err := newBuilder().Build(map[string]any{"channel": `{{ env("CHANNEL") }}`})
+		require.NoError(t, err)
+		assert.Equal(t, "#staging", result["channel"])
+	})
+
+	secretKeyFields := []configuration.Field{
+		{Name: "token", Type: configuration.FieldTypeSecretKey},
+	}
+
+	t.Run("secret-backed variable in a secret key field resolves to a secret reference", func(t *testing.T) {
+		result, err := newBuilder().WithConfigurationFields(secretKeyFields).Build(map[string]any{"token": `{{ env("TOKEN") }}`})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"secret": secret.Name, "key": "token"}, result["token"])
+	})
+
+	t.Run("secret-backed variable outside secret key fields -> error", func(t *testing.T) {
+		_, err := newBuilder().Build(map[string]any{"token": `{{ env("TOKEN") }}`})
+		require.ErrorContains(t, err, "can only be used in secret key fields")
+
+		_, err = newBuilder().WithConfigurationFields(secretKeyFields).Build(map[string]any{"token": `Bearer {{ env("TOKEN") }}`})
+		require.ErrorContains(t, err, "can only be used in secret key fields")
+
+		_, err = newBuilder().BuildExpressionEnv(`env("TOKEN")`)
+		require.ErrorContains(t, err, "can only be used in secret key fields")
+	})
+
+	t.Run("stored execution configuration does not contain the secret value", func(t *testing.T) {
+		node, err := models.FindCanvasNode(database.Conn(), canvas.ID, "node-1")
+		require.NoError(t, err)
+
+		event := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", nil)
+		queueItem := support.CreateQueueItem(t, canvas.ID, "node-1", event.ID, event.ID)
+
+		ctx, err := BuildProcessQueueContext(nil, database.Conn(), node, queueItem, secretKeyFields, nil)
+		require.NoError(t, err)
+
+		executionCtx, err := ctx.CreateExecution()
+		require.NoError(t, err)
+
+		execution, err := models.FindNodeExecution(canvas.ID, executionCtx.ID)
+		require.NoError(t, err)
+
+		stored, err := json.Marshal(execution.Configuration.Data())
+		require.NoError(t, err)
+		assert.NotContains(t, string(stored), "s3cr3t")
+		assert.Equal(t, map[string]any{"secret": secret.Name, "key": "token"}, execution.Configuration.Data()["token"])
+	})
+
+	t.Run("secret-backed variable is masked", func(t *testing.T) {
+		result, err := newBuilder().WithMaskedSecrets().Build(map[string]any{"token": `{{ env("TOKEN") }}`})
+		require.NoError(t, err)
+		assert.Equal(t, "********", result["token"])
+	})
+
+	t.Run("undefined variable -> error", func(t *testing.T) {
+		_, err := newBuilder().Build(map[string]any{"value": `{{ env("UNKNOWN") }}`})
+		require.ErrorContains(t, err, "variable UNKNOWN is not defined")
+	})
+
+	t.Run("canvas environment overrides values", func(t *testing.T) {
+		require.NoError(t, database.Conn().Model(canvas).Update("environment", "production").Error)
+
+		result, err := newBuilder().Build(map[string]any{"channel": `{{ env("CHANNEL") }}`})
+		require.NoError(t, err)
+		assert.Equal(t, "#deploys", result["channel"])
+
+		env, err := newBuilder().BuildExpressionEnv(`env("CHANNEL") + "!"`)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"CHANNEL": "#deploys"}, env[expressions.VariablesKey])
+	})
+}
@@ -100,6 +100,7 @@ func BuildProcessQueueContext(
 			RootEventID:         queueItem.RootEventID,
 			EventID:             event.ID,
 			PreviousExecutionID: event.ExecutionID,
+			RerunOfExecutionID:  queueItem.RerunOfExecutionID,
 			State:               models.CanvasNodeExecutionStatePending,
 			Configuration:       datatypes.NewJSONType(config),
 			CreatedAt:           &now,
@@ -135,6 +136,7 @@ func BuildProcessQueueContext(
 			Logger:         logging.WithExecution(logging.ForNode(*node), &execution, nil),
 			Notifications:  NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 			CanvasMemory:   NewCanvasMemoryContext(tx, execution.WorkflowID),
+			Logs:           NewExecutionLogContext(&execution),
 		}, nil
 	}
 
@@ -156,10 +158,21 @@ func BuildProcessQueueContext(
 			return nil, err
 		}
 
-		if err := ctx.UpdateNodeState(models.CanvasNodeStateProcessing); err != nil {
+		//
+		// The node only stops taking new items from its queue
+		// once it reaches its concurrency limit.
+		//
+		busy, err := node.IsBusyInTransaction(tx)
+		if err != nil {
 			return nil, err
 		}
 
+		if busy {
+			if err := ctx.UpdateNodeState(models.CanvasNodeStateProcessing); err != nil {
+				return nil, err
+			}
+		}
+
 		return &executionCtx.ID, nil
 	}
 
@@ -172,9 +185,9 @@ func BuildProcessQueueContext(
 				return 0, err
 			}
 
-			blueprintID := parent.Ref.Data().Blueprint.ID
-			if blueprintID != "" {
-				bp, err := models.FindUnscopedBlueprintInTransaction(tx, blueprintID)
+			blueprintRef := parent.Ref.Data().Blueprint
+			if blueprintRef.ID != "" {
+				bp, err := models.FindBlueprintForRefInTransaction(tx, blueprintRef)
 				if err != nil {
 					return 0, err
 				}
@@ -236,6 +249,7 @@ func BuildProcessQueueContext(
 			Logger:         logging.WithExecution(logging.ForNode(*node), execution, nil),
 			Notifications:  NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 			CanvasMemory:   NewCanvasMemoryContext(tx, execution.WorkflowID),
+			Logs:           NewExecutionLogContext(execution),
 		}, nil
 	}
 
@@ -2,12 +2,12 @@ package contexts
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/superplanehq/superplane/pkg/core"
 	"github.com/superplanehq/superplane/pkg/crypto"
 	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
 	"gorm.io/gorm"
 )
 
@@ -29,17 +29,19 @@ func NewSecretsContext(tx *gorm.DB, organizationID uuid.UUID, encryptor crypto.E
 }
 
 // GetKey implements core.SecretsContext.
+// The values are loaded through the provider of the secret,
+// so secrets stored in Vault work the same way as local ones.
 func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 	if secretName == "" || keyName == "" {
 		return nil, core.ErrSecretKeyNotFound
 	}
 
-	secret, err := models.FindSecretByNameInTransaction(c.tx, models.DomainTypeOrganization, c.organizationID, secretName)
+	provider, err := secrets.NewProvider(c.tx, c.encryptor, secretName, models.DomainTypeOrganization, c.organizationID)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := c.decryptSecretData(secret)
+	data, err := provider.Load(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -51,19 +53,3 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	return []byte(val), nil
 }
-
-func (c *SecretsContext) decryptSecretData(secret *models.Secret) (map[string]string, error) {
-	plain, err := c.encryptor.Decrypt(context.Background(), secret.Data, []byte(secret.Name))
-	if err != nil {
-		return nil, err
-	}
-
-	var data map[string]string
-	if len(plain) == 0 {
-		return make(map[string]string), nil
-	}
-	if err := json.Unmarshal(plain, &data); err != nil {
-		return nil, err
-	}
-	return data, nil
-}
@@ -0,0 +1,149 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+)
+
+// EncryptionKeyRotationWorker re-encrypts the values encrypted
+// with an old master key, or before envelope encryption was enabled,
+// with the current master key.
+//
+// Records are updated one by one, only if they did not change since they
+// were read, so the servers can keep reading and writing them during the rotation.
+// Old master keys can be removed once a full pass finds nothing to rotate;
+// CheckMasterKeysInUse prevents removing one which is still used.
+type EncryptionKeyRotationWorker struct {
+	logger            *log.Entry
+	encryptor         crypto.RotatableEncryptor
+	maxRecordsPerTick int
+
+	//
+	// Records which cannot be re-encrypted are skipped,
+	// so a pass goes through every column once.
+	//
+	cursors map[string]models.EncryptedRecordCursor
+}
+
+type EncryptionKeyRotationResult struct {
+	Rotated int
+	Failed  int
+	Done    bool
+}
+
+func NewEncryptionKeyRotationWorker(encryptor crypto.RotatableEncryptor) *EncryptionKeyRotationWorker {
+	return &EncryptionKeyRotationWorker{
+		logger:            log.WithFields(log.Fields{"worker": "EncryptionKeyRotationWorker"}),
+		encryptor:         encryptor,
+		maxRecordsPerTick: 100,
+		cursors:           map[string]models.EncryptedRecordCursor{},
+	}
+}
+
+func (w *EncryptionKeyRotationWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.Tick(ctx); err != nil {
+				w.logger.Errorf("Error rotating encryption keys: %v", err)
+			}
+		}
+	}
+}
+
+func (w *EncryptionKeyRotationWorker) Tick(ctx context.Context) (*EncryptionKeyRotationResult, error) {
+	result := &EncryptionKeyRotationResult{Done: true}
+	prefix := w.encryptor.CurrentPrefix()
+
+	for _, column := range models.EncryptedColumns {
+		records, err := models.ListEncryptedRecordsWithoutPrefix(column, prefix, w.cursors[column.String()], w.maxRecordsPerTick)
+		if err != nil {
+			return result, err
+		}
+
+		if len(records) == 0 {
+			delete(w.cursors, column.String())
+			continue
+		}
+
+		result.Done = false
+		for _, record := range records {
+			w.cursors[column.String()] = record.Cursor()
+			if err := w.rotate(ctx, column, record); err != nil {
+				w.logger.Errorf("Error rotating %s for record %s: %v", column, record.ID, err)
+				result.Failed++
+				continue
+			}
+
+			result.Rotated++
+		}
+	}
+
+	if result.Rotated > 0 || result.Failed > 0 {
+		w.logger.Infof("Rotated %d encrypted values, %d failed", result.Rotated, result.Failed)
+	}
+
+	return result, nil
+}
+
+func (w *EncryptionKeyRotationWorker) rotate(ctx context.Context, column models.EncryptedColumn, record models.EncryptedRecord) error {
+	associatedData := []byte(record.AssociatedData)
+	plaintext, err := w.encryptor.Decrypt(ctx, record.Data, associatedData)
+	if err != nil {
+		return err
+	}
+
+	ciphertext, err := w.encryptor.Encrypt(ctx, plaintext, associatedData)
+	if err != nil {
+		return err
+	}
+
+	replaced, err := models.ReplaceEncryptedRecord(column, record, ciphertext)
+	if err != nil {
+		return err
+	}
+
+	//
+	// The record was updated after being read,
+	// so it was already encrypted with the current key.
+	//
+	if !replaced {
+		w.logger.Infof("Record %s in %s changed during rotation, skipping", record.ID, column)
+	}
+
+	return nil
+}
+
+// CheckMasterKeysInUse returns an error if encrypted values
+// still use a master key which is not in the given ones.
+func CheckMasterKeysInUse(keyIDs []string) error {
+	configured := map[string]bool{}
+	for _, keyID := range keyIDs {
+		configured[keyID] = true
+	}
+
+	for _, column := range models.EncryptedColumns {
+		used, err := models.ListMasterKeyIDs(column)
+		if err != nil {
+			return fmt.Errorf("error listing master keys used by %s: %w", column, err)
+		}
+
+		for _, keyID := range used {
+			if !configured[keyID] {
+				return fmt.Errorf("master key %s is still used by %s, keep it until the values are rotated", keyID, column)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package workers
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/secrets"
+	"github.com/superplanehq/superplane/test/support"
+)
+
+func Test__EncryptionKeyRotationWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	ctx := context.Background()
+	legacy := crypto.NewAESGCMEncryptor([]byte("0123456789abcdef0123456789abcdef"))
+	keys := map[string][]byte{
+		"v1": []byte("abcdefghijklmnopqrstuvwxyz012345"),
+		"v2": []byte("543210zyxwvutsrqponmlkjihgfedcba"),
+	}
+
+	createSecret := func(t *testing.T, encryptor crypto.Encryptor) *models.Secret {
+		name := support.RandomName("secret")
+		data, err := encryptor.Encrypt(ctx, []byte(`{"key":"value"}`), []byte(name))
+		require.NoError(t, err)
+
+		secret, err := models.CreateSecret(name, secrets.ProviderLocal, r.User.String(), models.DomainTypeOrganization, r.Organization.ID, data)
+		require.NoError(t, err)
+		return secret
+	}
+
+	rotateAll := func(t *testing.T, w *EncryptionKeyRotationWorker) {
+		for i := 0; i < 100; i++ {
+			result, err := w.Tick(ctx)
+			require.NoError(t, err)
+			if result.Done {
+				return
+			}
+		}
+
+		t.Fatal("rotation did not finish")
+	}
+
+	t.Run("legacy values are rotated to the current master key", func(t *testing.T) {
+		secret := createSecret(t, legacy)
+
+		keyManager, err := crypto.NewLocalKeyManager("v1", keys)
+		require.NoError(t, err)
+		encryptor := crypto.NewEnvelopeEncryptor(keyManager, legacy)
+		rotateAll(t, NewEncryptionKeyRotationWorker(encryptor))
+
+		updated, err := models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, secret.Name)
+		require.NoError(t, err)
+
+		keyID, ok := crypto.KeyID(updated.Data)
+		require.True(t, ok)
+		assert.Equal(t, "v1", keyID)
+
+		values, err := secrets.NewLocalProvider(nil, encryptor, updated).Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key": "value"}, values)
+	})
+
+	t.Run("values are rotated to a new master key", func(t *testing.T) {
+		v1, err := crypto.NewLocalKeyManager("v1", keys)
+		require.NoError(t, err)
+		secret := createSecret(t, crypto.NewEnvelopeEncryptor(v1, legacy))
+
+		v2, err := crypto.NewLocalKeyManager("v2", keys)
+		require.NoError(t, err)
+		encryptor := crypto.NewEnvelopeEncryptor(v2, legacy)
+		rotateAll(t, NewEncryptionKeyRotationWorker(encryptor))
+
+		updated, err := models.FindSecretByName(models.DomainTypeOrganization, r.Organization.ID, secret.Name)
+		require.NoError(t, err)
+
+		keyID, ok := crypto.KeyID(updated.Data)
+		require.True(t, ok)
+		assert.Equal(t, "v2", keyID)
+
+		//
+		// v1 is not needed anymore.
+		//
+		onlyV2, err := crypto.NewLocalKeyManager("v2", map[string][]byte{"v2": keys["v2"]})
+		require.NoError(t, err)
+		values, err := secrets.NewLocalProvider(nil, crypto.NewEnvelopeEncryptor(onlyV2, nil), updated).Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"key": "value"}, values)
+	})
+
+	t.Run("sensitive fields of integration configurations are rotated", func(t *testing.T) {
+		v1, err := crypto.NewLocalKeyManager("v1", keys)
+		require.NoError(t, err)
+
+		integrationID := uuid.New()
+		token, err := crypto.NewEnvelopeEncryptor(v1, legacy).Encrypt(ctx, []byte("token"), []byte(integrationID.String()))
+		require.NoError(t, err)
+
+		_, err = models.CreateIntegration(integrationID, r.Organization.ID, "semaphore", support.RandomName("integration"), map[string]any{
+			"organizationUrl": "https://example.semaphoreci.com",
+			"apiToken":        base64.StdEncoding.EncodeToString(token),
+		})
+		require.NoError(t, err)
+
+		v2, err := crypto.NewLocalKeyManager("v2", keys)
+		require.NoError(t, err)
+		encryptor := crypto.NewEnvelopeEncryptor(v2, legacy)
+		rotateAll(t, NewEncryptionKeyRotationWorker(encryptor))
+
+		updated, err := models.FindIntegration(r.Organization.ID, integrationID)
+		require.NoError(t, err)
+
+		configuration := updated.Configuration.Data()
+		assert.Equal(t, "https://example.semaphoreci.com", configuration["organizationUrl"])
+
+		rotated, err := base64.StdEncoding.DecodeString(configuration["apiToken"].(string))
+		require.NoError(t, err)
+
+		keyID, ok := crypto.KeyID(rotated)
+		require.True(t, ok)
+		assert.Equal(t, "v2", keyID)
+
+		value, err := encryptor.Decrypt(ctx, rotated, []byte(integrationID.String()))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("token"), value)
+	})
+
+	t.Run("master keys still in use cannot be removed", func(t *testing.T) {
+		v1, err := crypto.NewLocalKeyManager("v1", keys)
+		require.NoError(t, err)
+		createSecret(t, crypto.NewEnvelopeEncryptor(v1, legacy))
+
+		err = CheckMasterKeysInUse([]string{"v2"})
+		require.ErrorContains(t, err, "master key v1 is still used by secrets.data")
+
+		require.NoError(t, CheckMasterKeysInUse([]string{"v1", "v2"}))
+	})
+
+	t.Run("records changed during the rotation are not overwritten", func(t *testing.T) {
+		secret := createSecret(t, legacy)
+		column := models.EncryptedColumns[0]
+
+		keyManager, err := crypto.NewLocalKeyManager("v1", keys)
+		require.NoError(t, err)
+		encryptor := crypto.NewEnvelopeEncryptor(keyManager, legacy)
+
+		record := models.EncryptedRecord{ID: secret.ID, Data: secret.Data, AssociatedData: secret.Name}
+		newData, err := encryptor.Encrypt(ctx, []byte(`{"key":"new"}`), []byte(secret.Name))
+		require.NoError(t, err)
+		_, err = secret.UpdateData(newData)
+		require.NoError(t, err)
+
+		replaced, err := models.ReplaceEncryptedRecord(column, record, []byte("stale"))
+		require.NoError(t, err)
+		assert.False(t, replaced)
+	})
+}
@@ -156,6 +156,7 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 	})
 
 	if err != nil {
+		w.recordFailure(logger, event, err)
 		return err
 	}
 
@@ -180,6 +181,20 @@ func (w *EventRouter) LockAndProcessEvent(logger *log.Entry, event models.Canvas
 	return nil
 }
 
+// recordFailure keeps track of events that cannot be routed,
+// so they are moved to the dead-letter state instead of
+// being retried forever.
+func (w *EventRouter) recordFailure(logger *log.Entry, event models.CanvasEvent, processingErr error) {
+	if err := event.RecordFailure(processingErr.Error()); err != nil {
+		logger.Errorf("Error recording failure for event %s: %v", event.ID, err)
+		return
+	}
+
+	if event.State == models.CanvasEventStateDeadLetter {
+		logger.Warnf("Event %s moved to dead letters after %d attempts: %v", event.ID, event.Attempts, processingErr)
+	}
+}
+
 func (w *EventRouter) processEvent(tx *gorm.DB, logger *log.Entry, event *models.CanvasEvent) ([]models.CanvasNodeQueueItem, *models.CanvasNodeExecution, error) {
 	canvas, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, event.WorkflowID)
 	if err != nil {
@@ -245,10 +260,16 @@ func (w *EventRouter) processRootEvent(tx *gorm.DB, canvas *models.Canvas, edges
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		enqueued, err := targetNode.EnqueueInTransaction(tx, &queueItem)
+		if err != nil {
 			return nil, err
 		}
 
+		if !enqueued {
+			w.logger.Infof("Node %s is busy - rejecting event %s", targetNode.NodeID, event.ID)
+			continue
+		}
+
 		queueItems = append(queueItems, queueItem)
 	}
 
@@ -293,10 +314,16 @@ func (w *EventRouter) processExecutionEvent(
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		enqueued, err := targetNode.EnqueueInTransaction(tx, &queueItem)
+		if err != nil {
 			return nil, err
 		}
 
+		if !enqueued {
+			logger.Infof("Node %s is busy - rejecting event", targetNode.NodeID)
+			continue
+		}
+
 		createdQueueItems = append(createdQueueItems, queueItem)
 	}
 
@@ -319,8 +346,7 @@ func (w *EventRouter) processChildExecutionEvent(tx *gorm.DB, logger *log.Entry,
 	logger = logging.WithExecution(logger, execution, parentExecution)
 	logger.Info("Processing child execution event")
 
-	blueprintID := parentNode.Ref.Data().Blueprint.ID
-	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, blueprintID)
+	blueprint, err := models.FindBlueprintForRefInTransaction(tx, parentNode.Ref.Data().Blueprint)
 	if err != nil {
 		logger.Errorf("Error finding blueprint: %v", err)
 		return nil, nil, err
@@ -385,11 +411,17 @@ func (w *EventRouter) processChildExecutionEvent(tx *gorm.DB, logger *log.Entry,
 			CreatedAt:   &now,
 		}
 
-		if err := tx.Create(&queueItem).Error; err != nil {
+		enqueued, err := targetNode.EnqueueInTransaction(tx, &queueItem)
+		if err != nil {
 			logger.Errorf("Error creating queue item: %v", err)
 			return nil, nil, err
 		}
 
+		if !enqueued {
+			logger.Infof("Node %s is busy - rejecting event", targetNodeID)
+			continue
+		}
+
 		createdQueueItems = append(createdQueueItems, queueItem)
 	}
 
@@ -2,11 +2,13 @@ package workers
 
 import (
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/superplanehq/superplane/pkg/config"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
 	"github.com/superplanehq/superplane/pkg/models"
 	testconsumer "github.com/superplanehq/superplane/test/consumer"
@@ -128,6 +130,60 @@ func Test__EventRouter_ProcessExecutionEvent(t *testing.T) {
 	assert.True(t, queueConsumer.HasReceivedMessage())
 }
 
+func Test__EventRouter_TimeoutChannel(t *testing.T) {
+	amqpURL, _ := config.RabbitMQURL()
+
+	router := NewEventRouter(amqpURL)
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	trigger1 := "trigger-1"
+	node1 := "component-1"
+	onSuccess := "on-success"
+	onTimeout := "on-timeout"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: trigger1, Type: models.NodeTypeTrigger},
+			{NodeID: node1, Type: models.NodeTypeComponent, TimeoutSeconds: 60},
+			{NodeID: onSuccess, Type: models.NodeTypeComponent},
+			{NodeID: onTimeout, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: trigger1, TargetID: node1, Channel: "default"},
+			{SourceID: node1, TargetID: onSuccess, Channel: "default"},
+			{SourceID: node1, TargetID: onTimeout, Channel: models.CanvasNodeExecutionTimeoutChannel},
+		},
+	)
+
+	//
+	// Time out the execution of node1,
+	// and verify the timeout event only goes to the node connected to the timeout channel.
+	//
+	triggerEvent := support.EmitCanvasEventForNode(t, canvas.ID, trigger1, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, node1, triggerEvent.ID, triggerEvent.ID, nil)
+	events, err := execution.TimeoutInTransaction(database.Conn(), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	require.NoError(t, router.LockAndProcessEvent(logger, events[0]))
+
+	updatedEvent, err := models.FindCanvasEvent(events[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasEventStateRouted, updatedEvent.State)
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, onTimeout, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	assert.Equal(t, events[0].ID, queueItems[0].EventID)
+
+	queueItems, err = models.ListNodeQueueItems(canvas.ID, onSuccess, 10, nil)
+	require.NoError(t, err)
+	assert.Empty(t, queueItems)
+}
+
 func Test__EventRouter_CustomComponent_RespectsOutputChannels(t *testing.T) {
 	amqpURL, _ := config.RabbitMQURL()
 	router := NewEventRouter(amqpURL)
@@ -342,3 +398,165 @@ func filterEventsByChannel(events []models.CanvasEvent, channel string) []models
 	}
 	return filtered
 }
+
+func Test__EventRouter_LatestWinsQueuePolicy(t *testing.T) {
+	amqpURL, _ := config.RabbitMQURL()
+
+	router := NewEventRouter(amqpURL)
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	//
+	// Create a simple canvas with a trigger and a component node
+	// which only keeps the latest item in its queue.
+	//
+	node1 := "trigger-1"
+	node2 := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: node1, Type: models.NodeTypeTrigger},
+			{NodeID: node2, Type: models.NodeTypeComponent, QueuePolicy: models.QueuePolicyLatestWins},
+		},
+		[]models.Edge{
+			{SourceID: node1, TargetID: node2, Channel: "default"},
+		},
+	)
+
+	//
+	// Route two events, and verify only the latest one is kept in the queue.
+	//
+	event1 := support.EmitCanvasEventForNode(t, canvas.ID, node1, "default", nil)
+	require.NoError(t, router.LockAndProcessEvent(logger, *event1))
+	event2 := support.EmitCanvasEventForNode(t, canvas.ID, node1, "default", nil)
+	require.NoError(t, router.LockAndProcessEvent(logger, *event2))
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, node2, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	assert.Equal(t, event2.ID, queueItems[0].EventID)
+}
+
+func Test__EventRouter_RejectWhenBusyQueuePolicy(t *testing.T) {
+	amqpURL, _ := config.RabbitMQURL()
+
+	router := NewEventRouter(amqpURL)
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	//
+	// Create a simple canvas with a trigger and a component node
+	// which rejects new items while it is busy.
+	//
+	node1 := "trigger-1"
+	node2 := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: node1, Type: models.NodeTypeTrigger},
+			{NodeID: node2, Type: models.NodeTypeComponent, QueuePolicy: models.QueuePolicyRejectWhenBusy},
+		},
+		[]models.Edge{
+			{SourceID: node1, TargetID: node2, Channel: "default"},
+		},
+	)
+
+	//
+	// While the node is idle, the event is queued.
+	//
+	event1 := support.EmitCanvasEventForNode(t, canvas.ID, node1, "default", nil)
+	require.NoError(t, router.LockAndProcessEvent(logger, *event1))
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, node2, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	require.NoError(t, queueItems[0].Delete(database.Conn()))
+
+	//
+	// While the node has an execution in flight, the event is rejected,
+	// but still marked as routed.
+	//
+	support.CreateCanvasNodeExecution(t, canvas.ID, node2, event1.ID, event1.ID, nil)
+	event2 := support.EmitCanvasEventForNode(t, canvas.ID, node1, "default", nil)
+	require.NoError(t, router.LockAndProcessEvent(logger, *event2))
+
+	queueItems, err = models.ListNodeQueueItems(canvas.ID, node2, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, queueItems)
+
+	updatedEvent, err := models.FindCanvasEvent(event2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasEventStateRouted, updatedEvent.State)
+}
+
+func Test__EventRouter_EventIsMovedToDeadLettersAfterMaxAttempts(t *testing.T) {
+	amqpURL, _ := config.RabbitMQURL()
+
+	router := NewEventRouter(amqpURL)
+	logger := log.NewEntry(log.New())
+	r := support.Setup(t)
+
+	//
+	// Create a simple canvas with a trigger and a component node,
+	// and remove the component node, so events cannot be routed to it.
+	//
+	node1 := "trigger-1"
+	node2 := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{NodeID: node1, Type: models.NodeTypeTrigger},
+			{NodeID: node2, Type: models.NodeTypeComponent},
+		},
+		[]models.Edge{
+			{SourceID: node1, TargetID: node2, Channel: "default"},
+		},
+	)
+
+	err := database.Conn().
+		Where("workflow_id = ? AND node_id = ?", canvas.ID, node2).
+		Delete(&models.CanvasNode{}).
+		Error
+	require.NoError(t, err)
+
+	//
+	// Every failed attempt is recorded,
+	// but the event stays pending until the last one.
+	//
+	event := support.EmitCanvasEventForNode(t, canvas.ID, node1, "default", nil)
+	for range models.MaxProcessingAttempts - 1 {
+		require.Error(t, router.LockAndProcessEvent(logger, *event))
+	}
+
+	updatedEvent, err := models.FindCanvasEvent(event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasEventStatePending, updatedEvent.State)
+	assert.Equal(t, models.MaxProcessingAttempts-1, updatedEvent.Attempts)
+
+	//
+	// After the last attempt, the event is moved to dead letters.
+	//
+	require.Error(t, router.LockAndProcessEvent(logger, *event))
+
+	updatedEvent, err = models.FindCanvasEvent(event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasEventStateDeadLetter, updatedEvent.State)
+	assert.Equal(t, models.MaxProcessingAttempts, updatedEvent.Attempts)
+	require.NotNil(t, updatedEvent.FailureReason)
+	assert.Equal(t, "record not found", *updatedEvent.FailureReason)
+
+	//
+	// Dead-lettered events are no longer picked up for routing.
+	//
+	pendingEvents, err := models.ListPendingCanvasEvents()
+	require.NoError(t, err)
+	for _, pendingEvent := range pendingEvents {
+		assert.NotEqual(t, event.ID, pendingEvent.ID)
+	}
+}
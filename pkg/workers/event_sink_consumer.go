@@ -0,0 +1,213 @@
+package workers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/renderedtext/go-tackle"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/eventsinks"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/messages"
+	"github.com/superplanehq/superplane/pkg/logging"
+	"github.com/superplanehq/superplane/pkg/models"
+	pbCanvases "github.com/superplanehq/superplane/pkg/protos/canvases"
+	pbOrganizations "github.com/superplanehq/superplane/pkg/protos/organizations"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const EventSinkConnectionName = "superplane"
+
+// EventSinkConsumer adds the canvas events, execution state changes and audit events
+// published to RabbitMQ to the backlog of the event sink of their organization.
+// The EventSinkWorker delivers them.
+type EventSinkConsumer struct {
+	RabbitMQURL string
+	logger      *log.Entry
+}
+
+func NewEventSinkConsumer(rabbitMQURL string) *EventSinkConsumer {
+	return &EventSinkConsumer{
+		RabbitMQURL: rabbitMQURL,
+		logger:      log.WithFields(log.Fields{"consumer": "event_sink"}),
+	}
+}
+
+func (c *EventSinkConsumer) Start() {
+	routes := map[string]func(tackle.Delivery) error{
+		messages.WorkflowEventCreatedRoutingKey: c.ConsumeCanvasEvent,
+		messages.WorkflowExecutionRoutingKey:    c.ConsumeExecution,
+		messages.AuditEventCreatedRoutingKey:    c.ConsumeAuditEvent,
+	}
+
+	for routingKey, handler := range routes {
+		go c.consume(routingKey, handler)
+	}
+}
+
+func (c *EventSinkConsumer) consume(routingKey string, handler func(tackle.Delivery) error) {
+	options := tackle.Options{
+		URL:            c.RabbitMQURL,
+		ConnectionName: EventSinkConnectionName,
+		Service:        "superplane." + messages.WorkflowExchange + "." + routingKey + ".event-sink-consumer",
+		RemoteExchange: messages.WorkflowExchange,
+		RoutingKey:     routingKey,
+	}
+
+	for {
+		log.Infof("Connecting to RabbitMQ queue for %s events", routingKey)
+
+		consumer := tackle.NewConsumer()
+		consumer.SetLogger(logging.NewTackleLogger(c.logger.WithField("route_handler", routingKey)))
+
+		err := consumer.Start(&options, handler)
+		if err != nil {
+			c.logger.Errorf("Error consuming messages from %s: %v", routingKey, err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		c.logger.Warnf("Connection to RabbitMQ closed for %s, reconnecting...", routingKey)
+		time.Sleep(5 * time.Second)
+	}
+}
+
+func (c *EventSinkConsumer) ConsumeCanvasEvent(delivery tackle.Delivery) error {
+	data := &pbCanvases.CanvasNodeEventMessage{}
+	if err := proto.Unmarshal(delivery.Body(), data); err != nil {
+		c.logger.Errorf("Error unmarshaling canvas event message: %v", err)
+		return err
+	}
+
+	eventID, err := uuid.Parse(data.Id)
+	if err != nil {
+		c.logger.Errorf("Invalid canvas event ID %s: %v", data.Id, err)
+		return nil
+	}
+
+	event, err := models.FindCanvasEvent(eventID)
+	if err != nil {
+		return c.ignoreNotFound(err, "canvas event", data.Id)
+	}
+
+	canvas, sink, err := c.findCanvasSink(event.WorkflowID, models.EventSinkCategoryCanvasEvents)
+	if err != nil || sink == nil {
+		return err
+	}
+
+	return eventsinks.Enqueue(
+		sink,
+		eventsinks.EventTypeCanvasEventCreated,
+		"canvas-event:"+event.ID.String(),
+		eventsinks.CanvasEventData(canvas, event),
+	)
+}
+
+func (c *EventSinkConsumer) ConsumeExecution(delivery tackle.Delivery) error {
+	data := &pbCanvases.CanvasNodeExecutionMessage{}
+	if err := proto.Unmarshal(delivery.Body(), data); err != nil {
+		c.logger.Errorf("Error unmarshaling execution message: %v", err)
+		return err
+	}
+
+	canvasID, err := uuid.Parse(data.CanvasId)
+	if err != nil {
+		c.logger.Errorf("Invalid canvas ID %s: %v", data.CanvasId, err)
+		return nil
+	}
+
+	executionID, err := uuid.Parse(data.Id)
+	if err != nil {
+		c.logger.Errorf("Invalid execution ID %s: %v", data.Id, err)
+		return nil
+	}
+
+	canvas, sink, err := c.findCanvasSink(canvasID, models.EventSinkCategoryExecutions)
+	if err != nil || sink == nil {
+		return err
+	}
+
+	execution, err := models.FindNodeExecution(canvasID, executionID)
+	if err != nil {
+		return c.ignoreNotFound(err, "execution", data.Id)
+	}
+
+	return eventsinks.Enqueue(
+		sink,
+		eventsinks.EventTypeExecutionStateChanged,
+		eventsinks.ExecutionDedupKey(execution),
+		eventsinks.ExecutionData(canvas, execution),
+	)
+}
+
+func (c *EventSinkConsumer) ConsumeAuditEvent(delivery tackle.Delivery) error {
+	data := &pbOrganizations.AuditEventCreated{}
+	if err := proto.Unmarshal(delivery.Body(), data); err != nil {
+		c.logger.Errorf("Error unmarshaling audit event message: %v", err)
+		return err
+	}
+
+	organizationID, err := uuid.Parse(data.OrganizationId)
+	if err != nil {
+		c.logger.Errorf("Invalid organization ID %s: %v", data.OrganizationId, err)
+		return nil
+	}
+
+	auditEventID, err := uuid.Parse(data.AuditEventId)
+	if err != nil {
+		c.logger.Errorf("Invalid audit event ID %s: %v", data.AuditEventId, err)
+		return nil
+	}
+
+	sink, err := c.findSink(organizationID, models.EventSinkCategoryAuditEvents)
+	if err != nil || sink == nil {
+		return err
+	}
+
+	event, err := models.FindAuditEvent(organizationID, auditEventID)
+	if err != nil {
+		return c.ignoreNotFound(err, "audit event", data.AuditEventId)
+	}
+
+	return eventsinks.Enqueue(
+		sink,
+		eventsinks.EventTypeAuditEventRecorded,
+		"audit-event:"+event.ID.String(),
+		eventsinks.AuditEventData(event),
+	)
+}
+
+func (c *EventSinkConsumer) findCanvasSink(canvasID uuid.UUID, category string) (*models.Canvas, *models.EventSink, error) {
+	canvas, err := models.FindUnscopedCanvas(canvasID)
+	if err != nil {
+		return nil, nil, c.ignoreNotFound(err, "canvas", canvasID.String())
+	}
+
+	sink, err := c.findSink(canvas.OrganizationID, category)
+	return canvas, sink, err
+}
+
+// findSink returns the sink of the organization,
+// or nil if the organization does not stream this category of events.
+func (c *EventSinkConsumer) findSink(organizationID uuid.UUID, category string) (*models.EventSink, error) {
+	sink, err := models.FindEventSink(organizationID)
+	if err != nil {
+		return nil, c.ignoreNotFound(err, "event sink for organization", organizationID.String())
+	}
+
+	if !sink.Subscribes(category) {
+		return nil, nil
+	}
+
+	return sink, nil
+}
+
+func (c *EventSinkConsumer) ignoreNotFound(err error, resource string, id string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	c.logger.Errorf("Error finding %s %s: %v", resource, id, err)
+	return err
+}
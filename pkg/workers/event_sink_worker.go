@@ -0,0 +1,182 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/crypto"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/eventsinks"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/registry"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+const (
+	// Delivered events are kept for a while,
+	// so recent deliveries can still be inspected.
+	deliveredEventSinkDeliveriesRetention = 24 * time.Hour
+	eventSinkCleanupInterval              = time.Hour
+)
+
+// EventSinkWorker delivers the backlog of the event sinks,
+// retrying failed deliveries with an exponential backoff.
+type EventSinkWorker struct {
+	semaphore            *semaphore.Weighted
+	encryptor            crypto.Encryptor
+	httpCtx              *registry.HTTPContext
+	logger               *log.Entry
+	maxDeliveriesPerTick int
+	nowFunc              func() time.Time
+	lastCleanup          time.Time
+}
+
+func NewEventSinkWorker(encryptor crypto.Encryptor, httpCtx *registry.HTTPContext) *EventSinkWorker {
+	return &EventSinkWorker{
+		semaphore:            semaphore.NewWeighted(25),
+		encryptor:            encryptor,
+		httpCtx:              httpCtx,
+		logger:               log.WithFields(log.Fields{"worker": "EventSinkWorker"}),
+		maxDeliveriesPerTick: 500,
+		nowFunc:              time.Now,
+	}
+}
+
+func (w *EventSinkWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Tick(ctx)
+		}
+	}
+}
+
+func (w *EventSinkWorker) Tick(ctx context.Context) {
+	tickStart := time.Now()
+	deliveries, err := models.ListPendingEventSinkDeliveries(w.maxDeliveriesPerTick)
+	if err != nil {
+		w.logger.Errorf("Error finding pending event sink deliveries: %v", err)
+		return
+	}
+
+	telemetry.RecordEventSinkWorkerDeliveriesCount(ctx, len(deliveries))
+
+	failed := 0
+	results := make(chan error, len(deliveries))
+	for _, delivery := range deliveries {
+		if err := w.semaphore.Acquire(ctx, 1); err != nil {
+			w.logger.Errorf("Error acquiring semaphore: %v", err)
+			results <- err
+			continue
+		}
+
+		go func(delivery models.EventSinkDelivery) {
+			defer w.semaphore.Release(1)
+			results <- w.LockAndDeliver(ctx, delivery.ID)
+		}(delivery)
+	}
+
+	for range deliveries {
+		if err := <-results; err != nil {
+			failed++
+		}
+	}
+
+	telemetry.RecordEventSinkWorkerFailedCount(ctx, failed)
+	w.cleanup()
+	telemetry.RecordEventSinkWorkerTickDuration(ctx, time.Since(tickStart))
+}
+
+// LockAndDeliver sends one pending delivery to its sink,
+// and records the outcome of the attempt on the delivery and on the sink.
+func (w *EventSinkWorker) LockAndDeliver(ctx context.Context, id uuid.UUID) error {
+	var sink *models.EventSink
+	var sendErr error
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		delivery, err := models.LockEventSinkDelivery(tx, id)
+		if err != nil {
+			w.logger.Infof("Delivery %s already being processed - skipping", id)
+			return nil
+		}
+
+		sink, err = models.FindEventSinkByID(delivery.SinkID)
+		if err != nil {
+			return fmt.Errorf("error finding sink %s: %v", delivery.SinkID, err)
+		}
+
+		sendErr = w.send(ctx, sink, delivery)
+		now := w.nowFunc()
+		if sendErr == nil {
+			return delivery.MarkDeliveredInTransaction(tx, now)
+		}
+
+		nextAttemptAt := eventsinks.NextAttemptAt(now, delivery.Attempts+1)
+		if nextAttemptAt == nil {
+			w.logger.Warnf("Giving up on delivery %s to sink %s after %d attempts: %v", delivery.ID, sink.ID, delivery.Attempts+1, sendErr)
+		}
+
+		return delivery.MarkFailedInTransaction(tx, sendErr.Error(), nextAttemptAt)
+	})
+
+	if err != nil || sink == nil {
+		return err
+	}
+
+	if sendErr != nil {
+		if err := sink.RecordError(w.nowFunc(), sendErr.Error()); err != nil {
+			w.logger.Errorf("Error recording failure for sink %s: %v", sink.ID, err)
+		}
+
+		return sendErr
+	}
+
+	if err := sink.RecordDelivery(w.nowFunc()); err != nil {
+		w.logger.Errorf("Error recording delivery for sink %s: %v", sink.ID, err)
+	}
+
+	return nil
+}
+
+func (w *EventSinkWorker) send(ctx context.Context, sink *models.EventSink, delivery *models.EventSinkDelivery) error {
+	secret := []byte{}
+	if len(sink.SecretCiphertext) > 0 {
+		decrypted, err := w.encryptor.Decrypt(ctx, sink.SecretCiphertext, []byte(sink.OrganizationID.String()))
+		if err != nil {
+			return fmt.Errorf("error decrypting sink secret: %v", err)
+		}
+
+		secret = decrypted
+	}
+
+	return eventsinks.Send(ctx, w.httpCtx, sink, secret, delivery)
+}
+
+func (w *EventSinkWorker) cleanup() {
+	now := w.nowFunc()
+	if now.Sub(w.lastCleanup) < eventSinkCleanupInterval {
+		return
+	}
+
+	w.lastCleanup = now
+	deleted, err := models.DeleteDeliveredEventSinkDeliveries(now.Add(-deliveredEventSinkDeliveriesRetention))
+	if err != nil {
+		w.logger.Errorf("Error deleting delivered event sink deliveries: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		w.logger.Infof("Deleted %d delivered event sink deliveries", deleted)
+	}
+}
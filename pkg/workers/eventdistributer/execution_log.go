@@ -0,0 +1,65 @@
+package eventdistributer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/grpc/actions/canvases"
+	"github.com/superplanehq/superplane/pkg/models"
+	pb "github.com/superplanehq/superplane/pkg/protos/canvases"
+	"github.com/superplanehq/superplane/pkg/public/ws"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const ExecutionLogEvent = "execution_log"
+
+func HandleCanvasExecutionLog(messageBody []byte, wsHub *ws.Hub) error {
+	log.Debugf("Received execution log event")
+
+	pbMsg := &pb.CanvasNodeExecutionLogMessage{}
+	if err := proto.Unmarshal(messageBody, pbMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal execution log event: %w", err)
+	}
+
+	workflowID, err := uuid.Parse(pbMsg.CanvasId)
+	if err != nil {
+		return fmt.Errorf("failed to parse workflow id: %w", err)
+	}
+
+	logID, err := uuid.Parse(pbMsg.Id)
+	if err != nil {
+		return fmt.Errorf("failed to parse log id: %w", err)
+	}
+
+	executionLog, err := models.FindNodeExecutionLog(workflowID, logID)
+	if err != nil {
+		return fmt.Errorf("failed to find execution log: %w", err)
+	}
+
+	serialized, err := canvases.SerializeNodeExecutionLog(executionLog)
+	if err != nil {
+		return fmt.Errorf("failed to serialize execution log: %w", err)
+	}
+
+	serializedJSON, err := protojson.Marshal(serialized)
+	if err != nil {
+		return fmt.Errorf("failed to marshal execution log: %w", err)
+	}
+
+	event, err := json.Marshal(ExecutionStateWebsocketEvent{
+		Event:   ExecutionLogEvent,
+		Payload: json.RawMessage(serializedJSON),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to marshal websocket event: %w", err)
+	}
+
+	wsHub.BroadcastToWorkflow(pbMsg.CanvasId, event)
+	log.Debugf("Broadcasted %s event to workflow %s", ExecutionLogEvent, pbMsg.CanvasId)
+
+	return nil
+}
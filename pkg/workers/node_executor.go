@@ -189,11 +189,15 @@ func (w *NodeExecutor) LockAndProcessNodeExecution(id uuid.UUID) error {
 		//
 		// Note: We use SKIP LOCKED to avoid waiting on locked records.
 		//
+		// Executions for retry attempts are only processed
+		// once the delay defined by the node retry policy is over.
+		//
 
 		err := tx.
 			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
 			Where("id = ?", id).
 			Where("state = ?", models.CanvasNodeExecutionStatePending).
+			Where("run_at IS NULL OR run_at <= ?", time.Now()).
 			First(&execution).
 			Error
 
@@ -231,7 +235,7 @@ func (w *NodeExecutor) processNodeExecution(tx *gorm.DB, execution *models.Canva
 
 func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
 	ref := node.Ref.Data()
-	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, ref.Blueprint.ID)
+	blueprint, err := models.FindBlueprintForRefInTransaction(tx, ref.Blueprint)
 	if err != nil {
 		return execution.FailInTransaction(tx, models.CanvasNodeExecutionResultReasonError, "failed to find blueprint")
 	}
@@ -295,8 +299,23 @@ func (w *NodeExecutor) executeBlueprintNode(tx *gorm.DB, execution *models.Canva
 	}
 
 	err = execution.StartInTransaction(tx)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return w.scheduleTimeout(tx, execution, node)
+}
+
+// scheduleTimeout creates a request to time out the execution,
+// if its node has a timeout. If the execution finishes before
+// the request runs, the request is just completed.
+func (w *NodeExecutor) scheduleTimeout(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+	if node.TimeoutSeconds <= 0 {
+		return nil
+	}
+
+	runAt := time.Now().Add(time.Duration(node.TimeoutSeconds) * time.Second)
+	return execution.CreateRequest(tx, models.NodeRequestTypeTimeout, models.NodeExecutionRequestSpec{}, &runAt)
 }
 
 func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node models.Node) ([]configuration.Field, error) {
@@ -314,7 +333,7 @@ func (w *NodeExecutor) configurationFieldsForBlueprintNode(tx *gorm.DB, node mod
 		}
 		return trigger.Configuration(), nil
 	case node.Ref.Blueprint != nil && node.Ref.Blueprint.ID != "":
-		blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, node.Ref.Blueprint.ID)
+		blueprint, err := models.FindBlueprintForRefInTransaction(tx, node.Ref.Blueprint)
 		if err != nil {
 			return nil, fmt.Errorf("blueprint %s not found: %w", node.Ref.Blueprint.ID, err)
 		}
@@ -337,6 +356,12 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		return fmt.Errorf("failed to start execution: %w", err)
 	}
 
+	err = w.scheduleTimeout(tx, execution, node)
+	if err != nil {
+		logger.Errorf("failed to schedule execution timeout: %v", err)
+		return fmt.Errorf("failed to schedule execution timeout: %w", err)
+	}
+
 	ref := node.Ref.Data()
 	component, err := w.registry.GetComponent(ref.Component.Name)
 	if err != nil {
@@ -376,6 +401,9 @@ func (w *NodeExecutor) executeComponentNode(tx *gorm.DB, execution *models.Canva
 		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
 		CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
+		Canvases:       contexts.NewCanvasRunContext(tx, workflow.OrganizationID, execution.WorkflowID, onNewEvents),
+		Downstream:     contexts.NewDownstreamContext(tx, execution.WorkflowID),
+		Logs:           contexts.NewExecutionLogContext(execution),
 		Webhook:        contexts.NewNodeWebhookContext(context.Background(), tx, w.encryptor, node, w.webhookBaseURL),
 	}
 	ctx.ExpressionEnv = func(expression string) (map[string]any, error) {
@@ -3,9 +3,11 @@ package workers
 import (
 	"log"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
 	"github.com/superplanehq/superplane/pkg/models"
 	"github.com/superplanehq/superplane/test/support"
 	"gorm.io/datatypes"
@@ -386,6 +388,116 @@ func Test__NodeExecutor_BlueprintNodeExecutionFailsWhenConfigurationCannotBeBuil
 	assert.Contains(t, failedExecution.ResultMessage, "error building configuration for execution of node")
 }
 
+func Test__NodeExecutor_FailedExecutionIsRetriedWithRetryPolicy(t *testing.T) {
+	r := support.Setup(t)
+
+	//
+	// Create a blueprint whose configuration cannot be built,
+	// so every execution of the blueprint node fails with an error.
+	//
+	blueprint := support.CreateBlueprint(
+		t,
+		r.Organization.ID,
+		[]models.Node{
+			{
+				ID:            "noop1",
+				Type:          models.NodeTypeComponent,
+				Ref:           models.NodeRef{Component: &models.ComponentRef{Name: "noop"}},
+				Configuration: map[string]any{"invalid_field": "{{ .nonexistent_variable }}"},
+			},
+		},
+		[]models.Edge{},
+		[]models.BlueprintOutputChannel{
+			{
+				Name:              "default",
+				NodeID:            "noop1",
+				NodeOutputChannel: "default",
+			},
+		},
+	)
+
+	//
+	// Create a canvas with a trigger and a blueprint node with a retry policy.
+	//
+	triggerNode := "trigger-1"
+	blueprintNode := "blueprint-1"
+	retryPolicy := datatypes.NewJSONType(models.RetryPolicy{
+		MaxAttempts:  2,
+		Backoff:      models.RetryBackoffFixed,
+		DelaySeconds: 60,
+	})
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:      blueprintNode,
+				Type:        models.NodeTypeBlueprint,
+				Ref:         datatypes.NewJSONType(models.NodeRef{Blueprint: &models.BlueprintRef{ID: blueprint.ID.String()}}),
+				RetryPolicy: &retryPolicy,
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: blueprintNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, blueprintNode, rootEvent.ID, rootEvent.ID, nil)
+
+	//
+	// Process the execution and verify it fails,
+	// and that a new pending attempt is scheduled for later.
+	//
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost", "")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	failedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, failedExecution.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, failedExecution.ResultReason)
+	assert.Equal(t, 1, failedExecution.Attempt)
+
+	var retry models.CanvasNodeExecution
+	require.NoError(t, database.Conn().Where("retry_of_execution_id = ?", execution.ID).First(&retry).Error)
+	assert.Equal(t, models.CanvasNodeExecutionStatePending, retry.State)
+	assert.Equal(t, 2, retry.Attempt)
+	assert.Equal(t, execution.EventID, retry.EventID)
+	require.NotNil(t, retry.RunAt)
+	assert.True(t, retry.RunAt.After(time.Now().Add(50*time.Second)))
+
+	//
+	// The retry is not picked up before its delay is over.
+	//
+	require.ErrorIs(t, executor.LockAndProcessNodeExecution(retry.ID), ErrRecordLocked)
+	retryExecution, err := models.FindNodeExecution(canvas.ID, retry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStatePending, retryExecution.State)
+
+	//
+	// Once the delay is over, the retry is processed and fails again.
+	// Since the max attempts were reached, no new attempt is scheduled.
+	//
+	require.NoError(t, database.Conn().Model(&retry).Update("run_at", time.Now().Add(-time.Second)).Error)
+	require.NoError(t, executor.LockAndProcessNodeExecution(retry.ID))
+
+	retryExecution, err = models.FindNodeExecution(canvas.ID, retry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, retryExecution.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, retryExecution.ResultReason)
+
+	var count int64
+	require.NoError(t, database.Conn().Model(&models.CanvasNodeExecution{}).Where("retry_of_execution_id = ?", retry.ID).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+}
+
 func countConcurrentExecutionResults(t *testing.T, results []error) (successCount int, lockedCount int) {
 	for i, result := range results {
 		switch result {
@@ -180,35 +180,55 @@ func (w *NodeQueueWorker) LockAndProcessNode(logger *log.Entry, node models.Canv
 		return err
 	})
 
-	if err == nil {
-		if len(executionIDs) > 0 {
-			for _, executionID := range executionIDs {
-				if executionID == nil {
-					continue
-				}
+	if err != nil {
+		if queueItem != nil {
+			w.recordFailure(logger, *queueItem, err)
+		}
 
-				messages.NewCanvasExecutionMessage(
-					node.WorkflowID.String(),
-					executionID.String(),
-					node.NodeID,
-				).Publish()
+		return err
+	}
+
+	if len(executionIDs) > 0 {
+		for _, executionID := range executionIDs {
+			if executionID == nil {
+				continue
 			}
-		}
 
-		if queueItem != nil {
-			messages.NewCanvasQueueItemMessage(
-				queueItem.WorkflowID.String(),
-				queueItem.ID.String(),
-				queueItem.NodeID,
-			).Publish(true)
+			messages.NewCanvasExecutionMessage(
+				node.WorkflowID.String(),
+				executionID.String(),
+				node.NodeID,
+			).Publish()
 		}
+	}
 
-		for _, event := range newEvents {
-			messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
-		}
+	if queueItem != nil {
+		messages.NewCanvasQueueItemMessage(
+			queueItem.WorkflowID.String(),
+			queueItem.ID.String(),
+			queueItem.NodeID,
+		).Publish(true)
+	}
+
+	for _, event := range newEvents {
+		messages.NewCanvasEventCreatedMessage(event.WorkflowID.String(), &event).Publish()
+	}
+
+	return nil
+}
+
+// recordFailure keeps track of queue items that cannot be processed,
+// so they are moved to the dead-letter state instead of
+// being retried forever.
+func (w *NodeQueueWorker) recordFailure(logger *log.Entry, queueItem models.CanvasNodeQueueItem, processingErr error) {
+	if err := queueItem.RecordFailure(processingErr.Error()); err != nil {
+		logger.Errorf("Error recording failure for queue item %s: %v", queueItem.ID, err)
+		return
 	}
 
-	return err
+	if queueItem.State == models.CanvasNodeQueueItemStateDeadLetter {
+		logger.Warnf("Queue item %s moved to dead letters after %d attempts: %v", queueItem.ID, queueItem.Attempts, processingErr)
+	}
 }
 
 func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *models.CanvasNode, onNewEvents func([]models.CanvasEvent)) ([]*uuid.UUID, *models.CanvasNodeQueueItem, error) {
@@ -226,7 +246,7 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 
 	configFields, err := w.configurationFieldsForNode(tx, node)
 	if err != nil {
-		return nil, nil, err
+		return nil, queueItem, err
 	}
 
 	ctx, err := contexts.BuildProcessQueueContext(w.registry.HTTPContext(), tx, node, queueItem, configFields, onNewEvents)
@@ -238,7 +258,7 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		//
 		var configErr *contexts.ConfigurationBuildError
 		if !errors.As(err, &configErr) {
-			return nil, nil, err
+			return nil, queueItem, err
 		}
 
 		//
@@ -252,7 +272,7 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		logger.Errorf("Error building configuration for node execution: %v", configErr.Error())
 		executions, err := w.handleNodeConfigurationError(tx, logger, configErr)
 		if err != nil {
-			return nil, nil, err
+			return nil, queueItem, err
 		}
 
 		return executions, queueItem, nil
@@ -273,7 +293,7 @@ func (w *NodeQueueWorker) processNode(tx *gorm.DB, logger *log.Entry, node *mode
 		 */
 		executionID, err = ctx.DefaultProcessing()
 	default:
-		return nil, nil, fmt.Errorf("unsupported node type: %s", node.Type)
+		return nil, queueItem, fmt.Errorf("unsupported node type: %s", node.Type)
 	}
 
 	return []*uuid.UUID{executionID}, queueItem, err
@@ -298,7 +318,7 @@ func (w *NodeQueueWorker) configurationFieldsForNode(tx *gorm.DB, node *models.C
 			return nil, fmt.Errorf("node %s has no blueprint reference", node.NodeID)
 		}
 
-		blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, ref.Blueprint.ID)
+		blueprint, err := models.FindBlueprintForRefInTransaction(tx, ref.Blueprint)
 		if err != nil {
 			return nil, fmt.Errorf("blueprint %s not found: %w", ref.Blueprint.ID, err)
 		}
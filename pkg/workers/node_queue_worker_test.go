@@ -811,3 +811,214 @@ func Test__WorkflowNodeQueueWorker_ConfigurationBuildFailure_PropagateToParent(t
 	assert.Equal(t, models.CanvasNodeExecutionResultFailed, updatedParent.Result)
 	assert.Equal(t, models.CanvasNodeExecutionResultReasonError, updatedParent.ResultReason)
 }
+
+func Test__NodeQueueWorker_RespectsMaxConcurrency(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewNodeQueueWorker(r.Registry, amqpURL)
+	logger := log.NewEntry(log.New())
+
+	//
+	// Create a simple canvas with a trigger and a component node
+	// which can have two executions in flight at the same time.
+	//
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:         componentNode,
+				Type:           models.NodeTypeComponent,
+				Ref:            datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				MaxConcurrency: 2,
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	for range 3 {
+		rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+		support.CreateQueueItem(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID)
+	}
+
+	//
+	// After the first execution is created, the node is still ready.
+	//
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+	node, err = models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateReady, node.State)
+
+	//
+	// After the second execution is created, the node reaches its limit,
+	// and the last item stays in the queue.
+	//
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+	node, err = models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeStateProcessing, node.State)
+
+	executions, err := models.ListNodeExecutions(canvas.ID, componentNode, nil, nil, 10, nil)
+	require.NoError(t, err)
+	assert.Len(t, executions, 2)
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+	require.NoError(t, err)
+	assert.Len(t, queueItems, 1)
+}
+
+func Test__NodeQueueWorker_RerunQueueItemReferencesOriginalExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewNodeQueueWorker(r.Registry, amqpURL)
+	logger := log.NewEntry(log.New())
+
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	//
+	// Finish an execution and put its input back in the queue as a rerun.
+	//
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	original := support.CreateCanvasNodeExecution(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID, nil)
+	_, err := original.Pass(map[string][]any{"default": {map[string]any{}}})
+	require.NoError(t, err)
+
+	now := time.Now()
+	queueItem := models.CanvasNodeQueueItem{
+		WorkflowID:         canvas.ID,
+		NodeID:             componentNode,
+		RootEventID:        rootEvent.ID,
+		EventID:            rootEvent.ID,
+		RerunOfExecutionID: &original.ID,
+		CreatedAt:          &now,
+	}
+	require.NoError(t, database.Conn().Create(&queueItem).Error)
+
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+	require.NoError(t, worker.LockAndProcessNode(logger, *node))
+
+	//
+	// The new execution belongs to the same root event,
+	// and references the execution it re-runs.
+	//
+	executions, err := models.ListNodeExecutions(canvas.ID, componentNode, []string{models.CanvasNodeExecutionStatePending}, nil, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, rootEvent.ID, executions[0].RootEventID)
+	assert.Equal(t, rootEvent.ID, executions[0].EventID)
+	require.NotNil(t, executions[0].RerunOfExecutionID)
+	assert.Equal(t, original.ID, *executions[0].RerunOfExecutionID)
+}
+
+func Test__NodeQueueWorker_QueueItemIsMovedToDeadLettersAfterMaxAttempts(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	amqpURL, _ := config.RabbitMQURL()
+	worker := NewNodeQueueWorker(r.Registry, amqpURL)
+	logger := log.NewEntry(log.New())
+
+	//
+	// Create a simple canvas with a trigger and a component node
+	// referencing a component that does not exist.
+	//
+	triggerNode := "trigger-1"
+	componentNode := "component-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID: componentNode,
+				Type:   models.NodeTypeComponent,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "does-not-exist"}}),
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: componentNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	queueItem := support.CreateQueueItem(t, canvas.ID, componentNode, rootEvent.ID, rootEvent.ID)
+	node, err := models.FindCanvasNode(database.Conn(), canvas.ID, componentNode)
+	require.NoError(t, err)
+
+	//
+	// Every failed attempt is recorded,
+	// but the item stays in the queue until the last one.
+	//
+	for range models.MaxProcessingAttempts - 1 {
+		require.Error(t, worker.LockAndProcessNode(logger, *node))
+	}
+
+	queueItems, err := models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, queueItems, 1)
+	assert.Equal(t, models.MaxProcessingAttempts-1, queueItems[0].Attempts)
+
+	//
+	// After the last attempt, the item is moved to dead letters,
+	// and is no longer part of the queue.
+	//
+	require.Error(t, worker.LockAndProcessNode(logger, *node))
+
+	queueItems, err = models.ListNodeQueueItems(canvas.ID, componentNode, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, queueItems)
+
+	deadLetters, err := models.ListDeadLetterQueueItems(canvas.ID, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, queueItem.ID, deadLetters[0].ID)
+	assert.Equal(t, models.CanvasNodeQueueItemStateDeadLetter, deadLetters[0].State)
+	assert.Equal(t, models.MaxProcessingAttempts, deadLetters[0].Attempts)
+	require.NotNil(t, deadLetters[0].FailureReason)
+	assert.Contains(t, *deadLetters[0].FailureReason, "component does-not-exist not found")
+}
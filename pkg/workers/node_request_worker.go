@@ -112,6 +112,8 @@ func (w *NodeRequestWorker) processRequest(tx *gorm.DB, request *models.CanvasNo
 	switch request.Type {
 	case models.NodeRequestTypeInvokeAction:
 		return w.invokeAction(tx, request, onNewEvents)
+	case models.NodeRequestTypeTimeout:
+		return w.timeoutExecution(tx, request, onNewEvents)
 	}
 
 	return fmt.Errorf("unsupported node execution request type %s", request.Type)
@@ -325,6 +327,9 @@ func (w *NodeRequestWorker) invokeParentNodeComponentAction(
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, node.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Canvases:       contexts.NewCanvasRunContext(tx, workflow.OrganizationID, execution.WorkflowID, onNewEvents),
+		Downstream:     contexts.NewDownstreamContext(tx, execution.WorkflowID),
+		Logs:           contexts.NewExecutionLogContext(execution),
 	}
 
 	if node.AppInstallationID != nil {
@@ -367,7 +372,7 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 		return fmt.Errorf("node not found: %w", err)
 	}
 
-	blueprint, err := models.FindUnscopedBlueprintInTransaction(tx, parentNode.Ref.Data().Blueprint.ID)
+	blueprint, err := models.FindBlueprintForRefInTransaction(tx, parentNode.Ref.Data().Blueprint)
 	if err != nil {
 		return fmt.Errorf("blueprint not found: %w", err)
 	}
@@ -411,6 +416,9 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 		Notifications:  contexts.NewNotificationContext(tx, uuid.Nil, execution.WorkflowID),
 		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
 		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		Canvases:       contexts.NewCanvasRunContext(tx, workflow.OrganizationID, execution.WorkflowID, onNewEvents),
+		Downstream:     contexts.NewDownstreamContext(tx, execution.WorkflowID),
+		Logs:           contexts.NewExecutionLogContext(execution),
 	}
 
 	err = component.HandleAction(actionCtx)
@@ -426,6 +434,137 @@ func (w *NodeRequestWorker) invokeChildNodeComponentAction(
 	return request.Complete(tx)
 }
 
+func (w *NodeRequestWorker) timeoutExecution(tx *gorm.DB, request *models.CanvasNodeRequest, onNewEvents func([]models.CanvasEvent)) error {
+	if request.ExecutionID == nil {
+		return fmt.Errorf("timeout request %s has no execution", request.ID)
+	}
+
+	execution, err := models.FindNodeExecutionInTransaction(tx, request.WorkflowID, *request.ExecutionID)
+	if err != nil {
+		return fmt.Errorf("execution %s not found: %w", request.ExecutionID, err)
+	}
+
+	//
+	// If the execution finished before the timeout, there is nothing to do.
+	//
+	if execution.State != models.CanvasNodeExecutionStateStarted {
+		return request.Complete(tx)
+	}
+
+	node, err := models.FindCanvasNode(tx, execution.WorkflowID, execution.NodeID)
+	if err != nil {
+		return fmt.Errorf("node not found: %w", err)
+	}
+
+	err = w.cancelTimedOutExecution(tx, execution, node)
+	if err != nil {
+		return err
+	}
+
+	events, err := execution.TimeoutInTransaction(tx, time.Duration(node.TimeoutSeconds)*time.Second)
+	if err != nil {
+		return fmt.Errorf("error timing out execution: %w", err)
+	}
+
+	onNewEvents(events)
+	return request.Complete(tx)
+}
+
+// cancelTimedOutExecution gives the component a chance to stop
+// whatever it started in external systems before the execution
+// is finished. For blueprint nodes, the child executions
+// which are still running are cancelled.
+func (w *NodeRequestWorker) cancelTimedOutExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+	if node.Type == models.NodeTypeBlueprint {
+		childExecutions, err := models.FindChildExecutionsInTransaction(
+			tx,
+			execution.ID,
+			[]string{models.CanvasNodeExecutionStatePending, models.CanvasNodeExecutionStateStarted},
+		)
+
+		if err != nil {
+			return fmt.Errorf("error finding child executions: %w", err)
+		}
+
+		for _, childExecution := range childExecutions {
+			childNode, err := models.FindCanvasNode(tx, childExecution.WorkflowID, childExecution.NodeID)
+			if err != nil {
+				return fmt.Errorf("child node %s not found: %w", childExecution.NodeID, err)
+			}
+
+			if err := w.cancelComponentExecution(tx, &childExecution, childNode); err != nil {
+				return err
+			}
+
+			if err := childExecution.CancelInTransaction(tx, nil); err != nil {
+				return fmt.Errorf("error cancelling child execution %s: %w", childExecution.ID, err)
+			}
+		}
+
+		return nil
+	}
+
+	return w.cancelComponentExecution(tx, execution, node)
+}
+
+func (w *NodeRequestWorker) cancelComponentExecution(tx *gorm.DB, execution *models.CanvasNodeExecution, node *models.CanvasNode) error {
+	ref := node.Ref.Data()
+	if node.Type != models.NodeTypeComponent || ref.Component == nil {
+		return nil
+	}
+
+	component, err := w.registry.GetComponent(ref.Component.Name)
+	if err != nil {
+		return fmt.Errorf("component not found: %w", err)
+	}
+
+	workflow, err := models.FindCanvasWithoutOrgScopeInTransaction(tx, execution.WorkflowID)
+	if err != nil {
+		return fmt.Errorf("workflow not found: %w", err)
+	}
+
+	logger := logging.ForExecution(execution, nil)
+	ctx := core.ExecutionContext{
+		ID:             execution.ID,
+		WorkflowID:     execution.WorkflowID.String(),
+		OrganizationID: workflow.OrganizationID.String(),
+		NodeID:         execution.NodeID,
+		Configuration:  execution.Configuration.Data(),
+		HTTP:           w.registry.HTTPContext(),
+		Metadata:       contexts.NewExecutionMetadataContext(tx, execution),
+		ExecutionState: contexts.NewExecutionStateContext(tx, execution, nil),
+		Requests:       contexts.NewExecutionRequestContext(tx, execution),
+		Auth:           contexts.NewAuthContext(tx, workflow.OrganizationID, nil, nil),
+		Notifications:  contexts.NewNotificationContext(tx, workflow.OrganizationID, execution.WorkflowID),
+		Secrets:        contexts.NewSecretsContext(tx, workflow.OrganizationID, w.encryptor),
+		CanvasMemory:   contexts.NewCanvasMemoryContext(tx, execution.WorkflowID),
+		Logs:           contexts.NewExecutionLogContext(execution),
+	}
+
+	if node.AppInstallationID != nil {
+		instance, err := models.FindUnscopedIntegrationInTransaction(tx, *node.AppInstallationID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to find integration: %v", err)
+		}
+
+		if instance != nil {
+			logger = logging.WithIntegration(logger, *instance)
+			ctx.Integration = contexts.NewIntegrationContext(tx, node, instance, w.encryptor, w.registry, nil)
+		}
+	}
+
+	//
+	// Errors from the component are only logged,
+	// since the execution is finished either way.
+	//
+	ctx.Logger = logger
+	if err := component.Cancel(ctx); err != nil {
+		logger.Errorf("failed to cancel timed out execution: %v", err)
+	}
+
+	return nil
+}
+
 func (w *NodeRequestWorker) log(format string, v ...any) {
 	log.Printf("[NodeRequestWorker] "+format, v...)
 }
@@ -2,6 +2,7 @@ package workers
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -607,3 +608,141 @@ func Test__NodeRequestWorker_DoesNotProcessDeletedWorkflowRequests(t *testing.T)
 
 	assert.False(t, executionConsumer.HasReceivedMessage())
 }
+
+func Test__NodeRequestWorker_TimeoutExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	//
+	// Create a simple canvas with a trigger and an approval node with a timeout.
+	// The approval component does not finish the execution on Execute(),
+	// so the execution stays in started state until the timeout.
+	//
+	triggerNode := "trigger-1"
+	approvalNode := "approval-1"
+	approvalConfiguration := map[string]any{
+		"items": []any{
+			map[string]any{
+				"type": "user",
+				"user": r.User.String(),
+			},
+		},
+	}
+
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:         approvalNode,
+				Type:           models.NodeTypeComponent,
+				Ref:            datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "approval"}}),
+				Configuration:  datatypes.NewJSONType(approvalConfiguration),
+				TimeoutSeconds: 60,
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: approvalNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateNodeExecutionWithConfiguration(t, canvas.ID, approvalNode, rootEvent.ID, rootEvent.ID, nil, approvalConfiguration)
+
+	//
+	// Starting the execution schedules a timeout request for it.
+	//
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost", "")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	var request models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", execution.ID).First(&request).Error)
+	assert.Equal(t, models.NodeRequestTypeTimeout, request.Type)
+	assert.Equal(t, models.NodeExecutionRequestStatePending, request.State)
+	assert.True(t, request.RunAt.After(time.Now().Add(50*time.Second)))
+
+	//
+	// Process the timeout request and verify the execution is timed out,
+	// and an event is emitted on the timeout channel.
+	//
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	timedOutExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionStateFinished, timedOutExecution.State)
+	assert.Equal(t, models.CanvasNodeExecutionResultFailed, timedOutExecution.Result)
+	assert.Equal(t, models.CanvasNodeExecutionResultReasonTimedOut, timedOutExecution.ResultReason)
+	assert.Equal(t, "execution timed out after 1m0s", timedOutExecution.ResultMessage)
+
+	outputs, err := timedOutExecution.GetOutputs()
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, models.CanvasNodeExecutionTimeoutChannel, outputs[0].Channel)
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
+
+func Test__NodeRequestWorker_TimeoutForFinishedExecution(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	//
+	// Create a simple canvas with a trigger and a noop node with a timeout.
+	// The noop component finishes the execution right away.
+	//
+	triggerNode := "trigger-1"
+	noopNode := "noop-1"
+	canvas, _ := support.CreateCanvas(
+		t,
+		r.Organization.ID,
+		r.User,
+		[]models.CanvasNode{
+			{
+				NodeID: triggerNode,
+				Type:   models.NodeTypeTrigger,
+				Ref:    datatypes.NewJSONType(models.NodeRef{Trigger: &models.TriggerRef{Name: "start"}}),
+			},
+			{
+				NodeID:         noopNode,
+				Type:           models.NodeTypeComponent,
+				Ref:            datatypes.NewJSONType(models.NodeRef{Component: &models.ComponentRef{Name: "noop"}}),
+				TimeoutSeconds: 60,
+			},
+		},
+		[]models.Edge{
+			{SourceID: triggerNode, TargetID: noopNode, Channel: "default"},
+		},
+	)
+
+	rootEvent := support.EmitCanvasEventForNode(t, canvas.ID, triggerNode, "default", nil)
+	execution := support.CreateCanvasNodeExecution(t, canvas.ID, noopNode, rootEvent.ID, rootEvent.ID, nil)
+
+	executor := NewNodeExecutor(r.Encryptor, r.Registry, "http://localhost", "http://localhost", "")
+	require.NoError(t, executor.LockAndProcessNodeExecution(execution.ID))
+
+	var request models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("execution_id = ?", execution.ID).First(&request).Error)
+
+	//
+	// Process the timeout request and verify the execution is untouched.
+	//
+	worker := NewNodeRequestWorker(r.Encryptor, r.Registry, "")
+	require.NoError(t, worker.LockAndProcessRequest(request))
+
+	finishedExecution, err := models.FindNodeExecution(canvas.ID, execution.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.CanvasNodeExecutionResultPassed, finishedExecution.Result)
+
+	var updatedRequest models.CanvasNodeRequest
+	require.NoError(t, database.Conn().Where("id = ?", request.ID).First(&updatedRequest).Error)
+	assert.Equal(t, models.NodeExecutionRequestStateCompleted, updatedRequest.State)
+}
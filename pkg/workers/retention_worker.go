@@ -0,0 +1,257 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/semaphore"
+	"gorm.io/gorm"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/pkg/telemetry"
+)
+
+// RetentionWorker deletes the history of canvases
+// which is not retained by their retention policy anymore.
+type RetentionWorker struct {
+	semaphore           *semaphore.Weighted
+	logger              *log.Entry
+	maxResourcesPerTick int
+	nowFunc             func() time.Time
+}
+
+type RetentionResult struct {
+	Executions int
+	Events     int
+	QueueItems int
+}
+
+func (r *RetentionResult) Total() int {
+	return r.Executions + r.Events + r.QueueItems
+}
+
+func NewRetentionWorker() *RetentionWorker {
+	return &RetentionWorker{
+		semaphore:           semaphore.NewWeighted(25),
+		logger:              log.WithFields(log.Fields{"worker": "RetentionWorker"}),
+		maxResourcesPerTick: 500,
+		nowFunc:             time.Now,
+	}
+}
+
+func (w *RetentionWorker) Start(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tickStart := time.Now()
+			canvases, err := models.ListCanvasesWithRetentionPolicy()
+			if err != nil {
+				w.logger.Errorf("Error finding canvases with retention policies: %v", err)
+				continue
+			}
+
+			results := make(chan RetentionResult, len(canvases))
+			for _, canvas := range canvases {
+				if err := w.semaphore.Acquire(context.Background(), 1); err != nil {
+					w.logger.Errorf("Error acquiring semaphore: %v", err)
+					continue
+				}
+
+				go func(canvas models.Canvas) {
+					defer w.semaphore.Release(1)
+
+					result, err := w.LockAndProcessCanvas(canvas)
+					if err != nil {
+						w.logger.Errorf("Error processing canvas %s: %v", canvas.ID, err)
+					}
+
+					results <- result
+				}(canvas)
+			}
+
+			//
+			// Wait for every canvas to be processed,
+			// so the deleted counts are recorded per tick.
+			//
+			if err := w.semaphore.Acquire(context.Background(), 25); err != nil {
+				w.logger.Errorf("Error acquiring semaphore: %v", err)
+				continue
+			}
+
+			w.semaphore.Release(25)
+			close(results)
+
+			total := RetentionResult{}
+			for result := range results {
+				total.Executions += result.Executions
+				total.Events += result.Events
+				total.QueueItems += result.QueueItems
+			}
+
+			telemetry.RecordRetentionWorkerDeletedExecutions(context.Background(), total.Executions)
+			telemetry.RecordRetentionWorkerDeletedEvents(context.Background(), total.Events)
+			telemetry.RecordRetentionWorkerDeletedQueueItems(context.Background(), total.QueueItems)
+			telemetry.RecordRetentionWorkerTickDuration(context.Background(), time.Since(tickStart))
+		}
+	}
+}
+
+func (w *RetentionWorker) LockAndProcessCanvas(canvas models.Canvas) (RetentionResult, error) {
+	result := RetentionResult{}
+
+	err := database.Conn().Transaction(func(tx *gorm.DB) error {
+		lockedCanvas, err := models.LockCanvasForRetention(tx, canvas.ID)
+		if err != nil {
+			w.logger.Infof("Canvas %s already being processed - skipping", canvas.ID)
+			return nil
+		}
+
+		result, err = w.processCanvas(tx, *lockedCanvas)
+		return err
+	})
+
+	return result, err
+}
+
+func (w *RetentionWorker) processCanvas(tx *gorm.DB, canvas models.Canvas) (RetentionResult, error) {
+	result := RetentionResult{}
+
+	policy, _, err := models.FindEffectiveRetentionPolicyInTransaction(tx, canvas.OrganizationID, canvas.ID)
+	if err != nil {
+		return result, fmt.Errorf("failed to find retention policy: %w", err)
+	}
+
+	if policy == nil || !policy.IsEnabled() {
+		return result, nil
+	}
+
+	//
+	// Deleted nodes are included, so their history expires too.
+	//
+	var nodes []models.CanvasNode
+	err = tx.Unscoped().Where("workflow_id = ?", canvas.ID).Find(&nodes).Error
+	if err != nil {
+		return result, fmt.Errorf("failed to find canvas nodes: %w", err)
+	}
+
+	//
+	// Events are only expired once no execution or queue item uses them,
+	// and those can be in other nodes, so they are deleted in a second pass.
+	//
+	now := w.nowFunc()
+	for _, node := range nodes {
+		if result.Total() >= w.maxResourcesPerTick {
+			w.logger.Infof("Reached max resources per tick (%d), stopping for this cycle", w.maxResourcesPerTick)
+			break
+		}
+
+		err := w.deleteExpiredExecutionsAndQueueItems(tx, policy, canvas.ID, node.NodeID, now, &result)
+		if err != nil {
+			return result, fmt.Errorf("failed to delete expired resources for node %s: %w", node.NodeID, err)
+		}
+	}
+
+	for _, node := range nodes {
+		if result.Total() >= w.maxResourcesPerTick {
+			w.logger.Infof("Reached max resources per tick (%d), stopping for this cycle", w.maxResourcesPerTick)
+			break
+		}
+
+		err := w.deleteExpiredEvents(tx, policy, canvas.ID, node.NodeID, now, &result)
+		if err != nil {
+			return result, fmt.Errorf("failed to delete expired events for node %s: %w", node.NodeID, err)
+		}
+	}
+
+	if result.Total() > 0 {
+		w.logger.Infof(
+			"Deleted %d executions, %d events and %d queue items from canvas %s",
+			result.Executions, result.Events, result.QueueItems, canvas.ID,
+		)
+	}
+
+	return result, nil
+}
+
+func (w *RetentionWorker) deleteExpiredExecutionsAndQueueItems(
+	tx *gorm.DB,
+	policy *models.RetentionPolicy,
+	workflowID uuid.UUID,
+	nodeID string,
+	now time.Time,
+	result *RetentionResult,
+) error {
+	query := policy.ExpiredExecutionsQuery(tx, workflowID, nodeID, now)
+	if query != nil {
+		var ids []uuid.UUID
+		err := query.Order("workflow_node_executions.created_at ASC").Limit(w.maxResourcesPerTick-result.Total()).Pluck("workflow_node_executions.id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to find expired executions: %w", err)
+		}
+
+		deleted, err := models.DeleteExecutionsInTransaction(tx, workflowID, ids)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired executions: %w", err)
+		}
+
+		result.Executions += deleted
+	}
+
+	query = policy.ExpiredQueueItemsQuery(tx, workflowID, nodeID, now)
+	if query != nil && result.Total() < w.maxResourcesPerTick {
+		var ids []uuid.UUID
+		err := query.Limit(w.maxResourcesPerTick-result.Total()).Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to find expired queue items: %w", err)
+		}
+
+		if len(ids) > 0 {
+			deleted := tx.Where("id IN ?", ids).Delete(&models.CanvasNodeQueueItem{})
+			if deleted.Error != nil {
+				return fmt.Errorf("failed to delete expired queue items: %w", deleted.Error)
+			}
+
+			result.QueueItems += int(deleted.RowsAffected)
+		}
+	}
+
+	return nil
+}
+
+func (w *RetentionWorker) deleteExpiredEvents(
+	tx *gorm.DB,
+	policy *models.RetentionPolicy,
+	workflowID uuid.UUID,
+	nodeID string,
+	now time.Time,
+	result *RetentionResult,
+) error {
+	query := policy.ExpiredEventsQuery(tx, workflowID, nodeID, now)
+	if query != nil {
+		var ids []uuid.UUID
+		err := query.Limit(w.maxResourcesPerTick-result.Total()).Pluck("workflow_events.id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to find expired events: %w", err)
+		}
+
+		if len(ids) > 0 {
+			deleted := tx.Where("id IN ?", ids).Delete(&models.CanvasEvent{})
+			if deleted.Error != nil {
+				return fmt.Errorf("failed to delete expired events: %w", deleted.Error)
+			}
+
+			result.Events += int(deleted.RowsAffected)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superplanehq/superplane/pkg/database"
+	"github.com/superplanehq/superplane/pkg/models"
+	"github.com/superplanehq/superplane/test/support"
+	"gorm.io/datatypes"
+)
+
+func Test__RetentionWorker(t *testing.T) {
+	r := support.Setup(t)
+	defer r.Close()
+
+	now := time.Now()
+	worker := NewRetentionWorker()
+	worker.nowFunc = func() time.Time { return now }
+
+	nodes := []models.CanvasNode{
+		{
+			NodeID: "node-1",
+			Type:   models.NodeTypeComponent,
+			Ref: datatypes.NewJSONType(models.NodeRef{
+				Component: &models.ComponentRef{Name: "noop"},
+			}),
+		},
+	}
+
+	createExecution := func(t *testing.T, canvasID uuid.UUID, state, result string, age time.Duration) *models.CanvasNodeExecution {
+		event := support.EmitCanvasEventForNode(t, canvasID, "trigger", "default", nil)
+		execution := support.CreateCanvasNodeExecution(t, canvasID, "node-1", event.ID, event.ID, nil)
+		createdAt := now.Add(-age)
+		require.NoError(t, database.Conn().Model(execution).Updates(map[string]any{
+			"state":      state,
+			"result":     result,
+			"created_at": createdAt,
+		}).Error)
+
+		return execution
+	}
+
+	executionExists := func(t *testing.T, id uuid.UUID) bool {
+		var count int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecution{}).Where("id = ?", id).Count(&count).Error)
+		return count > 0
+	}
+
+	day := 24 * time.Hour
+
+	t.Run("canvas without policy is not touched", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		execution := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 100*day)
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Zero(t, result.Total())
+		assert.True(t, executionExists(t, execution.ID))
+	})
+
+	t.Run("old executions are deleted, failed ones are kept longer", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID:   r.Organization.ID,
+			WorkflowID:       &canvas.ID,
+			MaxAgeDays:       7,
+			FailedMaxAgeDays: 30,
+		}))
+
+		oldPassed := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 10*day)
+		oldFailed := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultFailed, 10*day)
+		veryOldFailed := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultFailed, 40*day)
+		oldRunning := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateStarted, "", 10*day)
+		recent := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, day)
+
+		_, err := models.CreateNodeExecutionLogInTransaction(database.Conn(), oldPassed, models.CanvasNodeExecutionLogLevelInfo, "hello", nil)
+		require.NoError(t, err)
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Executions)
+
+		assert.False(t, executionExists(t, oldPassed.ID))
+		assert.False(t, executionExists(t, veryOldFailed.ID))
+		assert.True(t, executionExists(t, oldFailed.ID))
+		assert.True(t, executionExists(t, oldRunning.ID))
+		assert.True(t, executionExists(t, recent.ID))
+
+		var logs int64
+		require.NoError(t, database.Conn().Model(&models.CanvasNodeExecutionLog{}).Where("execution_id = ?", oldPassed.ID).Count(&logs).Error)
+		assert.Zero(t, logs)
+	})
+
+	t.Run("only the latest executions per node are kept", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID:       r.Organization.ID,
+			WorkflowID:           &canvas.ID,
+			MaxExecutionsPerNode: 2,
+		}))
+
+		oldest := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 3*time.Hour)
+		middle := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 2*time.Hour)
+		latest := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, time.Hour)
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Executions)
+
+		assert.False(t, executionExists(t, oldest.ID))
+		assert.True(t, executionExists(t, middle.ID))
+		assert.True(t, executionExists(t, latest.ID))
+	})
+
+	t.Run("upstream executions used by kept downstream executions are not deleted", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID:       r.Organization.ID,
+			WorkflowID:           &canvas.ID,
+			MaxExecutionsPerNode: 1,
+		}))
+
+		upstream := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 3*time.Hour)
+		createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, time.Hour)
+
+		output := support.EmitCanvasEventForNode(t, canvas.ID, "node-1", "default", &upstream.ID)
+		downstream := support.CreateCanvasNodeExecution(t, canvas.ID, "node-2", upstream.RootEventID, output.ID, nil)
+		require.NoError(t, database.Conn().Model(downstream).Updates(map[string]any{
+			"state":  models.CanvasNodeExecutionStateFinished,
+			"result": models.CanvasNodeExecutionResultPassed,
+		}).Error)
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Zero(t, result.Executions)
+		assert.True(t, executionExists(t, upstream.ID))
+
+		var reloaded models.CanvasNodeExecution
+		require.NoError(t, database.Conn().Where("id = ?", downstream.ID).First(&reloaded).Error)
+		assert.Equal(t, output.ID, reloaded.EventID)
+	})
+
+	t.Run("old events and dead-lettered queue items are deleted once unused", func(t *testing.T) {
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID: r.Organization.ID,
+			WorkflowID:     &canvas.ID,
+			MaxAgeDays:     7,
+		}))
+
+		old := now.Add(-10 * day)
+		unused := support.EmitCanvasEventForNode(t, canvas.ID, "trigger", "default", nil)
+		pending := support.EmitCanvasEventForNode(t, canvas.ID, "trigger", "default", nil)
+		used := support.EmitCanvasEventForNode(t, canvas.ID, "trigger", "default", nil)
+		support.CreateQueueItem(t, canvas.ID, "node-1", used.ID, used.ID)
+
+		deadLetter := support.CreateQueueItem(t, canvas.ID, "node-1", unused.ID, unused.ID)
+		require.NoError(t, database.Conn().Model(deadLetter).Updates(map[string]any{
+			"state":      models.CanvasNodeQueueItemStateDeadLetter,
+			"created_at": old,
+		}).Error)
+
+		for _, event := range []*models.CanvasEvent{unused, used} {
+			require.NoError(t, database.Conn().Model(event).Updates(map[string]any{
+				"state":      models.CanvasEventStateRouted,
+				"created_at": old,
+			}).Error)
+		}
+
+		require.NoError(t, database.Conn().Model(pending).Update("created_at", old).Error)
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.QueueItems)
+		assert.Equal(t, 1, result.Events)
+
+		support.VerifyCanvasEventsCount(t, canvas.ID, 2)
+		support.VerifyNodeQueueCount(t, canvas.ID, 1)
+	})
+
+	t.Run("canvas policy overrides the organization one", func(t *testing.T) {
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID: r.Organization.ID,
+			MaxAgeDays:     1,
+		}))
+
+		canvas, _ := support.CreateCanvas(t, r.Organization.ID, r.User, nodes, []models.Edge{})
+		execution := createExecution(t, canvas.ID, models.CanvasNodeExecutionStateFinished, models.CanvasNodeExecutionResultPassed, 2*day)
+		require.NoError(t, models.UpsertRetentionPolicyInTransaction(database.Conn(), &models.RetentionPolicy{
+			OrganizationID: r.Organization.ID,
+			WorkflowID:     &canvas.ID,
+			MaxAgeDays:     30,
+		}))
+
+		result, err := worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Zero(t, result.Executions)
+		assert.True(t, executionExists(t, execution.ID))
+
+		require.NoError(t, models.DeleteCanvasRetentionPolicyInTransaction(database.Conn(), canvas.ID))
+		result, err = worker.LockAndProcessCanvas(*canvas)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Executions)
+		assert.False(t, executionExists(t, execution.ID))
+	})
+}
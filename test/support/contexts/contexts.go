@@ -234,6 +234,14 @@ type ExecutionStateContext struct {
 	Type           string
 	Payloads       []any
 	KVs            map[string]string
+	EmittedEvents  []EmittedEvent
+}
+
+type EmittedEvent struct {
+	ID      string
+	Channel string
+	Type    string
+	Data    any
 }
 
 func (c *ExecutionStateContext) IsFinished() bool {
@@ -265,6 +273,17 @@ func (c *ExecutionStateContext) Emit(channel, payloadType string, payloads []any
 	return nil
 }
 
+func (c *ExecutionStateContext) EmitAndContinue(channel, payloadType string, payloads []any) ([]string, error) {
+	eventIDs := make([]string, 0, len(payloads))
+	for _, payload := range payloads {
+		event := EmittedEvent{ID: uuid.NewString(), Channel: channel, Type: payloadType, Data: payload}
+		c.EmittedEvents = append(c.EmittedEvents, event)
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	return eventIDs, nil
+}
+
 func (c *ExecutionStateContext) Fail(reason, message string) error {
 	c.Finished = true
 	c.Passed = false
@@ -367,3 +386,71 @@ func (c *SecretsContext) GetKey(secretName, keyName string) ([]byte, error) {
 
 	return value, nil
 }
+
+type CanvasRunContext struct {
+	Started []StartedCanvasRun
+	Runs    map[string]*core.CanvasRun
+	Err     error
+}
+
+type StartedCanvasRun struct {
+	CanvasID string
+	Payload  any
+}
+
+func (c *CanvasRunContext) Start(canvasID string, payload any) (string, error) {
+	if c.Err != nil {
+		return "", c.Err
+	}
+
+	c.Started = append(c.Started, StartedCanvasRun{CanvasID: canvasID, Payload: payload})
+	return uuid.NewString(), nil
+}
+
+func (c *CanvasRunContext) Get(canvasID, runID string) (*core.CanvasRun, error) {
+	run, ok := c.Runs[runID]
+	if !ok {
+		return nil, fmt.Errorf("run not found: %s", runID)
+	}
+
+	return run, nil
+}
+
+type DownstreamContext struct {
+	Results map[string]*core.DownstreamResult
+}
+
+func (c *DownstreamContext) Get(eventID string) (*core.DownstreamResult, error) {
+	result, ok := c.Results[eventID]
+	if !ok {
+		return &core.DownstreamResult{State: core.DownstreamStateRunning}, nil
+	}
+
+	return result, nil
+}
+
+type ExecutionLogContext struct {
+	Lines []LogLine
+}
+
+type LogLine struct {
+	Level   string
+	Message string
+	Fields  map[string]any
+}
+
+func (c *ExecutionLogContext) Debug(message string, fields map[string]any) {
+	c.Lines = append(c.Lines, LogLine{Level: "debug", Message: message, Fields: fields})
+}
+
+func (c *ExecutionLogContext) Info(message string, fields map[string]any) {
+	c.Lines = append(c.Lines, LogLine{Level: "info", Message: message, Fields: fields})
+}
+
+func (c *ExecutionLogContext) Warn(message string, fields map[string]any) {
+	c.Lines = append(c.Lines, LogLine{Level: "warn", Message: message, Fields: fields})
+}
+
+func (c *ExecutionLogContext) Error(message string, fields map[string]any) {
+	c.Lines = append(c.Lines, LogLine{Level: "error", Message: message, Fields: fields})
+}
@@ -22,14 +22,17 @@ import (
 
 	// Import components, triggers, and integrations to register them via init()
 	_ "github.com/superplanehq/superplane/pkg/components/approval"
+	_ "github.com/superplanehq/superplane/pkg/components/canvasrun"
 	_ "github.com/superplanehq/superplane/pkg/components/deletememory"
 	_ "github.com/superplanehq/superplane/pkg/components/filter"
+	_ "github.com/superplanehq/superplane/pkg/components/foreach"
 	_ "github.com/superplanehq/superplane/pkg/components/http"
 	_ "github.com/superplanehq/superplane/pkg/components/if"
 	_ "github.com/superplanehq/superplane/pkg/components/merge"
 	_ "github.com/superplanehq/superplane/pkg/components/noop"
 	_ "github.com/superplanehq/superplane/pkg/components/readmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/ssh"
+	_ "github.com/superplanehq/superplane/pkg/components/switch"
 	_ "github.com/superplanehq/superplane/pkg/components/updatememory"
 	_ "github.com/superplanehq/superplane/pkg/components/upsertmemory"
 	_ "github.com/superplanehq/superplane/pkg/components/wait"
@@ -311,14 +314,18 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 	inputNodes := make([]models.Node, len(nodes))
 	for i, node := range nodes {
 		inputNodes[i] = models.Node{
-			ID:            node.NodeID,
-			Name:          node.Name,
-			Type:          node.Type,
-			Ref:           node.Ref.Data(),
-			Configuration: node.Configuration.Data(),
-			Metadata:      node.Metadata.Data(),
-			Position:      node.Position.Data(),
-			IsCollapsed:   node.IsCollapsed,
+			ID:             node.NodeID,
+			Name:           node.Name,
+			Type:           node.Type,
+			Ref:            node.Ref.Data(),
+			Configuration:  node.Configuration.Data(),
+			Metadata:       node.Metadata.Data(),
+			Position:       node.Position.Data(),
+			IsCollapsed:    node.IsCollapsed,
+			RetryPolicy:    node.GetRetryPolicy(),
+			TimeoutSeconds: node.TimeoutSeconds,
+			MaxConcurrency: node.MaxConcurrency,
+			QueuePolicy:    node.QueuePolicy,
 		}
 	}
 
@@ -356,20 +363,30 @@ func CreateCanvas(t *testing.T, orgID uuid.UUID, userID uuid.UUID, nodes []model
 				parentNodeID = &parent
 			}
 
+			var retryPolicy *datatypes.JSONType[models.RetryPolicy]
+			if node.RetryPolicy != nil {
+				p := datatypes.NewJSONType(*node.RetryPolicy)
+				retryPolicy = &p
+			}
+
 			canvasNode := models.CanvasNode{
-				WorkflowID:    workflow.ID,
-				NodeID:        node.ID,
-				ParentNodeID:  parentNodeID,
-				Name:          node.Name,
-				State:         models.CanvasNodeStateReady,
-				Type:          node.Type,
-				Ref:           datatypes.NewJSONType(node.Ref),
-				Configuration: datatypes.NewJSONType(node.Configuration),
-				Position:      datatypes.NewJSONType(node.Position),
-				Metadata:      datatypes.NewJSONType(node.Metadata),
-				IsCollapsed:   node.IsCollapsed,
-				CreatedAt:     &now,
-				UpdatedAt:     &now,
+				WorkflowID:     workflow.ID,
+				NodeID:         node.ID,
+				ParentNodeID:   parentNodeID,
+				Name:           node.Name,
+				State:          models.CanvasNodeStateReady,
+				Type:           node.Type,
+				Ref:            datatypes.NewJSONType(node.Ref),
+				Configuration:  datatypes.NewJSONType(node.Configuration),
+				Position:       datatypes.NewJSONType(node.Position),
+				Metadata:       datatypes.NewJSONType(node.Metadata),
+				IsCollapsed:    node.IsCollapsed,
+				RetryPolicy:    retryPolicy,
+				TimeoutSeconds: node.TimeoutSeconds,
+				MaxConcurrency: node.MaxConcurrency,
+				QueuePolicy:    node.QueuePolicy,
+				CreatedAt:      &now,
+				UpdatedAt:      &now,
 			}
 
 			if err := tx.Clauses(clause.Returning{}).Create(&canvasNode).Error; err != nil {
@@ -410,7 +427,8 @@ func CreateBlueprint(t *testing.T, orgID uuid.UUID, nodes []models.Node, edges [
 		UpdatedAt:      &now,
 	}
 
-	require.NoError(t, database.Conn().Create(&blueprint).Error)
+	_, err := models.CreateBlueprintWithVersionInTransaction(database.Conn(), &blueprint)
+	require.NoError(t, err)
 
 	return &blueprint
 }
@@ -546,14 +564,18 @@ func expandBlueprintNodes(t *testing.T, orgID uuid.UUID, nodes []models.Node) ([
 
 		for _, bn := range b.Nodes {
 			internal := models.Node{
-				ID:            n.ID + ":" + bn.ID,
-				Name:          bn.Name,
-				Type:          bn.Type,
-				Ref:           bn.Ref,
-				Configuration: bn.Configuration,
-				Metadata:      maps.Clone(bn.Metadata),
-				Position:      bn.Position,
-				IsCollapsed:   bn.IsCollapsed,
+				ID:             n.ID + ":" + bn.ID,
+				Name:           bn.Name,
+				Type:           bn.Type,
+				Ref:            bn.Ref,
+				Configuration:  bn.Configuration,
+				Metadata:       maps.Clone(bn.Metadata),
+				Position:       bn.Position,
+				IsCollapsed:    bn.IsCollapsed,
+				RetryPolicy:    bn.RetryPolicy,
+				TimeoutSeconds: bn.TimeoutSeconds,
+				MaxConcurrency: bn.MaxConcurrency,
+				QueuePolicy:    bn.QueuePolicy,
 			}
 
 			expanded = append(expanded, internal)
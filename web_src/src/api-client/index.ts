@@ -4,9 +4,14 @@ export {
   blueprintsCreateBlueprint,
   blueprintsDeleteBlueprint,
   blueprintsDescribeBlueprint,
+  blueprintsDiffBlueprintVersions,
+  blueprintsDiscardBlueprintDraft,
   blueprintsListBlueprints,
+  blueprintsListBlueprintVersions,
+  blueprintsPublishBlueprint,
   blueprintsUpdateBlueprint,
   canvasesActOnCanvasChangeRequest,
+  canvasesAssignCanvasRole,
   canvasesCancelExecution,
   canvasesCreateCanvas,
   canvasesCreateCanvasChangeRequest,
@@ -18,22 +23,36 @@ export {
   canvasesDescribeCanvasChangeRequest,
   canvasesDescribeCanvasVersion,
   canvasesEmitNodeEvent,
+  canvasesEvaluateExpression,
+  canvasesExportCanvasBundle,
+  canvasesGetCanvasRetentionReport,
+  canvasesGetExecutionLogs,
+  canvasesImportCanvasBundle,
   canvasesInvokeNodeExecutionAction,
   canvasesInvokeNodeTriggerAction,
   canvasesListCanvasChangeRequests,
   canvasesListCanvases,
   canvasesListCanvasEvents,
   canvasesListCanvasMemories,
+  canvasesListCanvasMemoryNamespaces,
+  canvasesListCanvasRoleBindings,
   canvasesListCanvasVersions,
   canvasesListChildExecutions,
+  canvasesListDeadLetters,
   canvasesListEventExecutions,
   canvasesListNodeEvents,
   canvasesListNodeExecutions,
   canvasesListNodeQueueItems,
+  canvasesRemoveCanvasRole,
+  canvasesReplayCanvasEvent,
+  canvasesReplayDeadLetter,
+  canvasesRerunExecution,
   canvasesResolveCanvasChangeRequest,
   canvasesResolveExecutionErrors,
   canvasesSendAiMessage,
   canvasesUpdateCanvas,
+  canvasesUpdateCanvasMemoryNamespace,
+  canvasesUpdateCanvasRetentionPolicy,
   canvasesUpdateCanvasVersion,
   canvasesUpdateCanvasVersion2,
   canvasesUpdateNodePause,
@@ -56,23 +75,32 @@ export {
   organizationsCreateIntegration,
   organizationsCreateInvitation,
   organizationsDeleteAgentOpenAiKey,
+  organizationsDeleteEventSink,
   organizationsDeleteIntegration,
   organizationsDeleteOrganization,
   organizationsDescribeIntegration,
   organizationsDescribeOrganization,
   organizationsGetAgentSettings,
+  organizationsGetEventSink,
   organizationsGetInviteLink,
+  organizationsGetRetentionPolicy,
+  organizationsGetSamlSettings,
+  organizationsListAuditEvents,
   organizationsListIntegrationResources,
   organizationsListIntegrations,
   organizationsListInvitations,
   organizationsRemoveInvitation,
   organizationsRemoveUser,
   organizationsResetInviteLink,
+  organizationsRetryEventSinkDeliveries,
   organizationsSetAgentOpenAiKey,
   organizationsUpdateAgentSettings,
+  organizationsUpdateEventSink,
   organizationsUpdateIntegration,
   organizationsUpdateInviteLink,
   organizationsUpdateOrganization,
+  organizationsUpdateRetentionPolicy,
+  organizationsUpdateSamlSettings,
   rolesAssignRole,
   rolesCreateRole,
   rolesDeleteRole,
@@ -106,6 +134,8 @@ export type {
   AuthorizationDomainType,
   AuthorizationPermission,
   BlueprintsBlueprint,
+  BlueprintsBlueprintDiff,
+  BlueprintsBlueprintVersion,
   BlueprintsCreateBlueprintData,
   BlueprintsCreateBlueprintError,
   BlueprintsCreateBlueprintErrors,
@@ -125,12 +155,37 @@ export type {
   BlueprintsDescribeBlueprintResponse,
   BlueprintsDescribeBlueprintResponse2,
   BlueprintsDescribeBlueprintResponses,
+  BlueprintsDiffBlueprintVersionsData,
+  BlueprintsDiffBlueprintVersionsError,
+  BlueprintsDiffBlueprintVersionsErrors,
+  BlueprintsDiffBlueprintVersionsResponse,
+  BlueprintsDiffBlueprintVersionsResponse2,
+  BlueprintsDiffBlueprintVersionsResponses,
+  BlueprintsDiscardBlueprintDraftData,
+  BlueprintsDiscardBlueprintDraftError,
+  BlueprintsDiscardBlueprintDraftErrors,
+  BlueprintsDiscardBlueprintDraftResponse,
+  BlueprintsDiscardBlueprintDraftResponse2,
+  BlueprintsDiscardBlueprintDraftResponses,
   BlueprintsListBlueprintsData,
   BlueprintsListBlueprintsError,
   BlueprintsListBlueprintsErrors,
   BlueprintsListBlueprintsResponse,
   BlueprintsListBlueprintsResponse2,
   BlueprintsListBlueprintsResponses,
+  BlueprintsListBlueprintVersionsData,
+  BlueprintsListBlueprintVersionsError,
+  BlueprintsListBlueprintVersionsErrors,
+  BlueprintsListBlueprintVersionsResponse,
+  BlueprintsListBlueprintVersionsResponse2,
+  BlueprintsListBlueprintVersionsResponses,
+  BlueprintsPublishBlueprintBody,
+  BlueprintsPublishBlueprintData,
+  BlueprintsPublishBlueprintError,
+  BlueprintsPublishBlueprintErrors,
+  BlueprintsPublishBlueprintResponse,
+  BlueprintsPublishBlueprintResponse2,
+  BlueprintsPublishBlueprintResponses,
   BlueprintsUpdateBlueprintBody,
   BlueprintsUpdateBlueprintData,
   BlueprintsUpdateBlueprintError,
@@ -147,6 +202,13 @@ export type {
   CanvasesActOnCanvasChangeRequestResponse,
   CanvasesActOnCanvasChangeRequestResponse2,
   CanvasesActOnCanvasChangeRequestResponses,
+  CanvasesAssignCanvasRoleBody,
+  CanvasesAssignCanvasRoleData,
+  CanvasesAssignCanvasRoleError,
+  CanvasesAssignCanvasRoleErrors,
+  CanvasesAssignCanvasRoleResponse,
+  CanvasesAssignCanvasRoleResponse2,
+  CanvasesAssignCanvasRoleResponses,
   CanvasesCancelExecutionBody,
   CanvasesCancelExecutionData,
   CanvasesCancelExecutionError,
@@ -159,6 +221,11 @@ export type {
   CanvasesCanvasAiContext,
   CanvasesCanvasAiNodeContext,
   CanvasesCanvasAutoLayout,
+  CanvasesCanvasBundle,
+  CanvasesCanvasBundleDependency,
+  CanvasesCanvasBundleDependencyType,
+  CanvasesCanvasBundleIntegration,
+  CanvasesCanvasBundleSecret,
   CanvasesCanvasChangeRequest,
   CanvasesCanvasChangeRequestApproval,
   CanvasesCanvasChangeRequestApprovalConfig,
@@ -168,15 +235,23 @@ export type {
   CanvasesCanvasChangeRequestDiff,
   CanvasesCanvasChangeRequestMetadata,
   CanvasesCanvasChangeRequestStatus,
+  CanvasesCanvasEnvironment,
   CanvasesCanvasEvent,
   CanvasesCanvasEventWithExecutions,
   CanvasesCanvasMemory,
+  CanvasesCanvasMemoryNamespace,
+  CanvasesCanvasMemorySchema,
+  CanvasesCanvasMemorySchemaField,
   CanvasesCanvasMetadata,
   CanvasesCanvasNodeExecution,
+  CanvasesCanvasNodeExecutionLog,
   CanvasesCanvasNodeExecutionState,
   CanvasesCanvasNodeQueueItem,
+  CanvasesCanvasRole,
+  CanvasesCanvasRoleBinding,
   CanvasesCanvasSpec,
   CanvasesCanvasStatus,
+  CanvasesCanvasVariable,
   CanvasesCanvasVersion,
   CanvasesCanvasVersionMetadata,
   CanvasesCreateCanvasChangeRequestBody,
@@ -200,6 +275,8 @@ export type {
   CanvasesCreateCanvasVersionResponse,
   CanvasesCreateCanvasVersionResponse2,
   CanvasesCreateCanvasVersionResponses,
+  CanvasesDeadLetter,
+  CanvasesDeadLetterType,
   CanvasesDeleteCanvasData,
   CanvasesDeleteCanvasError,
   CanvasesDeleteCanvasErrors,
@@ -243,6 +320,39 @@ export type {
   CanvasesEmitNodeEventResponse,
   CanvasesEmitNodeEventResponse2,
   CanvasesEmitNodeEventResponses,
+  CanvasesEvaluateExpressionBody,
+  CanvasesEvaluateExpressionData,
+  CanvasesEvaluateExpressionError,
+  CanvasesEvaluateExpressionErrors,
+  CanvasesEvaluateExpressionResponse,
+  CanvasesEvaluateExpressionResponse2,
+  CanvasesEvaluateExpressionResponses,
+  CanvasesExportCanvasBundleData,
+  CanvasesExportCanvasBundleError,
+  CanvasesExportCanvasBundleErrors,
+  CanvasesExportCanvasBundleResponse,
+  CanvasesExportCanvasBundleResponse2,
+  CanvasesExportCanvasBundleResponses,
+  CanvasesGetCanvasRetentionReportData,
+  CanvasesGetCanvasRetentionReportError,
+  CanvasesGetCanvasRetentionReportErrors,
+  CanvasesGetCanvasRetentionReportResponse,
+  CanvasesGetCanvasRetentionReportResponse2,
+  CanvasesGetCanvasRetentionReportResponseNode,
+  CanvasesGetCanvasRetentionReportResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsError,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponse,
+  CanvasesGetExecutionLogsResponse2,
+  CanvasesGetExecutionLogsResponses,
+  CanvasesImportCanvasBundleData,
+  CanvasesImportCanvasBundleError,
+  CanvasesImportCanvasBundleErrors,
+  CanvasesImportCanvasBundleRequest,
+  CanvasesImportCanvasBundleResponse,
+  CanvasesImportCanvasBundleResponse2,
+  CanvasesImportCanvasBundleResponses,
   CanvasesInvokeNodeExecutionActionBody,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionError,
@@ -281,6 +391,18 @@ export type {
   CanvasesListCanvasMemoriesResponse,
   CanvasesListCanvasMemoriesResponse2,
   CanvasesListCanvasMemoriesResponses,
+  CanvasesListCanvasMemoryNamespacesData,
+  CanvasesListCanvasMemoryNamespacesError,
+  CanvasesListCanvasMemoryNamespacesErrors,
+  CanvasesListCanvasMemoryNamespacesResponse,
+  CanvasesListCanvasMemoryNamespacesResponse2,
+  CanvasesListCanvasMemoryNamespacesResponses,
+  CanvasesListCanvasRoleBindingsData,
+  CanvasesListCanvasRoleBindingsError,
+  CanvasesListCanvasRoleBindingsErrors,
+  CanvasesListCanvasRoleBindingsResponse,
+  CanvasesListCanvasRoleBindingsResponse2,
+  CanvasesListCanvasRoleBindingsResponses,
   CanvasesListCanvasVersionsData,
   CanvasesListCanvasVersionsError,
   CanvasesListCanvasVersionsErrors,
@@ -294,6 +416,12 @@ export type {
   CanvasesListChildExecutionsResponse,
   CanvasesListChildExecutionsResponse2,
   CanvasesListChildExecutionsResponses,
+  CanvasesListDeadLettersData,
+  CanvasesListDeadLettersError,
+  CanvasesListDeadLettersErrors,
+  CanvasesListDeadLettersResponse,
+  CanvasesListDeadLettersResponse2,
+  CanvasesListDeadLettersResponses,
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsError,
   CanvasesListEventExecutionsErrors,
@@ -318,6 +446,33 @@ export type {
   CanvasesListNodeQueueItemsResponse,
   CanvasesListNodeQueueItemsResponse2,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesRemoveCanvasRoleData,
+  CanvasesRemoveCanvasRoleError,
+  CanvasesRemoveCanvasRoleErrors,
+  CanvasesRemoveCanvasRoleResponse,
+  CanvasesRemoveCanvasRoleResponse2,
+  CanvasesRemoveCanvasRoleResponses,
+  CanvasesReplayCanvasEventBody,
+  CanvasesReplayCanvasEventData,
+  CanvasesReplayCanvasEventError,
+  CanvasesReplayCanvasEventErrors,
+  CanvasesReplayCanvasEventResponse,
+  CanvasesReplayCanvasEventResponse2,
+  CanvasesReplayCanvasEventResponses,
+  CanvasesReplayDeadLetterBody,
+  CanvasesReplayDeadLetterData,
+  CanvasesReplayDeadLetterError,
+  CanvasesReplayDeadLetterErrors,
+  CanvasesReplayDeadLetterResponse,
+  CanvasesReplayDeadLetterResponse2,
+  CanvasesReplayDeadLetterResponses,
+  CanvasesRerunExecutionBody,
+  CanvasesRerunExecutionData,
+  CanvasesRerunExecutionError,
+  CanvasesRerunExecutionErrors,
+  CanvasesRerunExecutionResponse,
+  CanvasesRerunExecutionResponse2,
+  CanvasesRerunExecutionResponses,
   CanvasesResolveCanvasChangeRequestBody,
   CanvasesResolveCanvasChangeRequestData,
   CanvasesResolveCanvasChangeRequestError,
@@ -343,9 +498,23 @@ export type {
   CanvasesUpdateCanvasData,
   CanvasesUpdateCanvasError,
   CanvasesUpdateCanvasErrors,
+  CanvasesUpdateCanvasMemoryNamespaceBody,
+  CanvasesUpdateCanvasMemoryNamespaceData,
+  CanvasesUpdateCanvasMemoryNamespaceError,
+  CanvasesUpdateCanvasMemoryNamespaceErrors,
+  CanvasesUpdateCanvasMemoryNamespaceResponse,
+  CanvasesUpdateCanvasMemoryNamespaceResponse2,
+  CanvasesUpdateCanvasMemoryNamespaceResponses,
   CanvasesUpdateCanvasResponse,
   CanvasesUpdateCanvasResponse2,
   CanvasesUpdateCanvasResponses,
+  CanvasesUpdateCanvasRetentionPolicyBody,
+  CanvasesUpdateCanvasRetentionPolicyData,
+  CanvasesUpdateCanvasRetentionPolicyError,
+  CanvasesUpdateCanvasRetentionPolicyErrors,
+  CanvasesUpdateCanvasRetentionPolicyResponse,
+  CanvasesUpdateCanvasRetentionPolicyResponse2,
+  CanvasesUpdateCanvasRetentionPolicyResponses,
   CanvasesUpdateCanvasVersion2Data,
   CanvasesUpdateCanvasVersion2Error,
   CanvasesUpdateCanvasVersion2Errors,
@@ -365,8 +534,13 @@ export type {
   CanvasesUpdateNodePauseResponse,
   CanvasesUpdateNodePauseResponse2,
   CanvasesUpdateNodePauseResponses,
+  CanvasMemorySchemaFieldType,
+  CanvasMemorySchemaLookupKey,
+  CanvasNodeExecutionLogLevel,
   CanvasNodeExecutionResult,
   CanvasNodeExecutionResultReason,
+  CanvasRoleBindingSubjectType,
+  CanvasVariableSecretRef,
   ClientOptions,
   ComponentsComponent,
   ComponentsComponentAction,
@@ -393,6 +567,7 @@ export type {
   ComponentsNode,
   ComponentsNodeType,
   ComponentsPosition,
+  ComponentsRetryPolicy,
   ConfigurationAnyPredicateListTypeOptions,
   ConfigurationDateTimeTypeOptions,
   ConfigurationDateTypeOptions,
@@ -415,6 +590,7 @@ export type {
   ConfigurationTypeOptions,
   ConfigurationValidationRule,
   ConfigurationVisibilityCondition,
+  EventSinkBacklog,
   GooglerpcStatus,
   GroupsAddUserToGroupBody,
   GroupsAddUserToGroupData,
@@ -492,6 +668,7 @@ export type {
   MeRegenerateTokenResponses,
   NodeBlueprintRef,
   NodeComponentRef,
+  NodeQueuePolicy,
   NodeTriggerRef,
   NodeWidgetRef,
   OrganizationsAcceptInviteLinkData,
@@ -501,6 +678,7 @@ export type {
   OrganizationsAcceptInviteLinkResponses,
   OrganizationsAgentOpenAiKey,
   OrganizationsAgentSettings,
+  OrganizationsAuditEvent,
   OrganizationsBrowserAction,
   OrganizationsCreateIntegrationBody,
   OrganizationsCreateIntegrationData,
@@ -522,6 +700,12 @@ export type {
   OrganizationsDeleteAgentOpenAiKeyResponse,
   OrganizationsDeleteAgentOpenAiKeyResponse2,
   OrganizationsDeleteAgentOpenAiKeyResponses,
+  OrganizationsDeleteEventSinkData,
+  OrganizationsDeleteEventSinkError,
+  OrganizationsDeleteEventSinkErrors,
+  OrganizationsDeleteEventSinkResponse,
+  OrganizationsDeleteEventSinkResponse2,
+  OrganizationsDeleteEventSinkResponses,
   OrganizationsDeleteIntegrationData,
   OrganizationsDeleteIntegrationError,
   OrganizationsDeleteIntegrationErrors,
@@ -546,18 +730,37 @@ export type {
   OrganizationsDescribeOrganizationResponse,
   OrganizationsDescribeOrganizationResponse2,
   OrganizationsDescribeOrganizationResponses,
+  OrganizationsEventSink,
   OrganizationsGetAgentSettingsData,
   OrganizationsGetAgentSettingsError,
   OrganizationsGetAgentSettingsErrors,
   OrganizationsGetAgentSettingsResponse,
   OrganizationsGetAgentSettingsResponse2,
   OrganizationsGetAgentSettingsResponses,
+  OrganizationsGetEventSinkData,
+  OrganizationsGetEventSinkError,
+  OrganizationsGetEventSinkErrors,
+  OrganizationsGetEventSinkResponse,
+  OrganizationsGetEventSinkResponse2,
+  OrganizationsGetEventSinkResponses,
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkError,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponse,
   OrganizationsGetInviteLinkResponse2,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetRetentionPolicyData,
+  OrganizationsGetRetentionPolicyError,
+  OrganizationsGetRetentionPolicyErrors,
+  OrganizationsGetRetentionPolicyResponse,
+  OrganizationsGetRetentionPolicyResponse2,
+  OrganizationsGetRetentionPolicyResponses,
+  OrganizationsGetSamlSettingsData,
+  OrganizationsGetSamlSettingsError,
+  OrganizationsGetSamlSettingsErrors,
+  OrganizationsGetSamlSettingsResponse,
+  OrganizationsGetSamlSettingsResponse2,
+  OrganizationsGetSamlSettingsResponses,
   OrganizationsIntegration,
   OrganizationsIntegrationMetadata,
   OrganizationsIntegrationResourceRef,
@@ -565,6 +768,12 @@ export type {
   OrganizationsIntegrationStatus,
   OrganizationsInvitation,
   OrganizationsInviteLink,
+  OrganizationsListAuditEventsData,
+  OrganizationsListAuditEventsError,
+  OrganizationsListAuditEventsErrors,
+  OrganizationsListAuditEventsResponse,
+  OrganizationsListAuditEventsResponse2,
+  OrganizationsListAuditEventsResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesError,
   OrganizationsListIntegrationResourcesErrors,
@@ -602,6 +811,14 @@ export type {
   OrganizationsResetInviteLinkResponse,
   OrganizationsResetInviteLinkResponse2,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsRetryEventSinkDeliveriesBody,
+  OrganizationsRetryEventSinkDeliveriesData,
+  OrganizationsRetryEventSinkDeliveriesError,
+  OrganizationsRetryEventSinkDeliveriesErrors,
+  OrganizationsRetryEventSinkDeliveriesResponse,
+  OrganizationsRetryEventSinkDeliveriesResponse2,
+  OrganizationsRetryEventSinkDeliveriesResponses,
+  OrganizationsSamlSettings,
   OrganizationsSetAgentOpenAiKeyBody,
   OrganizationsSetAgentOpenAiKeyData,
   OrganizationsSetAgentOpenAiKeyError,
@@ -616,6 +833,13 @@ export type {
   OrganizationsUpdateAgentSettingsResponse,
   OrganizationsUpdateAgentSettingsResponse2,
   OrganizationsUpdateAgentSettingsResponses,
+  OrganizationsUpdateEventSinkBody,
+  OrganizationsUpdateEventSinkData,
+  OrganizationsUpdateEventSinkError,
+  OrganizationsUpdateEventSinkErrors,
+  OrganizationsUpdateEventSinkResponse,
+  OrganizationsUpdateEventSinkResponse2,
+  OrganizationsUpdateEventSinkResponses,
   OrganizationsUpdateIntegrationBody,
   OrganizationsUpdateIntegrationData,
   OrganizationsUpdateIntegrationError,
@@ -637,8 +861,24 @@ export type {
   OrganizationsUpdateOrganizationResponse,
   OrganizationsUpdateOrganizationResponse2,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateRetentionPolicyBody,
+  OrganizationsUpdateRetentionPolicyData,
+  OrganizationsUpdateRetentionPolicyError,
+  OrganizationsUpdateRetentionPolicyErrors,
+  OrganizationsUpdateRetentionPolicyResponse,
+  OrganizationsUpdateRetentionPolicyResponse2,
+  OrganizationsUpdateRetentionPolicyResponses,
+  OrganizationsUpdateSamlSettingsBody,
+  OrganizationsUpdateSamlSettingsData,
+  OrganizationsUpdateSamlSettingsError,
+  OrganizationsUpdateSamlSettingsErrors,
+  OrganizationsUpdateSamlSettingsResponse,
+  OrganizationsUpdateSamlSettingsResponse2,
+  OrganizationsUpdateSamlSettingsResponses,
   ProtobufAny,
   ProtobufNullValue,
+  RetentionPolicySource,
+  RetryPolicyBackoff,
   RolesAssignRoleBody,
   RolesAssignRoleData,
   RolesAssignRoleError,
@@ -681,6 +921,7 @@ export type {
   RolesUpdateRoleResponse,
   RolesUpdateRoleResponse2,
   RolesUpdateRoleResponses,
+  SamlSettingsGroupMapping,
   SecretLocal,
   SecretProvider,
   SecretsCreateSecretData,
@@ -738,6 +979,7 @@ export type {
   SecretsUpdateSecretResponse,
   SecretsUpdateSecretResponse2,
   SecretsUpdateSecretResponses,
+  SecretVault,
   ServiceAccountsCreateServiceAccountData,
   ServiceAccountsCreateServiceAccountError,
   ServiceAccountsCreateServiceAccountErrors,
@@ -780,11 +1022,13 @@ export type {
   ServiceAccountsUpdateServiceAccountResponses,
   SuperplaneBlueprintsOutputChannel,
   SuperplaneBlueprintsUserRef,
+  SuperplaneCanvasesRetentionPolicy,
   SuperplaneCanvasesUserRef,
   SuperplaneComponentsOutputChannel,
   SuperplaneIntegrationsListIntegrationsResponse,
   SuperplaneMeUser,
   SuperplaneOrganizationsListIntegrationsResponse,
+  SuperplaneOrganizationsRetentionPolicy,
   SuperplaneUsersUser,
   TriggersDescribeTriggerData,
   TriggersDescribeTriggerError,
@@ -822,6 +1066,7 @@ export type {
   UsersUserRoleAssignment,
   UsersUserSpec,
   UsersUserStatus,
+  VaultAuthMethod,
   WidgetsDescribeWidgetData,
   WidgetsDescribeWidgetError,
   WidgetsDescribeWidgetErrors,
@@ -12,15 +12,30 @@ import type {
   BlueprintsDescribeBlueprintData,
   BlueprintsDescribeBlueprintErrors,
   BlueprintsDescribeBlueprintResponses,
+  BlueprintsDiffBlueprintVersionsData,
+  BlueprintsDiffBlueprintVersionsErrors,
+  BlueprintsDiffBlueprintVersionsResponses,
+  BlueprintsDiscardBlueprintDraftData,
+  BlueprintsDiscardBlueprintDraftErrors,
+  BlueprintsDiscardBlueprintDraftResponses,
   BlueprintsListBlueprintsData,
   BlueprintsListBlueprintsErrors,
   BlueprintsListBlueprintsResponses,
+  BlueprintsListBlueprintVersionsData,
+  BlueprintsListBlueprintVersionsErrors,
+  BlueprintsListBlueprintVersionsResponses,
+  BlueprintsPublishBlueprintData,
+  BlueprintsPublishBlueprintErrors,
+  BlueprintsPublishBlueprintResponses,
   BlueprintsUpdateBlueprintData,
   BlueprintsUpdateBlueprintErrors,
   BlueprintsUpdateBlueprintResponses,
   CanvasesActOnCanvasChangeRequestData,
   CanvasesActOnCanvasChangeRequestErrors,
   CanvasesActOnCanvasChangeRequestResponses,
+  CanvasesAssignCanvasRoleData,
+  CanvasesAssignCanvasRoleErrors,
+  CanvasesAssignCanvasRoleResponses,
   CanvasesCancelExecutionData,
   CanvasesCancelExecutionErrors,
   CanvasesCancelExecutionResponses,
@@ -54,6 +69,21 @@ import type {
   CanvasesEmitNodeEventData,
   CanvasesEmitNodeEventErrors,
   CanvasesEmitNodeEventResponses,
+  CanvasesEvaluateExpressionData,
+  CanvasesEvaluateExpressionErrors,
+  CanvasesEvaluateExpressionResponses,
+  CanvasesExportCanvasBundleData,
+  CanvasesExportCanvasBundleErrors,
+  CanvasesExportCanvasBundleResponses,
+  CanvasesGetCanvasRetentionReportData,
+  CanvasesGetCanvasRetentionReportErrors,
+  CanvasesGetCanvasRetentionReportResponses,
+  CanvasesGetExecutionLogsData,
+  CanvasesGetExecutionLogsErrors,
+  CanvasesGetExecutionLogsResponses,
+  CanvasesImportCanvasBundleData,
+  CanvasesImportCanvasBundleErrors,
+  CanvasesImportCanvasBundleResponses,
   CanvasesInvokeNodeExecutionActionData,
   CanvasesInvokeNodeExecutionActionErrors,
   CanvasesInvokeNodeExecutionActionResponses,
@@ -72,12 +102,21 @@ import type {
   CanvasesListCanvasMemoriesData,
   CanvasesListCanvasMemoriesErrors,
   CanvasesListCanvasMemoriesResponses,
+  CanvasesListCanvasMemoryNamespacesData,
+  CanvasesListCanvasMemoryNamespacesErrors,
+  CanvasesListCanvasMemoryNamespacesResponses,
+  CanvasesListCanvasRoleBindingsData,
+  CanvasesListCanvasRoleBindingsErrors,
+  CanvasesListCanvasRoleBindingsResponses,
   CanvasesListCanvasVersionsData,
   CanvasesListCanvasVersionsErrors,
   CanvasesListCanvasVersionsResponses,
   CanvasesListChildExecutionsData,
   CanvasesListChildExecutionsErrors,
   CanvasesListChildExecutionsResponses,
+  CanvasesListDeadLettersData,
+  CanvasesListDeadLettersErrors,
+  CanvasesListDeadLettersResponses,
   CanvasesListEventExecutionsData,
   CanvasesListEventExecutionsErrors,
   CanvasesListEventExecutionsResponses,
@@ -90,6 +129,18 @@ import type {
   CanvasesListNodeQueueItemsData,
   CanvasesListNodeQueueItemsErrors,
   CanvasesListNodeQueueItemsResponses,
+  CanvasesRemoveCanvasRoleData,
+  CanvasesRemoveCanvasRoleErrors,
+  CanvasesRemoveCanvasRoleResponses,
+  CanvasesReplayCanvasEventData,
+  CanvasesReplayCanvasEventErrors,
+  CanvasesReplayCanvasEventResponses,
+  CanvasesReplayDeadLetterData,
+  CanvasesReplayDeadLetterErrors,
+  CanvasesReplayDeadLetterResponses,
+  CanvasesRerunExecutionData,
+  CanvasesRerunExecutionErrors,
+  CanvasesRerunExecutionResponses,
   CanvasesResolveCanvasChangeRequestData,
   CanvasesResolveCanvasChangeRequestErrors,
   CanvasesResolveCanvasChangeRequestResponses,
@@ -101,7 +152,13 @@ import type {
   CanvasesSendAiMessageResponses,
   CanvasesUpdateCanvasData,
   CanvasesUpdateCanvasErrors,
+  CanvasesUpdateCanvasMemoryNamespaceData,
+  CanvasesUpdateCanvasMemoryNamespaceErrors,
+  CanvasesUpdateCanvasMemoryNamespaceResponses,
   CanvasesUpdateCanvasResponses,
+  CanvasesUpdateCanvasRetentionPolicyData,
+  CanvasesUpdateCanvasRetentionPolicyErrors,
+  CanvasesUpdateCanvasRetentionPolicyResponses,
   CanvasesUpdateCanvasVersion2Data,
   CanvasesUpdateCanvasVersion2Errors,
   CanvasesUpdateCanvasVersion2Responses,
@@ -165,6 +222,9 @@ import type {
   OrganizationsDeleteAgentOpenAiKeyData,
   OrganizationsDeleteAgentOpenAiKeyErrors,
   OrganizationsDeleteAgentOpenAiKeyResponses,
+  OrganizationsDeleteEventSinkData,
+  OrganizationsDeleteEventSinkErrors,
+  OrganizationsDeleteEventSinkResponses,
   OrganizationsDeleteIntegrationData,
   OrganizationsDeleteIntegrationErrors,
   OrganizationsDeleteIntegrationResponses,
@@ -180,9 +240,21 @@ import type {
   OrganizationsGetAgentSettingsData,
   OrganizationsGetAgentSettingsErrors,
   OrganizationsGetAgentSettingsResponses,
+  OrganizationsGetEventSinkData,
+  OrganizationsGetEventSinkErrors,
+  OrganizationsGetEventSinkResponses,
   OrganizationsGetInviteLinkData,
   OrganizationsGetInviteLinkErrors,
   OrganizationsGetInviteLinkResponses,
+  OrganizationsGetRetentionPolicyData,
+  OrganizationsGetRetentionPolicyErrors,
+  OrganizationsGetRetentionPolicyResponses,
+  OrganizationsGetSamlSettingsData,
+  OrganizationsGetSamlSettingsErrors,
+  OrganizationsGetSamlSettingsResponses,
+  OrganizationsListAuditEventsData,
+  OrganizationsListAuditEventsErrors,
+  OrganizationsListAuditEventsResponses,
   OrganizationsListIntegrationResourcesData,
   OrganizationsListIntegrationResourcesErrors,
   OrganizationsListIntegrationResourcesResponses,
@@ -201,12 +273,18 @@ import type {
   OrganizationsResetInviteLinkData,
   OrganizationsResetInviteLinkErrors,
   OrganizationsResetInviteLinkResponses,
+  OrganizationsRetryEventSinkDeliveriesData,
+  OrganizationsRetryEventSinkDeliveriesErrors,
+  OrganizationsRetryEventSinkDeliveriesResponses,
   OrganizationsSetAgentOpenAiKeyData,
   OrganizationsSetAgentOpenAiKeyErrors,
   OrganizationsSetAgentOpenAiKeyResponses,
   OrganizationsUpdateAgentSettingsData,
   OrganizationsUpdateAgentSettingsErrors,
   OrganizationsUpdateAgentSettingsResponses,
+  OrganizationsUpdateEventSinkData,
+  OrganizationsUpdateEventSinkErrors,
+  OrganizationsUpdateEventSinkResponses,
   OrganizationsUpdateIntegrationData,
   OrganizationsUpdateIntegrationErrors,
   OrganizationsUpdateIntegrationResponses,
@@ -216,6 +294,12 @@ import type {
   OrganizationsUpdateOrganizationData,
   OrganizationsUpdateOrganizationErrors,
   OrganizationsUpdateOrganizationResponses,
+  OrganizationsUpdateRetentionPolicyData,
+  OrganizationsUpdateRetentionPolicyErrors,
+  OrganizationsUpdateRetentionPolicyResponses,
+  OrganizationsUpdateSamlSettingsData,
+  OrganizationsUpdateSamlSettingsErrors,
+  OrganizationsUpdateSamlSettingsResponses,
   RolesAssignRoleData,
   RolesAssignRoleErrors,
   RolesAssignRoleResponses,
@@ -388,6 +472,65 @@ export const blueprintsUpdateBlueprint = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Diff blueprint versions
+ *
+ * Returns what changed between two versions of a blueprint
+ */
+export const blueprintsDiffBlueprintVersions = <ThrowOnError extends boolean = true>(
+  options: Options<BlueprintsDiffBlueprintVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    BlueprintsDiffBlueprintVersionsResponses,
+    BlueprintsDiffBlueprintVersionsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/blueprints/{id}/diff", ...options });
+
+/**
+ * Discard blueprint draft
+ *
+ * Discards the unpublished changes of a blueprint
+ */
+export const blueprintsDiscardBlueprintDraft = <ThrowOnError extends boolean = true>(
+  options: Options<BlueprintsDiscardBlueprintDraftData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    BlueprintsDiscardBlueprintDraftResponses,
+    BlueprintsDiscardBlueprintDraftErrors,
+    ThrowOnError
+  >({ url: "/api/v1/blueprints/{id}/draft", ...options });
+
+/**
+ * Publish blueprint draft
+ *
+ * Publishes the draft of a blueprint as its next version
+ */
+export const blueprintsPublishBlueprint = <ThrowOnError extends boolean = true>(
+  options: Options<BlueprintsPublishBlueprintData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<BlueprintsPublishBlueprintResponses, BlueprintsPublishBlueprintErrors, ThrowOnError>({
+    url: "/api/v1/blueprints/{id}/publish",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * List blueprint versions
+ *
+ * Returns the published versions of a blueprint, newest first
+ */
+export const blueprintsListBlueprintVersions = <ThrowOnError extends boolean = true>(
+  options: Options<BlueprintsListBlueprintVersionsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    BlueprintsListBlueprintVersionsResponses,
+    BlueprintsListBlueprintVersionsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/blueprints/{id}/versions", ...options });
+
 /**
  * List canvases
  *
@@ -418,6 +561,23 @@ export const canvasesCreateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Import canvas bundle
+ *
+ * Creates a canvas from a bundle, remapping integrations and reporting dependencies missing in the organization
+ */
+export const canvasesImportCanvasBundle = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesImportCanvasBundleData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesImportCanvasBundleResponses, CanvasesImportCanvasBundleErrors, ThrowOnError>({
+    url: "/api/v1/canvases/import",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Generate AI canvas proposal
  *
@@ -526,6 +686,36 @@ export const canvasesResolveCanvasChangeRequest = <ThrowOnError extends boolean
     },
   });
 
+/**
+ * List dead letters
+ *
+ * Returns events and queue items that could not be processed after too many attempts
+ */
+export const canvasesListDeadLetters = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListDeadLettersData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesListDeadLettersResponses, CanvasesListDeadLettersErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/dead-letters",
+    ...options,
+  });
+
+/**
+ * Replay dead letter
+ *
+ * Moves a dead-lettered event or queue item back to pending, so it is processed again
+ */
+export const canvasesReplayDeadLetter = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesReplayDeadLetterData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesReplayDeadLetterResponses, CanvasesReplayDeadLetterErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/dead-letters/{id}/replay",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas events
  *
@@ -551,6 +741,23 @@ export const canvasesListEventExecutions = <ThrowOnError extends boolean = true>
     { url: "/api/v1/canvases/{canvasId}/events/{eventId}/executions", ...options },
   );
 
+/**
+ * Replay canvas event
+ *
+ * Re-routes a root event through the live canvas version, optionally starting from a specific node
+ */
+export const canvasesReplayCanvasEvent = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesReplayCanvasEventData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesReplayCanvasEventResponses, CanvasesReplayCanvasEventErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/events/{eventId}/replay",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Resolve execution errors
  *
@@ -631,6 +838,36 @@ export const canvasesListChildExecutions = <ThrowOnError extends boolean = true>
     },
   });
 
+/**
+ * Get execution logs
+ *
+ * Returns the log lines written by a canvas node execution, oldest first
+ */
+export const canvasesGetExecutionLogs = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetExecutionLogsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<CanvasesGetExecutionLogsResponses, CanvasesGetExecutionLogsErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs",
+    ...options,
+  });
+
+/**
+ * Rerun execution
+ *
+ * Re-runs a finished execution with its original input and the current node configuration
+ */
+export const canvasesRerunExecution = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRerunExecutionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesRerunExecutionResponses, CanvasesRerunExecutionErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/executions/{executionId}/rerun",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List canvas memories
  *
@@ -644,6 +881,41 @@ export const canvasesListCanvasMemories = <ThrowOnError extends boolean = true>(
     ...options,
   });
 
+/**
+ * List canvas memory namespaces
+ *
+ * Returns the memory namespaces of a canvas which have settings
+ */
+export const canvasesListCanvasMemoryNamespaces = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasMemoryNamespacesData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListCanvasMemoryNamespacesResponses,
+    CanvasesListCanvasMemoryNamespacesErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/memory-namespaces", ...options });
+
+/**
+ * Update canvas memory namespace
+ *
+ * Updates the settings of a memory namespace, like the default TTL and the schema of its records
+ */
+export const canvasesUpdateCanvasMemoryNamespace = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasMemoryNamespaceData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasMemoryNamespaceResponses,
+    CanvasesUpdateCanvasMemoryNamespaceErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Delete canvas memory entry
  *
@@ -658,6 +930,23 @@ export const canvasesDeleteCanvasMemory = <ThrowOnError extends boolean = true>(
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/memory/{memoryId}", ...options });
 
+/**
+ * Evaluate expression
+ *
+ * Resolves an expression or a configuration for a node, against an existing event or execution
+ */
+export const canvasesEvaluateExpression = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesEvaluateExpressionData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<CanvasesEvaluateExpressionResponses, CanvasesEvaluateExpressionErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/evaluate",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List node events
  *
@@ -745,6 +1034,85 @@ export const canvasesDeleteNodeQueueItem = <ThrowOnError extends boolean = true>
     ThrowOnError
   >({ url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}", ...options });
 
+/**
+ * Update canvas retention policy
+ *
+ * Overrides the organization retention policy for a canvas, or inherits it again when no policy is given
+ */
+export const canvasesUpdateCanvasRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesUpdateCanvasRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<
+    CanvasesUpdateCanvasRetentionPolicyResponses,
+    CanvasesUpdateCanvasRetentionPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/canvases/{canvasId}/retention-policy",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get canvas retention report
+ *
+ * Returns the retention policy applied to a canvas, and what it would delete right now, without deleting anything
+ */
+export const canvasesGetCanvasRetentionReport = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesGetCanvasRetentionReportData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesGetCanvasRetentionReportResponses,
+    CanvasesGetCanvasRetentionReportErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/retention-report", ...options });
+
+/**
+ * List canvas role bindings
+ *
+ * Returns the roles bound to users, groups and service accounts on a canvas
+ */
+export const canvasesListCanvasRoleBindings = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesListCanvasRoleBindingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesListCanvasRoleBindingsResponses,
+    CanvasesListCanvasRoleBindingsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{canvasId}/role-bindings", ...options });
+
+/**
+ * Remove canvas role
+ *
+ * Removes the canvas role bound to a user, group or service account
+ */
+export const canvasesRemoveCanvasRole = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesRemoveCanvasRoleData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<CanvasesRemoveCanvasRoleResponses, CanvasesRemoveCanvasRoleErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/role-bindings",
+    ...options,
+  });
+
+/**
+ * Assign canvas role
+ *
+ * Binds a canvas role to a user, group or service account, replacing the role previously bound to them
+ */
+export const canvasesAssignCanvasRole = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesAssignCanvasRoleData, ThrowOnError>,
+) =>
+  (options.client ?? client).put<CanvasesAssignCanvasRoleResponses, CanvasesAssignCanvasRoleErrors, ThrowOnError>({
+    url: "/api/v1/canvases/{canvasId}/role-bindings",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Invoke trigger action
  *
@@ -897,6 +1265,20 @@ export const canvasesUpdateCanvas = <ThrowOnError extends boolean = true>(
     },
   });
 
+/**
+ * Export canvas bundle
+ *
+ * Returns a portable bundle with the canvas, the blueprints it uses, and the integrations and secrets it needs
+ */
+export const canvasesExportCanvasBundle = <ThrowOnError extends boolean = true>(
+  options: Options<CanvasesExportCanvasBundleData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    CanvasesExportCanvasBundleResponses,
+    CanvasesExportCanvasBundleErrors,
+    ThrowOnError
+  >({ url: "/api/v1/canvases/{id}/bundle", ...options });
+
 /**
  * List components
  *
@@ -1224,6 +1606,90 @@ export const organizationsSetAgentOpenAiKey = <ThrowOnError extends boolean = tr
     },
   });
 
+/**
+ * List audit events
+ *
+ * Returns the changes made in the organization, most recent first
+ */
+export const organizationsListAuditEvents = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsListAuditEventsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsListAuditEventsResponses,
+    OrganizationsListAuditEventsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/audit-events", ...options });
+
+/**
+ * Get organization event sink
+ *
+ * Returns the sink the organization activity is streamed to, and its delivery backlog
+ */
+export const organizationsGetEventSink = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetEventSinkData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetEventSinkResponses,
+    OrganizationsGetEventSinkErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/event-sink", ...options });
+
+/**
+ * Delete organization event sink
+ *
+ * Stops streaming the organization activity, and drops the events not delivered yet
+ */
+export const organizationsDeleteEventSink = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsDeleteEventSinkData, ThrowOnError>,
+) =>
+  (options.client ?? client).delete<
+    OrganizationsDeleteEventSinkResponses,
+    OrganizationsDeleteEventSinkErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/event-sink", ...options });
+
+/**
+ * Update organization event sink
+ *
+ * Configures the webhook or syslog sink the organization activity is streamed to
+ */
+export const organizationsUpdateEventSink = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateEventSinkData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    OrganizationsUpdateEventSinkResponses,
+    OrganizationsUpdateEventSinkErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/event-sink",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Retry failed event sink deliveries
+ *
+ * Puts the events which could not be delivered to the sink back into its backlog
+ */
+export const organizationsRetryEventSinkDeliveries = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsRetryEventSinkDeliveriesData, ThrowOnError>,
+) =>
+  (options.client ?? client).post<
+    OrganizationsRetryEventSinkDeliveriesResponses,
+    OrganizationsRetryEventSinkDeliveriesErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/event-sink/retry",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * List integrations in an organization
  *
@@ -1419,6 +1885,76 @@ export const organizationsResetInviteLink = <ThrowOnError extends boolean = true
     ThrowOnError
   >({ url: "/api/v1/organizations/{id}/invite-link/reset", ...options });
 
+/**
+ * Get organization retention policy
+ *
+ * Returns the retention policy applied to every canvas in the organization without its own
+ */
+export const organizationsGetRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetRetentionPolicyResponses,
+    OrganizationsGetRetentionPolicyErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/retention-policy", ...options });
+
+/**
+ * Update organization retention policy
+ *
+ * Updates the retention policy applied to every canvas in the organization without its own
+ */
+export const organizationsUpdateRetentionPolicy = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateRetentionPolicyData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    OrganizationsUpdateRetentionPolicyResponses,
+    OrganizationsUpdateRetentionPolicyErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/retention-policy",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
+/**
+ * Get organization SAML settings
+ *
+ * Returns the SAML single sign-on settings, and the service provider details to register in the identity provider
+ */
+export const organizationsGetSamlSettings = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsGetSamlSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).get<
+    OrganizationsGetSamlSettingsResponses,
+    OrganizationsGetSamlSettingsErrors,
+    ThrowOnError
+  >({ url: "/api/v1/organizations/{id}/saml", ...options });
+
+/**
+ * Update organization SAML settings
+ *
+ * Configures SAML single sign-on with the identity provider metadata, attribute mapping and group mappings
+ */
+export const organizationsUpdateSamlSettings = <ThrowOnError extends boolean = true>(
+  options: Options<OrganizationsUpdateSamlSettingsData, ThrowOnError>,
+) =>
+  (options.client ?? client).patch<
+    OrganizationsUpdateSamlSettingsResponses,
+    OrganizationsUpdateSamlSettingsErrors,
+    ThrowOnError
+  >({
+    url: "/api/v1/organizations/{id}/saml",
+    ...options,
+    headers: {
+      "Content-Type": "application/json",
+      ...options.headers,
+    },
+  });
+
 /**
  * Remove a user from an organization
  *
@@ -1,5 +1,297 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
+/**
+ * Layout changes, like node positions, are not reported.
+ */
+export type BlueprintsBlueprintDiff = {
+  fromVersion?: number;
+  toVersion?: number;
+  addedNodeIds?: Array<string>;
+  removedNodeIds?: Array<string>;
+  changedNodeIds?: Array<string>;
+  edgesChanged?: boolean;
+  addedConfigurationFields?: Array<string>;
+  removedConfigurationFields?: Array<string>;
+  changedConfigurationFields?: Array<string>;
+  addedOutputChannels?: Array<string>;
+  removedOutputChannels?: Array<string>;
+  changedOutputChannels?: Array<string>;
+};
+
+export type BlueprintsBlueprintVersion = {
+  version?: number;
+  publishedAt?: string;
+  publishedBy?: SuperplaneBlueprintsUserRef;
+};
+
+export type BlueprintsDiffBlueprintVersionsResponse = {
+  diff?: BlueprintsBlueprintDiff;
+};
+
+export type BlueprintsDiscardBlueprintDraftResponse = {
+  [key: string]: unknown;
+};
+
+export type BlueprintsListBlueprintVersionsResponse = {
+  versions?: Array<BlueprintsBlueprintVersion>;
+};
+
+export type BlueprintsPublishBlueprintBody = {
+  [key: string]: unknown;
+};
+
+export type BlueprintsPublishBlueprintResponse = {
+  blueprint?: BlueprintsBlueprint;
+};
+
+export type CanvasesAssignCanvasRoleBody = {
+  subjectType?: CanvasRoleBindingSubjectType;
+  subjectId?: string;
+  role?: CanvasesCanvasRole;
+};
+
+export type CanvasesAssignCanvasRoleResponse = {
+  roleBinding?: CanvasesCanvasRoleBinding;
+};
+
+/**
+ * A canvas with everything it depends on, so it can be created in another organization or instance.
+ * Nodes keep the integration and blueprint IDs of the organization the bundle was exported from,
+ * and importing maps them to the ones of the target organization.
+ * Secret values are never exported: secrets are listed by name, and must exist where the bundle is imported.
+ */
+export type CanvasesCanvasBundle = {
+  formatVersion?: number;
+  canvas?: CanvasesCanvas;
+  blueprints?: Array<BlueprintsBlueprint>;
+  integrations?: Array<CanvasesCanvasBundleIntegration>;
+  secrets?: Array<CanvasesCanvasBundleSecret>;
+};
+
+export type CanvasesCanvasBundleDependency = {
+  type?: CanvasesCanvasBundleDependencyType;
+  /**
+   * The ID of the integration or blueprint in the bundle, or the secret name.
+   */
+  id?: string;
+  name?: string;
+  message?: string;
+};
+
+export type CanvasesCanvasBundleDependencyType = "TYPE_UNKNOWN" | "TYPE_INTEGRATION" | "TYPE_SECRET" | "TYPE_BLUEPRINT";
+
+export type CanvasesCanvasBundleIntegration = {
+  /**
+   * The ID nodes in the bundle use to reference the integration.
+   */
+  id?: string;
+  name?: string;
+  integrationName?: string;
+};
+
+export type CanvasesCanvasBundleSecret = {
+  name?: string;
+  keys?: Array<string>;
+};
+
+/**
+ * A named set of values overriding the canvas variables,
+ * so the same spec can run with different values per environment.
+ */
+export type CanvasesCanvasEnvironment = {
+  name?: string;
+  variables?: Array<CanvasesCanvasVariable>;
+};
+
+export type CanvasesCanvasMemoryNamespace = {
+  namespace?: string;
+  /**
+   * TTL applied to records written without one.
+   * Zero means records do not expire.
+   */
+  defaultTtlSeconds?: number;
+  updatedAt?: string;
+  schema?: CanvasesCanvasMemorySchema;
+};
+
+export type CanvasesCanvasMemorySchema = {
+  fields?: Array<CanvasesCanvasMemorySchemaField>;
+  /**
+   * Records with the same values for these fields replace each other.
+   */
+  uniqueKey?: Array<string>;
+  /**
+   * Every lookup key gets an index, to speed up matches on its fields.
+   */
+  lookupKeys?: Array<CanvasMemorySchemaLookupKey>;
+};
+
+export type CanvasesCanvasMemorySchemaField = {
+  name?: string;
+  type?: CanvasMemorySchemaFieldType;
+  required?: boolean;
+};
+
+export type CanvasesCanvasRole =
+  | "CANVAS_ROLE_UNSPECIFIED"
+  | "CANVAS_ROLE_VIEWER"
+  | "CANVAS_ROLE_OPERATOR"
+  | "CANVAS_ROLE_EDITOR";
+
+export type CanvasesCanvasRoleBinding = {
+  subjectType?: CanvasRoleBindingSubjectType;
+  subjectId?: string;
+  subjectName?: string;
+  role?: CanvasesCanvasRole;
+};
+
+/**
+ * A variable expressions read with env("NAME").
+ * Its value is either given in plain text or read from a key of an organization secret.
+ */
+export type CanvasesCanvasVariable = {
+  name?: string;
+  description?: string;
+  value?: string;
+  secret?: CanvasVariableSecretRef;
+};
+
+export type CanvasesEvaluateExpressionBody = {
+  /**
+   * An expression, like $["Build"].data.ref, or a template with expressions between double braces.
+   * Either an expression or a configuration must be given.
+   */
+  expression?: string;
+  configuration?: {
+    [key: string]: unknown;
+  };
+  /**
+   * The data the node is evaluated against: an event it received,
+   * or one of its executions. Without them, only functions and memory can be used.
+   */
+  eventId?: string;
+  executionId?: string;
+};
+
+export type CanvasesEvaluateExpressionResponse = {
+  value?: unknown;
+  configuration?: {
+    [key: string]: unknown;
+  };
+  referencedNodes?: Array<string>;
+  errors?: Array<string>;
+};
+
+export type CanvasesExportCanvasBundleResponse = {
+  bundle?: CanvasesCanvasBundle;
+};
+
+export type CanvasesGetCanvasRetentionReportResponse = {
+  retentionPolicy?: SuperplaneCanvasesRetentionPolicy;
+  source?: RetentionPolicySource;
+  nodes?: Array<CanvasesGetCanvasRetentionReportResponseNode>;
+  executions?: number;
+  events?: number;
+  queueItems?: number;
+};
+
+export type CanvasesGetCanvasRetentionReportResponseNode = {
+  nodeId?: string;
+  executions?: number;
+  events?: number;
+  queueItems?: number;
+};
+
+export type CanvasesImportCanvasBundleRequest = {
+  bundle?: CanvasesCanvasBundle;
+  /**
+   * Overrides the name of the canvas in the bundle.
+   */
+  name?: string;
+  /**
+   * Integration IDs of the bundle mapped to integration IDs of the organization.
+   * Integrations without a mapping use the one with the same name and type,
+   * or the only one of the same type.
+   */
+  integrationIds?: {
+    [key: string]: string;
+  };
+  /**
+   * Only resolve the dependencies, without creating anything.
+   */
+  dryRun?: boolean;
+};
+
+export type CanvasesImportCanvasBundleResponse = {
+  /**
+   * Not set when some dependencies are unresolved, or on dry runs.
+   */
+  canvas?: CanvasesCanvas;
+  unresolved?: Array<CanvasesCanvasBundleDependency>;
+  integrationIds?: {
+    [key: string]: string;
+  };
+  blueprintIds?: {
+    [key: string]: string;
+  };
+};
+
+export type CanvasesListCanvasMemoryNamespacesResponse = {
+  namespaces?: Array<CanvasesCanvasMemoryNamespace>;
+};
+
+export type CanvasesListCanvasRoleBindingsResponse = {
+  roleBindings?: Array<CanvasesCanvasRoleBinding>;
+};
+
+export type CanvasesRemoveCanvasRoleResponse = {
+  [key: string]: unknown;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceBody = {
+  defaultTtlSeconds?: number;
+  /**
+   * Records are not validated when no schema is given.
+   */
+  schema?: CanvasesCanvasMemorySchema;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponse = {
+  namespace?: CanvasesCanvasMemoryNamespace;
+};
+
+export type CanvasesUpdateCanvasRetentionPolicyBody = {
+  retentionPolicy?: SuperplaneCanvasesRetentionPolicy;
+};
+
+export type CanvasesUpdateCanvasRetentionPolicyResponse = {
+  retentionPolicy?: SuperplaneCanvasesRetentionPolicy;
+  source?: RetentionPolicySource;
+};
+
+export type CanvasMemorySchemaFieldType =
+  | "TYPE_UNSPECIFIED"
+  | "TYPE_STRING"
+  | "TYPE_NUMBER"
+  | "TYPE_BOOLEAN"
+  | "TYPE_OBJECT"
+  | "TYPE_LIST";
+
+export type CanvasMemorySchemaLookupKey = {
+  fields?: Array<string>;
+};
+
+export type CanvasRoleBindingSubjectType =
+  | "SUBJECT_TYPE_UNSPECIFIED"
+  | "SUBJECT_TYPE_USER"
+  | "SUBJECT_TYPE_GROUP"
+  | "SUBJECT_TYPE_SERVICE_ACCOUNT";
+
+export type CanvasVariableSecretRef = {
+  name?: string;
+  key?: string;
+};
+
 export type ClientOptions = {
   baseUrl: `http://${string}` | `https://${string}` | (string & {});
 };
@@ -40,6 +332,14 @@ export type BlueprintsBlueprint = {
   icon?: string;
   color?: string;
   createdBy?: SuperplaneBlueprintsUserRef;
+  /**
+   * The version of the content. Zero when the content is the draft.
+   */
+  version?: number;
+  /**
+   * Whether the blueprint has unpublished changes.
+   */
+  hasDraft?: boolean;
 };
 
 export type BlueprintsCreateBlueprintRequest = {
@@ -74,12 +374,15 @@ export type CanvasAutoLayoutAlgorithm = "ALGORITHM_UNSPECIFIED" | "ALGORITHM_HOR
 
 export type CanvasAutoLayoutScope = "SCOPE_UNSPECIFIED" | "SCOPE_FULL_CANVAS" | "SCOPE_CONNECTED_COMPONENT";
 
+export type CanvasNodeExecutionLogLevel = "LEVEL_UNKNOWN" | "LEVEL_DEBUG" | "LEVEL_INFO" | "LEVEL_WARN" | "LEVEL_ERROR";
+
 export type CanvasNodeExecutionResult = "RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED";
 
 export type CanvasNodeExecutionResultReason =
   | "RESULT_REASON_OK"
   | "RESULT_REASON_ERROR"
-  | "RESULT_REASON_ERROR_RESOLVED";
+  | "RESULT_REASON_ERROR_RESOLVED"
+  | "RESULT_REASON_TIMED_OUT";
 
 export type CanvasesActOnCanvasChangeRequestBody = {
   action?: ActOnCanvasChangeRequestRequestAction;
@@ -196,6 +499,7 @@ export type CanvasesCanvasEvent = {
     [key: string]: unknown;
   };
   createdAt?: string;
+  replayOfId?: string;
 };
 
 export type CanvasesCanvasEventWithExecutions = {
@@ -209,12 +513,14 @@ export type CanvasesCanvasEventWithExecutions = {
   createdAt?: string;
   executions?: Array<CanvasesCanvasNodeExecution>;
   customName?: string;
+  replayOfId?: string;
 };
 
 export type CanvasesCanvasMemory = {
   id?: string;
   namespace?: string;
   values?: unknown;
+  expiresAt?: string;
 };
 
 export type CanvasesCanvasMetadata = {
@@ -228,6 +534,11 @@ export type CanvasesCanvasMetadata = {
   isTemplate?: boolean;
   canvasVersioningEnabled?: boolean;
   changeRequestApprovalConfig?: CanvasesCanvasChangeRequestApprovalConfig;
+  /**
+   * The environment of the spec whose variable values this canvas uses.
+   * Empty means the values defined in the spec variables.
+   */
+  environment?: string;
 };
 
 export type CanvasesCanvasNodeExecution = {
@@ -257,6 +568,22 @@ export type CanvasesCanvasNodeExecution = {
   childExecutions?: Array<CanvasesCanvasNodeExecution>;
   rootEvent?: CanvasesCanvasEvent;
   cancelledBy?: SuperplaneCanvasesUserRef;
+  attempt?: number;
+  retryOfExecutionId?: string;
+  runAt?: string;
+  rerunOfExecutionId?: string;
+};
+
+export type CanvasesCanvasNodeExecutionLog = {
+  id?: string;
+  executionId?: string;
+  nodeId?: string;
+  level?: CanvasNodeExecutionLogLevel;
+  message?: string;
+  fields?: {
+    [key: string]: unknown;
+  };
+  createdAt?: string;
 };
 
 export type CanvasesCanvasNodeExecutionState = "STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED";
@@ -275,6 +602,8 @@ export type CanvasesCanvasNodeQueueItem = {
 export type CanvasesCanvasSpec = {
   nodes?: Array<ComponentsNode>;
   edges?: Array<ComponentsEdge>;
+  variables?: Array<CanvasesCanvasVariable>;
+  environments?: Array<CanvasesCanvasEnvironment>;
 };
 
 export type CanvasesCanvasStatus = {
@@ -336,6 +665,19 @@ export type CanvasesDeleteNodeQueueItemResponse = {
   [key: string]: unknown;
 };
 
+export type CanvasesDeadLetter = {
+  id?: string;
+  type?: CanvasesDeadLetterType;
+  canvasId?: string;
+  nodeId?: string;
+  event?: CanvasesCanvasEvent;
+  attempts?: number;
+  failureReason?: string;
+  createdAt?: string;
+};
+
+export type CanvasesDeadLetterType = "TYPE_UNSPECIFIED" | "TYPE_EVENT" | "TYPE_QUEUE_ITEM";
+
 export type CanvasesDescribeCanvasChangeRequestResponse = {
   changeRequest?: CanvasesCanvasChangeRequest;
 };
@@ -359,6 +701,14 @@ export type CanvasesEmitNodeEventResponse = {
   eventId?: string;
 };
 
+export type CanvasesGetExecutionLogsResponse = {
+  logs?: Array<CanvasesCanvasNodeExecutionLog>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+  executionState?: CanvasesCanvasNodeExecutionState;
+};
+
 export type CanvasesInvokeNodeExecutionActionBody = {
   parameters?: {
     [key: string]: unknown;
@@ -418,6 +768,13 @@ export type CanvasesListChildExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
 };
 
+export type CanvasesListDeadLettersResponse = {
+  deadLetters?: Array<CanvasesDeadLetter>;
+  totalCount?: number;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 export type CanvasesListEventExecutionsResponse = {
   executions?: Array<CanvasesCanvasNodeExecution>;
 };
@@ -443,6 +800,30 @@ export type CanvasesListNodeQueueItemsResponse = {
   lastTimestamp?: string;
 };
 
+export type CanvasesReplayCanvasEventBody = {
+  nodeId?: string;
+};
+
+export type CanvasesReplayCanvasEventResponse = {
+  event?: CanvasesCanvasEvent;
+};
+
+export type CanvasesReplayDeadLetterBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesReplayDeadLetterResponse = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRerunExecutionBody = {
+  [key: string]: unknown;
+};
+
+export type CanvasesRerunExecutionResponse = {
+  queueItem?: CanvasesCanvasNodeQueueItem;
+};
+
 export type CanvasesResolveCanvasChangeRequestBody = {
   canvas?: CanvasesCanvas;
   autoLayout?: CanvasesCanvasAutoLayout;
@@ -478,6 +859,7 @@ export type CanvasesUpdateCanvasBody = {
   description?: string;
   canvasVersioningEnabled?: boolean;
   changeRequestApprovalConfig?: CanvasesCanvasChangeRequestApprovalConfig;
+  environment?: string;
 };
 
 export type CanvasesUpdateCanvasResponse = {
@@ -564,6 +946,10 @@ export type ComponentsNode = {
   errorMessage?: string;
   warningMessage?: string;
   paused?: boolean;
+  retryPolicy?: ComponentsRetryPolicy;
+  timeoutSeconds?: number;
+  maxConcurrency?: number;
+  queuePolicy?: NodeQueuePolicy;
 };
 
 export type ComponentsNodeType = "TYPE_COMPONENT" | "TYPE_BLUEPRINT" | "TYPE_TRIGGER" | "TYPE_WIDGET";
@@ -573,6 +959,14 @@ export type ComponentsPosition = {
   y?: number;
 };
 
+export type ComponentsRetryPolicy = {
+  maxAttempts?: number;
+  backoff?: RetryPolicyBackoff;
+  delaySeconds?: number;
+  maxDelaySeconds?: number;
+  retryOn?: Array<string>;
+};
+
 export type ConfigurationAnyPredicateListTypeOptions = {
   operators?: Array<ConfigurationSelectOption>;
 };
@@ -703,6 +1097,12 @@ export type ConfigurationVisibilityCondition = {
   values?: Array<string>;
 };
 
+export type EventSinkBacklog = {
+  pending?: string;
+  failed?: string;
+  oldestPendingAt?: string;
+};
+
 export type GroupsAddUserToGroupBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -810,12 +1210,24 @@ export type MeRegenerateTokenResponse = {
 
 export type NodeBlueprintRef = {
   id?: string;
+  /**
+   * The published version of the blueprint the node uses.
+   * Zero pins the node to the latest version when the canvas is saved.
+   */
+  version?: number;
+  /**
+   * The latest published version of the blueprint, set in responses.
+   * An upgrade is available when it is greater than version.
+   */
+  latestVersion?: number;
 };
 
 export type NodeComponentRef = {
   name?: string;
 };
 
+export type NodeQueuePolicy = "QUEUE_POLICY_FIFO" | "QUEUE_POLICY_LATEST_WINS" | "QUEUE_POLICY_REJECT_WHEN_BUSY";
+
 export type NodeTriggerRef = {
   name?: string;
 };
@@ -841,6 +1253,29 @@ export type OrganizationsAgentSettings = {
   openaiKey?: OrganizationsAgentOpenAiKey;
 };
 
+export type OrganizationsAuditEvent = {
+  id?: string;
+  actorId?: string;
+  actorName?: string;
+  actorEmail?: string;
+  resourceType?: string;
+  resourceId?: string;
+  action?: string;
+  method?: string;
+  status?: string;
+  error?: string;
+  before?: {
+    [key: string]: unknown;
+  };
+  after?: {
+    [key: string]: unknown;
+  };
+  metadata?: {
+    [key: string]: string;
+  };
+  createdAt?: string;
+};
+
 export type OrganizationsBrowserAction = {
   url?: string;
   method?: string;
@@ -874,6 +1309,10 @@ export type OrganizationsDeleteAgentOpenAiKeyResponse = {
   agentSettings?: OrganizationsAgentSettings;
 };
 
+export type OrganizationsDeleteEventSinkResponse = {
+  [key: string]: unknown;
+};
+
 export type OrganizationsDeleteIntegrationResponse = {
   [key: string]: unknown;
 };
@@ -890,14 +1329,49 @@ export type OrganizationsDescribeOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsEventSink = {
+  /**
+   * Either webhook or syslog.
+   */
+  type?: string;
+  /**
+   * HTTP endpoint for webhook sinks, or udp://, tcp:// or tls:// address for syslog sinks.
+   */
+  url?: string;
+  /**
+   * Any of canvas_events, executions and audit_events.
+   */
+  categories?: Array<string>;
+  enabled?: boolean;
+  hasSecret?: boolean;
+  backlog?: EventSinkBacklog;
+  lastDeliveredAt?: string;
+  lastError?: string;
+  lastErrorAt?: string;
+  updatedAt?: string;
+  updatedBy?: string;
+};
+
 export type OrganizationsGetAgentSettingsResponse = {
   agentSettings?: OrganizationsAgentSettings;
 };
 
+export type OrganizationsGetEventSinkResponse = {
+  eventSink?: OrganizationsEventSink;
+};
+
 export type OrganizationsGetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
+export type OrganizationsGetRetentionPolicyResponse = {
+  retentionPolicy?: SuperplaneOrganizationsRetentionPolicy;
+};
+
+export type OrganizationsGetSamlSettingsResponse = {
+  settings?: OrganizationsSamlSettings;
+};
+
 export type OrganizationsIntegration = {
   metadata?: OrganizationsIntegrationMetadata;
   spec?: OrganizationsIntegrationSpec;
@@ -951,6 +1425,12 @@ export type OrganizationsInviteLink = {
   updatedAt?: string;
 };
 
+export type OrganizationsListAuditEventsResponse = {
+  events?: Array<OrganizationsAuditEvent>;
+  hasNextPage?: boolean;
+  lastTimestamp?: string;
+};
+
 export type OrganizationsListIntegrationResourcesResponse = {
   resources?: Array<OrganizationsIntegrationResourceRef>;
 };
@@ -984,22 +1464,73 @@ export type OrganizationsResetInviteLinkResponse = {
   inviteLink?: OrganizationsInviteLink;
 };
 
-export type OrganizationsSetAgentOpenAiKeyBody = {
-  apiKey?: string;
-  validate?: boolean;
-};
-
-export type OrganizationsSetAgentOpenAiKeyResponse = {
-  agentSettings?: OrganizationsAgentSettings;
+export type OrganizationsRetryEventSinkDeliveriesBody = {
+  [key: string]: unknown;
 };
 
-export type OrganizationsUpdateAgentSettingsBody = {
-  agentModeEnabled?: boolean;
+export type OrganizationsRetryEventSinkDeliveriesResponse = {
+  requeued?: string;
 };
 
-export type OrganizationsUpdateAgentSettingsResponse = {
-  agentSettings?: OrganizationsAgentSettings;
-};
+export type OrganizationsSamlSettings = {
+  enabled?: boolean;
+  /**
+   * When enforced, members can only use the organization after signing in with the identity provider.
+   */
+  enforced?: boolean;
+  idpMetadataXml?: string;
+  idpEntityId?: string;
+  idpSsoUrl?: string;
+  /**
+   * Attribute holding the email address. The NameID is used when empty.
+   */
+  emailAttribute?: string;
+  nameAttribute?: string;
+  /**
+   * Attribute holding the IdP groups of the person, used by the group mappings.
+   */
+  groupsAttribute?: string;
+  groupMappings?: Array<SamlSettingsGroupMapping>;
+  spEntityId?: string;
+  spAcsUrl?: string;
+  spMetadataUrl?: string;
+  loginUrl?: string;
+  updatedAt?: string;
+  updatedBy?: string;
+};
+
+export type OrganizationsSetAgentOpenAiKeyBody = {
+  apiKey?: string;
+  validate?: boolean;
+};
+
+export type OrganizationsSetAgentOpenAiKeyResponse = {
+  agentSettings?: OrganizationsAgentSettings;
+};
+
+export type OrganizationsUpdateAgentSettingsBody = {
+  agentModeEnabled?: boolean;
+};
+
+export type OrganizationsUpdateAgentSettingsResponse = {
+  agentSettings?: OrganizationsAgentSettings;
+};
+
+export type OrganizationsUpdateEventSinkBody = {
+  type?: string;
+  url?: string;
+  categories?: Array<string>;
+  enabled?: boolean;
+  /**
+   * Key used to sign the events. Required when the sink is created,
+   * and kept as it is when left empty on updates.
+   */
+  secret?: string;
+};
+
+export type OrganizationsUpdateEventSinkResponse = {
+  eventSink?: OrganizationsEventSink;
+};
 
 export type OrganizationsUpdateIntegrationBody = {
   configuration?: {
@@ -1028,6 +1559,38 @@ export type OrganizationsUpdateOrganizationResponse = {
   organization?: OrganizationsOrganization;
 };
 
+export type OrganizationsUpdateRetentionPolicyBody = {
+  maxAgeDays?: number;
+  maxExecutionsPerNode?: number;
+  failedMaxAgeDays?: number;
+};
+
+export type OrganizationsUpdateRetentionPolicyResponse = {
+  retentionPolicy?: SuperplaneOrganizationsRetentionPolicy;
+};
+
+export type OrganizationsUpdateSamlSettingsBody = {
+  enabled?: boolean;
+  enforced?: boolean;
+  /**
+   * Metadata document of the identity provider.
+   * Required when SAML is configured, and kept as it is when left empty on updates.
+   */
+  idpMetadataXml?: string;
+  emailAttribute?: string;
+  nameAttribute?: string;
+  groupsAttribute?: string;
+  groupMappings?: Array<SamlSettingsGroupMapping>;
+};
+
+export type OrganizationsUpdateSamlSettingsResponse = {
+  settings?: OrganizationsSamlSettings;
+};
+
+export type RetentionPolicySource = "SOURCE_NONE" | "SOURCE_ORGANIZATION" | "SOURCE_CANVAS";
+
+export type RetryPolicyBackoff = "BACKOFF_FIXED" | "BACKOFF_EXPONENTIAL";
+
 export type RolesAssignRoleBody = {
   domainType?: AuthorizationDomainType;
   domainId?: string;
@@ -1094,13 +1657,24 @@ export type RolesUpdateRoleResponse = {
 /**
  * Local secrets are stored and managed by SuperPlane itself.
  */
+export type SamlSettingsGroupMapping = {
+  /**
+   * Group name sent by the identity provider.
+   */
+  idpGroup?: string;
+  /**
+   * Organization group the members of the IdP group are added to.
+   */
+  group?: string;
+};
+
 export type SecretLocal = {
   data?: {
     [key: string]: string;
   };
 };
 
-export type SecretProvider = "PROVIDER_UNKNOWN" | "PROVIDER_LOCAL";
+export type SecretProvider = "PROVIDER_UNKNOWN" | "PROVIDER_LOCAL" | "PROVIDER_VAULT";
 
 export type SecretsCreateSecretRequest = {
   secret?: SecretsSecret;
@@ -1144,6 +1718,7 @@ export type SecretsSecretMetadata = {
 export type SecretsSecretSpec = {
   provider?: SecretProvider;
   local?: SecretLocal;
+  vault?: SecretVault;
 };
 
 export type SecretsSetSecretKeyBody = {
@@ -1176,6 +1751,28 @@ export type SecretsUpdateSecretResponse = {
   secret?: SecretsSecret;
 };
 
+export type SecretVault = {
+  address?: string;
+  namespace?: string;
+  /**
+   * Mount path of the KV v2 secrets engine. Defaults to "secret".
+   */
+  mount?: string;
+  path?: string;
+  authMethod?: VaultAuthMethod;
+  /**
+   * Credentials are write-only, and are not returned when describing the secret.
+   */
+  token?: string;
+  approleMount?: string;
+  roleId?: string;
+  secretId?: string;
+  /**
+   * Keys of the secret in Vault. Only set in responses.
+   */
+  keys?: Array<string>;
+};
+
 export type ServiceAccountsCreateServiceAccountRequest = {
   name?: string;
   description?: string;
@@ -1238,6 +1835,12 @@ export type SuperplaneBlueprintsUserRef = {
   name?: string;
 };
 
+export type SuperplaneCanvasesRetentionPolicy = {
+  maxAgeDays?: number;
+  maxExecutionsPerNode?: number;
+  failedMaxAgeDays?: number;
+};
+
 export type SuperplaneCanvasesUserRef = {
   id?: string;
   name?: string;
@@ -1265,6 +1868,14 @@ export type SuperplaneOrganizationsListIntegrationsResponse = {
   integrations?: Array<OrganizationsIntegration>;
 };
 
+export type SuperplaneOrganizationsRetentionPolicy = {
+  maxAgeDays?: number;
+  maxExecutionsPerNode?: number;
+  failedMaxAgeDays?: number;
+  updatedAt?: string;
+  updatedBy?: string;
+};
+
 export type SuperplaneUsersUser = {
   metadata?: UsersUserMetadata;
   spec?: UsersUserSpec;
@@ -1344,6 +1955,8 @@ export type UsersUserStatus = {
   roleAssignments?: Array<UsersUserRoleAssignment>;
 };
 
+export type VaultAuthMethod = "AUTH_METHOD_UNSPECIFIED" | "AUTH_METHOD_TOKEN" | "AUTH_METHOD_APPROLE";
+
 export type WidgetsDescribeWidgetResponse = {
   widget?: WidgetsWidget;
 };
@@ -1467,7 +2080,16 @@ export type BlueprintsDescribeBlueprintData = {
   path: {
     id: string;
   };
-  query?: never;
+  query?: {
+    /**
+     * A published version to return instead of the latest one.
+     */
+    version?: number;
+    /**
+     * Return the draft, if the blueprint has one.
+     */
+    draft?: boolean;
+  };
   url: "/api/v1/blueprints/{id}";
 };
 
@@ -1519,6 +2141,130 @@ export type BlueprintsUpdateBlueprintResponses = {
 export type BlueprintsUpdateBlueprintResponse2 =
   BlueprintsUpdateBlueprintResponses[keyof BlueprintsUpdateBlueprintResponses];
 
+export type BlueprintsDiffBlueprintVersionsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: {
+    /**
+     * The version to compare from. Zero means the latest published version.
+     */
+    fromVersion?: number;
+    /**
+     * The version to compare to. Zero means the draft.
+     */
+    toVersion?: number;
+  };
+  url: "/api/v1/blueprints/{id}/diff";
+};
+
+export type BlueprintsDiffBlueprintVersionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type BlueprintsDiffBlueprintVersionsError =
+  BlueprintsDiffBlueprintVersionsErrors[keyof BlueprintsDiffBlueprintVersionsErrors];
+
+export type BlueprintsDiffBlueprintVersionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: BlueprintsDiffBlueprintVersionsResponse;
+};
+
+export type BlueprintsDiffBlueprintVersionsResponse2 =
+  BlueprintsDiffBlueprintVersionsResponses[keyof BlueprintsDiffBlueprintVersionsResponses];
+
+export type BlueprintsDiscardBlueprintDraftData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/blueprints/{id}/draft";
+};
+
+export type BlueprintsDiscardBlueprintDraftErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type BlueprintsDiscardBlueprintDraftError =
+  BlueprintsDiscardBlueprintDraftErrors[keyof BlueprintsDiscardBlueprintDraftErrors];
+
+export type BlueprintsDiscardBlueprintDraftResponses = {
+  /**
+   * A successful response.
+   */
+  200: BlueprintsDiscardBlueprintDraftResponse;
+};
+
+export type BlueprintsDiscardBlueprintDraftResponse2 =
+  BlueprintsDiscardBlueprintDraftResponses[keyof BlueprintsDiscardBlueprintDraftResponses];
+
+export type BlueprintsPublishBlueprintData = {
+  body: BlueprintsPublishBlueprintBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/blueprints/{id}/publish";
+};
+
+export type BlueprintsPublishBlueprintErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type BlueprintsPublishBlueprintError = BlueprintsPublishBlueprintErrors[keyof BlueprintsPublishBlueprintErrors];
+
+export type BlueprintsPublishBlueprintResponses = {
+  /**
+   * A successful response.
+   */
+  200: BlueprintsPublishBlueprintResponse;
+};
+
+export type BlueprintsPublishBlueprintResponse2 =
+  BlueprintsPublishBlueprintResponses[keyof BlueprintsPublishBlueprintResponses];
+
+export type BlueprintsListBlueprintVersionsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/blueprints/{id}/versions";
+};
+
+export type BlueprintsListBlueprintVersionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type BlueprintsListBlueprintVersionsError =
+  BlueprintsListBlueprintVersionsErrors[keyof BlueprintsListBlueprintVersionsErrors];
+
+export type BlueprintsListBlueprintVersionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: BlueprintsListBlueprintVersionsResponse;
+};
+
+export type BlueprintsListBlueprintVersionsResponse2 =
+  BlueprintsListBlueprintVersionsResponses[keyof BlueprintsListBlueprintVersionsResponses];
+
 export type CanvasesListCanvasesData = {
   body?: never;
   path?: never;
@@ -1571,6 +2317,32 @@ export type CanvasesCreateCanvasResponses = {
 
 export type CanvasesCreateCanvasResponse2 = CanvasesCreateCanvasResponses[keyof CanvasesCreateCanvasResponses];
 
+export type CanvasesImportCanvasBundleData = {
+  body: CanvasesImportCanvasBundleRequest;
+  path?: never;
+  query?: never;
+  url: "/api/v1/canvases/import";
+};
+
+export type CanvasesImportCanvasBundleErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesImportCanvasBundleError = CanvasesImportCanvasBundleErrors[keyof CanvasesImportCanvasBundleErrors];
+
+export type CanvasesImportCanvasBundleResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesImportCanvasBundleResponse;
+};
+
+export type CanvasesImportCanvasBundleResponse2 =
+  CanvasesImportCanvasBundleResponses[keyof CanvasesImportCanvasBundleResponses];
+
 export type CanvasesSendAiMessageData = {
   body: CanvasesSendAiMessageBody;
   path: {
@@ -1752,6 +2524,65 @@ export type CanvasesResolveCanvasChangeRequestResponses = {
 export type CanvasesResolveCanvasChangeRequestResponse2 =
   CanvasesResolveCanvasChangeRequestResponses[keyof CanvasesResolveCanvasChangeRequestResponses];
 
+export type CanvasesListDeadLettersData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/dead-letters";
+};
+
+export type CanvasesListDeadLettersErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListDeadLettersError = CanvasesListDeadLettersErrors[keyof CanvasesListDeadLettersErrors];
+
+export type CanvasesListDeadLettersResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListDeadLettersResponse;
+};
+
+export type CanvasesListDeadLettersResponse2 = CanvasesListDeadLettersResponses[keyof CanvasesListDeadLettersResponses];
+
+export type CanvasesReplayDeadLetterData = {
+  body: CanvasesReplayDeadLetterBody;
+  path: {
+    canvasId: string;
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/dead-letters/{id}/replay";
+};
+
+export type CanvasesReplayDeadLetterErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesReplayDeadLetterError = CanvasesReplayDeadLetterErrors[keyof CanvasesReplayDeadLetterErrors];
+
+export type CanvasesReplayDeadLetterResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesReplayDeadLetterResponse;
+};
+
+export type CanvasesReplayDeadLetterResponse2 =
+  CanvasesReplayDeadLetterResponses[keyof CanvasesReplayDeadLetterResponses];
+
 export type CanvasesListCanvasEventsData = {
   body?: never;
   path: {
@@ -1813,6 +2644,35 @@ export type CanvasesListEventExecutionsResponses = {
 export type CanvasesListEventExecutionsResponse2 =
   CanvasesListEventExecutionsResponses[keyof CanvasesListEventExecutionsResponses];
 
+export type CanvasesReplayCanvasEventData = {
+  body: CanvasesReplayCanvasEventBody;
+  path: {
+    canvasId: string;
+    eventId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/events/{eventId}/replay";
+};
+
+export type CanvasesReplayCanvasEventErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesReplayCanvasEventError = CanvasesReplayCanvasEventErrors[keyof CanvasesReplayCanvasEventErrors];
+
+export type CanvasesReplayCanvasEventResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesReplayCanvasEventResponse;
+};
+
+export type CanvasesReplayCanvasEventResponse2 =
+  CanvasesReplayCanvasEventResponses[keyof CanvasesReplayCanvasEventResponses];
+
 export type CanvasesResolveExecutionErrorsData = {
   body: CanvasesResolveExecutionErrorsBody;
   path: {
@@ -1931,6 +2791,66 @@ export type CanvasesListChildExecutionsResponses = {
 export type CanvasesListChildExecutionsResponse2 =
   CanvasesListChildExecutionsResponses[keyof CanvasesListChildExecutionsResponses];
 
+export type CanvasesGetExecutionLogsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: {
+    limit?: number;
+    after?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/logs";
+};
+
+export type CanvasesGetExecutionLogsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesGetExecutionLogsError = CanvasesGetExecutionLogsErrors[keyof CanvasesGetExecutionLogsErrors];
+
+export type CanvasesGetExecutionLogsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesGetExecutionLogsResponse;
+};
+
+export type CanvasesGetExecutionLogsResponse2 =
+  CanvasesGetExecutionLogsResponses[keyof CanvasesGetExecutionLogsResponses];
+
+export type CanvasesRerunExecutionData = {
+  body: CanvasesRerunExecutionBody;
+  path: {
+    canvasId: string;
+    executionId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/executions/{executionId}/rerun";
+};
+
+export type CanvasesRerunExecutionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesRerunExecutionError = CanvasesRerunExecutionErrors[keyof CanvasesRerunExecutionErrors];
+
+export type CanvasesRerunExecutionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesRerunExecutionResponse;
+};
+
+export type CanvasesRerunExecutionResponse2 = CanvasesRerunExecutionResponses[keyof CanvasesRerunExecutionResponses];
+
 export type CanvasesListCanvasMemoriesData = {
   body?: never;
   path: {
@@ -1959,6 +2879,65 @@ export type CanvasesListCanvasMemoriesResponses = {
 export type CanvasesListCanvasMemoriesResponse2 =
   CanvasesListCanvasMemoriesResponses[keyof CanvasesListCanvasMemoriesResponses];
 
+export type CanvasesListCanvasMemoryNamespacesData = {
+  body?: never;
+  path: {
+    canvasId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/memory-namespaces";
+};
+
+export type CanvasesListCanvasMemoryNamespacesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListCanvasMemoryNamespacesError =
+  CanvasesListCanvasMemoryNamespacesErrors[keyof CanvasesListCanvasMemoryNamespacesErrors];
+
+export type CanvasesListCanvasMemoryNamespacesResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListCanvasMemoryNamespacesResponse;
+};
+
+export type CanvasesListCanvasMemoryNamespacesResponse2 =
+  CanvasesListCanvasMemoryNamespacesResponses[keyof CanvasesListCanvasMemoryNamespacesResponses];
+
+export type CanvasesUpdateCanvasMemoryNamespaceData = {
+  body: CanvasesUpdateCanvasMemoryNamespaceBody;
+  path: {
+    canvasId: string;
+    namespace: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/memory-namespaces/{namespace}";
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceError =
+  CanvasesUpdateCanvasMemoryNamespaceErrors[keyof CanvasesUpdateCanvasMemoryNamespaceErrors];
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateCanvasMemoryNamespaceResponse;
+};
+
+export type CanvasesUpdateCanvasMemoryNamespaceResponse2 =
+  CanvasesUpdateCanvasMemoryNamespaceResponses[keyof CanvasesUpdateCanvasMemoryNamespaceResponses];
+
 export type CanvasesDeleteCanvasMemoryData = {
   body?: never;
   path: {
@@ -1988,6 +2967,35 @@ export type CanvasesDeleteCanvasMemoryResponses = {
 export type CanvasesDeleteCanvasMemoryResponse2 =
   CanvasesDeleteCanvasMemoryResponses[keyof CanvasesDeleteCanvasMemoryResponses];
 
+export type CanvasesEvaluateExpressionData = {
+  body: CanvasesEvaluateExpressionBody;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/evaluate";
+};
+
+export type CanvasesEvaluateExpressionErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesEvaluateExpressionError = CanvasesEvaluateExpressionErrors[keyof CanvasesEvaluateExpressionErrors];
+
+export type CanvasesEvaluateExpressionResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesEvaluateExpressionResponse;
+};
+
+export type CanvasesEvaluateExpressionResponse2 =
+  CanvasesEvaluateExpressionResponses[keyof CanvasesEvaluateExpressionResponses];
+
 export type CanvasesListNodeEventsData = {
   body?: never;
   path: {
@@ -2023,154 +3031,304 @@ export type CanvasesEmitNodeEventData = {
   body: CanvasesEmitNodeEventBody;
   path: {
     canvasId: string;
-    nodeId: string;
+    nodeId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+};
+
+export type CanvasesEmitNodeEventErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesEmitNodeEventError = CanvasesEmitNodeEventErrors[keyof CanvasesEmitNodeEventErrors];
+
+export type CanvasesEmitNodeEventResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesEmitNodeEventResponse;
+};
+
+export type CanvasesEmitNodeEventResponse2 = CanvasesEmitNodeEventResponses[keyof CanvasesEmitNodeEventResponses];
+
+export type CanvasesListNodeExecutionsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    states?: Array<"STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED">;
+    results?: Array<"RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED">;
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/executions";
+};
+
+export type CanvasesListNodeExecutionsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeExecutionsError = CanvasesListNodeExecutionsErrors[keyof CanvasesListNodeExecutionsErrors];
+
+export type CanvasesListNodeExecutionsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeExecutionsResponse;
+};
+
+export type CanvasesListNodeExecutionsResponse2 =
+  CanvasesListNodeExecutionsResponses[keyof CanvasesListNodeExecutionsResponses];
+
+export type CanvasesUpdateNodePauseData = {
+  body: CanvasesUpdateNodePauseBody;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/pause";
+};
+
+export type CanvasesUpdateNodePauseErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesUpdateNodePauseError = CanvasesUpdateNodePauseErrors[keyof CanvasesUpdateNodePauseErrors];
+
+export type CanvasesUpdateNodePauseResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesUpdateNodePauseResponse;
+};
+
+export type CanvasesUpdateNodePauseResponse2 = CanvasesUpdateNodePauseResponses[keyof CanvasesUpdateNodePauseResponses];
+
+export type CanvasesListNodeQueueItemsData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+  };
+  query?: {
+    limit?: number;
+    before?: string;
+  };
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue";
+};
+
+export type CanvasesListNodeQueueItemsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesListNodeQueueItemsError = CanvasesListNodeQueueItemsErrors[keyof CanvasesListNodeQueueItemsErrors];
+
+export type CanvasesListNodeQueueItemsResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesListNodeQueueItemsResponse;
+};
+
+export type CanvasesListNodeQueueItemsResponse2 =
+  CanvasesListNodeQueueItemsResponses[keyof CanvasesListNodeQueueItemsResponses];
+
+export type CanvasesDeleteNodeQueueItemData = {
+  body?: never;
+  path: {
+    canvasId: string;
+    nodeId: string;
+    itemId: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}";
+};
+
+export type CanvasesDeleteNodeQueueItemErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesDeleteNodeQueueItemError =
+  CanvasesDeleteNodeQueueItemErrors[keyof CanvasesDeleteNodeQueueItemErrors];
+
+export type CanvasesDeleteNodeQueueItemResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesDeleteNodeQueueItemResponse;
+};
+
+export type CanvasesDeleteNodeQueueItemResponse2 =
+  CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
+
+export type CanvasesUpdateCanvasRetentionPolicyData = {
+  body: CanvasesUpdateCanvasRetentionPolicyBody;
+  path: {
+    canvasId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/events";
+  url: "/api/v1/canvases/{canvasId}/retention-policy";
 };
 
-export type CanvasesEmitNodeEventErrors = {
+export type CanvasesUpdateCanvasRetentionPolicyErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesEmitNodeEventError = CanvasesEmitNodeEventErrors[keyof CanvasesEmitNodeEventErrors];
+export type CanvasesUpdateCanvasRetentionPolicyError =
+  CanvasesUpdateCanvasRetentionPolicyErrors[keyof CanvasesUpdateCanvasRetentionPolicyErrors];
 
-export type CanvasesEmitNodeEventResponses = {
+export type CanvasesUpdateCanvasRetentionPolicyResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesEmitNodeEventResponse;
+  200: CanvasesUpdateCanvasRetentionPolicyResponse;
 };
 
-export type CanvasesEmitNodeEventResponse2 = CanvasesEmitNodeEventResponses[keyof CanvasesEmitNodeEventResponses];
+export type CanvasesUpdateCanvasRetentionPolicyResponse2 =
+  CanvasesUpdateCanvasRetentionPolicyResponses[keyof CanvasesUpdateCanvasRetentionPolicyResponses];
 
-export type CanvasesListNodeExecutionsData = {
+export type CanvasesGetCanvasRetentionReportData = {
   body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
-  };
-  query?: {
-    states?: Array<"STATE_UNKNOWN" | "STATE_PENDING" | "STATE_STARTED" | "STATE_FINISHED">;
-    results?: Array<"RESULT_UNKNOWN" | "RESULT_PASSED" | "RESULT_FAILED" | "RESULT_CANCELLED">;
-    limit?: number;
-    before?: string;
   };
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/executions";
+  query?: never;
+  url: "/api/v1/canvases/{canvasId}/retention-report";
 };
 
-export type CanvasesListNodeExecutionsErrors = {
+export type CanvasesGetCanvasRetentionReportErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListNodeExecutionsError = CanvasesListNodeExecutionsErrors[keyof CanvasesListNodeExecutionsErrors];
+export type CanvasesGetCanvasRetentionReportError =
+  CanvasesGetCanvasRetentionReportErrors[keyof CanvasesGetCanvasRetentionReportErrors];
 
-export type CanvasesListNodeExecutionsResponses = {
+export type CanvasesGetCanvasRetentionReportResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListNodeExecutionsResponse;
+  200: CanvasesGetCanvasRetentionReportResponse;
 };
 
-export type CanvasesListNodeExecutionsResponse2 =
-  CanvasesListNodeExecutionsResponses[keyof CanvasesListNodeExecutionsResponses];
+export type CanvasesGetCanvasRetentionReportResponse2 =
+  CanvasesGetCanvasRetentionReportResponses[keyof CanvasesGetCanvasRetentionReportResponses];
 
-export type CanvasesUpdateNodePauseData = {
-  body: CanvasesUpdateNodePauseBody;
+export type CanvasesListCanvasRoleBindingsData = {
+  body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/pause";
+  url: "/api/v1/canvases/{canvasId}/role-bindings";
 };
 
-export type CanvasesUpdateNodePauseErrors = {
+export type CanvasesListCanvasRoleBindingsErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesUpdateNodePauseError = CanvasesUpdateNodePauseErrors[keyof CanvasesUpdateNodePauseErrors];
+export type CanvasesListCanvasRoleBindingsError =
+  CanvasesListCanvasRoleBindingsErrors[keyof CanvasesListCanvasRoleBindingsErrors];
 
-export type CanvasesUpdateNodePauseResponses = {
+export type CanvasesListCanvasRoleBindingsResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesUpdateNodePauseResponse;
+  200: CanvasesListCanvasRoleBindingsResponse;
 };
 
-export type CanvasesUpdateNodePauseResponse2 = CanvasesUpdateNodePauseResponses[keyof CanvasesUpdateNodePauseResponses];
+export type CanvasesListCanvasRoleBindingsResponse2 =
+  CanvasesListCanvasRoleBindingsResponses[keyof CanvasesListCanvasRoleBindingsResponses];
 
-export type CanvasesListNodeQueueItemsData = {
+export type CanvasesRemoveCanvasRoleData = {
   body?: never;
   path: {
     canvasId: string;
-    nodeId: string;
   };
   query?: {
-    limit?: number;
-    before?: string;
+    subjectType?:
+      | "SUBJECT_TYPE_UNSPECIFIED"
+      | "SUBJECT_TYPE_USER"
+      | "SUBJECT_TYPE_GROUP"
+      | "SUBJECT_TYPE_SERVICE_ACCOUNT";
+    subjectId?: string;
   };
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue";
+  url: "/api/v1/canvases/{canvasId}/role-bindings";
 };
 
-export type CanvasesListNodeQueueItemsErrors = {
+export type CanvasesRemoveCanvasRoleErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesListNodeQueueItemsError = CanvasesListNodeQueueItemsErrors[keyof CanvasesListNodeQueueItemsErrors];
+export type CanvasesRemoveCanvasRoleError = CanvasesRemoveCanvasRoleErrors[keyof CanvasesRemoveCanvasRoleErrors];
 
-export type CanvasesListNodeQueueItemsResponses = {
+export type CanvasesRemoveCanvasRoleResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesListNodeQueueItemsResponse;
+  200: CanvasesRemoveCanvasRoleResponse;
 };
 
-export type CanvasesListNodeQueueItemsResponse2 =
-  CanvasesListNodeQueueItemsResponses[keyof CanvasesListNodeQueueItemsResponses];
+export type CanvasesRemoveCanvasRoleResponse2 =
+  CanvasesRemoveCanvasRoleResponses[keyof CanvasesRemoveCanvasRoleResponses];
 
-export type CanvasesDeleteNodeQueueItemData = {
-  body?: never;
+export type CanvasesAssignCanvasRoleData = {
+  body: CanvasesAssignCanvasRoleBody;
   path: {
     canvasId: string;
-    nodeId: string;
-    itemId: string;
   };
   query?: never;
-  url: "/api/v1/canvases/{canvasId}/nodes/{nodeId}/queue/{itemId}";
+  url: "/api/v1/canvases/{canvasId}/role-bindings";
 };
 
-export type CanvasesDeleteNodeQueueItemErrors = {
+export type CanvasesAssignCanvasRoleErrors = {
   /**
    * An unexpected error response.
    */
   default: GooglerpcStatus;
 };
 
-export type CanvasesDeleteNodeQueueItemError =
-  CanvasesDeleteNodeQueueItemErrors[keyof CanvasesDeleteNodeQueueItemErrors];
+export type CanvasesAssignCanvasRoleError = CanvasesAssignCanvasRoleErrors[keyof CanvasesAssignCanvasRoleErrors];
 
-export type CanvasesDeleteNodeQueueItemResponses = {
+export type CanvasesAssignCanvasRoleResponses = {
   /**
    * A successful response.
    */
-  200: CanvasesDeleteNodeQueueItemResponse;
+  200: CanvasesAssignCanvasRoleResponse;
 };
 
-export type CanvasesDeleteNodeQueueItemResponse2 =
-  CanvasesDeleteNodeQueueItemResponses[keyof CanvasesDeleteNodeQueueItemResponses];
+export type CanvasesAssignCanvasRoleResponse2 =
+  CanvasesAssignCanvasRoleResponses[keyof CanvasesAssignCanvasRoleResponses];
 
 export type CanvasesInvokeNodeTriggerActionData = {
   body: CanvasesInvokeNodeTriggerActionBody;
@@ -2433,6 +3591,34 @@ export type CanvasesUpdateCanvasResponses = {
 
 export type CanvasesUpdateCanvasResponse2 = CanvasesUpdateCanvasResponses[keyof CanvasesUpdateCanvasResponses];
 
+export type CanvasesExportCanvasBundleData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/canvases/{id}/bundle";
+};
+
+export type CanvasesExportCanvasBundleErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type CanvasesExportCanvasBundleError = CanvasesExportCanvasBundleErrors[keyof CanvasesExportCanvasBundleErrors];
+
+export type CanvasesExportCanvasBundleResponses = {
+  /**
+   * A successful response.
+   */
+  200: CanvasesExportCanvasBundleResponse;
+};
+
+export type CanvasesExportCanvasBundleResponse2 =
+  CanvasesExportCanvasBundleResponses[keyof CanvasesExportCanvasBundleResponses];
+
 export type ComponentsListComponentsData = {
   body?: never;
   path?: never;
@@ -3059,6 +4245,158 @@ export type OrganizationsSetAgentOpenAiKeyResponses = {
 export type OrganizationsSetAgentOpenAiKeyResponse2 =
   OrganizationsSetAgentOpenAiKeyResponses[keyof OrganizationsSetAgentOpenAiKeyResponses];
 
+export type OrganizationsListAuditEventsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: {
+    actorId?: string;
+    resourceType?: string;
+    resourceId?: string;
+    action?: string;
+    since?: string;
+    before?: string;
+    limit?: number;
+  };
+  url: "/api/v1/organizations/{id}/audit-events";
+};
+
+export type OrganizationsListAuditEventsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsListAuditEventsError =
+  OrganizationsListAuditEventsErrors[keyof OrganizationsListAuditEventsErrors];
+
+export type OrganizationsListAuditEventsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsListAuditEventsResponse;
+};
+
+export type OrganizationsListAuditEventsResponse2 =
+  OrganizationsListAuditEventsResponses[keyof OrganizationsListAuditEventsResponses];
+
+export type OrganizationsGetEventSinkData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/event-sink";
+};
+
+export type OrganizationsGetEventSinkErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetEventSinkError = OrganizationsGetEventSinkErrors[keyof OrganizationsGetEventSinkErrors];
+
+export type OrganizationsGetEventSinkResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetEventSinkResponse;
+};
+
+export type OrganizationsGetEventSinkResponse2 =
+  OrganizationsGetEventSinkResponses[keyof OrganizationsGetEventSinkResponses];
+
+export type OrganizationsDeleteEventSinkData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/event-sink";
+};
+
+export type OrganizationsDeleteEventSinkErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsDeleteEventSinkError =
+  OrganizationsDeleteEventSinkErrors[keyof OrganizationsDeleteEventSinkErrors];
+
+export type OrganizationsDeleteEventSinkResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsDeleteEventSinkResponse;
+};
+
+export type OrganizationsDeleteEventSinkResponse2 =
+  OrganizationsDeleteEventSinkResponses[keyof OrganizationsDeleteEventSinkResponses];
+
+export type OrganizationsUpdateEventSinkData = {
+  body: OrganizationsUpdateEventSinkBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/event-sink";
+};
+
+export type OrganizationsUpdateEventSinkErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateEventSinkError =
+  OrganizationsUpdateEventSinkErrors[keyof OrganizationsUpdateEventSinkErrors];
+
+export type OrganizationsUpdateEventSinkResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateEventSinkResponse;
+};
+
+export type OrganizationsUpdateEventSinkResponse2 =
+  OrganizationsUpdateEventSinkResponses[keyof OrganizationsUpdateEventSinkResponses];
+
+export type OrganizationsRetryEventSinkDeliveriesData = {
+  body: OrganizationsRetryEventSinkDeliveriesBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/event-sink/retry";
+};
+
+export type OrganizationsRetryEventSinkDeliveriesErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsRetryEventSinkDeliveriesError =
+  OrganizationsRetryEventSinkDeliveriesErrors[keyof OrganizationsRetryEventSinkDeliveriesErrors];
+
+export type OrganizationsRetryEventSinkDeliveriesResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsRetryEventSinkDeliveriesResponse;
+};
+
+export type OrganizationsRetryEventSinkDeliveriesResponse2 =
+  OrganizationsRetryEventSinkDeliveriesResponses[keyof OrganizationsRetryEventSinkDeliveriesResponses];
+
 export type OrganizationsListIntegrationsData = {
   body?: never;
   path: {
@@ -3413,6 +4751,122 @@ export type OrganizationsResetInviteLinkResponses = {
 export type OrganizationsResetInviteLinkResponse2 =
   OrganizationsResetInviteLinkResponses[keyof OrganizationsResetInviteLinkResponses];
 
+export type OrganizationsGetRetentionPolicyData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/retention-policy";
+};
+
+export type OrganizationsGetRetentionPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetRetentionPolicyError =
+  OrganizationsGetRetentionPolicyErrors[keyof OrganizationsGetRetentionPolicyErrors];
+
+export type OrganizationsGetRetentionPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetRetentionPolicyResponse;
+};
+
+export type OrganizationsGetRetentionPolicyResponse2 =
+  OrganizationsGetRetentionPolicyResponses[keyof OrganizationsGetRetentionPolicyResponses];
+
+export type OrganizationsUpdateRetentionPolicyData = {
+  body: OrganizationsUpdateRetentionPolicyBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/retention-policy";
+};
+
+export type OrganizationsUpdateRetentionPolicyErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateRetentionPolicyError =
+  OrganizationsUpdateRetentionPolicyErrors[keyof OrganizationsUpdateRetentionPolicyErrors];
+
+export type OrganizationsUpdateRetentionPolicyResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateRetentionPolicyResponse;
+};
+
+export type OrganizationsUpdateRetentionPolicyResponse2 =
+  OrganizationsUpdateRetentionPolicyResponses[keyof OrganizationsUpdateRetentionPolicyResponses];
+
+export type OrganizationsGetSamlSettingsData = {
+  body?: never;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/saml";
+};
+
+export type OrganizationsGetSamlSettingsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsGetSamlSettingsError =
+  OrganizationsGetSamlSettingsErrors[keyof OrganizationsGetSamlSettingsErrors];
+
+export type OrganizationsGetSamlSettingsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsGetSamlSettingsResponse;
+};
+
+export type OrganizationsGetSamlSettingsResponse2 =
+  OrganizationsGetSamlSettingsResponses[keyof OrganizationsGetSamlSettingsResponses];
+
+export type OrganizationsUpdateSamlSettingsData = {
+  body: OrganizationsUpdateSamlSettingsBody;
+  path: {
+    id: string;
+  };
+  query?: never;
+  url: "/api/v1/organizations/{id}/saml";
+};
+
+export type OrganizationsUpdateSamlSettingsErrors = {
+  /**
+   * An unexpected error response.
+   */
+  default: GooglerpcStatus;
+};
+
+export type OrganizationsUpdateSamlSettingsError =
+  OrganizationsUpdateSamlSettingsErrors[keyof OrganizationsUpdateSamlSettingsErrors];
+
+export type OrganizationsUpdateSamlSettingsResponses = {
+  /**
+   * A successful response.
+   */
+  200: OrganizationsUpdateSamlSettingsResponse;
+};
+
+export type OrganizationsUpdateSamlSettingsResponse2 =
+  OrganizationsUpdateSamlSettingsResponses[keyof OrganizationsUpdateSamlSettingsResponses];
+
 export type OrganizationsRemoveUserData = {
   body?: never;
   path: {
@@ -74,6 +74,121 @@ export const EXPR_FUNCTIONS: readonly ExprFunction[] = [
       "Returns the payload from the immediate predecessor that emitted this event. Provide depth to walk upstream.",
     example: "previous(2).data.image.version",
   },
+  // SuperPlane
+  {
+    name: "env",
+    snippet: 'env("${1:name}")',
+    description: "Returns the value of a canvas variable.",
+    example: 'env("SLACK_CHANNEL")',
+  },
+  {
+    name: "semverCompare",
+    snippet: "semverCompare(${1:a}, ${2:b})",
+    description: "Compares two semantic versions. Returns -1, 0 or 1.",
+    example: 'semverCompare("v1.10.0", "v1.9.3") == 1',
+  },
+  {
+    name: "semverBump",
+    snippet: 'semverBump(${1:version}, "${2:patch}")',
+    description: "Increments the major, minor or patch part of a semantic version.",
+    example: 'semverBump("v1.4.2", "minor") == "v1.5.0"',
+  },
+  {
+    name: "regexCapture",
+    snippet: "regexCapture(${1:str}, ${2:pattern})",
+    description: "Returns the capture groups of the first match of a regular expression, or nil.",
+    example: 'regexCapture("release-1.2", "release-(\\d+)\\.(\\d+)") == ["1", "2"]',
+  },
+  {
+    name: "regexCaptureAll",
+    snippet: "regexCaptureAll(${1:str}, ${2:pattern})",
+    description: "Returns the capture groups of every match of a regular expression.",
+    example: 'regexCaptureAll("a=1 b=2", "(\\w)=(\\d)") == [["a", "1"], ["b", "2"]]',
+  },
+  {
+    name: "regexReplace",
+    snippet: "regexReplace(${1:str}, ${2:pattern}, ${3:replacement})",
+    description: "Replaces the matches of a regular expression. The replacement can use $1 for groups.",
+    example: 'regexReplace("feature/login", "^feature/", "") == "login"',
+  },
+  {
+    name: "jsonPath",
+    snippet: 'jsonPath(${1:v}, "${2:path}")',
+    description: "Reads a value with a JSONPath like $.items[0].name. JSON strings are parsed first.",
+    example: 'jsonPath(root().data, "$.commits[0].author.email")',
+  },
+  {
+    name: "toHex",
+    snippet: "toHex(${1:str})",
+    description: "Encodes the string into hexadecimal format.",
+    example: 'toHex("hi") == "6869"',
+  },
+  {
+    name: "fromHex",
+    snippet: "fromHex(${1:str})",
+    description: "Decodes the hexadecimal encoded string back to its original form.",
+    example: 'fromHex("6869") == "hi"',
+  },
+  {
+    name: "toBase64URL",
+    snippet: "toBase64URL(${1:str})",
+    description: "Encodes the string into URL-safe Base64 format, without padding.",
+    example: 'toBase64URL("hi?") == "aGk_"',
+  },
+  {
+    name: "fromBase64URL",
+    snippet: "fromBase64URL(${1:str})",
+    description: "Decodes the URL-safe Base64 encoded string back to its original form.",
+    example: 'fromBase64URL("aGk_") == "hi?"',
+  },
+  {
+    name: "sha256",
+    snippet: "sha256(${1:str})",
+    description: "Returns the hex encoded SHA-256 digest of the string.",
+    example: 'sha256("hello")',
+  },
+  {
+    name: "sha512",
+    snippet: "sha512(${1:str})",
+    description: "Returns the hex encoded SHA-512 digest of the string.",
+    example: 'sha512("hello")',
+  },
+  {
+    name: "hmacSHA256",
+    snippet: "hmacSHA256(${1:str}, ${2:key})",
+    description: "Returns the hex encoded HMAC-SHA256 of the string, signed with the key.",
+    example: 'hmacSHA256(toJSON(root().data), env("WEBHOOK_SECRET"))',
+  },
+  {
+    name: "timeAdd",
+    snippet: 'timeAdd(${1:time}, "${2:1h}")',
+    description: "Adds a duration, like 90m, to a time or an RFC 3339 string.",
+    example: 'timeAdd(root().data.createdAt, "2h")',
+  },
+  {
+    name: "addDays",
+    snippet: 'addDays(${1:time}, ${2:days}${3:, "${4:UTC}"})',
+    description: "Adds calendar days to a time in a timezone, keeping the wall clock across DST changes.",
+    example: 'addDays(now(), 1, "Europe/Belgrade")',
+  },
+  {
+    name: "startOfDay",
+    snippet: 'startOfDay(${1:time}${2:, "${3:UTC}"})',
+    description: "Returns the midnight starting the day of a time in a timezone.",
+    example: 'startOfDay(now(), "America/New_York")',
+  },
+  {
+    name: "formatTime",
+    snippet: 'formatTime(${1:time}, "${2:2006-01-02}"${3:, "${4:UTC}"})',
+    description: "Formats a time with a Go layout in a timezone.",
+    example: 'formatTime(now(), "2006-01-02 15:04", "Asia/Tokyo")',
+  },
+  {
+    name: "parseURL",
+    snippet: "parseURL(${1:str})",
+    description: "Parses a URL into its scheme, host, hostname, port, path, query and fragment.",
+    example: 'parseURL("https://example.com:8443/a?b=c").query.b == "c"',
+  },
   // String
   {
     name: "trim",
@@ -5,6 +5,8 @@ import {
   blueprintsCreateBlueprint,
   blueprintsUpdateBlueprint,
   blueprintsDeleteBlueprint,
+  blueprintsPublishBlueprint,
+  blueprintsDiscardBlueprintDraft,
   componentsListComponents,
   componentsDescribeComponent,
 } from "../api-client/sdk.gen";
@@ -17,6 +19,8 @@ export const blueprintKeys = {
   list: (orgId: string) => [...blueprintKeys.lists(), orgId] as const,
   details: () => [...blueprintKeys.all, "detail"] as const,
   detail: (orgId: string, id: string) => [...blueprintKeys.details(), orgId, id] as const,
+  version: (orgId: string, id: string, version: number | "draft") =>
+    [...blueprintKeys.detail(orgId, id), version] as const,
 };
 
 export const componentKeys = {
@@ -38,13 +42,16 @@ export const useBlueprints = (organizationId: string) => {
   });
 };
 
-export const useBlueprint = (organizationId: string, blueprintId: string) => {
+// Without a version, the latest published version is returned.
+// The draft, with its unpublished changes, is only returned when asked for.
+export const useBlueprint = (organizationId: string, blueprintId: string, version: number | "draft" = 0) => {
   return useQuery({
-    queryKey: blueprintKeys.detail(organizationId, blueprintId),
+    queryKey: blueprintKeys.version(organizationId, blueprintId, version),
     queryFn: async () => {
       const response = await blueprintsDescribeBlueprint(
         withOrganizationHeader({
           path: { id: blueprintId },
+          query: version === "draft" ? { draft: true } : { version },
         }),
       );
       return response.data?.blueprint;
@@ -131,6 +138,42 @@ export const useUpdateBlueprint = (organizationId: string, blueprintId: string)
   });
 };
 
+export const usePublishBlueprint = (organizationId: string, blueprintId: string) => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async () => {
+      return await blueprintsPublishBlueprint(
+        withOrganizationHeader({
+          path: { id: blueprintId },
+          body: {},
+        }),
+      );
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: blueprintKeys.detail(organizationId, blueprintId) });
+      queryClient.invalidateQueries({ queryKey: blueprintKeys.list(organizationId) });
+    },
+  });
+};
+
+export const useDiscardBlueprintDraft = (organizationId: string, blueprintId: string) => {
+  const queryClient = useQueryClient();
+
+  return useMutation({
+    mutationFn: async () => {
+      return await blueprintsDiscardBlueprintDraft(
+        withOrganizationHeader({
+          path: { id: blueprintId },
+        }),
+      );
+    },
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: blueprintKeys.detail(organizationId, blueprintId) });
+    },
+  });
+};
+
 export const useDeleteBlueprint = (organizationId: string) => {
   const queryClient = useQueryClient();
 
@@ -713,6 +713,7 @@ export interface CanvasMemoryEntry {
   id: string;
   namespace: string;
   values: unknown;
+  expiresAt?: string;
 }
 
 export const useCanvasMemoryEntries = (canvasId: string, enabled = true) => {
@@ -729,6 +730,7 @@ export const useCanvasMemoryEntries = (canvasId: string, enabled = true) => {
         id: item.id || "",
         namespace: item.namespace || "",
         values: item.values,
+        expiresAt: item.expiresAt,
       }));
     },
     refetchOnWindowFocus: false,
@@ -13,7 +13,11 @@ export const setupApiInterceptor = (): void => {
       if (!isAuthRoute(window.location.pathname)) {
         const redirectTarget = `${window.location.pathname}${window.location.search}`;
         const redirectParam = encodeURIComponent(redirectTarget);
-        window.location.href = `/login?redirect=${redirectParam}`;
+
+        // Organizations enforcing SAML single sign-on send people to their identity provider.
+        const ssoLoginURL = response.headers.get("X-SSO-Login-URL");
+        const loginURL = ssoLoginURL && ssoLoginURL.startsWith("/auth/saml/") ? ssoLoginURL : "/login";
+        window.location.href = `${loginURL}?redirect=${redirectParam}`;
       }
 
       throw new Error("Unauthorized");
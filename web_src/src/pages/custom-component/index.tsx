@@ -8,7 +8,13 @@ import { Link, useParams, useSearchParams } from "react-router-dom";
 import { ComponentsComponent, ComponentsIntegrationRef, ComponentsNode } from "../../api-client";
 import { Heading } from "../../components/Heading/heading";
 import { Button } from "../../components/ui/button";
-import { useBlueprint, useComponents, useUpdateBlueprint } from "../../hooks/useBlueprintData";
+import {
+  useBlueprint,
+  useComponents,
+  useDiscardBlueprintDraft,
+  usePublishBlueprint,
+  useUpdateBlueprint,
+} from "../../hooks/useBlueprintData";
 import { useAvailableIntegrations, useConnectedIntegrations } from "../../hooks/useIntegrations";
 import { BlockData } from "../../ui/CanvasPage/Block";
 import type { BreadcrumbItem, NewNodeData } from "../../ui/CustomComponentBuilderPage";
@@ -142,11 +148,17 @@ export const CustomComponent = () => {
   const [hasUnsavedChanges, setHasUnsavedChanges] = useState(false);
 
   // Fetch blueprint and components
-  const { data: blueprint, isLoading: blueprintLoading } = useBlueprint(organizationId || "", blueprintId || "");
+  const { data: blueprint, isLoading: blueprintLoading } = useBlueprint(
+    organizationId || "",
+    blueprintId || "",
+    "draft",
+  );
   const { data: components = [], isLoading: componentsLoading } = useComponents(organizationId!);
   const { data: availableIntegrations = [], isLoading: integrationsLoading } = useAvailableIntegrations();
   const { data: integrations = [] } = useConnectedIntegrations(organizationId!);
   const updateBlueprintMutation = useUpdateBlueprint(organizationId!, blueprintId!);
+  const publishBlueprintMutation = usePublishBlueprint(organizationId!, blueprintId!);
+  const discardBlueprintDraftMutation = useDiscardBlueprintDraft(organizationId!, blueprintId!);
 
   usePageTitle([blueprint?.name || "Custom Component"]);
 
@@ -439,6 +451,30 @@ export const CustomComponent = () => {
     ],
   );
 
+  // Saved changes stay in the draft until published,
+  // so canvases keep using the version they are pinned to.
+  const handlePublishDraft = useCallback(async () => {
+    try {
+      const response = await publishBlueprintMutation.mutateAsync();
+      showSuccessToast(`Component published as version ${response.data?.blueprint?.version}`);
+    } catch (error: any) {
+      const errorMessage = error?.response?.data?.message || error?.message || "Failed to publish component";
+      showErrorToast(errorMessage);
+    }
+  }, [publishBlueprintMutation]);
+
+  const handleDiscardDraft = useCallback(async () => {
+    try {
+      await discardBlueprintDraftMutation.mutateAsync();
+      setHasUnsavedChanges(false);
+      setInitialBlueprintSnapshot(null);
+      showSuccessToast("Unpublished changes discarded");
+    } catch (error: any) {
+      const errorMessage = error?.response?.data?.message || error?.message || "Failed to discard changes";
+      showErrorToast(errorMessage);
+    }
+  }, [discardBlueprintDraftMutation]);
+
   // Node and edge change handlers
   const onNodesChange = useCallback(
     (changes: any) => {
@@ -1342,6 +1378,10 @@ export const CustomComponent = () => {
         unsavedMessage={hasUnsavedChanges ? "You have unsaved changes" : undefined}
         saveButtonHidden={!hasUnsavedChanges}
         saveIsPrimary={hasUnsavedChanges}
+        hasDraft={!!blueprint?.hasDraft && !hasUnsavedChanges}
+        onPublishDraft={handlePublishDraft}
+        onDiscardDraft={handleDiscardDraft}
+        isPublishing={publishBlueprintMutation.isPending || discardBlueprintDraftMutation.isPending}
         onUndo={handleRevert}
         canUndo={initialBlueprintSnapshot !== null}
         templateNodeId={templateNodeId}
@@ -20,7 +20,11 @@ export function NodeRunPage() {
   const node = canvas?.spec?.nodes?.find((n) => n.id === nodeId);
 
   // If this is a blueprint node, load the blueprint definition
-  const { data: blueprint } = useBlueprint(organizationId || "", node?.blueprint?.id || "");
+  const { data: blueprint } = useBlueprint(
+    organizationId || "",
+    node?.blueprint?.id || "",
+    node?.blueprint?.version || 0,
+  );
 
   // Fetch child executions based on the executionId from the URL
   const { data: childExecsResp } = useChildExecutions(canvasId || "", executionId || null);
@@ -58,6 +58,14 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
         resource: "org",
         action: "delete",
       },
+      {
+        id: "audit_events.read",
+        name: "View Audit Log",
+        description: "View changes made in the organization",
+        category: "General",
+        resource: "audit_events",
+        action: "read",
+      },
     ],
   },
   {
@@ -196,6 +204,14 @@ const ORGANIZATION_PERMISSIONS: PermissionCategory[] = [
         resource: "canvases",
         action: "update",
       },
+      {
+        id: "canvas.operate",
+        name: "Operate Canvases",
+        description: "Approve, cancel and invoke actions on canvas runs, without editing canvases",
+        category: "Canvases",
+        resource: "canvases",
+        action: "operate",
+      },
       {
         id: "canvas.delete",
         name: "Delete Canvases",
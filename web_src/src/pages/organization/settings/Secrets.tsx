@@ -192,7 +192,7 @@ export function Secrets({ organizationId }: SecretsProps) {
                       </TableCell>
                       <TableCell>
                         <span className="text-sm text-gray-500 dark:text-gray-400">
-                          {keyCount} key{keyCount === 1 ? "" : "s"}
+                          {secret.spec?.vault ? "Vault" : `${keyCount} key${keyCount === 1 ? "" : "s"}`}
                         </span>
                       </TableCell>
                     </TableRow>
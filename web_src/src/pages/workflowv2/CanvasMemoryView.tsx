@@ -22,6 +22,14 @@ function formatValue(value: unknown): string {
   }
 }
 
+function formatExpiry(expiresAt?: string): string {
+  if (!expiresAt) {
+    return "Never";
+  }
+
+  return new Date(expiresAt).toLocaleString();
+}
+
 function isRecord(value: unknown): value is Record<string, unknown> {
   return typeof value === "object" && value !== null && !Array.isArray(value);
 }
@@ -56,6 +64,7 @@ function renderNamespaceTable(
                   {column}
                 </th>
               ))}
+              <th className="px-3 py-2 text-left text-xs font-semibold text-gray-600 uppercase">Expires</th>
               <th className="px-3 py-2 text-right text-xs font-semibold text-gray-600 uppercase w-12"></th>
             </tr>
           </thead>
@@ -69,6 +78,9 @@ function renderNamespaceTable(
                       {formatValue(item[column])}
                     </td>
                   ))}
+                  <td className="px-3 py-2 text-xs text-gray-500 align-middle whitespace-nowrap">
+                    {formatExpiry(entry.expiresAt)}
+                  </td>
                   <td className="px-3 py-2 text-right align-middle">
                     <Button
                       type="button"
@@ -99,6 +111,7 @@ function renderNamespaceTable(
         <thead>
           <tr className="border-b border-slate-200 bg-slate-50">
             <th className="px-3 py-2 text-left text-xs font-semibold text-gray-600 uppercase">Value</th>
+            <th className="px-3 py-2 text-left text-xs font-semibold text-gray-600 uppercase">Expires</th>
             <th className="px-3 py-2 text-right text-xs font-semibold text-gray-600 uppercase w-12"></th>
           </tr>
         </thead>
@@ -106,6 +119,9 @@ function renderNamespaceTable(
           {values.map((entry, index) => (
             <tr key={entry.id || index} className="border-b border-slate-100">
               <td className="px-3 py-2 font-mono text-xs text-gray-700 align-middle">{formatValue(entry.values)}</td>
+              <td className="px-3 py-2 text-xs text-gray-500 align-middle whitespace-nowrap">
+                {formatExpiry(entry.expiresAt)}
+              </td>
               <td className="px-3 py-2 text-right align-middle">
                 <Button
                   type="button"
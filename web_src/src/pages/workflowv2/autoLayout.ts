@@ -268,6 +268,31 @@ function applyLayoutedPositions(
   });
 }
 
+/**
+ * Switch nodes have one output channel per configured case, followed by default,
+ * so their channels come from the node configuration instead of the component definition.
+ */
+// Nodes with a timeout emit an event on this channel when an execution times out.
+export const TIMEOUT_CHANNEL = "timeout";
+
+export function withTimeoutChannel(node: ComponentsNode, channels: string[]): string[] {
+  if (!node.timeoutSeconds || node.timeoutSeconds <= 0 || channels.includes(TIMEOUT_CHANNEL)) {
+    return channels;
+  }
+
+  return [...channels, TIMEOUT_CHANNEL];
+}
+
+export function getComponentNodeChannels(node: ComponentsNode, component?: ComponentsComponent): string[] {
+  if (node.component?.name === "switch") {
+    const cases = (node.configuration?.cases as Array<{ name?: string }> | undefined) || [];
+    const names = cases.map((c) => c?.name || "").filter((name) => name !== "" && name !== "default");
+    return withTimeoutChannel(node, [...new Set(names), "default"]);
+  }
+
+  return withTimeoutChannel(node, component?.outputChannels?.map((c) => c.name!).filter(Boolean) || ["default"]);
+}
+
 export function buildChannelsByNodeId(
   workflow: CanvasesCanvas,
   components: ComponentsComponent[],
@@ -285,11 +310,14 @@ export function buildChannelsByNodeId(
     } else if (node.type === "TYPE_BLUEPRINT") {
       const componentMeta = components.find((c) => c.name === node.component?.name);
       const bp = blueprints.find((b) => b.id === node.blueprint?.id);
-      channels = componentMeta?.outputChannels?.map((c) => c.name!).filter(Boolean) ||
-        bp?.outputChannels?.map((c) => c.name!).filter(Boolean) || ["default"];
+      channels = withTimeoutChannel(
+        node,
+        componentMeta?.outputChannels?.map((c) => c.name!).filter(Boolean) ||
+          bp?.outputChannels?.map((c) => c.name!).filter(Boolean) || ["default"],
+      );
     } else if (node.type === "TYPE_COMPONENT" && node.component?.name) {
       const meta = components.find((c) => c.name === node.component?.name);
-      channels = meta?.outputChannels?.map((c) => c.name!).filter(Boolean) || ["default"];
+      channels = getComponentNodeChannels(node, meta);
     }
 
     map.set(node.id, channels);
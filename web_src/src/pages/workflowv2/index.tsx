@@ -97,7 +97,12 @@ import { useOnCancelQueueItemHandler } from "./useOnCancelQueueItemHandler";
 import { usePushThroughHandler } from "./usePushThroughHandler";
 import { useCancelExecutionHandler } from "./useCancelExecutionHandler";
 import { applyAiOperationsToWorkflow } from "./applyAiOperationsToWorkflow";
-import { applyHorizontalAutoLayout, buildChannelsByNodeId } from "./autoLayout";
+import {
+  applyHorizontalAutoLayout,
+  buildChannelsByNodeId,
+  getComponentNodeChannels,
+  withTimeoutChannel,
+} from "./autoLayout";
 import { useAccount } from "@/contexts/AccountContext";
 import { usePermissions } from "@/contexts/PermissionsContext";
 import { useApprovalGroupUsersPrefetch } from "@/hooks/useApprovalGroupUsersPrefetch";
@@ -5122,6 +5127,15 @@ function prepareCompositeNode(
   // Use node name if available, otherwise fall back to blueprint name (from metadata)
   const displayLabel = node.name || blueprintMetadata?.name!;
 
+  // Nodes keep the blueprint version they were pinned to,
+  // so newer versions are only used after the node is upgraded.
+  const pinnedVersion = node.blueprint?.version || 0;
+  const latestVersion = node.blueprint?.latestVersion || 0;
+  const upgradeWarning =
+    pinnedVersion > 0 && latestVersion > pinnedVersion
+      ? `Using version ${pinnedVersion}, version ${latestVersion} is available`
+      : undefined;
+
   const configurationFields = blueprintMetadata?.configuration || [];
   const fieldLabelMap = configurationFields.reduce<Record<string, string>>((acc, field) => {
     if (field.name) {
@@ -5137,7 +5151,7 @@ function prepareCompositeNode(
       type: "composite",
       label: displayLabel,
       state: "pending" as const,
-      outputChannels: blueprintMetadata?.outputChannels?.map((c) => c.name!) || ["default"],
+      outputChannels: withTimeoutChannel(node, blueprintMetadata?.outputChannels?.map((c) => c.name!) || ["default"]),
       composite: {
         iconSlug: BUNDLE_ICON_SLUG,
         iconColor: getColorClass(color),
@@ -5147,7 +5161,7 @@ function prepareCompositeNode(
         description: blueprintMetadata?.description,
         isMissing: isMissing,
         error: node.errorMessage,
-        warning: node.warningMessage,
+        warning: node.warningMessage || upgradeWarning,
         paused: !!node.paused,
         parameters:
           Object.keys(node.configuration!).length > 0
@@ -5237,7 +5251,7 @@ function prepareNode(
           ...compositeNode,
           data: {
             ...compositeNode.data,
-            outputChannels: componentMetadata.outputChannels.map((c) => c.name!),
+            outputChannels: withTimeoutChannel(node, componentMetadata.outputChannels.map((c) => c.name!)),
           },
         };
       }
@@ -5421,7 +5435,7 @@ function prepareComponentBaseNode(
       type: "component",
       label: displayLabel,
       state: "pending" as const,
-      outputChannels: metadata?.outputChannels?.map((channel) => channel.name) || ["default"],
+      outputChannels: getComponentNodeChannels(node, metadata),
       component: {
         ...componentBaseProps,
         emptyStateProps,
@@ -5483,7 +5497,10 @@ function prepareMergeNode(
       type: "merge",
       label: displayLabel,
       state: "pending" as const,
-      outputChannels: componentDef?.outputChannels?.map((channel) => channel.name!) || ["default"],
+      outputChannels: withTimeoutChannel(
+        node,
+        componentDef?.outputChannels?.map((channel) => channel.name!) || ["default"],
+      ),
       merge: {
         title: displayLabel,
         lastEvent: lastEvent,
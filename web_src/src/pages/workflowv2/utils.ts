@@ -285,6 +285,7 @@ export function buildRunItemFromExecution(options: {
       : null,
     " · ",
     executionState,
+    execution.attempt && execution.attempt > 1 ? ` · attempt ${execution.attempt}` : null,
   );
 
   return {
@@ -78,6 +78,9 @@ interface HeaderProps {
   exitEditModeDisabled?: boolean;
   exitEditModeDisabledTooltip?: string;
   showPendingDraftBadge?: boolean;
+  onPublishDraft?: () => void;
+  onDiscardDraft?: () => void;
+  draftActionsDisabled?: boolean;
 }
 
 export function Header({
@@ -118,6 +121,9 @@ export function Header({
   exitEditModeDisabled,
   exitEditModeDisabledTooltip,
   showPendingDraftBadge,
+  onPublishDraft,
+  onDiscardDraft,
+  draftActionsDisabled,
 }: HeaderProps) {
   const { workflowId } = useParams<{ workflowId?: string }>();
   const { data: workflows = [], isLoading: workflowsLoading } = useCanvases(organizationId || "");
@@ -498,6 +504,18 @@ export function Header({
                       </Button>,
                     )
                   : null}
+                {onDiscardDraft ? (
+                  <Button onClick={onDiscardDraft} size="sm" variant="outline" disabled={draftActionsDisabled}>
+                    <RotateCcw />
+                    Discard
+                  </Button>
+                ) : null}
+                {onPublishDraft ? (
+                  <Button onClick={onPublishDraft} size="sm" disabled={draftActionsDisabled}>
+                    <Rocket />
+                    Publish
+                  </Button>
+                ) : null}
               </>
             ) : null}
 
@@ -128,6 +128,10 @@ export interface CustomComponentBuilderPageProps {
   unsavedMessage?: string;
   saveIsPrimary?: boolean;
   saveButtonHidden?: boolean;
+  hasDraft?: boolean;
+  onPublishDraft?: () => void;
+  onDiscardDraft?: () => void;
+  isPublishing?: boolean;
   // Undo functionality
   onUndo?: () => void;
   canUndo?: boolean;
@@ -805,6 +809,10 @@ export function CustomComponentBuilderPage(props: CustomComponentBuilderPageProp
           unsavedMessage={props.unsavedMessage}
           saveIsPrimary={props.saveIsPrimary}
           saveButtonHidden={props.saveButtonHidden}
+          showPendingDraftBadge={props.hasDraft}
+          onPublishDraft={props.hasDraft ? props.onPublishDraft : undefined}
+          onDiscardDraft={props.hasDraft ? props.onDiscardDraft : undefined}
+          draftActionsDisabled={props.isPublishing || props.isSaving}
         />
       </div>
 
@@ -81,7 +81,9 @@ export const SecretKeyFieldRenderer = ({
       const secretName = secret.metadata?.name ?? secret.metadata?.id ?? "Unnamed";
       if (!ref) return;
       const detail = detailByRef[ref];
-      const keyNames = detail?.spec?.local?.data ? Object.keys(detail.spec.local.data) : [];
+      const keyNames = detail?.spec?.local?.data
+        ? Object.keys(detail.spec.local.data)
+        : (detail?.spec?.vault?.keys ?? []);
       if (keyNames.length === 0) return;
       keyNames.forEach((keyName) => {
         list.push({